@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/migration"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/prices"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/service"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -20,25 +21,29 @@ func execute() error {
 		Use: "analytics",
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
-				service.Run()
+				service.Run(false)
 			}
 		},
 	}
 
 	addServiceCommand(root)
 	addBackfiller(root)
+	addMigrationCommand(root)
 
 	return root.Execute()
 }
 
 func addServiceCommand(root *cobra.Command) {
+	var backfill bool
 	serviceCommand := &cobra.Command{
 		Use:   "service",
 		Short: "Run analytics as service",
 		Run: func(_ *cobra.Command, _ []string) {
-			service.Run()
+			service.Run(backfill)
 		},
 	}
+	// backfill flag
+	serviceCommand.Flags().BoolVar(&backfill, "backfill", false, "accept historical vaas and write their metrics in the bucket whose retention keeps them")
 	root.AddCommand(serviceCommand)
 }
 
@@ -289,3 +294,58 @@ func addVaasPrices(parent *cobra.Command) {
 
 	parent.AddCommand(vaasPricesCmd)
 }
+
+func addMigrationCommand(root *cobra.Command) {
+	migrationCmd := &cobra.Command{
+		Use:   "migration",
+		Short: "Manage the migrations of measurement schemas",
+	}
+	addMigrationCompareCommand(migrationCmd)
+	root.AddCommand(migrationCmd)
+}
+
+func addMigrationCompareCommand(parent *cobra.Command) {
+	var cfg migration.CompareConfig
+	var start, end string
+	compareCmd := &cobra.Command{
+		Use:   "compare",
+		Short: "Compare the old and the new measurement of a dual-write migration",
+		Run: func(_ *cobra.Command, _ []string) {
+			st, err := time.Parse(time.RFC3339, start)
+			if err != nil {
+				log.Fatal("Failed to parse start: ", err)
+			}
+			cfg.Start = st
+			et, err := time.Parse(time.RFC3339, end)
+			if err != nil {
+				log.Fatal("Failed to parse end: ", err)
+			}
+			cfg.End = et
+			migration.RunCompare(cfg)
+		},
+	}
+
+	// migration flag
+	compareCmd.Flags().StringVar(&cfg.Migration, "migration", "", "name of the migration")
+	compareCmd.MarkFlagRequired("migration")
+
+	//influx flags
+	compareCmd.Flags().StringVar(&cfg.InfluxUrl, "influx-url", "", "InfluxDB URL")
+	compareCmd.MarkFlagRequired("influx-url")
+	compareCmd.Flags().StringVar(&cfg.InfluxToken, "influx-token", "", "InfluxDB token")
+	compareCmd.MarkFlagRequired("influx-token")
+	compareCmd.Flags().StringVar(&cfg.InfluxOrganization, "influx-organization", "", "InfluxDB organization")
+	compareCmd.MarkFlagRequired("influx-organization")
+	compareCmd.Flags().StringVar(&cfg.InfluxBucket, "influx-bucket", "", "InfluxDB bucket")
+	compareCmd.MarkFlagRequired("influx-bucket")
+
+	// start flag
+	compareCmd.Flags().StringVar(&start, "start", "", "start timestamp in RFC3339 format")
+	compareCmd.MarkFlagRequired("start")
+
+	// end flag
+	compareCmd.Flags().StringVar(&end, "end", "", "end timestamp in RFC3339 format")
+	compareCmd.MarkFlagRequired("end")
+
+	parent.AddCommand(compareCmd)
+}
@@ -11,10 +11,10 @@ import (
 	"github.com/influxdata/influxdb-client-go/v2/api/write"
 	"github.com/shopspring/decimal"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/token"
-	"github.com/wormhole-foundation/wormhole-explorer/analytics/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/metric"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/prices"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -22,7 +22,7 @@ type VaaConverter struct {
 	MissingTokens            sync.Map
 	MissingTokensCounter     sync.Map
 	PriceCache               *prices.CoinPricesCache
-	Metrics                  metrics.Metrics
+	Metrics                  metrics.AnalyticsMetrics
 	GetTransferredTokenByVaa token.GetTransferredTokenByVaa
 	TokenProvider            *domain.TokenProvider
 	zap.Logger
@@ -36,7 +36,7 @@ func NewVaaConverter(priceCache *prices.CoinPricesCache,
 		MissingTokens:            sync.Map{},
 		MissingTokensCounter:     sync.Map{},
 		PriceCache:               priceCache,
-		Metrics:                  metrics.NewNoopMetrics(),
+		Metrics:                  metrics.NewNoopAnalyticsMetrics(),
 		GetTransferredTokenByVaa: GetTransferredTokenByVaa,
 		TokenProvider:            tokenProvider,
 	}
@@ -0,0 +1,61 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/analytics/migration"
+	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"go.uber.org/zap"
+)
+
+// CompareConfig contains the parameters of the comparison of a migration.
+type CompareConfig struct {
+	Migration          string
+	InfluxUrl          string
+	InfluxToken        string
+	InfluxOrganization string
+	InfluxBucket       string
+	Start              time.Time
+	End                time.Time
+}
+
+// RunCompare compares the old and the new measurement of a migration and prints the differences.
+// It exits with a non-zero status if the measurements are not equivalent.
+func RunCompare(cfg CompareConfig) {
+
+	logger := logger.New("wormhole-explorer-analytics")
+
+	m, ok := migration.Get(cfg.Migration)
+	if !ok {
+		logger.Fatal("unknown migration", zap.String("migration", cfg.Migration))
+	}
+
+	influxCli := influxdb2.NewClient(cfg.InfluxUrl, cfg.InfluxToken)
+	defer influxCli.Close()
+
+	logger.Info("comparing measurements",
+		zap.String("migration", m.Name),
+		zap.String("measurement", m.Measurement),
+		zap.String("newMeasurement", m.NewMeasurement),
+		zap.Time("start", cfg.Start),
+		zap.Time("end", cfg.End))
+
+	report, err := migration.Compare(context.Background(), influxCli.QueryAPI(cfg.InfluxOrganization),
+		cfg.InfluxBucket, m, cfg.Start, cfg.End)
+	if err != nil {
+		logger.Fatal("failed to compare measurements", zap.Error(err))
+	}
+
+	for _, d := range report.Differences {
+		fmt.Printf("field=%s tags=%v old=%s new=%s\n", d.Field, d.Tags, d.Old, d.New)
+	}
+	fmt.Printf("migration=%s groups=%d differences=%d\n", report.Migration, report.Groups, len(report.Differences))
+
+	if !report.Equivalent() {
+		os.Exit(1)
+	}
+}
@@ -20,16 +20,19 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/consumer"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/http"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/http/vaa"
-	"github.com/wormhole-foundation/wormhole-explorer/analytics/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/metric"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/queue"
 	wormscanNotionalCache "github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
+	sns_client "github.com/wormhole-foundation/wormhole-explorer/common/client/sns"
 	sqs_client "github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	health "github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 )
@@ -45,7 +48,8 @@ func handleExit() {
 	}
 }
 
-func Run() {
+// Run runs analytics as service, backfill enables the backfill mode regardless of the configuration.
+func Run(backfill bool) {
 	defer handleExit()
 	rootCtx, rootCtxCancel := context.WithCancel(context.Background())
 
@@ -54,6 +58,9 @@ func Run() {
 	if err != nil {
 		log.Fatal("Error creating config", err)
 	}
+	if backfill {
+		config.BackfillMode = true
+	}
 
 	// build logger
 	logger := logger.New("wormhole-explorer-analytics", logger.WithLevel(config.LogLevel))
@@ -86,7 +93,7 @@ func Run() {
 	}
 
 	// create prometheus client
-	metrics := metrics.NewPrometheusMetrics(config.Environment)
+	metrics := metrics.NewPrometheusAnalyticsMetrics(config.Environment)
 
 	// create a parserVAAAPIClient
 	parserVAAAPIClient, err := parser.NewParserVAAAPIClient(config.VaaPayloadParserTimeout,
@@ -101,24 +108,48 @@ func Run() {
 	// create a token provider
 	tokenProvider := domain.NewTokenProvider(config.P2pNetwork)
 
+	// parse the emitters of the protocols built on top of wormhole.
+	protocolEmitters, err := domain.ParseProtocolEmitters(config.ProtocolEmitters)
+	if err != nil {
+		logger.Fatal("failed to parse protocol emitters", zap.Error(err))
+	}
+
+	// parse the buckets of the other networks whose metrics are routed by this deployment.
+	networkBuckets, err := metric.ParseNetworkBuckets(config.InfluxNetworkBuckets)
+	if err != nil {
+		logger.Fatal("failed to parse network buckets", zap.Error(err))
+	}
+
 	// create a metrics instance
 	logger.Info("initializing metrics instance...")
 	metric, err := metric.New(rootCtx, db.Database, influxCli, config.InfluxOrganization, config.InfluxBucketInfinite,
-		config.InfluxBucket30Days, config.InfluxBucket24Hours, notionalCache, metrics, tokenResolver.GetTransferredTokenByVaa, tokenProvider, logger)
+		config.InfluxBucket30Days, config.InfluxBucket24Hours, config.P2pNetwork, networkBuckets, notionalCache, metrics, tokenResolver.GetTransferredTokenByVaa, tokenProvider, config.DualWriteMigrations, protocolEmitters, logger)
 	if err != nil {
 		logger.Fatal("failed to create metrics instance", zap.Error(err))
 	}
+	if config.EmitterDenyListEnabled {
+		metric.SetEmitterDenyList(repository.NewEmitterDenyList(db.Database, 5*time.Minute, logger))
+	}
+	if config.BackfillMode {
+		logger.Warn("backfill mode enabled, the historical vaas are written in the buckets whose retention keeps them")
+		metric.SetBackfillMode(true)
+	}
+
+	// create the backoffs applied when getting messages from the sqs queues fails.
+	vaaBackoff := newSQSBackoff(config.PipelineSQSUrl, metrics)
+	notificationBackoff := newSQSBackoff(config.NotificationsSQSUrl, metrics)
+	healthChecks = append(healthChecks, vaaBackoff.HealthCheck(), notificationBackoff.HealthCheck())
 
 	// create and start a vaa consumer.
 	logger.Info("initializing vaa consumer...")
-	vaaConsumeFunc := newVAAConsumeFunc(rootCtx, config, logger)
-	vaaConsumer := consumer.New(vaaConsumeFunc, metric.Push, logger, metrics, config.P2pNetwork)
+	vaaConsumeFunc := newVAAConsumeFunc(rootCtx, config, vaaBackoff, logger)
+	vaaConsumer := consumer.New(vaaConsumeFunc, metric.Push, logger, metrics, config.P2pNetwork, config.ConsumerDrainTimeout)
 	vaaConsumer.Start(rootCtx)
 
 	// create and start a notification consumer.
 	logger.Info("initializing notification consumer...")
-	notificationConsumeFunc := newNotificationConsumeFunc(rootCtx, config, logger)
-	notificationConsumer := consumer.New(notificationConsumeFunc, metric.Push, logger, metrics, config.P2pNetwork)
+	notificationConsumeFunc := newNotificationConsumeFunc(rootCtx, config, notificationBackoff, logger)
+	notificationConsumer := consumer.New(notificationConsumeFunc, metric.Push, logger, metrics, config.P2pNetwork, config.ConsumerDrainTimeout)
 	notificationConsumer.Start(rootCtx)
 
 	// create and start server.
@@ -143,6 +174,10 @@ func Run() {
 	logger.Info("cancelling root context...")
 	rootCtxCancel()
 
+	logger.Info("waiting for consumers to drain in-flight messages...")
+	vaaConsumer.Wait()
+	notificationConsumer.Wait()
+
 	logger.Info("closing metrics client...")
 	metric.Close()
 
@@ -156,27 +191,66 @@ func Run() {
 }
 
 // Creates a callbacks depending on whether the execution is local (memory queue) or not (SQS queue)
-func newVAAConsumeFunc(appCtx context.Context, config *config.Configuration, logger *zap.Logger) queue.ConsumeFunc {
+func newVAAConsumeFunc(appCtx context.Context, config *config.Configuration, backoff *sqs_client.Backoff, logger *zap.Logger) queue.ConsumeFunc {
+	// the filter policy is applied to the pipeline subscription, e.g. {"appId":["PORTAL_TOKEN_BRIDGE"]} to receive only token bridge vaas.
+	if config.PipelineSubscriptionArn != "" {
+		awsconfig, err := newAwsConfig(appCtx, config)
+		if err != nil {
+			logger.Fatal("failed to create aws config", zap.Error(err))
+		}
+		err = sns_client.SetSubscriptionFilterPolicy(appCtx, awsconfig, config.PipelineSubscriptionArn, config.PipelineFilterPolicy)
+		if err != nil {
+			logger.Fatal("failed to set pipeline subscription filter policy", zap.Error(err))
+		}
+		logger.Info("Pipeline subscription filter policy set", zap.String("filterPolicy", config.PipelineFilterPolicy))
+	}
+
 	sqsConsumer, err := newSQSConsumer(appCtx, config, config.PipelineSQSUrl)
 	if err != nil {
 		logger.Fatal("failed to create sqs consumer", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewEventSqs(sqsConsumer, queue.NewVaaConverter(logger), logger)
+	// the pipeline offloads to S3 the vaas that exceed the maximum message size.
+	awsConfig, err := newAwsConfig(appCtx, config)
+	if err != nil {
+		logger.Fatal("failed to create aws config", zap.Error(err))
+	}
+	codec := payload.NewCodec(payload.WithS3Store(payload.NewS3Store(awsConfig, config.AwsEndpoint), ""))
+	vaaQueue := sqs_client.NewEventQueue(sqsConsumer, queue.NewVaaConverter(logger), sqs_client.EventQueueConfig[queue.Event]{
+		Backoff: backoff,
+		Codec:   codec,
+		Hooks:   sqs_client.EventQueueHooks[queue.Event]{Attributes: setNetwork},
+	}, logger)
 	return vaaQueue.Consume
 }
 
-func newNotificationConsumeFunc(ctx context.Context, cfg *config.Configuration, logger *zap.Logger) queue.ConsumeFunc {
+func newNotificationConsumeFunc(ctx context.Context, cfg *config.Configuration, backoff *sqs_client.Backoff, logger *zap.Logger) queue.ConsumeFunc {
 
 	sqsConsumer, err := newSQSConsumer(ctx, cfg, cfg.NotificationsSQSUrl)
 	if err != nil {
 		logger.Fatal("failed to create sqs consumer", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewEventSqs(sqsConsumer, queue.NewNotificationEvent(logger), logger)
+	vaaQueue := sqs_client.NewEventQueue(sqsConsumer, queue.NewNotificationEvent(logger), sqs_client.EventQueueConfig[queue.Event]{
+		Backoff: backoff,
+		Hooks:   sqs_client.EventQueueHooks[queue.Event]{Attributes: setNetwork},
+	}, logger)
 	return vaaQueue.Consume
 }
 
+// setNetwork sets the p2p network of the event from the network attribute of the message.
+func setNetwork(event *queue.Event, attributes map[string]payload.Attribute) {
+	if network, ok := attributes[queue.NetworkAttribute]; ok {
+		event.Network = network.Value
+	}
+}
+
+func newSQSBackoff(sqsUrl string, metrics metrics.AnalyticsMetrics) *sqs_client.Backoff {
+	return sqs_client.NewBackoff(sqs_client.WithFailureObserver(func(failures int) {
+		metrics.SetSqsConsecutiveFailures(sqsUrl, failures)
+	}))
+}
+
 func newSQSConsumer(appCtx context.Context, config *config.Configuration, sqsUrl string) (*sqs_client.Consumer, error) {
 	awsconfig, err := newAwsConfig(appCtx, config)
 	if err != nil {
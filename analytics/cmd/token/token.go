@@ -40,6 +40,11 @@ type TransferredToken struct {
 	Amount       *big.Int
 	FromAddress  string
 	ToAddress    string
+	// Fee is the relayer fee carried by the payload, if any.
+	Fee *big.Int
+	// FeeChain and FeeAddress identify the token the relayer fee is paid with, they are set along with Fee.
+	FeeChain   sdk.ChainID
+	FeeAddress sdk.Address
 }
 
 func (t *TransferredToken) Clone() *TransferredToken {
@@ -50,6 +55,10 @@ func (t *TransferredToken) Clone() *TransferredToken {
 	if t.Amount != nil {
 		amount = new(big.Int).Set(t.Amount)
 	}
+	var fee *big.Int
+	if t.Fee != nil {
+		fee = new(big.Int).Set(t.Fee)
+	}
 	return &TransferredToken{
 		AppId:        t.AppId,
 		AppIDs:       t.AppIDs,
@@ -60,6 +69,9 @@ func (t *TransferredToken) Clone() *TransferredToken {
 		Amount:       amount,
 		FromAddress:  t.FromAddress,
 		ToAddress:    t.ToAddress,
+		Fee:          fee,
+		FeeChain:     t.FeeChain,
+		FeeAddress:   t.FeeAddress,
 	}
 }
 
@@ -160,6 +172,18 @@ func createToken(p *parser.ParseVaaWithStandarizedPropertiesdResponse, emitterCh
 		return nil, fmt.Errorf("amount [%s] is not a number", p.StandardizedProperties.Amount)
 	}
 
+	// the relayer fee is optional, so a malformed value or fee token is ignored.
+	var fee *big.Int
+	var feeChain sdk.ChainID
+	var feeAddress sdk.Address
+	if p.StandardizedProperties.Fee != "" {
+		if f, ok := new(big.Int).SetString(p.StandardizedProperties.Fee, 10); ok && f.Sign() > 0 {
+			if a, err := parseFeeAddress(p.StandardizedProperties); err == nil {
+				fee, feeChain, feeAddress = f, p.StandardizedProperties.FeeChain, a
+			}
+		}
+	}
+
 	appId := domain.AppIdUnkonwn
 	if len(p.StandardizedProperties.AppIds) > 0 {
 		appId = p.StandardizedProperties.AppIds[0]
@@ -175,9 +199,24 @@ func createToken(p *parser.ParseVaaWithStandarizedPropertiesdResponse, emitterCh
 		Amount:       n,
 		FromAddress:  p.StandardizedProperties.FromAddress,
 		ToAddress:    p.StandardizedProperties.ToAddress,
+		Fee:          fee,
+		FeeChain:     feeChain,
+		FeeAddress:   feeAddress,
 	}, nil
 }
 
+// parseFeeAddress returns the address of the token the relayer fee is paid with.
+func parseFeeAddress(sp parser.StandardizedProperties) (sdk.Address, error) {
+	if !domain.ChainIdIsValid(sp.FeeChain) || sp.FeeAddress == "" {
+		return sdk.Address{}, errors.New("fee token is not set")
+	}
+	addressHex, err := domain.DecodeNativeAddressToHex(sp.FeeChain, sp.FeeAddress)
+	if err != nil {
+		return sdk.Address{}, err
+	}
+	return sdk.StringToAddress(addressHex)
+}
+
 func parseTokenPayload(parsedPayload any) (*tokenParsedPayload, error) {
 	if parsedPayload == nil {
 		return nil, fmt.Errorf("parsedPayload is nil")
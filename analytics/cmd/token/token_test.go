@@ -0,0 +1,53 @@
+package token
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func newParseResponse(fee, feeAddress string) *parser.ParseVaaWithStandarizedPropertiesdResponse {
+	return &parser.ParseVaaWithStandarizedPropertiesdResponse{
+		StandardizedProperties: parser.StandardizedProperties{
+			AppIds:       []string{"PORTAL_TOKEN_BRIDGE"},
+			ToChain:      sdk.ChainIDSolana,
+			TokenChain:   sdk.ChainIDEthereum,
+			TokenAddress: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+			Amount:       "100000000",
+			FeeChain:     sdk.ChainIDEthereum,
+			FeeAddress:   feeAddress,
+			Fee:          fee,
+		},
+	}
+}
+
+func TestCreateTokenWithFeeToken(t *testing.T) {
+	token, err := createToken(newParseResponse("2000000", "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"), sdk.ChainIDEthereum)
+	require.NoError(t, err)
+
+	assert.Equal(t, big.NewInt(2000000), token.Fee)
+	assert.Equal(t, sdk.ChainIDEthereum, token.FeeChain)
+	assert.Equal(t, "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", token.FeeAddress.String())
+	// the fee token is not the transferred token.
+	assert.Equal(t, "000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", token.TokenAddress.String())
+}
+
+func TestCreateTokenWithoutFeeToken(t *testing.T) {
+	// a fee without the token it is paid with is ignored.
+	token, err := createToken(newParseResponse("2000000", ""), sdk.ChainIDEthereum)
+	require.NoError(t, err)
+
+	assert.Nil(t, token.Fee)
+	assert.Equal(t, sdk.ChainIDUnset, token.FeeChain)
+}
+
+func TestCreateTokenWithMalformedFee(t *testing.T) {
+	token, err := createToken(newParseResponse("abc", "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"), sdk.ChainIDEthereum)
+	require.NoError(t, err)
+
+	assert.Nil(t, token.Fee)
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
@@ -19,12 +20,18 @@ type Configuration struct {
 	AwsRegion               string `env:"AWS_REGION"`
 	PipelineSQSUrl          string `env:"PIPELINE_SQS_URL"`
 	NotificationsSQSUrl     string `env:"NOTIFICATIONS_SQS_URL"`
+	PipelineSubscriptionArn string `env:"PIPELINE_SUBSCRIPTION_ARN"`
+	PipelineFilterPolicy    string `env:"PIPELINE_FILTER_POLICY"`
 	InfluxUrl               string `env:"INFLUX_URL"`
 	InfluxToken             string `env:"INFLUX_TOKEN"`
 	InfluxOrganization      string `env:"INFLUX_ORGANIZATION"`
 	InfluxBucketInfinite    string `env:"INFLUX_BUCKET_INFINITE"`
 	InfluxBucket30Days      string `env:"INFLUX_BUCKET_30_DAYS"`
 	InfluxBucket24Hours     string `env:"INFLUX_BUCKET_24_HOURS"`
+	// InfluxNetworkBuckets routes the metrics of other p2p networks to their own organization and buckets, with the format
+	// "network:organization:bucketInfinite:bucket30Days:bucket24Hours,network:organization:...".
+	// The network of each vaa is taken from the network attribute of the message.
+	InfluxNetworkBuckets    string `env:"INFLUX_NETWORK_BUCKETS"`
 	MongodbURI              string `env:"MONGODB_URI,required"`
 	MongodbDatabase         string `env:"MONGODB_DATABASE,required"`
 	PprofEnabled            bool   `env:"PPROF_ENABLED,default=false"`
@@ -34,6 +41,18 @@ type Configuration struct {
 	CacheChannel            string `env:"CACHE_CHANNEL,required"`
 	VaaPayloadParserURL     string `env:"VAA_PAYLOAD_PARSER_URL, required"`
 	VaaPayloadParserTimeout int64  `env:"VAA_PAYLOAD_PARSER_TIMEOUT, required"`
+	// DualWriteMigrations are the names of the migrations whose points are written to both schemas.
+	DualWriteMigrations []string `env:"DUAL_WRITE_MIGRATIONS"`
+	// ProtocolEmitters tags the points of the emitters of protocols like the fast transfers or the swap layers
+	// with the protocol name, with the format "chainId:emitterAddress:protocol,chainId:emitterAddress:protocol".
+	ProtocolEmitters string `env:"PROTOCOL_EMITTERS"`
+	// EmitterDenyListEnabled excludes the vaas of the emitters in the deny-list managed by the api from the statistics.
+	EmitterDenyListEnabled bool `env:"EMITTER_DENY_LIST_ENABLED,default=true"`
+	// BackfillMode accepts the historical vaas pushed by the metric backfill tool and writes their points
+	// in the bucket whose retention keeps them, instead of skipping the vaas older than the retention.
+	BackfillMode bool `env:"BACKFILL_MODE,default=false"`
+	// ConsumerDrainTimeout bounds the processing of the in-flight messages on shutdown.
+	ConsumerDrainTimeout time.Duration `env:"CONSUMER_DRAIN_TIMEOUT,default=20s"`
 }
 
 // New creates a configuration with the values from .env file and environment variables.
@@ -2,65 +2,109 @@ package consumer
 
 import (
 	"context"
+	"errors"
+	"time"
 
-	"github.com/wormhole-foundation/wormhole-explorer/analytics/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/metric"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/queue"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pipeline"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
 // Consumer consumer struct definition.
 type Consumer struct {
-	consume    queue.ConsumeFunc
+	pipeline   *pipeline.Pipeline[queue.ConsumerMessage]
 	pushMetric metric.MetricPushFunc
 	logger     *zap.Logger
-	metrics    metrics.Metrics
+	metrics    metrics.AnalyticsMetrics
 	p2pNetwork string
 }
 
 // New creates a new vaa consumer.
-func New(consume queue.ConsumeFunc, pushMetric metric.MetricPushFunc, logger *zap.Logger, metrics metrics.Metrics, p2pNetwork string) *Consumer {
-	return &Consumer{consume: consume, pushMetric: pushMetric, logger: logger, metrics: metrics, p2pNetwork: p2pNetwork}
+// On shutdown, the in-flight messages are still processed for up to drainTimeout.
+func New(consume queue.ConsumeFunc, pushMetric metric.MetricPushFunc, logger *zap.Logger, metrics metrics.AnalyticsMetrics, p2pNetwork string, drainTimeout time.Duration) *Consumer {
+	c := &Consumer{pushMetric: pushMetric, logger: logger, metrics: metrics, p2pNetwork: p2pNetwork}
+	c.pipeline = pipeline.New(pipeline.Source[queue.ConsumerMessage](consume), c.processMessage, pipeline.Config[queue.ConsumerMessage]{
+		SkipExpired:  true,
+		DrainTimeout: drainTimeout,
+		Hooks: pipeline.Hooks[queue.ConsumerMessage]{
+			Expired:   c.onExpired,
+			Processed: c.onProcessed,
+			Failed:    c.onFailed,
+		},
+	})
+	return c
 }
 
 // Start consumes messages from VAA queue, parse and store those messages in a repository.
 func (c *Consumer) Start(ctx context.Context) {
-	go func() {
-		for msg := range c.consume(ctx) {
-			event := msg.Data()
+	c.pipeline.Start(ctx)
+}
+
+func (c *Consumer) processMessage(ctx context.Context, msg queue.ConsumerMessage) error {
+	event := msg.Data()
+
+	// unmarshal vaa.
+	vaa, err := sdk.Unmarshal(event.Vaa)
+	if err != nil {
+		return pipeline.Discard(err)
+	}
+
+	// chains added by governance may not be known by the wormhole sdk yet.
+	if vaa.EmitterChain != sdk.ChainIDUnset && !domain.ChainIdIsValid(vaa.EmitterChain) {
+		c.metrics.IncUnknownChain(uint16(vaa.EmitterChain))
+	}
+
+	// push vaa metrics.
+	err = c.pushMetric(ctx, &metric.Params{TrackID: event.TrackID, Vaa: vaa, VaaIsSigned: event.VaaIsSigned, Network: event.Network})
+	if errors.Is(err, metric.ErrUnknownNetwork) {
+		// the message is not retried, the network has no buckets in this deployment.
+		return pipeline.Discard(err)
+	}
+	return err
+}
 
-			chainID := sdk.ChainID(event.ChainID).String()
+func (c *Consumer) onExpired(msg queue.ConsumerMessage) {
+	event := msg.Data()
+	c.logger.Warn("Message with vaa expired", zap.String("id", event.ID))
+	c.metrics.IncExpiredMessage(sdk.ChainID(event.ChainID).String(), event.Source, msg.Retry())
+}
 
-			// check id message is expired.
-			if msg.IsExpired() {
-				msg.Failed()
-				c.logger.Warn("Message with vaa expired", zap.String("id", event.ID))
-				c.metrics.IncExpiredMessage(chainID, event.Source, msg.Retry())
-				continue
-			}
+func (c *Consumer) onFailed(msg queue.ConsumerMessage, err error, _ time.Duration) {
+	event := msg.Data()
+	chainID := sdk.ChainID(event.ChainID).String()
+	if pipeline.IsDiscarded(err) {
+		c.logger.Error("Invalid vaa", zap.String("id", event.ID), zap.Error(err))
+		c.metrics.IncInvalidMessage(chainID, event.Source, msg.Retry())
+		return
+	}
+	c.metrics.IncUnprocessedMessage(chainID, event.Source, msg.Retry())
+}
 
-			// unmarshal vaa.
-			vaa, err := sdk.Unmarshal(event.Vaa)
-			if err != nil {
-				msg.Done()
-				c.logger.Error("Invalid vaa", zap.String("id", event.ID), zap.Error(err))
-				c.metrics.IncInvalidMessage(chainID, event.Source, msg.Retry())
-				continue
-			}
+func (c *Consumer) onProcessed(msg queue.ConsumerMessage, _ time.Duration) {
+	event := msg.Data()
+	chainID := sdk.ChainID(event.ChainID).String()
+	c.logger.Debug("Pushed vaa metric", zap.String("id", event.ID))
+	c.metrics.IncProcessedMessage(chainID, event.Source, msg.Retry())
+	c.metrics.VaaProcessingDuration(chainID, msg.SentTimestamp())
+	c.observeLatency(event.Watermarks)
+}
 
-			// push vaa metrics.
-			err = c.pushMetric(ctx, &metric.Params{TrackID: event.TrackID, Vaa: vaa, VaaIsSigned: event.VaaIsSigned})
-			if err != nil {
-				msg.Failed()
-				c.metrics.IncUnprocessedMessage(chainID, event.Source, msg.Retry())
-				continue
-			}
+// observeLatency records the latency of the analytics stage and the end-to-end latency of a processed event.
+func (c *Consumer) observeLatency(w events.Watermarks) {
+	if latency, ok := w.StageLatency(events.StageAnalytics); ok {
+		c.metrics.VaaStageLatency(events.StageAnalytics, latency)
+	}
+	if latency, ok := w.EndToEndLatency(time.Now()); ok {
+		c.metrics.VaaEndToEndLatency(latency)
+	}
+}
 
-			msg.Done()
-			c.logger.Debug("Pushed vaa metric", zap.String("id", event.ID))
-			c.metrics.IncProcessedMessage(chainID, event.Source, msg.Retry())
-			c.metrics.VaaProcessingDuration(chainID, msg.SentTimestamp())
-		}
-	}()
+// Wait blocks until the consumer stops after the context is cancelled and the in-flight messages are drained.
+func (c *Consumer) Wait() {
+	c.pipeline.Wait()
 }
@@ -3,6 +3,7 @@ package vaa
 import (
 	"context"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 	"gopkg.in/mgo.v2/bson"
@@ -17,8 +18,8 @@ type Repository struct {
 
 // VaaDoc vaa document struct definition.
 type VaaDoc struct {
-	ID  string `bson:"_id" json:"id"`
-	Vaa []byte `bson:"vaas" json:"vaa"`
+	ID  string                     `bson:"_id" json:"id"`
+	Vaa repository.CompressedBytes `bson:"vaas" json:"vaa"`
 }
 
 // NewRepository create a new Repository.
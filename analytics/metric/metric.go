@@ -8,38 +8,47 @@ import (
 	"time"
 
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
-	"github.com/influxdata/influxdb-client-go/v2/api"
 	"github.com/influxdata/influxdb-client-go/v2/api/write"
 	"github.com/shopspring/decimal"
 	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/token"
-	"github.com/wormhole-foundation/wormhole-explorer/analytics/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/analytics/migration"
 	wormscanNotionalCache "github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 )
 
 const (
-	VaaCountMeasurement       = "vaa_count"
-	VaaVolumeMeasurement      = "vaa_volume_v2"
-	VaaAllMessagesMeasurement = "vaa_count_all_messages"
+	VaaCountMeasurement        = "vaa_count"
+	VaaVolumeMeasurement       = "vaa_volume_v2"
+	VaaAllMessagesMeasurement  = "vaa_count_all_messages"
+	RelayerFeeMeasurement      = "relayer_fee"
+	VaaPayloadStatsMeasurement = "vaa_payload_stats"
 )
 
 // Metric definition.
 type Metric struct {
 	db *mongo.Database
 	// transferPrices contains the notional price for each token bridge transfer.
-	transferPrices           *mongo.Collection
-	influxCli                influxdb2.Client
-	apiBucketInfinite        api.WriteAPIBlocking
-	apiBucket30Days          api.WriteAPIBlocking
-	apiBucket24Hours         api.WriteAPIBlocking
+	transferPrices *mongo.Collection
+	influxCli      influxdb2.Client
+	p2pNetwork     string
+	// buckets are the buckets of the p2p network of the deployment, used when the vaas have no network.
+	buckets *bucketWriters
+	// networkBuckets are the buckets of the other networks whose metrics are routed by this deployment.
+	networkBuckets           map[string]*bucketWriters
 	notionalCache            wormscanNotionalCache.NotionalLocalCacheReadable
-	metrics                  metrics.Metrics
+	metrics                  metrics.AnalyticsMetrics
 	getTransferredTokenByVaa token.GetTransferredTokenByVaa
 	tokenProvider            *domain.TokenProvider
-	logger                   *zap.Logger
+	protocols                domain.ProtocolEmitters
+	denyList                 *repository.EmitterDenyList
+	// backfill writes the points older than the retention of their buckets in the bucket with infinite retention.
+	backfill bool
+	logger   *zap.Logger
 }
 
 // New create a new *Metric.
@@ -51,45 +60,96 @@ func New(
 	bucketInifite string,
 	bucket30Days string,
 	bucket24Hours string,
+	p2pNetwork string,
+	networkBuckets []NetworkBuckets,
 	notionalCache wormscanNotionalCache.NotionalLocalCacheReadable,
-	metrics metrics.Metrics,
+	metrics metrics.AnalyticsMetrics,
 	getTransferredTokenByVaa token.GetTransferredTokenByVaa,
 	tokenProvider *domain.TokenProvider,
+	dualWriteMigrations []string,
+	protocols domain.ProtocolEmitters,
 	logger *zap.Logger,
 ) (*Metric, error) {
 
-	apiBucketInfinite := influxCli.WriteAPIBlocking(organization, bucketInifite)
-	apiBucket30Days := influxCli.WriteAPIBlocking(organization, bucket30Days)
-	apiBucket24Hours := influxCli.WriteAPIBlocking(organization, bucket24Hours)
-	apiBucket24Hours.EnableBatching()
+	migrations, err := migration.Enabled(dualWriteMigrations)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range migrations {
+		logger.Info("Dual write enabled",
+			zap.String("migration", m.Name),
+			zap.String("measurement", m.Measurement),
+			zap.String("newMeasurement", m.NewMeasurement))
+	}
+
+	buckets := newBucketWriters(influxCli, NetworkBuckets{
+		Network:        p2pNetwork,
+		Organization:   organization,
+		BucketInfinite: bucketInifite,
+		Bucket30Days:   bucket30Days,
+		Bucket24Hours:  bucket24Hours,
+	}, migrations, logger)
+	routed := make(map[string]*bucketWriters, len(networkBuckets))
+	for _, nb := range networkBuckets {
+		logger.Info("Routing network metrics",
+			zap.String("network", nb.Network),
+			zap.String("organization", nb.Organization))
+		routed[nb.Network] = newBucketWriters(influxCli, nb, migrations, logger)
+	}
 
 	m := Metric{
 		db:                       db,
 		transferPrices:           db.Collection("transferPrices"),
 		influxCli:                influxCli,
-		apiBucketInfinite:        apiBucketInfinite,
-		apiBucket24Hours:         apiBucket24Hours,
-		apiBucket30Days:          apiBucket30Days,
+		p2pNetwork:               p2pNetwork,
+		buckets:                  buckets,
+		networkBuckets:           routed,
 		logger:                   logger,
 		notionalCache:            notionalCache,
 		metrics:                  metrics,
 		getTransferredTokenByVaa: getTransferredTokenByVaa,
 		tokenProvider:            tokenProvider,
+		protocols:                protocols,
 	}
 	return &m, nil
 }
 
+// SetEmitterDenyList excludes the vaas of the denied emitters (e.g. spam emitters) from the statistics.
+func (m *Metric) SetEmitterDenyList(denyList *repository.EmitterDenyList) {
+	m.denyList = denyList
+}
+
+// SetBackfillMode enables the backfill mode, where the historical vaas are accepted and their points are routed
+// to the bucket whose retention keeps them instead of being skipped.
+func (m *Metric) SetBackfillMode(enabled bool) {
+	m.backfill = enabled
+}
+
 // Push implement MetricPushFunc definition.
 func (m *Metric) Push(ctx context.Context, params *Params) error {
 
-	var err1, err2, err3, err4 error
+	if m.denyList != nil && m.denyList.IsDenied(ctx, params.Vaa.EmitterChain, params.Vaa.EmitterAddress.String()) {
+		m.logger.Debug("Skipping vaa of a denied emitter",
+			zap.String("trackId", params.TrackID),
+			zap.String("vaaId", params.Vaa.MessageID()))
+		return nil
+	}
+
+	buckets, routed, err := m.bucketsOf(params.Network)
+	if err != nil {
+		return err
+	}
+
+	var err1, err2, err3, err4, err5, err6 error
 
 	isVaaSigned := params.VaaIsSigned
 
 	if isVaaSigned {
-		err1 = m.vaaCountMeasurement(ctx, params)
+		err1 = m.vaaCountMeasurement(ctx, buckets, params)
+
+		err2 = m.vaaCountAllMessagesMeasurement(ctx, buckets, params)
 
-		err2 = m.vaaCountAllMessagesMeasurement(ctx, params)
+		err6 = m.vaaPayloadStatsMeasurement(ctx, buckets, params)
 	}
 
 	if params.Vaa.EmitterChain != sdk.ChainIDPythNet {
@@ -108,25 +168,33 @@ func (m *Metric) Push(ctx context.Context, params *Params) error {
 		if transferredToken != nil {
 
 			if isVaaSigned {
-				err3 = m.volumeMeasurement(ctx, params, transferredToken.Clone())
+				err3 = m.volumeMeasurement(ctx, buckets, params, transferredToken.Clone())
 			}
 
-			err4 = UpsertTransferPrices(
-				ctx,
-				m.logger,
-				params.Vaa,
-				m.transferPrices,
-				func(tokenID, _ string, timestamp time.Time) (decimal.Decimal, error) {
-
-					priceData, err := m.notionalCache.Get(tokenID)
-					if err != nil {
-						return decimal.NewFromInt(0), err
-					}
-					return priceData.NotionalUsd, nil
-				},
-				transferredToken.Clone(),
-				m.tokenProvider,
-			)
+			if isVaaSigned && transferredToken.Fee != nil {
+				err5 = m.relayerFeeMeasurement(ctx, buckets, params, transferredToken.Clone())
+			}
+
+			// the transfer prices are stored in the database of the p2p network of the deployment,
+			// the vaas of the routed networks would collide with its vaas.
+			if !routed {
+				err4 = UpsertTransferPrices(
+					ctx,
+					m.logger,
+					params.Vaa,
+					m.transferPrices,
+					func(tokenID, _ string, timestamp time.Time) (decimal.Decimal, error) {
+
+						priceData, err := m.notionalCache.Get(tokenID)
+						if err != nil {
+							return decimal.NewFromInt(0), err
+						}
+						return priceData.NotionalUsd, nil
+					},
+					transferredToken.Clone(),
+					m.tokenProvider,
+				)
+			}
 
 		} else {
 			m.logger.Warn("Cannot obtain transferred token for this VAA",
@@ -138,8 +206,8 @@ func (m *Metric) Push(ctx context.Context, params *Params) error {
 	}
 
 	//TODO if we had go 1.20, we could just use `errors.Join(err1, err2, err3, ...)` here.
-	if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
-		return fmt.Errorf("err1=%w, err2=%w, err3=%w err4=%w", err1, err2, err3, err4)
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil || err5 != nil || err6 != nil {
+		return fmt.Errorf("err1=%w, err2=%w, err3=%w err4=%w err5=%w err6=%w", err1, err2, err3, err4, err5, err6)
 	}
 
 	if params.Vaa.EmitterChain != sdk.ChainIDPythNet {
@@ -159,16 +227,17 @@ func (m *Metric) Close() {
 
 	// wait a bounded amount of time for all buckets to flush
 	ctx, cancelFunc := context.WithTimeout(context.Background(), flushTimeout)
-	m.apiBucket24Hours.Flush(ctx)
-	m.apiBucket30Days.Flush(ctx)
-	m.apiBucketInfinite.Flush(ctx)
+	m.buckets.flush(ctx)
+	for _, buckets := range m.networkBuckets {
+		buckets.flush(ctx)
+	}
 	cancelFunc()
 
 	m.influxCli.Close()
 }
 
 // vaaCountMeasurement creates a new point for the `vaa_count` measurement.
-func (m *Metric) vaaCountMeasurement(ctx context.Context, p *Params) error {
+func (m *Metric) vaaCountMeasurement(ctx context.Context, buckets *bucketWriters, p *Params) error {
 
 	// Create a new point
 	point, err := MakePointForVaaCount(p.Vaa)
@@ -180,14 +249,14 @@ func (m *Metric) vaaCountMeasurement(ctx context.Context, p *Params) error {
 		return nil
 	}
 
-	// Ignore vaa older than 30 days
-	thirtyDaysBefore := time.Now().AddDate(0, 0, -30)
-	if p.Vaa.Timestamp.Before(thirtyDaysBefore) {
+	// Ignore vaa older than 30 days, unless the metrics are being backfilled
+	bucket, _, ok := m.route(buckets, retention30Days, retention30Days, p.Vaa.Timestamp)
+	if !ok {
 		return nil
 	}
 
 	// Write the point to influx
-	err = m.apiBucket30Days.WritePoint(ctx, point)
+	err = bucket.WritePoint(ctx, point)
 	if err != nil {
 		m.logger.Error("Failed to write metric",
 			zap.String("measurement", point.Name()),
@@ -203,19 +272,7 @@ func (m *Metric) vaaCountMeasurement(ctx context.Context, p *Params) error {
 }
 
 // vaaCountAllMessagesMeasurement creates a new point for the `vaa_count_all_messages` measurement.
-func (m *Metric) vaaCountAllMessagesMeasurement(ctx context.Context, params *Params) error {
-
-	// Quite often we get VAAs that are older than 24 hours.
-	// We do not want to generate metrics for those, and moreover influxDB
-	// returns an error when we try to do so.
-	if time.Since(params.Vaa.Timestamp) > time.Hour*24 {
-		m.logger.Debug("vaa is older than 24 hours, skipping",
-			zap.String("trackId", params.TrackID),
-			zap.Time("timestamp", params.Vaa.Timestamp),
-			zap.String("vaaId", params.Vaa.UniqueID()),
-		)
-		return nil
-	}
+func (m *Metric) vaaCountAllMessagesMeasurement(ctx context.Context, buckets *bucketWriters, params *Params) error {
 
 	// Create a new point
 	point := influxdb2.
@@ -223,9 +280,27 @@ func (m *Metric) vaaCountAllMessagesMeasurement(ctx context.Context, params *Par
 		AddTag("chain_id", strconv.Itoa(int(params.Vaa.EmitterChain))).
 		AddField("count", 1).
 		SetTime(generateUniqueTimestamp(params.Vaa))
+	m.addProtocolTag(point, params.Vaa)
+
+	// Quite often we get VAAs that are older than 24 hours, e.g. after an outage of the consumers.
+	// InfluxDB returns an error when we try to write them in the 24 hours bucket, so they are
+	// written in the 30 days bucket with the tag late=true. The counts beyond 24 hours are the sum
+	// of the hourly rollup `vaa_count_all_messages_1h` and the late points.
+	bucket, r, ok := m.route(buckets, retention24Hours, retention30Days, params.Vaa.Timestamp)
+	if !ok {
+		m.logger.Debug("vaa is older than 30 days, skipping",
+			zap.String("trackId", params.TrackID),
+			zap.Time("timestamp", params.Vaa.Timestamp),
+			zap.String("vaaId", params.Vaa.UniqueID()),
+		)
+		return nil
+	}
+	if r != retention24Hours {
+		point.AddTag("late", "true")
+	}
 
 	// Write the point to influx
-	err := m.apiBucket24Hours.WritePoint(ctx, point)
+	err := bucket.WritePoint(ctx, point)
 	if err != nil {
 		m.logger.Error("Failed to write metric",
 			zap.String("measurement", VaaAllMessagesMeasurement),
@@ -240,8 +315,39 @@ func (m *Metric) vaaCountAllMessagesMeasurement(ctx context.Context, params *Par
 	return nil
 }
 
+// vaaPayloadStatsMeasurement creates a new point for the `vaa_payload_stats` measurement.
+func (m *Metric) vaaPayloadStatsMeasurement(ctx context.Context, buckets *bucketWriters, params *Params) error {
+
+	// Create a new point
+	point := MakePointForVaaPayloadStats(params.Vaa)
+	if point == nil {
+		return nil
+	}
+
+	// Ignore vaa older than 30 days, unless the metrics are being backfilled
+	bucket, _, ok := m.route(buckets, retention30Days, retention30Days, params.Vaa.Timestamp)
+	if !ok {
+		return nil
+	}
+
+	// Write the point to influx
+	err := bucket.WritePoint(ctx, point)
+	if err != nil {
+		m.logger.Error("Failed to write metric",
+			zap.String("measurement", VaaPayloadStatsMeasurement),
+			zap.Uint16("chain_id", uint16(params.Vaa.EmitterChain)),
+			zap.Error(err),
+		)
+		m.metrics.IncFailedMeasurement(VaaPayloadStatsMeasurement)
+		return err
+	}
+	m.metrics.IncSuccessfulMeasurement(VaaPayloadStatsMeasurement)
+
+	return nil
+}
+
 // volumeMeasurement creates a new point for the `vaa_volume_v2` measurement.
-func (m *Metric) volumeMeasurement(ctx context.Context, params *Params, token *token.TransferredToken) error {
+func (m *Metric) volumeMeasurement(ctx context.Context, buckets *bucketWriters, params *Params, token *token.TransferredToken) error {
 
 	// Generate a data point for the volume metric
 	p := MakePointForVaaVolumeParams{
@@ -272,7 +378,7 @@ func (m *Metric) volumeMeasurement(ctx context.Context, params *Params, token *t
 	vaaVolumeV3point := m.MakePointVaaVolumeV3(point, params, token)
 
 	// Write the point to influx
-	err = m.apiBucketInfinite.WritePoint(ctx, point, vaaVolumeV3point)
+	err = buckets.apiBucketInfinite.WritePoint(ctx, point, vaaVolumeV3point)
 	if err != nil {
 		m.metrics.IncFailedMeasurement(VaaVolumeMeasurement)
 		return err
@@ -289,6 +395,52 @@ func (m *Metric) volumeMeasurement(ctx context.Context, params *Params, token *t
 	return nil
 }
 
+// relayerFeeMeasurement creates a new point for the `relayer_fee` measurement.
+func (m *Metric) relayerFeeMeasurement(ctx context.Context, buckets *bucketWriters, params *Params, token *token.TransferredToken) error {
+
+	// Generate a data point for the relayer fee metric
+	p := MakePointForVaaVolumeParams{
+		Logger: m.logger,
+		Vaa:    params.Vaa,
+		TokenPriceFunc: func(tokenID string, timestamp time.Time) (decimal.Decimal, error) {
+
+			priceData, err := m.notionalCache.Get(tokenID)
+			if err != nil {
+				return decimal.NewFromInt(0), err
+			}
+
+			return priceData.NotionalUsd, nil
+		},
+		Metrics:          m.metrics,
+		TransferredToken: token,
+		TokenProvider:    m.tokenProvider,
+	}
+	point, err := MakePointForRelayerFee(&p)
+	if err != nil {
+		return err
+	}
+	if point == nil {
+		return nil
+	}
+
+	// Write the point to influx
+	err = buckets.apiBucketInfinite.WritePoint(ctx, point)
+	if err != nil {
+		m.metrics.IncFailedMeasurement(RelayerFeeMeasurement)
+		return err
+	}
+	m.logger.Debug("Wrote a data point for the relayer fee metric",
+		zap.String("vaaId", params.Vaa.MessageID()),
+		zap.String("trackId", params.TrackID),
+		zap.String("measurement", point.Name()),
+		zap.Any("tags", point.TagList()),
+		zap.Any("fields", point.FieldList()),
+	)
+	m.metrics.IncSuccessfulMeasurement(RelayerFeeMeasurement)
+
+	return nil
+}
+
 func (m *Metric) MakePointVaaVolumeV3(vaaVolumeV2Point *write.Point, params *Params, transferredToken *token.TransferredToken) *write.Point {
 
 	point := influxdb2.NewPointWithMeasurement("vaa_volume_v3")
@@ -317,6 +469,7 @@ func (m *Metric) MakePointVaaVolumeV3(vaaVolumeV2Point *write.Point, params *Par
 	}
 
 	point.AddTag("size", strconv.Itoa(len(transferredToken.AppIDs)))
+	m.addProtocolTag(point, params.Vaa)
 
 	if transferredToken.FromAddress != "" {
 		var fromAddr string
@@ -352,6 +505,13 @@ func (m *Metric) MakePointVaaVolumeV3(vaaVolumeV2Point *write.Point, params *Par
 	return point
 }
 
+// addProtocolTag tags a point with the protocol of the emitter of the vaa, if the emitter belongs to a protocol.
+func (m *Metric) addProtocolTag(point *write.Point, vaa *sdk.VAA) {
+	if protocol, ok := m.protocols.Protocol(vaa.EmitterChain, vaa.EmitterAddress.String()); ok {
+		point.AddTag("protocol", protocol)
+	}
+}
+
 // MakePointForVaaCount generates a data point for the VAA count measurement.
 //
 // Some VAAs will not generate a measurement, so the caller must always check
@@ -373,6 +533,24 @@ func MakePointForVaaCount(vaa *sdk.VAA) (*write.Point, error) {
 	return point, nil
 }
 
+// MakePointForVaaPayloadStats returns a point with the payload size of a vaa, tagged by emitter
+// so the message rate and the payload sizes of each emitter can be aggregated.
+func MakePointForVaaPayloadStats(vaa *sdk.VAA) *write.Point {
+
+	// Do not generate this metric for PythNet VAAs
+	if vaa.EmitterChain == sdk.ChainIDPythNet {
+		return nil
+	}
+
+	return influxdb2.
+		NewPointWithMeasurement(VaaPayloadStatsMeasurement).
+		AddTag("emitter_chain", strconv.Itoa(int(vaa.EmitterChain))).
+		AddTag("emitter_address", vaa.EmitterAddress.String()).
+		AddField("count", 1).
+		AddField("payload_size", len(vaa.Payload)).
+		SetTime(generateUniqueTimestamp(vaa))
+}
+
 // MakePointForVaaVolumeParams contains input parameters for the function `MakePointForVaaVolume`
 type MakePointForVaaVolumeParams struct {
 
@@ -386,7 +564,7 @@ type MakePointForVaaVolumeParams struct {
 	Logger *zap.Logger
 
 	// Metrics is in case the caller wants additional visibility.
-	Metrics metrics.Metrics
+	Metrics metrics.AnalyticsMetrics
 
 	// TransferredToken is the token that was transferred in the VAA.
 	TransferredToken *token.TransferredToken
@@ -522,6 +700,80 @@ func MakePointForVaaVolume(params *MakePointForVaaVolumeParams) (*write.Point, e
 	return point, nil
 }
 
+// MakePointForRelayerFee builds the InfluxDB relayer fee metric for a given VAA.
+//
+// Only VAAs that carry a relayer fee generate a measurement, so the caller must always check
+// whether the returned point is nil.
+func MakePointForRelayerFee(params *MakePointForVaaVolumeParams) (*write.Point, error) {
+
+	if params.TransferredToken == nil || params.TransferredToken.Fee == nil || params.TransferredToken.Fee.Sign() <= 0 {
+		return nil, nil
+	}
+
+	// Get the metadata of the token the fee is paid with, which is not always the transferred token.
+	feeChain := params.TransferredToken.FeeChain
+	feeAddress := params.TransferredToken.FeeAddress
+	tokenMeta, ok := params.TokenProvider.GetTokenByAddress(feeChain, feeAddress.String())
+	if !ok {
+		params.Metrics.IncMissingToken(feeChain.String(), feeAddress.String())
+		return nil, nil
+	}
+
+	// Normalize the fee to 8 decimals
+	fee := params.TransferredToken.Fee
+	if tokenMeta.Decimals < 8 {
+
+		// factor = 10 ^ (8 - tokenMeta.Decimals)
+		var factor big.Int
+		factor.Exp(big.NewInt(10), big.NewInt(int64(8-tokenMeta.Decimals)), nil)
+
+		fee = new(big.Int).Mul(fee, &factor)
+	}
+
+	// Try to obtain the token notional value from the cache
+	notionalUSD, err := params.TokenPriceFunc(tokenMeta.GetTokenID(), params.Vaa.Timestamp)
+	if err != nil {
+		params.Metrics.IncMissingNotional(tokenMeta.Symbol.String())
+		if params.Logger != nil {
+			params.Logger.Warn("Failed to obtain notional for this token",
+				zap.String("vaaId", params.Vaa.MessageID()),
+				zap.String("tokenAddress", feeAddress.String()),
+				zap.Uint16("tokenChain", uint16(feeChain)),
+				zap.Error(err),
+			)
+		}
+		return nil, nil
+	}
+
+	// Convert the notional value to an integer with an implicit precision of 8 decimals
+	notionalBigInt := notionalUSD.
+		Truncate(8).
+		Mul(decimal.NewFromInt(1e8)).
+		BigInt()
+
+	// Calculate the fee in USD, with an implicit precision of 8 decimals
+	var feeUSD big.Int
+	feeUSD.Mul(fee, notionalBigInt)
+	feeUSD.Div(&feeUSD, big.NewInt(1e8))
+
+	point := influxdb2.NewPointWithMeasurement(RelayerFeeMeasurement).
+		AddTag("app_id", params.TransferredToken.AppId).
+		AddTag("emitter_chain", fmt.Sprintf("%d", params.Vaa.EmitterChain)).
+		AddTag("destination_chain", fmt.Sprintf("%d", params.TransferredToken.ToChain)).
+		AddTag("token_address", feeAddress.String()).
+		AddTag("token_chain", fmt.Sprintf("%d", feeChain)).
+		AddField("symbol", tokenMeta.Symbol.String()).
+		// Fee amount, integer, 8 decimals of precision
+		AddField("fee", fee.Uint64()).
+		// Fee token price at the time the VAA was emitted, integer, 8 decimals of precision
+		AddField("notional", notionalBigInt.Uint64()).
+		// Fee in USD, integer, 8 decimals of precision
+		AddField("fee_usd", feeUSD.Uint64()).
+		SetTime(generateUniqueTimestamp(params.Vaa))
+
+	return point, nil
+}
+
 // generateUniqueTimestamp generates a unique timestamp for each VAA.
 //
 // Most VAA timestamps only have millisecond resolution, so it is possible that two VAAs
@@ -0,0 +1,103 @@
+package metric
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole-explorer/analytics/cmd/token"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const (
+	wethAddress = "000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"
+	usdcAddress = "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+)
+
+// newRelayerFeeParams returns the params of a weth transfer with a relayer fee paid with [feeAddress].
+func newRelayerFeeParams(t *testing.T, fee *big.Int, feeAddress string) *MakePointForVaaVolumeParams {
+	weth, err := sdk.StringToAddress(wethAddress)
+	require.NoError(t, err)
+	feeToken, err := sdk.StringToAddress(feeAddress)
+	require.NoError(t, err)
+
+	return &MakePointForVaaVolumeParams{
+		Vaa: &sdk.VAA{
+			EmitterChain: sdk.ChainIDEthereum,
+			Timestamp:    time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		TokenPriceFunc: func(tokenID string, _ time.Time) (decimal.Decimal, error) {
+			switch tokenID {
+			case "2/" + usdcAddress:
+				return decimal.NewFromInt(1), nil
+			case "2/" + wethAddress:
+				return decimal.NewFromInt(3000), nil
+			}
+			return decimal.Zero, errors.New("price not found")
+		},
+		Metrics: metrics.NewNoopAnalyticsMetrics(),
+		TransferredToken: &token.TransferredToken{
+			AppId:        domain.AppIdPortalTokenBridge,
+			FromChain:    sdk.ChainIDEthereum,
+			ToChain:      sdk.ChainIDSolana,
+			TokenAddress: weth,
+			TokenChain:   sdk.ChainIDEthereum,
+			Amount:       big.NewInt(100000000),
+			Fee:          fee,
+			FeeChain:     sdk.ChainIDEthereum,
+			FeeAddress:   feeToken,
+		},
+		TokenProvider: domain.NewTokenProvider("mainnet"),
+	}
+}
+
+func TestMakePointForRelayerFeeWithFeeToken(t *testing.T) {
+	// a weth transfer with a fee of 2 usdc, 6 decimals.
+	fee := big.NewInt(2000000)
+	params := newRelayerFeeParams(t, fee, usdcAddress)
+
+	point, err := MakePointForRelayerFee(params)
+	require.NoError(t, err)
+	require.NotNil(t, point)
+
+	assert.Equal(t, RelayerFeeMeasurement, point.Name())
+	tags := map[string]string{}
+	for _, tag := range point.TagList() {
+		tags[tag.Key] = tag.Value
+	}
+	assert.Equal(t, usdcAddress, tags["token_address"])
+	assert.Equal(t, "2", tags["token_chain"])
+	assert.Equal(t, "1", tags["destination_chain"])
+
+	fields := map[string]any{}
+	for _, field := range point.FieldList() {
+		fields[field.Key] = field.Value
+	}
+	assert.Equal(t, "USDC", fields["symbol"])
+	// the fee and prices are integers with 8 decimals.
+	assert.Equal(t, uint64(200000000), fields["fee"])
+	assert.Equal(t, uint64(100000000), fields["notional"])
+	assert.Equal(t, uint64(200000000), fields["fee_usd"])
+
+	// the fee of the transferred token is not modified.
+	assert.Equal(t, big.NewInt(2000000), params.TransferredToken.Fee)
+}
+
+func TestMakePointForRelayerFeeWithoutFee(t *testing.T) {
+	point, err := MakePointForRelayerFee(newRelayerFeeParams(t, nil, usdcAddress))
+	assert.NoError(t, err)
+	assert.Nil(t, point)
+}
+
+func TestMakePointForRelayerFeeWithUnknownFeeToken(t *testing.T) {
+	unknown := "0000000000000000000000000000000000000000000000000000000000000001"
+	point, err := MakePointForRelayerFee(newRelayerFeeParams(t, big.NewInt(2000000), unknown))
+	assert.NoError(t, err)
+	assert.Nil(t, point)
+}
@@ -0,0 +1,145 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/wormhole-foundation/wormhole-explorer/analytics/migration"
+	"go.uber.org/zap"
+)
+
+// ErrUnknownNetwork is returned when the metrics of a vaa cannot be routed to the buckets of its network.
+var ErrUnknownNetwork = errors.New("unknown network")
+
+// NetworkBuckets are the influx organization and buckets where the metrics of a network are written.
+type NetworkBuckets struct {
+	Network        string
+	Organization   string
+	BucketInfinite string
+	Bucket30Days   string
+	Bucket24Hours  string
+}
+
+// ParseNetworkBuckets parses the buckets of the networks with the format
+// "network:organization:bucketInfinite:bucket30Days:bucket24Hours,network:organization:...".
+func ParseNetworkBuckets(s string) ([]NetworkBuckets, error) {
+	var result []NetworkBuckets
+	networks := make(map[string]bool)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.Split(item, ":")
+		if len(parts) != 5 {
+			return nil, fmt.Errorf("invalid network buckets %q, expected network:organization:bucketInfinite:bucket30Days:bucket24Hours", item)
+		}
+		for _, part := range parts {
+			if strings.TrimSpace(part) == "" {
+				return nil, fmt.Errorf("invalid network buckets %q, empty value", item)
+			}
+		}
+		nb := NetworkBuckets{
+			Network:        strings.TrimSpace(parts[0]),
+			Organization:   strings.TrimSpace(parts[1]),
+			BucketInfinite: strings.TrimSpace(parts[2]),
+			Bucket30Days:   strings.TrimSpace(parts[3]),
+			Bucket24Hours:  strings.TrimSpace(parts[4]),
+		}
+		if networks[nb.Network] {
+			return nil, fmt.Errorf("duplicated network buckets %q", nb.Network)
+		}
+		networks[nb.Network] = true
+		result = append(result, nb)
+	}
+	return result, nil
+}
+
+// bucketWriters are the write apis of the buckets of a network.
+type bucketWriters struct {
+	apiBucketInfinite api.WriteAPIBlocking
+	apiBucket30Days   api.WriteAPIBlocking
+	apiBucket24Hours  api.WriteAPIBlocking
+}
+
+func newBucketWriters(influxCli influxdb2.Client, nb NetworkBuckets, migrations []migration.Migration, logger *zap.Logger) *bucketWriters {
+	apiBucket24Hours := migration.NewDualWriteAPI(influxCli.WriteAPIBlocking(nb.Organization, nb.Bucket24Hours), migrations, logger)
+	apiBucket24Hours.EnableBatching()
+	return &bucketWriters{
+		apiBucketInfinite: migration.NewDualWriteAPI(influxCli.WriteAPIBlocking(nb.Organization, nb.BucketInfinite), migrations, logger),
+		apiBucket30Days:   migration.NewDualWriteAPI(influxCli.WriteAPIBlocking(nb.Organization, nb.Bucket30Days), migrations, logger),
+		apiBucket24Hours:  apiBucket24Hours,
+	}
+}
+
+// retention is the retention of a bucket, from the shortest to the longest.
+type retention int
+
+const (
+	retention24Hours retention = iota
+	retention30Days
+	retentionInfinite
+)
+
+// writer returns the write api of the bucket with the retention [r].
+func (w *bucketWriters) writer(r retention) api.WriteAPIBlocking {
+	switch r {
+	case retention24Hours:
+		return w.apiBucket24Hours
+	case retention30Days:
+		return w.apiBucket30Days
+	default:
+		return w.apiBucketInfinite
+	}
+}
+
+// retentionOf returns the shortest retention, starting from [shortest], that keeps a point of time [t].
+func retentionOf(shortest retention, t time.Time) retention {
+	r := shortest
+	if r == retention24Hours && time.Since(t) > 24*time.Hour {
+		r = retention30Days
+	}
+	if r == retention30Days && t.Before(time.Now().AddDate(0, 0, -30)) {
+		r = retentionInfinite
+	}
+	return r
+}
+
+// route returns the bucket where a point of time [t] of a measurement written in the bucket with the retention
+// [shortest] is kept, up to the bucket with the retention [longest]. It returns false when the point is older than
+// the retention of [longest]. In backfill mode, the points older than the retention of [longest] are written in the
+// bucket with infinite retention.
+func (m *Metric) route(buckets *bucketWriters, shortest, longest retention, t time.Time) (api.WriteAPIBlocking, retention, bool) {
+	if m.backfill {
+		longest = retentionInfinite
+	}
+	r := retentionOf(shortest, t)
+	if r > longest {
+		return nil, r, false
+	}
+	return buckets.writer(r), r, true
+}
+
+func (w *bucketWriters) flush(ctx context.Context) {
+	w.apiBucket24Hours.Flush(ctx)
+	w.apiBucket30Days.Flush(ctx)
+	w.apiBucketInfinite.Flush(ctx)
+}
+
+// bucketsOf returns the buckets of a network, and whether the network is routed to other buckets
+// than the ones of the p2p network of the deployment. The vaas without network belong to the p2p network of the deployment.
+func (m *Metric) bucketsOf(network string) (*bucketWriters, bool, error) {
+	if network == "" || network == m.p2pNetwork {
+		return m.buckets, false, nil
+	}
+	buckets, ok := m.networkBuckets[network]
+	if !ok {
+		return nil, false, fmt.Errorf("%w: %s", ErrUnknownNetwork, network)
+	}
+	return buckets, true, nil
+}
@@ -10,6 +10,9 @@ type Params struct {
 	TrackID     string
 	Vaa         *vaa.VAA
 	VaaIsSigned bool
+	// Network is the p2p network of the vaa, the metrics are written to the buckets of the network.
+	// If it is empty, the vaa belongs to the p2p network of the deployment.
+	Network string
 }
 
 // MetricPushFunc is a function to push metrics
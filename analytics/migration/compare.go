@@ -0,0 +1,144 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/shopspring/decimal"
+)
+
+// Difference is a group whose field has a different value in the old and the new measurement.
+type Difference struct {
+	Field string
+	Tags  map[string]string
+	Old   decimal.Decimal
+	New   decimal.Decimal
+}
+
+// Report is the result of the comparison of the old and the new measurement of a migration.
+type Report struct {
+	Migration   string
+	Start       time.Time
+	Stop        time.Time
+	Groups      int
+	Differences []Difference
+}
+
+// Equivalent returns whether both measurements contain the same values.
+func (r *Report) Equivalent() bool {
+	return len(r.Differences) == 0
+}
+
+// Compare sums the fields of the migration grouped by its tags in both measurements, for the
+// points in [start, stop), and reports the groups whose sums are different.
+func Compare(ctx context.Context, queryAPI api.QueryAPI, bucket string, m Migration, start, stop time.Time) (*Report, error) {
+	if len(m.Fields) == 0 {
+		return nil, fmt.Errorf("migration %s has no fields to compare", m.Name)
+	}
+
+	oldSums, err := sum(ctx, queryAPI, buildSumQuery(bucket, m.Measurement, m, start, stop), m.GroupBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query measurement %s: %w", m.Measurement, err)
+	}
+	newSums, err := sum(ctx, queryAPI, buildSumQuery(bucket, m.NewMeasurement, m, start, stop), m.GroupBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query measurement %s: %w", m.NewMeasurement, err)
+	}
+
+	report := &Report{Migration: m.Name, Start: start, Stop: stop}
+	keys := make(map[string]group)
+	for k, g := range oldSums {
+		keys[k] = g
+	}
+	for k, g := range newSums {
+		keys[k] = g
+	}
+	for k, g := range keys {
+		oldValue := oldSums[k].value
+		newValue := newSums[k].value
+		if !oldValue.Equal(newValue) {
+			report.Differences = append(report.Differences, Difference{
+				Field: g.field,
+				Tags:  g.tags,
+				Old:   oldValue,
+				New:   newValue,
+			})
+		}
+	}
+	report.Groups = len(keys)
+	sort.Slice(report.Differences, func(i, j int) bool {
+		return groupKey(report.Differences[i].Field, report.Differences[i].Tags, m.GroupBy) <
+			groupKey(report.Differences[j].Field, report.Differences[j].Tags, m.GroupBy)
+	})
+	return report, nil
+}
+
+// group is the sum of a field for a combination of tag values.
+type group struct {
+	field string
+	tags  map[string]string
+	value decimal.Decimal
+}
+
+func sum(ctx context.Context, queryAPI api.QueryAPI, query string, groupBy []string) (map[string]group, error) {
+	result, err := queryAPI.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	groups := make(map[string]group)
+	for result.Next() {
+		record := result.Record()
+		tags := make(map[string]string, len(groupBy))
+		for _, tag := range groupBy {
+			if v, ok := record.ValueByKey(tag).(string); ok {
+				tags[tag] = v
+			}
+		}
+		value, err := decimal.NewFromString(fmt.Sprint(record.Value()))
+		if err != nil {
+			return nil, fmt.Errorf("field %s is not numeric: %w", record.Field(), err)
+		}
+		groups[groupKey(record.Field(), tags, groupBy)] = group{field: record.Field(), tags: tags, value: value}
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return groups, nil
+}
+
+func groupKey(field string, tags map[string]string, groupBy []string) string {
+	var b strings.Builder
+	b.WriteString(field)
+	for _, tag := range groupBy {
+		b.WriteString("|")
+		b.WriteString(tags[tag])
+	}
+	return b.String()
+}
+
+func buildSumQuery(bucket, measurement string, m Migration, start, stop time.Time) string {
+	fields := make([]string, 0, len(m.Fields))
+	for _, f := range m.Fields {
+		fields = append(fields, fmt.Sprintf("r._field == %q", f))
+	}
+	columns := make([]string, 0, len(m.GroupBy)+1)
+	columns = append(columns, `"_field"`)
+	for _, tag := range m.GroupBy {
+		columns = append(columns, fmt.Sprintf("%q", tag))
+	}
+	query := `
+from(bucket: "%s")
+  |> range(start: %s, stop: %s)
+  |> filter(fn: (r) => r._measurement == "%s" and (%s))
+  |> group(columns: [%s])
+  |> sum()
+`
+	return fmt.Sprintf(query, bucket, start.UTC().Format(time.RFC3339Nano), stop.UTC().Format(time.RFC3339Nano),
+		measurement, strings.Join(fields, " or "), strings.Join(columns, ", "))
+}
@@ -0,0 +1,68 @@
+package migration
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+)
+
+// Migration describes the change of schema of a measurement.
+//
+// While a migration is enabled, every point written to the old measurement is also
+// converted and written to the new one, so both schemas can be compared before the
+// queries are switched over.
+type Migration struct {
+	// Name identifies the migration in the configuration, logs and metrics.
+	Name string
+	// Measurement is the name of the measurement being migrated.
+	Measurement string
+	// NewMeasurement is the name of the measurement with the new schema.
+	NewMeasurement string
+	// Convert builds the point of the new schema from a point of the old schema.
+	// If it returns a nil point, nothing is written to the new measurement.
+	Convert func(point *write.Point) (*write.Point, error)
+	// Fields are the fields that must be equivalent in both measurements.
+	Fields []string
+	// GroupBy are the tags used to compare the fields. They must be present in both schemas.
+	GroupBy []string
+}
+
+// migrations contains the migrations that can be enabled, by name.
+var migrations = map[string]Migration{}
+
+// Register adds a migration to the list of migrations that can be enabled.
+func Register(m Migration) {
+	if _, ok := migrations[m.Name]; ok {
+		panic(fmt.Sprintf("migration %s already registered", m.Name))
+	}
+	migrations[m.Name] = m
+}
+
+// Get returns the registered migration with the given name.
+func Get(name string) (Migration, bool) {
+	m, ok := migrations[name]
+	return m, ok
+}
+
+// Enabled returns the registered migrations with the given names.
+func Enabled(names []string) ([]Migration, error) {
+	enabled := make([]Migration, 0, len(names))
+	for _, name := range names {
+		m, ok := Get(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown migration %s, available migrations: %v", name, available())
+		}
+		enabled = append(enabled, m)
+	}
+	return enabled, nil
+}
+
+func available() []string {
+	names := make([]string, 0, len(migrations))
+	for name := range migrations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,74 @@
+package migration
+
+import (
+	"context"
+
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	"go.uber.org/zap"
+)
+
+// dualWriteAPI is an api.WriteAPIBlocking that writes the points of the migrated measurements
+// to both the old and the new schema.
+type dualWriteAPI struct {
+	api.WriteAPIBlocking
+	migrations map[string][]Migration
+	logger     *zap.Logger
+}
+
+// NewDualWriteAPI wraps writeAPI to also write the points of the enabled migrations to the new schema.
+// If there are no enabled migrations, writeAPI is returned.
+//
+// The points of the new schema are written after the points of the old schema, and a failure
+// writing them is logged without affecting the result of the write.
+func NewDualWriteAPI(writeAPI api.WriteAPIBlocking, enabled []Migration, logger *zap.Logger) api.WriteAPIBlocking {
+	if len(enabled) == 0 {
+		return writeAPI
+	}
+	byMeasurement := make(map[string][]Migration)
+	for _, m := range enabled {
+		byMeasurement[m.Measurement] = append(byMeasurement[m.Measurement], m)
+	}
+	return &dualWriteAPI{
+		WriteAPIBlocking: writeAPI,
+		migrations:       byMeasurement,
+		logger:           logger,
+	}
+}
+
+// WritePoint writes the points and the points converted to the new schema of the enabled migrations.
+func (w *dualWriteAPI) WritePoint(ctx context.Context, points ...*write.Point) error {
+	if err := w.WriteAPIBlocking.WritePoint(ctx, points...); err != nil {
+		return err
+	}
+
+	migrated := w.convert(points)
+	if len(migrated) == 0 {
+		return nil
+	}
+	if err := w.WriteAPIBlocking.WritePoint(ctx, migrated...); err != nil {
+		w.logger.Error("Failed to write migrated points", zap.Int("points", len(migrated)), zap.Error(err))
+	}
+	return nil
+}
+
+func (w *dualWriteAPI) convert(points []*write.Point) []*write.Point {
+	var migrated []*write.Point
+	for _, point := range points {
+		for _, m := range w.migrations[point.Name()] {
+			p, err := m.Convert(point)
+			if err != nil {
+				w.logger.Error("Failed to convert point",
+					zap.String("migration", m.Name),
+					zap.String("measurement", point.Name()),
+					zap.Error(err),
+				)
+				continue
+			}
+			if p != nil {
+				migrated = append(migrated, p)
+			}
+		}
+	}
+	return migrated
+}
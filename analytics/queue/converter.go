@@ -24,6 +24,8 @@ type VaaEvent struct {
 	TxHash           string     `json:"txHash"`
 	Version          uint16     `json:"version"`
 	Revision         uint16     `json:"revision"`
+	// Watermarks contains the time at which the vaa was ingested by each stage of the pipeline.
+	Watermarks events.Watermarks `json:"watermarks"`
 }
 
 // VaaConverter converts a message from a VAAEvent.
@@ -46,6 +48,7 @@ func NewVaaConverter(log *zap.Logger) ConverterFunc {
 			Vaa:            vaaEvent.Vaa,
 			Timestamp:      vaaEvent.Timestamp,
 			VaaIsSigned:    true,
+			Watermarks:     vaaEvent.Watermarks.Stamp(events.StageAnalytics, time.Now()),
 		}, nil
 	}
 }
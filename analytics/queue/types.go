@@ -3,12 +3,14 @@ package queue
 import (
 	"context"
 	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
 )
 
-type sqsEvent struct {
-	MessageID string `json:"MessageId"`
-	Message   string `json:"Message"`
-}
+// NetworkAttribute is the message attribute with the p2p network of the event,
+// used to route the metrics of several networks from a single deployment.
+const NetworkAttribute = "network"
 
 // Event represents a event data to be handle.
 type Event struct {
@@ -21,17 +23,16 @@ type Event struct {
 	Vaa            []byte
 	Timestamp      *time.Time
 	VaaIsSigned    bool
+	Watermarks     events.Watermarks
+	// Network is the p2p network of the event, from the network attribute of the message.
+	Network string
 }
 
 // ConsumerMessage defition.
-type ConsumerMessage interface {
-	Retry() uint8
-	Data() *Event
-	Done()
-	Failed()
-	IsExpired() bool
-	SentTimestamp() *time.Time
-}
+type ConsumerMessage = sqs.ConsumerMessage[Event]
+
+// ConverterFunc converts a message from a sqs message.
+type ConverterFunc = sqs.ConverterFunc[Event]
 
 // ConsumeFunc is a function to consume VAAEvent.
 type ConsumeFunc func(context.Context) <-chan ConsumerMessage
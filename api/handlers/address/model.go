@@ -1,7 +1,12 @@
 package address
 
-import "github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+import (
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/labels"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+)
 
 type AddressOverview struct {
 	Vaas []*vaa.VaaDoc `json:"vaas"`
+	// Label is the friendly name of the address, if it is a known contract or exchange.
+	Label *labels.Label `json:"label,omitempty"`
 }
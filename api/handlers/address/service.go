@@ -3,6 +3,7 @@ package address
 import (
 	"context"
 
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/labels"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	"go.uber.org/zap"
@@ -10,13 +11,15 @@ import (
 
 type Service struct {
 	repo   *Repository
+	labels *labels.Service
 	logger *zap.Logger
 }
 
-func NewService(r *Repository, logger *zap.Logger) *Service {
+func NewService(r *Repository, labels *labels.Service, logger *zap.Logger) *Service {
 
 	srv := Service{
 		repo:   r,
+		labels: labels,
 		logger: logger.With(zap.String("module", "AddressService")),
 	}
 
@@ -41,6 +44,15 @@ func (s *Service) GetAddressOverview(
 		return response, err
 	}
 
+	// the label is optional, the address is returned without label if the labels cannot be loaded.
+	if len(overview.Vaas) > 0 {
+		addressLabels, err := s.labels.GetLabels(ctx)
+		if err != nil {
+			s.logger.Warn("failed to get address labels", zap.String("address", address), zap.Error(err))
+		}
+		overview.Label = addressLabels.Get(address)
+	}
+
 	response.Data = overview
 	return response, nil
 }
@@ -0,0 +1,27 @@
+package attestations
+
+import (
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// TokenAttestationDoc represents a token attested by the token bridge of a chain.
+type TokenAttestationDoc struct {
+	ID           string      `bson:"_id" json:"id"`
+	TokenChain   sdk.ChainID `bson:"tokenChain" json:"tokenChain"`
+	TokenAddress string      `bson:"tokenAddress" json:"tokenAddress"`
+	Decimals     uint8       `bson:"decimals" json:"decimals"`
+	Symbol       string      `bson:"symbol" json:"symbol"`
+	Name         string      `bson:"name" json:"name"`
+	EmitterChain sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddr  string      `bson:"emitterAddr" json:"emitterAddr"`
+	Sequence     string      `bson:"sequence" json:"sequence"`
+	Timestamp    time.Time   `bson:"timestamp" json:"timestamp"`
+}
+
+// TokenAttestationQuery contains the filters of the token attestations.
+type TokenAttestationQuery struct {
+	TokenChain   *sdk.ChainID
+	TokenAddress string
+}
@@ -0,0 +1,68 @@
+package attestations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// Repository definition.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		tokenAttestations *mongo.Collection
+	}
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db,
+		logger: logger.With(zap.String("module", "AttestationsRepository")),
+		collections: struct {
+			tokenAttestations *mongo.Collection
+		}{
+			tokenAttestations: db.Collection("tokenAttestations"),
+		},
+	}
+}
+
+// FindTokenAttestations get the token attestations sorted by timestamp.
+func (r *Repository) FindTokenAttestations(ctx context.Context, q TokenAttestationQuery, p *pagination.Pagination) ([]*TokenAttestationDoc, error) {
+
+	filter := bson.D{}
+	if q.TokenChain != nil {
+		filter = append(filter, bson.E{Key: "tokenChain", Value: *q.TokenChain})
+	}
+	if q.TokenAddress != "" {
+		filter = append(filter, bson.E{Key: "tokenAddress", Value: q.TokenAddress})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: p.GetSortInt()}, {Key: "_id", Value: -1}}).
+		SetSkip(p.Skip).
+		SetLimit(p.Limit)
+
+	cur, err := r.collections.tokenAttestations.Find(ctx, filter, opts)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute find command to get token attestations",
+			zap.Error(err), zap.String("tokenAddress", q.TokenAddress), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	docs := []*TokenAttestationDoc{}
+	if err := cur.All(ctx, &docs); err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*TokenAttestationDoc",
+			zap.Error(err), zap.String("tokenAddress", q.TokenAddress), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return docs, nil
+}
@@ -0,0 +1,26 @@
+package attestations
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	repo   *Repository
+	logger *zap.Logger
+}
+
+// NewService create a new Service.
+func NewService(dao *Repository, logger *zap.Logger) *Service {
+	return &Service{repo: dao, logger: logger.With(zap.String("module", "AttestationsService"))}
+}
+
+// FindTokenAttestations get the token attestations, most recent first by default.
+func (s *Service) FindTokenAttestations(ctx context.Context, q TokenAttestationQuery, p *pagination.Pagination) ([]*TokenAttestationDoc, error) {
+	if p == nil {
+		p = pagination.Default()
+	}
+	return s.repo.FindTokenAttestations(ctx, q, p)
+}
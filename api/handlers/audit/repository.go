@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/audit"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// Query contains the filters to search the audit records.
+type Query struct {
+	Service string
+	Action  string
+	Actor   string
+	Result  string
+	From    *time.Time
+	To      *time.Time
+}
+
+// Repository definition.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		auditRecords *mongo.Collection
+	}
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db,
+		logger: logger.With(zap.String("module", "AuditRepository")),
+		collections: struct {
+			auditRecords *mongo.Collection
+		}{
+			auditRecords: db.Collection(audit.AuditCollection),
+		},
+	}
+}
+
+// FindRecords get the audit records that match the query sorted by timestamp.
+func (r *Repository) FindRecords(ctx context.Context, q *Query, p *pagination.Pagination) ([]*audit.Record, error) {
+
+	filter := bson.D{}
+	if q.Service != "" {
+		filter = append(filter, bson.E{Key: "service", Value: q.Service})
+	}
+	if q.Action != "" {
+		filter = append(filter, bson.E{Key: "action", Value: q.Action})
+	}
+	if q.Actor != "" {
+		filter = append(filter, bson.E{Key: "actor", Value: q.Actor})
+	}
+	if q.Result != "" {
+		filter = append(filter, bson.E{Key: "result", Value: q.Result})
+	}
+	if q.From != nil || q.To != nil {
+		timestamp := bson.D{}
+		if q.From != nil {
+			timestamp = append(timestamp, bson.E{Key: "$gte", Value: *q.From})
+		}
+		if q.To != nil {
+			timestamp = append(timestamp, bson.E{Key: "$lt", Value: *q.To})
+		}
+		filter = append(filter, bson.E{Key: "timestamp", Value: timestamp})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: p.GetSortInt()}, {Key: "_id", Value: -1}}).
+		SetSkip(p.Skip).
+		SetLimit(p.Limit)
+
+	cur, err := r.collections.auditRecords.Find(ctx, filter, opts)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute find command to get audit records",
+			zap.Error(err), zap.Any("query", q), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var records []*audit.Record
+	if err := cur.All(ctx, &records); err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*audit.Record",
+			zap.Error(err), zap.Any("query", q), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return records, nil
+}
@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/audit"
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	repo   *Repository
+	logger *zap.Logger
+}
+
+// NewService create a new Service.
+func NewService(dao *Repository, logger *zap.Logger) *Service {
+	return &Service{repo: dao, logger: logger.With(zap.String("module", "AuditService"))}
+}
+
+// FindRecords get the audit records of the admin actions, most recent first by default.
+func (s *Service) FindRecords(ctx context.Context, q *Query, p *pagination.Pagination) ([]*audit.Record, error) {
+	if p == nil {
+		p = pagination.Default()
+	}
+	return s.repo.FindRecords(ctx, q, p)
+}
@@ -0,0 +1,43 @@
+package chaindata
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// ErrWipeDisabled is returned when the data wipe is not enabled in the environment.
+var ErrWipeDisabled = errors.New("WIPE DISABLED")
+
+// Service deletes the data of the chains redeployed in the testnet.
+type Service struct {
+	wiper   *repository.ChainDataWiper
+	enabled bool
+	logger  *zap.Logger
+}
+
+// NewService create a new Service.
+// The data is only wiped when enabled is true, it must never be enabled in the environments with mainnet data.
+func NewService(wiper *repository.ChainDataWiper, enabled bool, logger *zap.Logger) *Service {
+	return &Service{
+		wiper:   wiper,
+		enabled: enabled,
+		logger:  logger.With(zap.String("module", "ChainDataService")),
+	}
+}
+
+// Wipe deletes all the data of a chain and returns the number of documents deleted by collection.
+func (s *Service) Wipe(ctx context.Context, chainID sdk.ChainID) (map[string]int64, error) {
+	if !s.enabled {
+		return nil, ErrWipeDisabled
+	}
+	deleted, err := s.wiper.Wipe(ctx, chainID)
+	if err != nil {
+		s.logger.Error("failed to wipe chain data", zap.Uint16("chainId", uint16(chainID)), zap.Error(err))
+		return nil, errors.WithStack(err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,14 @@
+package contracts
+
+import (
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// ChainContracts are the addresses of the wormhole contracts deployed in a chain,
+// encoded in the chain's native format.
+type ChainContracts struct {
+	ChainID     sdk.ChainID `json:"chainId"`
+	Core        string      `json:"core,omitempty"`
+	TokenBridge string      `json:"tokenBridge,omitempty"`
+	NFTBridge   string      `json:"nftBridge,omitempty"`
+}
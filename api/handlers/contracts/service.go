@@ -0,0 +1,35 @@
+package contracts
+
+import (
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	p2pNetwork string
+	logger     *zap.Logger
+}
+
+// NewService create a new Service.
+func NewService(p2pNetwork string, logger *zap.Logger) *Service {
+	return &Service{p2pNetwork: p2pNetwork, logger: logger.With(zap.String("module", "ContractsService"))}
+}
+
+// FindContracts get the address book of the wormhole contracts of the network.
+// If [chainID] is not nil only the contracts of that chain are returned.
+func (s *Service) FindContracts(chainID *sdk.ChainID) []*ChainContracts {
+	result := make([]*ChainContracts, 0)
+	for _, c := range domain.ContractsByNetwork(s.p2pNetwork) {
+		if chainID != nil && c.ChainID != *chainID {
+			continue
+		}
+		result = append(result, &ChainContracts{
+			ChainID:     c.ChainID,
+			Core:        c.Core,
+			TokenBridge: c.TokenBridge,
+			NFTBridge:   c.NFTBridge,
+		})
+	}
+	return result
+}
@@ -0,0 +1,75 @@
+package denylist
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// Repository definition.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		emitterDenyList *mongo.Collection
+	}
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db,
+		logger: logger.With(zap.String("module", "DenyListRepository")),
+		collections: struct {
+			emitterDenyList *mongo.Collection
+		}{
+			emitterDenyList: db.Collection(repository.EmitterDenyLists),
+		},
+	}
+}
+
+// FindAll get all the denied emitters sorted by id.
+func (r *Repository) FindAll(ctx context.Context) ([]*repository.DeniedEmitter, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cur, err := r.collections.emitterDenyList.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		r.logger.Error("failed execute find command to get denied emitters", zap.Error(err))
+		return nil, errors.WithStack(err)
+	}
+
+	emitters := []*repository.DeniedEmitter{}
+	if err := cur.All(ctx, &emitters); err != nil {
+		r.logger.Error("failed decoding cursor to []*repository.DeniedEmitter", zap.Error(err))
+		return nil, errors.WithStack(err)
+	}
+	return emitters, nil
+}
+
+// Upsert adds an emitter to the deny-list or replaces it.
+func (r *Repository) Upsert(ctx context.Context, emitter *repository.DeniedEmitter) error {
+	opts := options.Replace().SetUpsert(true)
+	_, err := r.collections.emitterDenyList.ReplaceOne(ctx, bson.M{"_id": emitter.ID}, emitter, opts)
+	if err != nil {
+		r.logger.Error("failed to upsert denied emitter", zap.String("id", emitter.ID), zap.Error(err))
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// Delete removes an emitter from the deny-list.
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	res, err := r.collections.emitterDenyList.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		r.logger.Error("failed to delete denied emitter", zap.String("id", id), zap.Error(err))
+		return errors.WithStack(err)
+	}
+	if res.DeletedCount == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package denylist
+
+import (
+	"context"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/cacheable"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const emitterDenyListKey = "wormscan:emitter-deny-list"
+
+// Service manages the deny-list of emitters, the emitters whose messages are excluded
+// from the list endpoints (e.g. spam emitters).
+type Service struct {
+	repo    *Repository
+	cache   cache.Cache
+	metrics metrics.Metrics
+	logger  *zap.Logger
+}
+
+// NewService create a new Service.
+func NewService(repo *Repository, cache cache.Cache, metrics metrics.Metrics, logger *zap.Logger) *Service {
+	return &Service{
+		repo:    repo,
+		cache:   cache,
+		metrics: metrics,
+		logger:  logger.With(zap.String("module", "DenyListService")),
+	}
+}
+
+// FindAll get all the denied emitters sorted by id.
+func (s *Service) FindAll(ctx context.Context) ([]*repository.DeniedEmitter, error) {
+	return s.getDeniedEmitters(ctx)
+}
+
+// Excluded returns the denied emitters to exclude from the listings.
+//
+// The listings are not blocked by the deny-list, so if the denied emitters
+// cannot be loaded the error is logged and no emitter is excluded.
+func (s *Service) Excluded(ctx context.Context) []*repository.DeniedEmitter {
+	emitters, err := s.getDeniedEmitters(ctx)
+	if err != nil {
+		s.logger.Error("failed to get denied emitters, no emitter is excluded", zap.Error(err))
+		return nil
+	}
+	return emitters
+}
+
+// Upsert adds an emitter to the deny-list or replaces its reason.
+func (s *Service) Upsert(ctx context.Context, chainID sdk.ChainID, address, reason string) (*repository.DeniedEmitter, error) {
+	emitter := &repository.DeniedEmitter{
+		ID:             repository.DeniedEmitterID(chainID, address),
+		EmitterChain:   chainID,
+		EmitterAddress: repository.NormalizeEmitterAddress(address),
+		Reason:         reason,
+		UpdatedAt:      time.Now().UTC(),
+	}
+	if err := s.repo.Upsert(ctx, emitter); err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx)
+	return emitter, nil
+}
+
+// Delete removes an emitter from the deny-list.
+func (s *Service) Delete(ctx context.Context, chainID sdk.ChainID, address string) error {
+	if err := s.repo.Delete(ctx, repository.DeniedEmitterID(chainID, address)); err != nil {
+		return err
+	}
+	s.invalidate(ctx)
+	return nil
+}
+
+func (s *Service) getDeniedEmitters(ctx context.Context) ([]*repository.DeniedEmitter, error) {
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, 5*time.Minute, emitterDenyListKey, s.metrics,
+		func() ([]*repository.DeniedEmitter, error) {
+			return s.repo.FindAll(ctx)
+		})
+}
+
+// invalidate removes the cached deny-list, so the changes are enforced in the next request.
+func (s *Service) invalidate(ctx context.Context) {
+	if err := s.cache.Delete(ctx, emitterDenyListKey); err != nil {
+		s.logger.Warn("failed to invalidate emitter deny-list cache", zap.Error(err))
+	}
+}
@@ -0,0 +1,62 @@
+package emitters
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// registered emitter sources.
+const (
+	SourceGovernance = "governance"
+	SourceConfig     = "config"
+)
+
+// emitter types used to tag the vaas.
+const (
+	EmitterTypeCoreProtocol = "coreProtocol"
+	EmitterTypeArbitrary    = "arbitrary"
+)
+
+// RegisteredEmitter is an official emitter of a core protocol module (token bridge, nft bridge, relayer) on a chain.
+type RegisteredEmitter struct {
+	Module         string      `bson:"module" json:"module"`
+	EmitterChain   sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddress string      `bson:"emitterAddress" json:"emitterAddress"`
+	VaaID          string      `bson:"vaaId" json:"vaaId,omitempty"`
+	Source         string      `bson:"-" json:"source"`
+}
+
+// ParseRegisteredEmitters parses a list of registered emitters with the format "module:chain:address,module:chain:address".
+//
+// The address is the wormhole emitter address in hex (32 bytes).
+func ParseRegisteredEmitters(s string) ([]*RegisteredEmitter, error) {
+	var emitters []*RegisteredEmitter
+	if strings.TrimSpace(s) == "" {
+		return emitters, nil
+	}
+	for _, item := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid registered emitter %q, expected format module:chain:address", item)
+		}
+		chainID, err := strconv.ParseUint(parts[1], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid registered emitter %q: %w", item, err)
+		}
+		address := strings.ToLower(strings.TrimPrefix(parts[2], "0x"))
+		if b, err := hex.DecodeString(address); err != nil || len(b) != 32 {
+			return nil, fmt.Errorf("invalid registered emitter %q, the address must be 32 bytes in hex", item)
+		}
+		emitters = append(emitters, &RegisteredEmitter{
+			Module:         parts[0],
+			EmitterChain:   sdk.ChainID(chainID),
+			EmitterAddress: address,
+			Source:         SourceConfig,
+		})
+	}
+	return emitters, nil
+}
@@ -0,0 +1,35 @@
+package emitters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParseRegisteredEmitters(t *testing.T) {
+
+	emitters, err := ParseRegisteredEmitters(" TokenBridge:2:0x0000000000000000000000003EE18B2214AFF97000D974CF647E7C347E8FA585, NFTBridge:1:0def15a24423e1edd1a5ab16f557b9060303ddbab8c803d2ee48f4b78a1cfd6b")
+	assert.NoError(t, err)
+	assert.Len(t, emitters, 2)
+	assert.Equal(t, "TokenBridge", emitters[0].Module)
+	assert.Equal(t, sdk.ChainIDEthereum, emitters[0].EmitterChain)
+	assert.Equal(t, "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585", emitters[0].EmitterAddress)
+	assert.Equal(t, SourceConfig, emitters[0].Source)
+	assert.Equal(t, sdk.ChainIDSolana, emitters[1].EmitterChain)
+
+	emitters, err = ParseRegisteredEmitters("")
+	assert.NoError(t, err)
+	assert.Empty(t, emitters)
+
+	_, err = ParseRegisteredEmitters("TokenBridge:2")
+	assert.Error(t, err)
+	_, err = ParseRegisteredEmitters("TokenBridge:2:3ee18b2214aff97000d974cf647e7c347e8fa585")
+	assert.Error(t, err)
+}
+
+func TestEmitterTypes_Get(t *testing.T) {
+	types := EmitterTypes{emitterKey(sdk.ChainIDEthereum, "abc"): true}
+	assert.Equal(t, EmitterTypeCoreProtocol, types.Get(sdk.ChainIDEthereum, "abc"))
+	assert.Equal(t, EmitterTypeArbitrary, types.Get(sdk.ChainIDSolana, "abc"))
+}
@@ -0,0 +1,50 @@
+package emitters
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// Repository definition.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		registeredEmitters *mongo.Collection
+	}
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db,
+		logger: logger.With(zap.String("module", "EmittersRepository")),
+		collections: struct {
+			registeredEmitters *mongo.Collection
+		}{
+			registeredEmitters: db.Collection("registeredEmitters"),
+		},
+	}
+}
+
+// FindRegisteredEmitters get the emitters registered by the governance vaas.
+func (r *Repository) FindRegisteredEmitters(ctx context.Context) ([]*RegisteredEmitter, error) {
+	cur, err := r.collections.registeredEmitters.Find(ctx, bson.D{})
+	if err != nil {
+		r.logger.Error("failed execute find command to get registered emitters", zap.Error(err))
+		return nil, errors.WithStack(err)
+	}
+
+	var emitters []*RegisteredEmitter
+	if err := cur.All(ctx, &emitters); err != nil {
+		r.logger.Error("failed decoding cursor to []*RegisteredEmitter", zap.Error(err))
+		return nil, errors.WithStack(err)
+	}
+	for _, e := range emitters {
+		e.Source = SourceGovernance
+	}
+	return emitters, nil
+}
@@ -0,0 +1,124 @@
+package emitters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/cacheable"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const registeredEmittersKey = "wormscan:registered-emitters"
+
+type Service struct {
+	repo    *Repository
+	seed    []*RegisteredEmitter
+	cache   cache.Cache
+	metrics metrics.Metrics
+	logger  *zap.Logger
+}
+
+// NewService create a new Service.
+//
+// The [seed] emitters come from the configuration and are replaced by the emitters registered by
+// governance for the same module and chain.
+func NewService(repo *Repository, seed []*RegisteredEmitter, cache cache.Cache, metrics metrics.Metrics, logger *zap.Logger) *Service {
+	return &Service{
+		repo:    repo,
+		seed:    seed,
+		cache:   cache,
+		metrics: metrics,
+		logger:  logger.With(zap.String("module", "EmittersService")),
+	}
+}
+
+// FindRegisteredEmitters get the registered emitters sorted by module and chain.
+// If the parameter [module] is not empty, only the emitters of that module are returned.
+// If the parameter [chain] is not nil, only the emitters of that chain are returned.
+func (s *Service) FindRegisteredEmitters(ctx context.Context, module string, chain *sdk.ChainID) ([]*RegisteredEmitter, error) {
+	emitters, err := s.getRegisteredEmitters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*RegisteredEmitter, 0, len(emitters))
+	for _, e := range emitters {
+		if module != "" && e.Module != module {
+			continue
+		}
+		if chain != nil && e.EmitterChain != *chain {
+			continue
+		}
+		result = append(result, e)
+	}
+	return result, nil
+}
+
+// GetEmitterTypes returns the registered emitters indexed by chain and address to tag the vaas.
+func (s *Service) GetEmitterTypes(ctx context.Context) (EmitterTypes, error) {
+	emitters, err := s.getRegisteredEmitters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	types := make(EmitterTypes, len(emitters)+1)
+	types[emitterKey(sdk.GovernanceChain, sdk.GovernanceEmitter.String())] = true
+	for _, e := range emitters {
+		types[emitterKey(e.EmitterChain, e.EmitterAddress)] = true
+	}
+	return types, nil
+}
+
+func (s *Service) getRegisteredEmitters(ctx context.Context) ([]*RegisteredEmitter, error) {
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, 5*time.Minute, registeredEmittersKey, s.metrics,
+		func() ([]*RegisteredEmitter, error) {
+			return s.loadRegisteredEmitters(ctx)
+		})
+}
+
+func (s *Service) loadRegisteredEmitters(ctx context.Context) ([]*RegisteredEmitter, error) {
+	registered, err := s.repo.FindRegisteredEmitters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byModuleAndChain := make(map[string]*RegisteredEmitter, len(s.seed)+len(registered))
+	for _, e := range s.seed {
+		byModuleAndChain[fmt.Sprintf("%s/%d", e.Module, e.EmitterChain)] = e
+	}
+	for _, e := range registered {
+		byModuleAndChain[fmt.Sprintf("%s/%d", e.Module, e.EmitterChain)] = e
+	}
+
+	emitters := make([]*RegisteredEmitter, 0, len(byModuleAndChain))
+	for _, e := range byModuleAndChain {
+		emitters = append(emitters, e)
+	}
+	sort.Slice(emitters, func(i, j int) bool {
+		if emitters[i].Module != emitters[j].Module {
+			return emitters[i].Module < emitters[j].Module
+		}
+		return emitters[i].EmitterChain < emitters[j].EmitterChain
+	})
+	return emitters, nil
+}
+
+// EmitterTypes contains the core protocol emitters indexed by chain and address.
+type EmitterTypes map[string]bool
+
+// Get returns EmitterTypeCoreProtocol if the emitter is the governance emitter or a registered emitter,
+// otherwise it returns EmitterTypeArbitrary.
+func (t EmitterTypes) Get(chain sdk.ChainID, address string) string {
+	if t[emitterKey(chain, address)] {
+		return EmitterTypeCoreProtocol
+	}
+	return EmitterTypeArbitrary
+}
+
+func emitterKey(chain sdk.ChainID, address string) string {
+	return fmt.Sprintf("%d/%s", chain, address)
+}
@@ -0,0 +1,45 @@
+package governance
+
+import (
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// GuardianSetUpgrade is the new guardian set of a guardian set upgrade.
+type GuardianSetUpgrade struct {
+	Index uint32   `bson:"index" json:"index"`
+	Keys  []string `bson:"keys" json:"keys"`
+}
+
+// ChainRegistration is the emitter registered for a chain.
+type ChainRegistration struct {
+	EmitterChain   sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddress string      `bson:"emitterAddress" json:"emitterAddress"`
+}
+
+// RecoverChainID is the chain id set on a contract after a chain fork.
+type RecoverChainID struct {
+	EvmChainID string      `bson:"evmChainId" json:"evmChainId"`
+	NewChainID sdk.ChainID `bson:"newChainId" json:"newChainId"`
+}
+
+// GovernanceVaaDoc represents a decoded governance vaa.
+type GovernanceVaaDoc struct {
+	ID               string              `bson:"_id" json:"id"`
+	Sequence         string              `bson:"sequence" json:"sequence"`
+	GuardianSetIndex uint32              `bson:"guardianSetIndex" json:"guardianSetIndex"`
+	Module           string              `bson:"module" json:"module"`
+	ActionID         uint8               `bson:"actionId" json:"actionId"`
+	Action           string              `bson:"action" json:"action"`
+	TargetChain      sdk.ChainID         `bson:"targetChain" json:"targetChain"`
+	NewContract      string              `bson:"newContract,omitempty" json:"newContract,omitempty"`
+	GuardianSet      *GuardianSetUpgrade `bson:"guardianSet,omitempty" json:"guardianSet,omitempty"`
+	Registration     *ChainRegistration  `bson:"registration,omitempty" json:"registration,omitempty"`
+	Fee              string              `bson:"fee,omitempty" json:"fee,omitempty"`
+	Recipient        string              `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	RecoverChainID   *RecoverChainID     `bson:"recoverChainId,omitempty" json:"recoverChainId,omitempty"`
+	DeliveryProvider string              `bson:"deliveryProvider,omitempty" json:"deliveryProvider,omitempty"`
+	Payload          string              `bson:"payload" json:"payload"`
+	Timestamp        time.Time           `bson:"timestamp" json:"timestamp"`
+}
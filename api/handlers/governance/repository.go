@@ -0,0 +1,66 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// Repository definition.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		governanceVaas *mongo.Collection
+	}
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db,
+		logger: logger.With(zap.String("module", "GovernanceRepository")),
+		collections: struct {
+			governanceVaas *mongo.Collection
+		}{
+			governanceVaas: db.Collection("governanceVaas"),
+		},
+	}
+}
+
+// FindGovernanceVaas get the governance vaas sorted by timestamp.
+// If the parameter [module] is not empty, only the vaas of that module are returned.
+func (r *Repository) FindGovernanceVaas(ctx context.Context, module string, p *pagination.Pagination) ([]*GovernanceVaaDoc, error) {
+
+	filter := bson.D{}
+	if module != "" {
+		filter = append(filter, bson.E{Key: "module", Value: module})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: p.GetSortInt()}, {Key: "_id", Value: -1}}).
+		SetSkip(p.Skip).
+		SetLimit(p.Limit)
+
+	cur, err := r.collections.governanceVaas.Find(ctx, filter, opts)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute find command to get governance vaas",
+			zap.Error(err), zap.String("module", module), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var docs []*GovernanceVaaDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*GovernanceVaaDoc",
+			zap.Error(err), zap.String("module", module), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return docs, nil
+}
@@ -0,0 +1,27 @@
+package governance
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	repo   *Repository
+	logger *zap.Logger
+}
+
+// NewService create a new Service.
+func NewService(dao *Repository, logger *zap.Logger) *Service {
+	return &Service{repo: dao, logger: logger.With(zap.String("module", "GovernanceService"))}
+}
+
+// FindGovernanceVaas get the governance actions, most recent first by default.
+// If the parameter [module] is not empty, only the actions of that module are returned.
+func (s *Service) FindGovernanceVaas(ctx context.Context, module string, p *pagination.Pagination) ([]*GovernanceVaaDoc, error) {
+	if p == nil {
+		p = pagination.Default()
+	}
+	return s.repo.FindGovernanceVaas(ctx, module, p)
+}
@@ -42,6 +42,17 @@ type GovStatus struct {
 	Chains    []*GovStatusChains `bson:"chains" json:"chains"`
 }
 
+// GovStatusFreshness is the freshness of the governor status of a guardian node.
+type GovStatusFreshness struct {
+	ID        string     `bson:"_id" json:"id"`
+	NodeName  string     `bson:"nodename" json:"nodeName"`
+	UpdatedAt *time.Time `bson:"updatedAt" json:"updatedAt"`
+	// Age in seconds since the last update of the governor status.
+	Age int64 `bson:"-" json:"age"`
+	// Stale is true when the governor status was not updated within the stale threshold.
+	Stale bool `bson:"-" json:"stale"`
+}
+
 type GovStatusChains struct {
 	ChainID                    vaa.ChainID              `bson:"chainid" json:"chainId"`
 	RemainingAvailableNotional mongo.Uint64             `bson:"remainingavailablenotional" json:"remainingAvailableNotional"`
@@ -59,6 +70,10 @@ type NotionalLimit struct {
 	ChainID           vaa.ChainID   `bson:"chainid" json:"chainId"`
 	NotionalLimit     *mongo.Uint64 `bson:"notionalLimit" json:"notionalLimit"`
 	MaxTrasactionSize *mongo.Uint64 `bson:"maxTransactionSize" json:"maxTransactionSize"`
+	// Guardians is the number of guardians that reported the values.
+	Guardians int `bson:"guardians" json:"guardians"`
+	// QuorumReached is false when fewer guardians than the quorum reported the values, then the lowest reported values are returned.
+	QuorumReached bool `bson:"quorumReached" json:"quorumReached"`
 }
 
 // NotionalLimitDetail represent a notional limit value
@@ -72,10 +87,34 @@ type NotionalLimitDetail struct {
 	UpdatedAt         *time.Time    `bson:"updatedAt" json:"updatedAt"`
 }
 
+// LimitChange represent a change of the notional limit and big transaction size of a chain
+// in the governor configuration of a guardian.
+type LimitChange struct {
+	ID                         string        `bson:"_id" json:"id"`
+	GuardianAddress            string        `bson:"guardianAddress" json:"guardianAddress"`
+	NodeName                   string        `bson:"nodeName" json:"nodeName"`
+	ChainID                    vaa.ChainID   `bson:"chainId" json:"chainId"`
+	NotionalLimit              mongo.Uint64  `bson:"notionalLimit" json:"notionalLimit"`
+	BigTransactionSize         mongo.Uint64  `bson:"bigTransactionSize" json:"bigTransactionSize"`
+	PreviousNotionalLimit      *mongo.Uint64 `bson:"previousNotionalLimit" json:"previousNotionalLimit,omitempty"`
+	PreviousBigTransactionSize *mongo.Uint64 `bson:"previousBigTransactionSize" json:"previousBigTransactionSize,omitempty"`
+	DetectedAt                 *time.Time    `bson:"detectedAt" json:"detectedAt"`
+}
+
 // NotionalAvailable represent the available notional for chainID.
 type NotionalAvailable struct {
-	ChainID           vaa.ChainID   `bson:"chainid" json:"chainId"`
-	AvailableNotional *mongo.Uint64 `bson:"availableNotional" json:"availableNotional"`
+	ChainID           vaa.ChainID             `bson:"chainid" json:"chainId"`
+	AvailableNotional *mongo.Uint64           `bson:"availableNotional" json:"availableNotional"`
+	Human             *NotionalAvailableHuman `bson:"-" json:"human,omitempty"`
+	// Guardians is the number of guardians that reported the values.
+	Guardians int `bson:"guardians" json:"guardians"`
+	// QuorumReached is false when fewer guardians than the quorum reported the values, then the lowest reported values are returned.
+	QuorumReached bool `bson:"quorumReached" json:"quorumReached"`
+}
+
+// NotionalAvailableHuman contains the human-readable representation of the available notional.
+type NotionalAvailableHuman struct {
+	AvailableNotional string `json:"availableNotional"`
 }
 
 // NotionalAvailableDetail represent a notional available value.
@@ -111,6 +150,10 @@ type MaxNotionalAvailableRecord struct {
 	Emitters          []Emitter     `bson:"emitters" json:"emitters"`
 	CreatedAt         *time.Time    `bson:"createdAt" json:"createdAt"`
 	UpdatedAt         *time.Time    `bson:"updatedAt" json:"updatedAt"`
+	// Guardians is the number of guardians that reported the chain.
+	Guardians int `bson:"-" json:"guardians"`
+	// QuorumReached is false when fewer guardians than the quorum reported the chain, then the record with the lowest value is returned.
+	QuorumReached bool `bson:"-" json:"quorumReached"`
 }
 
 // EnqueuedVaa definition.
@@ -147,12 +190,19 @@ type EnqueuedVaas struct {
 
 // EnqueuedVaaDetail definition.
 type EnqueuedVaaDetail struct {
-	ChainID        vaa.ChainID `bson:"chainid" json:"chainId"`
-	EmitterAddress string      `bson:"emitterAddress" json:"emitterAddress"`
-	Sequence       string      `bson:"sequence" json:"sequence"`
-	NotionalValue  int64       `bson:"notionalValue" json:"notionalValue"`
-	TxHash         string      `bson:"txHash" json:"txHash"`
-	ReleaseTime    int64       `bson:"releaseTime" json:"releaseTime"`
+	ChainID        vaa.ChainID             `bson:"chainid" json:"chainId"`
+	EmitterAddress string                  `bson:"emitterAddress" json:"emitterAddress"`
+	Sequence       string                  `bson:"sequence" json:"sequence"`
+	NotionalValue  int64                   `bson:"notionalValue" json:"notionalValue"`
+	TxHash         string                  `bson:"txHash" json:"txHash"`
+	ReleaseTime    int64                   `bson:"releaseTime" json:"releaseTime"`
+	Human          *EnqueuedVaaDetailHuman `bson:"-" json:"human,omitempty"`
+}
+
+// EnqueuedVaaDetailHuman contains the human-readable representation of the notional value and release time of an enqueued vaa.
+type EnqueuedVaaDetailHuman struct {
+	NotionalValue string `json:"notionalValue"`
+	ReleaseTime   string `json:"releaseTime"`
 }
 
 // MarshalJSON interface implementation.
@@ -174,10 +224,22 @@ func (v *EnqueuedVaaDetail) MarshalJSON() ([]byte, error) {
 
 // GovernorLimit definition.
 type GovernorLimit struct {
-	ChainID            vaa.ChainID  `bson:"chainId" json:"chainId"`
-	AvailableNotional  mongo.Uint64 `bson:"availableNotional" json:"availableNotional"`
-	NotionalLimit      mongo.Uint64 `bson:"notionalLimit" json:"notionalLimit"`
-	MaxTransactionSize mongo.Uint64 `bson:"maxTransactionSize" json:"maxTransactionSize"`
+	ChainID            vaa.ChainID         `bson:"chainId" json:"chainId"`
+	AvailableNotional  mongo.Uint64        `bson:"availableNotional" json:"availableNotional"`
+	NotionalLimit      mongo.Uint64        `bson:"notionalLimit" json:"notionalLimit"`
+	MaxTransactionSize mongo.Uint64        `bson:"maxTransactionSize" json:"maxTransactionSize"`
+	Human              *GovernorLimitHuman `bson:"-" json:"human,omitempty"`
+	// Guardians is the number of guardians that reported the values.
+	Guardians int `bson:"guardians" json:"guardians"`
+	// QuorumReached is false when fewer guardians than the quorum reported the values, then the lowest reported values are returned.
+	QuorumReached bool `bson:"quorumReached" json:"quorumReached"`
+}
+
+// GovernorLimitHuman contains the human-readable representation of the USD values of a governor limit.
+type GovernorLimitHuman struct {
+	AvailableNotional  string `json:"availableNotional"`
+	NotionalLimit      string `json:"notionalLimit"`
+	MaxTransactionSize string `json:"maxTransactionSize"`
 }
 
 // AvailableNotionalByChain definition.
@@ -11,6 +11,7 @@ import (
 	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	mongoTypes "github.com/wormhole-foundation/wormhole-explorer/api/internal/mongo"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
@@ -19,7 +20,9 @@ import (
 	"go.uber.org/zap"
 )
 
-const minGuardianNum = 13
+// defaultQuorum is the number of guardians required to reach quorum in a guardian set of 19 guardians,
+// it is used when the current guardian set is not available.
+const defaultQuorum = 13
 
 // Repository definition.
 type Repository struct {
@@ -29,9 +32,23 @@ type Repository struct {
 		governorConfig *mongo.Collection
 		governorStatus *mongo.Collection
 		governorVaas   *mongo.Collection
+		limitChanges   *mongo.Collection
 	}
 }
 
+// quorumIndex returns the index of the value supported by a quorum of the guardians in an array sorted
+// in descending order. When fewer guardians than the quorum reported values, it is the index of the lowest value.
+func quorumIndex(array string, quorum int) bson.M {
+	return bson.M{
+		"$min": bson.A{quorum - 1, bson.M{"$subtract": bson.A{bson.M{"$size": array}, 1}}},
+	}
+}
+
+// quorumReached returns whether at least a quorum of the guardians reported the values of an array.
+func quorumReached(array string, quorum int) bson.M {
+	return bson.M{"$gte": bson.A{bson.M{"$size": array}, quorum}}
+}
+
 // NewRepository create a new Repository.
 func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 	return &Repository{db: db,
@@ -40,10 +57,12 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 			governorConfig *mongo.Collection
 			governorStatus *mongo.Collection
 			governorVaas   *mongo.Collection
+			limitChanges   *mongo.Collection
 		}{
 			governorConfig: db.Collection("governorConfig"),
 			governorStatus: db.Collection("governorStatus"),
-			governorVaas:   db.Collection("governorVaas"),
+			governorVaas:   db.Collection(repository.GovernorVaas),
+			limitChanges:   db.Collection(repository.GovernorLimitChanges),
 		},
 	}
 }
@@ -51,13 +70,21 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 // GovernorQuery respresent a query for the governors mongodb documents.
 type GovernorQuery struct {
 	pagination.Pagination
-	id *types.Address
+	id       *types.Address
+	nodeName string
+	quorum   int
 }
 
+// keys of the guardian node name in the `governorConfig` and `governorStatus` collections.
+const (
+	governorConfigNodeNameKey = "parsedConfig.nodename"
+	governorStatusNodeNameKey = "parsedStatus.nodename"
+)
+
 // NewGovernorQuery creates a new `*GovernorQuery` with default pagination values.
 func NewGovernorQuery() *GovernorQuery {
 	p := pagination.Default()
-	return &GovernorQuery{Pagination: *p}
+	return &GovernorQuery{Pagination: *p, quorum: defaultQuorum}
 }
 
 // SetID sets the `id` field of the GovernorQuery struct.
@@ -69,13 +96,26 @@ func (q *GovernorQuery) SetID(id *types.Address) *GovernorQuery {
 	return q
 }
 
+// SetNodeName sets the guardian node name filter of the GovernorQuery struct.
+func (q *GovernorQuery) SetNodeName(nodeName string) *GovernorQuery {
+	q.nodeName = nodeName
+	return q
+}
+
+// SetQuorum sets the number of guardians required to reach quorum.
+func (q *GovernorQuery) SetQuorum(quorum int) *GovernorQuery {
+	q.quorum = quorum
+	return q
+}
+
 // SetPagination set the pagination field of the GovernorQuery struct.
 func (q *GovernorQuery) SetPagination(p *pagination.Pagination) *GovernorQuery {
 	q.Pagination = *p
 	return q
 }
 
-func (q *GovernorQuery) toBSON() *bson.D {
+// toBSON returns the filter of the query, nodeNameKey is the key of the node name in the collection.
+func (q *GovernorQuery) toBSON(nodeNameKey string) *bson.D {
 
 	r := bson.D{}
 
@@ -87,6 +127,10 @@ func (q *GovernorQuery) toBSON() *bson.D {
 		r = append(r, bson.E{Key: "_id", Value: q.id.ShortHex()})
 	}
 
+	if q.nodeName != "" {
+		r = append(r, bson.E{Key: nodeNameKey, Value: q.nodeName})
+	}
+
 	return &r
 }
 
@@ -114,7 +158,7 @@ func (r *Repository) FindGovConfigurations(
 		SetSkip(q.Skip).
 		SetSort(sort)
 
-	cur, err := r.collections.governorConfig.Find(ctx, q.toBSON(), options)
+	govConfigs, err := repository.Find[*GovConfig](ctx, r.collections.governorConfig, q.toBSON(governorConfigNodeNameKey), options)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Find command to get governor configurations",
@@ -125,18 +169,6 @@ func (r *Repository) FindGovConfigurations(
 		return nil, errors.WithStack(err)
 	}
 
-	var govConfigs []*GovConfig
-	err = cur.All(ctx, &govConfigs)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*GovConfig",
-			zap.Error(err),
-			zap.Any("q", q),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	return govConfigs, err
 }
 
@@ -163,7 +195,7 @@ func (r *Repository) FindGovernorStatus(
 		SetSkip(q.Skip).
 		SetSort(sort)
 
-	cur, err := r.collections.governorStatus.Find(ctx, q.toBSON(), options)
+	govStatus, err := repository.Find[*GovStatus](ctx, r.collections.governorStatus, q.toBSON(governorStatusNodeNameKey), options)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Find command to get all governor status",
@@ -174,19 +206,36 @@ func (r *Repository) FindGovernorStatus(
 		return nil, errors.WithStack(err)
 	}
 
-	var govStatus []*GovStatus
-	err = cur.All(ctx, &govStatus)
+	return govStatus, err
+}
+
+// FindGovernorStatusFreshness get the last update of the governor status of all the guardian nodes.
+func (r *Repository) FindGovernorStatusFreshness(ctx context.Context) ([]*GovStatusFreshness, error) {
+
+	// Sort guardians by ascending ID to guarantee deterministic output.
+	sort := bson.D{{Key: "_id", Value: 1}}
+
+	projection := bson.D{
+		{Key: "updatedAt", Value: 1},
+		{Key: "nodename", Value: "$parsedStatus.nodename"},
+	}
+
+	options := options.
+		Find().
+		SetProjection(projection).
+		SetSort(sort)
+
+	freshness, err := repository.Find[*GovStatusFreshness](ctx, r.collections.governorStatus, bson.D{}, options)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*GovStatus",
+		r.logger.Error("failed to execute Find command to get governor status freshness",
 			zap.Error(err),
-			zap.Any("q", q),
 			zap.String("requestID", requestID),
 		)
 		return nil, errors.WithStack(err)
 	}
 
-	return govStatus, err
+	return freshness, err
 }
 
 // FindOneGovernorStatus get a *GovStatus. The q parameter define the filter to apply to the query.
@@ -206,11 +255,10 @@ func (r *Repository) FindOneGovernorStatus(
 		FindOne().
 		SetProjection(projection)
 
-	var govConfig GovStatus
-	err := r.collections.governorStatus.FindOne(ctx, q.toBSON(), options).Decode(&govConfig)
+	govConfig, err := repository.FindOne[GovStatus](ctx, r.collections.governorStatus, q.toBSON(governorStatusNodeNameKey), options)
 	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, errs.ErrNotFound
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, err
 		}
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute FindOne command to get governor status",
@@ -221,7 +269,7 @@ func (r *Repository) FindOneGovernorStatus(
 		return nil, errors.WithStack(err)
 	}
 
-	return &govConfig, err
+	return govConfig, err
 }
 
 // NotionalLimitQuery
@@ -229,12 +277,13 @@ type NotionalLimitQuery struct {
 	pagination.Pagination
 	id      string
 	chainID vaa.ChainID
+	quorum  int
 }
 
 // QueryNotionalLimit create a new NotionalLimitQuery with default pagination values.
 func QueryNotionalLimit() *NotionalLimitQuery {
 	p := pagination.Default()
-	return &NotionalLimitQuery{Pagination: *p}
+	return &NotionalLimitQuery{Pagination: *p, quorum: defaultQuorum}
 }
 
 // SetID set the id field of the NotionalLimitQuery struct.
@@ -249,6 +298,12 @@ func (q *NotionalLimitQuery) SetChain(chainID vaa.ChainID) *NotionalLimitQuery {
 	return q
 }
 
+// SetQuorum set the number of guardians required to reach quorum.
+func (q *NotionalLimitQuery) SetQuorum(quorum int) *NotionalLimitQuery {
+	q.quorum = quorum
+	return q
+}
+
 // SetPagination set the Pagination field of the NotionalLimitQuery struct.
 func (q *NotionalLimitQuery) SetPagination(p *pagination.Pagination) *NotionalLimitQuery {
 	q.Pagination = *p
@@ -298,8 +353,10 @@ func (r *Repository) FindNotionalLimit(
 		{Key: "$project", Value: bson.D{
 			{Key: "chainId", Value: "$_id"},
 			{Key: "notionalLimit", Value: bson.M{
-				"$arrayElemAt": []interface{}{"$notionalLimits", minGuardianNum - 1},
+				"$arrayElemAt": []interface{}{"$notionalLimits", quorumIndex("$notionalLimits", q.quorum)},
 			}},
+			{Key: "guardians", Value: bson.M{"$size": "$notionalLimits"}},
+			{Key: "quorumReached", Value: quorumReached("$notionalLimits", q.quorum)},
 		}},
 	}
 
@@ -308,6 +365,8 @@ func (r *Repository) FindNotionalLimit(
 			{Key: "chainId", Value: 1},
 			{Key: "notionalLimit", Value: "$notionalLimit.notionalLimit"},
 			{Key: "maxTransactionSize", Value: "$notionalLimit.maxTransactionSize"},
+			{Key: "guardians", Value: 1},
+			{Key: "quorumReached", Value: 1},
 		}},
 	}
 
@@ -330,7 +389,7 @@ func (r *Repository) FindNotionalLimit(
 	}
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorConfig.Aggregate(ctx, pipeLine)
+	notionalLimits, err := repository.Aggregate[*NotionalLimit](ctx, r.collections.governorConfig, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get notional limit",
@@ -341,12 +400,68 @@ func (r *Repository) FindNotionalLimit(
 		return nil, errors.WithStack(err)
 	}
 
-	// decodes to NotionalLimit.
-	var notionalLimits []*NotionalLimit
-	err = cur.All(ctx, &notionalLimits)
+	// check records exists.
+	if len(notionalLimits) == 0 {
+		return nil, errs.ErrNotFound
+	}
+
+	return notionalLimits, nil
+}
+
+// LimitChangeQuery represent a query for the governor limit changes.
+type LimitChangeQuery struct {
+	pagination.Pagination
+	chainID *vaa.ChainID
+}
+
+// QueryLimitChange create a new LimitChangeQuery with default pagination values.
+func QueryLimitChange() *LimitChangeQuery {
+	p := pagination.Default()
+	return &LimitChangeQuery{Pagination: *p}
+}
+
+// SetChain set the chainID field of the LimitChangeQuery struct.
+func (q *LimitChangeQuery) SetChain(chainID *vaa.ChainID) *LimitChangeQuery {
+	q.chainID = chainID
+	return q
+}
+
+// SetPagination set the Pagination field of the LimitChangeQuery struct.
+func (q *LimitChangeQuery) SetPagination(p *pagination.Pagination) *LimitChangeQuery {
+	q.Pagination = *p
+	return q
+}
+
+func (q *LimitChangeQuery) toBSON() bson.D {
+	r := bson.D{}
+	if q.chainID != nil {
+		r = append(r, bson.E{Key: "chainId", Value: *q.chainID})
+	}
+	return r
+}
+
+// FindLimitChanges get the changes of the governor limits, the most recent first.
+func (r *Repository) FindLimitChanges(
+	ctx context.Context,
+	q *LimitChangeQuery,
+) ([]*LimitChange, error) {
+
+	sort := bson.D{
+		{Key: "detectedAt", Value: q.GetSortInt()},
+		{Key: "chainId", Value: 1},
+		{Key: "guardianAddress", Value: 1},
+	}
+
+	options := options.
+		Find().
+		SetLimit(q.Limit).
+		SetSkip(q.Skip).
+		SetSort(sort)
+
+	changes, err := repository.Find[*LimitChange](ctx, r.collections.limitChanges, q.toBSON(), options)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*NotionalLimit",
+		r.logger.Error("failed to execute Find command to get governor limit changes",
 			zap.Error(err),
 			zap.Any("q", q),
 			zap.String("requestID", requestID),
@@ -354,12 +469,7 @@ func (r *Repository) FindNotionalLimit(
 		return nil, errors.WithStack(err)
 	}
 
-	// check records exists.
-	if len(notionalLimits) == 0 {
-		return nil, errs.ErrNotFound
-	}
-
-	return notionalLimits, nil
+	return changes, nil
 }
 
 // GetNotionalLimitByChainID get a list *NotionalLimitDetail.
@@ -421,7 +531,7 @@ func (r *Repository) GetNotionalLimitByChainID(
 	}
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorConfig.Aggregate(ctx, pipeLine)
+	notionalLimits, err := repository.Aggregate[*NotionalLimitDetail](ctx, r.collections.governorConfig, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get notional limit by chainID",
@@ -432,19 +542,6 @@ func (r *Repository) GetNotionalLimitByChainID(
 		return nil, errors.WithStack(err)
 	}
 
-	// decode to []NotionalLimitRecord.
-	var notionalLimits []*NotionalLimitDetail
-	err = cur.All(ctx, &notionalLimits)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*NotionalLimitDetail",
-			zap.Error(err),
-			zap.Any("q", q),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	return notionalLimits, nil
 }
 
@@ -494,8 +591,10 @@ func (r *Repository) GetAvailableNotional(
 		{Key: "$project", Value: bson.D{
 			{Key: "chainId", Value: "$_id"},
 			{Key: "availableNotional", Value: bson.M{
-				"$arrayElemAt": []interface{}{"$availableNotionals", minGuardianNum - 1},
+				"$arrayElemAt": []interface{}{"$availableNotionals", quorumIndex("$availableNotionals", q.quorum)},
 			}},
+			{Key: "guardians", Value: bson.M{"$size": "$availableNotionals"}},
+			{Key: "quorumReached", Value: quorumReached("$availableNotionals", q.quorum)},
 		}},
 	}
 
@@ -504,6 +603,8 @@ func (r *Repository) GetAvailableNotional(
 		{Key: "$project", Value: bson.D{
 			{Key: "chainId", Value: 1},
 			{Key: "availableNotional", Value: "$availableNotional.availableNotional"},
+			{Key: "guardians", Value: 1},
+			{Key: "quorumReached", Value: 1},
 		}},
 	}
 
@@ -534,7 +635,7 @@ func (r *Repository) GetAvailableNotional(
 	}
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorStatus.Aggregate(ctx, pipeLine)
+	notionalAvailables, err := repository.Aggregate[*NotionalAvailable](ctx, r.collections.governorStatus, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get available notional",
@@ -545,19 +646,6 @@ func (r *Repository) GetAvailableNotional(
 		return nil, errors.WithStack(err)
 	}
 
-	// decode to []NotionalLimitRecord.
-	var notionalAvailables []*NotionalAvailable
-	err = cur.All(ctx, &notionalAvailables)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*NotionalAvailable",
-			zap.Error(err),
-			zap.Any("q", q),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	// check exists records
 	if len(notionalAvailables) == 0 {
 		return nil, errs.ErrNotFound
@@ -642,7 +730,7 @@ func (r *Repository) GetAvailableNotionalByChainID(
 	}
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorStatus.Aggregate(ctx, pipeLine)
+	notionalAvailability, err := repository.Aggregate[*NotionalAvailableDetail](ctx, r.collections.governorStatus, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get available notional by chainID",
@@ -653,19 +741,6 @@ func (r *Repository) GetAvailableNotionalByChainID(
 		return nil, errors.WithStack(err)
 	}
 
-	// decode to []NotionalLimitRecord.
-	var notionalAvailability []*NotionalAvailableDetail
-	err = cur.All(ctx, &notionalAvailability)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*NotionalAvailableDetail",
-			zap.Error(err),
-			zap.Any("q", q),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	return notionalAvailability, nil
 }
 
@@ -739,7 +814,7 @@ func (r *Repository) GetMaxNotionalAvailableByChainID(
 	}
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorStatus.Aggregate(ctx, pipeLine)
+	rows, err := repository.Aggregate[*MaxNotionalAvailableRecord](ctx, r.collections.governorStatus, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get maximun available notional by chainID",
@@ -750,29 +825,15 @@ func (r *Repository) GetMaxNotionalAvailableByChainID(
 		return nil, errors.WithStack(err)
 	}
 
-	// decode to []NotionalLimitRecord.
-	var rows []*MaxNotionalAvailableRecord
-	err = cur.All(ctx, &rows)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*MaxNotionalAvailableRecord",
-			zap.Error(err),
-			zap.Any("q", q),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	// check exists records
 	if len(rows) == 0 {
 		return nil, errs.ErrNotFound
 	}
 
-	if len(rows) < minGuardianNum {
-		return nil, errs.ErrNotFound
-	}
-
-	maxNotionalLimit := rows[minGuardianNum-1]
+	// when fewer guardians than the quorum reported the chain, the lowest value is returned as partial data.
+	maxNotionalLimit := rows[min(q.quorum, len(rows))-1]
+	maxNotionalLimit.Guardians = len(rows)
+	maxNotionalLimit.QuorumReached = len(rows) >= q.quorum
 	return maxNotionalLimit, nil
 }
 
@@ -859,18 +920,7 @@ func (r *Repository) GetEnqueueVass(ctx context.Context, q *EnqueuedVaaQuery) ([
 		groupStage5,
 	}
 
-	cur, err := r.collections.governorStatus.Aggregate(ctx, pipeLine)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to execute Aggregate command to get enqueued vaas",
-			zap.Error(err),
-			zap.Any("q", q),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
-	var rows []struct {
+	type enqueuedVaasRow struct {
 		ID       vaa.ChainID `bson:"_id"`
 		Emitters []*struct {
 			Address      string `bson:"emitterAddress"`
@@ -883,11 +933,10 @@ func (r *Repository) GetEnqueueVass(ctx context.Context, q *EnqueuedVaaQuery) ([
 		} `bson:"emitters"`
 	}
 
-	// decode query response.
-	err = cur.All(ctx, &rows)
+	rows, err := repository.Aggregate[enqueuedVaasRow](ctx, r.collections.governorStatus, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into rows",
+		r.logger.Error("failed to execute Aggregate command to get enqueued vaas",
 			zap.Error(err),
 			zap.Any("q", q),
 			zap.String("requestID", requestID),
@@ -1013,19 +1062,7 @@ func (r *Repository) GetEnqueueVassByChainID(
 		groupStage5,
 	}
 
-	cur, err := r.collections.governorStatus.Aggregate(ctx, pipeline)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to execute Aggregate command to get enqueued vaas by chainID",
-			zap.Error(err),
-			zap.Any("q", q),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
-	// decode query response.
-	var rows []*struct {
+	type enqueuedVaasByChainRow struct {
 		ID           string `bson:"_id"`
 		EnqueuedVaas []*struct {
 			EnqueuedVaas [][]*struct {
@@ -1036,10 +1073,11 @@ func (r *Repository) GetEnqueueVassByChainID(
 			} `bson:"enqueuedVaa"`
 		} `bson:"enqueuedVaas"`
 	}
-	err = cur.All(ctx, &rows)
+
+	rows, err := repository.Aggregate[*enqueuedVaasByChainRow](ctx, r.collections.governorStatus, pipeline)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into rows",
+		r.logger.Error("failed to execute Aggregate command to get enqueued vaas by chainID",
 			zap.Error(err),
 			zap.Any("q", q),
 			zap.String("requestID", requestID),
@@ -1182,14 +1220,16 @@ func (r *Repository) GetGovernorLimit(
 	projectStage9 := bson.D{
 		{Key: "$project", Value: bson.D{
 			{Key: "notionalLimit", Value: bson.M{
-				"$arrayElemAt": []interface{}{"$notionalLimits", minGuardianNum - 1},
+				"$arrayElemAt": []interface{}{"$notionalLimits", quorumIndex("$notionalLimits", q.quorum)},
 			}},
 			{Key: "maxTransactionSize", Value: bson.M{
-				"$arrayElemAt": []interface{}{"$maxTransactionSizes", minGuardianNum - 1},
+				"$arrayElemAt": []interface{}{"$maxTransactionSizes", quorumIndex("$maxTransactionSizes", q.quorum)},
 			}},
 			{Key: "availableNotional", Value: bson.M{
-				"$arrayElemAt": []interface{}{"$availableNotionals", minGuardianNum - 1},
+				"$arrayElemAt": []interface{}{"$availableNotionals", quorumIndex("$availableNotionals", q.quorum)},
 			}},
+			{Key: "guardians", Value: bson.M{"$size": "$notionalLimits"}},
+			{Key: "quorumReached", Value: quorumReached("$notionalLimits", q.quorum)},
 		}},
 	}
 
@@ -1199,6 +1239,8 @@ func (r *Repository) GetGovernorLimit(
 			{Key: "notionalLimit", Value: "$notionalLimit.notionalLimit"},
 			{Key: "maxTransactionSize", Value: "$maxTransactionSize.maxTransactionSize"},
 			{Key: "availableNotional", Value: "$availableNotional.availableNotional"},
+			{Key: "guardians", Value: 1},
+			{Key: "quorumReached", Value: 1},
 		}},
 	}
 
@@ -1236,7 +1278,7 @@ func (r *Repository) GetGovernorLimit(
 	})
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorConfig.Aggregate(ctx, pipeline)
+	governorLimits, err := repository.Aggregate[*GovernorLimit](ctx, r.collections.governorConfig, pipeline)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get governor limit",
@@ -1247,19 +1289,6 @@ func (r *Repository) GetGovernorLimit(
 		return nil, errors.WithStack(err)
 	}
 
-	// decodes to RawDocRecord.
-	governorLimits := []*GovernorLimit{}
-	err = cur.All(ctx, &governorLimits)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*GovernorLimit",
-			zap.Error(err),
-			zap.Any("q", q),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	return governorLimits, nil
 }
 
@@ -1372,7 +1401,7 @@ func (r *Repository) GetAvailNotionByChain(
 	}
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorConfig.Aggregate(ctx, pipeLine)
+	availbleNotional, err := repository.Aggregate[*AvailableNotionalByChain](ctx, r.collections.governorConfig, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get governor limit",
@@ -1382,18 +1411,6 @@ func (r *Repository) GetAvailNotionByChain(
 		return nil, errors.WithStack(err)
 	}
 
-	// decodes to GovernorLimitV2.
-	var availbleNotional []*AvailableNotionalByChain
-	err = cur.All(ctx, &availbleNotional)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*AvailableNotionalByChain",
-			zap.Error(err),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	// check exists records
 	if len(availbleNotional) == 0 {
 		return nil, errs.ErrNotFound
@@ -1499,7 +1516,7 @@ func (r *Repository) GetTokenList(ctx context.Context) ([]*TokenList, error) {
 	}
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorConfig.Aggregate(ctx, pipeLine)
+	tokens, err := repository.Aggregate[*TokenList](ctx, r.collections.governorConfig, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get token list",
@@ -1509,18 +1526,6 @@ func (r *Repository) GetTokenList(ctx context.Context) ([]*TokenList, error) {
 		return nil, errors.WithStack(err)
 	}
 
-	// decodes to RawDocRecord.
-	var tokens []*TokenList
-	err = cur.All(ctx, &tokens)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*TokenList",
-			zap.Error(err),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	// check exists records
 	if len(tokens) == 0 {
 		return nil, errs.ErrNotFound
@@ -1607,7 +1612,7 @@ func (r *Repository) GetEnqueuedVaas(ctx context.Context) ([]*EnqueuedVaaItem, e
 	}
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorStatus.Aggregate(ctx, pipeLine)
+	enqueuedVAA, err := repository.Aggregate[*EnqueuedVaaItem](ctx, r.collections.governorStatus, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get enqueuedVAA",
@@ -1617,18 +1622,6 @@ func (r *Repository) GetEnqueuedVaas(ctx context.Context) ([]*EnqueuedVaaItem, e
 		return nil, errors.WithStack(err)
 	}
 
-	// decodes to []*EnqueuedVaaItem.
-	var enqueuedVAA []*EnqueuedVaaItem
-	err = cur.All(ctx, &enqueuedVAA)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*EnqueuedVaaItem",
-			zap.Error(err),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	return enqueuedVAA, nil
 }
 
@@ -1700,7 +1693,7 @@ func (r *Repository) IsVaaEnqueued(
 	}
 
 	// execute aggregate operations.
-	cur, err := r.collections.governorStatus.Aggregate(ctx, pipeLine)
+	response, err := repository.Aggregate[*EnqueuedResponse](ctx, r.collections.governorStatus, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to execute Aggregate command to get token list",
@@ -1710,18 +1703,6 @@ func (r *Repository) IsVaaEnqueued(
 		return false, errors.WithStack(err)
 	}
 
-	// decodes to RawDocRecord.
-	var response []*EnqueuedResponse
-	err = cur.All(ctx, &response)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor into []*EnqueuedResponse",
-			zap.Error(err),
-			zap.String("requestID", requestID),
-		)
-		return false, errors.WithStack(err)
-	}
-
 	// check exists records
 	if len(response) == 0 {
 		return false, nil
@@ -1751,24 +1732,74 @@ func (r *Repository) GetGovernorVaas(ctx context.Context) ([]GovernorVaaDoc, err
 	}}},
 	}
 
-	cur, err := r.collections.governorVaas.Aggregate(ctx, pipeline)
+	result, err := repository.Aggregate[GovernorVaaDoc](ctx, r.collections.governorVaas, pipeline)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed execute aggregate command to get governor enqueded vaas",
 			zap.Error(err), zap.String("requestID", requestID))
 		return nil, errors.WithStack(err)
 	}
+	return result, nil
+}
+
+// GovernorVaaDetailDoc represents a governor vaa joined with the guardians that enqueued it.
+type GovernorVaaDetailDoc struct {
+	GovernorVaaDoc `bson:",inline"`
+	Nodes          []NodeGovernorVaaDoc `bson:"nodes"`
+}
+
+// NodeGovernorVaaDoc represents a guardian that has a vaa enqueued in its governor.
+type NodeGovernorVaaDoc struct {
+	NodeName    string `bson:"nodeName"`
+	NodeAddress string `bson:"nodeAddress"`
+}
+
+// FindGovernorVaaByID get a governor vaa by vaa ID.
+func (r *Repository) FindGovernorVaaByID(ctx context.Context, vaaID string) (*GovernorVaaDetailDoc, error) {
+
+	matchStage1 := bson.D{
+		{Key: "$match", Value: bson.D{
+			{Key: "_id", Value: vaaID},
+		}},
+	}
+
+	// left outer join on the `vaas` collection
+	lookupStage2 := bson.D{
+		{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "vaas"},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "vaas"},
+		}},
+	}
+
+	// left outer join on the `nodeGovernorVaas` collection
+	lookupStage3 := bson.D{
+		{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "nodeGovernorVaas"},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "vaaId"},
+			{Key: "as", Value: "nodes"},
+		}},
+	}
+
+	// define aggregate pipeline
+	pipeLine := mongo.Pipeline{
+		matchStage1,
+		lookupStage2,
+		lookupStage3,
+	}
 
-	// read results from cursor
-	var result []GovernorVaaDoc
-	err = cur.All(ctx, &result)
+	result, err := repository.Aggregate[GovernorVaaDetailDoc](ctx, r.collections.governorVaas, pipeLine)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed decoding cursor to []*VaaDoc",
-			zap.Error(err),
-			zap.String("requestID", requestID),
-		)
+		r.logger.Error("failed execute aggregate command to get governor vaa by id",
+			zap.Error(err), zap.String("vaaID", vaaID), zap.String("requestID", requestID))
 		return nil, errors.WithStack(err)
 	}
-	return result, nil
+
+	if len(result) == 0 {
+		return nil, errs.ErrNotFound
+	}
+	return &result[0], nil
 }
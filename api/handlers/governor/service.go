@@ -4,10 +4,13 @@ package governor
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/cacheable"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
 	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/format"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
@@ -20,9 +23,12 @@ import (
 
 type Service struct {
 	repo              *Repository
+	guardianSrv       *guardian.Service
 	cache             cache.Cache
 	metrics           metrics.Metrics
 	supportedChainIDs map[vaa.ChainID]string
+	quorum            int
+	staleThreshold    time.Duration
 	logger            *zap.Logger
 }
 
@@ -32,19 +38,36 @@ const (
 )
 
 // NewService create a new governor.Service.
-func NewService(dao *Repository, cache cache.Cache, metrics metrics.Metrics, logger *zap.Logger) *Service {
+// [quorum] overrides the number of guardians required to reach quorum, if it is zero the quorum is calculated from the current guardian set.
+// [staleThreshold] is the age after which the governor status of a guardian node is flagged as stale.
+func NewService(dao *Repository, guardianSrv *guardian.Service, cache cache.Cache, metrics metrics.Metrics, quorum int, staleThreshold time.Duration, logger *zap.Logger) *Service {
 	supportedChainIDs := domain.GetSupportedChainIDs()
-	return &Service{repo: dao, cache: cache, metrics: metrics, supportedChainIDs: supportedChainIDs, logger: logger.With(zap.String("module", "GovernorService"))}
+	return &Service{repo: dao, guardianSrv: guardianSrv, cache: cache, metrics: metrics, supportedChainIDs: supportedChainIDs, quorum: quorum, staleThreshold: staleThreshold, logger: logger.With(zap.String("module", "GovernorService"))}
+}
+
+// getQuorum returns the number of guardians required to reach quorum, 2/3+1 of the current guardian set.
+func (s *Service) getQuorum(ctx context.Context) int {
+	if s.quorum > 0 {
+		return s.quorum
+	}
+	gs, err := s.guardianSrv.GetGuardianSet(ctx)
+	if err != nil || len(gs.GstByIndex) == 0 {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		s.logger.Warn("failed to get current guardian set, using default quorum", zap.Error(err), zap.String("requestID", requestID))
+		return defaultQuorum
+	}
+	return vaa.CalculateQuorum(len(gs.GetLatest().Keys))
 }
 
 // FindGovernorConfig get a list of governor configurations.
-func (s *Service) FindGovernorConfig(ctx context.Context, p *pagination.Pagination) (*response.Response[[]*GovConfig], error) {
+// If [nodeName] is not empty only the configuration of that guardian node is returned.
+func (s *Service) FindGovernorConfig(ctx context.Context, nodeName string, p *pagination.Pagination) (*response.Response[[]*GovConfig], error) {
 	if p == nil {
 		p = pagination.Default()
 	}
-	query := NewGovernorQuery().SetPagination(p)
+	query := NewGovernorQuery().SetNodeName(nodeName).SetPagination(p)
 	govConfigs, err := s.repo.FindGovConfigurations(ctx, query)
-	res := response.Response[[]*GovConfig]{Data: govConfigs}
+	res := response.Response[[]*GovConfig]{Data: govConfigs, Pagination: nextPage(p, len(govConfigs))}
 	return &res, err
 }
 
@@ -67,16 +90,53 @@ func (s *Service) FindGovernorConfigByGuardianAddress(
 }
 
 // FindGovernorStatus get a list of governor status.
-func (s *Service) FindGovernorStatus(ctx context.Context, p *pagination.Pagination) (*response.Response[[]*GovStatus], error) {
+// If [nodeName] is not empty only the status of that guardian node is returned.
+func (s *Service) FindGovernorStatus(ctx context.Context, nodeName string, p *pagination.Pagination) (*response.Response[[]*GovStatus], error) {
 	if p == nil {
 		p = pagination.Default()
 	}
-	query := NewGovernorQuery().SetPagination(p)
+	query := NewGovernorQuery().SetNodeName(nodeName).SetPagination(p)
 	govStatus, err := s.repo.FindGovernorStatus(ctx, query)
-	res := response.Response[[]*GovStatus]{Data: govStatus}
+	res := response.Response[[]*GovStatus]{Data: govStatus, Pagination: nextPage(p, len(govStatus))}
 	return &res, err
 }
 
+// nextPage returns the pagination of a response with the number of the next page,
+// which is empty when the current page is not full.
+func nextPage(p *pagination.Pagination, n int) response.ResponsePagination {
+	if p.Limit <= 0 || int64(n) < p.Limit {
+		return response.ResponsePagination{}
+	}
+	return response.ResponsePagination{Next: strconv.FormatInt(p.Skip/p.Limit+1, 10)}
+}
+
+// FindGovernorStatusFreshness get the freshness of the governor status of all the guardian nodes.
+// If [threshold] is zero the stale threshold of the service is used.
+func (s *Service) FindGovernorStatusFreshness(ctx context.Context, threshold time.Duration) (*response.Response[[]*GovStatusFreshness], error) {
+	if threshold <= 0 {
+		threshold = s.staleThreshold
+	}
+	freshness, err := s.repo.FindGovernorStatusFreshness(ctx)
+	if err != nil {
+		return nil, err
+	}
+	markStaleGovernorStatus(freshness, threshold, time.Now())
+	return &response.Response[[]*GovStatusFreshness]{Data: freshness}, nil
+}
+
+// markStaleGovernorStatus sets the age of each governor status and flags the ones not updated within [threshold].
+func markStaleGovernorStatus(freshness []*GovStatusFreshness, threshold time.Duration, now time.Time) {
+	for _, f := range freshness {
+		if f.UpdatedAt == nil {
+			f.Stale = true
+			continue
+		}
+		age := now.Sub(*f.UpdatedAt)
+		f.Age = int64(age.Seconds())
+		f.Stale = age > threshold
+	}
+}
+
 // FindGovernorStatusByGuardianAddress get a governor status by guardianAddress.
 func (s *Service) FindGovernorStatusByGuardianAddress(
 	ctx context.Context,
@@ -99,7 +159,7 @@ func (s *Service) FindNotionalLimit(ctx context.Context, p *pagination.Paginatio
 	if p == nil {
 		p = pagination.Default()
 	}
-	query := QueryNotionalLimit().SetPagination(p)
+	query := QueryNotionalLimit().SetPagination(p).SetQuorum(s.getQuorum(ctx))
 	notionalLimit, err := s.repo.FindNotionalLimit(ctx, query)
 	res := response.Response[[]*NotionalLimit]{Data: notionalLimit}
 	return &res, err
@@ -118,7 +178,7 @@ func (s *Service) GetAvailableNotional(ctx context.Context, p *pagination.Pagina
 	if p == nil {
 		p = pagination.Default()
 	}
-	query := QueryNotionalLimit().SetPagination(p)
+	query := QueryNotionalLimit().SetPagination(p).SetQuorum(s.getQuorum(ctx))
 	notionalAvailability, err := s.repo.GetAvailableNotional(ctx, query)
 	res := response.Response[[]*NotionalAvailable]{Data: notionalAvailability}
 	return &res, err
@@ -142,7 +202,7 @@ func (s *Service) GetMaxNotionalAvailableByChainID(ctx context.Context, chainID
 	if _, ok := s.supportedChainIDs[chainID]; !ok {
 		return nil, errs.ErrNotFound
 	}
-	query := QueryNotionalLimit().SetChain(chainID)
+	query := QueryNotionalLimit().SetChain(chainID).SetQuorum(s.getQuorum(ctx))
 	maxNotionaLAvailable, err := s.repo.GetMaxNotionalAvailableByChainID(ctx, query)
 	res := response.Response[*MaxNotionalAvailableRecord]{Data: maxNotionaLAvailable}
 	return &res, err
@@ -175,12 +235,23 @@ func (s *Service) GetGovernorLimit(ctx context.Context, p *pagination.Pagination
 	if p == nil {
 		p = pagination.Default()
 	}
-	query := NewGovernorQuery().SetPagination(p)
+	query := NewGovernorQuery().SetPagination(p).SetQuorum(s.getQuorum(ctx))
 	governorLimit, err := s.repo.GetGovernorLimit(ctx, query)
 	res := response.Response[[]*GovernorLimit]{Data: governorLimit}
 	return &res, err
 }
 
+// FindLimitChanges get the changes of the governor limits, optionally filtered by chainID.
+func (s *Service) FindLimitChanges(ctx context.Context, p *pagination.Pagination, chainID *vaa.ChainID) (*response.Response[[]*LimitChange], error) {
+	if p == nil {
+		p = pagination.Default()
+	}
+	query := QueryLimitChange().SetPagination(p).SetChain(chainID)
+	changes, err := s.repo.FindLimitChanges(ctx, query)
+	res := response.Response[[]*LimitChange]{Data: changes}
+	return &res, err
+}
+
 // GetAvailNotionByChain get governor limit for each chainID.
 // Guardian api migration.
 func (s *Service) GetAvailNotionByChain(ctx context.Context) ([]*AvailableNotionalByChain, error) {
@@ -238,3 +309,54 @@ func (s *Service) GetGovernorVaas(ctx context.Context) ([]GovernorVaaDoc, error)
 	}
 	return result, nil
 }
+
+// GetGovernorVaaByID get a governor vaa by chainID, emitter address and sequence.
+func (s *Service) GetGovernorVaaByID(
+	ctx context.Context,
+	chainID vaa.ChainID,
+	emitter *types.Address,
+	seq string,
+) (*GovernorVaaDetailDoc, error) {
+	vaaID := fmt.Sprintf("%d/%s/%s", chainID, emitter.Hex(), seq)
+	return s.repo.FindGovernorVaaByID(ctx, vaaID)
+}
+
+// HumanizeGovernorLimits sets the human-readable representation of the USD values of the governor limits.
+func (s *Service) HumanizeGovernorLimits(limits []*GovernorLimit) {
+	for _, l := range limits {
+		if l == nil {
+			continue
+		}
+		l.Human = &GovernorLimitHuman{
+			AvailableNotional:  format.USD(float64(l.AvailableNotional)),
+			NotionalLimit:      format.USD(float64(l.NotionalLimit)),
+			MaxTransactionSize: format.USD(float64(l.MaxTransactionSize)),
+		}
+	}
+}
+
+// HumanizeAvailableNotional sets the human-readable representation of the available notional for each chainID.
+func (s *Service) HumanizeAvailableNotional(notionals []*NotionalAvailable) {
+	for _, n := range notionals {
+		if n == nil || n.AvailableNotional == nil {
+			continue
+		}
+		n.Human = &NotionalAvailableHuman{
+			AvailableNotional: format.USD(float64(*n.AvailableNotional)),
+		}
+	}
+}
+
+// HumanizeEnqueuedVaas sets the human-readable representation of the notional value and release time of the enqueued vaas.
+func (s *Service) HumanizeEnqueuedVaas(vaas []*EnqueuedVaaDetail) {
+	now := time.Now()
+	for _, v := range vaas {
+		if v == nil {
+			continue
+		}
+		v.Human = &EnqueuedVaaDetailHuman{
+			NotionalValue: format.USD(float64(v.NotionalValue)),
+			ReleaseTime:   format.RelativeTime(time.Unix(v.ReleaseTime, 0), now),
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package governor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/types"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+)
+
+const tokenBridgeEmitter = "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"
+
+func newTestService(mt *mtest.T) *Service {
+	return NewService(NewRepository(mt.DB, zap.NewNop()), nil, nil, metrics.NewNoOpMetrics(), 13, time.Hour, zap.NewNop())
+}
+
+func TestGetGovernorVaaByID(t *testing.T) {
+
+	m := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer m.Close()
+
+	emitter, err := types.StringToAddress(tokenBridgeEmitter, false)
+	require.NoError(t, err)
+	vaaID := "2/" + tokenBridgeEmitter + "/5"
+
+	m.Run("returns the governor vaa with the guardians that have it enqueued", func(mt *mtest.T) {
+		amount, err := primitive.ParseDecimal128("1500000")
+		require.NoError(mt, err)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "wormhole.governorVaas", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: vaaID},
+			{Key: "chainId", Value: 2},
+			{Key: "emitterAddress", Value: tokenBridgeEmitter},
+			{Key: "sequence", Value: "5"},
+			{Key: "txHash", Value: "0xabc"},
+			{Key: "releaseTime", Value: time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)},
+			{Key: "amount", Value: amount},
+			{Key: "vaas", Value: bson.A{}},
+			{Key: "nodes", Value: bson.A{
+				bson.D{{Key: "nodeName", Value: "guardian-0"}, {Key: "nodeAddress", Value: "0x58cc3ae5c097b213ce3c81979e1b9f9570746aa5"}},
+				bson.D{{Key: "nodeName", Value: "guardian-1"}, {Key: "nodeAddress", Value: "0xff6cb952589bde862c25ef4392132fb9d4a42157"}},
+			}},
+		}))
+
+		governorVaa, err := newTestService(mt).GetGovernorVaaByID(context.Background(), sdk.ChainIDEthereum, emitter, "5")
+		require.NoError(mt, err)
+		assert.Equal(mt, vaaID, governorVaa.ID)
+		assert.Equal(mt, sdk.ChainIDEthereum, governorVaa.ChainID)
+		assert.Equal(mt, "0xabc", governorVaa.TxHash)
+		assert.Equal(mt, uint64(1500000), uint64(governorVaa.Amount))
+		assert.Empty(mt, governorVaa.Vaas)
+		if assert.Len(mt, governorVaa.Nodes, 2) {
+			assert.Equal(mt, "guardian-0", governorVaa.Nodes[0].NodeName)
+			assert.Equal(mt, "guardian-1", governorVaa.Nodes[1].NodeName)
+		}
+
+		// the vaa is matched by the id built from the chain, the emitter and the sequence.
+		cmd := mt.GetStartedEvent().Command
+		assert.Equal(mt, vaaID, cmd.Lookup("pipeline", "0", "$match", "_id").StringValue())
+	})
+
+	m.Run("returns not found when the vaa is not governed", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "wormhole.governorVaas", mtest.FirstBatch))
+
+		_, err := newTestService(mt).GetGovernorVaaByID(context.Background(), sdk.ChainIDEthereum, emitter, "5")
+		assert.ErrorIs(mt, err, errs.ErrNotFound)
+	})
+
+	m.Run("returns the database errors", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "command failed"}))
+
+		_, err := newTestService(mt).GetGovernorVaaByID(context.Background(), sdk.ChainIDEthereum, emitter, "5")
+		assert.Error(mt, err)
+		assert.False(mt, errors.Is(err, errs.ErrNotFound))
+	})
+}
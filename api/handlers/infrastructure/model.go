@@ -1,5 +1,12 @@
 package infrastructure
 
+import (
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
 // MongoStatus represent a mongo server status.
 type MongoStatus struct {
 	Ok          int32             `bson:"ok"`
@@ -17,3 +24,52 @@ type MongoConnections struct {
 	Available    int32 `bson:"available"`
 	TotalCreated int32 `bson:"totalCreated"`
 }
+
+// GossipStatsDoc represents the gossip statistics of a guardian stored by the fly service.
+type GossipStatsDoc struct {
+	GuardianAddr          string     `bson:"guardianAddr" json:"guardianAddr"`
+	NodeName              string     `bson:"nodeName" json:"nodeName"`
+	P2pNodeID             string     `bson:"p2pNodeId" json:"p2pNodeId"`
+	WindowSeconds         float64    `bson:"windowSeconds" json:"windowSeconds"`
+	Observations          uint64     `bson:"observations" json:"observations"`
+	ObservationsPerSecond float64    `bson:"observationsPerSecond" json:"observationsPerSecond"`
+	Heartbeats            uint64     `bson:"heartbeats" json:"heartbeats"`
+	LastObservationAt     *time.Time `bson:"lastObservationAt" json:"lastObservationAt"`
+	LastHeartbeatAt       *time.Time `bson:"lastHeartbeatAt" json:"lastHeartbeatAt"`
+	UpdatedAt             *time.Time `bson:"updatedAt" json:"updatedAt"`
+}
+
+// CacheStats represents the statistics of the cache operations, in total and by key prefix.
+type CacheStats struct {
+	Hits     int64               `json:"hits"`
+	Misses   int64               `json:"misses"`
+	Errors   int64               `json:"errors"`
+	HitRatio float64             `json:"hitRatio"`
+	Prefixes []cache.PrefixStats `json:"prefixes"`
+}
+
+// LastVaaDoc represents the most recent vaa of a chain.
+type LastVaaDoc struct {
+	ChainID   sdk.ChainID `bson:"_id"`
+	Timestamp *time.Time  `bson:"timestamp"`
+	IndexedAt *time.Time  `bson:"indexedAt"`
+}
+
+// GuardianHeightDoc represents the newest height of a chain reported by the guardians in their heartbeats.
+type GuardianHeightDoc struct {
+	ChainID sdk.ChainID `bson:"_id"`
+	Height  int64       `bson:"height"`
+}
+
+// ChainLag represents the indexing lag of a chain.
+type ChainLag struct {
+	ChainID sdk.ChainID `json:"chainId"`
+	// LastVaaTimestamp is the timestamp of the most recent vaa of the chain.
+	LastVaaTimestamp *time.Time `json:"lastVaaTimestamp,omitempty"`
+	// LastVaaIndexedAt is the time at which the most recent vaa of the chain was indexed.
+	LastVaaIndexedAt *time.Time `json:"lastVaaIndexedAt,omitempty"`
+	// LagSeconds is the age of the most recent vaa of the chain.
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+	// GuardianHeight is the newest height of the chain reported by the guardians.
+	GuardianHeight *int64 `json:"guardianHeight,omitempty"`
+}
@@ -3,10 +3,13 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
 )
 
@@ -44,3 +47,82 @@ func (r *Repository) GetMongoStatus(ctx context.Context) (*MongoStatus, error) {
 	}
 	return &mongoStatus, nil
 }
+
+// FindGossipStats get the gossip statistics of the guardians sorted by node name.
+func (r *Repository) FindGossipStats(ctx context.Context) ([]*GossipStatsDoc, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "nodeName", Value: 1}})
+	cur, err := r.db.Collection("gossipStats").Find(ctx, bson.D{}, opts)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Find command to get gossip stats",
+			zap.Error(err), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var stats []*GossipStatsDoc
+	err = cur.All(ctx, &stats)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*GossipStatsDoc", zap.Error(err),
+			zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return stats, nil
+}
+
+// FindLastVaaByChain get the most recent vaa of each chain with vaas emitted since the given time.
+func (r *Repository) FindLastVaaByChain(ctx context.Context, since time.Time) ([]*LastVaaDoc, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "timestamp", Value: bson.D{{Key: "$gte", Value: since}}}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$emitterChain"},
+			{Key: "timestamp", Value: bson.D{{Key: "$max", Value: "$timestamp"}}},
+			{Key: "indexedAt", Value: bson.D{{Key: "$max", Value: "$indexedAt"}}},
+		}}},
+	}
+	cur, err := r.db.Collection(repository.Vaas).Aggregate(ctx, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Aggregate command to get last vaa by chain",
+			zap.Error(err), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var docs []*LastVaaDoc
+	err = cur.All(ctx, &docs)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*LastVaaDoc", zap.Error(err),
+			zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return docs, nil
+}
+
+// FindGuardianHeights get the newest height of each chain reported by the guardians in their heartbeats.
+func (r *Repository) FindGuardianHeights(ctx context.Context) ([]*GuardianHeightDoc, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$unwind", Value: "$networks"}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$networks.id"},
+			{Key: "height", Value: bson.D{{Key: "$max", Value: "$networks.height"}}},
+		}}},
+	}
+	cur, err := r.db.Collection("heartbeats").Aggregate(ctx, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Aggregate command to get guardian heights",
+			zap.Error(err), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var docs []*GuardianHeightDoc
+	err = cur.All(ctx, &docs)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*GuardianHeightDoc", zap.Error(err),
+			zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return docs, nil
+}
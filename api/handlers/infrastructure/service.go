@@ -3,18 +3,35 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/slo"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
+// lastVaaWindow is the period in which the most recent vaa of each chain is searched.
+// The chains without vaas in the period are reported without last vaa.
+const lastVaaWindow = 7 * 24 * time.Hour
+
 type Service struct {
-	repo   *Repository
-	logger *zap.Logger
+	repo         *Repository
+	cacheMetrics cache.Metrics
+	sloTracker   *slo.Tracker
+	logger       *zap.Logger
 }
 
 // NewService create a new governor.Service.
-func NewService(dao *Repository, logger *zap.Logger) *Service {
-	return &Service{repo: dao, logger: logger.With(zap.String("module", "InfrastructureService"))}
+func NewService(dao *Repository, cacheMetrics cache.Metrics, logger *zap.Logger) *Service {
+	return &Service{repo: dao, cacheMetrics: cacheMetrics, logger: logger.With(zap.String("module", "InfrastructureService"))}
+}
+
+// SetSLOTracker sets the tracker of the service level objectives of the endpoints.
+func (s *Service) SetSLOTracker(tracker *slo.Tracker) {
+	s.sloTracker = tracker
 }
 
 // CheckMongoServerStatus
@@ -36,3 +53,85 @@ func (s *Service) CheckMongoServerStatus(ctx context.Context) (bool, error) {
 	}
 	return true, nil
 }
+
+// GetGossipStats get the gossip statistics of the guardians.
+func (s *Service) GetGossipStats(ctx context.Context) ([]*GossipStatsDoc, error) {
+	return s.repo.FindGossipStats(ctx)
+}
+
+// GetCacheStats get the statistics of the cache operations since the api started.
+func (s *Service) GetCacheStats() *CacheStats {
+	stats := CacheStats{Prefixes: s.cacheMetrics.Stats()}
+	for _, p := range stats.Prefixes {
+		stats.Hits += p.Hits
+		stats.Misses += p.Misses
+		stats.Errors += p.Errors
+	}
+	if gets := stats.Hits + stats.Misses; gets > 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(gets)
+	}
+	return &stats
+}
+
+// GetSLO get the compliance with the service level objectives of each endpoint in the rolling window.
+func (s *Service) GetSLO(ctx context.Context) (*slo.Summary, error) {
+	if s.sloTracker == nil {
+		return nil, errs.ErrNotFound
+	}
+	summary, err := s.sloTracker.Summary(ctx)
+	if err != nil {
+		s.logger.Error("failed to get the slo summary", zap.Error(err))
+		return nil, errs.ErrInternalError
+	}
+	return summary, nil
+}
+
+// GetChainLag get the age of the most recent vaa and the newest height reported by the guardians of each chain.
+func (s *Service) GetChainLag(ctx context.Context) ([]*ChainLag, error) {
+	now := time.Now()
+	lastVaas, err := s.repo.FindLastVaaByChain(ctx, now.Add(-lastVaaWindow))
+	if err != nil {
+		return nil, err
+	}
+	heights, err := s.repo.FindGuardianHeights(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mergeChainLag(lastVaas, heights, now), nil
+}
+
+// mergeChainLag builds the lag of each chain sorted by chain id.
+func mergeChainLag(lastVaas []*LastVaaDoc, heights []*GuardianHeightDoc, now time.Time) []*ChainLag {
+	byChain := make(map[sdk.ChainID]*ChainLag)
+	get := func(chainID sdk.ChainID) *ChainLag {
+		lag, ok := byChain[chainID]
+		if !ok {
+			lag = &ChainLag{ChainID: chainID}
+			byChain[chainID] = lag
+		}
+		return lag
+	}
+
+	for _, v := range lastVaas {
+		lag := get(v.ChainID)
+		lag.LastVaaTimestamp = v.Timestamp
+		lag.LastVaaIndexedAt = v.IndexedAt
+		if v.Timestamp != nil {
+			seconds := int64(now.Sub(*v.Timestamp).Seconds())
+			lag.LagSeconds = &seconds
+		}
+	}
+	for _, h := range heights {
+		height := h.Height
+		get(h.ChainID).GuardianHeight = &height
+	}
+
+	lags := make([]*ChainLag, 0, len(byChain))
+	for _, lag := range byChain {
+		lags = append(lags, lag)
+	}
+	sort.Slice(lags, func(i, j int) bool {
+		return lags[i].ChainID < lags[j].ChainID
+	})
+	return lags
+}
@@ -0,0 +1,44 @@
+package infrastructure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func Test_mergeChainLag(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	solanaTimestamp := now.Add(-30 * time.Second)
+	solanaIndexedAt := now.Add(-25 * time.Second)
+	ethereumTimestamp := now.Add(-2 * time.Minute)
+
+	lags := mergeChainLag(
+		[]*LastVaaDoc{
+			{ChainID: sdk.ChainIDSolana, Timestamp: &solanaTimestamp, IndexedAt: &solanaIndexedAt},
+			{ChainID: sdk.ChainIDEthereum, Timestamp: &ethereumTimestamp},
+		},
+		[]*GuardianHeightDoc{
+			{ChainID: sdk.ChainIDEthereum, Height: 19000000},
+			{ChainID: sdk.ChainIDBSC, Height: 38000000},
+		},
+		now,
+	)
+
+	if assert.Len(t, lags, 3) {
+		assert.Equal(t, sdk.ChainIDSolana, lags[0].ChainID)
+		assert.Equal(t, int64(30), *lags[0].LagSeconds)
+		assert.Equal(t, &solanaIndexedAt, lags[0].LastVaaIndexedAt)
+		assert.Nil(t, lags[0].GuardianHeight)
+
+		assert.Equal(t, sdk.ChainIDEthereum, lags[1].ChainID)
+		assert.Equal(t, int64(120), *lags[1].LagSeconds)
+		assert.Equal(t, int64(19000000), *lags[1].GuardianHeight)
+
+		assert.Equal(t, sdk.ChainIDBSC, lags[2].ChainID)
+		assert.Nil(t, lags[2].LastVaaTimestamp)
+		assert.Nil(t, lags[2].LagSeconds)
+		assert.Equal(t, int64(38000000), *lags[2].GuardianHeight)
+	}
+}
@@ -0,0 +1,71 @@
+package labels
+
+import (
+	"strings"
+	"time"
+)
+
+// label categories.
+const (
+	CategoryContract = "contract"
+	CategoryExchange = "exchange"
+	CategoryBridge   = "bridge"
+	CategoryOther    = "other"
+)
+
+// Label is a friendly name of a known address, e.g. "Token Bridge: Ethereum" or "Binance hot wallet".
+type Label struct {
+	Address   string    `bson:"_id" json:"address"`
+	Name      string    `bson:"name" json:"name"`
+	Category  string    `bson:"category" json:"category"`
+	UpdatedAt time.Time `bson:"updatedAt" json:"updatedAt"`
+}
+
+// IsValidCategory returns true if the category is one of the supported label categories.
+func IsValidCategory(category string) bool {
+	switch category {
+	case CategoryContract, CategoryExchange, CategoryBridge, CategoryOther:
+		return true
+	}
+	return false
+}
+
+// NormalizeAddress returns the address used as key of the labels.
+//
+// Hex addresses are case insensitive, so they are stored in lower case.
+// Other encodings (e.g. base58) are case sensitive and are stored as they are.
+func NormalizeAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if strings.HasPrefix(address, "0x") || strings.HasPrefix(address, "0X") {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+// Labels are the labels indexed by normalized address.
+type Labels map[string]*Label
+
+// Get returns the label of an address or nil if the address has no label.
+func (l Labels) Get(address string) *Label {
+	if address == "" {
+		return nil
+	}
+	return l[NormalizeAddress(address)]
+}
+
+// Collect returns the labels of the given addresses indexed by the addresses as they were given.
+// It returns nil if none of the addresses has a label.
+func (l Labels) Collect(addresses ...string) map[string]*Label {
+	var result map[string]*Label
+	for _, address := range addresses {
+		label := l.Get(address)
+		if label == nil {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]*Label)
+		}
+		result[address] = label
+	}
+	return result
+}
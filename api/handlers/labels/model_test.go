@@ -0,0 +1,30 @@
+package labels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	assert.Equal(t, "0x3ee18b2214aff97000d974cf647e7c347e8fa585", NormalizeAddress(" 0x3EE18B2214AFF97000D974CF647E7C347E8FA585 "))
+	assert.Equal(t, "wormDTUJ6AWPNvk59vGQbDvGJmqbDTdgWgAqcLBCgUb", NormalizeAddress("wormDTUJ6AWPNvk59vGQbDvGJmqbDTdgWgAqcLBCgUb"))
+}
+
+func TestLabels_Collect(t *testing.T) {
+	tokenBridge := &Label{Address: "0x3ee18b2214aff97000d974cf647e7c347e8fa585", Name: "Token Bridge: Ethereum", Category: CategoryContract}
+	labels := Labels{tokenBridge.Address: tokenBridge}
+
+	assert.Equal(t, tokenBridge, labels.Get("0x3EE18B2214AFF97000D974CF647E7C347E8FA585"))
+	assert.Nil(t, labels.Get(""))
+
+	result := labels.Collect("0x3EE18B2214AFF97000D974CF647E7C347E8FA585", "0x28c6c06298d514db089934071355e5743bf21d60", "")
+	assert.Equal(t, map[string]*Label{"0x3EE18B2214AFF97000D974CF647E7C347E8FA585": tokenBridge}, result)
+
+	assert.Nil(t, labels.Collect("0x28c6c06298d514db089934071355e5743bf21d60"))
+}
+
+func TestIsValidCategory(t *testing.T) {
+	assert.True(t, IsValidCategory(CategoryExchange))
+	assert.False(t, IsValidCategory("wallet"))
+}
@@ -0,0 +1,77 @@
+package labels
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// AddressLabelsCollection contains the labels of the known addresses.
+const AddressLabelsCollection = "addressLabels"
+
+// Repository definition.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		addressLabels *mongo.Collection
+	}
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db,
+		logger: logger.With(zap.String("module", "LabelsRepository")),
+		collections: struct {
+			addressLabels *mongo.Collection
+		}{
+			addressLabels: db.Collection(AddressLabelsCollection),
+		},
+	}
+}
+
+// FindAll get all the address labels sorted by address.
+func (r *Repository) FindAll(ctx context.Context) ([]*Label, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cur, err := r.collections.addressLabels.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		r.logger.Error("failed execute find command to get address labels", zap.Error(err))
+		return nil, errors.WithStack(err)
+	}
+
+	labels := []*Label{}
+	if err := cur.All(ctx, &labels); err != nil {
+		r.logger.Error("failed decoding cursor to []*Label", zap.Error(err))
+		return nil, errors.WithStack(err)
+	}
+	return labels, nil
+}
+
+// Upsert creates or replaces the label of an address.
+func (r *Repository) Upsert(ctx context.Context, label *Label) error {
+	opts := options.Replace().SetUpsert(true)
+	_, err := r.collections.addressLabels.ReplaceOne(ctx, bson.M{"_id": label.Address}, label, opts)
+	if err != nil {
+		r.logger.Error("failed to upsert address label", zap.String("address", label.Address), zap.Error(err))
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// Delete removes the label of an address.
+func (r *Repository) Delete(ctx context.Context, address string) error {
+	res, err := r.collections.addressLabels.DeleteOne(ctx, bson.M{"_id": address})
+	if err != nil {
+		r.logger.Error("failed to delete address label", zap.String("address", address), zap.Error(err))
+		return errors.WithStack(err)
+	}
+	if res.DeletedCount == 0 {
+		return errs.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package labels
+
+import (
+	"context"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/cacheable"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"go.uber.org/zap"
+)
+
+const addressLabelsKey = "wormscan:address-labels"
+
+type Service struct {
+	repo    *Repository
+	cache   cache.Cache
+	metrics metrics.Metrics
+	logger  *zap.Logger
+}
+
+// NewService create a new Service.
+func NewService(repo *Repository, cache cache.Cache, metrics metrics.Metrics, logger *zap.Logger) *Service {
+	return &Service{
+		repo:    repo,
+		cache:   cache,
+		metrics: metrics,
+		logger:  logger.With(zap.String("module", "LabelsService")),
+	}
+}
+
+// FindAll get all the address labels sorted by address.
+func (s *Service) FindAll(ctx context.Context) ([]*Label, error) {
+	return s.getLabels(ctx)
+}
+
+// GetLabels returns the address labels indexed by address to label the responses.
+func (s *Service) GetLabels(ctx context.Context) (Labels, error) {
+	labels, err := s.getLabels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(Labels, len(labels))
+	for _, l := range labels {
+		result[l.Address] = l
+	}
+	return result, nil
+}
+
+// Upsert creates or replaces the label of an address.
+func (s *Service) Upsert(ctx context.Context, address, name, category string) (*Label, error) {
+	label := &Label{
+		Address:   NormalizeAddress(address),
+		Name:      name,
+		Category:  category,
+		UpdatedAt: time.Now().UTC(),
+	}
+	if err := s.repo.Upsert(ctx, label); err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx)
+	return label, nil
+}
+
+// Delete removes the label of an address.
+func (s *Service) Delete(ctx context.Context, address string) error {
+	if err := s.repo.Delete(ctx, NormalizeAddress(address)); err != nil {
+		return err
+	}
+	s.invalidate(ctx)
+	return nil
+}
+
+func (s *Service) getLabels(ctx context.Context) ([]*Label, error) {
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, 5*time.Minute, addressLabelsKey, s.metrics,
+		func() ([]*Label, error) {
+			return s.repo.FindAll(ctx)
+		})
+}
+
+// invalidate removes the cached labels, so the changes are visible in the next request.
+func (s *Service) invalidate(ctx context.Context) {
+	if err := s.cache.Delete(ctx, addressLabelsKey); err != nil {
+		s.logger.Warn("failed to invalidate address labels cache", zap.Error(err))
+	}
+}
@@ -46,4 +46,11 @@ func (o *ObservationDoc) MarshalJSON() ([]byte, error) {
 type FindAllParams struct {
 	Pagination *pagination.Pagination
 	TxHash     *types.TxHash
+	TimeRange  *TimeRange
+}
+
+// TimeRange filters the observations by indexedAt. Nil bounds are open.
+type TimeRange struct {
+	From *time.Time
+	To   *time.Time
 }
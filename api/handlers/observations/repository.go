@@ -4,10 +4,12 @@ package observations
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
@@ -29,7 +31,7 @@ type Repository struct {
 func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 	return &Repository{db: db,
 		logger:      logger.With(zap.String("module", "ObservationsRepository")),
-		collections: struct{ observations *mongo.Collection }{observations: db.Collection("observations")},
+		collections: struct{ observations *mongo.Collection }{observations: db.Collection(repository.Observations)},
 	}
 }
 
@@ -37,49 +39,34 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 // The input parameter [q *ObservationQuery] define the filters to apply in the query.
 func (r *Repository) Find(ctx context.Context, q *ObservationQuery) ([]*ObservationDoc, error) {
 
-	// Sort observations in descending timestamp order
-	sort := bson.D{{"indexedAt", -1}}
+	// Sort observations in descending timestamp order, and by id for the cursors of the pages
+	sort := bson.D{{"indexedAt", -1}, {"_id", -1}}
 
-	cur, err := r.collections.observations.Find(ctx, q.toBSON(), options.Find().SetLimit(q.Limit).SetSkip(q.Skip).SetSort(sort))
+	obs, err := repository.Find[*ObservationDoc](ctx, r.collections.observations, q.toBSON(),
+		options.Find().SetLimit(q.Limit).SetSkip(q.Skip).SetSort(sort))
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed execute Find command to get observations",
+		r.logger.Error("failed to get observations",
 			zap.Error(err), zap.Any("q", q), zap.String("requestID", requestID))
 		return nil, errors.WithStack(err)
 	}
-
-	var obs []*ObservationDoc
-	err = cur.All(ctx, &obs)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed decoding cursor to []*ObservationDoc", zap.Error(err), zap.Any("q", q),
-			zap.String("requestID", requestID))
-		return nil, errors.WithStack(err)
-	}
-
-	// If no results were found, return an empty slice instead of nil.
-	if obs == nil {
-		obs = make([]*ObservationDoc, 0)
-	}
-
-	return obs, err
+	return obs, nil
 }
 
 // Find get ObservationDoc pointer.
 // The input parameter [q *ObservationQuery] define the filters to apply in the query.
 func (r *Repository) FindOne(ctx context.Context, q *ObservationQuery) (*ObservationDoc, error) {
-	var obs ObservationDoc
-	err := r.collections.observations.FindOne(ctx, q.toBSON()).Decode(&obs)
+	obs, err := repository.FindOne[ObservationDoc](ctx, r.collections.observations, q.toBSON())
 	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, errs.ErrNotFound
+		if errors.Is(err, errs.ErrNotFound) {
+			return nil, err
 		}
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed execute FindOne command to get observations",
 			zap.Error(err), zap.Any("q", q), zap.String("requestID", requestID))
 		return nil, errors.WithStack(err)
 	}
-	return &obs, err
+	return obs, nil
 }
 
 // ObservationQuery respresent a query for the observation mongodb document.
@@ -91,6 +78,8 @@ type ObservationQuery struct {
 	guardianAddr string
 	hash         []byte
 	txHash       *types.TxHash
+	from         *time.Time
+	to           *time.Time
 	uint64
 }
 
@@ -136,6 +125,13 @@ func (q *ObservationQuery) SetTxHash(txHash *types.TxHash) *ObservationQuery {
 	return q
 }
 
+// SetTimeRange set the from and to fields of the ObservationQuery struct.
+func (q *ObservationQuery) SetTimeRange(from, to *time.Time) *ObservationQuery {
+	q.from = from
+	q.to = to
+	return q
+}
+
 // SetPagination set the pagination field of the ObservationQuery struct.
 func (q *ObservationQuery) SetPagination(p *pagination.Pagination) *ObservationQuery {
 	q.Pagination = *p
@@ -163,6 +159,19 @@ func (q *ObservationQuery) toBSON() *bson.D {
 		nativeTxHash := q.txHash.String()
 		r = append(r, bson.E{"nativeTxHash", nativeTxHash})
 	}
+	if q.from != nil || q.to != nil {
+		indexedAt := bson.D{}
+		if q.from != nil {
+			indexedAt = append(indexedAt, bson.E{"$gte", q.from})
+		}
+		if q.to != nil {
+			indexedAt = append(indexedAt, bson.E{"$lte", q.to})
+		}
+		r = append(r, bson.E{"indexedAt", indexedAt})
+	}
+	if after := q.AfterFilter("indexedAt", -1); after != nil {
+		r = append(r, after...)
+	}
 
 	return &r
 }
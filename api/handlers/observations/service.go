@@ -3,7 +3,9 @@ package observations
 
 import (
 	"context"
+	"time"
 
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -13,22 +15,69 @@ import (
 // Service definition.
 type Service struct {
 	repo   *Repository
+	limits Limits
 	logger *zap.Logger
 }
 
+// Limits defines the guards applied to the observations listings.
+// A zero value disables the corresponding guard.
+type Limits struct {
+	// MaxPageSize is the maximum page size of the FindAll and FindByChain listings.
+	MaxPageSize int64
+	// MaxPageSizeByEmitter is the maximum page size of the FindByEmitter and FindByVAA listings.
+	MaxPageSizeByEmitter int64
+	// MaxTimeRange is the maximum window between the from and to bounds of a listing.
+	MaxTimeRange time.Duration
+	// MaxScan is the maximum number of observations a listing can skip and return.
+	MaxScan int64
+}
+
 // NewService create a new Service.
-func NewService(dao *Repository, logger *zap.Logger) *Service {
-	return &Service{repo: dao, logger: logger.With(zap.String("module", "ObservationsService"))}
+func NewService(dao *Repository, limits Limits, logger *zap.Logger) *Service {
+	return &Service{
+		repo:   dao,
+		limits: limits,
+		logger: logger.With(zap.String("module", "ObservationsService")),
+	}
 }
 
 // FindAll get all the observations.
 func (s *Service) FindAll(ctx context.Context, p *FindAllParams) ([]*ObservationDoc, error) {
-	return s.repo.Find(ctx, Query().SetPagination(p.Pagination).SetTxHash(p.TxHash))
+	if err := s.checkPagination(p.Pagination, s.limits.MaxPageSize); err != nil {
+		return nil, err
+	}
+
+	query := Query().SetPagination(p.Pagination).SetTxHash(p.TxHash)
+
+	// a tx hash lookup is bounded by the index, so the window is only enforced on the full listing.
+	if p.TxHash == nil {
+		from, to, err := s.timeRange(p.TimeRange)
+		if err != nil {
+			return nil, err
+		}
+		query.SetTimeRange(from, to)
+	}
+
+	return s.repo.Find(ctx, query)
 }
 
 // FindByChain get all the observations by chainID.
-func (s *Service) FindByChain(ctx context.Context, chain vaa.ChainID, p *pagination.Pagination) ([]*ObservationDoc, error) {
-	query := Query().SetChain(chain).SetPagination(p)
+func (s *Service) FindByChain(
+	ctx context.Context,
+	chain vaa.ChainID,
+	p *pagination.Pagination,
+	r *TimeRange,
+) ([]*ObservationDoc, error) {
+
+	if err := s.checkPagination(p, s.limits.MaxPageSize); err != nil {
+		return nil, err
+	}
+	from, to, err := s.timeRange(r)
+	if err != nil {
+		return nil, err
+	}
+
+	query := Query().SetChain(chain).SetPagination(p).SetTimeRange(from, to)
 	return s.repo.Find(ctx, query)
 }
 
@@ -38,12 +87,22 @@ func (s *Service) FindByEmitter(
 	chain vaa.ChainID,
 	emitter *types.Address,
 	p *pagination.Pagination,
+	r *TimeRange,
 ) ([]*ObservationDoc, error) {
 
+	if err := s.checkPagination(p, s.limits.MaxPageSizeByEmitter); err != nil {
+		return nil, err
+	}
+	from, to, err := s.timeRange(r)
+	if err != nil {
+		return nil, err
+	}
+
 	query := Query().
 		SetChain(chain).
 		SetEmitter(emitter.Hex()).
-		SetPagination(p)
+		SetPagination(p).
+		SetTimeRange(from, to)
 
 	return s.repo.Find(ctx, query)
 }
@@ -57,6 +116,10 @@ func (s *Service) FindByVAA(
 	p *pagination.Pagination,
 ) ([]*ObservationDoc, error) {
 
+	if err := s.checkPagination(p, s.limits.MaxPageSizeByEmitter); err != nil {
+		return nil, err
+	}
+
 	query := Query().
 		SetChain(chain).
 		SetEmitter(emitter.Hex()).
@@ -85,3 +148,49 @@ func (s *Service) FindOne(
 
 	return s.repo.FindOne(ctx, query)
 }
+
+// checkPagination rejects the pages bigger than maxPageSize and the queries that
+// would scan more observations than the configured limit.
+func (s *Service) checkPagination(p *pagination.Pagination, maxPageSize int64) error {
+	if maxPageSize > 0 && p.Limit > maxPageSize {
+		return errs.NewInvalidParam("pageSize cannot be greater than %d", maxPageSize)
+	}
+	if s.limits.MaxScan > 0 && p.Skip+p.Limit > s.limits.MaxScan {
+		return errs.NewQueryTooLarge("the query would scan more than %d observations, narrow the time range instead of paging", s.limits.MaxScan)
+	}
+	return nil
+}
+
+// timeRange returns the from/to bounds of a listing.
+// When a maximum window is configured, the missing bounds are filled so the window is always
+// enforced: to defaults to now and from defaults to to minus the maximum window.
+func (s *Service) timeRange(r *TimeRange) (*time.Time, *time.Time, error) {
+	var from, to *time.Time
+	if r != nil {
+		from, to = r.From, r.To
+	}
+	if from != nil && to != nil && from.After(to) {
+		return nil, nil, errs.NewInvalidParam("from cannot be after to")
+	}
+	if s.limits.MaxTimeRange <= 0 {
+		return from, to, nil
+	}
+
+	if to == nil {
+		now := time.Now()
+		if from != nil && from.Add(s.limits.MaxTimeRange).Before(now) {
+			end := from.Add(s.limits.MaxTimeRange)
+			to = &end
+		} else {
+			to = &now
+		}
+	}
+	if from == nil {
+		start := to.Add(-s.limits.MaxTimeRange)
+		from = &start
+	}
+	if to.Sub(*from) > s.limits.MaxTimeRange {
+		return nil, nil, errs.NewQueryTooLarge("the time range cannot be greater than %s", s.limits.MaxTimeRange)
+	}
+	return from, to, nil
+}
@@ -3,6 +3,7 @@ package operations
 import (
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -18,6 +19,7 @@ type OperationDto struct {
 	DestinationTx          *DestinationTx          `bson:"destinationTx" json:"destinationTx"`
 	Payload                map[string]any          `bson:"payload"`
 	StandardizedProperties *StandardizedProperties `bson:"standardizedProperties"`
+	Protocol               string                  `bson:"protocol"`
 }
 
 // StandardizedProperties represents the standardized properties of a operation.
@@ -37,19 +39,19 @@ type StandardizedProperties struct {
 
 // VaaDto vaa data transfer object.
 type VaaDto struct {
-	ID                string      `bson:"_id" json:"id"`
-	Version           uint8       `bson:"version" json:"version"`
-	EmitterChain      sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
-	EmitterAddr       string      `bson:"emitterAddr" json:"emitterAddr"`
-	EmitterNativeAddr string      `json:"emitterNativeAddr,omitempty"`
-	Sequence          string      `bson:"sequence" json:"-"`
-	GuardianSetIndex  uint32      `bson:"guardianSetIndex" json:"guardianSetIndex"`
-	Vaa               []byte      `bson:"vaas" json:"vaa"`
-	Timestamp         *time.Time  `bson:"timestamp" json:"timestamp"`
-	UpdatedAt         *time.Time  `bson:"updatedAt" json:"updatedAt"`
-	IndexedAt         *time.Time  `bson:"indexedAt" json:"indexedAt"`
-	Hash              []byte      `bson:"hash" json:"hash"`
-	IsDuplicated      bool        `bson:"isDuplicated" json:"isDuplicated"`
+	ID                string                     `bson:"_id" json:"id"`
+	Version           uint8                      `bson:"version" json:"version"`
+	EmitterChain      sdk.ChainID                `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddr       string                     `bson:"emitterAddr" json:"emitterAddr"`
+	EmitterNativeAddr string                     `json:"emitterNativeAddr,omitempty"`
+	Sequence          string                     `bson:"sequence" json:"-"`
+	GuardianSetIndex  uint32                     `bson:"guardianSetIndex" json:"guardianSetIndex"`
+	Vaa               repository.CompressedBytes `bson:"vaas" json:"vaa"`
+	Timestamp         *time.Time                 `bson:"timestamp" json:"timestamp"`
+	UpdatedAt         *time.Time                 `bson:"updatedAt" json:"updatedAt"`
+	IndexedAt         *time.Time                 `bson:"indexedAt" json:"indexedAt"`
+	Hash              []byte                     `bson:"hash" json:"hash"`
+	IsDuplicated      bool                       `bson:"isDuplicated" json:"isDuplicated"`
 }
 
 // GlobalTransactionDoc definitions.
@@ -61,12 +63,14 @@ type GlobalTransactionDoc struct {
 
 // OriginTx represents a origin transaction.
 type OriginTx struct {
-	TxHash    string        `bson:"nativeTxHash" json:"txHash"`
-	From      string        `bson:"from" json:"from"`
-	Status    string        `bson:"status" json:"status"`
-	Timestamp *time.Time    `bson:"timestamp" json:"timestamp"`
-	Attribute *AttributeDoc `bson:"attribute" json:"attribute"`
-	Fee       *FeeDoc       `bson:"feeDetail" json:"feeDetail"`
+	TxHash      string        `bson:"nativeTxHash" json:"txHash"`
+	From        string        `bson:"from" json:"from"`
+	Status      string        `bson:"status" json:"status"`
+	Timestamp   *time.Time    `bson:"timestamp" json:"timestamp"`
+	BlockNumber uint64        `bson:"blockNumber" json:"blockNumber"`
+	Finality    string        `bson:"finality" json:"finality"`
+	Attribute   *AttributeDoc `bson:"attribute" json:"attribute"`
+	Fee         *FeeDoc       `bson:"feeDetail" json:"feeDetail"`
 }
 
 // AttributeDoc represents a custom attribute for a origin transaction.
@@ -95,3 +99,18 @@ type DestinationTx struct {
 	Fee         *FeeDoc     `bson:"feeDetail" json:"feeDetail"`
 	UpdatedAt   *time.Time  `bson:"updatedAt" json:"updatedAt"`
 }
+
+// PendingRedeemsDto is a group of vaas without a detected redeem, by target chain and app.
+type PendingRedeemsDto struct {
+	TargetChain     sdk.ChainID        `bson:"targetChain"`
+	AppID           string             `bson:"appId"`
+	Count           int                `bson:"count"`
+	OldestTimestamp *time.Time         `bson:"oldestTimestamp"`
+	Vaas            []PendingRedeemVaa `bson:"vaas"`
+}
+
+// PendingRedeemVaa is a vaa without a detected redeem.
+type PendingRedeemVaa struct {
+	ID        string     `bson:"_id"`
+	Timestamp *time.Time `bson:"timestamp"`
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -68,6 +70,7 @@ func (r *Repository) FindById(ctx context.Context, id string) (*OperationDto, er
 		{Key: "payload", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$parsedVaa.parsedPayload", 0}}}},
 		{Key: "vaa", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$vaas", 0}}}},
 		{Key: "standardizedProperties", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$parsedVaa.standardizedProperties", 0}}}},
+		{Key: "protocol", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$parsedVaa.protocol", 0}}}},
 		{Key: "symbol", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.symbol", 0}}}},
 		{Key: "usdAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.usdAmount", 0}}}},
 		{Key: "tokenAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.tokenAmount", 0}}}},
@@ -117,6 +120,9 @@ type OperationQuery struct {
 	AppIDs         []string
 	ExclusiveAppId bool
 	PayloadType    []int
+	Protocols      []string
+	// ExcludedEmitters are the emitters whose operations are excluded from the results.
+	ExcludedEmitters []*repository.DeniedEmitter
 }
 
 func buildQueryOperationsByChain(sourceChainIDs, targetChainIDs []vaa.ChainID) bson.D {
@@ -254,6 +260,11 @@ func BuildPipelineSearchFromParsedVaa(query OperationQuery) mongo.Pipeline {
 
 	var pipeline mongo.Pipeline
 
+	// exclude the operations of the denied emitters
+	if match := repository.MatchNotDenied(query.ExcludedEmitters); match != nil {
+		pipeline = append(pipeline, match)
+	}
+
 	if len(query.PayloadType) > 0 {
 		payloadTypeFilter := bson.D{{Key: "$match", Value: bson.M{"parsedPayload.payloadType": bson.M{"$in": query.PayloadType}}}}
 		pipeline = append(pipeline, payloadTypeFilter)
@@ -269,6 +280,11 @@ func BuildPipelineSearchFromParsedVaa(query OperationQuery) mongo.Pipeline {
 		pipeline = append(pipeline, matchByAppId)
 	}
 
+	if len(query.Protocols) > 0 {
+		matchByProtocol := bson.D{{Key: "$match", Value: bson.M{"protocol": bson.M{"$in": query.Protocols}}}}
+		pipeline = append(pipeline, matchByProtocol)
+	}
+
 	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{
 		bson.E{Key: "timestamp", Value: query.Pagination.GetSortInt()},
 		bson.E{Key: "_id", Value: -1},
@@ -325,6 +341,11 @@ func (r *Repository) FindAll(ctx context.Context, query OperationQuery) ([]*Oper
 		pipeline = append(pipeline, matchByTxHash)
 	}
 
+	// exclude the operations of the denied emitters
+	if match := repository.MatchNotDenied(query.ExcludedEmitters); match != nil {
+		pipeline = append(pipeline, match)
+	}
+
 	// sort
 	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{
 		bson.E{Key: "originTx.timestamp", Value: query.Pagination.GetSortInt()},
@@ -354,6 +375,7 @@ func (r *Repository) FindAll(ctx context.Context, query OperationQuery) ([]*Oper
 		{Key: "payload", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$parsedVaa.parsedPayload", 0}}}},
 		{Key: "vaa", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$vaas", 0}}}},
 		{Key: "standardizedProperties", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$parsedVaa.standardizedProperties", 0}}}},
+		{Key: "protocol", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$parsedVaa.protocol", 0}}}},
 		{Key: "symbol", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.symbol", 0}}}},
 		{Key: "usdAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.usdAmount", 0}}}},
 		{Key: "tokenAmount", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{"$transferPrices.tokenAmount", 0}}}},
@@ -379,3 +401,76 @@ func (r *Repository) FindAll(ctx context.Context, query OperationQuery) ([]*Oper
 
 	return operations, nil
 }
+
+// PendingRedeemsQuery contains the filters to find the vaas without a detected redeem.
+type PendingRedeemsQuery struct {
+	// From and To define the range of the vaa timestamps.
+	From           time.Time
+	To             time.Time
+	TargetChainIDs []vaa.ChainID
+	AppIDs         []string
+	// VaasPerGroup is the max number of vaas listed in each group.
+	VaasPerGroup int
+}
+
+// FindPendingRedeems returns the transfers signed in the range of the query that have no destination tx,
+// grouped by target chain and app. A vaa with several apps is counted in the group of each app.
+func (r *Repository) FindPendingRedeems(ctx context.Context, query PendingRedeemsQuery) ([]*PendingRedeemsDto, error) {
+
+	match := bson.D{
+		{Key: "timestamp", Value: bson.M{"$gte": query.From, "$lt": query.To}},
+		{Key: "rawStandardizedProperties.toChain", Value: bson.M{"$nin": bson.A{nil, vaa.ChainIDUnset}}},
+	}
+	if len(query.TargetChainIDs) > 0 {
+		match = append(match, bson.E{Key: "rawStandardizedProperties.toChain", Value: bson.M{"$in": query.TargetChainIDs}})
+	}
+	if len(query.AppIDs) > 0 {
+		match = append(match, bson.E{Key: "rawStandardizedProperties.appIds", Value: bson.M{"$in": query.AppIDs}})
+	}
+
+	var pipeline mongo.Pipeline
+	pipeline = append(pipeline, bson.D{{Key: "$match", Value: match}})
+
+	// lookup globalTransactions and keep the vaas without destination tx
+	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.D{{Key: "from", Value: "globalTransactions"}, {Key: "localField", Value: "_id"}, {Key: "foreignField", Value: "_id"}, {Key: "as", Value: "globalTransactions"}}}})
+	pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "globalTransactions.destinationTx", Value: bson.M{"$exists": false}}}}})
+
+	// group by target chain and app, listing the oldest vaas first
+	pipeline = append(pipeline, bson.D{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$rawStandardizedProperties.appIds"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}})
+	if len(query.AppIDs) > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "rawStandardizedProperties.appIds", Value: bson.M{"$in": query.AppIDs}}}}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{{Key: "timestamp", Value: 1}}}})
+	pipeline = append(pipeline, bson.D{{Key: "$group", Value: bson.D{
+		{Key: "_id", Value: bson.D{{Key: "targetChain", Value: "$rawStandardizedProperties.toChain"}, {Key: "appId", Value: "$rawStandardizedProperties.appIds"}}},
+		{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		{Key: "oldestTimestamp", Value: bson.D{{Key: "$min", Value: "$timestamp"}}},
+		{Key: "vaas", Value: bson.D{{Key: "$push", Value: bson.D{{Key: "_id", Value: "$_id"}, {Key: "timestamp", Value: "$timestamp"}}}}},
+	}}})
+	pipeline = append(pipeline, bson.D{{Key: "$project", Value: bson.D{
+		{Key: "_id", Value: 0},
+		{Key: "targetChain", Value: "$_id.targetChain"},
+		{Key: "appId", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$_id.appId", ""}}}},
+		{Key: "count", Value: 1},
+		{Key: "oldestTimestamp", Value: 1},
+		{Key: "vaas", Value: bson.D{{Key: "$slice", Value: bson.A{"$vaas", query.VaasPerGroup}}}},
+	}}})
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "targetChain", Value: 1}, {Key: "appId", Value: 1}}}})
+
+	// Execute the aggregation pipeline
+	cur, err := r.collections.parsedVaa.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("failed execute aggregation pipeline", zap.Error(err))
+		return nil, err
+	}
+
+	// Read results from cursor
+	var pending []*PendingRedeemsDto
+	err = cur.All(ctx, &pending)
+	if err != nil {
+		r.logger.Error("failed to decode cursor", zap.Error(err))
+		return nil, err
+	}
+
+	return pending, nil
+}
@@ -241,6 +241,23 @@ func TestPipeline_FindByChainAndAppId(t *testing.T) {
 				unSetStage,
 			},
 		},
+		{
+			name: "Search by protocol",
+			query: operations.OperationQuery{
+				Protocols: []string{"MAYAN_SWIFT", "SWAP_LAYER"},
+			},
+			expected: mongo.Pipeline{
+				bson.D{{"$match", bson.M{"protocol": bson.M{"$in": []string{"MAYAN_SWIFT", "SWAP_LAYER"}}}}},
+				sortStage,
+				skipStage,
+				limitStage,
+				lookupVaasStage,
+				lookupTransferPricesStage,
+				lookupGlobalTransactionsStage,
+				addFieldsStage,
+				unSetStage,
+			},
+		},
 	}
 
 	for _, testCase := range cases {
@@ -3,21 +3,41 @@ package operations
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/denylist"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
+// pendingRedeemsMaxAge is the age of the oldest vaas checked for a pending redeem.
+// Older vaas are not expected to be redeemed anymore.
+const pendingRedeemsMaxAge = 30 * 24 * time.Hour
+
+// pendingRedeemsMetricAge is the min age of the vaas without a detected redeem counted in the metric.
+const pendingRedeemsMetricAge = 24 * time.Hour
+
+// pendingRedeemsVaasPerGroup is the max number of vaas listed in each group of pending redeems.
+const pendingRedeemsVaasPerGroup = 50
+
 type Service struct {
-	repo   *Repository
-	logger *zap.Logger
+	repo     *Repository
+	metrics  metrics.Metrics
+	denyList *denylist.Service
+	logger   *zap.Logger
 }
 
 // NewService create a new Service.
-func NewService(repo *Repository, logger *zap.Logger) *Service {
-	return &Service{repo: repo, logger: logger.With(zap.String("module", "OperationService"))}
+func NewService(repo *Repository, metrics metrics.Metrics, logger *zap.Logger) *Service {
+	return &Service{repo: repo, metrics: metrics, logger: logger.With(zap.String("module", "OperationService"))}
+}
+
+// SetEmitterDenyList excludes the operations of the denied emitters from the operation listings.
+func (s *Service) SetEmitterDenyList(denyList *denylist.Service) {
+	s.denyList = denyList
 }
 
 // FindById returns the operations for the given chainID/emitter/seq.
@@ -40,6 +60,7 @@ type OperationFilter struct {
 	ExclusiveAppId bool
 	Pagination     pagination.Pagination
 	PayloadType    []int
+	Protocols      []string
 }
 
 // FindAll returns all operations filtered by q.
@@ -58,9 +79,13 @@ func (s *Service) FindAll(ctx context.Context, filter OperationFilter) ([]*Opera
 		AppIDs:         filter.AppIDs,
 		ExclusiveAppId: filter.ExclusiveAppId,
 		PayloadType:    filter.PayloadType,
+		Protocols:      filter.Protocols,
+	}
+	if s.denyList != nil {
+		operationQuery.ExcludedEmitters = s.denyList.Excluded(ctx)
 	}
 
-	if len(operationQuery.AppIDs) != 0 || len(operationQuery.SourceChainIDs) > 0 || len(operationQuery.TargetChainIDs) > 0 || len(operationQuery.PayloadType) > 0 {
+	if len(operationQuery.AppIDs) != 0 || len(operationQuery.SourceChainIDs) > 0 || len(operationQuery.TargetChainIDs) > 0 || len(operationQuery.PayloadType) > 0 || len(operationQuery.Protocols) > 0 {
 		return s.repo.FindFromParsedVaa(ctx, operationQuery)
 	}
 
@@ -70,3 +95,54 @@ func (s *Service) FindAll(ctx context.Context, filter OperationFilter) ([]*Opera
 	}
 	return operations, nil
 }
+
+type PendingRedeemsFilter struct {
+	// OlderThan is the min age of the vaas without a detected redeem.
+	OlderThan      time.Duration
+	TargetChainIDs []vaa.ChainID
+	AppIDs         []string
+}
+
+// FindPendingRedeems returns the vaas signed more than filter.OlderThan ago without a detected redeem,
+// grouped by target chain and app.
+func (s *Service) FindPendingRedeems(ctx context.Context, filter PendingRedeemsFilter) ([]*PendingRedeemsDto, error) {
+	now := time.Now()
+	query := PendingRedeemsQuery{
+		From:           now.Add(-pendingRedeemsMaxAge),
+		To:             now.Add(-filter.OlderThan),
+		TargetChainIDs: filter.TargetChainIDs,
+		AppIDs:         filter.AppIDs,
+		VaasPerGroup:   pendingRedeemsVaasPerGroup,
+	}
+	return s.repo.FindPendingRedeems(ctx, query)
+}
+
+// StartPendingRedeemsMetric refreshes the pending redeems metric every [interval] until the context is cancelled,
+// so the metric does not depend on the requests to the endpoint and their filters.
+func (s *Service) StartPendingRedeemsMetric(ctx context.Context, interval time.Duration) {
+	s.refreshPendingRedeemsMetric(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.refreshPendingRedeemsMetric(ctx)
+		}
+	}
+}
+
+func (s *Service) refreshPendingRedeemsMetric(ctx context.Context) {
+	pending, err := s.FindPendingRedeems(ctx, PendingRedeemsFilter{OlderThan: pendingRedeemsMetricAge})
+	if err != nil {
+		s.logger.Error("failed to refresh the pending redeems metric", zap.Error(err))
+		return
+	}
+	counts := make(map[metrics.PendingRedeemsKey]int, len(pending))
+	for _, p := range pending {
+		key := metrics.PendingRedeemsKey{TargetChain: p.TargetChain.String(), AppID: p.AppID}
+		counts[key] = p.Count
+	}
+	s.metrics.SetPendingRedeems(counts)
+}
@@ -0,0 +1,18 @@
+package participation
+
+import (
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// GuardianParticipation is the signing participation of a guardian in the vaas of a chain.
+type GuardianParticipation struct {
+	ChainID         sdk.ChainID `bson:"chainId" json:"chainId"`
+	GuardianAddress string      `bson:"guardianAddress" json:"guardianAddress"`
+	NodeName        string      `bson:"-" json:"nodeName,omitempty"`
+	// Signed is the number of vaas signed by the guardian.
+	Signed int64 `bson:"signed" json:"signed"`
+	// Total is the number of vaas emitted while the guardian was part of the guardian set.
+	Total int64 `bson:"total" json:"total"`
+	// Rate is the ratio between the signed vaas and the total vaas.
+	Rate float64 `bson:"-" json:"rate"`
+}
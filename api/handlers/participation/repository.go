@@ -0,0 +1,75 @@
+package participation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// Repository definition.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		guardianParticipation *mongo.Collection
+	}
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db,
+		logger: logger.With(zap.String("module", "ParticipationRepository")),
+		collections: struct {
+			guardianParticipation *mongo.Collection
+		}{
+			guardianParticipation: db.Collection(repository.GuardianParticipation),
+		},
+	}
+}
+
+// FindGuardianParticipation get the daily participation of the guardians between [from] and [to],
+// summed by guardian and chain.
+// If the parameter [chainID] is not nil, only the participation in that chain is returned.
+func (r *Repository) FindGuardianParticipation(ctx context.Context, from, to time.Time, chainID *sdk.ChainID) ([]*GuardianParticipation, error) {
+
+	match := bson.D{{Key: "date", Value: bson.D{{Key: "$gte", Value: from}, {Key: "$lt", Value: to}}}}
+	if chainID != nil {
+		match = append(match, bson.E{Key: "chainId", Value: *chainID})
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "chainId", Value: "$chainId"},
+				{Key: "guardianAddress", Value: "$guardianAddress"},
+			}},
+			{Key: "signed", Value: bson.D{{Key: "$sum", Value: "$signed"}}},
+			{Key: "total", Value: bson.D{{Key: "$sum", Value: "$total"}}},
+		}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 0},
+			{Key: "chainId", Value: "$_id.chainId"},
+			{Key: "guardianAddress", Value: "$_id.guardianAddress"},
+			{Key: "signed", Value: 1},
+			{Key: "total", Value: 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "chainId", Value: 1}, {Key: "guardianAddress", Value: 1}}}},
+	}
+
+	docs, err := repository.Aggregate[*GuardianParticipation](ctx, r.collections.guardianParticipation, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute aggregation to get guardian participation",
+			zap.Error(err), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return docs, nil
+}
@@ -0,0 +1,58 @@
+package participation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/heartbeats"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	repo          *Repository
+	heartbeatsSrv *heartbeats.Service
+	logger        *zap.Logger
+}
+
+// NewService create a new Service.
+func NewService(dao *Repository, heartbeatsSrv *heartbeats.Service, logger *zap.Logger) *Service {
+	return &Service{repo: dao, heartbeatsSrv: heartbeatsSrv, logger: logger.With(zap.String("module", "ParticipationService"))}
+}
+
+// FindGuardianParticipation get the signing participation rate of each guardian by chain between the days [from] and [to].
+// The node names of the guardians are taken from their heartbeats.
+func (s *Service) FindGuardianParticipation(ctx context.Context, from, to time.Time, chainID *sdk.ChainID) ([]*GuardianParticipation, error) {
+	docs, err := s.repo.FindGuardianParticipation(ctx, from, to, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0, len(docs))
+	for _, d := range docs {
+		d.GuardianAddress = eth_common.HexToAddress(d.GuardianAddress).Hex()
+		if d.Total > 0 {
+			d.Rate = float64(d.Signed) / float64(d.Total)
+		}
+		addresses = append(addresses, d.GuardianAddress)
+	}
+
+	if len(addresses) > 0 {
+		hbs, err := s.heartbeatsSrv.GetHeartbeatsByIds(ctx, addresses)
+		if err != nil {
+			requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+			s.logger.Error("failed to get guardian heartbeats", zap.Error(err), zap.String("requestID", requestID))
+			return docs, nil
+		}
+		names := make(map[string]string, len(hbs))
+		for _, hb := range hbs {
+			names[hb.ID] = hb.NodeName
+		}
+		for _, d := range docs {
+			d.NodeName = names[d.GuardianAddress]
+		}
+	}
+	return docs, nil
+}
@@ -7,8 +7,11 @@ import (
 
 	"github.com/pkg/errors"
 	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 )
@@ -17,7 +20,8 @@ type Repository struct {
 	db          *mongo.Database
 	logger      *zap.Logger
 	collections struct {
-		relays *mongo.Collection
+		relays    *mongo.Collection
+		parsedVaa *mongo.Collection
 	}
 }
 
@@ -25,9 +29,11 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 	return &Repository{db: db,
 		logger: logger.With(zap.String("module", "VaaRepository")),
 		collections: struct {
-			relays *mongo.Collection
+			relays    *mongo.Collection
+			parsedVaa *mongo.Collection
 		}{
-			relays: db.Collection("relays"),
+			relays:    db.Collection("relays"),
+			parsedVaa: db.Collection("parsedVaa"),
 		},
 	}
 }
@@ -191,3 +197,82 @@ func (q *RelaysQuery) SetSequence(sequence string) *RelaysQuery {
 func Query() *RelaysQuery {
 	return &RelaysQuery{}
 }
+
+// RelayerFeeDoc represents the fees earned by a relayer address for a given token.
+type RelayerFeeDoc struct {
+	ID struct {
+		Relayer      string      `bson:"relayer"`
+		ChainID      vaa.ChainID `bson:"chainId"`
+		TokenChain   vaa.ChainID `bson:"tokenChain"`
+		TokenAddress string      `bson:"tokenAddress"`
+	} `bson:"_id"`
+	TotalFee primitive.Decimal128 `bson:"totalFee"`
+	Count    int64                `bson:"count"`
+}
+
+// FindRelayerFees get the relayer fees earned grouped by relayer address, destination chain and token.
+//
+// The relayer that earns the fee is the sender of the destination transaction that redeems the vaa.
+func (r *Repository) FindRelayerFees(ctx context.Context, relayer string, p *pagination.Pagination) ([]*RelayerFeeDoc, error) {
+
+	matchDestination := bson.D{
+		{Key: "globalTx.destinationTx.status", Value: domain.DstTxStatusConfirmed},
+	}
+	if relayer != "" {
+		matchDestination = append(matchDestination, bson.E{Key: "globalTx.destinationTx.from", Value: relayer})
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "relayerFee", Value: bson.D{{Key: "$exists", Value: true}}}}}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "globalTransactions"},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "globalTx"},
+		}}},
+		{{Key: "$unwind", Value: "$globalTx"}},
+		{{Key: "$match", Value: matchDestination}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "relayer", Value: "$globalTx.destinationTx.from"},
+				{Key: "chainId", Value: "$globalTx.destinationTx.chainId"},
+				{Key: "tokenChain", Value: "$relayerFee.tokenChain"},
+				{Key: "tokenAddress", Value: "$relayerFee.tokenAddress"},
+			}},
+			{Key: "totalFee", Value: bson.D{{Key: "$sum", Value: bson.D{
+				{Key: "$convert", Value: bson.D{
+					{Key: "input", Value: "$relayerFee.normalizedAmount"},
+					{Key: "to", Value: "decimal"},
+					{Key: "onError", Value: 0},
+					{Key: "onNull", Value: 0},
+				}},
+			}}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: p.GetSortInt()}, {Key: "_id.relayer", Value: 1}}}},
+		{{Key: "$skip", Value: p.Skip}},
+		{{Key: "$limit", Value: p.Limit}},
+	}
+
+	cur, err := r.collections.parsedVaa.Aggregate(ctx, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute aggregate command to get relayer fees",
+			zap.Error(err), zap.String("relayer", relayer), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	var fees []*RelayerFeeDoc
+	err = cur.All(ctx, &fees)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed decoding cursor to []*RelayerFeeDoc",
+			zap.Error(err), zap.String("relayer", relayer), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	if fees == nil {
+		fees = make([]*RelayerFeeDoc, 0)
+	}
+	return fees, nil
+}
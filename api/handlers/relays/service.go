@@ -3,6 +3,7 @@ package relays
 import (
 	"context"
 
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -33,3 +34,12 @@ func (s *Service) FindByVAA(
 
 	return s.repo.FindOne(ctx, query)
 }
+
+// FindRelayerFees get the relayer fees earned grouped by relayer address.
+// If the parameter [relayer] is not empty, only the fees earned by that address are returned.
+func (s *Service) FindRelayerFees(ctx context.Context, relayer string, p *pagination.Pagination) ([]*RelayerFeeDoc, error) {
+	if p == nil {
+		p = pagination.Default()
+	}
+	return s.repo.FindRelayerFees(ctx, relayer, p)
+}
@@ -0,0 +1,57 @@
+package reports
+
+import (
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Sources of the fee revenue.
+const (
+	// FeeSourcePayload is the fee set in the payload of the token bridge transfers.
+	FeeSourcePayload = "payload"
+	// FeeSourceRelayer is the budget paid to the standard relayer for the deliveries.
+	FeeSourceRelayer = "relayer"
+)
+
+// FeeRevenue is the fee revenue of the bridge in a token and a chain during a day.
+type FeeRevenue struct {
+	Date time.Time `bson:"date" json:"date"`
+	// Source is the origin of the fee, payload or relayer.
+	Source string `bson:"source" json:"source"`
+	// ChainID is the target chain of the transfers, where the fees are collected.
+	ChainID      sdk.ChainID `bson:"chainId" json:"chainId"`
+	TokenChain   sdk.ChainID `bson:"tokenChain" json:"tokenChain"`
+	TokenAddress string      `bson:"tokenAddress" json:"tokenAddress"`
+	Symbol       string      `bson:"symbol" json:"symbol,omitempty"`
+	// Amount is the fee amount in token units, it is empty when the token is not known.
+	Amount string `bson:"amount" json:"amount,omitempty"`
+	// AmountUSD is the fee amount in USD at the price of the token of the day.
+	AmountUSD float64 `bson:"amountUsd" json:"amountUsd"`
+	// Count is the number of transfers that paid a fee.
+	Count int64 `bson:"count" json:"count"`
+}
+
+// Statuses of the origin txs affected by a reorg.
+const (
+	// ReorgStatusReincluded indicates that the origin tx was included again in another block.
+	ReorgStatusReincluded = "reincluded"
+	// ReorgStatusRemoved indicates that the origin tx is no longer included in any block.
+	ReorgStatusRemoved = "removed"
+)
+
+// Reorg is a reorg that removed the recorded block of the origin tx of a vaa.
+type Reorg struct {
+	VaaID   string      `bson:"vaaId" json:"vaaId"`
+	ChainID sdk.ChainID `bson:"chainId" json:"chainId"`
+	TxHash  string      `bson:"txHash" json:"txHash"`
+	// Status is reincluded or removed.
+	Status string `bson:"status" json:"status"`
+	// BlockNumber and BlockHash are the block recorded for the origin tx before the reorg.
+	BlockNumber uint64 `bson:"blockNumber" json:"blockNumber"`
+	BlockHash   string `bson:"blockHash" json:"blockHash"`
+	// NewBlockNumber and NewBlockHash are the block that includes the origin tx after the reorg, if any.
+	NewBlockNumber uint64    `bson:"newBlockNumber" json:"newBlockNumber,omitempty"`
+	NewBlockHash   string    `bson:"newBlockHash" json:"newBlockHash,omitempty"`
+	DetectedAt     time.Time `bson:"detectedAt" json:"detectedAt"`
+}
@@ -0,0 +1,127 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// Repository definition.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		feeRevenue *mongo.Collection
+		reorgs     *mongo.Collection
+	}
+}
+
+// FeeRevenueQuery is the filter of the fee revenue.
+type FeeRevenueQuery struct {
+	From    time.Time
+	To      time.Time
+	ChainID *sdk.ChainID
+	Source  string
+}
+
+// ReorgQuery is the filter of the reorgs.
+type ReorgQuery struct {
+	ChainID *sdk.ChainID
+	Status  string
+	From    *time.Time
+	To      *time.Time
+}
+
+// NewRepository create a new Repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db: db,
+		logger: logger.With(zap.String("module", "ReportsRepository")),
+		collections: struct {
+			feeRevenue *mongo.Collection
+			reorgs     *mongo.Collection
+		}{
+			feeRevenue: db.Collection(repository.FeeRevenue),
+			reorgs:     db.Collection(repository.Reorgs),
+		},
+	}
+}
+
+// FindFeeRevenue get the daily fee revenue between the days [q.From] and [q.To], by chain and token.
+// If [q.ChainID] is not nil only the revenue collected in that chain is returned,
+// and if [q.Source] is not empty only the revenue of that source.
+func (r *Repository) FindFeeRevenue(ctx context.Context, q FeeRevenueQuery) ([]*FeeRevenue, error) {
+
+	filter := bson.D{{Key: "date", Value: bson.D{{Key: "$gte", Value: q.From}, {Key: "$lt", Value: q.To}}}}
+	if q.ChainID != nil {
+		filter = append(filter, bson.E{Key: "chainId", Value: *q.ChainID})
+	}
+	if q.Source != "" {
+		filter = append(filter, bson.E{Key: "source", Value: q.Source})
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "date", Value: 1},
+			{Key: "chainId", Value: 1},
+			{Key: "source", Value: 1},
+			{Key: "tokenChain", Value: 1},
+			{Key: "tokenAddress", Value: 1},
+		}}},
+	}
+
+	docs, err := repository.Aggregate[*FeeRevenue](ctx, r.collections.feeRevenue, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute aggregation to get fee revenue",
+			zap.Error(err), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return docs, nil
+}
+
+// FindReorgs get the reorgs that affected origin txs sorted by detection time.
+func (r *Repository) FindReorgs(ctx context.Context, q ReorgQuery, p *pagination.Pagination) ([]*Reorg, error) {
+
+	filter := bson.D{}
+	if q.ChainID != nil {
+		filter = append(filter, bson.E{Key: "chainId", Value: *q.ChainID})
+	}
+	if q.Status != "" {
+		filter = append(filter, bson.E{Key: "status", Value: q.Status})
+	}
+	if q.From != nil || q.To != nil {
+		detectedAt := bson.D{}
+		if q.From != nil {
+			detectedAt = append(detectedAt, bson.E{Key: "$gte", Value: *q.From})
+		}
+		if q.To != nil {
+			detectedAt = append(detectedAt, bson.E{Key: "$lt", Value: *q.To})
+		}
+		filter = append(filter, bson.E{Key: "detectedAt", Value: detectedAt})
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$sort", Value: bson.D{{Key: "detectedAt", Value: p.GetSortInt()}, {Key: "_id", Value: -1}}}},
+		{{Key: "$skip", Value: p.Skip}},
+		{{Key: "$limit", Value: p.Limit}},
+	}
+
+	docs, err := repository.Aggregate[*Reorg](ctx, r.collections.reorgs, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute aggregation to get reorgs",
+			zap.Error(err), zap.Any("query", q), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+	return docs, nil
+}
@@ -0,0 +1,43 @@
+package reports
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+
+	"go.uber.org/zap"
+)
+
+type Service struct {
+	repo   *Repository
+	logger *zap.Logger
+}
+
+// NewService create a new Service.
+func NewService(dao *Repository, logger *zap.Logger) *Service {
+	return &Service{repo: dao, logger: logger.With(zap.String("module", "ReportsService"))}
+}
+
+// FindFeeRevenue get the daily fee revenue of the bridge by chain and token.
+func (s *Service) FindFeeRevenue(ctx context.Context, q FeeRevenueQuery) ([]*FeeRevenue, error) {
+	docs, err := s.repo.FindFeeRevenue(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if docs == nil {
+		docs = make([]*FeeRevenue, 0)
+	}
+	return docs, nil
+}
+
+// FindReorgs get the reorgs that affected origin txs.
+func (s *Service) FindReorgs(ctx context.Context, q ReorgQuery, p *pagination.Pagination) ([]*Reorg, error) {
+	docs, err := s.repo.FindReorgs(ctx, q, p)
+	if err != nil {
+		return nil, err
+	}
+	if docs == nil {
+		docs = make([]*Reorg, 0)
+	}
+	return docs, nil
+}
@@ -2,6 +2,7 @@ package stats
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -31,6 +32,71 @@ func buildTopCorridors(bucket string, t time.Time, measurement string) string {
 	return fmt.Sprintf(queryTemplateTopCorridors, bucket, start, measurement)
 }
 
+const queryTemplateEmitterMessageRates = `
+bucket = "%s"
+windowStart = %s
+baselineStart = %s
+
+current = from(bucket: bucket)
+    |> range(start: windowStart)
+    |> filter(fn: (r) => r._measurement == "vaa_payload_stats" and (r._field == "count" or r._field == "payload_size"))
+    |> group(columns: ["emitter_chain", "emitter_address", "_field"])
+    |> sum()
+    |> map(fn: (r) => ({r with _field: "current_" + r._field}))
+
+baseline = from(bucket: bucket)
+    |> range(start: baselineStart, stop: windowStart)
+    |> filter(fn: (r) => r._measurement == "vaa_payload_stats" and r._field == "count")
+    |> group(columns: ["emitter_chain", "emitter_address", "_field"])
+    |> sum()
+    |> map(fn: (r) => ({r with _field: "baseline_" + r._field}))
+
+union(tables: [current, baseline])
+    |> group(columns: ["emitter_chain", "emitter_address"])
+    |> pivot(rowKey: ["emitter_chain", "emitter_address"], columnKey: ["_field"], valueColumn: "_value")
+    |> group()
+`
+
+func buildEmitterMessageRates(bucket string, t time.Time, window, baseline time.Duration) string {
+	windowStart := t.Truncate(time.Minute).Add(-window)
+	baselineStart := windowStart.Add(-baseline)
+	return fmt.Sprintf(queryTemplateEmitterMessageRates, bucket, windowStart.Format(time.RFC3339Nano), baselineStart.Format(time.RFC3339Nano))
+}
+
+const queryTemplateTransferSizes = `
+data = from(bucket: "%s")
+    |> range(start: %s)
+    |> filter(fn: (r) => r._measurement == "vaa_volume_v2" and r._field == "volume" and r._value > 0)
+    |> group(columns: [%s])
+    |> toFloat()
+    |> map(fn: (r) => ({r with _value: r._value / 100000000.0}))
+
+transfers = data
+    |> count()
+    |> toFloat()
+    |> set(key: "_field", value: "transfers")
+
+average = data
+    |> mean()
+    |> set(key: "_field", value: "average")
+
+median = data
+    |> quantile(q: 0.5, method: "exact_mean")
+    |> set(key: "_field", value: "median")
+
+union(tables: [transfers, average, median])
+    |> pivot(rowKey: [%s], columnKey: ["_field"], valueColumn: "_value")
+    |> group()
+`
+
+// buildTransferSizes returns the query of the number of transfers and their average and median usd size
+// in the [window] before [t], grouped by the tags [groupBy].
+func buildTransferSizes(bucket string, t time.Time, window time.Duration, groupBy ...string) string {
+	start := t.Truncate(time.Hour).Add(-window).Format(time.RFC3339Nano)
+	columns := `"` + strings.Join(groupBy, `", "`) + `"`
+	return fmt.Sprintf(queryTemplateTransferSizes, bucket, start, columns, columns)
+}
+
 const queryTemplateNTTTotalValueTokenTransferred = `
 import "influxdata/influxdb/schema"
 import "date"
@@ -166,3 +166,66 @@ union(tables: [current, last])
 	actual := buildNTTChainActivity("wormscan", tm, "", true)
 	assert.Equal(t, expected, actual)
 }
+
+func TestQueries_buildEmitterMessageRates(t *testing.T) {
+
+	expected := `
+bucket = "wormscan-30days"
+windowStart = 2024-08-23T17:39:00Z
+baselineStart = 2024-08-16T17:39:00Z
+
+current = from(bucket: bucket)
+    |> range(start: windowStart)
+    |> filter(fn: (r) => r._measurement == "vaa_payload_stats" and (r._field == "count" or r._field == "payload_size"))
+    |> group(columns: ["emitter_chain", "emitter_address", "_field"])
+    |> sum()
+    |> map(fn: (r) => ({r with _field: "current_" + r._field}))
+
+baseline = from(bucket: bucket)
+    |> range(start: baselineStart, stop: windowStart)
+    |> filter(fn: (r) => r._measurement == "vaa_payload_stats" and r._field == "count")
+    |> group(columns: ["emitter_chain", "emitter_address", "_field"])
+    |> sum()
+    |> map(fn: (r) => ({r with _field: "baseline_" + r._field}))
+
+union(tables: [current, baseline])
+    |> group(columns: ["emitter_chain", "emitter_address"])
+    |> pivot(rowKey: ["emitter_chain", "emitter_address"], columnKey: ["_field"], valueColumn: "_value")
+    |> group()
+`
+	tm := time.Date(2024, 8, 23, 18, 39, 10, 985, time.UTC)
+	actual := buildEmitterMessageRates("wormscan-30days", tm, time.Hour, 7*24*time.Hour)
+	assert.Equal(t, expected, actual)
+}
+
+func TestQueries_buildTransferSizes(t *testing.T) {
+
+	expected := `
+data = from(bucket: "wormscan")
+    |> range(start: 2024-08-16T18:00:00Z)
+    |> filter(fn: (r) => r._measurement == "vaa_volume_v2" and r._field == "volume" and r._value > 0)
+    |> group(columns: ["token_chain", "token_address"])
+    |> toFloat()
+    |> map(fn: (r) => ({r with _value: r._value / 100000000.0}))
+
+transfers = data
+    |> count()
+    |> toFloat()
+    |> set(key: "_field", value: "transfers")
+
+average = data
+    |> mean()
+    |> set(key: "_field", value: "average")
+
+median = data
+    |> quantile(q: 0.5, method: "exact_mean")
+    |> set(key: "_field", value: "median")
+
+union(tables: [transfers, average, median])
+    |> pivot(rowKey: ["token_chain", "token_address"], columnKey: ["_field"], valueColumn: "_value")
+    |> group()
+`
+	tm := time.Date(2024, 8, 23, 18, 39, 10, 985, time.UTC)
+	actual := buildTransferSizes("wormscan", tm, 7*24*time.Hour, "token_chain", "token_address")
+	assert.Equal(t, expected, actual)
+}
@@ -3,7 +3,6 @@ package stats
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -14,6 +13,7 @@ import (
 	"github.com/influxdata/influxdb-client-go/v2/api"
 	"github.com/mitchellh/mapstructure"
 	"github.com/shopspring/decimal"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/common/coingecko"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/stats"
@@ -26,6 +26,7 @@ type Repository struct {
 	influxCli               influxdb2.Client
 	queryAPI                api.QueryAPI
 	bucket24HoursRetention  string
+	bucket30DaysRetention   string
 	bucketInfiniteRetention string
 	coingeckoAPI            *coingecko.CoinGeckoAPI
 	tokenProvider           *domain.TokenProvider
@@ -37,6 +38,7 @@ func NewRepository(
 	client influxdb2.Client,
 	org string,
 	bucket24HoursRetention string,
+	bucket30DaysRetention string,
 	bucketInfiniteRetention string,
 	coingeckoAPI *coingecko.CoinGeckoAPI,
 	tokenProvider *domain.TokenProvider,
@@ -48,6 +50,7 @@ func NewRepository(
 		influxCli:               client,
 		queryAPI:                client.QueryAPI(org),
 		bucket24HoursRetention:  bucket24HoursRetention,
+		bucket30DaysRetention:   bucket30DaysRetention,
 		bucketInfiniteRetention: bucketInfiniteRetention,
 		coingeckoAPI:            coingeckoAPI,
 		tokenProvider:           tokenProvider,
@@ -233,6 +236,154 @@ func (r *Repository) GetTopCorridores(ctx context.Context, timeSpan TopCorridors
 	return values, nil
 }
 
+// GetEmitterMessageRates returns the messages and payload sizes of each emitter in the last [window],
+// and its messages in the [baseline] period before the window.
+//
+// The emitters without messages in the window are returned too, so the baseline of an emitter replaced
+// by a redeployed contract can be aggregated with the new emitter.
+func (r *Repository) GetEmitterMessageRates(ctx context.Context, window, baseline time.Duration) ([]EmitterMessageRate, error) {
+
+	query := buildEmitterMessageRates(r.bucket30DaysRetention, time.Now(), window, baseline)
+	result, err := r.queryAPI.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	// Scan query results
+	type Row struct {
+		EmitterChain       string `mapstructure:"emitter_chain"`
+		EmitterAddress     string `mapstructure:"emitter_address"`
+		CurrentCount       int64  `mapstructure:"current_count"`
+		CurrentPayloadSize int64  `mapstructure:"current_payload_size"`
+		BaselineCount      int64  `mapstructure:"baseline_count"`
+	}
+
+	var values []EmitterMessageRate
+	for result.Next() {
+		var row Row
+		if err := mapstructure.Decode(result.Record().Values(), &row); err != nil {
+			return nil, err
+		}
+
+		// parse emitter chain
+		emitterChain, err := strconv.ParseUint(row.EmitterChain, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert emitter chain field to uint16. %v", err)
+		}
+
+		values = append(values, EmitterMessageRate{
+			EmitterChainID:   sdk.ChainID(emitterChain),
+			EmitterAddress:   row.EmitterAddress,
+			Messages:         row.CurrentCount,
+			PayloadSize:      row.CurrentPayloadSize,
+			BaselineMessages: row.BaselineCount,
+		})
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	return values, nil
+}
+
+// transferSizeRow is a row of the transfer sizes query.
+type transferSizeRow struct {
+	EmitterChain string  `mapstructure:"emitter_chain"`
+	TokenChain   string  `mapstructure:"token_chain"`
+	TokenAddress string  `mapstructure:"token_address"`
+	Transfers    float64 `mapstructure:"transfers"`
+	Average      float64 `mapstructure:"average"`
+	Median       float64 `mapstructure:"median"`
+}
+
+func (row *transferSizeRow) size() TransferSize {
+	return TransferSize{
+		Transfers:   int64(row.Transfers),
+		AverageSize: decimal.NewFromFloat(row.Average).Round(2),
+		MedianSize:  decimal.NewFromFloat(row.Median).Round(2),
+	}
+}
+
+func (r *Repository) getTransferSizes(ctx context.Context, window time.Duration, groupBy ...string) ([]transferSizeRow, error) {
+
+	query := buildTransferSizes(r.bucketInfiniteRetention, time.Now(), window, groupBy...)
+	result, err := r.queryAPI.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	var rows []transferSizeRow
+	for result.Next() {
+		var row transferSizeRow
+		if err := mapstructure.Decode(result.Record().Values(), &row); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	return rows, nil
+}
+
+// GetChainTransferSizes returns the number of transfers emitted by each chain in the [window]
+// and their average and median size in usd. The transfers without price are not included.
+func (r *Repository) GetChainTransferSizes(ctx context.Context, window time.Duration) ([]ChainTransferSize, error) {
+	rows, err := r.getTransferSizes(ctx, window, "emitter_chain")
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]ChainTransferSize, 0, len(rows))
+	for _, row := range rows {
+		emitterChain, err := strconv.ParseUint(row.EmitterChain, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert emitter chain field to uint16. %v", err)
+		}
+		if !domain.ChainIdIsValid(sdk.ChainID(emitterChain)) {
+			continue
+		}
+		values = append(values, ChainTransferSize{
+			EmitterChainID: sdk.ChainID(emitterChain),
+			TransferSize:   row.size(),
+		})
+	}
+	return values, nil
+}
+
+// GetTokenTransferSizes returns the number of transfers of each token in the [window]
+// and their average and median size in usd. The transfers without price are not included.
+func (r *Repository) GetTokenTransferSizes(ctx context.Context, window time.Duration) ([]TokenTransferSize, error) {
+	rows, err := r.getTransferSizes(ctx, window, "token_chain", "token_address")
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]TokenTransferSize, 0, len(rows))
+	for _, row := range rows {
+		tokenChain, err := strconv.ParseUint(row.TokenChain, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert token chain field to uint16. %v", err)
+		}
+		value := TokenTransferSize{
+			TokenChainID: sdk.ChainID(tokenChain),
+			TokenAddress: row.TokenAddress,
+			TransferSize: row.size(),
+		}
+		if token, ok := r.tokenProvider.GetTokenByAddress(value.TokenChainID, value.TokenAddress); ok {
+			value.Symbol = token.Symbol.String()
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
 func (r *Repository) GetNativeTokenTransferSummary(ctx context.Context, symbol string) (*NativeTokenTransferSummary, error) {
 	var wg sync.WaitGroup
 
@@ -332,7 +483,7 @@ func (r *Repository) getNTTTotalValueTokenTransferred(ctx context.Context, symbo
 	if !result.Next() {
 		r.logger.Error("ntt total value tokend transferred query result has no next",
 			zap.String("symbol", symbol))
-		return nil, errors.New("no result")
+		return nil, errs.NewNotFound("no result")
 	}
 	row := struct {
 		Value uint64 `mapstructure:"_value"`
@@ -362,7 +513,7 @@ func (r *Repository) getNTTTotalTokenTransferred(ctx context.Context, symbol str
 	if !result.Next() {
 		r.logger.Error("ntt total token transferred query result has no next",
 			zap.String("symbol", symbol))
-		return nil, errors.New("no result")
+		return nil, errs.NewNotFound("no result")
 	}
 	row := struct {
 		Value uint64 `mapstructure:"_value"`
@@ -393,7 +544,7 @@ func (r *Repository) getNTTAverageTransferSize(ctx context.Context, symbol strin
 	if !result.Next() {
 		r.logger.Error("ntt average transfer size query result has no next",
 			zap.String("symbol", symbol))
-		return nil, errors.New("no result")
+		return nil, errs.NewNotFound("no result")
 	}
 	row := struct {
 		Value float64 `mapstructure:"_value"`
@@ -2,14 +2,17 @@ package stats
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/cacheable"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/stats"
 	"go.uber.org/zap"
 )
@@ -18,8 +21,9 @@ type Service struct {
 	repo               *Repository
 	addressRepositorty *stats.AddressRepository
 	holderRepository   *stats.HolderRepositoryReadable
+	emitterRemapping   domain.EmitterRemapping
 	cache              cache.Cache
-	expiration         time.Duration
+	expiration         atomic.Int64
 	metrics            metrics.Metrics
 	logger             *zap.Logger
 }
@@ -30,26 +34,54 @@ const (
 	nttSummary             = "wormscan:ntt-summary"
 	nttChainActivity       = "wormscan:ntt-ntt-chain-activity"
 	nttTransferByTime      = "wormscan:ntt-transfer-by-time"
+	emitterAnomaliesKey    = "wormscan:emitter-rate-anomalies"
+	transferSizesKey       = "wormscan:transfer-sizes"
+)
+
+// The message rate of each emitter in the last hour is compared with its rate in the previous 7 days,
+// and the emitters whose rate increased at least 10x are reported.
+// The emitters with few messages in the last hour are ignored to avoid reporting the quiet emitters.
+const (
+	anomalyWindow      = time.Hour
+	anomalyBaseline    = 7 * 24 * time.Hour
+	anomalyIncrease    = 10
+	anomalyMinMessages = 100
 )
 
 // NewService create a new Service.
 func NewService(repo *Repository, statsRepository *stats.AddressRepository,
 	holderRepository *stats.HolderRepositoryReadable, cache cache.Cache,
 	expiration time.Duration, metrics metrics.Metrics, logger *zap.Logger) *Service {
-	return &Service{
+	s := &Service{
 		repo:               repo,
 		addressRepositorty: statsRepository,
 		holderRepository:   holderRepository,
 		cache:              cache,
-		expiration:         expiration,
 		metrics:            metrics,
 		logger:             logger.With(zap.String("module", "StatsService"))}
+	s.SetCacheExpiration(expiration)
+	return s
+}
+
+// SetCacheExpiration changes the expiration of the values cached by the service.
+func (s *Service) SetCacheExpiration(expiration time.Duration) {
+	s.expiration.Store(int64(expiration))
+}
+
+func (s *Service) cacheExpiration() time.Duration {
+	return time.Duration(s.expiration.Load())
+}
+
+// SetEmitterRemapping aggregates the stats of the emitters replaced by a redeployed contract
+// (e.g. a new token bridge) with the stats of the new emitter.
+func (s *Service) SetEmitterRemapping(remapping domain.EmitterRemapping) {
+	s.emitterRemapping = remapping
 }
 
 func (s *Service) GetSymbolWithAssets(ctx context.Context, ts SymbolWithAssetsTimeSpan) ([]SymbolWithAssetDTO, error) {
 	key := topSymbolsByVolumeKey
 	key = fmt.Sprintf("%s:%s", key, ts)
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
 		func() ([]SymbolWithAssetDTO, error) {
 			return s.repo.GetSymbolWithAssets(ctx, ts)
 		})
@@ -58,7 +90,7 @@ func (s *Service) GetSymbolWithAssets(ctx context.Context, ts SymbolWithAssetsTi
 func (s *Service) GetTopCorridors(ctx context.Context, ts TopCorridorsTimeSpan) ([]TopCorridorsDTO, error) {
 	key := topCorridorsByCountKey
 	key = fmt.Sprintf("%s:%s", key, ts)
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
 		func() ([]TopCorridorsDTO, error) {
 			return s.repo.GetTopCorridores(ctx, ts)
 		})
@@ -66,10 +98,10 @@ func (s *Service) GetTopCorridors(ctx context.Context, ts TopCorridorsTimeSpan)
 
 func (s *Service) GetNativeTokenTransferSummary(ctx context.Context, symbol string) (*NativeTokenTransferSummary, error) {
 	if strings.ToUpper(symbol) != "W" {
-		return nil, errors.New("symbol not supported")
+		return nil, errs.NewInvalidParam("symbol not supported")
 	}
 
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, nttSummary, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), nttSummary, s.metrics,
 		func() (*NativeTokenTransferSummary, error) {
 			return s.repo.GetNativeTokenTransferSummary(ctx, symbol)
 		})
@@ -77,10 +109,10 @@ func (s *Service) GetNativeTokenTransferSummary(ctx context.Context, symbol stri
 
 func (s *Service) GetNativeTokenTransferActivity(ctx context.Context, isNotional bool, symbol string) ([]NativeTokenTransferActivity, error) {
 	if symbol != "W" {
-		return nil, errors.New("symbol not supported")
+		return nil, errs.NewInvalidParam("symbol not supported")
 	}
 	key := fmt.Sprintf("%s:%s:%t", nttChainActivity, symbol, isNotional)
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
 		func() ([]NativeTokenTransferActivity, error) {
 			return s.repo.GetNativeTokenTransferActivity(ctx, isNotional, symbol)
 		})
@@ -88,49 +120,49 @@ func (s *Service) GetNativeTokenTransferActivity(ctx context.Context, isNotional
 
 func (s *Service) GetNativeTokenTransferByTime(ctx context.Context, timespan NttTimespan, symbol string, isNotional bool, from, to time.Time) ([]NativeTokenTransferByTime, error) {
 	if symbol != "W" {
-		return nil, errors.New("symbol not supported")
+		return nil, errs.NewInvalidParam("symbol not supported")
 	}
 
 	timeDuration := to.Sub(from)
 
 	if timespan == HourNttTimespan && timeDuration > 15*24*time.Hour {
-		return nil, errors.New("time range is too large for hourly data. Max time range allowed: 15 days")
+		return nil, errs.NewInvalidParam("time range is too large for hourly data. Max time range allowed: 15 days")
 	}
 
 	if timespan == DayNttTimespan {
 		if timeDuration < 24*time.Hour {
-			return nil, errors.New("time range is too small for daily data. Min time range allowed: 2 day")
+			return nil, errs.NewInvalidParam("time range is too small for daily data. Min time range allowed: 2 day")
 		}
 
 		if timeDuration > 365*24*time.Hour {
-			return nil, errors.New("time range is too large for daily data. Max time range allowed: 1 year")
+			return nil, errs.NewInvalidParam("time range is too large for daily data. Max time range allowed: 1 year")
 		}
 	}
 
 	if timespan == MonthNttTimespan {
 		if timeDuration < 30*24*time.Hour {
-			return nil, errors.New("time range is too small for monthly data. Min time range allowed: 60 days")
+			return nil, errs.NewInvalidParam("time range is too small for monthly data. Min time range allowed: 60 days")
 		}
 
 		if timeDuration > 10*365*24*time.Hour {
-			return nil, errors.New("time range is too large for monthly data. Max time range allowed: 1 year")
+			return nil, errs.NewInvalidParam("time range is too large for monthly data. Max time range allowed: 1 year")
 		}
 	}
 
 	if timespan == YearNttTimespan {
 		if timeDuration < 365*24*time.Hour {
-			return nil, errors.New("time range is too small for yearly data. Min time range allowed: 1 year")
+			return nil, errs.NewInvalidParam("time range is too small for yearly data. Min time range allowed: 1 year")
 		}
 
 		if timeDuration > 10*365*24*time.Hour {
-			return nil, errors.New("time range is too large for yearly data. Max time range allowed: 10 year")
+			return nil, errs.NewInvalidParam("time range is too large for yearly data. Max time range allowed: 10 year")
 		}
 	}
 	fromStr := from.Format(time.RFC3339)
 	toStr := to.Format(time.RFC3339)
 	key := fmt.Sprintf("%s:%s:%s:%t:%s:%s", nttTransferByTime, timespan, symbol, isNotional, fromStr, toStr)
 
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
 		func() ([]NativeTokenTransferByTime, error) {
 			return s.repo.GetNativeTokenTransferByTime(ctx, timespan, symbol, isNotional, from, to)
 		})
@@ -139,7 +171,7 @@ func (s *Service) GetNativeTokenTransferByTime(ctx context.Context, timespan Ntt
 
 func (s *Service) GetNativeTokenTransferAddressTop(ctx context.Context, symbol string, isNotional bool) ([]stats.NativeTokenTransferTopAddress, error) {
 	if symbol != "W" {
-		return nil, errors.New("symbol not supported")
+		return nil, errs.NewInvalidParam("symbol not supported")
 	}
 
 	return s.addressRepositorty.GetNativeTokenTransferTopAddress(ctx, symbol, isNotional)
@@ -147,7 +179,105 @@ func (s *Service) GetNativeTokenTransferAddressTop(ctx context.Context, symbol s
 
 func (s *Service) GetNativeTokenTransferTopHolder(ctx context.Context, symbol string) ([]stats.NativeTokenTransferTopHolder, error) {
 	if symbol != "W" {
-		return nil, errors.New("symbol not supported")
+		return nil, errs.NewInvalidParam("symbol not supported")
 	}
 	return s.holderRepository.GetNativeTokenTransferTopHolder(ctx, symbol)
 }
+
+// GetEmitterRateAnomalies returns the emitters whose message rate increased suddenly (see [anomalyIncrease]),
+// sorted by increase. The new emitters, without messages in the baseline period, are listed first.
+func (s *Service) GetEmitterRateAnomalies(ctx context.Context) ([]EmitterRateAnomaly, error) {
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), emitterAnomaliesKey, s.metrics,
+		func() ([]EmitterRateAnomaly, error) {
+			rates, err := s.repo.GetEmitterMessageRates(ctx, anomalyWindow, anomalyBaseline)
+			if err != nil {
+				return nil, err
+			}
+			rates = remapEmitterRates(rates, s.emitterRemapping)
+			return findRateAnomalies(rates, anomalyWindow, anomalyBaseline), nil
+		})
+}
+
+// GetTransferSizes returns the number of transfers and their average and median usd size in the time span,
+// by emitter chain and by token, sorted by number of transfers.
+func (s *Service) GetTransferSizes(ctx context.Context, ts TransferSizeTimeSpan) (*TransferSizes, error) {
+	key := fmt.Sprintf("%s:%s", transferSizesKey, ts)
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
+		func() (*TransferSizes, error) {
+			byChain, err := s.repo.GetChainTransferSizes(ctx, ts.Duration())
+			if err != nil {
+				return nil, err
+			}
+			byToken, err := s.repo.GetTokenTransferSizes(ctx, ts.Duration())
+			if err != nil {
+				return nil, err
+			}
+			sort.SliceStable(byChain, func(i, j int) bool {
+				return byChain[i].Transfers > byChain[j].Transfers
+			})
+			sort.SliceStable(byToken, func(i, j int) bool {
+				return byToken[i].Transfers > byToken[j].Transfers
+			})
+			return &TransferSizes{TimeSpan: ts, ByChain: byChain, ByToken: byToken}, nil
+		})
+}
+
+// remapEmitterRates aggregates the message rates of the emitters replaced by a redeployed contract with the rates
+// of the new emitter, so the new emitter of a redeployed contract is not reported as an anomaly.
+func remapEmitterRates(rates []EmitterMessageRate, remapping domain.EmitterRemapping) []EmitterMessageRate {
+	if len(remapping) == 0 {
+		return rates
+	}
+	merged := make([]EmitterMessageRate, 0, len(rates))
+	index := make(map[string]int, len(rates))
+	for _, r := range rates {
+		r.EmitterAddress = remapping.Canonical(r.EmitterChainID, r.EmitterAddress)
+		key := fmt.Sprintf("%d/%s", r.EmitterChainID, r.EmitterAddress)
+		if i, ok := index[key]; ok {
+			merged[i].Messages += r.Messages
+			merged[i].PayloadSize += r.PayloadSize
+			merged[i].BaselineMessages += r.BaselineMessages
+			continue
+		}
+		index[key] = len(merged)
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// findRateAnomalies returns the emitters whose rate in the window is at least [anomalyIncrease] times their baseline rate.
+func findRateAnomalies(rates []EmitterMessageRate, window, baseline time.Duration) []EmitterRateAnomaly {
+	anomalies := []EmitterRateAnomaly{}
+	for _, r := range rates {
+		if r.Messages < anomalyMinMessages {
+			continue
+		}
+		anomaly := EmitterRateAnomaly{
+			EmitterChainID: r.EmitterChainID,
+			EmitterAddress: r.EmitterAddress,
+			Messages:       r.Messages,
+			Rate:           float64(r.Messages) / window.Hours(),
+			BaselineRate:   float64(r.BaselineMessages) / baseline.Hours(),
+			AvgPayloadSize: r.PayloadSize / r.Messages,
+		}
+		if anomaly.BaselineRate > 0 {
+			anomaly.Increase = anomaly.Rate / anomaly.BaselineRate
+			if anomaly.Increase < anomalyIncrease {
+				continue
+			}
+		}
+		anomalies = append(anomalies, anomaly)
+	}
+
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		a, b := anomalies[i], anomalies[j]
+		if (a.BaselineRate == 0) != (b.BaselineRate == 0) {
+			return a.BaselineRate == 0
+		}
+		if a.Increase != b.Increase {
+			return a.Increase > b.Increase
+		}
+		return a.Messages > b.Messages
+	})
+	return anomalies
+}
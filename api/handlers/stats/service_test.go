@@ -0,0 +1,64 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestFindRateAnomalies(t *testing.T) {
+	window, baseline := time.Hour, 7*24*time.Hour
+
+	rates := []EmitterMessageRate{
+		// 1680 messages in 7 days is 10 messages per hour, 100 messages per hour is a 10x increase.
+		{EmitterChainID: sdk.ChainIDEthereum, EmitterAddress: "spike", Messages: 100, PayloadSize: 13300, BaselineMessages: 1680},
+		// 20x increase.
+		{EmitterChainID: sdk.ChainIDSolana, EmitterAddress: "incident", Messages: 200, PayloadSize: 20000, BaselineMessages: 1680},
+		// steady emitter, 2x increase.
+		{EmitterChainID: sdk.ChainIDSolana, EmitterAddress: "steady", Messages: 500, PayloadSize: 50000, BaselineMessages: 42000},
+		// new emitter.
+		{EmitterChainID: sdk.ChainIDBase, EmitterAddress: "new", Messages: 150, PayloadSize: 1500},
+		// quiet emitter, ignored although its rate increased.
+		{EmitterChainID: sdk.ChainIDBase, EmitterAddress: "quiet", Messages: 20, PayloadSize: 2000, BaselineMessages: 1},
+	}
+
+	anomalies := findRateAnomalies(rates, window, baseline)
+
+	assert.Equal(t, []EmitterRateAnomaly{
+		{EmitterChainID: sdk.ChainIDBase, EmitterAddress: "new", Messages: 150, Rate: 150, AvgPayloadSize: 10},
+		{EmitterChainID: sdk.ChainIDSolana, EmitterAddress: "incident", Messages: 200, Rate: 200, BaselineRate: 10, Increase: 20, AvgPayloadSize: 100},
+		{EmitterChainID: sdk.ChainIDEthereum, EmitterAddress: "spike", Messages: 100, Rate: 100, BaselineRate: 10, Increase: 10, AvgPayloadSize: 133},
+	}, anomalies)
+}
+
+func TestRemapEmitterRates(t *testing.T) {
+	const (
+		oldBridge = "000000000000000000000000000000000000000000000000000000000000000a"
+		newBridge = "000000000000000000000000000000000000000000000000000000000000000b"
+	)
+	remapping, err := domain.ParseEmitterRemapping("2:" + oldBridge + ":" + newBridge)
+	assert.NoError(t, err)
+
+	rates := []EmitterMessageRate{
+		// the old token bridge only has messages in the baseline period.
+		{EmitterChainID: sdk.ChainIDEthereum, EmitterAddress: oldBridge, BaselineMessages: 16800},
+		{EmitterChainID: sdk.ChainIDEthereum, EmitterAddress: newBridge, Messages: 100, PayloadSize: 10000},
+		// the same address in other chain is not remapped.
+		{EmitterChainID: sdk.ChainIDSolana, EmitterAddress: oldBridge, Messages: 5, BaselineMessages: 10},
+	}
+
+	assert.Equal(t, []EmitterMessageRate{
+		{EmitterChainID: sdk.ChainIDEthereum, EmitterAddress: newBridge, Messages: 100, PayloadSize: 10000, BaselineMessages: 16800},
+		{EmitterChainID: sdk.ChainIDSolana, EmitterAddress: oldBridge, Messages: 5, BaselineMessages: 10},
+	}, remapEmitterRates(rates, remapping))
+
+	// the redeployed token bridge is not reported as a new emitter.
+	assert.Len(t, findRateAnomalies(rates, time.Hour, 7*24*time.Hour), 1)
+	assert.Empty(t, findRateAnomalies(remapEmitterRates(rates, remapping), time.Hour, 7*24*time.Hour))
+
+	// without remapping the rates are not changed.
+	assert.Equal(t, rates, remapEmitterRates(rates, nil))
+}
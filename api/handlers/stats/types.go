@@ -12,6 +12,7 @@ import (
 type SymbolWithAssetsTimeSpan string
 type TopCorridorsTimeSpan string
 type NttTimespan string
+type TransferSizeTimeSpan string
 
 const (
 	TimeSpan7Days  SymbolWithAssetsTimeSpan = "7d"
@@ -25,6 +26,10 @@ const (
 	DayNttTimespan   NttTimespan = "1d"
 	MonthNttTimespan NttTimespan = "1mo"
 	YearNttTimespan  NttTimespan = "1y"
+
+	TimeSpan1DayTransferSize   TransferSizeTimeSpan = "1d"
+	TimeSpan7DaysTransferSize  TransferSizeTimeSpan = "7d"
+	TimeSpan30DaysTransferSize TransferSizeTimeSpan = "30d"
 )
 
 // ParseSymbolsWithAssetsTimeSpan parses a string and returns a `SymbolsWithAssetsTimeSpan`.
@@ -41,6 +46,31 @@ func ParseSymbolsWithAssetsTimeSpan(s string) (*SymbolWithAssetsTimeSpan, error)
 	return nil, fmt.Errorf("invalid time span: %s", s)
 }
 
+// ParseTransferSizeTimeSpan parses a string and returns a `TransferSizeTimeSpan`.
+func ParseTransferSizeTimeSpan(s string) (*TransferSizeTimeSpan, error) {
+	if s == string(TimeSpan1DayTransferSize) ||
+		s == string(TimeSpan7DaysTransferSize) ||
+		s == string(TimeSpan30DaysTransferSize) {
+
+		tmp := TransferSizeTimeSpan(s)
+		return &tmp, nil
+	}
+
+	return nil, fmt.Errorf("invalid time span: %s", s)
+}
+
+// Duration returns the window of the time span.
+func (ts TransferSizeTimeSpan) Duration() time.Duration {
+	switch ts {
+	case TimeSpan1DayTransferSize:
+		return 24 * time.Hour
+	case TimeSpan30DaysTransferSize:
+		return 30 * 24 * time.Hour
+	default:
+		return 7 * 24 * time.Hour
+	}
+}
+
 type SymbolWithAssetDTO struct {
 	Symbol         string
 	EmitterChainID sdk.ChainID
@@ -102,3 +132,59 @@ type NativeTokenTransferByTime struct {
 	Symbol string          `json:"symbol"`
 	Value  decimal.Decimal `json:"value"`
 }
+
+// EmitterMessageRate contains the messages of an emitter in a window and in the baseline period before the window.
+type EmitterMessageRate struct {
+	EmitterChainID sdk.ChainID
+	EmitterAddress string
+	// Messages is the number of messages in the window.
+	Messages int64
+	// PayloadSize is the total size in bytes of the payloads of the messages in the window.
+	PayloadSize int64
+	// BaselineMessages is the number of messages in the baseline period.
+	BaselineMessages int64
+}
+
+// EmitterRateAnomaly is an emitter whose message rate increased suddenly, e.g. a spam emitter or a protocol incident.
+type EmitterRateAnomaly struct {
+	EmitterChainID sdk.ChainID `json:"emitterChain"`
+	EmitterAddress string      `json:"emitterAddress"`
+	// Messages is the number of messages in the window.
+	Messages int64 `json:"messages"`
+	// Rate is the number of messages per hour in the window.
+	Rate float64 `json:"rate"`
+	// BaselineRate is the number of messages per hour in the baseline period, zero for the new emitters.
+	BaselineRate float64 `json:"baselineRate"`
+	// Increase is the ratio between the rate and the baseline rate, zero for the new emitters.
+	Increase float64 `json:"increase"`
+	// AvgPayloadSize is the average size in bytes of the payloads of the messages in the window.
+	AvgPayloadSize int64 `json:"avgPayloadSize"`
+}
+
+// TransferSize is the number of transfers and their average and median size in usd.
+type TransferSize struct {
+	Transfers   int64           `json:"transfers"`
+	AverageSize decimal.Decimal `json:"averageSize"`
+	MedianSize  decimal.Decimal `json:"medianSize"`
+}
+
+// ChainTransferSize is the size of the transfers emitted by a chain.
+type ChainTransferSize struct {
+	EmitterChainID sdk.ChainID `json:"emitterChain"`
+	TransferSize
+}
+
+// TokenTransferSize is the size of the transfers of a token.
+type TokenTransferSize struct {
+	TokenChainID sdk.ChainID `json:"tokenChain"`
+	TokenAddress string      `json:"tokenAddress"`
+	Symbol       string      `json:"symbol,omitempty"`
+	TransferSize
+}
+
+// TransferSizes is the size of the transfers by chain and by token in a time span.
+type TransferSizes struct {
+	TimeSpan TransferSizeTimeSpan `json:"timeSpan"`
+	ByChain  []ChainTransferSize  `json:"byChain"`
+	ByToken  []TokenTransferSize  `json:"byToken"`
+}
@@ -0,0 +1,115 @@
+package transactions
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// forecastHorizon is the number of hours projected by the forecast.
+const forecastHorizon = 24
+
+// forecastTransactions projects the message counts of the next 24 hours for every chain in counts.
+//
+// The history window starts at from and spans days full days, hours without messages count as zero.
+func forecastTransactions(counts []ChainHourlyCount, from time.Time, days int, method ForecastMethod) []TransactionForecast {
+	hours := days * 24
+
+	// build an hourly series for every chain.
+	series := make(map[sdk.ChainID][]float64)
+	for _, c := range counts {
+		chainID, err := strconv.ParseUint(c.ChainID, 10, 16)
+		if err != nil {
+			continue
+		}
+		idx := int(c.Time.Sub(from) / time.Hour)
+		if c.Time.Before(from) || idx >= hours {
+			continue
+		}
+		s, ok := series[sdk.ChainID(chainID)]
+		if !ok {
+			s = make([]float64, hours)
+			series[sdk.ChainID(chainID)] = s
+		}
+		s[idx] += float64(c.Count)
+	}
+
+	start := from.Add(time.Duration(hours) * time.Hour)
+	result := make([]TransactionForecast, 0, len(series))
+	for chainID, s := range series {
+		var values []float64
+		switch method {
+		case MovingAverage:
+			values = movingAverage(s, forecastHorizon)
+		default:
+			values = seasonalNaive(s, forecastHorizon)
+		}
+
+		forecast := TransactionForecast{
+			ChainID:     chainID,
+			Method:      method,
+			HistoryDays: days,
+			From:        start,
+			To:          start.Add(forecastHorizon * time.Hour),
+			Hourly:      make([]ForecastPoint, 0, len(values)),
+		}
+		for i, v := range values {
+			forecast.Total += v
+			forecast.Hourly = append(forecast.Hourly, ForecastPoint{
+				Time:  start.Add(time.Duration(i) * time.Hour),
+				Count: v,
+			})
+		}
+		result = append(result, forecast)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ChainID < result[j].ChainID
+	})
+	return result
+}
+
+// movingAverage projects every hour of the horizon as the mean of the last horizon values of s.
+func movingAverage(s []float64, horizon int) []float64 {
+	window := s
+	if len(window) > horizon {
+		window = window[len(window)-horizon:]
+	}
+	var sum float64
+	for _, v := range window {
+		sum += v
+	}
+	var avg float64
+	if len(window) > 0 {
+		avg = sum / float64(len(window))
+	}
+
+	values := make([]float64, horizon)
+	for i := range values {
+		values[i] = avg
+	}
+	return values
+}
+
+// seasonalNaive projects every hour of the horizon as the mean of the values observed at the same
+// position of each season of s, where a season spans horizon values.
+// With a single season it is the classic seasonal naive forecast.
+func seasonalNaive(s []float64, horizon int) []float64 {
+	values := make([]float64, horizon)
+	seasons := len(s) / horizon
+	if seasons == 0 {
+		return values
+	}
+	// skip the oldest values when s is not made of full seasons.
+	offset := len(s) - seasons*horizon
+	for i := range values {
+		var sum float64
+		for season := 0; season < seasons; season++ {
+			sum += s[offset+season*horizon+i]
+		}
+		values[i] = sum / float64(seasons)
+	}
+	return values
+}
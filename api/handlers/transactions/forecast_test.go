@@ -0,0 +1,78 @@
+package transactions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestForecast_seasonalNaive(t *testing.T) {
+	// two days of history, the count of every hour is the hour of the day plus the day offset.
+	var s []float64
+	for day := 0; day < 2; day++ {
+		for hour := 0; hour < 24; hour++ {
+			s = append(s, float64(hour+day*2))
+		}
+	}
+	values := seasonalNaive(s, 24)
+	assert.Len(t, values, 24)
+	for hour, v := range values {
+		assert.Equal(t, float64(hour+1), v)
+	}
+
+	// not enough history for a full season.
+	assert.Equal(t, make([]float64, 24), seasonalNaive(s[:10], 24))
+}
+
+func TestForecast_movingAverage(t *testing.T) {
+	s := make([]float64, 48)
+	for i := 24; i < 48; i++ {
+		s[i] = 6
+	}
+	s[47] = 30
+	values := movingAverage(s, 24)
+	assert.Len(t, values, 24)
+	for _, v := range values {
+		assert.Equal(t, float64(7), v)
+	}
+}
+
+func TestForecast_forecastTransactions(t *testing.T) {
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	counts := []ChainHourlyCount{
+		{Time: from.Add(-time.Hour), ChainID: "2", Count: 100},
+		{Time: from, ChainID: "2", Count: 8},
+		{Time: from.Add(25 * time.Hour), ChainID: "2", Count: 4},
+		{Time: from.Add(30 * time.Hour), ChainID: "1", Count: 12},
+		{Time: from.Add(48 * time.Hour), ChainID: "1", Count: 100},
+		{Time: from.Add(time.Hour), ChainID: "solana", Count: 1},
+	}
+
+	result := forecastTransactions(counts, from, 2, SeasonalNaive)
+	if !assert.Len(t, result, 2) {
+		return
+	}
+
+	solana := result[0]
+	assert.Equal(t, sdk.ChainIDSolana, solana.ChainID)
+	assert.Equal(t, from.Add(48*time.Hour), solana.From)
+	assert.Equal(t, from.Add(72*time.Hour), solana.To)
+	assert.InDelta(t, 6, solana.Total, 1e-9)
+	assert.Equal(t, float64(6), solana.Hourly[6].Count)
+	assert.Equal(t, from.Add(54*time.Hour), solana.Hourly[6].Time)
+
+	ethereum := result[1]
+	assert.Equal(t, sdk.ChainIDEthereum, ethereum.ChainID)
+	assert.InDelta(t, 6, ethereum.Total, 1e-9)
+	assert.Equal(t, float64(4), ethereum.Hourly[0].Count)
+	assert.Equal(t, float64(2), ethereum.Hourly[1].Count)
+
+	result = forecastTransactions(counts, from, 2, MovingAverage)
+	if assert.Len(t, result, 2) {
+		assert.InDelta(t, 12, result[0].Total, 1e-9)
+		assert.Equal(t, float64(0.5), result[0].Hourly[0].Count)
+		assert.InDelta(t, 4, result[1].Total, 1e-9)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -80,12 +81,79 @@ type GlobalTransactionDoc struct {
 	DestinationTx *DestinationTx `bson:"destinationTx" json:"destinationTx"`
 }
 
+// FeeDetail represents the fee paid by a transaction, in the gas token of the chain and in USD.
+type FeeDetail struct {
+	Fee              string            `bson:"fee" json:"fee"`
+	RawFee           map[string]string `bson:"rawFee" json:"rawFee,omitempty"`
+	GasTokenNotional string            `bson:"gasTokenNotional" json:"gasTokenNotional,omitempty"`
+	FeeUSD           string            `bson:"feeUSD" json:"feeUSD,omitempty"`
+}
+
+// TransactionFees represents the fees in USD paid by the origin and destination transactions.
+type TransactionFees struct {
+	OriginUSD      string `json:"originUSD,omitempty"`
+	DestinationUSD string `json:"destinationUSD,omitempty"`
+	TotalUSD       string `json:"totalUSD"`
+}
+
+// Fees returns the fees in USD paid by the transactions of the global transaction,
+// or nil when none of them has a fee in USD.
+func (g *GlobalTransactionDoc) Fees() *TransactionFees {
+	var fees TransactionFees
+	var total decimal.Decimal
+	var found bool
+	if g.OriginTx != nil {
+		if fee, ok := g.OriginTx.FeeDetail.usd(); ok {
+			fees.OriginUSD = fee.String()
+			total = total.Add(fee)
+			found = true
+		}
+	}
+	if g.DestinationTx != nil {
+		if fee, ok := g.DestinationTx.FeeDetail.usd(); ok {
+			fees.DestinationUSD = fee.String()
+			total = total.Add(fee)
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	fees.TotalUSD = total.String()
+	return &fees
+}
+
+func (f *FeeDetail) usd() (decimal.Decimal, bool) {
+	if f == nil || f.FeeUSD == "" {
+		return decimal.Decimal{}, false
+	}
+	fee, err := decimal.NewFromString(f.FeeUSD)
+	if err != nil {
+		return decimal.Decimal{}, false
+	}
+	return fee, true
+}
+
 // OriginTx represents a origin transaction.
 type OriginTx struct {
-	TxHash    string        `bson:"nativeTxHash" json:"txHash"`
-	From      string        `bson:"from" json:"from"`
-	Status    string        `bson:"status" json:"status"`
-	Attribute *AttributeDoc `bson:"attribute" json:"attribute"`
+	TxHash      string         `bson:"nativeTxHash" json:"txHash"`
+	From        string         `bson:"from" json:"from"`
+	Status      string         `bson:"status" json:"status"`
+	BlockNumber uint64         `bson:"blockNumber" json:"blockNumber,omitempty"`
+	Finality    string         `bson:"finality" json:"finality,omitempty"`
+	Attribute   *AttributeDoc  `bson:"attribute" json:"attribute"`
+	FeeDetail   *FeeDetail     `bson:"feeDetail" json:"feeDetail,omitempty"`
+	Reorg       *OriginTxReorg `bson:"reorg" json:"reorg,omitempty"`
+}
+
+// OriginTxReorg flags an origin transaction whose recorded block was removed by a reorg.
+type OriginTxReorg struct {
+	// Status is reincluded or removed.
+	Status string `bson:"status" json:"status"`
+	// BlockNumber and BlockHash are the block recorded before the reorg.
+	BlockNumber uint64    `bson:"blockNumber" json:"blockNumber"`
+	BlockHash   string    `bson:"blockHash" json:"blockHash"`
+	DetectedAt  time.Time `bson:"detectedAt" json:"detectedAt"`
 }
 
 // AttributeDoc represents a custom attribute for a origin transaction.
@@ -105,6 +173,7 @@ type DestinationTx struct {
 	BlockNumber string      `bson:"blockNumber" json:"blockNumber"`
 	Timestamp   *time.Time  `bson:"timestamp" json:"timestamp"`
 	UpdatedAt   *time.Time  `bson:"updatedAt" json:"updatedAt"`
+	FeeDetail   *FeeDetail  `bson:"feeDetail" json:"feeDetail,omitempty"`
 }
 
 // TransactionUpdate represents a transaction document.
@@ -233,8 +302,23 @@ type TransactionDto struct {
 	GlobalTransations      []GlobalTransactionDoc `bson:"globalTransactions"`
 	Payload                map[string]interface{} `bson:"payload"`
 	StandardizedProperties map[string]interface{} `bson:"standardizedProperties"`
+	// Direction and SignedAmount are only set when the transactions are listed by address.
+	Direction    TransferDirection `bson:"direction"`
+	SignedAmount string            `bson:"signedAmount"`
 }
 
+// TransferDirection is the direction of a transaction relative to the queried address.
+type TransferDirection string
+
+const (
+	// DirectionSender is set when the address sent the tokens.
+	DirectionSender TransferDirection = "sender"
+	// DirectionReceiver is set when the address received the tokens.
+	DirectionReceiver TransferDirection = "receiver"
+	// DirectionSelf is set when the address sent the tokens to itself.
+	DirectionSelf TransferDirection = "self"
+)
+
 type ChainActivityTopsQuery struct {
 	SourceChains []sdk.ChainID `json:"source_chain"`
 	TargetChains []sdk.ChainID `json:"target_chain"`
@@ -242,6 +326,8 @@ type ChainActivityTopsQuery struct {
 	From         time.Time     `json:"from"`
 	To           time.Time     `json:"to"`
 	Timespan     Timespan      `json:"timespan"`
+	// Location aligns the days, months and years to its midnight, UTC when nil.
+	Location *time.Location `json:"-"`
 }
 
 type ApplicationActivityQuery struct {
@@ -272,6 +358,8 @@ type TokenSymbolActivityQuery struct {
 	SourceChains []sdk.ChainID
 	TargetChains []sdk.ChainID
 	Timespan     Timespan
+	// Location aligns the days, months and years to its midnight, UTC when nil.
+	Location *time.Location
 }
 
 type TokenVolume struct {
@@ -290,3 +378,68 @@ type TokenSymbolActivityResult struct {
 	EmitterChain        sdk.ChainID `json:"emitter_chain"`
 	DestinationChain    sdk.ChainID `json:"destination_chain"`
 }
+
+// ScorecardsHuman contains the human-readable representation of the USD values of the scorecards.
+type ScorecardsHuman struct {
+	TotalVolume string `json:"total_volume"`
+	Tvl         string `json:"tvl"`
+	Volume24h   string `json:"24h_volume"`
+	Volume7d    string `json:"7d_volume"`
+	Volume30d   string `json:"30d_volume"`
+}
+
+// AssetHuman contains the human-readable representation of the volume of an asset.
+type AssetHuman struct {
+	Volume string `json:"volume"`
+}
+
+// TransactionHuman contains the human-readable representation of the amount and timestamp of a transaction.
+type TransactionHuman struct {
+	UsdAmount string `json:"usdAmount,omitempty"`
+	Timestamp string `json:"timestamp"`
+}
+
+// ForecastMethod is the algorithm used to project the message counts.
+type ForecastMethod string
+
+const (
+	// MovingAverage projects every hour of the horizon as the mean hourly count of the last 24 hours.
+	MovingAverage ForecastMethod = "moving-average"
+	// SeasonalNaive projects every hour of the horizon as the mean count of the same hour of the day
+	// over the history window.
+	SeasonalNaive ForecastMethod = "seasonal-naive"
+)
+
+func (m ForecastMethod) IsValid() bool {
+	return m == MovingAverage || m == SeasonalNaive
+}
+
+type TransactionForecastQuery struct {
+	// Days is the number of days of history used to compute the forecast.
+	Days    int
+	Method  ForecastMethod
+	ChainID *sdk.ChainID
+}
+
+// ChainHourlyCount is the number of messages emitted by a chain in a given hour.
+type ChainHourlyCount struct {
+	Time    time.Time `mapstructure:"_time"`
+	ChainID string    `mapstructure:"chain_id"`
+	Count   uint64    `mapstructure:"_value"`
+}
+
+type ForecastPoint struct {
+	Time  time.Time `json:"time"`
+	Count float64   `json:"count"`
+}
+
+// TransactionForecast contains the projected message counts of a chain for the next 24 hours.
+type TransactionForecast struct {
+	ChainID     sdk.ChainID     `json:"chainId"`
+	Method      ForecastMethod  `json:"method"`
+	HistoryDays int             `json:"historyDays"`
+	From        time.Time       `json:"from"`
+	To          time.Time       `json:"to"`
+	Total       float64         `json:"total"`
+	Hourly      []ForecastPoint `json:"hourly"`
+}
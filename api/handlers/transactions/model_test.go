@@ -0,0 +1,22 @@
+package transactions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlobalTransactionDocFees(t *testing.T) {
+	g := GlobalTransactionDoc{
+		OriginTx:      &OriginTx{FeeDetail: &FeeDetail{Fee: "0.001", FeeUSD: "2.5"}},
+		DestinationTx: &DestinationTx{FeeDetail: &FeeDetail{Fee: "0.0001"}},
+	}
+	fees := g.Fees()
+	assert.Equal(t, &TransactionFees{OriginUSD: "2.5", TotalUSD: "2.5"}, fees)
+
+	g.DestinationTx.FeeDetail.FeeUSD = "0.25"
+	fees = g.Fees()
+	assert.Equal(t, &TransactionFees{OriginUSD: "2.5", DestinationUSD: "0.25", TotalUSD: "2.75"}, fees)
+
+	assert.Nil(t, (&GlobalTransactionDoc{OriginTx: &OriginTx{}}).Fees())
+}
@@ -0,0 +1,95 @@
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const originTxKey = "wormscan:origin-tx"
+
+// unresolvedOriginTx is the value cached for the origin txs that could not be resolved.
+const unresolvedOriginTx = "unresolved"
+
+// OriginTxResolver resolves on demand the origin tx of the transactions not processed yet by the tx-tracker.
+type OriginTxResolver struct {
+	resolve              txtracker.ResolveOriginTxFunc
+	timeout              time.Duration
+	cache                cache.Cache
+	expiration           time.Duration
+	unresolvedExpiration time.Duration
+	logger               *zap.Logger
+}
+
+// NewOriginTxResolver creates a new OriginTxResolver.
+//
+// The resolved origin txs are cached for expiration, and the ones that could not be resolved for unresolvedExpiration.
+func NewOriginTxResolver(resolve txtracker.ResolveOriginTxFunc, timeout time.Duration, cache cache.Cache, expiration, unresolvedExpiration time.Duration, logger *zap.Logger) *OriginTxResolver {
+	return &OriginTxResolver{
+		resolve:              resolve,
+		timeout:              timeout,
+		cache:                cache,
+		expiration:           expiration,
+		unresolvedExpiration: unresolvedExpiration,
+		logger:               logger.With(zap.String("module", "OriginTxResolver")),
+	}
+}
+
+// Resolve returns the origin tx of a transaction or nil if it can not be resolved.
+// The timestamp of the vaa is required to resolve the solana transactions.
+func (r *OriginTxResolver) Resolve(ctx context.Context, chainID vaa.ChainID, txHash string, timestamp *time.Time) *OriginTx {
+	log := r.logger.With(zap.String("chainId", chainID.String()), zap.String("txHash", txHash))
+	key := fmt.Sprintf("%s:%d:%s", originTxKey, chainID, txHash)
+
+	if value, err := r.cache.Get(ctx, key); err == nil {
+		if value == unresolvedOriginTx {
+			return nil
+		}
+		var originTx OriginTx
+		err = json.Unmarshal([]byte(value), &originTx)
+		if err == nil {
+			return &originTx
+		}
+		log.Warn("unmarshal cached origin tx", zap.Error(err))
+	}
+
+	// the resolution is best effort, it must not delay the response more than the configured timeout.
+	resolveCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	resp, err := r.resolve(resolveCtx, chainID, txHash, timestamp)
+	if err != nil {
+		log.Debug("failed to resolve origin tx", zap.Error(err))
+		// the failures are cached for a short time, so each request does not query the rpc providers again.
+		// The request may be cancelled by the client, in that case the tx is resolved on the next request.
+		if ctx.Err() == nil && r.unresolvedExpiration > 0 {
+			if err := r.cache.Set(ctx, key, unresolvedOriginTx, r.unresolvedExpiration); err != nil {
+				log.Warn("saving unresolved origin tx in cache", zap.Error(err))
+			}
+		}
+		return nil
+	}
+
+	originTx := OriginTx{
+		TxHash: resp.NativeTxHash,
+		From:   resp.From,
+		Status: string(domain.SourceTxStatusConfirmed),
+	}
+	if resp.Attribute != nil {
+		value, _ := resp.Attribute.Value.(map[string]any)
+		originTx.Attribute = &AttributeDoc{Type: resp.Attribute.Type, Value: value}
+	}
+
+	if b, err := json.Marshal(originTx); err == nil {
+		if err := r.cache.Set(ctx, key, string(b), r.expiration); err != nil {
+			log.Warn("saving origin tx in cache", zap.Error(err))
+		}
+	}
+	return &originTx
+}
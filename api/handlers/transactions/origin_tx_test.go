@@ -0,0 +1,89 @@
+package transactions_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestOriginTxResolver_Resolve(t *testing.T) {
+
+	resolve := func(ctx context.Context, chainID vaa.ChainID, txHash string, timestamp *time.Time) (*txtracker.ResolveOriginTxResponse, error) {
+		return &txtracker.ResolveOriginTxResponse{
+			From:         "0xsender",
+			NativeTxHash: txHash,
+			Attribute:    &txtracker.OriginTxAttribute{Type: "wrapped", Value: map[string]any{"originChainId": float64(2)}},
+		}, nil
+	}
+	resolver := transactions.NewOriginTxResolver(resolve, time.Second, cache.NewDummyCacheClient(), time.Hour, time.Minute, zap.NewNop())
+
+	originTx := resolver.Resolve(context.Background(), vaa.ChainIDEthereum, "0xcafe", nil)
+	assert.NotNil(t, originTx)
+	assert.Equal(t, "0xsender", originTx.From)
+	assert.Equal(t, "0xcafe", originTx.TxHash)
+	assert.Equal(t, "confirmed", originTx.Status)
+	assert.Equal(t, "wrapped", originTx.Attribute.Type)
+	assert.Equal(t, float64(2), originTx.Attribute.Value["originChainId"])
+}
+
+func TestOriginTxResolver_ResolveTimeout(t *testing.T) {
+
+	resolve := func(ctx context.Context, chainID vaa.ChainID, txHash string, timestamp *time.Time) (*txtracker.ResolveOriginTxResponse, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	resolver := transactions.NewOriginTxResolver(resolve, 10*time.Millisecond, cache.NewDummyCacheClient(), time.Hour, time.Minute, zap.NewNop())
+
+	originTx := resolver.Resolve(context.Background(), vaa.ChainIDEthereum, "0xcafe", nil)
+	assert.Nil(t, originTx)
+}
+
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func (c *memoryCache) Get(_ context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if value, ok := c.values[key]; ok {
+		return value, nil
+	}
+	return "", cache.ErrNotFound
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value interface{}, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = value.(string)
+	return nil
+}
+
+func (c *memoryCache) Delete(_ context.Context, keys ...string) error { return nil }
+
+func (c *memoryCache) Close() error { return nil }
+
+func TestOriginTxResolver_ResolveCachesFailures(t *testing.T) {
+
+	calls := 0
+	timestamp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	resolve := func(ctx context.Context, chainID vaa.ChainID, txHash string, ts *time.Time) (*txtracker.ResolveOriginTxResponse, error) {
+		calls++
+		assert.Equal(t, &timestamp, ts)
+		return nil, errors.New("transaction not found")
+	}
+	resolver := transactions.NewOriginTxResolver(resolve, time.Second, &memoryCache{values: map[string]string{}}, time.Hour, time.Minute, zap.NewNop())
+
+	assert.Nil(t, resolver.Resolve(context.Background(), vaa.ChainIDSolana, "cafe", &timestamp))
+	assert.Nil(t, resolver.Resolve(context.Background(), vaa.ChainIDSolana, "cafe", &timestamp))
+	assert.Equal(t, 1, calls)
+}
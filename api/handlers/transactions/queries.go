@@ -3,6 +3,8 @@ package transactions
 import (
 	"fmt"
 	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
 // queryTemplateVaaCount1d1h is the query used to get the last VAA count and the aggregated VAA count for the last 24 hours by hour.
@@ -106,3 +108,47 @@ func buildTotalTrxVolumeQuery(bucketForever, bucket30Days string, t time.Time) s
 	start := t.Truncate(time.Hour * 24).Format(time.RFC3339Nano)
 	return fmt.Sprintf(queryTemplateTotalTrxVolume, bucketForever, start, bucket30Days)
 }
+
+// truncateTimespan returns the start of the hour, day, month or year of t in loc, UTC when loc is nil.
+func truncateTimespan(t time.Time, ts Timespan, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	switch ts {
+	case Hour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	case Day:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	case Month:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// isUTC returns true if loc is nil or UTC.
+func isUTC(loc *time.Location) bool {
+	return loc == nil || loc.String() == time.UTC.String()
+}
+
+// queryTemplateHourlyMessageCount is the query used to get the number of messages by emitter chain and hour.
+const queryTemplateHourlyMessageCount = `
+from(bucket: "%s")
+  |> range(start: %s, stop: %s)
+  |> filter(fn: (r) => r["_measurement"] == "vaa_count")
+  |> filter(fn: (r) => r["_field"] == "count")%s
+  |> group(columns: ["chain_id"])
+  |> aggregateWindow(every: 1h, fn: count, createEmpty: true, timeSrc: "_start")
+`
+
+// buildHourlyMessageCountQuery returns the hourly message counts of the full hours in [from, to).
+func buildHourlyMessageCountQuery(bucket string, from, to time.Time, chainID *sdk.ChainID) string {
+	var chainFilter string
+	if chainID != nil {
+		chainFilter = fmt.Sprintf("\n  |> filter(fn: (r) => r[\"chain_id\"] == \"%d\")", *chainID)
+	}
+	start := from.Truncate(time.Hour).Format(time.RFC3339Nano)
+	stop := to.Truncate(time.Hour).Format(time.RFC3339Nano)
+	return fmt.Sprintf(queryTemplateHourlyMessageCount, bucket, start, stop, chainFilter)
+}
@@ -131,3 +131,29 @@ union(tables: [current, last])
 	actual := buildTotalTrxVolumeQuery("bucket-forever", "bucket-30days", tm)
 	assert.Equal(t, expected, actual)
 }
+
+func TestQueries_truncateTimespan(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	tm := time.Date(2024, 3, 1, 2, 30, 0, 0, time.UTC)
+	var tests = []struct {
+		ts       Timespan
+		loc      *time.Location
+		expected time.Time
+	}{
+		{ts: Hour, loc: nil, expected: time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)},
+		{ts: Day, loc: nil, expected: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{ts: Month, loc: time.UTC, expected: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{ts: Year, loc: time.UTC, expected: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		// 2024-03-01T02:30Z is 2024-02-29T21:30 in New York.
+		{ts: Hour, loc: newYork, expected: time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC)},
+		{ts: Day, loc: newYork, expected: time.Date(2024, 2, 29, 5, 0, 0, 0, time.UTC)},
+		{ts: Month, loc: newYork, expected: time.Date(2024, 2, 1, 5, 0, 0, 0, time.UTC)},
+		{ts: Year, loc: newYork, expected: time.Date(2024, 1, 1, 5, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		actual := truncateTimespan(tm, tt.ts, tt.loc)
+		assert.True(t, tt.expected.Equal(actual), "%s %v: expected %s, got %s", tt.ts, tt.loc, tt.expected, actual)
+	}
+}
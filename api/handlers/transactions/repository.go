@@ -18,9 +18,12 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/common"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/config"
 	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/flux"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/tvl"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	commonRepo "github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -469,7 +472,7 @@ func (r *Repository) buildChainActivityQuery(q *ChainActivityQuery) string {
 	//today without hours
 	start := time.Now().Truncate(24 * time.Hour).UTC().Format(time.RFC3339)
 	if q.HasAppIDS() {
-		apps := `["` + strings.Join(q.GetAppIDs(), `","`) + `"]`
+		apps := flux.StringArray(q.GetAppIDs())
 		return fmt.Sprintf(queryTemplateChainActivityWithApps, r.bucket24HoursRetention, start, measurement, field, apps)
 	} else {
 		return fmt.Sprintf(queryTemplateChainActivity, r.bucket24HoursRetention, start, measurement, field)
@@ -761,6 +764,27 @@ func (r *Repository) GetTransactionCount(ctx context.Context, q *TransactionCoun
 	return response, nil
 }
 
+// FindHourlyMessageCounts returns the number of messages by emitter chain and hour in [from, to).
+func (r *Repository) FindHourlyMessageCounts(ctx context.Context, from, to time.Time, chainID *sdk.ChainID) ([]ChainHourlyCount, error) {
+	query := buildHourlyMessageCountQuery(r.bucket30DaysRetention, from, to, chainID)
+	result, err := r.queryAPI.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	response := []ChainHourlyCount{}
+	for result.Next() {
+		var row ChainHourlyCount
+		if err := mapstructure.Decode(result.Record().Values(), &row); err != nil {
+			return nil, err
+		}
+		response = append(response, row)
+	}
+	return response, nil
+}
+
 // getTotalPythMessage returns the last sequence for the pyth emitter address
 func (r *Repository) getTotalPythMessage(ctx context.Context) (string, error) {
 	if r.p2pNetwork != config.P2pMainNet {
@@ -791,7 +815,7 @@ func (r *Repository) FindGlobalTransactionByID(ctx context.Context, q *GlobalTra
 
 	// Look up the global transaction
 	globalTransaction, err := r.findGlobalTransactionByID(ctx, q)
-	if err != nil && err != errs.ErrNotFound {
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
 		return nil, fmt.Errorf("failed to find global transaction by id: %w", err)
 	}
 
@@ -896,8 +920,14 @@ type FindTransactionsInput struct {
 	//
 	// If set to true, the results will be sorted by descending timestamp and ID.
 	// If set to false, the results will not be sorted.
-	sort       bool
+	sort bool
+	// appID specifies the application ID of the transactions to be found.
+	//
+	// The pagination is applied to the global transactions of the application.
+	appID      string
 	pagination *pagination.Pagination
+	// excludedEmitters are the emitters whose transactions are excluded from the results.
+	excludedEmitters []*commonRepo.DeniedEmitter
 }
 
 // FindTransactions returns transactions matching a specified search criteria.
@@ -906,6 +936,19 @@ func (r *Repository) FindTransactions(
 	input *FindTransactionsInput,
 ) ([]TransactionDto, error) {
 
+	// Find the IDs of the application transactions
+	var appTxIDs []string
+	if input.appID != "" {
+		var err error
+		appTxIDs, err = r.findTransactionIDsByAppID(ctx, input.appID, input.excludedEmitters, input.pagination)
+		if err != nil {
+			return nil, err
+		}
+		if len(appTxIDs) == 0 {
+			return []TransactionDto{}, nil
+		}
+	}
+
 	// Build the aggregation pipeline
 	var pipeline mongo.Pipeline
 	{
@@ -917,6 +960,17 @@ func (r *Repository) FindTransactions(
 					bson.E{"_id", -1},
 				}},
 			})
+
+			// Start after the cursor of the previous page, the IDs of the application
+			// transactions are already read after it
+			if after := input.pagination.AfterFilter("timestamp", input.pagination.GetSortInt()); after != nil && input.appID == "" {
+				pipeline = append(pipeline, bson.D{{"$match", after}})
+			}
+		}
+
+		// Exclude the transactions of the denied emitters
+		if match := commonRepo.MatchNotDenied(input.excludedEmitters); match != nil {
+			pipeline = append(pipeline, match)
 		}
 
 		// Filter by ID
@@ -926,6 +980,13 @@ func (r *Repository) FindTransactions(
 			})
 		}
 
+		// Filter by application
+		if input.appID != "" {
+			pipeline = append(pipeline, bson.D{
+				{"$match", bson.D{{"_id", bson.D{{"$in", appTxIDs}}}}},
+			})
+		}
+
 		// left outer join on the `transferPrices` collection
 		pipeline = append(pipeline, bson.D{
 			{"$lookup", bson.D{
@@ -984,14 +1045,14 @@ func (r *Repository) FindTransactions(
 		})
 
 		// Skip initial results
-		if input.pagination != nil {
+		if input.pagination != nil && input.appID == "" {
 			pipeline = append(pipeline, bson.D{
 				{"$skip", input.pagination.Skip},
 			})
 		}
 
 		// Limit size of results
-		if input.pagination != nil {
+		if input.pagination != nil && input.appID == "" {
 			pipeline = append(pipeline, bson.D{
 				{"$limit", input.pagination.Limit},
 			})
@@ -1016,6 +1077,48 @@ func (r *Repository) FindTransactions(
 	return documents, nil
 }
 
+// findTransactionIDsByAppID returns a page of the IDs of the transactions of an application.
+//
+// The application IDs are stored in the `globalTransactions` collection by the parser.
+// The transactions of the denied emitters are excluded before paging, so the pages are full.
+func (r *Repository) findTransactionIDsByAppID(
+	ctx context.Context,
+	appID string,
+	excludedEmitters []*commonRepo.DeniedEmitter,
+	pagination *pagination.Pagination,
+) ([]string, error) {
+
+	opts := options.Find().
+		SetProjection(bson.D{{Key: "_id", Value: 1}}).
+		SetSort(bson.D{{Key: "timestamp", Value: pagination.GetSortInt()}, {Key: "_id", Value: -1}}).
+		SetSkip(pagination.Skip).
+		SetLimit(pagination.Limit)
+	filter := bson.D{{Key: "appIds", Value: appID}}
+	filter = append(filter, commonRepo.FilterNotDenied(excludedEmitters)...)
+	if after := pagination.AfterFilter("timestamp", pagination.GetSortInt()); after != nil {
+		filter = append(filter, after...)
+	}
+	cur, err := r.collections.globalTransactions.Find(ctx, filter, opts)
+	if err != nil {
+		r.logger.Error("failed to find global transactions by app id", zap.String("appId", appID), zap.Error(err))
+		return nil, err
+	}
+
+	var docs []struct {
+		ID string `bson:"_id"`
+	}
+	if err := cur.All(ctx, &docs); err != nil {
+		r.logger.Error("failed to decode cursor", zap.Error(err))
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		ids = append(ids, doc.ID)
+	}
+	return ids, nil
+}
+
 // ListTransactionsByAddress returns a sorted list of transactions for a given address.
 //
 // Pagination is implemented using a keyset cursor pattern, based on the (timestamp, ID) pair.
@@ -1048,8 +1151,16 @@ func (r *Repository) ListTransactionsByAddress(
 	}}})
 	pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.D{{Key: "parsedVaa", Value: bson.D{{Key: "$ne", Value: []any{}}}}}}})
 
-	// sort by timestamp
-	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{bson.E{Key: "timestamp", Value: pagination.GetSortInt()}}}})
+	// start after the cursor of the previous page
+	if after := pagination.AfterFilter("timestamp", pagination.GetSortInt()); after != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: after}})
+	}
+
+	// sort by timestamp and id
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{
+		bson.E{Key: "timestamp", Value: pagination.GetSortInt()},
+		bson.E{Key: "_id", Value: -1},
+	}}})
 
 	// Skip initial results
 	pipeline = append(pipeline, bson.D{{Key: "$skip", Value: pagination.Skip}})
@@ -1093,6 +1204,9 @@ func (r *Repository) ListTransactionsByAddress(
 		}},
 	})
 
+	// add the direction of the transfer and the amount signed by the direction
+	pipeline = append(pipeline, transferDirectionStages(address)...)
+
 	// Execute the aggregation pipeline
 	cur, err := r.collections.vaas.Aggregate(ctx, pipeline)
 	if err != nil {
@@ -1111,6 +1225,53 @@ func (r *Repository) ListTransactionsByAddress(
 	return documents, nil
 }
 
+// transferDirectionStages returns the pipeline stages that add the direction of the transactions
+// relative to the address, and the token amount signed by the direction: negative when the address
+// sent the tokens, positive when it received them, and zero when it sent them to itself.
+//
+// The address is matched as given and as lowercase hex, like in the search of the transactions.
+func transferDirectionStages(address string) []bson.D {
+
+	addressHexa := strings.ToLower(address)
+	if !utils.StartsWith0x(address) {
+		addressHexa = "0x" + addressHexa
+	}
+	candidates := bson.A{address, addressHexa}
+
+	from := bson.M{"$ifNull": bson.A{bson.M{"$arrayElemAt": bson.A{"$globalTransactions.originTx.from", 0}}, ""}}
+	to := bson.M{"$ifNull": bson.A{"$standardizedProperties.toAddress", ""}}
+	isSender := bson.M{"$in": bson.A{from, candidates}}
+	isReceiver := bson.M{"$in": bson.A{to, candidates}}
+
+	direction := bson.D{{Key: "$addFields", Value: bson.D{
+		{Key: "direction", Value: bson.M{"$switch": bson.D{
+			{Key: "branches", Value: bson.A{
+				bson.M{"case": bson.M{"$and": bson.A{isSender, isReceiver}}, "then": DirectionSelf},
+				bson.M{"case": isSender, "then": DirectionSender},
+				bson.M{"case": isReceiver, "then": DirectionReceiver},
+			}},
+			{Key: "default", Value: "$$REMOVE"},
+		}}},
+	}}}
+
+	hasAmount := bson.M{"$gt": bson.A{bson.M{"$strLenCP": bson.M{"$ifNull": bson.A{"$tokenAmount", ""}}}, 0}}
+	withDirection := func(d TransferDirection) bson.M {
+		return bson.M{"$and": bson.A{hasAmount, bson.M{"$eq": bson.A{"$direction", d}}}}
+	}
+	signedAmount := bson.D{{Key: "$addFields", Value: bson.D{
+		{Key: "signedAmount", Value: bson.M{"$switch": bson.D{
+			{Key: "branches", Value: bson.A{
+				bson.M{"case": withDirection(DirectionSender), "then": bson.M{"$concat": bson.A{"-", "$tokenAmount"}}},
+				bson.M{"case": withDirection(DirectionReceiver), "then": "$tokenAmount"},
+				bson.M{"case": withDirection(DirectionSelf), "then": "0"},
+			}},
+			{Key: "default", Value: "$$REMOVE"},
+		}}},
+	}}}
+
+	return []bson.D{direction, signedAmount}
+}
+
 func (r *Repository) FindApplicationActivity(ctx *fasthttp.RequestCtx, q ApplicationActivityQuery) ([]ApplicationActivityTotalsResult, []ApplicationActivityResult, error) {
 
 	if q.AppId != "" && q.ExclusiveAppID {
@@ -1198,6 +1359,10 @@ func (r *Repository) FindChainActivityTops(ctx *fasthttp.RequestCtx, q ChainActi
 			return nil, err
 		}
 		parsedTime, errTime := time.Parse(time.RFC3339Nano, row.To)
+		if !isUTC(q.Location) {
+			parsedTime = parsedTime.In(q.Location)
+			row.Time = row.Time.In(q.Location)
+		}
 		if errTime == nil {
 			row.To = parsedTime.Format(time.RFC3339)
 		}
@@ -1268,6 +1433,10 @@ func (r *Repository) FindTokenSymbolActivity(ctx context.Context, payload TokenS
 		}
 		row.EmitterChain = sdk.ChainID(emitterChainID)
 		row.DestinationChain = sdk.ChainID(destChainID)
+		if !isUTC(payload.Location) {
+			row.From = row.From.In(payload.Location)
+			row.To = row.To.In(payload.Location)
+		}
 		response = append(response, row)
 	}
 
@@ -1276,52 +1445,13 @@ func (r *Repository) FindTokenSymbolActivity(ctx context.Context, payload TokenS
 
 func (r *Repository) buildTokenSymbolActivityQuery(q TokenSymbolActivityQuery) string {
 
-	var start, stop string
+	start := flux.Time(truncateTimespan(q.From, q.Timespan, q.Location))
+	stop := flux.Time(truncateTimespan(q.To, q.Timespan, q.Location))
 
-	switch q.Timespan {
-	case Hour:
-		stop = q.To.Truncate(1 * time.Hour).UTC().Format(time.RFC3339)
-		start = q.From.Truncate(1 * time.Hour).UTC().Format(time.RFC3339)
-	case Day:
-		start = q.From.Truncate(24 * time.Hour).UTC().Format(time.RFC3339)
-		stop = q.To.Truncate(24 * time.Hour).UTC().Format(time.RFC3339)
-	case Month:
-		start = time.Date(q.From.Year(), q.From.Month(), 1, 0, 0, 0, 0, q.From.Location()).UTC().Format(time.RFC3339)
-		stop = time.Date(q.To.Year(), q.To.Month(), 1, 0, 0, 0, 0, q.To.Location()).UTC().Format(time.RFC3339)
-	default:
-		start = time.Date(q.From.Year(), 1, 1, 0, 0, 0, 0, q.From.Location()).UTC().Format(time.RFC3339)
-		stop = time.Date(q.To.Year(), 1, 1, 0, 0, 0, 0, q.To.Location()).UTC().Format(time.RFC3339)
-	}
+	filterTargetChain := flux.Filter(flux.ChainIn("destination_chain", q.TargetChains...))
+	filterSourceChain := flux.Filter(flux.ChainIn("emitter_chain", q.SourceChains...))
 
-	filterTargetChain := ""
-	if len(q.TargetChains) > 0 {
-		val := fmt.Sprintf("r.destination_chain == \"%d\"", q.TargetChains[0])
-		buff := ""
-		for _, tc := range q.TargetChains[1:] {
-			buff += fmt.Sprintf(" or r.destination_chain == \"%d\"", tc)
-		}
-		filterTargetChain = fmt.Sprintf("|> filter(fn: (r) => %s%s)", val, buff)
-	}
-
-	filterSourceChain := ""
-	if len(q.SourceChains) > 0 {
-		val := fmt.Sprintf("r.emitter_chain == \"%d\"", q.SourceChains[0])
-		buff := ""
-		for _, tc := range q.SourceChains[1:] {
-			buff += fmt.Sprintf(" or r.emitter_chain == \"%d\"", tc)
-		}
-		filterSourceChain = fmt.Sprintf("|> filter(fn: (r) => %s%s)", val, buff)
-	}
-
-	filterTokenSymbol := ""
-	if len(q.TokenSymbols) > 0 {
-		val := fmt.Sprintf("r.symbol == \"%s\"", q.TokenSymbols[0])
-		buff := ""
-		for _, tc := range q.TokenSymbols[1:] {
-			buff += fmt.Sprintf(" or r.symbol == \"%s\"", tc)
-		}
-		filterTokenSymbol = fmt.Sprintf("|> filter(fn: (r) => %s%s)", val, buff)
-	}
+	filterTokenSymbol := flux.Filter(flux.In("symbol", q.TokenSymbols...))
 
 	query := `
 	import "date"
@@ -1363,51 +1493,26 @@ func (r *Repository) buildTokenSymbolActivityQuery(q TokenSymbolActivityQuery) s
 		|> drop(columns:["_value","_start","_stop","_field"])	
 	`
 
-	return fmt.Sprintf(query, r.bucketInfiniteRetention, start, stop, filterTokenSymbol, filterSourceChain, filterTargetChain, q.Timespan, q.Timespan)
+	query = fmt.Sprintf(query, r.bucketInfiniteRetention, start, stop, filterTokenSymbol, filterSourceChain, filterTargetChain, q.Timespan, q.Timespan)
+	return flux.WithLocation(query, q.Location)
 }
 
 func (r *Repository) buildChainActivityQueryTops(q ChainActivityTopsQuery) string {
 
-	var start, stop string
-
-	switch q.Timespan {
-	case Hour:
-		start = q.From.Truncate(1 * time.Hour).UTC().Format(time.RFC3339)
-		stop = q.To.Truncate(1 * time.Hour).UTC().Format(time.RFC3339)
-	case Day:
-		start = q.From.Truncate(24 * time.Hour).UTC().Format(time.RFC3339)
-		stop = q.To.Truncate(24 * time.Hour).UTC().Format(time.RFC3339)
-	case Month:
-		start = time.Date(q.From.Year(), q.From.Month(), 1, 0, 0, 0, 0, q.From.Location()).UTC().Format(time.RFC3339)
-		stop = time.Date(q.To.Year(), q.To.Month(), 1, 0, 0, 0, 0, q.To.Location()).UTC().Format(time.RFC3339)
-	default:
-		start = time.Date(q.From.Year(), 1, 1, 0, 0, 0, 0, q.From.Location()).UTC().Format(time.RFC3339)
-		stop = time.Date(q.To.Year(), 1, 1, 0, 0, 0, 0, q.To.Location()).UTC().Format(time.RFC3339)
-	}
+	start := flux.Time(truncateTimespan(q.From, q.Timespan, q.Location))
+	stop := flux.Time(truncateTimespan(q.To, q.Timespan, q.Location))
 
-	filterTargetChain := ""
-	if len(q.TargetChains) > 0 {
-		val := fmt.Sprintf("r.destination_chain == \"%d\"", q.TargetChains[0])
-		buff := ""
-		for _, tc := range q.TargetChains[1:] {
-			buff += fmt.Sprintf(" or r.destination_chain == \"%d\"", tc)
-		}
-		filterTargetChain = fmt.Sprintf("|> filter(fn: (r) => %s%s)", val, buff)
-	}
-
-	filterSourceChain := ""
-	if len(q.SourceChains) > 0 {
-		val := fmt.Sprintf("r.emitter_chain == \"%d\"", q.SourceChains[0])
-		buff := ""
-		for _, tc := range q.SourceChains[1:] {
-			buff += fmt.Sprintf(" or r.emitter_chain == \"%d\"", tc)
-		}
-		filterSourceChain = fmt.Sprintf("|> filter(fn: (r) => %s%s)", val, buff)
-	}
+	filterTargetChain := flux.Filter(flux.ChainIn("destination_chain", q.TargetChains...))
+	filterSourceChain := flux.Filter(flux.ChainIn("emitter_chain", q.SourceChains...))
 
 	filterAppId := ""
 	if q.AppId != "" {
-		filterAppId = "|> filter(fn: (r) => r.app_id == \"" + q.AppId + "\")"
+		filterAppId = flux.Filter(flux.Eq("app_id", q.AppId))
+	}
+
+	// the daily aggregates are aligned to UTC, the buckets in other locations are built from the hourly aggregates.
+	if q.Timespan != Hour && !isUTC(q.Location) {
+		return r.buildQueryChainActivityTopsInLocation(q, start, stop, filterSourceChain, filterTargetChain, filterAppId)
 	}
 
 	if len(q.TargetChains) == 0 && q.AppId == "" {
@@ -1530,6 +1635,51 @@ func (r *Repository) buildQueryChainActivityTopsByEmitter(q ChainActivityTopsQue
 
 }
 
+// buildQueryChainActivityTopsInLocation aggregates the hourly chain activity in days, months or years
+// that start at midnight of the location of the query.
+func (r *Repository) buildQueryChainActivityTopsInLocation(q ChainActivityTopsQuery, start, stop, filterSourceChain, filterTargetChain, filterAppId string) string {
+	measurement := "chain_activity_1h"
+	if len(q.TargetChains) == 0 && q.AppId == "" {
+		measurement = "emitter_chain_activity_1h"
+	}
+	query := `
+					import "date"
+					import "join"
+
+					data = from(bucket: "%s")
+					|> range(start: %s,stop: %s)
+					|> filter(fn: (r) => r._measurement == "%s")
+					%s
+					%s
+					%s
+					|> keep(columns:["_time","_field","_value","emitter_chain"])
+
+					vols = data
+						|> filter(fn: (r) => (r._field == "volume" and r._value > 0))
+						|> toUInt()
+						|> group(columns:["emitter_chain"])
+						|> aggregateWindow(every: %s, fn: sum, timeSrc: "_start", createEmpty: false)
+						|> rename(columns: {_value: "volume"})
+
+					counts = data
+						|> filter(fn: (r) => (r._field == "count"))
+						|> toUInt()
+						|> group(columns:["emitter_chain"])
+						|> aggregateWindow(every: %s, fn: sum, timeSrc: "_start", createEmpty: false)
+						|> rename(columns: {_value: "count"})
+
+					join.inner(
+					    left: vols,
+					    right: counts,
+					    on: (l, r) => l._time == r._time and l.emitter_chain == r.emitter_chain,
+					    as: (l, r) => ({_time: l._time, emitter_chain: l.emitter_chain, volume: l.volume, count: r.count, to: string(v: date.add(d: %s, to: l._time))}),
+					)
+					|> group()
+					|> sort(columns:["emitter_chain","_time"],desc:false)`
+	query = fmt.Sprintf(query, r.bucketInfiniteRetention, start, stop, measurement, filterSourceChain, filterTargetChain, filterAppId, q.Timespan, q.Timespan, q.Timespan)
+	return flux.WithLocation(query, q.Location)
+}
+
 func (r *Repository) buildQueryChainActivityHourly(start, stop, filterSourceChain, filterTargetChain, filterAppId string) string {
 	query := `
 					import "date"
@@ -1712,7 +1862,7 @@ func (r *Repository) buildTotalsAppActivityQuery(q ApplicationActivityQuery) str
 
 	filterByAppId := ""
 	if q.AppId != "" && !q.ExclusiveAppID {
-		filterByAppId = fmt.Sprintf("|> filter(fn: (r) => r.app_id == \"TOTAL_%s\")", strings.ToUpper(q.AppId))
+		filterByAppId = flux.Filter(flux.Eq("app_id", "TOTAL_"+strings.ToUpper(q.AppId)))
 	}
 
 	if q.Timespan == Month {
@@ -1783,9 +1933,9 @@ func (r *Repository) buildAppActivityQuery(q ApplicationActivityQuery) string {
 	filterByAppId := ""
 	if q.AppId != "" {
 		if !q.ExclusiveAppID {
-			filterByAppId = fmt.Sprintf("|> filter(fn: (r) => r.app_id_1 == \"%s\" or r.app_id_2 == \"%s\" or r.app_id_3 == \"%s\")", q.AppId, q.AppId, q.AppId)
+			filterByAppId = flux.Filter(flux.Or(flux.Eq("app_id_1", q.AppId), flux.Eq("app_id_2", q.AppId), flux.Eq("app_id_3", q.AppId)))
 		} else {
-			filterByAppId = fmt.Sprintf("|> filter(fn: (r) => r.app_id_1 == \"%s\" and r.app_id_2 == \"none\" and r.app_id_3 == \"none\")", q.AppId)
+			filterByAppId = flux.Filter(flux.And(flux.Eq("app_id_1", q.AppId), flux.Eq("app_id_2", "none"), flux.Eq("app_id_3", "none")))
 		}
 	}
 
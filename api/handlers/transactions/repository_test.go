@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/config"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	commonRepo "github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
@@ -909,3 +911,158 @@ func (m *mockInfluxQueryResult) Record() *query.FluxRecord {
 	args := m.Called()
 	return args.Get(0).(*query.FluxRecord)
 }
+
+func TestFindTransactionIDsByAppID(t *testing.T) {
+
+	m := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer m.Close()
+
+	logger, _ := zap.NewDevelopment()
+
+	m.Run("returns the ids of the global transactions", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "wormhole.globalTransactions", mtest.FirstBatch,
+			bson.D{{"_id", "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/2"}},
+			bson.D{{"_id", "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1"}},
+		))
+
+		repo := &Repository{
+			logger:      logger,
+			collections: repositoryCollections{globalTransactions: mt.Coll},
+		}
+
+		ids, err := repo.findTransactionIDsByAppID(context.Background(), "PORTAL_TOKEN_BRIDGE", nil, pagination.Default())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			"2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/2",
+			"2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1",
+		}, ids)
+
+		cmd := mt.GetStartedEvent().Command
+		assert.Equal(t, "PORTAL_TOKEN_BRIDGE", cmd.Lookup("filter", "appIds").StringValue())
+	})
+
+	m.Run("returns no ids when the application has no transactions", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "wormhole.globalTransactions", mtest.FirstBatch))
+
+		repo := &Repository{
+			logger:      logger,
+			collections: repositoryCollections{globalTransactions: mt.Coll},
+		}
+
+		ids, err := repo.findTransactionIDsByAppID(context.Background(), "UNKNOWN_APP", nil, pagination.Default())
+		assert.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	m.Run("excludes the transactions of the denied emitters", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "wormhole.globalTransactions", mtest.FirstBatch))
+
+		repo := &Repository{
+			logger:      logger,
+			collections: repositoryCollections{globalTransactions: mt.Coll},
+		}
+
+		denied := []*commonRepo.DeniedEmitter{{ID: "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"}}
+		_, err := repo.findTransactionIDsByAppID(context.Background(), "PORTAL_TOKEN_BRIDGE", denied, pagination.Default())
+		assert.NoError(t, err)
+
+		// the denied emitters are excluded by the query, before the page is limited.
+		cmd := mt.GetStartedEvent().Command
+		nin := cmd.Lookup("filter", "_id", "$nin").Array()
+		values, err := nin.Values()
+		assert.NoError(t, err)
+		if assert.Len(t, values, 1) {
+			pattern, _ := values[0].Regex()
+			assert.Equal(t, "^2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/", pattern)
+		}
+	})
+}
+
+func Test_buildQueries_escapeParams(t *testing.T) {
+	repository := &Repository{
+		bucketInfiniteRetention: "wormscan-testenv",
+		bucket30DaysRetention:   "wormscan-30days-testenv",
+	}
+	param := `X") |> drop(columns: ["symbol"]`
+	escaped := `"X\") |> drop(columns: [\"symbol\"]"`
+
+	query := repository.buildTokenSymbolActivityQuery(TokenSymbolActivityQuery{
+		From:         time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+		To:           time.Date(2023, 8, 1, 13, 0, 0, 0, time.UTC),
+		TokenSymbols: []string{param},
+		Timespan:     Hour,
+	})
+	assert.Contains(t, query, `|> filter(fn: (r) => r.symbol == `+escaped+`)`)
+
+	query = repository.buildChainActivityQueryTops(ChainActivityTopsQuery{
+		From:     time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+		To:       time.Date(2023, 8, 1, 13, 0, 0, 0, time.UTC),
+		AppId:    param,
+		Timespan: Hour,
+	})
+	assert.Contains(t, query, `|> filter(fn: (r) => r.app_id == `+escaped+`)`)
+
+	query = repository.buildAppActivityQuery(ApplicationActivityQuery{
+		From:     time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+		To:       time.Date(2023, 8, 1, 13, 0, 0, 0, time.UTC),
+		AppId:    param,
+		Timespan: Hour,
+	})
+	assert.Contains(t, query, `r.app_id_1 == `+escaped)
+}
+
+func Test_buildChainActivityQueryTops_location(t *testing.T) {
+	repository := &Repository{bucketInfiniteRetention: "wormscan-testenv"}
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	q := ChainActivityTopsQuery{
+		From:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Timespan: Day,
+		Location: loc,
+	}
+	query := repository.buildChainActivityQueryTops(q)
+	assert.Contains(t, query, `option location = timezone.location(name: "America/New_York")`)
+	assert.Contains(t, query, "|> range(start: 2023-12-31T05:00:00Z,stop: 2024-02-29T05:00:00Z)")
+	assert.Contains(t, query, `r._measurement == "emitter_chain_activity_1h"`)
+	assert.Contains(t, query, "aggregateWindow(every: 1d, fn: sum")
+
+	q.AppId = "CCTP_WORMHOLE_INTEGRATION"
+	query = repository.buildChainActivityQueryTops(q)
+	assert.Contains(t, query, `r._measurement == "chain_activity_1h"`)
+	assert.Contains(t, query, `|> filter(fn: (r) => r.app_id == "CCTP_WORMHOLE_INTEGRATION")`)
+
+	q.Timespan = Hour
+	query = repository.buildChainActivityQueryTops(q)
+	assert.NotContains(t, query, "timezone")
+}
+
+func Test_transferDirectionStages(t *testing.T) {
+
+	tcs := []struct {
+		address    string
+		candidates []string
+	}{
+		{address: "0xAbC123", candidates: []string{`"0xAbC123"`, `"0xabc123"`}},
+		{address: "AbC123", candidates: []string{`"AbC123"`, `"0xabc123"`}},
+	}
+
+	for _, tc := range tcs {
+		stages := transferDirectionStages(tc.address)
+		assert.Len(t, stages, 2)
+
+		direction, err := bson.MarshalExtJSON(stages[0], false, false)
+		assert.NoError(t, err)
+		for _, candidate := range tc.candidates {
+			assert.Contains(t, string(direction), candidate)
+		}
+		for _, d := range []TransferDirection{DirectionSender, DirectionReceiver, DirectionSelf} {
+			assert.Contains(t, string(direction), `"then":"`+string(d)+`"`)
+		}
+
+		signedAmount, err := bson.MarshalExtJSON(stages[1], false, false)
+		assert.NoError(t, err)
+		assert.Contains(t, string(signedAmount), `"$concat":["-","$tokenAmount"]`)
+	}
+}
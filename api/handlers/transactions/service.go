@@ -2,14 +2,16 @@ package transactions
 
 import (
 	"context"
-	errors "errors"
 	"fmt"
 	"github.com/valyala/fasthttp"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/cacheable"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/denylist"
 	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/format"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
@@ -22,10 +24,12 @@ import (
 type Service struct {
 	repo              repository
 	cache             cache.Cache
-	expiration        time.Duration
+	expiration        atomic.Int64
 	supportedChainIDs map[vaa.ChainID]string
 	tokenProvider     *domain.TokenProvider
 	metrics           metrics.Metrics
+	originTxResolver  *OriginTxResolver
+	denyList          *denylist.Service
 	logger            *zap.Logger
 }
 
@@ -43,6 +47,7 @@ type repository interface {
 	FindTokensVolume(ctx context.Context) ([]TokenVolume, error)
 	FindTokenSymbolActivity(ctx context.Context, payload TokenSymbolActivityQuery) ([]TokenSymbolActivityResult, error)
 	GetTransactionCount(ctx context.Context, q *TransactionCountQuery) ([]TransactionCountResult, error)
+	FindHourlyMessageCounts(ctx context.Context, from, to time.Time, chainID *vaa.ChainID) ([]ChainHourlyCount, error)
 }
 
 const (
@@ -52,27 +57,65 @@ const (
 	topChainPairsByNumTransfersKey = "wormscan:top-chain-pairs-by-num-transfers"
 	chainActivityKey               = "wormscan:chain-activity"
 	chainActivityTopsKey           = "wormscan:chain-activity-tops"
+	transactionForecastKey         = "wormscan:transaction-forecast"
 )
 
 // NewService create a new Service.
 func NewService(repo repository, cache cache.Cache, expiration time.Duration, tokenProvider *domain.TokenProvider, metrics metrics.Metrics, logger *zap.Logger) *Service {
 	supportedChainIDs := domain.GetSupportedChainIDs()
-	return &Service{repo: repo, supportedChainIDs: supportedChainIDs,
-		cache: cache, expiration: expiration, tokenProvider: tokenProvider, metrics: metrics,
+	s := &Service{repo: repo, supportedChainIDs: supportedChainIDs,
+		cache: cache, tokenProvider: tokenProvider, metrics: metrics,
 		logger: logger.With(zap.String("module", "TransactionService"))}
+	s.SetCacheExpiration(expiration)
+	return s
+}
+
+// SetCacheExpiration changes the expiration of the values cached by the service.
+func (s *Service) SetCacheExpiration(expiration time.Duration) {
+	s.expiration.Store(int64(expiration))
+}
+
+func (s *Service) cacheExpiration() time.Duration {
+	return time.Duration(s.expiration.Load())
+}
+
+// SetOriginTxResolver enables the on-demand resolution of the origin tx of the transactions
+// that have not been processed yet by the tx-tracker.
+func (s *Service) SetOriginTxResolver(resolver *OriginTxResolver) {
+	s.originTxResolver = resolver
 }
 
 // GetTransactionCount get the last transactions.
 func (s *Service) GetTransactionCount(ctx context.Context, q *TransactionCountQuery) ([]TransactionCountResult, error) {
 	key := fmt.Sprintf("%s:%s:%s:%v", lastTxsKey, q.TimeSpan, q.SampleRate, q.CumulativeSum)
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
 		func() ([]TransactionCountResult, error) {
 			return s.repo.GetTransactionCount(ctx, q)
 		})
 }
 
+// GetTransactionForecast returns the projected message counts by chain for the next 24 hours,
+// computed from the hourly message counts of the last q.Days days.
+func (s *Service) GetTransactionForecast(ctx context.Context, q *TransactionForecastQuery) ([]TransactionForecast, error) {
+	var chain string
+	if q.ChainID != nil {
+		chain = q.ChainID.String()
+	}
+	key := fmt.Sprintf("%s:%d:%s:%s", transactionForecastKey, q.Days, q.Method, chain)
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
+		func() ([]TransactionForecast, error) {
+			to := time.Now().UTC().Truncate(time.Hour)
+			from := to.Add(-time.Duration(q.Days) * 24 * time.Hour)
+			counts, err := s.repo.FindHourlyMessageCounts(ctx, from, to, q.ChainID)
+			if err != nil {
+				return nil, err
+			}
+			return forecastTransactions(counts, from, q.Days, q.Method), nil
+		})
+}
+
 func (s *Service) GetScorecards(ctx context.Context) (*Scorecards, error) {
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, scorecardsKey, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), scorecardsKey, s.metrics,
 		func() (*Scorecards, error) {
 			return s.repo.GetScorecards(ctx)
 		})
@@ -83,7 +126,7 @@ func (s *Service) GetTopAssets(ctx context.Context, timeSpan *TopStatisticsTimeS
 	if timeSpan != nil {
 		key = fmt.Sprintf("%s:%s", key, *timeSpan)
 	}
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
 		func() ([]AssetDTO, error) {
 			return s.repo.GetTopAssets(ctx, timeSpan)
 		})
@@ -94,7 +137,7 @@ func (s *Service) GetTopChainPairs(ctx context.Context, timeSpan *TopStatisticsT
 	if timeSpan != nil {
 		key = fmt.Sprintf("%s:%s", key, *timeSpan)
 	}
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
 		func() ([]ChainPairDTO, error) {
 			return s.repo.GetTopChainPairs(ctx, timeSpan)
 		})
@@ -103,7 +146,7 @@ func (s *Service) GetTopChainPairs(ctx context.Context, timeSpan *TopStatisticsT
 // GetChainActivity get chain activity.
 func (s *Service) GetChainActivity(ctx context.Context, q *ChainActivityQuery) ([]ChainActivityResult, error) {
 	key := fmt.Sprintf("%s:%s:%v:%s", chainActivityKey, q.TimeSpan, q.IsNotional, strings.Join(q.GetAppIDs(), ","))
-	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
+	return cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
 		func() ([]ChainActivityResult, error) {
 			return s.repo.FindChainActivity(ctx, q)
 		})
@@ -111,7 +154,7 @@ func (s *Service) GetChainActivity(ctx context.Context, q *ChainActivityQuery) (
 
 func (s *Service) GetTokensByVolume(ctx context.Context, limit int) ([]TokenVolume, error) {
 	key := "wormscan:tokens-by-volume"
-	value, err := cacheable.GetOrLoad(ctx, s.logger, s.cache, s.expiration, key, s.metrics,
+	value, err := cacheable.GetOrLoad(ctx, s.logger, s.cache, s.cacheExpiration(), key, s.metrics,
 		func() ([]TokenVolume, error) {
 			return s.repo.FindTokensVolume(ctx)
 		})
@@ -150,15 +193,26 @@ func (s *Service) GetTokenByChainAndAddress(ctx context.Context, chainID vaa.Cha
 	}, nil
 }
 
+// SetEmitterDenyList excludes the transactions of the denied emitters from the latest transactions.
+func (s *Service) SetEmitterDenyList(denyList *denylist.Service) {
+	s.denyList = denyList
+}
+
+// ListTransactions returns the latest transactions, filtered by application when appID is not empty.
 func (s *Service) ListTransactions(
 	ctx context.Context,
+	appID string,
 	pagination *pagination.Pagination,
 ) ([]TransactionDto, error) {
 
 	input := FindTransactionsInput{
 		sort:       true,
+		appID:      appID,
 		pagination: pagination,
 	}
+	if s.denyList != nil {
+		input.excludedEmitters = s.denyList.Excluded(ctx)
+	}
 	return s.repo.FindTransactions(ctx, &input)
 }
 
@@ -189,9 +243,24 @@ func (s *Service) GetTransactionByID(
 	if len(output) == 0 {
 		return nil, errs.ErrNotFound
 	}
+	tx := &output[0]
+
+	// very recent transactions may not have been processed by the tx-tracker yet.
+	if s.originTxResolver != nil && !hasOriginTx(tx) && tx.TxHash != "" {
+		if originTx := s.originTxResolver.Resolve(ctx, tx.EmitterChain, tx.TxHash, &tx.Timestamp); originTx != nil {
+			if len(tx.GlobalTransations) == 0 {
+				tx.GlobalTransations = []GlobalTransactionDoc{{ID: tx.ID}}
+			}
+			tx.GlobalTransations[0].OriginTx = originTx
+		}
+	}
 
 	// Return matching document
-	return &output[0], nil
+	return tx, nil
+}
+
+func hasOriginTx(tx *TransactionDto) bool {
+	return len(tx.GlobalTransations) > 0 && tx.GlobalTransations[0].OriginTx != nil
 }
 
 func (s *Service) GetTokenProvider() *domain.TokenProvider {
@@ -203,36 +272,36 @@ func (s *Service) GetChainActivityTops(ctx *fasthttp.RequestCtx, q ChainActivity
 	timeDuration := q.To.Sub(q.From)
 
 	if q.Timespan == Hour && timeDuration > 15*24*time.Hour {
-		return nil, errors.New("time range is too large for hourly data. Max time range allowed: 15 days")
+		return nil, errs.NewInvalidParam("time range is too large for hourly data. Max time range allowed: 15 days")
 	}
 
 	if q.Timespan == Day {
 		if timeDuration < 24*time.Hour {
-			return nil, errors.New("time range is too small for daily data. Min time range allowed: 2 day")
+			return nil, errs.NewInvalidParam("time range is too small for daily data. Min time range allowed: 2 day")
 		}
 
 		if timeDuration > 365*24*time.Hour {
-			return nil, errors.New("time range is too large for daily data. Max time range allowed: 1 year")
+			return nil, errs.NewInvalidParam("time range is too large for daily data. Max time range allowed: 1 year")
 		}
 	}
 
 	if q.Timespan == Month {
 		if timeDuration < 30*24*time.Hour {
-			return nil, errors.New("time range is too small for monthly data. Min time range allowed: 60 days")
+			return nil, errs.NewInvalidParam("time range is too small for monthly data. Min time range allowed: 60 days")
 		}
 
 		if timeDuration > 10*365*24*time.Hour {
-			return nil, errors.New("time range is too large for monthly data. Max time range allowed: 1 year")
+			return nil, errs.NewInvalidParam("time range is too large for monthly data. Max time range allowed: 1 year")
 		}
 	}
 
 	if q.Timespan == Year {
 		if timeDuration < 365*24*time.Hour {
-			return nil, errors.New("time range is too small for yearly data. Min time range allowed: 1 year")
+			return nil, errs.NewInvalidParam("time range is too small for yearly data. Min time range allowed: 1 year")
 		}
 
 		if timeDuration > 10*365*24*time.Hour {
-			return nil, errors.New("time range is too large for yearly data. Max time range allowed: 10 year")
+			return nil, errs.NewInvalidParam("time range is too large for yearly data. Max time range allowed: 10 year")
 		}
 	}
 
@@ -461,3 +530,27 @@ type TokenSymbolPerChainPairData struct {
 type TokenSymbolActivityResponse struct {
 	Tokens []TokenSymbolActivity `json:"tokens"`
 }
+
+// HumanizeScorecards returns the human-readable representation of the scorecards.
+func (s *Service) HumanizeScorecards(scorecards *Scorecards) *ScorecardsHuman {
+	return &ScorecardsHuman{
+		TotalVolume: format.USDFromString(scorecards.TotalTxVolume),
+		Tvl:         format.USDFromString(scorecards.Tvl),
+		Volume24h:   format.USDFromString(scorecards.Volume24h),
+		Volume7d:    format.USDFromString(scorecards.Volume7d),
+		Volume30d:   format.USDFromString(scorecards.Volume30d),
+	}
+}
+
+// HumanizeAsset returns the human-readable representation of an asset.
+func (s *Service) HumanizeAsset(asset *AssetDTO) *AssetHuman {
+	return &AssetHuman{Volume: format.USDFromString(asset.Volume)}
+}
+
+// HumanizeTransaction returns the human-readable representation of a transaction.
+func (s *Service) HumanizeTransaction(tx *TransactionDto) *TransactionHuman {
+	return &TransactionHuman{
+		UsdAmount: format.USDFromString(tx.UsdAmount),
+		Timestamp: format.RelativeTime(tx.Timestamp, time.Now()),
+	}
+}
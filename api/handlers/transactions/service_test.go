@@ -2,11 +2,13 @@ package transactions_test
 
 import (
 	"context"
+	"errors"
 	"github.com/valyala/fasthttp"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"testing"
 	"time"
@@ -214,6 +216,59 @@ func TestService_GetTokenSymbolActivity(t *testing.T) {
 	}
 }
 
+func TestService_GetTransactionForecast(t *testing.T) {
+
+	// constant hourly counts covering the whole history window, regardless of the current hour.
+	now := time.Now().UTC().Truncate(time.Hour)
+	var counts []transactions.ChainHourlyCount
+	for tm := now.Add(-9 * 24 * time.Hour); tm.Before(now.Add(24 * time.Hour)); tm = tm.Add(time.Hour) {
+		counts = append(counts,
+			transactions.ChainHourlyCount{Time: tm, ChainID: "2", Count: 10},
+			transactions.ChainHourlyCount{Time: tm, ChainID: "1", Count: 4},
+			transactions.ChainHourlyCount{Time: tm, ChainID: "invalid", Count: 1})
+	}
+
+	tests := []struct {
+		name   string
+		method transactions.ForecastMethod
+	}{
+		{name: "Seasonal naive", method: transactions.SeasonalNaive},
+		{name: "Moving average", method: transactions.MovingAverage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mockRepository)
+			mockRepo.On("FindHourlyMessageCounts", mock.Anything, mock.Anything, mock.Anything, (*sdk.ChainID)(nil)).Return(counts, nil)
+			svc := transactions.NewService(mockRepo, cache.NewDummyCacheClient(), 0, nil, metrics.NewNoOpMetrics(), zap.NewNop())
+
+			result, err := svc.GetTransactionForecast(context.Background(), &transactions.TransactionForecastQuery{Days: 7, Method: tt.method})
+			assert.NoError(t, err)
+			if assert.Len(t, result, 2) {
+				assert.Equal(t, sdk.ChainIDSolana, result[0].ChainID)
+				assert.Equal(t, float64(4*24), result[0].Total)
+				assert.Equal(t, sdk.ChainIDEthereum, result[1].ChainID)
+				assert.Equal(t, float64(10*24), result[1].Total)
+				for _, f := range result {
+					assert.Equal(t, tt.method, f.Method)
+					assert.Len(t, f.Hourly, 24)
+					assert.Equal(t, f.From.Add(24*time.Hour), f.To)
+				}
+			}
+		})
+	}
+
+	t.Run("Repository error", func(t *testing.T) {
+		mockRepo := new(mockRepository)
+		mockRepo.On("FindHourlyMessageCounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]transactions.ChainHourlyCount(nil), errors.New("influx error"))
+		svc := transactions.NewService(mockRepo, cache.NewDummyCacheClient(), 0, nil, metrics.NewNoOpMetrics(), zap.NewNop())
+
+		chainID := sdk.ChainIDEthereum
+		_, err := svc.GetTransactionForecast(context.Background(), &transactions.TransactionForecastQuery{Days: 7, Method: transactions.SeasonalNaive, ChainID: &chainID})
+		assert.Error(t, err)
+	})
+}
+
 type mockRepository struct {
 	mock.Mock
 }
@@ -277,3 +332,8 @@ func (m *mockRepository) FindTokenSymbolActivity(ctx context.Context, payload tr
 	args := m.Called(ctx, payload)
 	return args.Get(0).([]transactions.TokenSymbolActivityResult), args.Error(1)
 }
+
+func (m *mockRepository) FindHourlyMessageCounts(ctx context.Context, from, to time.Time, chainID *sdk.ChainID) ([]transactions.ChainHourlyCount, error) {
+	args := m.Called(ctx, from, to, chainID)
+	return args.Get(0).([]transactions.ChainHourlyCount), args.Error(1)
+}
@@ -0,0 +1,47 @@
+package vaa
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errInvalidCursor is returned when a recent vaas cursor cannot be decoded.
+var errInvalidCursor = errors.New("invalid cursor")
+
+// RecentCursor is the position of a client in the recent vaas, the vaas are sorted by
+// indexing time and id, so the position is the indexing time and the id of the last vaa read.
+type RecentCursor struct {
+	IndexedAt time.Time
+	ID        string
+}
+
+// String encodes the cursor as an opaque string.
+func (c RecentCursor) String() string {
+	raw := strconv.FormatInt(c.IndexedAt.UnixNano(), 10) + ":" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseRecentSince parses the `since` parameter of the recent vaas, that is either
+// a RFC3339 timestamp or a cursor returned by a previous request.
+func ParseRecentSince(since string) (RecentCursor, error) {
+	if t, err := time.Parse(time.RFC3339Nano, since); err == nil {
+		return RecentCursor{IndexedAt: t}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(since)
+	if err != nil {
+		return RecentCursor{}, errInvalidCursor
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return RecentCursor{}, errInvalidCursor
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return RecentCursor{}, errInvalidCursor
+	}
+	return RecentCursor{IndexedAt: time.Unix(0, n), ID: id}, nil
+}
@@ -0,0 +1,28 @@
+package vaa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRecentSince(t *testing.T) {
+	indexedAt := time.Date(2024, 5, 1, 10, 30, 0, 123000000, time.UTC)
+
+	cursor, err := ParseRecentSince("2024-05-01T10:30:00.123Z")
+	assert.NoError(t, err)
+	assert.True(t, indexedAt.Equal(cursor.IndexedAt))
+	assert.Empty(t, cursor.ID)
+
+	want := RecentCursor{IndexedAt: indexedAt, ID: "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1"}
+	cursor, err = ParseRecentSince(want.String())
+	assert.NoError(t, err)
+	assert.True(t, want.IndexedAt.Equal(cursor.IndexedAt))
+	assert.Equal(t, want.ID, cursor.ID)
+
+	for _, since := range []string{"", "yesterday", "bm90LWEtY3Vyc29y", "eDox"} {
+		_, err := ParseRecentSince(since)
+		assert.ErrorIs(t, err, errInvalidCursor, since)
+	}
+}
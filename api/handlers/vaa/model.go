@@ -1,10 +1,13 @@
 package vaa
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"strconv"
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaapayload"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -15,23 +18,32 @@ const (
 
 // VaaDoc defines the JSON model for VAA objects in the REST API.
 type VaaDoc struct {
-	ID                string      `bson:"_id" json:"id"`
-	Version           uint8       `bson:"version" json:"version"`
-	EmitterChain      vaa.ChainID `bson:"emitterChain" json:"emitterChain"`
-	EmitterAddr       string      `bson:"emitterAddr" json:"emitterAddr"`
-	EmitterNativeAddr string      `json:"emitterNativeAddr,omitempty"`
-	Sequence          string      `bson:"sequence" json:"-"`
-	GuardianSetIndex  uint32      `bson:"guardianSetIndex" json:"guardianSetIndex"`
-	Vaa               []byte      `bson:"vaas" json:"vaa"`
-	Timestamp         *time.Time  `bson:"timestamp" json:"timestamp"`
-	UpdatedAt         *time.Time  `bson:"updatedAt" json:"updatedAt"`
-	IndexedAt         *time.Time  `bson:"indexedAt" json:"indexedAt"`
+	ID                string                     `bson:"_id" json:"id"`
+	Version           uint8                      `bson:"version" json:"version"`
+	EmitterChain      vaa.ChainID                `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddr       string                     `bson:"emitterAddr" json:"emitterAddr"`
+	EmitterNativeAddr string                     `json:"emitterNativeAddr,omitempty"`
+	Sequence          string                     `bson:"sequence" json:"-"`
+	GuardianSetIndex  uint32                     `bson:"guardianSetIndex" json:"guardianSetIndex"`
+	Vaa               repository.CompressedBytes `bson:"vaas" json:"vaa"`
+	Timestamp         *time.Time                 `bson:"timestamp" json:"timestamp"`
+	UpdatedAt         *time.Time                 `bson:"updatedAt" json:"updatedAt"`
+	IndexedAt         *time.Time                 `bson:"indexedAt" json:"indexedAt"`
 	// TxHash is an extension field - it is not present in the guardian API.
 	TxHash *string `bson:"txHash" json:"txHash,omitempty"`
 	// AppId is an extension field - it is not present in the guardian API.
 	AppId string `bson:"appId" json:"appId,omitempty"`
 	// Payload is an extension field - it is not present in the guardian API.
 	Payload map[string]interface{} `bson:"payload" json:"payload,omitempty"`
+	// DecodedPayload is an extension field - it is not present in the guardian API.
+	//
+	// It is the payload decoded with the schema registered by a third-party protocol for the emitter.
+	DecodedPayload map[string]interface{} `bson:"decodedPayload" json:"decodedPayload,omitempty"`
+
+	// SignersBitmap is an internal field, the bit i is set when the guardian i of the guardian set signed the vaa.
+	//
+	// It is not intended to be accessed by consumers of this package.
+	SignersBitmap *uint64 `bson:"signersBitmap" json:"-"`
 
 	// NativeTxHash is an internal field.
 	//
@@ -39,6 +51,41 @@ type VaaDoc struct {
 	NativeTxHash string `bson:"nativeTxHash" json:"-"`
 	Digest       string `bson:"digest" json:"digest"`
 	IsDuplicated bool   `bson:"isDuplicated" json:"isDuplicated"`
+	// Conflict is set when a vaa with the same id and a different digest was received.
+	Conflict bool `bson:"conflict" json:"conflict,omitempty"`
+	// ArchivedAt is an internal field, it is set when the raw vaa was copied to the archive.
+	//
+	// It is not intended to be accessed by consumers of this package.
+	ArchivedAt *time.Time `bson:"archivedAt" json:"-"`
+
+	// SignaturesCount is an extension field - it is not present in the guardian API.
+	SignaturesCount *int `bson:"-" json:"signaturesCount,omitempty"`
+	// Quorum is an extension field - it is not present in the guardian API.
+	Quorum *int `bson:"-" json:"quorum,omitempty"`
+	// Observations is an extension field - it is not present in the guardian API.
+	Observations []*ObservationSummary `bson:"-" json:"observations,omitempty"`
+	// Signers is an extension field - it is not present in the guardian API.
+	//
+	// It is the list of guardians whose signature is included in the vaa.
+	Signers []*Signer `bson:"-" json:"signers,omitempty"`
+	// EmitterType is an extension field - it is not present in the guardian API.
+	//
+	// It is "coreProtocol" for the governance and registered emitters and "arbitrary" otherwise.
+	EmitterType string `bson:"-" json:"emitterType,omitempty"`
+}
+
+// ObservationSummary represents a guardian observation of a VAA.
+type ObservationSummary struct {
+	GuardianAddr string     `bson:"guardianAddr" json:"guardianAddr"`
+	Hash         []byte     `bson:"hash" json:"hash"`
+	IndexedAt    *time.Time `bson:"indexedAt" json:"indexedAt"`
+}
+
+// Signer represents a guardian that signed a VAA.
+type Signer struct {
+	Index   uint8  `json:"index"`
+	Address string `json:"address"`
+	Name    string `json:"name,omitempty"`
 }
 
 // MarshalJSON interface implementation.
@@ -63,3 +110,53 @@ type VaaStats struct {
 	ChainID vaa.ChainID `bson:"_id" json:"chainId"`
 	Count   int64       `bson:"count" json:"count"`
 }
+
+// ParsedVaa is a raw vaa decoded by the API.
+type ParsedVaa struct {
+	Header  VaaHeader           `json:"header"`
+	Payload *vaapayload.Payload `json:"payload"`
+	// ParsedPayload is the payload parsed by the vaa payload parser, it is not set when the parser fails
+	// or does not support the emitter of the vaa.
+	ParsedPayload any `json:"parsedPayload,omitempty"`
+}
+
+// VaaHeader is the header of a vaa and the identifiers derived from it.
+type VaaHeader struct {
+	ID               string          `json:"id"`
+	Version          uint8           `json:"version"`
+	GuardianSetIndex uint32          `json:"guardianSetIndex"`
+	Signatures       []*VaaSignature `json:"signatures"`
+	Timestamp        time.Time       `json:"timestamp"`
+	Nonce            uint32          `json:"nonce"`
+	EmitterChain     vaa.ChainID     `json:"emitterChain"`
+	EmitterAddress   string          `json:"emitterAddress"`
+	Sequence         uint64          `json:"sequence"`
+	ConsistencyLevel uint8           `json:"consistencyLevel"`
+	Digest           string          `json:"digest"`
+}
+
+// VaaSignature is the signature of a guardian included in a vaa.
+type VaaSignature struct {
+	Index     uint8  `json:"index"`
+	Signature string `json:"signature"`
+}
+
+func newVaaHeader(v *vaa.VAA) VaaHeader {
+	signatures := make([]*VaaSignature, 0, len(v.Signatures))
+	for _, s := range v.Signatures {
+		signatures = append(signatures, &VaaSignature{Index: s.Index, Signature: hex.EncodeToString(s.Signature[:])})
+	}
+	return VaaHeader{
+		ID:               v.MessageID(),
+		Version:          v.Version,
+		GuardianSetIndex: v.GuardianSetIndex,
+		Signatures:       signatures,
+		Timestamp:        v.Timestamp,
+		Nonce:            v.Nonce,
+		EmitterChain:     v.EmitterChain,
+		EmitterAddress:   v.EmitterAddress.String(),
+		Sequence:         v.Sequence,
+		ConsistencyLevel: v.ConsistencyLevel,
+		Digest:           v.HexDigest(),
+	}
+}
@@ -0,0 +1,336 @@
+package vaa
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/types"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// vaaColumns are the columns of the vaas and duplicate_vaas tables, except the id.
+const vaaColumns = `v.version, v.emitter_chain, v.emitter_addr, v.sequence, v.guardian_set_index, v.vaa,
+	v.timestamp, v.updated_at, v.indexed_at, v.tx_hash, v.digest, v.conflict`
+
+// PostgresRepository is a Store implementation on PostgreSQL.
+type PostgresRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewPostgresRepository create a new PostgresRepository.
+func NewPostgresRepository(db *sql.DB, logger *zap.Logger) *PostgresRepository {
+	return &PostgresRepository{
+		db:     db,
+		logger: logger.With(zap.String("module", "VaaPostgresRepository")),
+	}
+}
+
+// FindVaasByTxHashWorkaround searches the database for VAAs that match a given transaction hash.
+//
+// As in the mongodb repository, the transaction hash is looked up first in the `global_transactions` table
+// and then in the `vaas` table.
+func (r *PostgresRepository) FindVaasByTxHashWorkaround(ctx context.Context, query *VaaQuery) ([]*VaaDoc, error) {
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id FROM global_transactions
+		WHERE native_tx_hash = ANY($1) OR origin_tx_hash = ANY($1)`,
+		pq.Array([]string{query.txHash, "0x" + query.txHash}))
+	if err != nil {
+		return nil, r.queryError(ctx, "failed to find globalTransactions by TxHash", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, r.queryError(ctx, "failed to scan globalTransactions", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.queryError(ctx, "failed to find globalTransactions by TxHash", err)
+	}
+
+	// If no rows were found, look up the transaction hash in the `vaas` table instead.
+	if len(ids) == 0 {
+		return r.FindVaas(ctx, query)
+	}
+
+	// Disable txHash filter, but keep all the other filters.
+	q := *query
+	q.SetIDs(ids)
+	q.txHash = ""
+	return r.FindVaas(ctx, &q)
+}
+
+// FindVaasByEmitterAndToChain searches the database for VAAs that match a given emitter chain, address and toChain.
+func (r *PostgresRepository) FindVaasByEmitterAndToChain(ctx context.Context, query *VaaQuery, toChain sdk.ChainID) ([]*VaaDoc, error) {
+	return r.findVaas(ctx, query, &toChain)
+}
+
+// FindVaas searches the database for VAAs matching the given filters.
+func (r *PostgresRepository) FindVaas(ctx context.Context, q *VaaQuery) ([]*VaaDoc, error) {
+	return r.findVaas(ctx, q, nil)
+}
+
+func (r *PostgresRepository) findVaas(ctx context.Context, q *VaaQuery, toChain *sdk.ChainID) ([]*VaaDoc, error) {
+
+	// build the query based on input parameters
+	var conditions []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if len(q.ids) > 0 {
+		conditions = append(conditions, "v.id = ANY("+arg(pq.Array(q.ids))+")")
+	}
+	if q.chainId != 0 {
+		conditions = append(conditions, "v.emitter_chain = "+arg(int(q.chainId)))
+	}
+	if q.emitter != "" {
+		conditions = append(conditions, "v.emitter_addr = "+arg(q.emitter))
+	}
+	if q.sequence != "" {
+		conditions = append(conditions, "v.sequence = "+arg(q.sequence))
+	}
+	if q.txHash != "" {
+		conditions = append(conditions, "v.tx_hash = "+arg(q.txHash))
+	}
+	if q.appId != "" {
+		conditions = append(conditions, arg(q.appId)+" = ANY(p.app_ids)")
+	}
+	if toChain != nil {
+		conditions = append(conditions, "p.raw_to_chain = "+arg(int(*toChain)))
+	}
+	var where string
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	order := "DESC"
+	if q.GetSortInt() == 1 {
+		order = "ASC"
+	}
+	table := "vaas"
+	if q.chainId == ChainIDPythNet {
+		table = "vaas_pythnet"
+	}
+
+	// left outer join on the `parsed_vaa` and `global_transactions` tables
+	query := fmt.Sprintf(`SELECT v.id, %s, p.parsed_payload, COALESCE(p.app_ids[1], ''), COALESCE(g.native_tx_hash, '')
+		FROM %s v
+		LEFT JOIN parsed_vaa p ON p.id = v.id
+		LEFT JOIN global_transactions g ON g.id = v.id
+		%s
+		ORDER BY v.timestamp %s
+		OFFSET %s LIMIT %s`, vaaColumns, table, where, order, arg(q.Skip), arg(q.Limit))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get vaa with payload", err, zap.Any("q", q))
+	}
+	defer rows.Close()
+
+	vaas := make([]*VaaDoc, 0)
+	for rows.Next() {
+		var vaa VaaDoc
+		var payload []byte
+		dest := append([]any{&vaa.ID}, vaaFields(&vaa)...)
+		dest = append(dest, &payload, &vaa.AppId, &vaa.NativeTxHash)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, r.queryError(ctx, "failed to scan vaa", err)
+		}
+		// If the payload field was not requested, it is not decoded.
+		if payload != nil && (q.includeParsedPayload || q.appId != "") {
+			if err := json.Unmarshal(payload, &vaa.Payload); err != nil {
+				return nil, r.queryError(ctx, "failed to decode parsed payload", err, zap.String("id", vaa.ID))
+			}
+		}
+		vaas = append(vaas, &vaa)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get vaa with payload", err, zap.Any("q", q))
+	}
+
+	// Set remaining fields on the returned structs
+	setExtensionFields(vaas, r.logger)
+
+	return vaas, nil
+}
+
+// GetVaaCount get a count of vaa by chainID.
+func (r *PostgresRepository) GetVaaCount(ctx context.Context, q *VaaQuery) ([]*VaaStats, error) {
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT chain_id, count FROM vaa_counts ORDER BY chain_id OFFSET $1 LIMIT $2`, q.Skip, q.Limit)
+	if err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get vaaCount", err, zap.Any("q", q))
+	}
+	defer rows.Close()
+
+	stats := make([]*VaaStats, 0)
+	for rows.Next() {
+		var s VaaStats
+		if err := rows.Scan(&s.ChainID, &s.Count); err != nil {
+			return nil, r.queryError(ctx, "failed to scan vaaCount", err)
+		}
+		stats = append(stats, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get vaaCount", err, zap.Any("q", q))
+	}
+	return stats, nil
+}
+
+// FindDuplicatedByID get the duplicated vaas of a vaa by chainID, emitter address and sequence.
+func (r *PostgresRepository) FindDuplicatedByID(ctx context.Context, chain sdk.ChainID, emitter *types.Address, seq string) ([]*VaaDoc, error) {
+
+	vaaID := fmt.Sprintf("%d/%s/%s", chain, emitter.Hex(), seq)
+
+	duplicateVaas, err := r.findDuplicateVaas(ctx,
+		`SELECT v.vaa_id, `+vaaColumns+` FROM duplicate_vaas v WHERE v.vaa_id = $1`, vaaID)
+	if err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get duplicated vaas", err)
+	}
+	if len(duplicateVaas) == 0 {
+		return []*VaaDoc{}, nil
+	}
+
+	var vaa VaaDoc
+	row := r.db.QueryRowContext(ctx, `SELECT v.id, v.is_duplicated, `+vaaColumns+` FROM vaas v WHERE v.id = $1`, vaaID)
+	dest := append([]any{&vaa.ID, &vaa.IsDuplicated}, vaaFields(&vaa)...)
+	if err := row.Scan(dest...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrNotFound
+		}
+		return nil, r.queryError(ctx, "failed to get vaa", err)
+	}
+
+	// on a digest conflict the stored vaa is also saved in the duplicate_vaas table.
+	for _, d := range duplicateVaas {
+		if d.Digest == vaa.Digest {
+			return duplicateVaas, nil
+		}
+	}
+	return append(duplicateVaas, &vaa), nil
+}
+
+// FindConflicts get the vaas received with the same id and a different digest, most recent first.
+func (r *PostgresRepository) FindConflicts(ctx context.Context, p *pagination.Pagination) ([]*VaaDoc, error) {
+
+	conflicts, err := r.findDuplicateVaas(ctx,
+		`SELECT v.vaa_id, `+vaaColumns+` FROM duplicate_vaas v
+		WHERE v.conflict
+		ORDER BY v.timestamp DESC, v.vaa_id
+		OFFSET $1 LIMIT $2`, p.Skip, p.Limit)
+	if err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get conflict vaas", err)
+	}
+	return conflicts, nil
+}
+
+// FindRecentVaas get the vaas indexed after the cursor, sorted by indexing time and id.
+func (r *PostgresRepository) FindRecentVaas(ctx context.Context, q *RecentVaasQuery) ([]*VaaDoc, error) {
+
+	var excluded []string
+	for _, e := range q.ExcludedEmitters {
+		excluded = append(excluded, e.ID+"/%")
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT v.id, `+vaaColumns+` FROM vaas v
+		WHERE (v.indexed_at, v.id) > ($1, $2) AND v.indexed_at <= $3
+		AND NOT v.id LIKE ANY($4)
+		ORDER BY v.indexed_at, v.id
+		LIMIT $5`, q.After.IndexedAt, q.After.ID, q.Until, pq.Array(excluded), q.Limit)
+	if err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get recent vaas", err, zap.Any("q", q))
+	}
+	defer rows.Close()
+
+	vaas := make([]*VaaDoc, 0)
+	for rows.Next() {
+		var vaa VaaDoc
+		dest := append([]any{&vaa.ID}, vaaFields(&vaa)...)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, r.queryError(ctx, "failed to scan vaa", err)
+		}
+		vaas = append(vaas, &vaa)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get recent vaas", err, zap.Any("q", q))
+	}
+
+	// Set remaining fields on the returned structs
+	setExtensionFields(vaas, r.logger)
+
+	return vaas, nil
+}
+
+func (r *PostgresRepository) findDuplicateVaas(ctx context.Context, query string, args ...any) ([]*VaaDoc, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	vaas := make([]*VaaDoc, 0)
+	for rows.Next() {
+		var vaa VaaDoc
+		dest := append([]any{&vaa.ID}, vaaFields(&vaa)...)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		vaa.IsDuplicated = true
+		vaas = append(vaas, &vaa)
+	}
+	return vaas, rows.Err()
+}
+
+// FindObservationsByID get the guardian observations of a vaa by chainID, emitter address and sequence.
+func (r *PostgresRepository) FindObservationsByID(ctx context.Context, chain sdk.ChainID, emitter *types.Address, seq string) ([]*ObservationSummary, error) {
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT guardian_addr, hash, indexed_at FROM observations
+		WHERE emitter_chain = $1 AND emitter_addr = $2 AND sequence = $3
+		ORDER BY indexed_at`, int(chain), emitter.Hex(), seq)
+	if err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get observations", err)
+	}
+	defer rows.Close()
+
+	observations := make([]*ObservationSummary, 0)
+	for rows.Next() {
+		var o ObservationSummary
+		if err := rows.Scan(&o.GuardianAddr, &o.Hash, &o.IndexedAt); err != nil {
+			return nil, r.queryError(ctx, "failed to scan observation", err)
+		}
+		observations = append(observations, &o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, r.queryError(ctx, "failed execute query to get observations", err)
+	}
+	return observations, nil
+}
+
+// vaaFields returns the destinations of the vaaColumns.
+func vaaFields(vaa *VaaDoc) []any {
+	return []any{&vaa.Version, &vaa.EmitterChain, &vaa.EmitterAddr, &vaa.Sequence, &vaa.GuardianSetIndex, &vaa.Vaa,
+		&vaa.Timestamp, &vaa.UpdatedAt, &vaa.IndexedAt, &vaa.TxHash, &vaa.Digest, &vaa.Conflict}
+}
+
+func (r *PostgresRepository) queryError(ctx context.Context, msg string, err error, fields ...zap.Field) error {
+	requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+	r.logger.Error(msg, append(fields, zap.Error(err), zap.String("requestID", requestID))...)
+	return errors.WithStack(err)
+}
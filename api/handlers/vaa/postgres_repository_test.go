@@ -0,0 +1,227 @@
+//go:build integration
+
+package vaa
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/postgres"
+	"github.com/wormhole-foundation/wormhole-explorer/common/types"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const tokenBridgeEmitter = "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"
+
+// pgDB is the database of the postgres container shared by the tests, each test truncates the tables.
+// The container is started with testcontainers-go, the tests run with: go test -tags integration ./handlers/vaa/...
+var pgDB *sql.DB
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:16-alpine",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "wormscan",
+				"POSTGRES_PASSWORD": "wormscan-password",
+				"POSTGRES_DB":       "wormscan",
+			},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).WithStartupTimeout(time.Minute),
+		},
+		Started: true,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start postgres container: %v\n", err)
+		os.Exit(1)
+	}
+	endpoint, err := c.PortEndpoint(ctx, nat.Port("5432/tcp"), "")
+	if err == nil {
+		url := fmt.Sprintf("postgres://wormscan:wormscan-password@%s/wormscan?sslmode=disable", endpoint)
+		pgDB, err = postgres.Connect(ctx, url, zap.NewNop())
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect to postgres: %v\n", err)
+		c.Terminate(ctx)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	pgDB.Close()
+	c.Terminate(ctx)
+	os.Exit(code)
+}
+
+// newPostgresRepository truncates the tables and inserts the rows of [statements].
+func newPostgresRepository(t *testing.T, statements ...string) *PostgresRepository {
+	ctx := context.Background()
+	_, err := pgDB.ExecContext(ctx,
+		`TRUNCATE vaas, vaas_pythnet, duplicate_vaas, parsed_vaa, global_transactions, observations, vaa_counts`)
+	require.NoError(t, err)
+	for _, s := range statements {
+		_, err := pgDB.ExecContext(ctx, s)
+		require.NoError(t, err)
+	}
+	return NewPostgresRepository(pgDB, zap.NewNop())
+}
+
+func insertVaa(table string, seq int, digest string, timestamp string) string {
+	return fmt.Sprintf(`INSERT INTO %s (id, version, emitter_chain, emitter_addr, sequence, guardian_set_index, vaa,
+		digest, tx_hash, timestamp, indexed_at, updated_at)
+		VALUES ('2/%s/%d', 1, 2, '%s', '%d', 4, '\x01', '%s', 'txhash%d', '%s', '%s', '%s')`,
+		table, tokenBridgeEmitter, seq, tokenBridgeEmitter, seq, digest, seq, timestamp, timestamp, timestamp)
+}
+
+func insertDuplicateVaa(seq int, digest string, conflict bool) string {
+	return fmt.Sprintf(`INSERT INTO duplicate_vaas (id, vaa_id, version, emitter_chain, emitter_addr, sequence,
+		guardian_set_index, vaa, digest, conflict, timestamp)
+		VALUES ('%s', '2/%s/%d', 1, 2, '%s', '%d', 4, '\x01', '%s', %t, '2024-05-01T10:00:00Z')`,
+		digest, tokenBridgeEmitter, seq, tokenBridgeEmitter, seq, digest, conflict)
+}
+
+func emitterAddress(t *testing.T) *types.Address {
+	addr, err := types.StringToAddress(tokenBridgeEmitter, false)
+	require.NoError(t, err)
+	return addr
+}
+
+func TestPostgresFindVaasByChain(t *testing.T) {
+	repo := newPostgresRepository(t,
+		insertVaa("vaas", 1, "digest1", "2024-05-01T10:00:00Z"),
+		insertVaa("vaas", 2, "digest2", "2024-05-02T10:00:00Z"),
+		insertVaa("vaas", 3, "digest3", "2024-05-03T10:00:00Z"),
+	)
+
+	vaas, err := repo.FindVaas(context.Background(), Query().SetChain(sdk.ChainIDEthereum))
+	require.NoError(t, err)
+	if assert.Len(t, vaas, 3) {
+		// most recent first.
+		assert.Equal(t, "2/"+tokenBridgeEmitter+"/3", vaas[0].ID)
+		assert.Equal(t, "2/"+tokenBridgeEmitter+"/2", vaas[1].ID)
+		assert.Equal(t, "2/"+tokenBridgeEmitter+"/1", vaas[2].ID)
+		assert.Equal(t, "digest3", vaas[0].Digest)
+		assert.Equal(t, []byte{0x01}, []byte(vaas[0].Vaa))
+	}
+}
+
+func TestPostgresFindVaasByAppIdWithPayload(t *testing.T) {
+	repo := newPostgresRepository(t,
+		insertVaa("vaas", 1, "digest1", "2024-05-01T10:00:00Z"),
+		insertVaa("vaas", 2, "digest2", "2024-05-02T10:00:00Z"),
+		fmt.Sprintf(`INSERT INTO parsed_vaa (id, app_ids, parsed_payload, raw_to_chain)
+			VALUES ('2/%s/1', '{PORTAL_TOKEN_BRIDGE}', '{"amount":"1000"}', 4)`, tokenBridgeEmitter),
+	)
+
+	vaas, err := repo.FindVaas(context.Background(), Query().SetAppId("PORTAL_TOKEN_BRIDGE"))
+	require.NoError(t, err)
+	if assert.Len(t, vaas, 1) {
+		assert.Equal(t, "2/"+tokenBridgeEmitter+"/1", vaas[0].ID)
+		assert.Equal(t, "PORTAL_TOKEN_BRIDGE", vaas[0].AppId)
+		assert.Equal(t, map[string]interface{}{"amount": "1000"}, vaas[0].Payload)
+	}
+}
+
+func TestPostgresFindVaasByTxHash(t *testing.T) {
+	repo := newPostgresRepository(t,
+		insertVaa("vaas", 1, "digest1", "2024-05-01T10:00:00Z"),
+		insertVaa("vaas", 2, "digest2", "2024-05-02T10:00:00Z"),
+		fmt.Sprintf(`INSERT INTO global_transactions (id, native_tx_hash) VALUES ('2/%s/2', '0xabc')`, tokenBridgeEmitter),
+	)
+
+	vaas, err := repo.FindVaasByTxHashWorkaround(context.Background(), Query().SetTxHash("abc"))
+	require.NoError(t, err)
+	if assert.Len(t, vaas, 1) {
+		assert.Equal(t, "2/"+tokenBridgeEmitter+"/2", vaas[0].ID)
+	}
+}
+
+func TestPostgresFindDuplicatedByID(t *testing.T) {
+	repo := newPostgresRepository(t,
+		insertVaa("vaas", 1, "digest1", "2024-05-01T10:00:00Z"),
+		insertDuplicateVaa(1, "digest2", true),
+	)
+
+	vaas, err := repo.FindDuplicatedByID(context.Background(), sdk.ChainIDEthereum, emitterAddress(t), "1")
+	require.NoError(t, err)
+	if assert.Len(t, vaas, 2) {
+		// the duplicates are followed by the stored vaa.
+		assert.Equal(t, "digest2", vaas[0].Digest)
+		assert.True(t, vaas[0].IsDuplicated)
+		assert.Equal(t, "digest1", vaas[1].Digest)
+	}
+}
+
+func TestPostgresFindDuplicatedByIDWithoutDuplicates(t *testing.T) {
+	repo := newPostgresRepository(t,
+		insertVaa("vaas", 1, "digest1", "2024-05-01T10:00:00Z"),
+	)
+
+	vaas, err := repo.FindDuplicatedByID(context.Background(), sdk.ChainIDEthereum, emitterAddress(t), "1")
+	require.NoError(t, err)
+	assert.Empty(t, vaas)
+}
+
+func TestPostgresFindDuplicatedByIDNotFound(t *testing.T) {
+	// the duplicates of a vaa that is not stored.
+	repo := newPostgresRepository(t,
+		insertDuplicateVaa(1, "digest2", false),
+	)
+
+	_, err := repo.FindDuplicatedByID(context.Background(), sdk.ChainIDEthereum, emitterAddress(t), "1")
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestPostgresFindConflicts(t *testing.T) {
+	repo := newPostgresRepository(t,
+		insertDuplicateVaa(1, "digest1", false),
+		insertDuplicateVaa(2, "digest2", true),
+	)
+
+	vaas, err := repo.FindConflicts(context.Background(), pagination.Default())
+	require.NoError(t, err)
+	if assert.Len(t, vaas, 1) {
+		assert.Equal(t, "2/"+tokenBridgeEmitter+"/2", vaas[0].ID)
+		assert.True(t, vaas[0].Conflict)
+	}
+}
+
+func TestPostgresGetVaaCount(t *testing.T) {
+	repo := newPostgresRepository(t,
+		`INSERT INTO vaa_counts (chain_id, count) VALUES (1, 10), (2, 20)`,
+	)
+
+	stats, err := repo.GetVaaCount(context.Background(), Query())
+	require.NoError(t, err)
+	assert.Equal(t, []*VaaStats{{ChainID: 1, Count: 10}, {ChainID: 2, Count: 20}}, stats)
+}
+
+func TestPostgresFindObservationsByID(t *testing.T) {
+	repo := newPostgresRepository(t,
+		fmt.Sprintf(`INSERT INTO observations (id, emitter_chain, emitter_addr, sequence, guardian_addr, hash, indexed_at)
+			VALUES ('obs1', 2, '%s', '1', '0xguardian1', '\x02', '2024-05-01T10:00:00Z'),
+			('obs2', 2, '%s', '1', '0xguardian2', '\x02', '2024-05-01T10:00:01Z'),
+			('obs3', 2, '%s', '2', '0xguardian1', '\x03', '2024-05-01T10:00:02Z')`,
+			tokenBridgeEmitter, tokenBridgeEmitter, tokenBridgeEmitter),
+	)
+
+	observations, err := repo.FindObservationsByID(context.Background(), sdk.ChainIDEthereum, emitterAddress(t), "1")
+	require.NoError(t, err)
+	if assert.Len(t, observations, 2) {
+		assert.Equal(t, "0xguardian1", observations[0].GuardianAddr)
+		assert.Equal(t, "0xguardian2", observations[1].GuardianAddr)
+	}
+}
@@ -3,6 +3,7 @@ package vaa
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
@@ -30,6 +31,7 @@ type Repository struct {
 		vaaCount           *mongo.Collection
 		globalTransactions *mongo.Collection
 		duplicateVaas      *mongo.Collection
+		observations       *mongo.Collection
 	}
 }
 
@@ -45,6 +47,7 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 			vaaCount           *mongo.Collection
 			globalTransactions *mongo.Collection
 			duplicateVaas      *mongo.Collection
+			observations       *mongo.Collection
 		}{
 			vaas:               db.Collection(repository.Vaas),
 			parsedVaa:          db.Collection("parsedVaa"),
@@ -53,6 +56,7 @@ func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
 			vaaCount:           db.Collection("vaaCounts"),
 			globalTransactions: db.Collection("globalTransactions"),
 			duplicateVaas:      db.Collection(repository.DuplicateVaas),
+			observations:       db.Collection(repository.Observations),
 		},
 	}
 }
@@ -72,8 +76,9 @@ func (r *Repository) FindVaasByTxHashWorkaround(
 ) ([]*VaaDoc, error) {
 
 	// Find globalTransactions that match the given TxHash
-	cur, err := r.collections.globalTransactions.Find(
+	globalTxs, err := repository.Find[transactions.GlobalTransactionDoc](
 		ctx,
+		r.collections.globalTransactions,
 		bson.D{
 			{"$or", bson.A{
 				bson.D{{"originTx.nativeTxHash", bson.M{"$eq": query.txHash}}},
@@ -82,9 +87,7 @@ func (r *Repository) FindVaasByTxHashWorkaround(
 				bson.D{{"originTx.attribute.value.originTxHash", bson.M{"$eq": "0x" + query.txHash}}},
 			}},
 		},
-		nil,
 	)
-
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed to find globalTransactions by TxHash",
@@ -94,18 +97,6 @@ func (r *Repository) FindVaasByTxHashWorkaround(
 		return nil, errors.WithStack(err)
 	}
 
-	// Read results from cursor
-	var globalTxs []transactions.GlobalTransactionDoc
-	err = cur.All(ctx, &globalTxs)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor to []GlobalTransactionDoc",
-			zap.Error(err),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
 	// If no documents were found, look up the transaction hash in the `vaas` collection instead.
 	if len(globalTxs) == 0 {
 		return r.FindVaas(ctx, query)
@@ -160,26 +151,13 @@ func (r *Repository) FindVaasByEmitterAndToChain(
 	}
 
 	// execute the aggregation pipeline
-	cur, err := r.collections.parsedVaa.Aggregate(ctx, pipeline)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed execute Aggregate command to get vaa by emitter and toChain",
-			zap.Error(err),
-			zap.Any("q", query),
-			zap.Any("toChain", toChain),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
-	// read results from cursor
-	var vaas []struct {
+	type vaaID struct {
 		ID string `bson:"_id"`
 	}
-	err = cur.All(ctx, &vaas)
+	vaas, err := repository.Aggregate[vaaID](ctx, r.collections.parsedVaa, pipeline)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor",
+		r.logger.Error("failed execute Aggregate command to get vaa by emitter and toChain",
 			zap.Error(err),
 			zap.Any("q", query),
 			zap.Any("toChain", toChain),
@@ -219,6 +197,11 @@ func (r *Repository) FindVaas(
 			{"$sort", bson.D{q.getSortPredicate()}},
 		})
 
+		// exclude the vaas of the denied emitters
+		if match := repository.MatchNotDenied(q.excludedEmitters); match != nil {
+			pipeline = append(pipeline, match)
+		}
+
 		// filter by VAA ids (potentially more than one)
 		if len(q.ids) > 0 {
 			var array bson.A
@@ -273,6 +256,7 @@ func (r *Repository) FindVaas(
 		pipeline = append(pipeline, bson.D{
 			{"$addFields", bson.D{
 				{"payload", bson.M{"$arrayElemAt": []interface{}{"$payload.parsedPayload", 0}}},
+				{"decodedPayload", bson.M{"$arrayElemAt": []interface{}{"$payload.decodedPayload", 0}}},
 				{"appId", bson.M{"$arrayElemAt": []interface{}{"$payload.appId", 0}}},
 			}},
 		})
@@ -315,13 +299,11 @@ func (r *Repository) FindVaas(
 	}
 
 	// execute the aggregation pipeline
-	var err error
-	var cur *mongo.Cursor
+	collection := r.collections.vaas
 	if q.chainId == sdk.ChainIDPythNet {
-		cur, err = r.collections.vaasPythnet.Aggregate(ctx, pipeline)
-	} else {
-		cur, err = r.collections.vaas.Aggregate(ctx, pipeline)
+		collection = r.collections.vaasPythnet
 	}
+	vaasWithPayload, err := repository.Aggregate[*VaaDoc](ctx, collection, pipeline)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed execute Aggregate command to get vaa with payload",
@@ -329,33 +311,24 @@ func (r *Repository) FindVaas(
 		return nil, errors.WithStack(err)
 	}
 
-	// read results from cursor
-	var vaasWithPayload []*VaaDoc
-	err = cur.All(ctx, &vaasWithPayload)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed decoding cursor to []*VaaDoc",
-			zap.Error(err),
-			zap.Any("q", q),
-			zap.String("requestID", requestID),
-		)
-		return nil, errors.WithStack(err)
-	}
-
-	// If no results were found, return an empty slice instead of nil.
-	if vaasWithPayload == nil {
-		vaasWithPayload = make([]*VaaDoc, 0)
-	}
-
 	// If the payload field was not requested, remove it from the results.
 	if !q.includeParsedPayload && q.appId == "" {
 		for i := range vaasWithPayload {
 			vaasWithPayload[i].Payload = nil
+			vaasWithPayload[i].DecodedPayload = nil
 		}
 	}
 
 	// Set remaining fields on the returned structs
-	for _, vaa := range vaasWithPayload {
+	setExtensionFields(vaasWithPayload, r.logger)
+
+	return vaasWithPayload, nil
+}
+
+// setExtensionFields sets the fields of the vaas that are not stored in the vaas collection.
+func setExtensionFields(vaas []*VaaDoc, logger *zap.Logger) {
+	var err error
+	for _, vaa := range vaas {
 
 		// For Solana and Aptos VAAs, overwrite the txHash found in the `vaas` collection
 		// with the one from the `globalTransactions` collection.
@@ -381,33 +354,23 @@ func (r *Repository) FindVaas(
 		// Set the `EmitterNativeAddr` field
 		vaa.EmitterNativeAddr, err = domain.TranslateEmitterAddress(vaa.EmitterChain, vaa.EmitterAddr)
 		if err != nil {
-			r.logger.Warn("failed to translate emitter address for VAA",
+			logger.Warn("failed to translate emitter address for VAA",
 				zap.Stringer("emitterChain", vaa.EmitterChain),
 				zap.String("emitterAddr", vaa.EmitterAddr),
 				zap.Error(err),
 			)
 		}
 	}
-
-	return vaasWithPayload, nil
 }
 
 // GetVaaCount get a count of vaa by chainID.
 func (r *Repository) GetVaaCount(ctx context.Context, q *VaaQuery) ([]*VaaStats, error) {
 
-	cur, err := r.collections.vaaCount.Find(ctx, bson.D{}, q.findOptions())
+	varCounts, err := repository.Find[*VaaStats](ctx, r.collections.vaaCount, bson.D{}, q.findOptions())
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed execute Find command to get vaaCount",
-			zap.Error(err), zap.String("requestID", requestID))
-		return nil, errors.WithStack(err)
-	}
-	var varCounts []*VaaStats
-	err = cur.All(ctx, &varCounts)
-	if err != nil {
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed decoding cursor to []*VaaStats", zap.Error(err), zap.Any("q", q),
-			zap.String("requestID", requestID))
+			zap.Error(err), zap.Any("q", q), zap.String("requestID", requestID))
 		return nil, errors.WithStack(err)
 	}
 	return varCounts, nil
@@ -417,9 +380,7 @@ func (r *Repository) FindDuplicatedByID(ctx context.Context, chain sdk.ChainID,
 
 	vaaID := fmt.Sprintf("%d/%s/%s", chain, emitter.Hex(), seq)
 
-	var duplicateVaas []*VaaDoc
-
-	cur, err := r.collections.duplicateVaas.Find(ctx, bson.D{{Key: "vaaId", Value: vaaID}})
+	duplicateVaas, err := repository.Find[*VaaDoc](ctx, r.collections.duplicateVaas, bson.D{{Key: "vaaId", Value: vaaID}})
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		r.logger.Error("failed execute Find command to get duplicated vaas",
@@ -427,29 +388,122 @@ func (r *Repository) FindDuplicatedByID(ctx context.Context, chain sdk.ChainID,
 		return nil, errors.WithStack(err)
 	}
 
-	err = cur.All(ctx, &duplicateVaas)
+	if len(duplicateVaas) == 0 {
+		return []*VaaDoc{}, nil
+	}
+
+	for i, _ := range duplicateVaas {
+		duplicateVaas[i].ID = vaaID
+	}
+
+	vaa, err := repository.FindOne[VaaDoc](ctx, r.collections.vaas, bson.D{{Key: "_id", Value: vaaID}})
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed decoding cursor to []*VaaDoc", zap.Error(err), zap.String("requestID", requestID))
+		r.logger.Error("failed to get vaa", zap.Error(err), zap.String("requestID", requestID))
 		return nil, errors.WithStack(err)
 	}
 
-	if len(duplicateVaas) == 0 {
-		return []*VaaDoc{}, nil
+	// on a digest conflict the stored vaa is also saved in the duplicateVaas collection.
+	for _, d := range duplicateVaas {
+		if d.Digest == vaa.Digest {
+			return duplicateVaas, nil
+		}
 	}
+	return append(duplicateVaas, vaa), nil
+}
 
-	for i, _ := range duplicateVaas {
-		duplicateVaas[i].ID = vaaID
+// FindConflicts get the vaas received with the same id and a different digest, most recent first.
+func (r *Repository) FindConflicts(ctx context.Context, p *pagination.Pagination) ([]*VaaDoc, error) {
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "vaaId", Value: 1}}).
+		SetSkip(p.Skip).
+		SetLimit(p.Limit)
+	conflicts, err := repository.Find[*VaaDoc](ctx, r.collections.duplicateVaas, bson.D{{Key: "conflict", Value: true}}, opts)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Find command to get conflict vaas",
+			zap.Error(err), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
+	}
+
+	for _, c := range conflicts {
+		c.ID = fmt.Sprintf("%d/%s/%s", c.EmitterChain, c.EmitterAddr, c.Sequence)
+	}
+	return conflicts, nil
+}
+
+// RecentVaasQuery contains the filters of the recent vaas.
+type RecentVaasQuery struct {
+	// After is the position of the last vaa read, only the vaas after it are returned.
+	After RecentCursor
+	// Until is the maximum indexing time of the vaas returned.
+	Until time.Time
+	Limit int64
+	// ExcludedEmitters are the emitters whose vaas are excluded from the results.
+	ExcludedEmitters []*repository.DeniedEmitter
+}
+
+// FindRecentVaas get the vaas indexed after the cursor, sorted by indexing time and id.
+func (r *Repository) FindRecentVaas(ctx context.Context, q *RecentVaasQuery) ([]*VaaDoc, error) {
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "indexedAt", Value: bson.D{{Key: "$gt", Value: q.After.IndexedAt}}}},
+				bson.D{{Key: "indexedAt", Value: q.After.IndexedAt}, {Key: "_id", Value: bson.D{{Key: "$gt", Value: q.After.ID}}}},
+			}},
+			{Key: "indexedAt", Value: bson.D{{Key: "$lte", Value: q.Until}}},
+		}}},
+	}
+
+	// exclude the vaas of the denied emitters
+	if match := repository.MatchNotDenied(q.ExcludedEmitters); match != nil {
+		pipeline = append(pipeline, match)
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "indexedAt", Value: 1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: q.Limit}})
+
+	vaas, err := repository.Aggregate[*VaaDoc](ctx, r.collections.vaas, pipeline)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		r.logger.Error("failed execute Aggregate command to get recent vaas",
+			zap.Error(err), zap.Any("q", q), zap.String("requestID", requestID))
+		return nil, errors.WithStack(err)
 	}
 
-	var vaa VaaDoc
-	err = r.collections.vaas.FindOne(ctx, bson.D{{Key: "_id", Value: vaaID}}).Decode(&vaa)
+	// Set remaining fields on the returned structs
+	setExtensionFields(vaas, r.logger)
+
+	return vaas, nil
+}
+
+// FindObservationsByID get the guardian observations of a vaa by chainID, emitter address and sequence.
+func (r *Repository) FindObservationsByID(ctx context.Context, chain sdk.ChainID, emitter *types.Address, seq string) ([]*ObservationSummary, error) {
+
+	filter := bson.D{
+		{Key: "emitterChain", Value: chain},
+		{Key: "emitterAddr", Value: emitter.Hex()},
+		{Key: "sequence", Value: seq},
+	}
+	opts := options.Find().
+		SetProjection(bson.D{
+			{Key: "guardianAddr", Value: 1},
+			{Key: "hash", Value: 1},
+			{Key: "indexedAt", Value: 1},
+		}).
+		SetSort(bson.D{{Key: "indexedAt", Value: 1}})
+
+	observations, err := repository.Find[*ObservationSummary](ctx, r.collections.observations, filter, opts)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		r.logger.Error("failed to decode cursor to VaaDoc", zap.Error(err), zap.String("requestID", requestID))
+		r.logger.Error("failed execute Find command to get observations",
+			zap.Error(err), zap.String("requestID", requestID))
 		return nil, errors.WithStack(err)
 	}
-	return append(duplicateVaas, &vaa), nil
+	return observations, nil
 }
 
 // VaaQuery respresent a query for the vaa mongodb document.
@@ -462,6 +516,7 @@ type VaaQuery struct {
 	txHash               string
 	appId                string
 	includeParsedPayload bool
+	excludedEmitters     []*repository.DeniedEmitter
 }
 
 // Query create a new VaaQuery with default pagination vaues.
@@ -515,6 +570,12 @@ func (q *VaaQuery) IncludeParsedPayload(val bool) *VaaQuery {
 	return q
 }
 
+// ExcludeEmitters set the emitters whose vaas are excluded from the results.
+func (q *VaaQuery) ExcludeEmitters(emitters []*repository.DeniedEmitter) *VaaQuery {
+	q.excludedEmitters = emitters
+	return q
+}
+
 func (q *VaaQuery) getSortPredicate() bson.E {
 	return bson.E{"timestamp", q.GetSortInt()}
 }
@@ -2,41 +2,99 @@ package vaa
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
-
+	"sync/atomic"
+	"time"
+
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/denylist"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/emitters"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/heartbeats"
 	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
 	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaapayload"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.uber.org/zap"
 )
 
 // Service definition.
 type Service struct {
-	repo         *Repository
-	getCacheFunc cache.CacheGetFunc
-	parseVaaFunc vaaPayloadParser.ParseVaaFunc
-	logger       *zap.Logger
+	repo            Store
+	cache           cache.Cache
+	cacheExpiration atomic.Int64
+	parseVaaFunc    vaaPayloadParser.ParseVaaFunc
+	guardianSrv     *guardian.Service
+	heartbeatsSrv   *heartbeats.Service
+	emittersSrv     *emitters.Service
+	denyList        *denylist.Service
+	archive         Archive
+	p2pNetwork      string
+	logger          *zap.Logger
+}
+
+// Archive is the long-term storage of the raw vaas moved out of the database.
+type Archive interface {
+	Get(ctx context.Context, vaaID string) ([]byte, error)
 }
 
 // NewService creates a new VAA Service.
-func NewService(r *Repository, getCacheFunc cache.CacheGetFunc, parseVaaFunc vaaPayloadParser.ParseVaaFunc, logger *zap.Logger) *Service {
+//
+// The vaas found by id are cached for [cacheExpiration], a zero value disables the vaa cache.
+func NewService(r Store, cache cache.Cache, cacheExpiration time.Duration, parseVaaFunc vaaPayloadParser.ParseVaaFunc, guardianSrv *guardian.Service, heartbeatsSrv *heartbeats.Service, emittersSrv *emitters.Service, logger *zap.Logger) *Service {
 
 	s := Service{
-		repo:         r,
-		getCacheFunc: getCacheFunc,
-		parseVaaFunc: parseVaaFunc,
-		logger:       logger.With(zap.String("module", "VaaService")),
+		repo:          r,
+		cache:         cache,
+		parseVaaFunc:  parseVaaFunc,
+		guardianSrv:   guardianSrv,
+		heartbeatsSrv: heartbeatsSrv,
+		emittersSrv:   emittersSrv,
+		logger:        logger.With(zap.String("module", "VaaService")),
 	}
+	s.SetCacheExpiration(cacheExpiration)
 
 	return &s
 }
 
+// SetCacheExpiration changes the expiration of the vaas cached by id, a zero value disables the vaa cache.
+func (s *Service) SetCacheExpiration(expiration time.Duration) {
+	s.cacheExpiration.Store(int64(expiration))
+}
+
+// SetEmitterDenyList excludes the vaas of the denied emitters from the vaa listings.
+func (s *Service) SetEmitterDenyList(denyList *denylist.Service) {
+	s.denyList = denyList
+}
+
+// SetP2pNetwork sets the network used to find the token bridge and the nft bridge emitters of the parsed vaas.
+func (s *Service) SetP2pNetwork(p2pNetwork string) {
+	s.p2pNetwork = p2pNetwork
+}
+
+// SetArchive enables reading the raw vaas moved to the archive when the vaas are found by id.
+func (s *Service) SetArchive(archive Archive) {
+	s.archive = archive
+}
+
+// excludedEmitters returns the emitters to exclude from the listings.
+func (s *Service) excludedEmitters(ctx context.Context) []*repository.DeniedEmitter {
+	if s.denyList == nil {
+		return nil
+	}
+	return s.denyList.Excluded(ctx)
+}
+
 // FindAllParams passes input data to the function `FindAll`.
 type FindAllParams struct {
 	Pagination           *pagination.Pagination
@@ -53,7 +111,8 @@ func (s *Service) FindAll(
 
 	// Populate query parameters
 	query := Query().
-		IncludeParsedPayload(params.IncludeParsedPayload)
+		IncludeParsedPayload(params.IncludeParsedPayload).
+		ExcludeEmitters(s.excludedEmitters(ctx))
 	if params.Pagination != nil {
 		query.SetPagination(params.Pagination)
 	}
@@ -80,6 +139,7 @@ func (s *Service) FindAll(
 	if err != nil {
 		return nil, err
 	}
+	s.setEmitterType(ctx, vaas...)
 
 	// Return the matching documents
 	res := response.Response[[]*VaaDoc]{Data: vaas}
@@ -96,9 +156,13 @@ func (s *Service) FindByChain(
 	query := Query().
 		SetChain(chain).
 		SetPagination(p).
-		IncludeParsedPayload(false)
+		IncludeParsedPayload(false).
+		ExcludeEmitters(s.excludedEmitters(ctx))
 
 	vaas, err := s.repo.FindVaas(ctx, query)
+	if err == nil {
+		s.setEmitterType(ctx, vaas...)
+	}
 
 	res := response.Response[[]*VaaDoc]{Data: vaas}
 	return &res, err
@@ -136,6 +200,9 @@ func (s *Service) FindByEmitter(
 	} else {
 		vaas, err = s.repo.FindVaas(ctx, query)
 	}
+	if err == nil {
+		s.setEmitterType(ctx, vaas...)
+	}
 
 	res := response.Response[[]*VaaDoc]{Data: vaas}
 	return &res, err
@@ -156,17 +223,76 @@ func (s *Service) FindById(
 		return nil, errs.ErrNotFound
 	}
 
-	// execute the database query
-	vaa, err := s.findById(ctx, chain, emitter, seq, includeParsedPayload)
+	// get the vaa from the cache or execute the database query
+	vaa, err := s.findCachedById(ctx, chain, emitter, seq)
 	if err != nil {
 		return &response.Response[*VaaDoc]{}, err
 	}
+	if !includeParsedPayload {
+		vaa.Payload = nil
+		vaa.DecodedPayload = nil
+	}
+	s.setEmitterType(ctx, vaa)
 
 	// return matching documents
 	resp := response.Response[*VaaDoc]{Data: vaa}
 	return &resp, err
 }
 
+// findCachedById get a vaa with its parsed payload by chainID, emitter address and sequence number.
+//
+// The vaa is read from the cache, and on a cache miss it is loaded from the database and saved in the cache.
+// The pipeline deletes the cached vaa when the document is updated (e.g. when the txHash is backfilled),
+// so the cache expiration only bounds the staleness of the changes made by other components.
+// The vaas not parsed yet are not cached, since the parser does not delete the cached vaa when it stores the payload.
+func (s *Service) findCachedById(
+	ctx context.Context,
+	chain sdk.ChainID,
+	emitter *types.Address,
+	seq string,
+) (*VaaDoc, error) {
+
+	expiration := time.Duration(s.cacheExpiration.Load())
+	if expiration <= 0 {
+		return s.findById(ctx, chain, emitter, seq, true)
+	}
+
+	key := cache.VaaKey(fmt.Sprintf("%d/%s/%s", chain, emitter.Hex(), seq))
+	value, err := s.cache.Get(ctx, key)
+	if err == nil {
+		var vaa VaaDoc
+		if err := bson.Unmarshal([]byte(value), &vaa); err == nil {
+			return &vaa, nil
+		}
+		s.logger.Warn("failed to decode cached vaa", zap.Error(err), zap.String("key", key))
+	} else if errors.Is(err, cache.ErrInternal) {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		s.logger.Error("encountered an internal error while getting value from cache",
+			zap.Error(err),
+			zap.String("requestID", requestID),
+		)
+	}
+
+	vaa, err := s.findById(ctx, chain, emitter, seq, true)
+	if err != nil {
+		return nil, err
+	}
+	if vaa.Payload == nil && vaa.DecodedPayload == nil {
+		return vaa, nil
+	}
+
+	// the vaa is stored in bson since the json representation of the vaa does not include all the fields.
+	b, err := bson.Marshal(vaa)
+	if err != nil {
+		s.logger.Warn("failed to encode vaa to cache", zap.Error(err), zap.String("key", key))
+		return vaa, nil
+	}
+	if err := s.cache.Set(ctx, key, b, expiration); err != nil && !errors.Is(err, cache.ErrCacheNotEnabled) {
+		s.logger.Warn("failed to save vaa in cache", zap.Error(err), zap.String("key", key))
+	}
+	return vaa, nil
+}
+
 // findById get a vaa by chainID, emitter address and sequence number.
 func (s *Service) findById(
 	ctx context.Context,
@@ -199,9 +325,150 @@ func (s *Service) findById(
 		return nil, errs.ErrInternalError
 	}
 
+	if err := s.restoreArchivedVaa(ctx, docs[0]); err != nil {
+		return nil, err
+	}
 	return docs[0], nil
 }
 
+// restoreArchivedVaa reads from the archive the raw vaa of a document whose raw vaa was moved to the archive.
+func (s *Service) restoreArchivedVaa(ctx context.Context, v *VaaDoc) error {
+	if len(v.Vaa) > 0 || v.ArchivedAt == nil || s.archive == nil {
+		return nil
+	}
+	raw, err := s.archive.Get(ctx, v.ID)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		s.logger.Error("failed to get archived vaa",
+			zap.Error(err),
+			zap.String("id", v.ID),
+			zap.String("requestID", requestID))
+		return errs.ErrInternalError
+	}
+	v.Vaa = raw
+	return nil
+}
+
+// AddQuorumProgress sets the number of guardian signatures and the quorum required for a vaa
+// by joining the observations emitted by the guardians.
+//
+// If the parameter [includeObservations] is true, a summary of each observation is added to the vaa.
+func (s *Service) AddQuorumProgress(
+	ctx context.Context,
+	chain sdk.ChainID,
+	emitter *types.Address,
+	seq string,
+	v *VaaDoc,
+	includeObservations bool,
+) error {
+
+	observations, err := s.repo.FindObservationsByID(ctx, chain, emitter, seq)
+	if err != nil {
+		return err
+	}
+
+	// count the guardians that signed the same digest as the vaa
+	signers := make(map[string]bool)
+	for _, o := range observations {
+		if v.Digest != "" && hex.EncodeToString(o.Hash) != v.Digest {
+			continue
+		}
+		signers[o.GuardianAddr] = true
+	}
+	signaturesCount := len(signers)
+
+	// observations are not kept forever, fall back to the signatures of the vaa
+	if signaturesCount == 0 && len(v.Vaa) > 0 {
+		vaa, err := sdk.Unmarshal(v.Vaa)
+		if err == nil {
+			signaturesCount = len(vaa.Signatures)
+		}
+	}
+	v.SignaturesCount = &signaturesCount
+
+	gs, err := s.guardianSrv.GetGuardianSet(ctx)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		s.logger.Error("failed to get guardian set", zap.Error(err), zap.String("requestID", requestID))
+	} else {
+		for _, g := range gs.GstByIndex {
+			if g.Index == v.GuardianSetIndex {
+				quorum := sdk.CalculateQuorum(len(g.Keys))
+				v.Quorum = &quorum
+				break
+			}
+		}
+	}
+
+	if includeObservations {
+		v.Observations = observations
+	}
+	return nil
+}
+
+// AddSigners sets the guardians whose signature is included in the vaa, with their node names when known.
+//
+// The signers are taken from the stored signers bitmap, falling back to the signatures of the vaa.
+func (s *Service) AddSigners(ctx context.Context, v *VaaDoc) {
+
+	var bitmap uint64
+	if v.SignersBitmap != nil && *v.SignersBitmap != 0 {
+		bitmap = *v.SignersBitmap
+	} else if len(v.Vaa) > 0 {
+		vaa, err := sdk.Unmarshal(v.Vaa)
+		if err != nil {
+			return
+		}
+		bitmap = domain.SignersBitmap(vaa)
+	}
+	indexes := domain.SignerIndexes(bitmap)
+	if len(indexes) == 0 {
+		return
+	}
+
+	requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+	gs, err := s.guardianSrv.GetGuardianSet(ctx)
+	if err != nil {
+		s.logger.Error("failed to get guardian set", zap.Error(err), zap.String("requestID", requestID))
+		return
+	}
+	var keys []eth_common.Address
+	for _, g := range gs.GstByIndex {
+		if g.Index == v.GuardianSetIndex {
+			keys = g.Keys
+			break
+		}
+	}
+
+	signers := make([]*Signer, 0, len(indexes))
+	addresses := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		signer := &Signer{Index: i}
+		if int(i) < len(keys) {
+			signer.Address = keys[i].Hex()
+			addresses = append(addresses, signer.Address)
+		}
+		signers = append(signers, signer)
+	}
+
+	// the node names are taken from the heartbeats of the guardians
+	if s.heartbeatsSrv != nil && len(addresses) > 0 {
+		hbs, err := s.heartbeatsSrv.GetHeartbeatsByIds(ctx, addresses)
+		if err != nil {
+			s.logger.Error("failed to get guardian heartbeats", zap.Error(err), zap.String("requestID", requestID))
+		} else {
+			names := make(map[string]string, len(hbs))
+			for _, hb := range hbs {
+				names[hb.ID] = hb.NodeName
+			}
+			for _, signer := range signers {
+				signer.Name = names[signer.Address]
+			}
+		}
+	}
+	v.Signers = signers
+}
+
 // GetVaaCount get a list a list of vaa count grouped by chainID.
 func (s *Service) GetVaaCount(ctx context.Context) (*response.Response[[]*VaaStats], error) {
 	q := Query()
@@ -215,7 +482,7 @@ func (s *Service) GetVaaCount(ctx context.Context) (*response.Response[[]*VaaSta
 // If the sequence does not exist we can not discard the request.
 func (s *Service) discardVaaNotIndexed(ctx context.Context, chain sdk.ChainID, emitter *types.Address, seq string) bool {
 	key := fmt.Sprintf("%s:%d:%s", "wormscan:vaa-max-sequence", chain, emitter.Hex())
-	sequence, err := s.getCacheFunc(ctx, key)
+	sequence, err := s.cache.Get(ctx, key)
 	if err != nil {
 		if errors.Is(err, cache.ErrInternal) {
 			requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
@@ -249,27 +516,39 @@ func (s *Service) discardVaaNotIndexed(ctx context.Context, chain sdk.ChainID, e
 	return true
 }
 
-// ParseVaa parse a vaa payload.
-func (s *Service) ParseVaa(ctx context.Context, vaaByte []byte) (any, error) {
+// ParseVaa decodes the header and the payload of a raw vaa.
+//
+// The payloads of the governance emitter, the token bridge and the nft bridge are decoded by the API,
+// the payload parsed by the vaa payload parser is added when the parser supports the emitter of the vaa.
+func (s *Service) ParseVaa(ctx context.Context, vaaByte []byte) (*ParsedVaa, error) {
 	// unmarshal vaa
 	vaa, err := sdk.Unmarshal(vaaByte)
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		s.logger.Error("error unmarshal vaa to parse", zap.Error(err), zap.String("requestID", requestID))
-		return vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse{}, errs.ErrInternalError
+		return nil, errs.NewInvalidParam("invalid vaa")
+	}
+
+	payload, err := vaapayload.Decode(s.p2pNetwork, vaa)
+	if err != nil {
+		return nil, errs.NewInvalidParam(fmt.Sprintf("invalid vaa payload: %s", err))
 	}
+	parsed := ParsedVaa{Header: newVaaHeader(vaa), Payload: payload}
 
 	// call vaa payload parser api
-	parsedVaa, err := s.parseVaaFunc(vaa)
+	if s.parseVaaFunc == nil {
+		return &parsed, nil
+	}
+	parsedPayload, err := s.parseVaaFunc(vaa)
 	if err != nil {
-		if errors.Is(err, vaaPayloadParser.ErrNotFound) {
-			return nil, errs.ErrNotFound
+		if !errors.Is(err, vaaPayloadParser.ErrNotFound) {
+			requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+			s.logger.Error("error parse vaa", zap.Error(err), zap.String("requestID", requestID))
 		}
-		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
-		s.logger.Error("error parse vaa", zap.Error(err), zap.String("requestID", requestID))
-		return nil, errs.ErrInternalError
+		return &parsed, nil
 	}
-	return parsedVaa, nil
+	parsed.ParsedPayload = parsedPayload
+	return &parsed, nil
 }
 
 // If the parameter [payload] is true, the parse payload is added in the response.
@@ -295,8 +574,92 @@ func (s *Service) FindDuplicatedById(
 	if len(vaas) == 0 {
 		return nil, errs.ErrNotFound
 	}
+	s.setEmitterType(ctx, vaas...)
 
 	// return matching documents
 	resp := response.Response[[]*VaaDoc]{Data: vaas}
 	return &resp, err
 }
+
+// FindConflicts get the vaas received with the same id and a different digest.
+func (s *Service) FindConflicts(ctx context.Context, p *pagination.Pagination) (*response.Response[[]*VaaDoc], error) {
+	if p == nil {
+		p = pagination.Default()
+	}
+	vaas, err := s.repo.FindConflicts(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	s.setEmitterType(ctx, vaas...)
+	return &response.Response[[]*VaaDoc]{Data: vaas}, nil
+}
+
+const (
+	// RecentWindow is how far back the recent vaas can be read.
+	RecentWindow = time.Hour
+	// recentSettleDelay is the time a vaa takes to be visible once it is indexed.
+	// The recent vaas are only returned once they are settled, so the vaas indexed
+	// concurrently cannot be stored behind the cursor of a client.
+	recentSettleDelay = 10 * time.Second
+)
+
+// FindRecent get the vaas indexed after the `since` parameter, that is either a RFC3339 timestamp
+// or the cursor returned by a previous call, sorted by indexing time.
+//
+// The cursor of the next call is returned in the pagination of the response, the clients
+// that poll with it read every vaa once.
+func (s *Service) FindRecent(ctx context.Context, since string, limit int64) (*response.Response[[]*VaaDoc], error) {
+	now := time.Now()
+	after := RecentCursor{IndexedAt: now.Add(-RecentWindow)}
+	if since != "" {
+		var err error
+		if after, err = ParseRecentSince(since); err != nil {
+			return nil, errs.NewInvalidParam("since must be a RFC3339 timestamp or a cursor")
+		}
+		if after.IndexedAt.Before(now.Add(-RecentWindow)) {
+			return nil, errs.NewInvalidParam("since cannot be older than %s", RecentWindow)
+		}
+	}
+
+	query := RecentVaasQuery{
+		After:            after,
+		Until:            now.Add(-recentSettleDelay),
+		Limit:            limit,
+		ExcludedEmitters: s.excludedEmitters(ctx),
+	}
+	vaas := []*VaaDoc{}
+	if query.After.IndexedAt.Before(query.Until) {
+		var err error
+		if vaas, err = s.repo.FindRecentVaas(ctx, &query); err != nil {
+			return nil, err
+		}
+	}
+	s.setEmitterType(ctx, vaas...)
+
+	// the cursor does not move until new vaas are settled.
+	next := after
+	if len(vaas) > 0 && vaas[len(vaas)-1].IndexedAt != nil {
+		last := vaas[len(vaas)-1]
+		next = RecentCursor{IndexedAt: *last.IndexedAt, ID: last.ID}
+	}
+	return &response.Response[[]*VaaDoc]{Data: vaas, Pagination: response.ResponsePagination{Next: next.String()}}, nil
+}
+
+// setEmitterType tags the vaas emitted by the core protocol (governance, token bridge, nft bridge and relayer)
+// and the vaas emitted by arbitrary emitters.
+//
+// If the registered emitters cannot be loaded, the vaas are not tagged.
+func (s *Service) setEmitterType(ctx context.Context, vaas ...*VaaDoc) {
+	if s.emittersSrv == nil || len(vaas) == 0 {
+		return
+	}
+	emitterTypes, err := s.emittersSrv.GetEmitterTypes(ctx)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		s.logger.Warn("failed to get registered emitters", zap.Error(err), zap.String("requestID", requestID))
+		return
+	}
+	for _, v := range vaas {
+		v.EmitterType = emitterTypes.Get(v.EmitterChain, v.EmitterAddr)
+	}
+}
@@ -0,0 +1,103 @@
+package vaa
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaapayload"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type archiveMock map[string][]byte
+
+func (a archiveMock) Get(_ context.Context, vaaID string) ([]byte, error) {
+	vaa, ok := a[vaaID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return vaa, nil
+}
+
+func TestRestoreArchivedVaa(t *testing.T) {
+	archivedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	archive := archiveMock{"2/0001/1": []byte{1, 2, 3}}
+
+	s := NewService(nil, nil, 0, nil, nil, nil, nil, zap.NewNop())
+
+	// the archive is not enabled.
+	v := &VaaDoc{ID: "2/0001/1", ArchivedAt: &archivedAt}
+	assert.NoError(t, s.restoreArchivedVaa(context.Background(), v))
+	assert.Empty(t, v.Vaa)
+
+	s.SetArchive(archive)
+
+	// the vaa is read from the archive.
+	assert.NoError(t, s.restoreArchivedVaa(context.Background(), v))
+	assert.Equal(t, []byte{1, 2, 3}, []byte(v.Vaa))
+
+	// the vaa was not archived.
+	v = &VaaDoc{ID: "2/0001/2", Vaa: []byte{4}}
+	assert.NoError(t, s.restoreArchivedVaa(context.Background(), v))
+	assert.Equal(t, []byte{4}, []byte(v.Vaa))
+
+	// the vaa is missing in the archive.
+	v = &VaaDoc{ID: "2/0001/3", ArchivedAt: &archivedAt}
+	assert.ErrorIs(t, s.restoreArchivedVaa(context.Background(), v), errs.ErrInternalError)
+}
+
+func TestParseVaa(t *testing.T) {
+	// a governance vaa that sets the message fee of the core contracts to 1.
+	var payload bytes.Buffer
+	payload.Write(append(make([]byte, 28), "Core"...))
+	payload.Write([]byte{3, 0, 0})
+	payload.Write(append(make([]byte, 31), 1))
+	v := &sdk.VAA{
+		Version:          1,
+		GuardianSetIndex: 4,
+		Signatures:       []*sdk.Signature{{Index: 2}},
+		Timestamp:        time.Unix(1714521600, 0),
+		Nonce:            7,
+		Sequence:         42,
+		ConsistencyLevel: 1,
+		EmitterChain:     sdk.GovernanceChain,
+		EmitterAddress:   sdk.GovernanceEmitter,
+		Payload:          payload.Bytes(),
+	}
+	raw, err := v.Marshal()
+	require.NoError(t, err)
+
+	parseVaaFunc := func(*sdk.VAA) (any, error) { return nil, vaaPayloadParser.ErrNotFound }
+	s := NewService(nil, nil, 0, parseVaaFunc, nil, nil, nil, zap.NewNop())
+	s.SetP2pNetwork(domain.P2pMainNet)
+
+	// the payload parser does not support the vaa.
+	parsed, err := s.ParseVaa(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Equal(t, v.MessageID(), parsed.Header.ID)
+	assert.Equal(t, uint32(4), parsed.Header.GuardianSetIndex)
+	assert.Equal(t, uint64(42), parsed.Header.Sequence)
+	assert.Equal(t, v.HexDigest(), parsed.Header.Digest)
+	assert.Len(t, parsed.Header.Signatures, 1)
+	assert.Equal(t, vaapayload.TypeGovernance, parsed.Payload.Type)
+	assert.Equal(t, vaapayload.ActionSetMessageFee, parsed.Payload.Governance.Action)
+	assert.Equal(t, "1", parsed.Payload.Governance.Fee)
+	assert.Nil(t, parsed.ParsedPayload)
+
+	// the parsed payload is added.
+	s.parseVaaFunc = func(*sdk.VAA) (any, error) { return map[string]any{"fee": "1"}, nil }
+	parsed, err = s.ParseVaa(context.Background(), raw)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"fee": "1"}, parsed.ParsedPayload)
+
+	_, err = s.ParseVaa(context.Background(), []byte{1, 2, 3})
+	assert.Error(t, err)
+}
@@ -0,0 +1,22 @@
+package vaa
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/common/types"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Store is the storage of the vaas read by the vaa service.
+// It is implemented by Repository on mongodb and by PostgresRepository on PostgreSQL.
+type Store interface {
+	FindVaasByTxHashWorkaround(ctx context.Context, query *VaaQuery) ([]*VaaDoc, error)
+	FindVaasByEmitterAndToChain(ctx context.Context, query *VaaQuery, toChain sdk.ChainID) ([]*VaaDoc, error)
+	FindVaas(ctx context.Context, q *VaaQuery) ([]*VaaDoc, error)
+	GetVaaCount(ctx context.Context, q *VaaQuery) ([]*VaaStats, error)
+	FindDuplicatedByID(ctx context.Context, chain sdk.ChainID, emitter *types.Address, seq string) ([]*VaaDoc, error)
+	FindConflicts(ctx context.Context, p *pagination.Pagination) ([]*VaaDoc, error)
+	FindRecentVaas(ctx context.Context, q *RecentVaasQuery) ([]*VaaDoc, error)
+	FindObservationsByID(ctx context.Context, chain sdk.ChainID, emitter *types.Address, seq string) ([]*ObservationSummary, error)
+}
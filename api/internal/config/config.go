@@ -10,11 +10,14 @@ import (
 
 	ipfslog "github.com/ipfs/go-log/v2"
 	"github.com/spf13/viper"
+	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/policy"
 )
 
 const (
-	RunModeProduction   = "PRODUCTION"
-	RunModeDevelopmernt = "DEVELOPMENT"
+	RunModeProduction   = policy.RunModeProduction
+	RunModeTestnet      = policy.RunModeTestnet
+	RunModeDevelopmernt = policy.RunModeDevelopment
 )
 
 // p2p network constants.
@@ -24,6 +27,12 @@ const (
 	P2pDevNet  = "devnet"
 )
 
+// storage backend constants.
+const (
+	StorageBackendMongo    = "mongo"
+	StorageBackendPostgres = "postgres"
+)
+
 // AppConfig defines the configuration for the app.
 type AppConfig struct {
 	DB struct {
@@ -31,6 +40,15 @@ type AppConfig struct {
 		// database name
 		Name string
 	}
+	Storage struct {
+		// Backend used to read the core collections: "mongo" (default) or "postgres".
+		//
+		// The "postgres" tables are copied from mongodb by the postgres sync job (JOB_POSTGRES_SYNC),
+		// the job must be scheduled before the api reads them.
+		Backend string
+		// PostgresURL is the connection string used when the backend is "postgres".
+		PostgresURL string
+	}
 	Cache struct {
 		URL                      string
 		TvlKey                   string
@@ -40,6 +58,16 @@ type AppConfig struct {
 		Prefix                   string
 		ProtocolsStatsKey        string
 		ProtocolsStatsExpiration int
+		// VaaExpiration in minutes of the vaas cached by id, zero disables the vaa cache.
+		VaaExpiration int
+		// LocalExpiration in seconds of the values kept in memory in front of redis, zero disables the local cache.
+		LocalExpiration int
+		// LocalMaxEntries is the maximum number of values kept in memory.
+		LocalMaxEntries int
+		// ExpirationJitter in percentage randomizes the expirations so the keys do not expire at the same time.
+		ExpirationJitter int
+		// Password of redis, empty when redis does not require authentication.
+		Password string
 	}
 	PORT         int
 	LogLevel     string
@@ -47,7 +75,9 @@ type AppConfig struct {
 	P2pNetwork   string
 	PprofEnabled bool
 	Environment  string
-	Influx       struct {
+	// PendingRedeemsInterval in minutes of the refresh of the pending redeems metric, zero disables the metric.
+	PendingRedeemsInterval int
+	Influx                 struct {
 		URL            string
 		Token          string
 		Organization   string
@@ -65,16 +95,138 @@ type AppConfig struct {
 		URL     string
 		Timeout int64
 	}
+	OriginTxResolver struct {
+		Enabled bool
+		// URL of the tx-tracker internal gRPC service.
+		URL string
+		// Timeout in milliseconds to resolve an origin tx.
+		Timeout int64
+		// CacheExpiration in minutes of the resolved origin txs.
+		CacheExpiration int
+		// UnresolvedCacheExpiration in seconds of the origin txs that could not be resolved,
+		// so they are not resolved again on every request.
+		UnresolvedCacheExpiration int
+	}
+	// VaaArchive is the S3 bucket of the raw vaas moved out of the database by the archive job.
+	VaaArchive struct {
+		Enabled bool
+		Bucket  string
+		Region  string
+		// Endpoint overrides the S3 endpoint, e.g. for localstack.
+		Endpoint        string
+		AccessKeyID     string
+		SecretAccessKey string
+	}
 	RateLimit struct {
 		Enabled bool
 		// Max number of requests per minute
 		Max int
+		// Burst of requests by ip to the wormscan routes, zero uses the max.
+		Burst int
+		// GuardianMax number of requests per minute by ip to the guardian routes, zero uses the max.
+		GuardianMax int
+		// GuardianBurst of requests by ip to the guardian routes, zero uses the guardian max.
+		GuardianBurst int
+		// ApiKeyMax number of requests per minute by api token, zero does not limit the requests with an api token.
+		ApiKeyMax int
 		// Prefix for redis keys
 		Prefix string
 		//Api Tokens
 		Tokens string
 	}
+	LoadShedding struct {
+		Enabled bool
+		// MongoLatencyThreshold in milliseconds of the average latency of the mongo queries.
+		MongoLatencyThreshold int
+		// InfluxLatencyThreshold in milliseconds of the average latency of the influx queries.
+		InfluxLatencyThreshold int
+		// RetryAfter in seconds sent in the rejected requests.
+		RetryAfter int
+	}
+	// SLO defines the service level objectives of the endpoints reported in /api/v1/infrastructure/slo.
+	SLO struct {
+		// Availability is the target ratio of the requests without server errors.
+		Availability float64
+		// Latency is the target ratio of the requests faster than the latency threshold.
+		Latency float64
+		// LatencyThreshold in milliseconds of the duration of a fast request.
+		LatencyThreshold int
+		// Window in minutes of the rolling window of the objectives.
+		Window int
+		// PrometheusURL of the prometheus server that scrapes the api instances, the objectives are computed from
+		// the requests of all of them. The endpoint is disabled and answers 404 when it is empty.
+		PrometheusURL string
+	}
+	// Secrets defines the secret stores of the config values set with a secret reference instead of the plain value,
+	// e.g. WORMSCAN_DB_URL=aws-secret://prod/wormscan-api#mongo_uri or WORMSCAN_INFLUX_TOKEN=vault://secret/data/wormscan-api#influx_token.
+	Secrets struct {
+		// AwsRegion of AWS Secrets Manager, the aws-secret references are disabled when empty.
+		AwsRegion string
+		// AwsEndpoint overrides the AWS Secrets Manager endpoint, e.g. for localstack.
+		AwsEndpoint string
+		// VaultAddr is the address of the Vault server, the vault references are disabled when empty.
+		VaultAddr  string
+		VaultToken string
+		// RotationInterval in minutes to fetch the secrets again, the api is stopped to start again with the new secrets
+		// when a secret changed. Zero disables the rotation.
+		RotationInterval int
+	}
+	// Reload defines the file with the settings that can be changed without restarting the api,
+	// e.g. a mounted configmap. The file is read again on SIGHUP and when it changes.
+	Reload struct {
+		// File is the path of the json file with the reloadable settings, the reload is disabled when empty.
+		File string
+		// Interval in seconds to check the changes of the file, zero only reloads the file on SIGHUP.
+		Interval int
+	}
+	// Observations defines the guards of the observations listing endpoints.
+	Observations struct {
+		// MaxPageSize is the maximum page size of the observations and observations by chain listings.
+		MaxPageSize int64
+		// MaxPageSizeByEmitter is the maximum page size of the observations by emitter and by vaa listings.
+		MaxPageSizeByEmitter int64
+		// MaxTimeRange in hours of the from/to window of the listings, zero disables the window.
+		MaxTimeRange int
+		// MaxScan is the maximum number of observations (page * pageSize + pageSize) a listing can scan.
+		MaxScan int64
+	}
+	// Governor defines the settings of the governor endpoints.
+	Governor struct {
+		// Quorum overrides the number of guardians required to reach quorum in the governor limits,
+		// zero calculates it from the current guardian set.
+		Quorum int
+		// StatusStaleThreshold in minutes after which the governor status of a guardian node is flagged as stale.
+		StatusStaleThreshold int
+	}
 	Protocols []string
+	// ChainIDOverrides registers chains not known by the wormhole sdk with the format "id:name,id:name".
+	ChainIDOverrides string
+	// RegisteredEmitters seeds the official emitters not registered by governance vaas yet
+	// with the format "module:chain:address,module:chain:address".
+	RegisteredEmitters string
+	// EmitterRemapping aggregates the stats of the emitters replaced by a redeployed contract (e.g. a new token bridge)
+	// with the new emitter, with the format "chainId:oldEmitterAddress:newEmitterAddress,...".
+	EmitterRemapping string
+	// AdminApiKey is required in the X-Api-Key header of the admin endpoints.
+	// If it is empty, the admin endpoints reject all the requests.
+	AdminApiKey string
+	// EmitterDenyListEnabled excludes the messages of the denied emitters from the vaa, operation and transaction listings.
+	EmitterDenyListEnabled bool
+	// DataWipeEnabled allows deleting all the data of a chain with the admin endpoint, e.g. after a testnet redeployment.
+	// It is disabled by default and must only be enabled in the testnet environments.
+	DataWipeEnabled bool
+
+	// LogRedaction defines the data removed from the logs by the operators with stricter privacy rules.
+	LogRedaction struct {
+		// Fields are the comma separated keys of the log fields whose values are removed.
+		Fields string
+		// TruncatedFields are the comma separated keys of the log fields whose values are truncated, e.g. addresses.
+		TruncatedFields string
+		// TruncateLength is the number of leading and trailing characters kept in the truncated fields.
+		TruncateLength int
+		// IPs removes the ip addresses from the request logs and from the messages and errors of the logs.
+		IPs bool
+	}
 }
 
 // GetLogLevel get zapcore.Level define in the configuraion.
@@ -82,8 +234,14 @@ func (cfg *AppConfig) GetLogLevel() (ipfslog.LogLevel, error) {
 	return ipfslog.LevelFromString(cfg.LogLevel)
 }
 
+// GetPolicy returns the operational policy of the run mode, e.g. the default rate limit.
+func (cfg *AppConfig) GetPolicy() policy.Policy {
+	return policy.ForRunMode(cfg.RunMode)
+}
+
 func defaulConfig() *AppConfig {
 	return &AppConfig{
+		PendingRedeemsInterval: 5,
 		Cache: struct {
 			URL                      string
 			TvlKey                   string
@@ -93,8 +251,76 @@ func defaulConfig() *AppConfig {
 			Prefix                   string
 			ProtocolsStatsKey        string
 			ProtocolsStatsExpiration int
+			VaaExpiration            int
+			LocalExpiration          int
+			LocalMaxEntries          int
+			ExpirationJitter         int
+			Password                 string
 		}{
 			MetricExpiration: 10,
+			VaaExpiration:    5,
+			LocalMaxEntries:  10000,
+		},
+		LoadShedding: struct {
+			Enabled                bool
+			MongoLatencyThreshold  int
+			InfluxLatencyThreshold int
+			RetryAfter             int
+		}{
+			MongoLatencyThreshold:  2000,
+			InfluxLatencyThreshold: 5000,
+			RetryAfter:             30,
+		},
+		SLO: struct {
+			Availability     float64
+			Latency          float64
+			LatencyThreshold int
+			Window           int
+			PrometheusURL    string
+		}{
+			Availability:     0.999,
+			Latency:          0.99,
+			LatencyThreshold: 1000,
+			Window:           1440,
+		},
+		Secrets: struct {
+			AwsRegion        string
+			AwsEndpoint      string
+			VaultAddr        string
+			VaultToken       string
+			RotationInterval int
+		}{
+			RotationInterval: 60,
+		},
+		Reload: struct {
+			File     string
+			Interval int
+		}{
+			Interval: 60,
+		},
+		Observations: struct {
+			MaxPageSize          int64
+			MaxPageSizeByEmitter int64
+			MaxTimeRange         int
+			MaxScan              int64
+		}{
+			MaxPageSize:          1000,
+			MaxPageSizeByEmitter: 1000,
+			MaxScan:              100000,
+		},
+		Governor: struct {
+			Quorum               int
+			StatusStaleThreshold int
+		}{
+			StatusStaleThreshold: 15,
+		},
+		LogRedaction: struct {
+			Fields          string
+			TruncatedFields string
+			TruncateLength  int
+			IPs             bool
+		}{
+			TruncateLength: 6,
 		},
 	}
 }
@@ -104,8 +330,11 @@ func init() {
 	viper.SetDefault("loglevel", "INFO")
 	viper.SetDefault("runmode", "PRODUCTION")
 	viper.SetDefault("p2pnetwork", P2pMainNet)
+	viper.SetDefault("Storage_Backend", StorageBackendMongo)
 	viper.SetDefault("PprofEnabled", false)
 	viper.SetDefault("RateLimit_Enabled", true)
+	viper.SetDefault("EmitterDenyListEnabled", true)
+	viper.SetDefault("DataWipeEnabled", false)
 
 	// Consider environment variables in unmarshall doesn't work unless doing this: https://github.com/spf13/viper/issues/188#issuecomment-1168898503
 	b, err := json.Marshal(defaulConfig())
@@ -137,6 +366,21 @@ func Get() (*AppConfig, error) {
 	return &cfg, err
 }
 
-func (c *AppConfig) GetApiTokens() []string {
-	return strings.Split(c.RateLimit.Tokens, ",")
+// GetLogRedaction returns the configuration of the data removed from the logs.
+func (c *AppConfig) GetLogRedaction() logger.RedactConfig {
+	split := func(s string) []string {
+		var values []string
+		for _, v := range strings.Split(s, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		return values
+	}
+	return logger.RedactConfig{
+		Fields:          split(c.LogRedaction.Fields),
+		TruncatedFields: split(c.LogRedaction.TruncatedFields),
+		TruncateLength:  c.LogRedaction.TruncateLength,
+		IPs:             c.LogRedaction.IPs,
+	}
 }
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Reloadable is the subset of the settings that can be changed without restarting the api.
+// The settings missing in the reload file keep the values the api was started with.
+type Reloadable struct {
+	RateLimit struct {
+		// Max number of requests per minute, zero uses the rate limit of the run mode.
+		Max int `json:"max"`
+		// Burst of requests by ip to the wormscan routes, zero uses the max.
+		Burst int `json:"burst"`
+		// GuardianMax number of requests per minute by ip to the guardian routes, zero uses the max.
+		GuardianMax int `json:"guardianMax"`
+		// GuardianBurst of requests by ip to the guardian routes, zero uses the guardian max.
+		GuardianBurst int `json:"guardianBurst"`
+		// ApiKeyMax number of requests per minute by api token, zero does not limit the requests with an api token.
+		ApiKeyMax int `json:"apiKeyMax"`
+		// Tokens are the api tokens limited by token instead of by ip, separated by commas.
+		Tokens string `json:"tokens"`
+	} `json:"rateLimit"`
+	Cache struct {
+		// MetricExpiration in minutes of the cached metrics and stats.
+		MetricExpiration int `json:"metricExpiration"`
+		// VaaExpiration in minutes of the vaas cached by id, zero disables the vaa cache.
+		VaaExpiration int `json:"vaaExpiration"`
+	} `json:"cache"`
+}
+
+// GetReloadable returns the reloadable settings the api was started with.
+func (c *AppConfig) GetReloadable() *Reloadable {
+	r := c.reloadable()
+	r.setDefaults(c)
+	return r
+}
+
+// reloadable returns the reloadable settings of the configuration, without defaults
+// so the settings left to zero in the reload file get the defaults too.
+func (c *AppConfig) reloadable() *Reloadable {
+	var r Reloadable
+	r.RateLimit.Max = c.RateLimit.Max
+	r.RateLimit.Burst = c.RateLimit.Burst
+	r.RateLimit.GuardianMax = c.RateLimit.GuardianMax
+	r.RateLimit.GuardianBurst = c.RateLimit.GuardianBurst
+	r.RateLimit.ApiKeyMax = c.RateLimit.ApiKeyMax
+	r.RateLimit.Tokens = c.RateLimit.Tokens
+	r.Cache.MetricExpiration = c.Cache.MetricExpiration
+	r.Cache.VaaExpiration = c.Cache.VaaExpiration
+	return &r
+}
+
+// LoadReloadable returns a function that parses and validates the content of the reload file
+// on top of the settings the api was started with.
+func (c *AppConfig) LoadReloadable() func(data []byte) (*Reloadable, error) {
+	return func(data []byte) (*Reloadable, error) {
+		r := c.reloadable()
+		if err := json.Unmarshal(data, r); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal reloadable settings: %w", err)
+		}
+		r.setDefaults(c)
+		if err := r.validate(); err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+}
+
+// setDefaults sets the rate limits left to zero, e.g. the max requests default to the rate limit of the run mode.
+func (r *Reloadable) setDefaults(c *AppConfig) {
+	if r.RateLimit.Max == 0 {
+		r.RateLimit.Max = c.GetPolicy().RateLimitMax
+	}
+	if r.RateLimit.GuardianMax == 0 {
+		r.RateLimit.GuardianMax = r.RateLimit.Max
+	}
+}
+
+func (r *Reloadable) validate() error {
+	if r.RateLimit.Max < 0 || r.RateLimit.GuardianMax < 0 || r.RateLimit.ApiKeyMax < 0 {
+		return errors.New("rate limit max must not be negative")
+	}
+	if r.RateLimit.Burst < 0 || r.RateLimit.GuardianBurst < 0 {
+		return errors.New("rate limit burst must not be negative")
+	}
+	if r.Cache.MetricExpiration <= 0 {
+		return errors.New("cache metric expiration must be greater than zero")
+	}
+	if r.Cache.VaaExpiration < 0 {
+		return errors.New("cache vaa expiration must not be negative")
+	}
+	return nil
+}
+
+// GetApiTokens returns the api tokens limited by token instead of by ip.
+func (r *Reloadable) GetApiTokens() []string {
+	return strings.Split(r.RateLimit.Tokens, ",")
+}
@@ -1,10 +1,58 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+)
 
 // Error definitions to use in service and repository layers.
 var (
 	ErrMalformedQuery = errors.New("MALFORMED_QUERY")
-	ErrNotFound       = errors.New("NOT FOUND")
-	ErrInternalError  = errors.New("INTERNAL ERROR")
+	// ErrNotFound is the error returned by the common repository helpers when no document was found.
+	ErrNotFound      = repository.ErrNotFound
+	ErrInvalidParam  = errors.New("INVALID PARAM")
+	ErrInternalError = errors.New("INTERNAL ERROR")
+	// ErrQueryTooLarge is returned when a query would scan more documents than allowed.
+	ErrQueryTooLarge = errors.New("QUERY TOO LARGE")
 )
+
+// Error is an error of a given kind with a message that can be returned to the client.
+//
+// The kind is one of the sentinel errors defined in this package, so the error can be
+// checked with errors.Is, e.g. errors.Is(err, ErrNotFound).
+type Error struct {
+	kind    error
+	message string
+}
+
+// Error returns the message of the error.
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Unwrap returns the kind of the error.
+func (e *Error) Unwrap() error {
+	return e.kind
+}
+
+// Message returns the message of the error.
+func (e *Error) Message() string {
+	return e.message
+}
+
+// NewNotFound creates an error of kind ErrNotFound.
+func NewNotFound(format string, args ...any) error {
+	return &Error{kind: ErrNotFound, message: fmt.Sprintf(format, args...)}
+}
+
+// NewInvalidParam creates an error of kind ErrInvalidParam.
+func NewInvalidParam(format string, args ...any) error {
+	return &Error{kind: ErrInvalidParam, message: fmt.Sprintf(format, args...)}
+}
+
+// NewQueryTooLarge creates an error of kind ErrQueryTooLarge.
+func NewQueryTooLarge(format string, args ...any) error {
+	return &Error{kind: ErrQueryTooLarge, message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,124 @@
+// Package flux contains helpers to build the parts of the flux queries that contain user parameters.
+//
+// The values are always rendered as flux literals, so a parameter can not end a string literal
+// or start an interpolation and change the query. The column names are not escaped, they must
+// be identifiers defined by the caller.
+package flux
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// String returns the flux string literal of s.
+func String(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case '$':
+			// ${ starts an interpolation in a flux string.
+			if i+1 < len(s) && s[i+1] == '{' {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// StringArray returns the flux array literal of the strings.
+func StringArray(values []string) string {
+	literals := make([]string, 0, len(values))
+	for _, v := range values {
+		literals = append(literals, String(v))
+	}
+	return "[" + strings.Join(literals, ",") + "]"
+}
+
+// Time returns the flux time literal of t, in UTC with second precision.
+func Time(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// WithLocation sets the location option of the query, so the windows of days, months and years
+// start at midnight of loc. The query is returned unchanged when loc is nil or UTC.
+func WithLocation(query string, loc *time.Location) string {
+	if loc == nil || loc.String() == time.UTC.String() {
+		return query
+	}
+	// the options must be declared after the imports.
+	lines := strings.Split(query, "\n")
+	lastImport := -1
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "import ") {
+			lastImport = i
+		}
+	}
+	result := make([]string, 0, len(lines)+2)
+	result = append(result, `import "timezone"`)
+	result = append(result, lines[:lastImport+1]...)
+	result = append(result, "option location = timezone.location(name: "+String(loc.String())+")")
+	result = append(result, lines[lastImport+1:]...)
+	return strings.Join(result, "\n")
+}
+
+// Eq returns a predicate that checks that a column of the row is equal to value.
+func Eq(column, value string) string {
+	return "r." + column + " == " + String(value)
+}
+
+// In returns a predicate that checks that a column of the row is equal to any of the values.
+// It returns an empty predicate when there are no values.
+func In(column string, values ...string) string {
+	predicates := make([]string, 0, len(values))
+	for _, v := range values {
+		predicates = append(predicates, Eq(column, v))
+	}
+	return Or(predicates...)
+}
+
+// ChainIn returns a predicate that checks that a chain column of the row is equal to any of the chains.
+// It returns an empty predicate when there are no chains.
+func ChainIn(column string, chains ...sdk.ChainID) string {
+	values := make([]string, 0, len(chains))
+	for _, c := range chains {
+		values = append(values, strconv.Itoa(int(c)))
+	}
+	return In(column, values...)
+}
+
+// Or joins the predicates with the or operator.
+func Or(predicates ...string) string {
+	return strings.Join(predicates, " or ")
+}
+
+// And joins the predicates with the and operator.
+func And(predicates ...string) string {
+	return strings.Join(predicates, " and ")
+}
+
+// Filter returns a filter step that keeps the rows that match the predicate.
+// It returns an empty step when the predicate is empty.
+func Filter(predicate string) string {
+	if predicate == "" {
+		return ""
+	}
+	return "|> filter(fn: (r) => " + predicate + ")"
+}
@@ -0,0 +1,105 @@
+package flux
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "USDC", expected: `"USDC"`},
+		{value: "", expected: `""`},
+		{value: `a" or true or r.symbol == "b`, expected: `"a\" or true or r.symbol == \"b"`},
+		{value: `back\slash`, expected: `"back\\slash"`},
+		{value: "${r._value}", expected: `"\${r._value}"`},
+		{value: "$5", expected: `"$5"`},
+		{value: "line\nbreak\ttab\r", expected: `"line\nbreak\ttab\r"`},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, String(tt.value), tt.value)
+	}
+}
+
+func TestStringArray(t *testing.T) {
+	assert.Equal(t, `[]`, StringArray(nil))
+	assert.Equal(t, `["PORTAL_TOKEN_BRIDGE","CCTP_\"X"]`, StringArray([]string{"PORTAL_TOKEN_BRIDGE", `CCTP_"X`}))
+}
+
+func TestTime(t *testing.T) {
+	tm := time.Date(2024, 3, 3, 5, 10, 20, 123, time.FixedZone("UTC-3", -3*60*60))
+	assert.Equal(t, "2024-03-03T08:10:20Z", Time(tm))
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		expected string
+	}{
+		{
+			name:     "eq",
+			filter:   Filter(Eq("app_id", "CCTP_WORMHOLE_INTEGRATION")),
+			expected: `|> filter(fn: (r) => r.app_id == "CCTP_WORMHOLE_INTEGRATION")`,
+		},
+		{
+			name:     "in",
+			filter:   Filter(In("symbol", "USDC", "WETH")),
+			expected: `|> filter(fn: (r) => r.symbol == "USDC" or r.symbol == "WETH")`,
+		},
+		{
+			name:     "in without values",
+			filter:   Filter(In("symbol")),
+			expected: "",
+		},
+		{
+			name:     "chain in",
+			filter:   Filter(ChainIn("emitter_chain", sdk.ChainIDSolana, sdk.ChainIDEthereum)),
+			expected: `|> filter(fn: (r) => r.emitter_chain == "1" or r.emitter_chain == "2")`,
+		},
+		{
+			name:     "and",
+			filter:   Filter(And(Eq("app_id_1", "X"), Eq("app_id_2", "none"))),
+			expected: `|> filter(fn: (r) => r.app_id_1 == "X" and r.app_id_2 == "none")`,
+		},
+		{
+			name:     "escaped value",
+			filter:   Filter(Eq("app_id", `X") |> drop(columns: ["_value"]`)),
+			expected: `|> filter(fn: (r) => r.app_id == "X\") |> drop(columns: [\"_value\"]")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter)
+		})
+	}
+}
+
+func TestWithLocation(t *testing.T) {
+	query := `
+	import "date"
+	import "join"
+
+	from(bucket: "b")`
+	assert.Equal(t, query, WithLocation(query, nil))
+	assert.Equal(t, query, WithLocation(query, time.UTC))
+
+	loc, err := time.LoadLocation("America/Argentina/Buenos_Aires")
+	assert.NoError(t, err)
+	expected := `import "timezone"
+
+	import "date"
+	import "join"
+option location = timezone.location(name: "America/Argentina/Buenos_Aires")
+
+	from(bucket: "b")`
+	assert.Equal(t, expected, WithLocation(query, loc))
+
+	assert.Equal(t, "import \"timezone\"\noption location = timezone.location(name: \"America/Argentina/Buenos_Aires\")\nfrom(bucket: \"b\")",
+		WithLocation(`from(bucket: "b")`, loc))
+}
@@ -0,0 +1,86 @@
+// Package format contains helpers to build human-readable representations of the values returned by the api.
+//
+// The formatting is intentionally locale-agnostic (english units, dot as decimal separator),
+// so lightweight clients can display the values without doing any number or time formatting.
+package format
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+var usdUnits = []struct {
+	value  float64
+	suffix string
+}{
+	{value: 1e12, suffix: "T"},
+	{value: 1e9, suffix: "B"},
+	{value: 1e6, suffix: "M"},
+	{value: 1e3, suffix: "K"},
+}
+
+// USD returns a compact human-readable representation of an amount in USD (e.g. $1.23M).
+func USD(amount float64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = math.Abs(amount)
+	}
+	for _, u := range usdUnits {
+		if amount >= u.value {
+			return fmt.Sprintf("%s$%.2f%s", sign, amount/u.value, u.suffix)
+		}
+	}
+	return fmt.Sprintf("%s$%.2f", sign, amount)
+}
+
+// USDFromString returns the human-readable representation of an amount in USD encoded as a string.
+// If the amount cannot be parsed, an empty string is returned.
+func USDFromString(amount string) string {
+	if amount == "" {
+		return ""
+	}
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return ""
+	}
+	return USD(value)
+}
+
+// RelativeTime returns the time t relative to now (e.g. "5 minutes ago", "in 2 hours").
+func RelativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	if d < time.Minute {
+		return "just now"
+	}
+
+	var value int64
+	var unit string
+	switch {
+	case d < time.Hour:
+		value, unit = int64(d/time.Minute), "minute"
+	case d < 24*time.Hour:
+		value, unit = int64(d/time.Hour), "hour"
+	case d < 30*24*time.Hour:
+		value, unit = int64(d/(24*time.Hour)), "day"
+	case d < 365*24*time.Hour:
+		value, unit = int64(d/(30*24*time.Hour)), "month"
+	default:
+		value, unit = int64(d/(365*24*time.Hour)), "year"
+	}
+	if value != 1 {
+		unit += "s"
+	}
+
+	if future {
+		return fmt.Sprintf("in %d %s", value, unit)
+	}
+	return fmt.Sprintf("%d %s ago", value, unit)
+}
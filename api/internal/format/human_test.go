@@ -0,0 +1,64 @@
+package format
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUSD(t *testing.T) {
+	testCases := []struct {
+		amount   float64
+		expected string
+	}{
+		{amount: 0, expected: "$0.00"},
+		{amount: 12.346, expected: "$12.35"},
+		{amount: 999.99, expected: "$999.99"},
+		{amount: 1500, expected: "$1.50K"},
+		{amount: 1234567, expected: "$1.23M"},
+		{amount: 4560000000, expected: "$4.56B"},
+		{amount: 7e12, expected: "$7.00T"},
+		{amount: -2500, expected: "-$2.50K"},
+	}
+
+	for _, tc := range testCases {
+		if got := USD(tc.amount); got != tc.expected {
+			t.Errorf("USD(%v): expected %s, got %s", tc.amount, tc.expected, got)
+		}
+	}
+}
+
+func TestUSDFromString(t *testing.T) {
+	if got := USDFromString("1234567.89"); got != "$1.23M" {
+		t.Errorf("expected $1.23M, got %s", got)
+	}
+	if got := USDFromString(""); got != "" {
+		t.Errorf("expected empty string, got %s", got)
+	}
+	if got := USDFromString("not a number"); got != "" {
+		t.Errorf("expected empty string, got %s", got)
+	}
+}
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		t        time.Time
+		expected string
+	}{
+		{t: now.Add(-10 * time.Second), expected: "just now"},
+		{t: now.Add(-1 * time.Minute), expected: "1 minute ago"},
+		{t: now.Add(-5 * time.Minute), expected: "5 minutes ago"},
+		{t: now.Add(-3 * time.Hour), expected: "3 hours ago"},
+		{t: now.Add(-48 * time.Hour), expected: "2 days ago"},
+		{t: now.Add(-90 * 24 * time.Hour), expected: "3 months ago"},
+		{t: now.Add(-800 * 24 * time.Hour), expected: "2 years ago"},
+		{t: now.Add(2 * time.Hour), expected: "in 2 hours"},
+	}
+
+	for _, tc := range testCases {
+		if got := RelativeTime(tc.t, now); got != tc.expected {
+			t.Errorf("RelativeTime(%v): expected %s, got %s", tc.t, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package loadshed
+
+import (
+	"context"
+	"time"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// WrapInfluxClient returns an influx client that records the latency of the queries in the monitor.
+func WrapInfluxClient(client influxdb2.Client, monitor *Monitor) influxdb2.Client {
+	return &influxClient{Client: client, monitor: monitor}
+}
+
+type influxClient struct {
+	influxdb2.Client
+	monitor *Monitor
+}
+
+// QueryAPI returns a query api that records the latency of the queries.
+func (c *influxClient) QueryAPI(org string) api.QueryAPI {
+	return &influxQueryAPI{QueryAPI: c.Client.QueryAPI(org), monitor: c.monitor}
+}
+
+type influxQueryAPI struct {
+	api.QueryAPI
+	monitor *Monitor
+}
+
+// Query executes a flux query and records the time to get the response.
+func (q *influxQueryAPI) Query(ctx context.Context, query string) (*api.QueryTableResult, error) {
+	start := time.Now()
+	result, err := q.QueryAPI.Query(ctx, query)
+	q.monitor.Observe(UpstreamInflux, time.Since(start))
+	return result, err
+}
+
+// QueryRaw executes a flux query and records the time to get the response.
+func (q *influxQueryAPI) QueryRaw(ctx context.Context, query string, dialect *domain.Dialect) (string, error) {
+	start := time.Now()
+	result, err := q.QueryAPI.QueryRaw(ctx, query, dialect)
+	q.monitor.Observe(UpstreamInflux, time.Since(start))
+	return result, err
+}
@@ -0,0 +1,122 @@
+// Package loadshed tracks the latency of the upstream databases to reject the low-priority
+// requests while they are overloaded.
+package loadshed
+
+import (
+	"sync"
+	"time"
+)
+
+// upstream names.
+const (
+	UpstreamMongo  = "mongo"
+	UpstreamInflux = "influx"
+)
+
+const (
+	// smoothing is the weight of the last observation in the moving average of the latency.
+	smoothing = 0.2
+	// recovery is the ratio of the threshold the latency must go below to stop shedding,
+	// so the shedding does not flap when the latency is close to the threshold.
+	recovery = 0.8
+)
+
+// Monitor tracks the latency of the upstreams with an exponentially weighted moving average.
+//
+// An upstream is overloaded when its average latency crosses its threshold, and it remains overloaded
+// until the average latency goes below 80% of the threshold. The low-priority requests are not sent
+// while an upstream is overloaded, so the observations expire after [probeInterval] to let requests
+// through and measure the latency again.
+type Monitor struct {
+	thresholds    map[string]time.Duration
+	probeInterval time.Duration
+	now           func() time.Time
+	mu            sync.Mutex
+	upstreams     map[string]*upstream
+}
+
+type upstream struct {
+	latency    float64
+	overloaded bool
+	observedAt time.Time
+}
+
+// Status is the latency status of an upstream.
+type Status struct {
+	Upstream   string        `json:"upstream"`
+	Latency    time.Duration `json:"latency"`
+	Threshold  time.Duration `json:"threshold"`
+	Overloaded bool          `json:"overloaded"`
+}
+
+// NewMonitor creates a monitor with the latency threshold of each upstream.
+// The upstreams without threshold are never overloaded.
+func NewMonitor(thresholds map[string]time.Duration, probeInterval time.Duration) *Monitor {
+	return &Monitor{
+		thresholds:    thresholds,
+		probeInterval: probeInterval,
+		now:           time.Now,
+		upstreams:     make(map[string]*upstream),
+	}
+}
+
+// Observe records the latency of a request to an upstream.
+func (m *Monitor) Observe(name string, elapsed time.Duration) {
+	threshold, ok := m.thresholds[name]
+	if !ok || threshold <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	u, ok := m.upstreams[name]
+	if !ok || m.expired(u, now) {
+		u = &upstream{latency: float64(elapsed)}
+		m.upstreams[name] = u
+	} else {
+		u.latency = smoothing*float64(elapsed) + (1-smoothing)*u.latency
+	}
+	u.observedAt = now
+
+	switch {
+	case u.latency > float64(threshold):
+		u.overloaded = true
+	case u.latency < recovery*float64(threshold):
+		u.overloaded = false
+	}
+}
+
+// Overloaded returns true if any upstream is overloaded.
+func (m *Monitor) Overloaded() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	for _, u := range m.upstreams {
+		if u.overloaded && !m.expired(u, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// Status returns the latency status of the observed upstreams.
+func (m *Monitor) Status() []Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	status := make([]Status, 0, len(m.upstreams))
+	for name, u := range m.upstreams {
+		status = append(status, Status{
+			Upstream:   name,
+			Latency:    time.Duration(u.latency),
+			Threshold:  m.thresholds[name],
+			Overloaded: u.overloaded && !m.expired(u, now),
+		})
+	}
+	return status
+}
+
+func (m *Monitor) expired(u *upstream, now time.Time) bool {
+	return now.Sub(u.observedAt) > m.probeInterval
+}
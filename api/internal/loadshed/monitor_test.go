@@ -0,0 +1,69 @@
+package loadshed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonitor_Overloaded(t *testing.T) {
+
+	now := time.Now()
+	m := NewMonitor(map[string]time.Duration{UpstreamMongo: 100 * time.Millisecond}, 30*time.Second)
+	m.now = func() time.Time { return now }
+
+	m.Observe(UpstreamMongo, 50*time.Millisecond)
+	assert.False(t, m.Overloaded())
+
+	// the average crosses the threshold after a few slow queries.
+	for i := 0; i < 5; i++ {
+		m.Observe(UpstreamMongo, time.Second)
+	}
+	assert.True(t, m.Overloaded())
+
+	// the upstream remains overloaded until the average goes below 80% of the threshold.
+	for statusOf(m, UpstreamMongo).Latency > 90*time.Millisecond {
+		m.Observe(UpstreamMongo, 10*time.Millisecond)
+	}
+	assert.True(t, m.Overloaded())
+	for statusOf(m, UpstreamMongo).Latency > 70*time.Millisecond {
+		m.Observe(UpstreamMongo, 10*time.Millisecond)
+	}
+	assert.False(t, m.Overloaded())
+}
+
+func TestMonitor_Expiration(t *testing.T) {
+
+	now := time.Now()
+	m := NewMonitor(map[string]time.Duration{UpstreamInflux: 100 * time.Millisecond}, 30*time.Second)
+	m.now = func() time.Time { return now }
+
+	m.Observe(UpstreamInflux, time.Second)
+	assert.True(t, m.Overloaded())
+
+	// without new observations the upstream is probed again.
+	now = now.Add(31 * time.Second)
+	assert.False(t, m.Overloaded())
+
+	// the first observation after the expiration resets the average.
+	m.Observe(UpstreamInflux, 10*time.Millisecond)
+	assert.False(t, m.Overloaded())
+}
+
+func TestMonitor_UpstreamWithoutThreshold(t *testing.T) {
+	m := NewMonitor(map[string]time.Duration{UpstreamMongo: 0}, 30*time.Second)
+	m.Observe(UpstreamMongo, time.Minute)
+	m.Observe(UpstreamInflux, time.Minute)
+	assert.False(t, m.Overloaded())
+	assert.Empty(t, m.Status())
+}
+
+func statusOf(m *Monitor, name string) Status {
+	for _, s := range m.Status() {
+		if s.Upstream == name {
+			return s
+		}
+	}
+	return Status{}
+}
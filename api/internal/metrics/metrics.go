@@ -1,8 +1,22 @@
 package metrics
 
+import "time"
+
 const serviceName = "wormscan-api"
 
 type Metrics interface {
 	IncExpiredCacheResponse(key string)
 	IncOrigin(origin string)
+	ObserveQuery(collection, operation string, elapsed time.Duration, err error)
+	IncInFlightRequests(method, route string)
+	DecInFlightRequests(method, route string)
+	IncShedRequests(route string)
+	IncRateLimitedRequests(group string)
+	SetPendingRedeems(counts map[PendingRedeemsKey]int)
+}
+
+// PendingRedeemsKey identifies a group of vaas pending to be redeemed.
+type PendingRedeemsKey struct {
+	TargetChain string
+	AppID       string
 }
@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"runtime"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -9,6 +12,12 @@ import (
 type PrometheusMetrics struct {
 	expiredCacheResponseCount *prometheus.CounterVec
 	originRequestsCount       *prometheus.CounterVec
+	queryDuration             *prometheus.HistogramVec
+	inFlightRequests          *prometheus.GaugeVec
+	pendingRedeems            *prometheus.GaugeVec
+	shedRequests              *prometheus.CounterVec
+	rateLimitedRequests       *prometheus.CounterVec
+	constLabels               map[string]string
 }
 
 // NewPrometheusMetrics returns a new instance of PrometheusMetrics.
@@ -34,12 +43,95 @@ func NewPrometheusMetrics(environment string) *PrometheusMetrics {
 		[]string{"origin"},
 	)
 
+	queryDuration := promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:        "mongo_query_duration_seconds",
+			Help:        "Duration of mongo queries by collection, operation and status.",
+			ConstLabels: constLabels,
+			Buckets:     []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"collection", "operation", "status"},
+	)
+
+	inFlightRequests := promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:        "http_requests_in_flight",
+			Help:        "Number of http requests being served by method and route.",
+			ConstLabels: constLabels,
+		},
+		[]string{"method", "route"},
+	)
+
+	pendingRedeems := promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:        "pending_redeems",
+			Help:        "Number of vaas signed more than a day ago without a detected redeem by target chain and app.",
+			ConstLabels: constLabels,
+		},
+		[]string{"target_chain", "app_id"},
+	)
+
+	shedRequests := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:        "http_requests_shed_total",
+			Help:        "Number of http requests rejected by route while the databases are overloaded.",
+			ConstLabels: constLabels,
+		},
+		[]string{"route"},
+	)
+
+	rateLimitedRequests := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:        "http_requests_rate_limited_total",
+			Help:        "Number of http requests rejected by the rate limiter by route group.",
+			ConstLabels: constLabels,
+		},
+		[]string{"group"},
+	)
+
 	return &PrometheusMetrics{
 		expiredCacheResponseCount: vaaTxTrackerCount,
 		originRequestsCount:       originRequestsCount,
+		queryDuration:             queryDuration,
+		inFlightRequests:          inFlightRequests,
+		pendingRedeems:            pendingRedeems,
+		shedRequests:              shedRequests,
+		rateLimitedRequests:       rateLimitedRequests,
+		constLabels:               constLabels,
 	}
 }
 
+// RegisterServerGauges registers the process and http server gauges.
+// The values are read on each scrape, so no background collection is needed.
+func (m *PrometheusMetrics) RegisterServerGauges(openConnections func() int32, concurrency int) {
+	promauto.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name:        "http_server_open_connections",
+			Help:        "Number of open connections of the http server.",
+			ConstLabels: m.constLabels,
+		},
+		func() float64 { return float64(openConnections()) },
+	)
+
+	promauto.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name:        "http_server_concurrency_limit",
+			Help:        "Maximum number of concurrent connections accepted by the http server.",
+			ConstLabels: m.constLabels,
+		},
+		func() float64 { return float64(concurrency) },
+	)
+
+	promauto.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name:        "process_goroutines_count",
+			Help:        "Number of goroutines of the process.",
+			ConstLabels: m.constLabels,
+		},
+		func() float64 { return float64(runtime.NumGoroutine()) },
+	)
+}
+
 func (m *PrometheusMetrics) IncExpiredCacheResponse(key string) {
 	m.expiredCacheResponseCount.WithLabelValues(key).Inc()
 }
@@ -48,12 +140,57 @@ func (m *PrometheusMetrics) IncOrigin(origin string) {
 	m.originRequestsCount.WithLabelValues(origin).Inc()
 }
 
+func (m *PrometheusMetrics) ObserveQuery(collection, operation string, elapsed time.Duration, err error) {
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	m.queryDuration.WithLabelValues(collection, operation, status).Observe(elapsed.Seconds())
+}
+
+func (m *PrometheusMetrics) IncInFlightRequests(method, route string) {
+	m.inFlightRequests.WithLabelValues(method, route).Inc()
+}
+
+func (m *PrometheusMetrics) DecInFlightRequests(method, route string) {
+	m.inFlightRequests.WithLabelValues(method, route).Dec()
+}
+
+func (m *PrometheusMetrics) IncShedRequests(route string) {
+	m.shedRequests.WithLabelValues(route).Inc()
+}
+
+func (m *PrometheusMetrics) IncRateLimitedRequests(group string) {
+	m.rateLimitedRequests.WithLabelValues(group).Inc()
+}
+
+// SetPendingRedeems replaces the pending redeems of all the groups,
+// so the groups that are no longer pending are removed.
+func (m *PrometheusMetrics) SetPendingRedeems(counts map[PendingRedeemsKey]int) {
+	m.pendingRedeems.Reset()
+	for key, count := range counts {
+		m.pendingRedeems.WithLabelValues(key.TargetChain, key.AppID).Set(float64(count))
+	}
+}
+
 type noOpMetrics struct{}
 
 func (s *noOpMetrics) IncExpiredCacheResponse(_ string) {}
 
 func (s *noOpMetrics) IncOrigin(_ string) {}
 
+func (s *noOpMetrics) ObserveQuery(_, _ string, _ time.Duration, _ error) {}
+
+func (s *noOpMetrics) IncInFlightRequests(_, _ string) {}
+
+func (s *noOpMetrics) DecInFlightRequests(_, _ string) {}
+
+func (s *noOpMetrics) IncShedRequests(_ string) {}
+
+func (s *noOpMetrics) IncRateLimitedRequests(_ string) {}
+
+func (s *noOpMetrics) SetPendingRedeems(_ map[PendingRedeemsKey]int) {}
+
 func NewNoOpMetrics() Metrics {
 	return &noOpMetrics{}
 }
@@ -1,10 +1,28 @@
 package pagination
 
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
 // Pagination definition.
 type Pagination struct {
 	Skip      int64
 	Limit     int64
 	SortOrder string
+	// After is the position of the last item of the previous page, when set the page starts
+	// after it and Skip is ignored by the queries that support it.
+	After *Cursor
+}
+
+// Cursor is the position of an item in a list sorted by a timestamp and then by descending id.
+//
+// Reading the pages after a cursor does not scan the skipped items, and the pages do not
+// shift when items are inserted before the cursor.
+type Cursor struct {
+	Timestamp time.Time
+	ID        string
 }
 
 // Default returns a `*Pagination` with default values.
@@ -34,6 +52,11 @@ func (p *Pagination) SetSortOrder(sortOrder string) *Pagination {
 	return p
 }
 
+func (p *Pagination) SetAfter(after *Cursor) *Pagination {
+	p.After = after
+	return p
+}
+
 // GetSortInt mapping to mongodb sort values.
 func (p *Pagination) GetSortInt() int {
 	if p.SortOrder == "ASC" {
@@ -41,3 +64,20 @@ func (p *Pagination) GetSortInt() int {
 	}
 	return -1
 }
+
+// AfterFilter returns the mongodb filter of the items after the cursor p.After, for the items
+// sorted by [field] in the order [order] (1 or -1) and then by descending _id.
+// It returns nil when the pagination has no cursor.
+func (p *Pagination) AfterFilter(field string, order int) bson.D {
+	if p == nil || p.After == nil {
+		return nil
+	}
+	op := "$lt"
+	if order == 1 {
+		op = "$gt"
+	}
+	return bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: field, Value: bson.D{{Key: op, Value: p.After.Timestamp}}}},
+		bson.D{{Key: field, Value: p.After.Timestamp}, {Key: "_id", Value: bson.D{{Key: "$lt", Value: p.After.ID}}}},
+	}}}
+}
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// maxMemoryBuckets is the number of buckets above which the full buckets are dropped.
+const maxMemoryBuckets = 10000
+
+// MemoryStore keeps the token buckets in memory.
+// It is meant for the development environments without redis, each replica has its own buckets.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates a store of token buckets in memory.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket), now: time.Now}
+}
+
+// Take takes a token from the bucket of the key.
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok {
+		if len(s.buckets) >= maxMemoryBuckets {
+			s.dropExpired(now)
+		}
+		b = &bucket{tokens: limit.capacity(), updatedAt: now}
+		s.buckets[key] = b
+	}
+
+	elapsed := math.Max(0, now.Sub(b.updatedAt).Seconds())
+	b.tokens = math.Min(limit.capacity(), b.tokens+elapsed*limit.tokensPerSecond())
+	b.updatedAt = now
+	b.expiresAt = now.Add(limit.ttl())
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	return newResult(allowed, b.tokens, limit), nil
+}
+
+// dropExpired drops the buckets that are full again, they are created as new on the next request.
+func (s *MemoryStore) dropExpired(now time.Time) {
+	for key, b := range s.buckets {
+		if now.After(b.expiresAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limit := Limit{Rate: 60, Burst: 3}
+	ctx := context.Background()
+
+	// the burst is allowed on a new bucket.
+	for i := 2; i >= 0; i-- {
+		result, err := store.Take(ctx, "client", limit)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, i, result.Remaining)
+	}
+
+	result, err := store.Take(ctx, "client", limit)
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Second, result.RetryAfter)
+
+	// the buckets of the other keys are independent.
+	result, err = store.Take(ctx, "other", limit)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	// a token is added every second.
+	now = now.Add(1500 * time.Millisecond)
+	result, err = store.Take(ctx, "client", limit)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	result, err = store.Take(ctx, "client", limit)
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+
+	// the bucket is not refilled above its capacity.
+	now = now.Add(time.Hour)
+	result, err = store.Take(ctx, "client", limit)
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 2, result.Remaining)
+}
+
+func TestMemoryStore_BurstDefaultsToRate(t *testing.T) {
+	store := NewMemoryStore()
+	limit := Limit{Rate: 2}
+
+	for i := 0; i < 2; i++ {
+		result, err := store.Take(context.Background(), "client", limit)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+	}
+	result, err := store.Take(context.Background(), "client", limit)
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 30*time.Second, result.RetryAfter.Round(time.Second))
+}
+
+func TestMemoryStore_DropExpired(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limit := Limit{Rate: 60}
+
+	_, _ = store.Take(context.Background(), "expired", limit)
+	now = now.Add(2 * time.Minute)
+	_, _ = store.Take(context.Background(), "recent", limit)
+
+	store.dropExpired(now)
+	assert.Len(t, store.buckets, 1)
+	assert.Contains(t, store.buckets, "recent")
+}
@@ -0,0 +1,66 @@
+// Package ratelimit implements the token buckets used to limit the requests of the api clients.
+//
+// Each bucket holds up to burst tokens and is refilled at a constant rate, a request takes one token
+// and it is rejected when the bucket is empty. So a client can make a burst of requests after being idle,
+// while the sustained rate of requests is bounded by the refill rate.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limit is the configuration of a token bucket.
+type Limit struct {
+	// Rate is the number of tokens added to the bucket per minute.
+	Rate int
+	// Burst is the capacity of the bucket, the rate when it is zero.
+	Burst int
+}
+
+// Unlimited returns true if the requests are not limited.
+func (l Limit) Unlimited() bool {
+	return l.Rate <= 0
+}
+
+func (l Limit) capacity() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return float64(l.Rate)
+}
+
+// tokensPerSecond is the refill rate of the bucket.
+func (l Limit) tokensPerSecond() float64 {
+	return float64(l.Rate) / 60
+}
+
+// ttl is the time it takes to refill an empty bucket, a bucket not used for that long is full
+// and its state can be dropped.
+func (l Limit) ttl() time.Duration {
+	return time.Duration(l.capacity() / l.tokensPerSecond() * float64(time.Second))
+}
+
+// Result is the outcome of taking a token from a bucket.
+type Result struct {
+	Allowed bool
+	// Remaining is the number of whole tokens left in the bucket.
+	Remaining int
+	// RetryAfter is the time until a token is available when the request is not allowed.
+	RetryAfter time.Duration
+}
+
+// Store keeps the state of the token buckets.
+type Store interface {
+	// Take takes a token from the bucket of the key.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+func newResult(allowed bool, tokens float64, limit Limit) Result {
+	r := Result{Allowed: allowed, Remaining: int(math.Floor(tokens))}
+	if !allowed {
+		r.RetryAfter = time.Duration((1 - tokens) / limit.tokensPerSecond() * float64(time.Second))
+	}
+	return r
+}
@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// takeScript refills the bucket with the tokens accrued since it was last updated and takes a token.
+// It runs atomically in redis, so the replicas of the api share the buckets.
+//
+// KEYS[1] is the key of the bucket.
+// ARGV are the capacity, the refill rate in tokens per millisecond, the current time and the ttl in milliseconds.
+// It returns whether the token was taken and the tokens left.
+var takeScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local ttl = tonumber(ARGV[4])
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], ttl)
+return {allowed, tostring(tokens)}
+`)
+
+// RedisStore keeps the token buckets in redis.
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+	now    func() time.Time
+}
+
+// NewRedisStore creates a store of token buckets with the given key prefix.
+func NewRedisStore(client *redis.Client, prefix string) *RedisStore {
+	return &RedisStore{client: client, prefix: prefix, now: time.Now}
+}
+
+// Take takes a token from the bucket of the key.
+func (s *RedisStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	ttl := limit.ttl() + time.Second
+	values, err := takeScript.Run(ctx, s.client, []string{s.prefix + key},
+		limit.capacity(),
+		limit.tokensPerSecond()/1000,
+		s.now().UnixMilli(),
+		ttl.Milliseconds(),
+	).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to take token of %s: %w", key, err)
+	}
+	if len(values) != 2 {
+		return Result{}, fmt.Errorf("unexpected response taking token of %s: %v", key, values)
+	}
+	allowed, _ := values[0].(int64)
+	remaining, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(remaining, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("unexpected tokens of %s: %w", key, err)
+	}
+	return newResult(allowed == 1, tokens, limit), nil
+}
@@ -0,0 +1,211 @@
+// Package slo computes the availability and latency service level objectives of the endpoints
+// from the request duration histogram of the http metrics, and the remaining error budget of each one.
+package slo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"go.uber.org/zap"
+)
+
+// RequestDurationMetric is the histogram of the request durations by status code, method and path
+// registered by the prometheus middleware.
+const RequestDurationMetric = "http_request_duration_seconds"
+
+// Objectives are the service level objectives of every endpoint.
+type Objectives struct {
+	// Availability is the target ratio of the requests without server errors, e.g. 0.999.
+	Availability float64 `json:"availability"`
+	// Latency is the target ratio of the requests faster than the latency threshold, e.g. 0.99.
+	Latency float64 `json:"latency"`
+	// LatencyThreshold is the maximum duration of a fast request, it must be a bucket bound of the histogram.
+	LatencyThreshold time.Duration `json:"latencyThreshold"`
+	// Window is the rolling window of the objectives.
+	Window time.Duration `json:"window"`
+}
+
+// Endpoint is the compliance with the objectives of an endpoint in the window.
+type Endpoint struct {
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	Requests     uint64 `json:"requests"`
+	Errors       uint64 `json:"errors"`
+	SlowRequests uint64 `json:"slowRequests"`
+	// Availability is the ratio of the requests without server errors.
+	Availability float64 `json:"availability"`
+	// Latency is the ratio of the requests faster than the latency threshold.
+	Latency float64 `json:"latency"`
+	// AvailabilityBudget is the ratio of the availability error budget not consumed yet, negative when it is exhausted.
+	AvailabilityBudget float64 `json:"availabilityBudget"`
+	// LatencyBudget is the ratio of the latency error budget not consumed yet, negative when it is exhausted.
+	LatencyBudget float64 `json:"latencyBudget"`
+}
+
+// Summary is the compliance with the objectives of the endpoints called in the window.
+type Summary struct {
+	Objectives Objectives `json:"objectives"`
+	// From is the start of the window covered by the summary.
+	From      time.Time  `json:"from"`
+	To        time.Time  `json:"to"`
+	Endpoints []Endpoint `json:"endpoints"`
+}
+
+type endpointKey struct {
+	method string
+	path   string
+}
+
+// Tracker computes the objectives in a rolling window from the increase of the request duration
+// histogram of all the api instances, queried to the Prometheus server that scrapes them, so the
+// objectives do not depend on the instance that serves the summary or on its restarts.
+type Tracker struct {
+	api        v1.API
+	selector   string
+	objectives Objectives
+	now        func() time.Time
+	logger     *zap.Logger
+}
+
+// NewTracker creates a tracker of the objectives that queries the Prometheus server at [url].
+// The [selector] are the label matchers of the series of the api, e.g. service="wormscan-api".
+func NewTracker(url, selector string, objectives Objectives, client *http.Client, logger *zap.Logger) (*Tracker, error) {
+	c, err := api.NewClient(api.Config{Address: url, Client: client})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the prometheus client: %w", err)
+	}
+	return &Tracker{
+		api:        v1.NewAPI(c),
+		selector:   selector,
+		objectives: objectives,
+		now:        time.Now,
+		logger:     logger.With(zap.String("module", "SLOTracker")),
+	}, nil
+}
+
+// Summary returns the compliance with the objectives of each endpoint in the window.
+func (t *Tracker) Summary(ctx context.Context) (*Summary, error) {
+	now := t.now()
+	window := model.Duration(t.objectives.Window).String()
+
+	requests, err := t.query(ctx, now, fmt.Sprintf("sum by (method, path) (increase(%s_count{%s}[%s]))",
+		RequestDurationMetric, t.matchers(), window))
+	if err != nil {
+		return nil, err
+	}
+	failed, err := t.query(ctx, now, fmt.Sprintf("sum by (method, path) (increase(%s_count{%s}[%s]))",
+		RequestDurationMetric, t.matchers(`status_code=~"5.."`), window))
+	if err != nil {
+		return nil, err
+	}
+	fast, err := t.query(ctx, now, fmt.Sprintf("sum by (method, path) (increase(%s_bucket{%s}[%s]))",
+		RequestDurationMetric, t.matchers(bucketMatcher(t.objectives.LatencyThreshold)), window))
+	if err != nil {
+		return nil, err
+	}
+
+	summary := Summary{
+		Objectives: t.objectives,
+		From:       now.Add(-t.objectives.Window),
+		To:         now,
+		Endpoints:  []Endpoint{},
+	}
+	for key, value := range requests {
+		// the increase is extrapolated to the window, so the counts are rounded.
+		requests := count(value)
+		if requests == 0 {
+			continue
+		}
+		errors := min(count(failed[key]), requests)
+		slow := requests - min(count(fast[key]), requests)
+		summary.Endpoints = append(summary.Endpoints, Endpoint{
+			Method:             key.method,
+			Path:               key.path,
+			Requests:           requests,
+			Errors:             errors,
+			SlowRequests:       slow,
+			Availability:       1 - float64(errors)/float64(requests),
+			Latency:            1 - float64(slow)/float64(requests),
+			AvailabilityBudget: remainingBudget(t.objectives.Availability, requests, errors),
+			LatencyBudget:      remainingBudget(t.objectives.Latency, requests, slow),
+		})
+	}
+	sort.Slice(summary.Endpoints, func(i, j int) bool {
+		if summary.Endpoints[i].Path != summary.Endpoints[j].Path {
+			return summary.Endpoints[i].Path < summary.Endpoints[j].Path
+		}
+		return summary.Endpoints[i].Method < summary.Endpoints[j].Method
+	})
+	return &summary, nil
+}
+
+// query returns the values of an instant query by endpoint.
+func (t *Tracker) query(ctx context.Context, at time.Time, query string) (map[endpointKey]float64, error) {
+	value, warnings, err := t.api.Query(ctx, query, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the request metrics: %w", err)
+	}
+	for _, w := range warnings {
+		t.logger.Warn("Warning in the query of the request metrics", zap.String("query", query), zap.String("warning", w))
+	}
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type %s of the query of the request metrics", value.Type())
+	}
+
+	result := make(map[endpointKey]float64, len(vector))
+	for _, sample := range vector {
+		key := endpointKey{method: string(sample.Metric["method"]), path: string(sample.Metric["path"])}
+		result[key] += float64(sample.Value)
+	}
+	return result, nil
+}
+
+// matchers returns the label matchers of the series of the api and the [extra] matchers.
+func (t *Tracker) matchers(extra ...string) string {
+	var matchers []string
+	if t.selector != "" {
+		matchers = append(matchers, t.selector)
+	}
+	return strings.Join(append(matchers, extra...), ",")
+}
+
+// bucketMatcher returns the matcher of the histogram bucket of the threshold, whose bound is
+// written as an integer or a float depending on the exposition format, e.g. 1 or 1.0.
+func bucketMatcher(threshold time.Duration) string {
+	bound := strconv.FormatFloat(threshold.Seconds(), 'f', -1, 64)
+	bounds := []string{regexp.QuoteMeta(bound)}
+	if !strings.Contains(bound, ".") {
+		bounds = append(bounds, regexp.QuoteMeta(bound+".0"))
+	}
+	return fmt.Sprintf("le=~%q", strings.Join(bounds, "|"))
+}
+
+func count(value float64) uint64 {
+	if value <= 0 || math.IsNaN(value) {
+		return 0
+	}
+	return uint64(math.Round(value))
+}
+
+// remainingBudget returns the ratio of the error budget of the target not consumed by the bad requests.
+func remainingBudget(target float64, requests, bad uint64) float64 {
+	budget := (1 - target) * float64(requests)
+	if budget <= 0 {
+		if bad == 0 {
+			return 1
+		}
+		return 0
+	}
+	return 1 - float64(bad)/budget
+}
@@ -0,0 +1,119 @@
+package slo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newPrometheusServer returns a fake Prometheus server that answers the instant queries with the
+// vector of the first [results] key contained in the query.
+func newPrometheusServer(t *testing.T, queries *[]string, results map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		query := r.Form.Get("query")
+		*queries = append(*queries, query)
+
+		result := "[]"
+		for _, key := range []string{`status_code=~"5.."`, "_bucket", "_count"} {
+			if strings.Contains(query, key) {
+				if v, ok := results[key]; ok {
+					result = v
+				}
+				break
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":%s}}`, result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func sample(method, path string, value string) string {
+	return fmt.Sprintf(`{"metric":{"method":%q,"path":%q},"value":[1714557600,%q]}`, method, path, value)
+}
+
+func TestTracker_Summary(t *testing.T) {
+	var queries []string
+	server := newPrometheusServer(t, &queries, map[string]string{
+		"_count":             "[" + sample("GET", "/api/v1/vaas", "20.2") + "," + sample("GET", "/api/v1/health", "1") + "]",
+		`status_code=~"5.."`: "[" + sample("GET", "/api/v1/vaas", "1") + "]",
+		"_bucket":            "[" + sample("GET", "/api/v1/vaas", "19") + "," + sample("GET", "/api/v1/health", "1") + "]",
+	})
+
+	objectives := Objectives{Availability: 0.9, Latency: 0.8, LatencyThreshold: time.Second, Window: 24 * time.Hour}
+	tracker, err := NewTracker(server.URL, `service="wormscan-api"`, objectives, http.DefaultClient, zap.NewNop())
+	require.NoError(t, err)
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tracker.now = func() time.Time { return now }
+
+	summary, err := tracker.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-24*time.Hour), summary.From)
+	assert.Equal(t, now, summary.To)
+
+	// the increase of the counters of all the instances in the window.
+	assert.Equal(t, []string{
+		`sum by (method, path) (increase(http_request_duration_seconds_count{service="wormscan-api"}[1d]))`,
+		`sum by (method, path) (increase(http_request_duration_seconds_count{service="wormscan-api",status_code=~"5.."}[1d]))`,
+		`sum by (method, path) (increase(http_request_duration_seconds_bucket{service="wormscan-api",le=~"1|1\\.0"}[1d]))`,
+	}, queries)
+
+	require.Len(t, summary.Endpoints, 2)
+	health := summary.Endpoints[0]
+	assert.Equal(t, "/api/v1/health", health.Path)
+	assert.Equal(t, uint64(1), health.Requests)
+	assert.Equal(t, 1.0, health.AvailabilityBudget)
+
+	vaas := summary.Endpoints[1]
+	assert.Equal(t, "/api/v1/vaas", vaas.Path)
+	assert.Equal(t, "GET", vaas.Method)
+	assert.Equal(t, uint64(20), vaas.Requests)
+	assert.Equal(t, uint64(1), vaas.Errors)
+	assert.Equal(t, uint64(1), vaas.SlowRequests)
+	assert.InDelta(t, 0.95, vaas.Availability, 1e-9)
+	assert.InDelta(t, 0.95, vaas.Latency, 1e-9)
+	// 1 error of a budget of 2, 1 slow request of a budget of 4.
+	assert.InDelta(t, 0.5, vaas.AvailabilityBudget, 1e-9)
+	assert.InDelta(t, 0.75, vaas.LatencyBudget, 1e-9)
+}
+
+func TestTracker_SummaryWithoutRequests(t *testing.T) {
+	var queries []string
+	server := newPrometheusServer(t, &queries, nil)
+	tracker, err := NewTracker(server.URL, "", Objectives{LatencyThreshold: 500 * time.Millisecond, Window: time.Hour}, http.DefaultClient, zap.NewNop())
+	require.NoError(t, err)
+
+	summary, err := tracker.Summary(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, summary.Endpoints)
+	assert.Contains(t, queries[2], `{le=~"0\\.5"}[1h]`)
+}
+
+func TestTracker_SummaryQueryError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	tracker, err := NewTracker(server.URL, "", Objectives{LatencyThreshold: time.Second, Window: time.Hour}, http.DefaultClient, zap.NewNop())
+	require.NoError(t, err)
+
+	_, err = tracker.Summary(context.Background())
+	assert.Error(t, err)
+}
+
+func TestRemainingBudget(t *testing.T) {
+	assert.Equal(t, 1.0, remainingBudget(0.99, 0, 0))
+	assert.InDelta(t, -1.0, remainingBudget(0.99, 100, 2), 1e-9)
+	assert.Equal(t, 1.0, remainingBudget(1, 100, 0))
+	assert.Equal(t, 0.0, remainingBudget(1, 100, 1))
+}
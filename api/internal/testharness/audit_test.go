@@ -0,0 +1,23 @@
+//go:build integration
+
+package testharness
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRequiresAdminApiKey(t *testing.T) {
+	harness.Reset(t)
+
+	status, _ := harness.Get(t, "/api/v1/audit")
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	status, _ = harness.Get(t, "/api/v1/audit", "X-Api-Key", "wrong-key")
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	status, _ = harness.Get(t, "/api/v1/audit", "X-Api-Key", AdminApiKey)
+	assert.Equal(t, http.StatusOK, status)
+}
@@ -0,0 +1,50 @@
+//go:build integration
+
+package testharness
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// fixturesDir contains the fixture files, relative to the package directory.
+const fixturesDir = "testdata"
+
+// LoadFixtures inserts the documents of a fixture file in a collection.
+//
+// The fixture file is a JSON array of documents in MongoDB extended JSON (relaxed mode),
+// e.g. dates are written as {"$date": "2024-01-01T00:00:00Z"}.
+func (h *Harness) LoadFixtures(t *testing.T, collection, file string) {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(fixturesDir, file))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", file, err)
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		t.Fatalf("failed to parse fixture %s: %v", file, err)
+	}
+
+	docs := make([]any, 0, len(raws))
+	for _, raw := range raws {
+		var doc bson.D
+		if err := bson.UnmarshalExtJSON(raw, false, &doc); err != nil {
+			t.Fatalf("failed to parse document of fixture %s: %v", file, err)
+		}
+		docs = append(docs, doc)
+	}
+	if len(docs) == 0 {
+		return
+	}
+
+	if _, err := h.DB.Collection(collection).InsertMany(context.Background(), docs); err != nil {
+		t.Fatalf("failed to insert fixture %s in %s: %v", file, collection, err)
+	}
+}
@@ -0,0 +1,249 @@
+//go:build integration
+
+package testharness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type notionalLimitResponse struct {
+	Data []struct {
+		ChainID            uint16 `json:"chainId"`
+		NotionalLimit      uint64 `json:"notionalLimit"`
+		MaxTransactionSize uint64 `json:"maxTransactionSize"`
+	} `json:"data"`
+}
+
+type availableNotionalResponse struct {
+	Data []struct {
+		ChainID           uint16 `json:"chainId"`
+		AvailableNotional uint64 `json:"availableNotional"`
+		Guardians         int    `json:"guardians"`
+		QuorumReached     bool   `json:"quorumReached"`
+	} `json:"data"`
+}
+
+func TestGovernorConfig(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "governorConfig", "governor_config.json")
+
+	status, body := harness.Get(t, "/api/v1/governor/config?pageSize=5")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp struct {
+		Data []struct {
+			ID       string `json:"id"`
+			NodeName string `json:"nodeName"`
+			Chains   []any  `json:"chains"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	assert.Len(t, resp.Data, 5)
+	for i := 1; i < len(resp.Data); i++ {
+		assert.Less(t, resp.Data[i-1].ID, resp.Data[i].ID, "configurations must be sorted by guardian address")
+	}
+	assert.Len(t, resp.Data[0].Chains, 2)
+}
+
+func TestGovernorConfigPagination(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "governorConfig", "governor_config.json")
+
+	type page struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+		Pagination struct {
+			Next string `json:"next"`
+		} `json:"pagination"`
+	}
+
+	// 19 guardians in pages of 10.
+	status, body := harness.Get(t, "/api/v1/governor/config?pageSize=10")
+	assert.Equal(t, http.StatusOK, status)
+	var first page
+	assert.NoError(t, json.Unmarshal(body, &first))
+	assert.Len(t, first.Data, 10)
+	assert.Equal(t, "1", first.Pagination.Next)
+
+	status, body = harness.Get(t, "/api/v1/governor/config?pageSize=10&page=1")
+	assert.Equal(t, http.StatusOK, status)
+	var second page
+	assert.NoError(t, json.Unmarshal(body, &second))
+	assert.Len(t, second.Data, 9)
+	assert.Empty(t, second.Pagination.Next)
+	assert.Less(t, first.Data[9].ID, second.Data[0].ID)
+}
+
+func TestGovernorConfigByNodeName(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "governorConfig", "governor_config.json")
+
+	status, body := harness.Get(t, "/api/v1/governor/config?nodeName=guardian-3")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp struct {
+		Data []struct {
+			NodeName string `json:"nodeName"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	if assert.Len(t, resp.Data, 1) {
+		assert.Equal(t, "guardian-3", resp.Data[0].NodeName)
+	}
+}
+
+func TestGovernorStatusByNodeName(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "governorStatus", "governor_status.json")
+
+	status, body := harness.Get(t, "/api/v1/governor/status?nodeName=guardian-7")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp struct {
+		Data []struct {
+			NodeName string `json:"nodeName"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	if assert.Len(t, resp.Data, 1) {
+		assert.Equal(t, "guardian-7", resp.Data[0].NodeName)
+	}
+
+	status, body = harness.Get(t, "/api/v1/governor/status?nodeName=unknown")
+	assert.Equal(t, http.StatusOK, status)
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	assert.Empty(t, resp.Data)
+}
+
+func TestGovernorStatusFreshness(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "governorStatus", "governor_status.json")
+
+	status, body := harness.Get(t, "/api/v1/governor/status/freshness")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp struct {
+		Data []struct {
+			ID       string `json:"id"`
+			NodeName string `json:"nodeName"`
+			Age      int64  `json:"age"`
+			Stale    bool   `json:"stale"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	assert.NotEmpty(t, resp.Data)
+	for _, f := range resp.Data {
+		// the fixtures were updated long before the stale threshold.
+		assert.NotEmpty(t, f.NodeName)
+		assert.True(t, f.Stale)
+		assert.Greater(t, f.Age, int64(0))
+	}
+
+	status, _ = harness.Get(t, "/api/v1/governor/status/freshness?threshold=abc")
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestGovernorConfigByGuardianAddress(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "governorConfig", "governor_config.json")
+
+	status, body := harness.Get(t, "/api/v1/governor/config/0x4bb18eee9aed2efc30804ead9cf1db7c6653f3a2")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp struct {
+		Data struct {
+			ID       string `json:"id"`
+			NodeName string `json:"nodeName"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	assert.Equal(t, "4bb18eee9aed2efc30804ead9cf1db7c6653f3a2", resp.Data.ID)
+	assert.Equal(t, "guardian-0", resp.Data.NodeName)
+}
+
+// The notional limit of a chain is the limit configured by the 13th guardian
+// with the highest limit, so it is supported by a quorum of the guardians.
+func TestGovernorNotionalLimit(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "governorConfig", "governor_config.json")
+
+	status, body := harness.Get(t, "/api/v1/governor/notional/limit/")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp notionalLimitResponse
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	if assert.Len(t, resp.Data, 2) {
+		assert.Equal(t, uint16(1), resp.Data[0].ChainID)
+		assert.Equal(t, uint64(25000000), resp.Data[0].NotionalLimit)
+		assert.Equal(t, uint64(2500000), resp.Data[0].MaxTransactionSize)
+
+		// guardian limits go from 50M to 68M, the 13th highest is 56M.
+		assert.Equal(t, uint16(2), resp.Data[1].ChainID)
+		assert.Equal(t, uint64(56000000), resp.Data[1].NotionalLimit)
+		assert.Equal(t, uint64(5600000), resp.Data[1].MaxTransactionSize)
+	}
+}
+
+func TestGovernorNotionalLimitNotFound(t *testing.T) {
+	harness.Reset(t)
+
+	status, _ := harness.Get(t, "/api/v1/governor/notional/limit/")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestGovernorAvailableNotional(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "governorStatus", "governor_status.json")
+
+	status, body := harness.Get(t, "/api/v1/governor/notional/available/")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp availableNotionalResponse
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	if assert.Len(t, resp.Data, 2) {
+		assert.Equal(t, uint16(1), resp.Data[0].ChainID)
+		assert.Equal(t, uint64(20000000), resp.Data[0].AvailableNotional)
+
+		// guardian available notionals go from 40M to 41.8M, the 13th highest is 40.6M.
+		assert.Equal(t, uint16(2), resp.Data[1].ChainID)
+		assert.Equal(t, uint64(40600000), resp.Data[1].AvailableNotional)
+		assert.Equal(t, 19, resp.Data[1].Guardians)
+		assert.True(t, resp.Data[1].QuorumReached)
+	}
+}
+
+// When fewer guardians than the quorum report a chain, the lowest reported value
+// is returned flagged as partial data.
+func TestGovernorAvailableNotionalWithoutQuorum(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "governorStatus", "governor_status.json")
+
+	missing := bson.A{}
+	for i := 0; i < 10; i++ {
+		missing = append(missing, fmt.Sprintf("guardian-%d", i))
+	}
+	filter := bson.M{"parsedStatus.nodename": bson.M{"$in": missing}}
+	_, err := harness.DB.Collection("governorStatus").DeleteMany(context.Background(), filter)
+	assert.NoError(t, err)
+
+	status, body := harness.Get(t, "/api/v1/governor/notional/available/")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp availableNotionalResponse
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	if assert.Len(t, resp.Data, 2) {
+		// the remaining guardian available notionals go from 41M to 41.8M.
+		assert.Equal(t, uint16(2), resp.Data[1].ChainID)
+		assert.Equal(t, uint64(41000000), resp.Data[1].AvailableNotional)
+		assert.Equal(t, 9, resp.Data[1].Guardians)
+		assert.False(t, resp.Data[1].QuorumReached)
+	}
+}
@@ -0,0 +1,387 @@
+//go:build integration
+
+// Package testharness runs the wormscan API against dockerized dependencies,
+// so the handlers and repositories can be tested at the HTTP level.
+//
+// The mongo, redis and influx containers are started with testcontainers-go,
+// which requires a docker daemon. The tests using the harness are excluded from
+// the default build and run with:
+//
+//	go test -tags integration ./internal/testharness/...
+package testharness
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/go-redis/redis/v8"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/address"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/attestations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/chaindata"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/contracts"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/denylist"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/emitters"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governance"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
+	guardianHandlers "github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/heartbeats"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/infrastructure"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/labels"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/observations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/operations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/participation"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/protocols"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/relays"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/reports"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/stats"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/config"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/tvl"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/guardian"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan"
+	commonAudit "github.com/wormhole-foundation/wormhole-explorer/common/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/common/auth"
+	wormscanCache "github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/coingecko"
+	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	stats2 "github.com/wormhole-foundation/wormhole-explorer/common/stats"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+const (
+	mongoImage  = "mongo:6.0"
+	redisImage  = "redis:7.0"
+	influxImage = "influxdb:2.7"
+
+	// AdminApiKey is the admin api key configured in the API started by the harness.
+	AdminApiKey = "integration-test-admin-key"
+
+	influxOrganization = "wormscan"
+	influxToken        = "integration-test-token"
+	influxBucket       = "wormscan"
+	databaseName       = "wormscan"
+)
+
+// Harness contains the API under test and the clients of its dependencies.
+type Harness struct {
+	App    *fiber.App
+	DB     *mongo.Database
+	Redis  *redis.Client
+	Influx influxdb2.Client
+	Config *config.AppConfig
+
+	db         *dbutil.Session
+	containers []testcontainers.Container
+}
+
+// Start starts the containers of the dependencies and builds the API wired to them.
+// The caller must call Stop to remove the containers.
+func Start(ctx context.Context) (*Harness, error) {
+
+	h := &Harness{}
+	ok := false
+	defer func() {
+		if !ok {
+			h.Stop(context.Background())
+		}
+	}()
+
+	mongoURI, err := h.startContainer(ctx, testcontainers.ContainerRequest{
+		Image:        mongoImage,
+		ExposedPorts: []string{"27017/tcp"},
+		WaitingFor:   wait.ForLog("Waiting for connections"),
+	}, "27017/tcp", "mongodb")
+	if err != nil {
+		return nil, err
+	}
+
+	redisURL, err := h.startContainer(ctx, testcontainers.ContainerRequest{
+		Image:        redisImage,
+		ExposedPorts: []string{"6379/tcp"},
+		WaitingFor:   wait.ForLog("Ready to accept connections"),
+	}, "6379/tcp", "")
+	if err != nil {
+		return nil, err
+	}
+
+	influxURL, err := h.startContainer(ctx, testcontainers.ContainerRequest{
+		Image:        influxImage,
+		ExposedPorts: []string{"8086/tcp"},
+		Env: map[string]string{
+			"DOCKER_INFLUXDB_INIT_MODE":        "setup",
+			"DOCKER_INFLUXDB_INIT_USERNAME":    "wormscan",
+			"DOCKER_INFLUXDB_INIT_PASSWORD":    "wormscan-password",
+			"DOCKER_INFLUXDB_INIT_ORG":         influxOrganization,
+			"DOCKER_INFLUXDB_INIT_BUCKET":      influxBucket,
+			"DOCKER_INFLUXDB_INIT_ADMIN_TOKEN": influxToken,
+		},
+		WaitingFor: wait.ForHTTP("/health").WithPort("8086/tcp").WithStartupTimeout(time.Minute),
+	}, "8086/tcp", "http")
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := newConfig(mongoURI, redisURL, influxURL)
+	h.Config = cfg
+
+	logger := zap.NewNop()
+
+	h.db, err = dbutil.Connect(ctx, logger, cfg.DB.URL, cfg.DB.Name, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to mongodb: %w", err)
+	}
+	h.DB = h.db.Database
+	h.Redis = redis.NewClient(&redis.Options{Addr: cfg.Cache.URL})
+	h.Influx = influxdb2.NewClient(cfg.Influx.URL, cfg.Influx.Token)
+
+	h.App, err = newApp(cfg, h.DB, h.Redis, h.Influx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ok = true
+	return h, nil
+}
+
+// startContainer starts a container and returns the address of the exposed port.
+// If scheme is not empty, the address is returned as an url with that scheme.
+func (h *Harness) startContainer(ctx context.Context, req testcontainers.ContainerRequest, port, scheme string) (string, error) {
+	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to start %s container: %w", req.Image, err)
+	}
+	h.containers = append(h.containers, c)
+
+	endpoint, err := c.PortEndpoint(ctx, nat.Port(port), scheme)
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s endpoint: %w", req.Image, err)
+	}
+	return endpoint, nil
+}
+
+// Stop closes the clients and removes the containers.
+func (h *Harness) Stop(ctx context.Context) {
+	if h.Influx != nil {
+		h.Influx.Close()
+	}
+	if h.Redis != nil {
+		h.Redis.Close()
+	}
+	if h.db != nil {
+		h.db.DisconnectWithTimeout(10 * time.Second)
+	}
+	for _, c := range h.containers {
+		c.Terminate(ctx)
+	}
+}
+
+// Reset removes the documents and cached values created by a test.
+func (h *Harness) Reset(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	if err := h.DB.Drop(ctx); err != nil {
+		t.Fatalf("failed to drop database: %v", err)
+	}
+	if err := h.Redis.FlushAll(ctx).Err(); err != nil {
+		t.Fatalf("failed to flush redis: %v", err)
+	}
+}
+
+// Get sends a GET request to the API and returns the status code and the body of the response.
+func (h *Harness) Get(t *testing.T, path string, headers ...string) (int, []byte) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	resp, err := h.App.Test(req, -1)
+	if err != nil {
+		t.Fatalf("failed to send request %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response body %s: %v", path, err)
+	}
+	return resp.StatusCode, body
+}
+
+// newConfig creates the configuration of the API pointing to the containers.
+func newConfig(mongoURI, redisURL, influxURL string) *config.AppConfig {
+	cfg := &config.AppConfig{
+		RunMode:                config.RunModeDevelopmernt,
+		P2pNetwork:             domain.P2pMainNet,
+		Environment:            "integration",
+		AdminApiKey:            AdminApiKey,
+		EmitterDenyListEnabled: true,
+	}
+	cfg.DB.URL = mongoURI
+	cfg.DB.Name = databaseName
+	cfg.Cache.URL = redisURL
+	cfg.Cache.Enabled = true
+	cfg.Cache.Prefix = "integration"
+	cfg.Cache.TvlKey = "wormscan:tvl"
+	cfg.Cache.TvlExpiration = 60
+	cfg.Cache.MetricExpiration = 10
+	cfg.Cache.VaaExpiration = 10
+	cfg.Cache.ProtocolsStatsKey = "wormscan:protocols"
+	cfg.Cache.ProtocolsStatsExpiration = 60
+	cfg.Observations.MaxPageSize = 1000
+	cfg.Observations.MaxPageSizeByEmitter = 1000
+	cfg.Observations.MaxTimeRange = 24 * 365
+	cfg.Observations.MaxScan = 100000
+	cfg.Governor.StatusStaleThreshold = 15
+	cfg.Influx.URL = influxURL
+	cfg.Influx.Token = influxToken
+	cfg.Influx.Organization = influxOrganization
+	cfg.Influx.Bucket24Hours = influxBucket
+	cfg.Influx.Bucket30Days = influxBucket
+	cfg.Influx.BucketInfinite = influxBucket
+	return cfg
+}
+
+// newApp builds the fiber app with the same routes and services of the API.
+//
+// The middlewares that only report metrics or depend on external services
+// (rate limiter, payload parser, origin tx resolver) are not registered.
+func newApp(cfg *config.AppConfig, db *mongo.Database, redisClient *redis.Client, influxCli influxdb2.Client, logger *zap.Logger) (*fiber.App, error) {
+
+	cacheMetrics := wormscanCache.NewDummyMetrics()
+	cache, err := wormscanCache.NewCacheClient(redisClient, cfg.Cache.Enabled, cfg.Cache.Prefix, logger, wormscanCache.WithMetrics(cacheMetrics))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize cache client: %w", err)
+	}
+
+	metrics := metrics.NewNoOpMetrics()
+	tvl := tvl.NewTVL(cfg.P2pNetwork, cache, cfg.Cache.TvlKey, cfg.Cache.TvlExpiration, logger)
+	coingeckoAPI := coingecko.NewCoinGeckoAPI(cfg.Coingecko.URL, cfg.Coingecko.HeaderKey, cfg.Coingecko.ApiKey)
+	tokenProvider := domain.NewTokenProvider(cfg.P2pNetwork)
+	vaaParserFunc := func(vaa *sdk.VAA) (any, error) {
+		return nil, nil
+	}
+
+	// Set up repositories
+	addressRepo := address.NewRepository(db, logger)
+	vaaRepo := vaa.NewRepository(db, logger)
+	obsRepo := observations.NewRepository(db, logger)
+	governorRepo := governor.NewRepository(db, logger)
+	infrastructureRepo := infrastructure.NewRepository(db, logger)
+	heartbeatsRepo := heartbeats.NewRepository(db, logger)
+	transactionsRepo := transactions.NewRepository(
+		tvl,
+		cfg.P2pNetwork,
+		influxCli,
+		cfg.Influx.Organization,
+		cfg.Influx.Bucket24Hours,
+		cfg.Influx.Bucket30Days,
+		cfg.Influx.BucketInfinite,
+		db,
+		logger,
+	)
+	relaysRepo := relays.NewRepository(db, logger)
+	governanceRepo := governance.NewRepository(db, logger)
+	attestationsRepo := attestations.NewRepository(db, logger)
+	participationRepo := participation.NewRepository(db, logger)
+	reportsRepo := reports.NewRepository(db, logger)
+	emittersRepo := emitters.NewRepository(db, logger)
+	auditRepo := audit.NewRepository(db, logger)
+	labelsRepo := labels.NewRepository(db, logger)
+	denyListRepo := denylist.NewRepository(db, logger)
+	operationsRepo := operations.NewRepository(db, logger)
+	nttRepo := stats2.NewNTTRepository(influxCli, cfg.Influx.Organization, cfg.Influx.BucketInfinite, cache, logger)
+	statsRepo := stats.NewRepository(
+		nttRepo,
+		influxCli,
+		cfg.Influx.Organization,
+		cfg.Influx.Bucket24Hours,
+		cfg.Influx.Bucket30Days,
+		cfg.Influx.BucketInfinite,
+		coingeckoAPI,
+		tokenProvider,
+		logger)
+	statsAddressRepo := stats2.NewAddressRepository(influxCli, cfg.Influx.Organization, cfg.Influx.BucketInfinite, cache, logger)
+	statsHolderRepo := stats2.NewHolderRepositoryReadable(cache, logger)
+	protocolsRepo := protocols.NewRepository(
+		protocols.WrapQueryAPI(influxCli.QueryAPI(cfg.Influx.Organization)),
+		cfg.Influx.BucketInfinite,
+		cfg.Influx.Bucket30Days,
+		cfg.Influx.Bucket24Hours,
+		logger)
+	guardianSetRepository := repository.NewGuardianSetRepository(db, logger)
+
+	// Set up services
+	expirationTime := time.Duration(cfg.Cache.MetricExpiration) * time.Minute
+	labelsService := labels.NewService(labelsRepo, cache, metrics, logger)
+	denyListService := denylist.NewService(denyListRepo, cache, metrics, logger)
+	chainDataService := chaindata.NewService(repository.NewChainDataWiper(db, logger), cfg.DataWipeEnabled, logger)
+	addressService := address.NewService(addressRepo, labelsService, logger)
+	guardianService := guardianHandlers.NewService(guardianSetRepository, cfg.P2pNetwork, cache, metrics, logger)
+	emittersService := emitters.NewService(emittersRepo, nil, cache, metrics, logger)
+	heartbeatsService := heartbeats.NewService(heartbeatsRepo, logger)
+	vaaService := vaa.NewService(vaaRepo, cache, time.Duration(cfg.Cache.VaaExpiration)*time.Minute, vaaParserFunc, guardianService, heartbeatsService, emittersService, logger)
+	obsService := observations.NewService(obsRepo, observations.Limits{
+		MaxPageSize:          cfg.Observations.MaxPageSize,
+		MaxPageSizeByEmitter: cfg.Observations.MaxPageSizeByEmitter,
+		MaxTimeRange:         time.Duration(cfg.Observations.MaxTimeRange) * time.Hour,
+		MaxScan:              cfg.Observations.MaxScan,
+	}, logger)
+	governorService := governor.NewService(governorRepo, guardianService, cache, metrics, cfg.Governor.Quorum, time.Duration(cfg.Governor.StatusStaleThreshold)*time.Minute, logger)
+	infrastructureService := infrastructure.NewService(infrastructureRepo, cacheMetrics, logger)
+	transactionsService := transactions.NewService(transactionsRepo, cache, expirationTime, tokenProvider, metrics, logger)
+	relaysService := relays.NewService(relaysRepo, logger)
+	governanceService := governance.NewService(governanceRepo, logger)
+	attestationsService := attestations.NewService(attestationsRepo, logger)
+	participationService := participation.NewService(participationRepo, heartbeatsService, logger)
+	reportsService := reports.NewService(reportsRepo, logger)
+	contractsService := contracts.NewService(cfg.P2pNetwork, logger)
+	auditService := audit.NewService(auditRepo, logger)
+	operationsService := operations.NewService(operationsRepo, metrics, logger)
+	if cfg.EmitterDenyListEnabled {
+		vaaService.SetEmitterDenyList(denyListService)
+		transactionsService.SetEmitterDenyList(denyListService)
+		operationsService.SetEmitterDenyList(denyListService)
+	}
+	statsService := stats.NewService(statsRepo, statsAddressRepo, statsHolderRepo, cache, expirationTime, metrics, logger)
+	protocolsService := protocols.NewService(cfg.Protocols, []string{protocols.CCTP, protocols.PortalTokenBridge, protocols.NTT}, protocolsRepo, logger, cache, cfg.Cache.ProtocolsStatsKey, cfg.Cache.ProtocolsStatsExpiration, metrics, tvl)
+
+	app := fiber.New(fiber.Config{
+		ErrorHandler:          middleware.ErrorHandler,
+		DisableStartupMessage: true,
+		Immutable:             true,
+	})
+	app.Use(requestid.New())
+
+	notSupportedByEnv := middleware.NotSupportedByTestnetEnv(cfg.P2pNetwork)
+	lowPriority := middleware.LoadShedding(nil, time.Second, metrics)
+	adminOnly := auth.AdminApiKey(cfg.AdminApiKey)
+	auditor := commonAudit.NewAuditor(commonAudit.NewRepository(db), "wormscan-api", logger)
+	wormscan.RegisterRoutes(notSupportedByEnv, lowPriority, adminOnly, auditor, app, logger, addressService, vaaService, obsService, governorService, infrastructureService, transactionsService, relaysService, operationsService, statsService, protocolsService, governanceService, emittersService, auditService, labelsService, denyListService, participationService, attestationsService, reportsService, contractsService, chainDataService)
+	guardian.RegisterRoutes(cfg, app, logger, vaaService, governorService, heartbeatsService, guardianService)
+
+	return app, nil
+}
@@ -0,0 +1,26 @@
+//go:build integration
+
+package testharness
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+)
+
+// harness is shared by all the tests of the package, each test resets its state.
+var harness *Harness
+
+func TestMain(m *testing.M) {
+	h, err := Start(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start test harness: %v\n", err)
+		os.Exit(1)
+	}
+	harness = h
+
+	code := m.Run()
+	h.Stop(context.Background())
+	os.Exit(code)
+}
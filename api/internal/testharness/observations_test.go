@@ -0,0 +1,67 @@
+//go:build integration
+
+package testharness
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindObservationsByVaa(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "observations", "observations.json")
+
+	status, body := harness.Get(t, "/api/v1/observations/2/"+tokenBridgeEmitter+"/3")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp []struct {
+		EmitterChain uint16 `json:"emitterChain"`
+		Sequence     uint64 `json:"sequence"`
+		GuardianAddr string `json:"guardianAddr"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	assert.Len(t, resp, 3)
+	for _, o := range resp {
+		assert.Equal(t, uint16(2), o.EmitterChain)
+		assert.Equal(t, uint64(3), o.Sequence)
+	}
+}
+
+func TestFindObservationsByVaaEmpty(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "observations", "observations.json")
+
+	status, body := harness.Get(t, "/api/v1/observations/2/"+tokenBridgeEmitter+"/4")
+	assert.Equal(t, http.StatusOK, status)
+	assert.JSONEq(t, "[]", string(body))
+}
+
+func TestFindObservationsPageSizeTooLarge(t *testing.T) {
+	harness.Reset(t)
+
+	status, _ := harness.Get(t, "/api/v1/observations?pageSize=1001")
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestFindObservationsScanTooLarge(t *testing.T) {
+	harness.Reset(t)
+
+	status, body := harness.Get(t, "/api/v1/observations/2?page=1000&pageSize=1000")
+	assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+
+	var resp struct {
+		Code int `json:"code"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	assert.Equal(t, 8, resp.Code)
+}
+
+func TestFindObservationsTimeRangeTooLarge(t *testing.T) {
+	harness.Reset(t)
+
+	status, _ := harness.Get(t, "/api/v1/observations?from=2020-01-01T00:00:00Z&to=2024-01-01T00:00:00Z")
+	assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+}
@@ -0,0 +1,76 @@
+//go:build integration
+
+package testharness
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	relayerAddress = "0x00000000000000000000000000000000000000a1"
+	usdcAddress    = "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+	wethAddress    = "000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"
+)
+
+type relayerFeeResponse struct {
+	Relayer      string `json:"relayer"`
+	ChainID      uint16 `json:"chainId"`
+	TokenChain   uint16 `json:"tokenChain"`
+	TokenAddress string `json:"tokenAddress"`
+	TotalFee     string `json:"totalFee"`
+	Count        int64  `json:"count"`
+}
+
+func TestGetRelayerFees(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "parsedVaa", "relayer_fees_parsed_vaa.json")
+	harness.LoadFixtures(t, "globalTransactions", "relayer_fees_global_transactions.json")
+
+	status, body := harness.Get(t, "/api/v1/relays/fees?address="+relayerAddress)
+	require.Equal(t, http.StatusOK, status)
+
+	var resp []relayerFeeResponse
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	// the fees are grouped by the token they are paid with, the pending redeem and the vaa
+	// without a fee are not counted.
+	require.Len(t, resp, 2)
+	assert.Equal(t, relayerFeeResponse{
+		Relayer:      relayerAddress,
+		ChainID:      1,
+		TokenChain:   2,
+		TokenAddress: usdcAddress,
+		TotalFee:     "300000000",
+		Count:        2,
+	}, resp[0])
+	assert.Equal(t, relayerFeeResponse{
+		Relayer:      relayerAddress,
+		ChainID:      1,
+		TokenChain:   2,
+		TokenAddress: wethAddress,
+		TotalFee:     "5000000",
+		Count:        1,
+	}, resp[1])
+}
+
+func TestGetRelayerFeesUnknownRelayer(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "parsedVaa", "relayer_fees_parsed_vaa.json")
+	harness.LoadFixtures(t, "globalTransactions", "relayer_fees_global_transactions.json")
+
+	status, body := harness.Get(t, "/api/v1/relays/fees?address=0x00000000000000000000000000000000000000b2")
+	assert.Equal(t, http.StatusOK, status)
+	assert.JSONEq(t, "[]", string(body))
+}
+
+func TestGetRelayerFeesPageSizeTooLarge(t *testing.T) {
+	harness.Reset(t)
+
+	status, _ := harness.Get(t, "/api/v1/relays/fees?pageSize=1001")
+	assert.Equal(t, http.StatusBadRequest, status)
+}
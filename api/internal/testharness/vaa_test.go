@@ -0,0 +1,63 @@
+//go:build integration
+
+package testharness
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const tokenBridgeEmitter = "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"
+
+type vaaResponse struct {
+	ID           string `json:"id"`
+	EmitterChain uint16 `json:"emitterChain"`
+	EmitterAddr  string `json:"emitterAddr"`
+	TxHash       string `json:"txHash"`
+}
+
+func TestFindVaasByChain(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "vaas", "vaas.json")
+
+	status, body := harness.Get(t, "/api/v1/vaas/2")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp struct {
+		Data []vaaResponse `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	if assert.Len(t, resp.Data, 3) {
+		// most recent first.
+		assert.Equal(t, "2/"+tokenBridgeEmitter+"/3", resp.Data[0].ID)
+		assert.Equal(t, "2/"+tokenBridgeEmitter+"/2", resp.Data[1].ID)
+		assert.Equal(t, "2/"+tokenBridgeEmitter+"/1", resp.Data[2].ID)
+	}
+}
+
+func TestFindVaaByID(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "vaas", "vaas.json")
+
+	status, body := harness.Get(t, "/api/v1/vaas/2/"+tokenBridgeEmitter+"/2")
+	assert.Equal(t, http.StatusOK, status)
+
+	var resp struct {
+		Data vaaResponse `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &resp))
+	assert.Equal(t, "2/"+tokenBridgeEmitter+"/2", resp.Data.ID)
+	assert.Equal(t, uint16(2), resp.Data.EmitterChain)
+	assert.Equal(t, tokenBridgeEmitter, resp.Data.EmitterAddr)
+}
+
+func TestFindVaaByIDNotFound(t *testing.T) {
+	harness.Reset(t)
+	harness.LoadFixtures(t, "vaas", "vaas.json")
+
+	status, _ := harness.Get(t, "/api/v1/vaas/2/"+tokenBridgeEmitter+"/100")
+	assert.Equal(t, http.StatusNotFound, status)
+}
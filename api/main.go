@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	_ "embed"
 	"fmt"
 	"net/http"
@@ -13,13 +14,13 @@ import (
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/protocols"
 
-	frs "github.com/XLabs/fiber-redis-storage"
 	"github.com/ansrivas/fiberprometheus/v2"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/go-redis/redis/v8"
 	"github.com/gofiber/adaptor/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/limiter"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/pprof"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
@@ -27,37 +28,62 @@ import (
 
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/address"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/attestations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/chaindata"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/contracts"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/denylist"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/emitters"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governance"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
 	guardianHandlers "github.com/wormhole-foundation/wormhole-explorer/api/handlers/guardian"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/heartbeats"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/infrastructure"
+	addressLabels "github.com/wormhole-foundation/wormhole-explorer/api/handlers/labels"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/observations"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/operations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/participation"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/relays"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/reports"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/stats"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/config"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/loadshed"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/ratelimit"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/slo"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/tvl"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/guardian"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan"
 	rpcApi "github.com/wormhole-foundation/wormhole-explorer/api/rpc"
+	commonAudit "github.com/wormhole-foundation/wormhole-explorer/common/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/common/auth"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/archive"
 	wormscanCache "github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/httpclient"
 	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
 	"github.com/wormhole-foundation/wormhole-explorer/common/coingecko"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	xlogger "github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/postgres"
+	"github.com/wormhole-foundation/wormhole-explorer/common/reload"
 	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/common/secrets"
 	stats2 "github.com/wormhole-foundation/wormhole-explorer/common/stats"
-	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
+// The swagger specification is generated from the annotations of the handlers with `make generate`,
+// the swag version is pinned to the one in go.mod so the output does not depend on the local install.
+//go:generate go run github.com/swaggo/swag/cmd/swag@v1.16.1 init --parseDependency --parseInternal --output docs --outputTypes go,json,yaml
+
 //go:embed docs/swagger.json
 var swagger []byte
 
@@ -71,6 +97,7 @@ var swagger []byte
 // @Router /swagger.json [get]
 func GetSwagger(ctx *fiber.Ctx) error {
 
+	ctx.Type("json")
 	written, err := ctx.
 		Response().
 		BodyWriter().
@@ -111,9 +138,48 @@ func main() {
 	}
 
 	// Logging
-	rootLogger := xlogger.New("wormhole-api", xlogger.WithLevel(cfg.LogLevel))
+	logRedaction := cfg.GetLogRedaction()
+	rootLogger := xlogger.Redact(xlogger.New("wormhole-api", xlogger.WithLevel(cfg.LogLevel)), logRedaction)
 	defer rootLogger.Sync()
 
+	// Register the chains not known by the compiled wormhole sdk
+	chainIDOverrides, err := domain.ParseChainIDOverrides(cfg.ChainIDOverrides)
+	if err != nil {
+		rootLogger.Fatal("failed to parse chain id overrides", zap.Error(err))
+	}
+	domain.RegisterChainIDs(chainIDOverrides)
+
+	// Parse the registered emitters set by configuration
+	configEmitters, err := emitters.ParseRegisteredEmitters(cfg.RegisteredEmitters)
+	if err != nil {
+		rootLogger.Fatal("failed to parse registered emitters", zap.Error(err))
+	}
+
+	// Parse the emitters replaced by redeployed contracts
+	emitterRemapping, err := domain.ParseEmitterRemapping(cfg.EmitterRemapping)
+	if err != nil {
+		rootLogger.Fatal("failed to parse emitter remapping", zap.Error(err))
+	}
+
+	// Resolve the config values set with a secret reference
+	secretsResolver, err := NewSecretsResolver(appCtx, cfg, rootLogger)
+	if err != nil {
+		rootLogger.Fatal("failed to initialize secrets", zap.Error(err))
+	}
+	err = secretsResolver.ResolveAll(appCtx, &cfg.DB.URL, &cfg.Storage.PostgresURL, &cfg.Influx.Token,
+		&cfg.Cache.Password, &cfg.Coingecko.ApiKey, &cfg.VaaArchive.SecretAccessKey)
+	if err != nil {
+		rootLogger.Fatal("failed to resolve secrets", zap.Error(err))
+	}
+	if cfg.Secrets.RotationInterval > 0 {
+		// the clients are created with the secrets at startup, so the api is stopped to start again with the new secrets.
+		secretsResolver.OnRotate(func(ref secrets.Reference) {
+			rootLogger.Warn("secret rotated, stopping the api to use the new secret", zap.String("ref", ref.String()))
+			cancel()
+		})
+		go secretsResolver.Start(appCtx, time.Duration(cfg.Secrets.RotationInterval)*time.Minute)
+	}
+
 	// Setup DB
 	rootLogger.Info("connecting to MongoDB")
 	db, err := dbutil.Connect(appCtx, rootLogger, cfg.DB.URL, cfg.DB.Name, false)
@@ -121,9 +187,20 @@ func main() {
 		rootLogger.Fatal("failed to connect to MongoDB", zap.Error(err))
 	}
 
+	// Setup the optional PostgreSQL storage backend
+	var pgDB *sql.DB
+	if cfg.Storage.Backend == config.StorageBackendPostgres {
+		rootLogger.Info("connecting to PostgreSQL")
+		pgDB, err = postgres.Connect(appCtx, cfg.Storage.PostgresURL, rootLogger)
+		if err != nil {
+			rootLogger.Fatal("failed to connect to PostgreSQL", zap.Error(err))
+		}
+	}
+
 	// Get cache get function
 	rootLogger.Info("initializing cache")
-	cache, err := NewCache(appCtx, cfg, rootLogger)
+	cacheMetrics := wormscanCache.NewPrometheusMetrics(cfg.Environment, "wormscan-api")
+	cache, err := NewCache(appCtx, cfg, cacheMetrics, rootLogger)
 	if err != nil {
 		rootLogger.Fatal("failed to initialize cache", zap.Error(err))
 	}
@@ -140,6 +217,16 @@ func main() {
 	rootLogger.Info("initializing InfluxDB client")
 	influxCli := newInfluxClient(cfg.Influx.URL, cfg.Influx.Token)
 
+	// Track the latency of the databases to reject the low-priority requests while they are overloaded
+	var loadMonitor *loadshed.Monitor
+	if cfg.LoadShedding.Enabled {
+		loadMonitor = loadshed.NewMonitor(map[string]time.Duration{
+			loadshed.UpstreamMongo:  time.Duration(cfg.LoadShedding.MongoLatencyThreshold) * time.Millisecond,
+			loadshed.UpstreamInflux: time.Duration(cfg.LoadShedding.InfluxLatencyThreshold) * time.Millisecond,
+		}, time.Duration(cfg.LoadShedding.RetryAfter)*time.Second)
+		influxCli = loadshed.WrapInfluxClient(influxCli, loadMonitor)
+	}
+
 	//VaaPayloadParser client
 	vaaParserFunc, err := NewVaaParserFunc(cfg, rootLogger)
 	if err != nil {
@@ -152,7 +239,10 @@ func main() {
 	// Set up repositories
 	rootLogger.Info("initializing repositories")
 	addressRepo := address.NewRepository(db.Database, rootLogger)
-	vaaRepo := vaa.NewRepository(db.Database, rootLogger)
+	var vaaRepo vaa.Store = vaa.NewRepository(db.Database, rootLogger)
+	if pgDB != nil {
+		vaaRepo = vaa.NewPostgresRepository(pgDB, rootLogger)
+	}
 	obsRepo := observations.NewRepository(db.Database, rootLogger)
 	governorRepo := governor.NewRepository(db.Database, rootLogger)
 	infrastructureRepo := infrastructure.NewRepository(db.Database, rootLogger)
@@ -169,6 +259,14 @@ func main() {
 		rootLogger,
 	)
 	relaysRepo := relays.NewRepository(db.Database, rootLogger)
+	governanceRepo := governance.NewRepository(db.Database, rootLogger)
+	attestationsRepo := attestations.NewRepository(db.Database, rootLogger)
+	participationRepo := participation.NewRepository(db.Database, rootLogger)
+	reportsRepo := reports.NewRepository(db.Database, rootLogger)
+	emittersRepo := emitters.NewRepository(db.Database, rootLogger)
+	auditRepo := audit.NewRepository(db.Database, rootLogger)
+	labelsRepo := addressLabels.NewRepository(db.Database, rootLogger)
+	denyListRepo := denylist.NewRepository(db.Database, rootLogger)
 	operationsRepo := operations.NewRepository(db.Database, rootLogger)
 	nttRepo := stats2.NewNTTRepository(
 		influxCli,
@@ -181,6 +279,7 @@ func main() {
 		influxCli,
 		cfg.Influx.Organization,
 		cfg.Influx.Bucket24Hours,
+		cfg.Influx.Bucket30Days,
 		cfg.Influx.BucketInfinite,
 		coingeckoAPI,
 		tokenProvider,
@@ -202,22 +301,67 @@ func main() {
 	guardianSetRepository := repository.NewGuardianSetRepository(db.Database, rootLogger)
 
 	metrics := metrics.NewPrometheusMetrics(cfg.Environment)
+	repository.SetQueryObserver(func(collection, operation string, elapsed time.Duration, err error) {
+		metrics.ObserveQuery(collection, operation, elapsed, err)
+		if loadMonitor != nil {
+			loadMonitor.Observe(loadshed.UpstreamMongo, elapsed)
+		}
+	})
 
 	// Set up services
 	rootLogger.Info("initializing services")
 	expirationTime := time.Duration(cfg.Cache.MetricExpiration) * time.Minute
-	addressService := address.NewService(addressRepo, rootLogger)
-	vaaService := vaa.NewService(vaaRepo, cache.Get, vaaParserFunc, rootLogger)
-	obsService := observations.NewService(obsRepo, rootLogger)
-	governorService := governor.NewService(governorRepo, cache, metrics, rootLogger)
-	infrastructureService := infrastructure.NewService(infrastructureRepo, rootLogger)
+	labelsService := addressLabels.NewService(labelsRepo, cache, metrics, rootLogger)
+	denyListService := denylist.NewService(denyListRepo, cache, metrics, rootLogger)
+	chainDataService := chaindata.NewService(repository.NewChainDataWiper(db.Database, rootLogger), cfg.DataWipeEnabled, rootLogger)
+	addressService := address.NewService(addressRepo, labelsService, rootLogger)
+	guardianService := guardianHandlers.NewService(guardianSetRepository, cfg.P2pNetwork, cache, metrics, rootLogger)
+	emittersService := emitters.NewService(emittersRepo, configEmitters, cache, metrics, rootLogger)
 	heartbeatsService := heartbeats.NewService(heartbeatsRepo, rootLogger)
+	vaaService := vaa.NewService(vaaRepo, cache, time.Duration(cfg.Cache.VaaExpiration)*time.Minute, vaaParserFunc, guardianService, heartbeatsService, emittersService, rootLogger)
+	vaaService.SetP2pNetwork(cfg.P2pNetwork)
+	obsService := observations.NewService(obsRepo, observations.Limits{
+		MaxPageSize:          cfg.Observations.MaxPageSize,
+		MaxPageSizeByEmitter: cfg.Observations.MaxPageSizeByEmitter,
+		MaxTimeRange:         time.Duration(cfg.Observations.MaxTimeRange) * time.Hour,
+		MaxScan:              cfg.Observations.MaxScan,
+	}, rootLogger)
+	governorService := governor.NewService(governorRepo, guardianService, cache, metrics, cfg.Governor.Quorum, time.Duration(cfg.Governor.StatusStaleThreshold)*time.Minute, rootLogger)
+	infrastructureService := infrastructure.NewService(infrastructureRepo, cacheMetrics, rootLogger)
 	transactionsService := transactions.NewService(transactionsRepo, cache, expirationTime, tokenProvider, metrics, rootLogger)
+	if cfg.OriginTxResolver.Enabled {
+		originTxResolver, err := NewOriginTxResolver(cfg, cache, rootLogger)
+		if err != nil {
+			rootLogger.Fatal("failed to initialize origin tx resolver", zap.Error(err))
+		}
+		transactionsService.SetOriginTxResolver(originTxResolver)
+	}
+	if cfg.VaaArchive.Enabled {
+		vaaArchive, err := NewVaaArchive(appCtx, cfg)
+		if err != nil {
+			rootLogger.Fatal("failed to initialize vaa archive", zap.Error(err))
+		}
+		vaaService.SetArchive(vaaArchive)
+	}
 	relaysService := relays.NewService(relaysRepo, rootLogger)
-	operationsService := operations.NewService(operationsRepo, rootLogger)
+	governanceService := governance.NewService(governanceRepo, rootLogger)
+	attestationsService := attestations.NewService(attestationsRepo, rootLogger)
+	participationService := participation.NewService(participationRepo, heartbeatsService, rootLogger)
+	reportsService := reports.NewService(reportsRepo, rootLogger)
+	contractsService := contracts.NewService(cfg.P2pNetwork, rootLogger)
+	auditService := audit.NewService(auditRepo, rootLogger)
+	operationsService := operations.NewService(operationsRepo, metrics, rootLogger)
+	if cfg.EmitterDenyListEnabled {
+		vaaService.SetEmitterDenyList(denyListService)
+		transactionsService.SetEmitterDenyList(denyListService)
+		operationsService.SetEmitterDenyList(denyListService)
+	}
+	if cfg.PendingRedeemsInterval > 0 {
+		go operationsService.StartPendingRedeemsMetric(appCtx, time.Duration(cfg.PendingRedeemsInterval)*time.Minute)
+	}
 	statsService := stats.NewService(statsRepo, statsAddressRepo, statsHolderRepo, cache, expirationTime, metrics, rootLogger)
+	statsService.SetEmitterRemapping(emitterRemapping)
 	protocolsService := protocols.NewService(cfg.Protocols, []string{protocols.CCTP, protocols.PortalTokenBridge, protocols.NTT}, protocolsRepo, rootLogger, cache, cfg.Cache.ProtocolsStatsKey, cfg.Cache.ProtocolsStatsExpiration, metrics, tvl)
-	guardianService := guardianHandlers.NewService(guardianSetRepository, cfg.P2pNetwork, cache, metrics, rootLogger)
 
 	// Set up a custom error handler
 	response.SetEnableStackTrace(*cfg)
@@ -232,11 +376,39 @@ func main() {
 	prometheus := fiberprometheus.NewWithLabels(labels, "http", "")
 	prometheus.RegisterAt(app, "/metrics")
 	app.Use(prometheus.Middleware)
+
+	// track the service level objectives of the endpoints from the request durations of the prometheus middleware
+	// of all the instances, queried to the prometheus server that scrapes them.
+	if cfg.SLO.PrometheusURL != "" {
+		selector := fmt.Sprintf("service=%q,environment=%q", labels["service"], labels["environment"])
+		sloTracker, err := slo.NewTracker(cfg.SLO.PrometheusURL, selector, slo.Objectives{
+			Availability:     cfg.SLO.Availability,
+			Latency:          cfg.SLO.Latency,
+			LatencyThreshold: time.Duration(cfg.SLO.LatencyThreshold) * time.Millisecond,
+			Window:           time.Duration(cfg.SLO.Window) * time.Minute,
+		}, httpclient.Default(), rootLogger)
+		if err != nil {
+			rootLogger.Fatal("failed to create the slo tracker", zap.Error(err))
+		}
+		infrastructureService.SetSLOTracker(sloTracker)
+	}
 	app.Use(middleware.OriginMetrics(metrics))
+	app.Use(middleware.InFlightMetrics(app, metrics))
+	metrics.RegisterServerGauges(func() int32 { return app.Server().GetOpenConnectionsCount() }, app.Config().Concurrency)
 
 	app.Use(requestid.New())
+	requestLogFormat := "level=info timestamp=${time} method=${method} path=${path} latency=${latency} status${status} request_id=${locals:requestid} ip=${ips} queryParams=${queryParams}\n"
+	if logRedaction.IPs {
+		requestLogFormat = "level=info timestamp=${time} method=${method} path=${path} latency=${latency} status${status} request_id=${locals:requestid} queryParams=${queryParams}\n"
+	}
 	app.Use(logger.New(logger.Config{
-		Format: "level=info timestamp=${time} method=${method} path=${path} latency=${latency} status${status} request_id=${locals:requestid} ip=${ips} queryParams=${queryParams}\n",
+		Format: requestLogFormat,
+		// the query params are redacted as the log fields, e.g. ?address=.
+		CustomTags: map[string]logger.LogFunc{
+			logger.TagQueryStringParams: func(output logger.Buffer, c *fiber.Ctx, _ *logger.Data, _ string) (int, error) {
+				return output.WriteString(logRedaction.RedactQuery(c.Request().URI().QueryArgs().String()))
+			},
+		},
 		Next: func(c *fiber.Ctx) bool {
 			return middleware.IsK8sPath(c.Path())
 		},
@@ -247,18 +419,28 @@ func main() {
 	app.Use(cors.New())
 
 	// Configure rate limiter
+	var rateLimiter *middleware.RateLimiter
 	if cfg.RateLimit.Enabled {
-		rl, err := NewRateLimiter(appCtx, cfg, rootLogger)
+		rateLimiter = NewRateLimiter(cfg, metrics, rootLogger)
+		app.Use(rateLimiter.Handler)
+	}
+
+	// Configure the reload of the settings that can be changed without restarting the api
+	if cfg.Reload.File != "" {
+		configWatcher, err := NewConfigWatcher(cfg, rateLimiter, vaaService, transactionsService, statsService, rootLogger)
 		if err != nil {
-			panic(err)
+			rootLogger.Fatal("failed to initialize config reload", zap.Error(err))
 		}
-		app.Use(rl)
+		go configWatcher.Start(appCtx)
 	}
 
 	notSupportedByEnv := middleware.NotSupportedByTestnetEnv(cfg.P2pNetwork)
+	lowPriority := middleware.LoadShedding(loadMonitor, time.Duration(cfg.LoadShedding.RetryAfter)*time.Second, metrics)
 	// Set up route handlers
 	app.Get("/swagger.json", GetSwagger)
-	wormscan.RegisterRoutes(notSupportedByEnv, app, rootLogger, addressService, vaaService, obsService, governorService, infrastructureService, transactionsService, relaysService, operationsService, statsService, protocolsService)
+	adminOnly := auth.AdminApiKey(cfg.AdminApiKey)
+	auditor := commonAudit.NewAuditor(commonAudit.NewRepository(db.Database), "wormscan-api", rootLogger)
+	wormscan.RegisterRoutes(notSupportedByEnv, lowPriority, adminOnly, auditor, app, rootLogger, addressService, vaaService, obsService, governorService, infrastructureService, transactionsService, relaysService, operationsService, statsService, protocolsService, governanceService, emittersService, auditService, labelsService, denyListService, participationService, attestationsService, reportsService, contractsService, chainDataService)
 	guardian.RegisterRoutes(cfg, app, rootLogger, vaaService, governorService, heartbeatsService, guardianService)
 
 	// Set up gRPC handlers
@@ -305,11 +487,16 @@ func main() {
 	rootLogger.Info("closing MongoDB connection...")
 	db.DisconnectWithTimeout(10 * time.Second)
 
+	if pgDB != nil {
+		rootLogger.Info("closing PostgreSQL connection...")
+		pgDB.Close()
+	}
+
 	rootLogger.Info("terminated API service successfully")
 }
 
 // NewCache get a CacheGetFunc to get a value by a Key from cache and a CacheReadable to get a value by a Key from notional local cache.
-func NewCache(ctx context.Context, cfg *config.AppConfig, logger *zap.Logger) (wormscanCache.Cache, error) {
+func NewCache(ctx context.Context, cfg *config.AppConfig, metrics wormscanCache.Metrics, logger *zap.Logger) (wormscanCache.Cache, error) {
 
 	// if run mode is development with cache is disabled, return a dummy cache client and a dummy notional cache client.
 	if cfg.RunMode == config.RunModeDevelopmernt && !cfg.Cache.Enabled {
@@ -318,14 +505,23 @@ func NewCache(ctx context.Context, cfg *config.AppConfig, logger *zap.Logger) (w
 	}
 
 	// if we are not in development mode, use a distributed cache and for notional a pubsub to sync local cache.
-	redisClient := redis.NewClient(&redis.Options{Addr: cfg.Cache.URL})
+	redisClient := redis.NewClient(&redis.Options{Addr: cfg.Cache.URL, Password: cfg.Cache.Password})
 
 	// get cache client
-	cacheClient, err := wormscanCache.NewCacheClient(redisClient, cfg.Cache.Enabled, cfg.Cache.Prefix, logger)
+	cacheClient, err := wormscanCache.NewCacheClient(redisClient, cfg.Cache.Enabled, cfg.Cache.Prefix, logger, wormscanCache.WithMetrics(metrics))
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize cache client: %w", err)
 	}
 
+	// keep the values in memory so the replicas far from redis do not reach it on every read.
+	if cfg.Cache.LocalExpiration > 0 {
+		return wormscanCache.NewTieredCache(cacheClient,
+			time.Duration(cfg.Cache.LocalExpiration)*time.Second,
+			wormscanCache.WithMaxLocalEntries(cfg.Cache.LocalMaxEntries),
+			wormscanCache.WithExpirationJitter(float64(cfg.Cache.ExpirationJitter)/100),
+		), nil
+	}
+
 	return cacheClient, nil
 }
 
@@ -333,65 +529,60 @@ func newInfluxClient(url, token string) influxdb2.Client {
 	return influxdb2.NewClient(url, token)
 }
 
-func NewRateLimiter(ctx context.Context, cfg *config.AppConfig, logger *zap.Logger) (func(*fiber.Ctx) error, error) {
+// NewRateLimiter creates the rate limiter of the api with the token buckets in redis.
+// In development mode with the cache disabled, the token buckets are kept in memory.
+func NewRateLimiter(cfg *config.AppConfig, metrics metrics.Metrics, logger *zap.Logger) *middleware.RateLimiter {
 
+	prefix := "rate-limiter:"
 	if cfg.RateLimit.Prefix != "" {
-		cfg.RateLimit.Prefix += ":rate-limiter:"
+		prefix = cfg.RateLimit.Prefix + ":rate-limiter:"
+	}
+
+	var store ratelimit.Store
+	if cfg.RunMode == config.RunModeDevelopmernt && !cfg.Cache.Enabled {
+		store = ratelimit.NewMemoryStore()
 	} else {
-		cfg.RateLimit.Prefix = "rate-limiter:"
+		redisClient := redis.NewClient(&redis.Options{Addr: cfg.Cache.URL, Password: cfg.Cache.Password})
+		store = ratelimit.NewRedisStore(redisClient, prefix)
 	}
 
-	enableApiTokens := len(cfg.GetApiTokens()) > 0
-	enableByApiToken := make(map[string]bool)
-	if enableApiTokens {
-		for _, token := range cfg.GetApiTokens() {
-			enableByApiToken[token] = true
-		}
+	return middleware.NewRateLimiter(store, newRateLimits(cfg.GetReloadable()), metrics, logger)
+}
+
+// newRateLimits returns the limits of the rate limiter of the reloadable settings.
+func newRateLimits(r *config.Reloadable) middleware.RateLimits {
+	return middleware.RateLimits{
+		Groups: map[string]ratelimit.Limit{
+			middleware.RateLimitWormscan: {Rate: r.RateLimit.Max, Burst: r.RateLimit.Burst},
+			middleware.RateLimitGuardian: {Rate: r.RateLimit.GuardianMax, Burst: r.RateLimit.GuardianBurst},
+		},
+		ApiKey:  ratelimit.Limit{Rate: r.RateLimit.ApiKeyMax},
+		ApiKeys: r.GetApiTokens(),
 	}
+}
+
+// NewConfigWatcher creates the watcher of the reload file.
+// The reloaded settings are applied to the rate limiter, when it is enabled, and to the cache expirations of the services.
+func NewConfigWatcher(cfg *config.AppConfig, rateLimiter *middleware.RateLimiter, vaaService *vaa.Service,
+	transactionsService *transactions.Service, statsService *stats.Service, logger *zap.Logger) (*reload.Watcher[config.Reloadable], error) {
 
-	// initialize rate limiter
-	store, err := frs.New(
-		frs.Config{URL: cfg.Cache.URL, Prefix: cfg.RateLimit.Prefix})
+	w, err := reload.NewWatcher(cfg.Reload.File, time.Duration(cfg.Reload.Interval)*time.Second, cfg.LoadReloadable(), logger)
 	if err != nil {
-		logger.Error("failed to initialize rate limiter",
-			zap.String("url", cfg.Cache.URL),
-			zap.String("prefix", cfg.RateLimit.Prefix),
-			zap.Error(err))
 		return nil, err
 	}
 
-	// default to 60 requests per minute
-	if cfg.RateLimit.Max == 0 {
-		cfg.RateLimit.Max = 60
+	apply := func(r *config.Reloadable) {
+		if rateLimiter != nil {
+			rateLimiter.SetLimits(newRateLimits(r))
+		}
+		expiration := time.Duration(r.Cache.MetricExpiration) * time.Minute
+		transactionsService.SetCacheExpiration(expiration)
+		statsService.SetCacheExpiration(expiration)
+		vaaService.SetCacheExpiration(time.Duration(r.Cache.VaaExpiration) * time.Minute)
 	}
-
-	logger.Info("rate limit enabled", zap.Int("max requests per minute", cfg.RateLimit.Max))
-
-	router := limiter.New(limiter.Config{
-		Next: func(c *fiber.Ctx) bool {
-			if enableApiTokens {
-				apiKey := c.Get("X-API-KEY")
-				if apiKey != "" {
-					_, exists := enableByApiToken[apiKey]
-					return exists
-				}
-			}
-			ip := utils.GetRealIp(c)
-			return utils.IsPrivateIPAsString(ip)
-		},
-		Max:        cfg.RateLimit.Max,
-		Expiration: 60 * time.Second,
-		KeyGenerator: func(c *fiber.Ctx) string {
-			return utils.GetRealIp(c)
-		},
-		LimitReached: func(c *fiber.Ctx) error {
-			return c.SendStatus(fiber.StatusTooManyRequests)
-		},
-		Storage: store,
-	})
-
-	return router, nil
-
+	apply(w.Get())
+	w.OnReload(apply)
+	return w, nil
 }
 
 // NewVaaParserFunc returns a function to parse VAA payload.
@@ -408,3 +599,54 @@ func NewVaaParserFunc(cfg *config.AppConfig, logger *zap.Logger) (vaaPayloadPars
 	}
 	return vaaPayloadParserClient.ParseVaa, nil
 }
+
+// NewOriginTxResolver creates a resolver of origin txs backed by the tx-tracker internal gRPC service.
+func NewOriginTxResolver(cfg *config.AppConfig, cacheClient wormscanCache.Cache, logger *zap.Logger) (*transactions.OriginTxResolver, error) {
+	client, err := txtracker.NewTxTrackerGRPCClient(0, cfg.OriginTxResolver.URL, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize tx-tracker client: %w", err)
+	}
+	timeout := time.Duration(cfg.OriginTxResolver.Timeout) * time.Millisecond
+	if timeout <= 0 {
+		timeout = 500 * time.Millisecond
+	}
+	expiration := time.Duration(cfg.OriginTxResolver.CacheExpiration) * time.Minute
+	if expiration <= 0 {
+		expiration = time.Hour
+	}
+	unresolvedExpiration := time.Duration(cfg.OriginTxResolver.UnresolvedCacheExpiration) * time.Second
+	if unresolvedExpiration <= 0 {
+		unresolvedExpiration = 30 * time.Second
+	}
+	return transactions.NewOriginTxResolver(client.ResolveOriginTx, timeout, cacheClient, expiration, unresolvedExpiration, logger), nil
+}
+
+// NewSecretsResolver creates the resolver of the secret references with the secret stores of the configuration.
+func NewSecretsResolver(ctx context.Context, cfg *config.AppConfig, logger *zap.Logger) (*secrets.Resolver, error) {
+	resolver := secrets.NewResolver(logger)
+	if cfg.Secrets.AwsRegion != "" {
+		awsConfig, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(cfg.Secrets.AwsRegion))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create aws config: %w", err)
+		}
+		resolver.Register(secrets.SchemeAws, secrets.NewAwsSecretsManager(awsConfig, cfg.Secrets.AwsEndpoint))
+	}
+	if cfg.Secrets.VaultAddr != "" {
+		resolver.Register(secrets.SchemeVault, secrets.NewVault(cfg.Secrets.VaultAddr, cfg.Secrets.VaultToken))
+	}
+	return resolver, nil
+}
+
+// NewVaaArchive creates the client of the archive of the raw vaas.
+func NewVaaArchive(ctx context.Context, cfg *config.AppConfig) (*archive.VaaArchive, error) {
+	opts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(cfg.VaaArchive.Region)}
+	if cfg.VaaArchive.AccessKeyID != "" && cfg.VaaArchive.SecretAccessKey != "" {
+		credentials := credentials.NewStaticCredentialsProvider(cfg.VaaArchive.AccessKeyID, cfg.VaaArchive.SecretAccessKey, "")
+		opts = append(opts, awsconfig.WithCredentialsProvider(credentials))
+	}
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aws config: %w", err)
+	}
+	return archive.NewVaaArchive(payload.NewS3Store(awsConfig, cfg.VaaArchive.Endpoint), cfg.VaaArchive.Bucket), nil
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// canonicalRedirectMaxAge is the max-age of the redirects to the canonical urls,
+// the canonical form of a query string never changes.
+const canonicalRedirectMaxAge = "public, max-age=86400"
+
+// CanonicalQuery redirects the GET and HEAD requests whose query string is not canonical
+// to the canonical url, so the CDN in front of the API caches a single response for all
+// the orderings of the same parameters.
+//
+// The canonical query string has its parameters sorted by name, without the empty ones
+// and without the ones set to the default value in [defaults]. The values are kept as
+// they were encoded by the client and the repeated parameters keep their relative order.
+func CanonicalQuery(defaults map[string]string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		method := c.Method()
+		if method != fiber.MethodGet && method != fiber.MethodHead {
+			return c.Next()
+		}
+		raw := string(c.Request().URI().QueryString())
+		if raw == "" {
+			return c.Next()
+		}
+		canonical := CanonicalQueryString(raw, defaults)
+		if canonical == raw {
+			return c.Next()
+		}
+		location := c.Path()
+		if canonical != "" {
+			location += "?" + canonical
+		}
+		c.Set(fiber.HeaderCacheControl, canonicalRedirectMaxAge)
+		return c.Redirect(location, fiber.StatusMovedPermanently)
+	}
+}
+
+// CacheKey returns the key of the request in the cache middlewares: the path and the query string.
+// The query string is expected to be canonical, see [CanonicalQuery].
+func CacheKey(c *fiber.Ctx) string {
+	key := c.Path()
+	if query := c.Request().URI().QueryString(); len(query) > 0 {
+		key += "?" + string(query)
+	}
+	return key
+}
+
+type queryParam struct {
+	name string
+	raw  string
+}
+
+// CanonicalQueryString returns the canonical form of the raw query string.
+// The default values are compared ignoring the case.
+func CanonicalQueryString(raw string, defaults map[string]string) string {
+	var params []queryParam
+	for _, pair := range strings.Split(raw, "&") {
+		if pair == "" {
+			continue
+		}
+		rawName, rawValue, _ := strings.Cut(pair, "=")
+		name, err := url.QueryUnescape(rawName)
+		if err != nil {
+			name = rawName
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			value = rawValue
+		}
+		if name == "" || value == "" {
+			continue
+		}
+		if d, ok := defaults[name]; ok && strings.EqualFold(d, value) {
+			continue
+		}
+		params = append(params, queryParam{name: name, raw: pair})
+	}
+	sort.SliceStable(params, func(i, j int) bool {
+		return params[i].name < params[j].name
+	})
+
+	pairs := make([]string, 0, len(params))
+	for _, p := range params {
+		pairs = append(pairs, p.raw)
+	}
+	return strings.Join(pairs, "&")
+}
@@ -0,0 +1,84 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+)
+
+var testQueryDefaults = map[string]string{
+	"page":      "0",
+	"pageSize":  "50",
+	"sortOrder": "DESC",
+}
+
+func Test_CanonicalQueryString(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{name: "empty", raw: "", expected: ""},
+		{name: "canonical", raw: "page=1&pageSize=10", expected: "page=1&pageSize=10"},
+		{name: "sorted", raw: "pageSize=10&page=1", expected: "page=1&pageSize=10"},
+		{name: "defaults", raw: "sortOrder=desc&page=0&pageSize=50&address=0xabc", expected: "address=0xabc"},
+		{name: "empty values", raw: "txHash=&page=2&&chain", expected: "page=2"},
+		{name: "encoded values", raw: "q=a%2Cb&apps=X,Y", expected: "apps=X,Y&q=a%2Cb"},
+		{name: "repeated", raw: "chain=2&appId=b&chain=1", expected: "appId=b&chain=2&chain=1"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			canonical := middleware.CanonicalQueryString(testCase.raw, testQueryDefaults)
+			if canonical != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, canonical)
+			}
+		})
+	}
+}
+
+func Test_CanonicalQuery(t *testing.T) {
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Use(middleware.CanonicalQuery(testQueryDefaults))
+	app.Get("/api/v1/vaas", func(c *fiber.Ctx) error { return c.SendString("vaas") })
+	app.Post("/api/v1/vaas/parse", func(c *fiber.Ctx) error { return c.SendString("parsed") })
+
+	testCases := []struct {
+		name             string
+		method           string
+		path             string
+		expectedStatus   int
+		expectedLocation string
+	}{
+		{name: "no query", method: http.MethodGet, path: "/api/v1/vaas", expectedStatus: http.StatusOK},
+		{name: "canonical", method: http.MethodGet, path: "/api/v1/vaas?page=1&pageSize=10", expectedStatus: http.StatusOK},
+		{name: "unsorted", method: http.MethodGet, path: "/api/v1/vaas?pageSize=10&page=1", expectedStatus: http.StatusMovedPermanently, expectedLocation: "/api/v1/vaas?page=1&pageSize=10"},
+		{name: "defaults", method: http.MethodHead, path: "/api/v1/vaas?page=0&sortOrder=DESC", expectedStatus: http.StatusMovedPermanently, expectedLocation: "/api/v1/vaas"},
+		{name: "post", method: http.MethodPost, path: "/api/v1/vaas/parse?pageSize=10&page=1", expectedStatus: http.StatusOK},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(testCase.method, testCase.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := app.Test(req, 1000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != testCase.expectedStatus {
+				t.Errorf("expected status %d, got %d", testCase.expectedStatus, resp.StatusCode)
+			}
+			if location := resp.Header.Get(fiber.HeaderLocation); location != testCase.expectedLocation {
+				t.Errorf("expected Location %q, got %q", testCase.expectedLocation, location)
+			}
+		})
+	}
+}
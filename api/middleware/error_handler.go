@@ -24,6 +24,22 @@ func ErrorHandler(ctx *fiber.Ctx, err error) error {
 	case errors.Is(err, errs.ErrNotFound):
 		apiError = response.NewNotFoundError(ctx)
 		ctx.Status(fiber.StatusNotFound).JSON(apiError)
+	case errors.Is(err, errs.ErrInvalidParam), errors.Is(err, errs.ErrMalformedQuery):
+		var message string
+		var typedError *errs.Error
+		if errors.As(err, &typedError) {
+			message = typedError.Message()
+		}
+		apiError = response.NewInvalidParamError(ctx, message, err)
+		ctx.Status(fiber.StatusBadRequest).JSON(apiError)
+	case errors.Is(err, errs.ErrQueryTooLarge):
+		var message string
+		var typedError *errs.Error
+		if errors.As(err, &typedError) {
+			message = typedError.Message()
+		}
+		apiError = response.NewQueryTooLargeError(ctx, message, err)
+		ctx.Status(fiber.StatusRequestEntityTooLarge).JSON(apiError)
 	default:
 		apiError = response.NewInternalError(ctx, err)
 		ctx.Status(fiber.StatusInternalServerError).JSON(apiError)
@@ -0,0 +1,86 @@
+package middleware_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	errs "github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+)
+
+func Test_ErrorHandler(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		err                error
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:               "Test_ErrorHandler_NotFound",
+			err:                errors.WithStack(errs.ErrNotFound),
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"code":5,"message":"NOT FOUND","details":[{"request_id":"\u003cnil\u003e"}]}`,
+		},
+		{
+			name:               "Test_ErrorHandler_RepositoryNotFound",
+			err:                fmt.Errorf("failed to find vaa: %w", repository.ErrNotFound),
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"code":5,"message":"NOT FOUND","details":[{"request_id":"\u003cnil\u003e"}]}`,
+		},
+		{
+			name:               "Test_ErrorHandler_TypedNotFound",
+			err:                errs.NewNotFound("no result"),
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"code":5,"message":"NOT FOUND","details":[{"request_id":"\u003cnil\u003e"}]}`,
+		},
+		{
+			name:               "Test_ErrorHandler_InvalidParam",
+			err:                errors.WithStack(errs.NewInvalidParam("symbol not supported")),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"code":3,"message":"symbol not supported","details":[{"request_id":"\u003cnil\u003e"}]}`,
+		},
+		{
+			name:               "Test_ErrorHandler_Internal",
+			err:                errors.New("unexpected error"),
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedResponse:   `{"code":13,"message":"INTERNAL ERROR","details":[{"request_id":"\u003cnil\u003e"}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			req, err := http.NewRequest(http.MethodGet, "/test", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler:          middleware.ErrorHandler,
+				DisableStartupMessage: true,
+			})
+			app.Get("/test", func(c *fiber.Ctx) error { return testCase.err })
+
+			resp, _ := app.Test(req, 1000)
+			defer resp.Body.Close()
+
+			if resp.StatusCode != testCase.expectedStatusCode {
+				t.Fatalf("expected status code %d, got %d", testCase.expectedStatusCode, resp.StatusCode)
+			}
+
+			respBytes, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(respBytes) != testCase.expectedResponse {
+				t.Fatalf("expected response %s, got %s", testCase.expectedResponse, string(respBytes))
+			}
+		})
+	}
+}
@@ -61,6 +61,31 @@ func ExtractToChain(c *fiber.Ctx, l *zap.Logger) (*sdk.ChainID, error) {
 	return &result, nil
 }
 
+// ExtractChainQuery obtains the "chain" query parameter from the request.
+//
+// When the parameter is not present, the function returns: a nil ChainID and a nil error.
+func ExtractChainQuery(c *fiber.Ctx, l *zap.Logger) (*sdk.ChainID, error) {
+
+	param := c.Query("chain")
+	if param == "" {
+		return nil, nil
+	}
+
+	chain, err := strconv.ParseInt(param, 10, 16)
+	if err != nil {
+		requestID := fmt.Sprintf("%v", c.Locals("requestid"))
+		l.Error("failed to parse chain parameter",
+			zap.Error(err),
+			zap.String("requestID", requestID),
+		)
+
+		return nil, response.NewInvalidParamError(c, "INVALID CHAIN VALUE", errors.WithStack(err))
+	}
+
+	result := sdk.ChainID(chain)
+	return &result, nil
+}
+
 func ExtractSourceChain(c *fiber.Ctx, l *zap.Logger) ([]sdk.ChainID, error) {
 	param := c.Query("sourceChain")
 	if param == "" {
@@ -322,6 +347,33 @@ func ExtractParsedPayload(c *fiber.Ctx, l *zap.Logger) (bool, error) {
 	return parsedPayload, nil
 }
 
+// ExtractIncludeObservations get includeObservations query parameter.
+func ExtractIncludeObservations(c *fiber.Ctx, l *zap.Logger) (bool, error) {
+
+	includeObservationsStr := c.Query("includeObservations", "false")
+
+	includeObservations, err := strconv.ParseBool(includeObservationsStr)
+	if err != nil {
+		return false, response.NewInvalidQueryParamError(c, "INVALID <includeObservations> QUERY PARAMETER", errors.WithStack(err))
+	}
+
+	return includeObservations, nil
+}
+
+// ExtractHumanFormat get format query parameter.
+// It returns true when the human-readable fields must be included in the response.
+func ExtractHumanFormat(c *fiber.Ctx) (bool, error) {
+
+	switch c.Query("format") {
+	case "", "raw":
+		return false, nil
+	case "human":
+		return true, nil
+	default:
+		return false, response.NewInvalidQueryParamError(c, "INVALID <format> QUERY PARAMETER", nil)
+	}
+}
+
 func ExtractAppId(c *fiber.Ctx, l *zap.Logger) string {
 	return c.Query("appId")
 }
@@ -406,6 +458,19 @@ func ExtractTime(c *fiber.Ctx, timeLayout, queryParam string) (*time.Time, error
 	return &t, nil
 }
 
+// ExtractTimeZone returns the location of the IANA time zone of the `tz` query param, UTC when it is not present.
+func ExtractTimeZone(c *fiber.Ctx) (*time.Location, error) {
+	tz := c.Query("tz")
+	if tz == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil || strings.EqualFold(tz, "local") {
+		return nil, response.NewInvalidQueryParamError(c, "INVALID <tz> QUERY PARAMETER", nil)
+	}
+	return loc, nil
+}
+
 func ExtractSymbol(c *fiber.Ctx) (string, error) {
 	symbol := c.Query("symbol")
 	if symbol == "" {
@@ -505,6 +570,21 @@ func ExtractTopCorridorsTimeSpan(ctx *fiber.Ctx) (*stats.TopCorridorsTimeSpan, e
 	return timeSpan, nil
 }
 
+// ExtractTransferSizeTimeSpan parses the `timeSpan` query parameter of the transfer sizes, 7d by default.
+func ExtractTransferSizeTimeSpan(ctx *fiber.Ctx) (*stats.TransferSizeTimeSpan, error) {
+	defaultTimeSpan := stats.TimeSpan7DaysTransferSize
+	s := ctx.Query("timeSpan")
+	if s == "" {
+		return &defaultTimeSpan, nil
+	}
+	timeSpan, err := stats.ParseTransferSizeTimeSpan(s)
+	if err != nil {
+		return nil, response.NewInvalidQueryParamError(ctx, "INVALID <timeSpan> QUERY PARAMETER", nil)
+	}
+
+	return timeSpan, nil
+}
+
 func ExtractNttTimeSpan(ctx *fiber.Ctx) (*stats.NttTimespan, error) {
 
 	s := ctx.Query("timeSpan")
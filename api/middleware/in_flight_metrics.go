@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+)
+
+const unknownRoute = "unknown"
+
+// InFlightMetrics tracks the number of requests being served by method and route.
+//
+// The route is not known by fiber until the request is dispatched to its handler,
+// so the route templates are resolved from the routes registered in the app.
+func InFlightMetrics(app *fiber.App, m metrics.Metrics) fiber.Handler {
+	matcher := &routeMatcher{app: app}
+	return func(c *fiber.Ctx) error {
+		method := c.Method()
+		route := matcher.match(method, c.Path())
+		m.IncInFlightRequests(method, route)
+		defer m.DecInFlightRequests(method, route)
+		return c.Next()
+	}
+}
+
+// routeMatcher resolves the route template (e.g. /api/v1/vaas/:chain) of a request path.
+type routeMatcher struct {
+	app    *fiber.App
+	once   sync.Once
+	routes map[string][][]string
+}
+
+// load builds the route templates on the first request, when all the routes are already registered.
+func (r *routeMatcher) load() {
+	r.routes = make(map[string][][]string)
+	for _, route := range r.app.GetRoutes(true) {
+		r.routes[route.Method] = append(r.routes[route.Method], splitPath(route.Path))
+	}
+}
+
+func (r *routeMatcher) match(method, path string) string {
+	r.once.Do(r.load)
+	segments := splitPath(path)
+	for _, template := range r.routes[method] {
+		if matchSegments(template, segments) {
+			return "/" + strings.Join(template, "/")
+		}
+	}
+	return unknownRoute
+}
+
+// allowed returns the methods of the routes matching the path, OPTIONS included.
+// It returns nil when no route matches the path.
+func (r *routeMatcher) allowed(path string) []string {
+	r.once.Do(r.load)
+	segments := splitPath(path)
+	var methods []string
+	for _, method := range fiber.DefaultMethods {
+		if method == fiber.MethodOptions {
+			continue
+		}
+		for _, template := range r.routes[method] {
+			if matchSegments(template, segments) {
+				methods = append(methods, method)
+				break
+			}
+		}
+	}
+	if len(methods) == 0 {
+		return nil
+	}
+	return append(methods, fiber.MethodOptions)
+}
+
+func matchSegments(template, segments []string) bool {
+	for i, t := range template {
+		if t == "*" || strings.HasPrefix(t, "+") {
+			return true
+		}
+		if i >= len(segments) {
+			return strings.HasPrefix(t, ":") && strings.HasSuffix(t, "?") && i == len(template)-1
+		}
+		if strings.HasPrefix(t, ":") {
+			continue
+		}
+		if !strings.EqualFold(t, segments[i]) {
+			return false
+		}
+	}
+	return len(template) == len(segments)
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return []string{}
+	}
+	return strings.Split(path, "/")
+}
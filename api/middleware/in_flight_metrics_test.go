@@ -0,0 +1,69 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+)
+
+type inFlightMetrics struct {
+	metrics.Metrics
+	inFlight map[string]int
+	seen     []string
+}
+
+func (m *inFlightMetrics) IncInFlightRequests(method, route string) {
+	m.inFlight[method+" "+route]++
+	m.seen = append(m.seen, method+" "+route)
+}
+
+func (m *inFlightMetrics) DecInFlightRequests(method, route string) {
+	m.inFlight[method+" "+route]--
+}
+
+func Test_InFlightMetrics(t *testing.T) {
+
+	m := &inFlightMetrics{Metrics: metrics.NewNoOpMetrics(), inFlight: map[string]int{}}
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Use(middleware.InFlightMetrics(app, m))
+	handler := func(c *fiber.Ctx) error { return c.SendStatus(http.StatusOK) }
+	app.Get("/api/v1/vaas/vaa-counts", handler)
+	app.Get("/api/v1/vaas/:chain", handler)
+	app.Get("/api/v1/vaas/:chain/:emitter", handler)
+	app.Post("/api/v1/vaas/parse", handler)
+
+	testCases := []struct {
+		method        string
+		path          string
+		expectedRoute string
+	}{
+		{method: http.MethodGet, path: "/api/v1/vaas/vaa-counts", expectedRoute: "GET /api/v1/vaas/vaa-counts"},
+		{method: http.MethodGet, path: "/api/v1/vaas/2", expectedRoute: "GET /api/v1/vaas/:chain"},
+		{method: http.MethodGet, path: "/api/v1/vaas/2/0xcafe/", expectedRoute: "GET /api/v1/vaas/:chain/:emitter"},
+		{method: http.MethodPost, path: "/api/v1/vaas/parse", expectedRoute: "POST /api/v1/vaas/parse"},
+		{method: http.MethodGet, path: "/api/v1/unknown", expectedRoute: "GET unknown"},
+	}
+
+	for i, testCase := range testCases {
+		req, err := http.NewRequest(testCase.method, testCase.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := app.Test(req, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if m.seen[i] != testCase.expectedRoute {
+			t.Fatalf("expected route %s, got %s", testCase.expectedRoute, m.seen[i])
+		}
+		if m.inFlight[testCase.expectedRoute] != 0 {
+			t.Fatalf("expected no requests in flight for %s, got %d", testCase.expectedRoute, m.inFlight[testCase.expectedRoute])
+		}
+	}
+}
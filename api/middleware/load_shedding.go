@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/loadshed"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+)
+
+// LoadShedding rejects the requests with 503 Service Unavailable while an upstream is overloaded.
+//
+// It is meant to be registered only on the low-priority routes (searches and heavy aggregations),
+// so the critical routes keep serving while the databases recover.
+// The response includes a Retry-After header with the given duration.
+func LoadShedding(monitor *loadshed.Monitor, retryAfter time.Duration, m metrics.Metrics) fiber.Handler {
+	seconds := strconv.Itoa(int(retryAfter.Seconds()))
+	return func(c *fiber.Ctx) error {
+		if monitor == nil || !monitor.Overloaded() {
+			return c.Next()
+		}
+		m.IncShedRequests(c.Route().Path)
+		c.Set(fiber.HeaderRetryAfter, seconds)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "The service is overloaded, retry later",
+		})
+	}
+}
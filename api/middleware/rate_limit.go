@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/ratelimit"
+	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	"go.uber.org/zap"
+)
+
+// Route groups with their own rate limits.
+const (
+	RateLimitWormscan = "wormscan"
+	RateLimitGuardian = "guardian"
+)
+
+// RateLimits are the limits of the rate limiter.
+type RateLimits struct {
+	// Groups are the limits of the requests of each client ip by route group, the groups without limit are not limited.
+	Groups map[string]ratelimit.Limit
+	// ApiKey is the limit of the requests of each api key by route group,
+	// the requests with an api key are not limited when it is unlimited.
+	ApiKey ratelimit.Limit
+	// ApiKeys are the keys of the clients limited by api key instead of by ip.
+	ApiKeys []string
+}
+
+type rateLimits struct {
+	groups  map[string]ratelimit.Limit
+	apiKey  ratelimit.Limit
+	apiKeys map[string]bool
+}
+
+// RateLimiter limits the requests of the clients with a token bucket by route group and client.
+//
+// The requests with one of the configured api keys are limited by api key, the other requests by client ip.
+// The requests from private ips are not limited. The limits can be changed while the api is running.
+type RateLimiter struct {
+	store   ratelimit.Store
+	limits  atomic.Pointer[rateLimits]
+	metrics metrics.Metrics
+	logger  *zap.Logger
+}
+
+// NewRateLimiter creates a rate limiter with the token buckets of the given store.
+func NewRateLimiter(store ratelimit.Store, limits RateLimits, m metrics.Metrics, logger *zap.Logger) *RateLimiter {
+	r := &RateLimiter{store: store, metrics: m, logger: logger}
+	r.SetLimits(limits)
+	return r
+}
+
+// SetLimits changes the limits of the rate limiter.
+// The buckets are kept, so the tokens taken so far still count with the new limits.
+func (r *RateLimiter) SetLimits(limits RateLimits) {
+	apiKeys := make(map[string]bool)
+	for _, key := range limits.ApiKeys {
+		if key = strings.TrimSpace(key); key != "" {
+			apiKeys[key] = true
+		}
+	}
+	r.limits.Store(&rateLimits{groups: limits.Groups, apiKey: limits.ApiKey, apiKeys: apiKeys})
+	for group, limit := range limits.Groups {
+		r.logger.Info("rate limit enabled",
+			zap.String("group", group),
+			zap.Int("max requests per minute", limit.Rate),
+			zap.Int("burst", limit.Burst))
+	}
+}
+
+// Handler is the middleware of the rate limiter.
+// The route group of a request is resolved from its path with [RateLimitGroup].
+func (r *RateLimiter) Handler(c *fiber.Ctx) error {
+	limits := r.limits.Load()
+	group := RateLimitGroup(c.Path())
+
+	var key string
+	var limit ratelimit.Limit
+	if apiKey := c.Get("X-API-KEY"); apiKey != "" && limits.apiKeys[apiKey] {
+		key, limit = group+":key:"+hashApiKey(apiKey), limits.apiKey
+	} else {
+		ip := utils.GetRealIp(c)
+		if utils.IsPrivateIPAsString(ip) {
+			return c.Next()
+		}
+		key, limit = group+":ip:"+ip, limits.groups[group]
+	}
+	if limit.Unlimited() {
+		return c.Next()
+	}
+
+	result, err := r.store.Take(c.Context(), key, limit)
+	if err != nil {
+		// the requests are not rejected while the rate limits cannot be checked.
+		r.logger.Error("failed to check rate limit", zap.String("group", group), zap.Error(err))
+		return c.Next()
+	}
+
+	c.Set("X-RateLimit-Limit", strconv.Itoa(limit.Rate))
+	c.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	if !result.Allowed {
+		r.metrics.IncRateLimitedRequests(group)
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(result.RetryAfter.Seconds()))))
+		return c.SendStatus(fiber.StatusTooManyRequests)
+	}
+	return c.Next()
+}
+
+// RateLimitGroup returns the route group of a request path, the guardian routes are served under /v1.
+func RateLimitGroup(path string) string {
+	if strings.HasPrefix(path, "/v1/") {
+		return RateLimitGuardian
+	}
+	return RateLimitWormscan
+}
+
+// hashApiKey keeps the api keys out of the keys of the buckets.
+func hashApiKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:8])
+}
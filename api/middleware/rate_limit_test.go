@@ -0,0 +1,135 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/ratelimit"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"go.uber.org/zap"
+)
+
+type rateLimitedMetrics struct {
+	metrics.Metrics
+	rejected map[string]int
+}
+
+func (m *rateLimitedMetrics) IncRateLimitedRequests(group string) {
+	m.rejected[group]++
+}
+
+func newRateLimitedApp(limiter *middleware.RateLimiter) *fiber.App {
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Use(limiter.Handler)
+	handler := func(c *fiber.Ctx) error { return c.SendStatus(http.StatusOK) }
+	app.Get("/api/v1/vaas", handler)
+	app.Get("/v1/heartbeats", handler)
+	return app
+}
+
+func doRequest(t *testing.T, app *fiber.App, path, ip, apiKey string) *http.Response {
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Forwarded-For", ip)
+	if apiKey != "" {
+		req.Header.Set("X-API-KEY", apiKey)
+	}
+	resp, err := app.Test(req, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp
+}
+
+func Test_RateLimiter(t *testing.T) {
+
+	m := &rateLimitedMetrics{Metrics: metrics.NewNoOpMetrics(), rejected: map[string]int{}}
+	limiter := middleware.NewRateLimiter(ratelimit.NewMemoryStore(), middleware.RateLimits{
+		Groups: map[string]ratelimit.Limit{
+			middleware.RateLimitWormscan: {Rate: 2},
+			middleware.RateLimitGuardian: {Rate: 1},
+		},
+		ApiKey:  ratelimit.Limit{Rate: 3},
+		ApiKeys: []string{"key1", " key2 "},
+	}, m, zap.NewNop())
+	app := newRateLimitedApp(limiter)
+
+	// the wormscan routes are limited by ip.
+	assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", "8.8.8.8", "").StatusCode)
+	resp := doRequest(t, app, "/api/v1/vaas", "8.8.8.8", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "2", resp.Header.Get("X-RateLimit-Limit"))
+	assert.Equal(t, "0", resp.Header.Get("X-RateLimit-Remaining"))
+	resp = doRequest(t, app, "/api/v1/vaas", "8.8.8.8", "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "30", resp.Header.Get(fiber.HeaderRetryAfter))
+	assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", "8.8.4.4", "").StatusCode)
+
+	// the guardian routes have their own limit.
+	assert.Equal(t, http.StatusOK, doRequest(t, app, "/v1/heartbeats", "8.8.8.8", "").StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(t, app, "/v1/heartbeats", "8.8.8.8", "").StatusCode)
+
+	// the requests with an unknown api key are limited by ip.
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(t, app, "/api/v1/vaas", "8.8.8.8", "unknown").StatusCode)
+
+	// the requests with an api key are limited by api key, whatever their ip.
+	for _, ip := range []string{"8.8.8.8", "1.1.1.1", "8.8.4.4"} {
+		assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", ip, "key2").StatusCode)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(t, app, "/api/v1/vaas", "1.1.1.1", "key2").StatusCode)
+	assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", "1.1.1.1", "key1").StatusCode)
+
+	// the requests from private ips are not limited.
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", "10.0.0.1", "").StatusCode)
+	}
+
+	assert.Equal(t, map[string]int{middleware.RateLimitWormscan: 3, middleware.RateLimitGuardian: 1}, m.rejected)
+}
+
+func Test_RateLimiter_SetLimits(t *testing.T) {
+
+	limiter := middleware.NewRateLimiter(ratelimit.NewMemoryStore(), middleware.RateLimits{
+		Groups: map[string]ratelimit.Limit{
+			middleware.RateLimitWormscan: {Rate: 1},
+		},
+		ApiKeys: []string{"key1"},
+	}, metrics.NewNoOpMetrics(), zap.NewNop())
+	app := newRateLimitedApp(limiter)
+
+	// the groups without limit and the api keys are not limited when their limit is not set.
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, doRequest(t, app, "/v1/heartbeats", "8.8.8.8", "").StatusCode)
+		assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", "8.8.8.8", "key1").StatusCode)
+	}
+	assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", "8.8.8.8", "").StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(t, app, "/api/v1/vaas", "8.8.8.8", "").StatusCode)
+
+	limiter.SetLimits(middleware.RateLimits{
+		Groups: map[string]ratelimit.Limit{
+			middleware.RateLimitWormscan: {Rate: 1, Burst: 3},
+		},
+	})
+
+	// the bucket keeps its tokens and it is refilled up to the new burst.
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(t, app, "/api/v1/vaas", "8.8.8.8", "").StatusCode)
+	assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", "8.8.4.4", "").StatusCode)
+	assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", "8.8.4.4", "").StatusCode)
+	assert.Equal(t, http.StatusOK, doRequest(t, app, "/api/v1/vaas", "8.8.4.4", "").StatusCode)
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(t, app, "/api/v1/vaas", "8.8.4.4", "").StatusCode)
+
+	// the api key is no longer known.
+	assert.Equal(t, http.StatusTooManyRequests, doRequest(t, app, "/api/v1/vaas", "8.8.8.8", "key1").StatusCode)
+}
+
+func Test_RateLimitGroup(t *testing.T) {
+	assert.Equal(t, middleware.RateLimitGuardian, middleware.RateLimitGroup("/v1/heartbeats"))
+	assert.Equal(t, middleware.RateLimitWormscan, middleware.RateLimitGroup("/api/v1/vaas"))
+	assert.Equal(t, middleware.RateLimitWormscan, middleware.RateLimitGroup("/swagger.json"))
+}
@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+)
+
+// immutableMaxAge is the max-age of the immutable responses.
+const immutableMaxAge = 365 * 24 * time.Hour
+
+// varyHeaders are the request headers that select the representation of the cacheable responses.
+// The body is compressed according to Accept-Encoding; the CORS headers do not depend on the
+// Origin because every origin is allowed, and the version is part of the path.
+var varyHeaders = []string{fiber.HeaderAcceptEncoding}
+
+// CachePolicy is the http caching policy of a family of endpoints.
+type CachePolicy struct {
+	// Path is the path of the family, relative to the version prefix (e.g. /vaas).
+	// It matches the path itself and every path under it.
+	Path string
+	// MaxAge is the time the responses can be cached, the responses must be revalidated when it is zero.
+	MaxAge time.Duration
+	// ImmutableAfter marks as immutable the responses whose Last-Modified is older than it.
+	// Zero disables the immutable responses.
+	ImmutableAfter time.Duration
+	// NoStore disables any caching of the responses.
+	NoStore bool
+}
+
+// SetLastModified sets the Last-Modified header with the time the resource of the response was last modified.
+//
+// The ResponsePolicy middleware answers the conditional requests that are still fresh with 304 Not Modified.
+// The header is set by the handler, so it is kept by the cache middlewares along with the response.
+func SetLastModified(c *fiber.Ctx, t time.Time) {
+	c.Set(fiber.HeaderLastModified, t.UTC().Format(http.TimeFormat))
+}
+
+// ResponsePolicy sets the caching headers (Cache-Control and Vary) of the successful GET and HEAD
+// responses according to the policy of the longest path matching the request, and answers
+// the OPTIONS requests of the registered routes with the allowed methods.
+//
+// It must be registered on the versioned route groups, the CORS preflight requests
+// are expected to be answered before reaching it.
+func ResponsePolicy(app *fiber.App, policies []CachePolicy) fiber.Handler {
+	sorted := make([]CachePolicy, len(policies))
+	copy(sorted, policies)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].Path) > len(sorted[j].Path)
+	})
+	matcher := &routeMatcher{app: app}
+
+	return func(c *fiber.Ctx) error {
+		method := c.Method()
+		if method == fiber.MethodOptions {
+			allowed := matcher.allowed(c.Path())
+			if len(allowed) == 0 {
+				return c.Next()
+			}
+			c.Set(fiber.HeaderAllow, strings.Join(allowed, ", "))
+			return c.SendStatus(fiber.StatusNoContent)
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+		if method != fiber.MethodGet && method != fiber.MethodHead {
+			return nil
+		}
+		status := c.Response().StatusCode()
+		if status < fiber.StatusOK || status >= fiber.StatusMultipleChoices {
+			return nil
+		}
+
+		lastModified, err := http.ParseTime(string(c.Response().Header.Peek(fiber.HeaderLastModified)))
+		hasLastModified := err == nil
+
+		path := strings.TrimPrefix(c.Path(), "/api/"+string(versioning.FromContext(c)))
+		policy, ok := matchPolicy(sorted, path)
+		if ok {
+			c.Set(fiber.HeaderCacheControl, cacheControl(policy, c.Query("refresh") == "true", lastModified, hasLastModified))
+			if !policy.NoStore {
+				c.Vary(varyHeaders...)
+			}
+		}
+
+		if hasLastModified && notModified(c.Get(fiber.HeaderIfModifiedSince), lastModified) {
+			c.Response().ResetBody()
+			c.Response().Header.Del(fiber.HeaderContentType)
+			c.Status(fiber.StatusNotModified)
+		}
+		return nil
+	}
+}
+
+func matchPolicy(policies []CachePolicy, path string) (CachePolicy, bool) {
+	path = strings.TrimSuffix(path, "/")
+	for _, p := range policies {
+		prefix := strings.TrimSuffix(p.Path, "/")
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return p, true
+		}
+	}
+	return CachePolicy{}, false
+}
+
+func cacheControl(p CachePolicy, refresh bool, lastModified time.Time, hasLastModified bool) string {
+	switch {
+	case p.NoStore:
+		return "no-store"
+	case refresh:
+		return "no-cache"
+	case p.ImmutableAfter > 0 && hasLastModified && time.Since(lastModified) > p.ImmutableAfter:
+		return fmt.Sprintf("public, max-age=%d, immutable", int64(immutableMaxAge.Seconds()))
+	case p.MaxAge > 0:
+		return fmt.Sprintf("public, max-age=%d", int64(p.MaxAge.Seconds()))
+	default:
+		return "no-cache"
+	}
+}
+
+// notModified returns true when the If-Modified-Since header is not older than the last modification.
+func notModified(ifModifiedSince string, lastModified time.Time) bool {
+	if ifModifiedSince == "" {
+		return false
+	}
+	t, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(t)
+}
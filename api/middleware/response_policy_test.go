@@ -0,0 +1,93 @@
+package middleware_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+)
+
+func Test_ResponsePolicy(t *testing.T) {
+
+	oldVaa := time.Now().Add(-48 * time.Hour).UTC().Truncate(time.Second)
+	newVaa := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+
+	policies := []middleware.CachePolicy{
+		{Path: "/vaas", MaxAge: 5 * time.Second, ImmutableAfter: 24 * time.Hour},
+		{Path: "/vaas/vaa-counts", MaxAge: time.Minute},
+		{Path: "/health", NoStore: true},
+	}
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	for _, api := range versioning.Groups(app, "/api", versioning.Supported...) {
+		api.Use(middleware.ResponsePolicy(app, policies))
+		api.Get("/health", func(c *fiber.Ctx) error { return c.SendString("ok") })
+		api.Get("/vaas/vaa-counts", func(c *fiber.Ctx) error { return c.SendString("counts") })
+		api.Get("/vaas/old", func(c *fiber.Ctx) error {
+			middleware.SetLastModified(c, oldVaa)
+			return c.SendString("old")
+		})
+		api.Get("/vaas/new", func(c *fiber.Ctx) error {
+			middleware.SetLastModified(c, newVaa)
+			return c.SendString("new")
+		})
+		api.Get("/vaas/missing", func(c *fiber.Ctx) error { return c.SendStatus(http.StatusNotFound) })
+		api.Post("/vaas/parse", func(c *fiber.Ctx) error { return c.SendString("parsed") })
+		api.Get("/other", func(c *fiber.Ctx) error { return c.SendString("other") })
+	}
+
+	testCases := []struct {
+		name                 string
+		method               string
+		path                 string
+		ifModifiedSince      time.Time
+		expectedStatus       int
+		expectedCacheControl string
+		expectedAllow        string
+	}{
+		{name: "no store", method: http.MethodGet, path: "/api/v1/health", expectedStatus: http.StatusOK, expectedCacheControl: "no-store"},
+		{name: "longest path", method: http.MethodGet, path: "/api/v2/vaas/vaa-counts", expectedStatus: http.StatusOK, expectedCacheControl: "public, max-age=60"},
+		{name: "immutable", method: http.MethodGet, path: "/api/v1/vaas/old", expectedStatus: http.StatusOK, expectedCacheControl: "public, max-age=31536000, immutable"},
+		{name: "recent", method: http.MethodGet, path: "/api/v1/vaas/new", expectedStatus: http.StatusOK, expectedCacheControl: "public, max-age=5"},
+		{name: "head", method: http.MethodHead, path: "/api/v1/vaas/new", expectedStatus: http.StatusOK, expectedCacheControl: "public, max-age=5"},
+		{name: "refresh", method: http.MethodGet, path: "/api/v1/vaas/new?refresh=true", expectedStatus: http.StatusOK, expectedCacheControl: "no-cache"},
+		{name: "not modified", method: http.MethodGet, path: "/api/v1/vaas/old", ifModifiedSince: oldVaa, expectedStatus: http.StatusNotModified, expectedCacheControl: "public, max-age=31536000, immutable"},
+		{name: "modified", method: http.MethodGet, path: "/api/v1/vaas/new", ifModifiedSince: oldVaa, expectedStatus: http.StatusOK, expectedCacheControl: "public, max-age=5"},
+		{name: "error", method: http.MethodGet, path: "/api/v1/vaas/missing", expectedStatus: http.StatusNotFound},
+		{name: "post", method: http.MethodPost, path: "/api/v1/vaas/parse", expectedStatus: http.StatusOK},
+		{name: "no policy", method: http.MethodGet, path: "/api/v1/other", expectedStatus: http.StatusOK},
+		{name: "options", method: http.MethodOptions, path: "/api/v1/vaas/parse", expectedStatus: http.StatusNoContent, expectedAllow: "POST, OPTIONS"},
+		{name: "options get", method: http.MethodOptions, path: "/api/v2/vaas/old", expectedStatus: http.StatusNoContent, expectedAllow: "GET, HEAD, OPTIONS"},
+		{name: "options unknown", method: http.MethodOptions, path: "/api/v1/unknown", expectedStatus: http.StatusNotFound},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(testCase.method, testCase.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !testCase.ifModifiedSince.IsZero() {
+				req.Header.Set(fiber.HeaderIfModifiedSince, testCase.ifModifiedSince.Format(http.TimeFormat))
+			}
+			resp, err := app.Test(req, 1000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != testCase.expectedStatus {
+				t.Errorf("expected status %d, got %d", testCase.expectedStatus, resp.StatusCode)
+			}
+			if cacheControl := resp.Header.Get(fiber.HeaderCacheControl); cacheControl != testCase.expectedCacheControl {
+				t.Errorf("expected Cache-Control %q, got %q", testCase.expectedCacheControl, cacheControl)
+			}
+			if allow := resp.Header.Get(fiber.HeaderAllow); allow != testCase.expectedAllow {
+				t.Errorf("expected Allow %q, got %q", testCase.expectedAllow, allow)
+			}
+		})
+	}
+}
@@ -146,6 +146,25 @@ func NewInvalidQueryParamError(ctx *fiber.Ctx, message string, err error) APIErr
 	}
 }
 
+// NewQueryTooLargeError create a new APIError for queries that would scan too much data.
+func NewQueryTooLargeError(ctx *fiber.Ctx, message string, err error) APIError {
+	if message == "" {
+		message = "QUERY TOO LARGE"
+	}
+	detail := ErrorDetail{
+		RequestID: fmt.Sprintf("%v", ctx.Locals("requestid")),
+	}
+	if enableStackTrace && err != nil {
+		detail.StackTrace = fmt.Sprintf("%+v\n", err)
+	}
+	return APIError{
+		StatusCode: fiber.StatusRequestEntityTooLarge,
+		Code:       ResourceExhausted,
+		Message:    message,
+		Details:    []ErrorDetail{detail},
+	}
+}
+
 func NewRequestBodyError(ctx *fiber.Ctx, message string, err error) APIError {
 	if message == "" {
 		message = "INVALID BODY"
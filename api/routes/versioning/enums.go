@@ -0,0 +1,285 @@
+package versioning
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// enumNames contains the versions whose responses have the canonical names of the enums
+// instead of their numeric values. The numeric value is kept in a field with the same
+// name and the _id suffix, e.g. {"emitterChain": "solana", "emitterChain_id": 1}.
+// A name ending with Id loses the suffix, e.g. {"chain": "solana", "chain_id": 1}.
+var enumNames = map[Version]bool{V2: true}
+
+// chainFields are the fields of the responses that contain a chain id or a list of chain ids.
+var chainFields = map[string]bool{
+	"chainId":           true,
+	"chainID":           true,
+	"chains":            true,
+	"emitterChain":      true,
+	"emitter_chain":     true,
+	"tokenChain":        true,
+	"fromChain":         true,
+	"toChain":           true,
+	"sourceChain":       true,
+	"source_chain":      true,
+	"targetChain":       true,
+	"target_chain":      true,
+	"destinationChain":  true,
+	"destination_chain": true,
+	"originChainId":     true,
+	"feeChain":          true,
+	"newChainId":        true,
+	"recoverChainId":    true,
+}
+
+// payloadTypeField is the field of the payloads that contains the payload type,
+// which is mapped according to the app ids of the enclosing objects.
+const payloadTypeField = "payloadType"
+
+// appIDsFields are the fields of the objects that contain the app id of their payload,
+// directly or in their standardized properties.
+var (
+	appIDsFields     = []string{"appId", "appIds"}
+	standardizedKeys = []string{"standardizedProperties", "standarizedProperties"}
+)
+
+// nameEnums replaces the numeric enums of the body by their canonical names,
+// keeping the order of the fields of the body.
+func nameEnums(body any) (any, error) {
+	value, err := toOrdered(body)
+	if err != nil {
+		return nil, err
+	}
+	return nameEnumsIn(value, nil), nil
+}
+
+// toOrdered converts the body to its JSON representation, with the objects decoded as [object]
+// and the numbers as [json.Number].
+func toOrdered(body any) (any, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	value, err := decodeOrdered(dec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response body: %w", err)
+	}
+	return value, nil
+}
+
+func nameEnumsIn(value any, appIDs []string) any {
+	switch v := value.(type) {
+	case []any:
+		for i := range v {
+			v[i] = nameEnumsIn(v[i], appIDs)
+		}
+	case *object:
+		if ids := v.appIDs(); len(ids) > 0 {
+			appIDs = ids
+		}
+		for _, key := range append([]string(nil), v.keys...) {
+			field := v.values[key]
+			if chainFields[key] {
+				if name, ok := chainNames(field); ok {
+					v.rename(key, name)
+					continue
+				}
+			}
+			if key == payloadTypeField {
+				if name, ok := payloadTypeName(field, appIDs); ok {
+					v.rename(key, name)
+					continue
+				}
+			}
+			v.values[key] = nameEnumsIn(field, appIDs)
+		}
+	}
+	return value
+}
+
+// chainNames returns the name of a chain id or the names of a list of chain ids.
+func chainNames(value any) (any, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		id, ok := enumValue(v, math.MaxUint16)
+		if !ok {
+			return nil, false
+		}
+		return domain.ChainName(sdk.ChainID(id))
+	case []any:
+		names := make([]any, 0, len(v))
+		for _, item := range v {
+			name, ok := chainNames(item)
+			if !ok {
+				return nil, false
+			}
+			names = append(names, name)
+		}
+		return names, true
+	}
+	return nil, false
+}
+
+func payloadTypeName(value any, appIDs []string) (string, bool) {
+	n, ok := value.(json.Number)
+	if !ok {
+		return "", false
+	}
+	payloadType, ok := enumValue(n, math.MaxUint8)
+	if !ok {
+		return "", false
+	}
+	for _, appID := range appIDs {
+		if name, ok := domain.PayloadTypeName(appID, uint8(payloadType)); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func enumValue(n json.Number, max int64) (int64, bool) {
+	v, err := n.Int64()
+	if err != nil || v < 0 || v > max {
+		return 0, false
+	}
+	return v, true
+}
+
+// object is a JSON object that keeps the order of its fields.
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+// appIDs returns the app ids of the object or of its standardized properties.
+func (o *object) appIDs() []string {
+	candidates := []*object{o}
+	for _, key := range standardizedKeys {
+		if props, ok := o.values[key].(*object); ok {
+			candidates = append(candidates, props)
+		}
+	}
+	var ids []string
+	for _, c := range candidates {
+		for _, key := range appIDsFields {
+			switch v := c.values[key].(type) {
+			case string:
+				ids = append(ids, v)
+			case []any:
+				for _, item := range v {
+					if id, ok := item.(string); ok {
+						ids = append(ids, id)
+					}
+				}
+			}
+		}
+	}
+	return ids
+}
+
+// rename replaces the numeric value of the field by its name, and adds the numeric value
+// in the _id field next to it. The field is left unchanged if the new fields already exist.
+func (o *object) rename(key string, name any) {
+	base := strings.TrimSuffix(strings.TrimSuffix(key, "Id"), "ID")
+	idKey := base + "_id"
+	if _, exists := o.values[idKey]; exists {
+		return
+	}
+	if _, exists := o.values[base]; exists && base != key {
+		return
+	}
+	id := o.values[key]
+	keys := make([]string, 0, len(o.keys)+1)
+	for _, k := range o.keys {
+		if k != key {
+			keys = append(keys, k)
+			continue
+		}
+		keys = append(keys, base, idKey)
+	}
+	delete(o.values, key)
+	o.keys = keys
+	o.values[base] = name
+	o.values[idKey] = id
+}
+
+// MarshalJSON encodes the object with its fields in order.
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeOrdered decodes the next JSON value, the objects are decoded as [object].
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+	switch delim {
+	case '{':
+		obj := &object{values: map[string]any{}}
+		for dec.More() {
+			token, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := token.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object key %v", token)
+			}
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := obj.values[key]; !exists {
+				obj.keys = append(obj.keys, key)
+			}
+			obj.values[key] = value
+		}
+		_, err = dec.Token()
+		return obj, err
+	case '[':
+		items := []any{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		}
+		_, err = dec.Token()
+		return items, err
+	}
+	return nil, fmt.Errorf("unexpected delimiter %v", delim)
+}
@@ -0,0 +1,78 @@
+package versioning_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+)
+
+type transferResponse struct {
+	EmitterChain int            `json:"emitterChain"`
+	AppID        string         `json:"appId"`
+	Payload      map[string]any `json:"payload"`
+	Target       targetResponse `json:"target"`
+	Chains       []int          `json:"chains"`
+}
+
+type targetResponse struct {
+	ChainID int `json:"chainId"`
+}
+
+func Test_EnumNames(t *testing.T) {
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	for _, api := range versioning.Groups(app, "/api", versioning.Supported...) {
+		api.Get("/transfer", func(c *fiber.Ctx) error {
+			return versioning.JSON(c, transferResponse{
+				EmitterChain: 1,
+				AppID:        "PORTAL_TOKEN_BRIDGE",
+				Payload:      map[string]any{"payloadType": 3},
+				Target:       targetResponse{ChainID: 2},
+				Chains:       []int{1, 65000},
+			})
+		})
+	}
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedResponse string
+	}{
+		{
+			name:             "Test_EnumNames_V1",
+			path:             "/api/v1/transfer",
+			expectedResponse: `{"emitterChain":1,"appId":"PORTAL_TOKEN_BRIDGE","payload":{"payloadType":3},"target":{"chainId":2},"chains":[1,65000]}`,
+		},
+		{
+			name:             "Test_EnumNames_V2",
+			path:             "/api/v2/transfer",
+			expectedResponse: `{"emitterChain":"solana","emitterChain_id":1,"appId":"PORTAL_TOKEN_BRIDGE","payload":{"payloadType":"transferWithPayload","payloadType_id":3},"target":{"chain":"ethereum","chain_id":2},"chains":[1,65000]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, testCase.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := app.Test(req, 1000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			respBytes, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(respBytes) != testCase.expectedResponse {
+				t.Fatalf("expected response %s, got %s", testCase.expectedResponse, string(respBytes))
+			}
+		})
+	}
+}
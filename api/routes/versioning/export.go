@@ -0,0 +1,252 @@
+package versioning
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"go.uber.org/zap"
+)
+
+// ExportFormat is the format of the exports of the list endpoints.
+type ExportFormat string
+
+const (
+	ExportCSV    ExportFormat = "csv"
+	ExportNDJSON ExportFormat = "ndjson"
+)
+
+// MaxExportRows is the maximum number of items written by an export.
+const MaxExportRows = 1_000_000
+
+var exportContentTypes = map[ExportFormat]string{
+	ExportCSV:    "text/csv; charset=utf-8",
+	ExportNDJSON: "application/x-ndjson",
+}
+
+// ExportFormatFromQuery returns the export format requested in the format query parameter.
+// It returns false when the request is not an export, the other values of the parameter
+// are left to the handler.
+func ExportFormatFromQuery(ctx *fiber.Ctx) (ExportFormat, bool) {
+	format := ExportFormat(strings.ToLower(ctx.Query("format")))
+	_, ok := exportContentTypes[format]
+	return format, ok
+}
+
+// ExportOnly returns a handler that runs [handler] for the export requests, the other requests
+// continue to the next handler. It registers the low priority handler on the list endpoints
+// that are only heavy when they are exported.
+func ExportOnly(handler fiber.Handler) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if _, ok := ExportFormatFromQuery(ctx); ok {
+			return handler(ctx)
+		}
+		return ctx.Next()
+	}
+}
+
+// ExportTimeout is the maximum duration of an export.
+const ExportTimeout = 10 * time.Minute
+
+// PageFunc returns the items of the page [p], a page shorter than p.Limit is the last one.
+type PageFunc[T any] func(ctx context.Context, p *pagination.Pagination) ([]T, error)
+
+// CursorFunc returns the position of an item, the next page of an export is read after the
+// last item of the previous page. It returns nil when the items are not paged, the export
+// then ends after the first page.
+type CursorFunc[T any] func(item T) *pagination.Cursor
+
+// Export streams the items of the pages returned by [next] in the given format, starting at
+// the page [p] and reading pages of p.Limit items until the last page or MaxExportRows items.
+// Each page after the first one is read after the cursor of the last item of the previous
+// page, so the export does not skip or repeat items when new items are inserted.
+//
+// The first page is read before the response starts, so its errors are returned to the
+// error handler. The response is already sent when the next pages are read, so their errors
+// are logged and end the export. The request context is recycled once the handler returns, so
+// the next pages are read with a detached context that ends after ExportTimeout.
+//
+// The items are rendered as in JSON for the version of the request, except for the mappers of
+// the response types. The CSV columns are the flattened fields of the items of the first page,
+// the nested fields are named with their path (e.g. payload.amount) and the arrays are written
+// as JSON.
+func Export[T any](ctx *fiber.Ctx, format ExportFormat, p *pagination.Pagination, next PageFunc[T], cursor CursorFunc[T], logger *zap.Logger) error {
+	version := FromContext(ctx)
+	items, err := next(ctx.Context(), p)
+	if err != nil {
+		return err
+	}
+	rows, err := exportRows(version, items)
+	if err != nil {
+		return err
+	}
+
+	var w exportWriter
+	switch format {
+	case ExportCSV:
+		w = newCSVWriter(rows)
+	case ExportNDJSON:
+		w = &ndjsonWriter{}
+	default:
+		return fmt.Errorf("unsupported export format %s", format)
+	}
+
+	logger = logger.With(zap.String("path", strings.Clone(ctx.Path())),
+		zap.String("requestID", fmt.Sprintf("%v", ctx.Locals("requestid"))))
+	exportCtx, cancel := context.WithTimeout(context.Background(), ExportTimeout)
+	page := *p
+	ctx.Set(fiber.HeaderContentType, exportContentTypes[format])
+	ctx.Context().SetBodyStreamWriter(func(bw *bufio.Writer) {
+		defer cancel()
+		written := 0
+		for {
+			for _, row := range rows {
+				if written == MaxExportRows {
+					break
+				}
+				if err := w.write(bw, row); err != nil {
+					logger.Error("error writing export row", zap.Error(err))
+					return
+				}
+				written++
+			}
+			// the client is gone when the rows cannot be flushed.
+			if err := bw.Flush(); err != nil {
+				return
+			}
+			if len(items) == 0 || int64(len(items)) < page.Limit || written == MaxExportRows {
+				return
+			}
+
+			after := cursor(items[len(items)-1])
+			if after == nil {
+				return
+			}
+			page.Skip = 0
+			page.After = after
+			items, err = next(exportCtx, &page)
+			if err == nil {
+				rows, err = exportRows(version, items)
+			}
+			if err != nil {
+				logger.Error("error reading export page", zap.Error(err),
+					zap.Time("afterTimestamp", after.Timestamp), zap.String("afterId", after.ID))
+				return
+			}
+		}
+	})
+	return nil
+}
+
+// exportRows converts the items to their ordered JSON representation in the given version.
+func exportRows[T any](version Version, items []T) ([]any, error) {
+	rows := make([]any, 0, len(items))
+	for _, item := range items {
+		row, err := toOrdered(item)
+		if err != nil {
+			return nil, err
+		}
+		if enumNames[version] {
+			row = nameEnumsIn(row, nil)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+type exportWriter interface {
+	write(w *bufio.Writer, row any) error
+}
+
+// ndjsonWriter writes a JSON document per line.
+type ndjsonWriter struct{}
+
+func (*ndjsonWriter) write(w *bufio.Writer, row any) error {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	w.Write(data)
+	return w.WriteByte('\n')
+}
+
+// csvWriter writes the header with the columns of the first rows and then a record per row.
+type csvWriter struct {
+	columns       []string
+	headerWritten bool
+}
+
+func newCSVWriter(rows []any) *csvWriter {
+	seen := map[string]bool{}
+	var columns []string
+	for _, row := range rows {
+		for _, field := range flatten("", row, nil) {
+			if !seen[field.name] {
+				seen[field.name] = true
+				columns = append(columns, field.name)
+			}
+		}
+	}
+	return &csvWriter{columns: columns}
+}
+
+func (c *csvWriter) write(w *bufio.Writer, row any) error {
+	cw := csv.NewWriter(w)
+	if !c.headerWritten {
+		if err := cw.Write(c.columns); err != nil {
+			return err
+		}
+		c.headerWritten = true
+	}
+	values := map[string]string{}
+	for _, field := range flatten("", row, nil) {
+		values[field.name] = field.value
+	}
+	record := make([]string, len(c.columns))
+	for i, column := range c.columns {
+		record[i] = values[column]
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+type flatField struct {
+	name  string
+	value string
+}
+
+// flatten appends the leaf fields of the value to [fields], the names of the nested fields
+// are joined with dots and the arrays are encoded as JSON.
+func flatten(name string, value any, fields []flatField) []flatField {
+	switch v := value.(type) {
+	case *object:
+		for _, key := range v.keys {
+			fieldName := key
+			if name != "" {
+				fieldName = name + "." + key
+			}
+			fields = flatten(fieldName, v.values[key], fields)
+		}
+		return fields
+	case nil:
+		return append(fields, flatField{name: name})
+	case string:
+		return append(fields, flatField{name: name, value: v})
+	case json.Number:
+		return append(fields, flatField{name: name, value: v.String()})
+	case []any:
+		data, _ := json.Marshal(v)
+		return append(fields, flatField{name: name, value: string(data)})
+	default:
+		return append(fields, flatField{name: name, value: fmt.Sprint(v)})
+	}
+}
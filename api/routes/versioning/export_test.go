@@ -0,0 +1,169 @@
+package versioning_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+type exportItem struct {
+	ID           string         `json:"id"`
+	EmitterChain int            `json:"emitterChain"`
+	Payload      map[string]any `json:"payload,omitempty"`
+	Tags         []string       `json:"tags"`
+}
+
+func Test_Export(t *testing.T) {
+
+	items := []exportItem{
+		{ID: "a", EmitterChain: 1, Tags: []string{"x"}},
+		{ID: "b", EmitterChain: 2, Payload: map[string]any{"amount": "10"}},
+		{ID: "c,d", EmitterChain: 1},
+	}
+	// the next pages are read after the cursor of the last item of the previous page.
+	next := func(ctx context.Context, p *pagination.Pagination) ([]exportItem, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		start := p.Skip
+		if p.After != nil {
+			for i, item := range items {
+				if item.ID == p.After.ID {
+					start = int64(i) + 1
+				}
+			}
+		}
+		if start >= int64(len(items)) {
+			return nil, nil
+		}
+		return items[start:min(start+p.Limit, int64(len(items)))], nil
+	}
+	cursor := func(item exportItem) *pagination.Cursor {
+		return &pagination.Cursor{ID: item.ID}
+	}
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	for _, api := range versioning.Groups(app, "/api", versioning.Supported...) {
+		api.Get("/items", func(c *fiber.Ctx) error {
+			format, ok := versioning.ExportFormatFromQuery(c)
+			if !ok {
+				return versioning.JSON(c, items)
+			}
+			p := pagination.Default().SetLimit(2)
+			return versioning.Export(c, format, p, next, cursor, zap.NewNop())
+		})
+		api.Get("/unpaged", func(c *fiber.Ctx) error {
+			// the items are not paged, the export ends after the first page.
+			unpaged := func(exportItem) *pagination.Cursor { return nil }
+			p := pagination.Default().SetLimit(2)
+			return versioning.Export(c, versioning.ExportNDJSON, p, next, unpaged, zap.NewNop())
+		})
+		api.Get("/failed", func(c *fiber.Ctx) error {
+			next := func(context.Context, *pagination.Pagination) ([]exportItem, error) {
+				return nil, errors.New("failed")
+			}
+			return versioning.Export(c, versioning.ExportNDJSON, pagination.Default(), next, cursor, zap.NewNop())
+		})
+	}
+
+	testCases := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		expectedContentType string
+		expectedResponse    string
+	}{
+		{
+			name:                "Test_Export_NDJSON",
+			path:                "/api/v1/items?format=ndjson",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/x-ndjson",
+			expectedResponse: `{"id":"a","emitterChain":1,"tags":["x"]}
+{"id":"b","emitterChain":2,"payload":{"amount":"10"},"tags":null}
+{"id":"c,d","emitterChain":1,"tags":null}
+`,
+		},
+		{
+			name:                "Test_Export_CSV",
+			path:                "/api/v1/items?format=csv",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedResponse: `id,emitterChain,tags,payload.amount
+a,1,"[""x""]",
+b,2,,10
+"c,d",1,,
+`,
+		},
+		{
+			name:                "Test_Export_CSV_V2",
+			path:                "/api/v2/items?format=CSV",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedResponse: `id,emitterChain,emitterChain_id,tags,payload.amount
+a,solana,1,"[""x""]",
+b,ethereum,2,,10
+"c,d",solana,1,,
+`,
+		},
+		{
+			name:                "Test_Export_JSON",
+			path:                "/api/v1/items",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json",
+			expectedResponse:    `[{"id":"a","emitterChain":1,"tags":["x"]},{"id":"b","emitterChain":2,"payload":{"amount":"10"},"tags":null},{"id":"c,d","emitterChain":1,"tags":null}]`,
+		},
+		{
+			name:                "Test_Export_Unpaged",
+			path:                "/api/v1/unpaged",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/x-ndjson",
+			expectedResponse: `{"id":"a","emitterChain":1,"tags":["x"]}
+{"id":"b","emitterChain":2,"payload":{"amount":"10"},"tags":null}
+`,
+		},
+		{
+			name:                "Test_Export_FirstPageError",
+			path:                "/api/v1/failed",
+			expectedStatus:      http.StatusInternalServerError,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedResponse:    "failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, testCase.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := app.Test(req, 1000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != testCase.expectedStatus {
+				t.Fatalf("expected status code %d, got %d", testCase.expectedStatus, resp.StatusCode)
+			}
+			if contentType := resp.Header.Get(fiber.HeaderContentType); contentType != testCase.expectedContentType {
+				t.Fatalf("expected content type %s, got %s", testCase.expectedContentType, contentType)
+			}
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != testCase.expectedResponse {
+				t.Fatalf("expected response %s, got %s", testCase.expectedResponse, string(body))
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Package versioning provides versioned route groups that share the same handlers.
+//
+// Every version is mounted under its own prefix (e.g. /api/v1, /api/v2) and the
+// handlers are registered once for all of them. When a response changes in a
+// breaking way, a mapper is registered for the new version that transforms the
+// response built by the handler, so the handler code does not need to be forked.
+package versioning
+
+import (
+	"reflect"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Version represents an API version.
+type Version string
+
+const (
+	V1 Version = "v1"
+	V2 Version = "v2"
+)
+
+// Supported contains all the versions exposed by the API, in order.
+var Supported = []Version{V1, V2}
+
+const localsKey = "apiVersion"
+
+// Mapper transforms the response built by a handler into the response of a specific version.
+type Mapper func(ctx *fiber.Ctx, body any) (any, error)
+
+var mappers = map[Version]map[reflect.Type]Mapper{}
+
+// RegisterMapper registers a mapper for the responses of type T in the given version.
+//
+// It must be called at startup, before the server starts handling requests.
+func RegisterMapper[T any](v Version, mapper func(ctx *fiber.Ctx, body T) (any, error)) {
+	if _, ok := mappers[v]; !ok {
+		mappers[v] = map[reflect.Type]Mapper{}
+	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	mappers[v][t] = func(ctx *fiber.Ctx, body any) (any, error) {
+		return mapper(ctx, body.(T))
+	}
+}
+
+// Groups creates a route group for each version under the given prefix.
+// The version of the group is stored in the request context, so handlers can
+// call JSON to render the response of the requested version.
+func Groups(router fiber.Router, prefix string, versions ...Version) []fiber.Router {
+	groups := make([]fiber.Router, 0, len(versions))
+	for _, v := range versions {
+		groups = append(groups, router.Group(prefix+"/"+string(v), middleware(v)))
+	}
+	return groups
+}
+
+func middleware(v Version) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		ctx.Locals(localsKey, v)
+		return ctx.Next()
+	}
+}
+
+// FromContext returns the API version of the request.
+// If the request was not routed through a versioned group, V1 is returned.
+func FromContext(ctx *fiber.Ctx) Version {
+	if v, ok := ctx.Locals(localsKey).(Version); ok {
+		return v
+	}
+	return V1
+}
+
+// JSON sends the body as a JSON response, applying the mapper registered for
+// the version of the request and the type of the body, if any.
+//
+// The numeric enums of the responses of the versions in [enumNames] are replaced by their names.
+func JSON(ctx *fiber.Ctx, body any) error {
+	if body == nil {
+		return ctx.JSON(body)
+	}
+	version := FromContext(ctx)
+	if mapper, ok := mappers[version][reflect.TypeOf(body)]; ok {
+		mapped, err := mapper(ctx, body)
+		if err != nil {
+			return err
+		}
+		body = mapped
+	}
+	if enumNames[version] {
+		named, err := nameEnums(body)
+		if err != nil {
+			return err
+		}
+		body = named
+	}
+	return ctx.JSON(body)
+}
@@ -0,0 +1,90 @@
+package versioning_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+)
+
+type itemsResponse struct {
+	Items []string `json:"items"`
+}
+
+type itemsResponseV2 struct {
+	Data  []string `json:"data"`
+	Count int      `json:"count"`
+}
+
+func Test_VersionedGroups(t *testing.T) {
+
+	versioning.RegisterMapper(versioning.V2, func(_ *fiber.Ctx, body itemsResponse) (any, error) {
+		return itemsResponseV2{Data: body.Items, Count: len(body.Items)}, nil
+	})
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	for _, api := range versioning.Groups(app, "/api", versioning.Supported...) {
+		api.Get("/items", func(c *fiber.Ctx) error {
+			return versioning.JSON(c, itemsResponse{Items: []string{"a", "b"}})
+		})
+		api.Get("/version", func(c *fiber.Ctx) error {
+			return c.SendString(string(versioning.FromContext(c)))
+		})
+	}
+
+	testCases := []struct {
+		name             string
+		path             string
+		expectedResponse string
+	}{
+		{
+			name:             "Test_VersionedGroups_V1",
+			path:             "/api/v1/items",
+			expectedResponse: `{"items":["a","b"]}`,
+		},
+		{
+			name:             "Test_VersionedGroups_V2",
+			path:             "/api/v2/items",
+			expectedResponse: `{"data":["a","b"],"count":2}`,
+		},
+		{
+			name:             "Test_VersionedGroups_V1Version",
+			path:             "/api/v1/version",
+			expectedResponse: "v1",
+		},
+		{
+			name:             "Test_VersionedGroups_V2Version",
+			path:             "/api/v2/version",
+			expectedResponse: "v2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, testCase.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := app.Test(req, 1000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+
+			respBytes, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(respBytes) != testCase.expectedResponse {
+				t.Fatalf("expected response %s, got %s", testCase.expectedResponse, string(respBytes))
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware" // required by swaggo
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	_ "github.com/wormhole-foundation/wormhole-explorer/api/response" // required by swaggo
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 	"go.uber.org/zap"
 )
 
@@ -59,5 +60,5 @@ func (c *Controller) FindById(ctx *fiber.Ctx) error {
 		return errors.ErrNotFound
 	}
 
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
 }
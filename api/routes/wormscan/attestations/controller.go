@@ -0,0 +1,102 @@
+package attestations
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/attestations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+// Controller definition.
+type Controller struct {
+	srv    *attestations.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *attestations.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "AttestationsController")),
+	}
+}
+
+// FindTokenAttestations godoc
+// @Description Returns the tokens attested by the token bridges, with the chain and the time of the attestation.
+// @Tags wormholescan
+// @ID find-token-attestations
+// @Param chain query integer false "id of the chain of the token"
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Param sortOrder query string false "Sort results by timestamp in ascending or descending order." Enums(ASC, DESC)
+// @Success 200 {object} []attestations.TokenAttestationDoc
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/token-attestations [get]
+func (c *Controller) FindTokenAttestations(ctx *fiber.Ctx) error {
+	p, err := c.extractPagination(ctx)
+	if err != nil {
+		return err
+	}
+	chain, err := middleware.ExtractChainQuery(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.srv.FindTokenAttestations(ctx.Context(), attestations.TokenAttestationQuery{TokenChain: chain}, p)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, result)
+}
+
+// FindTokenAttestationsByToken godoc
+// @Description Returns the attestations of a token, a token is attested again when its metadata changes.
+// @Tags wormholescan
+// @ID find-token-attestations-by-token
+// @Param chain_id path integer true "id of the chain of the token"
+// @Param token_address path string true "address of the token"
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Param sortOrder query string false "Sort results by timestamp in ascending or descending order." Enums(ASC, DESC)
+// @Success 200 {object} []attestations.TokenAttestationDoc
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/token-attestations/:chain_id/:token_address [get]
+func (c *Controller) FindTokenAttestationsByToken(ctx *fiber.Ctx) error {
+	p, err := c.extractPagination(ctx)
+	if err != nil {
+		return err
+	}
+	chain, err := middleware.ExtractChainID(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+	tokenAddress, err := middleware.ExtractTokenAddress(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	q := attestations.TokenAttestationQuery{TokenChain: &chain, TokenAddress: tokenAddress.Hex()}
+	result, err := c.srv.FindTokenAttestations(ctx.Context(), q, p)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, result)
+}
+
+func (c *Controller) extractPagination(ctx *fiber.Ctx) (*pagination.Pagination, error) {
+	p, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check pagination max limit
+	if p.Limit > 1000 {
+		return nil, response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+	}
+	return p, nil
+}
@@ -0,0 +1,89 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	commonAudit "github.com/wormhole-foundation/wormhole-explorer/common/audit"
+	"go.uber.org/zap"
+)
+
+// Controller definition.
+type Controller struct {
+	srv    *audit.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *audit.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "AuditController")),
+	}
+}
+
+// FindRecords godoc
+// @Description Returns the audit records of the actions executed with the admin endpoints
+// @Description of the services (who, what, when, parameters and result).
+// @Description Requires the admin api key in the X-Api-Key header.
+// @Tags wormholescan
+// @ID find-audit-records
+// @Param service query string false "service that executed the action (e.g. wormscan-parser)"
+// @Param action query string false "action name (e.g. payload-schema.register)"
+// @Param actor query string false "who executed the action"
+// @Param result query string false "result of the action" Enums(success, failure)
+// @Param from query string false "from date, ISO 8601 format"
+// @Param to query string false "to date, ISO 8601 format"
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Param sortOrder query string false "Sort results by timestamp in ascending or descending order." Enums(ASC, DESC)
+// @Success 200 {object} []audit.Record
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /api/v1/audit [get]
+func (c *Controller) FindRecords(ctx *fiber.Ctx) error {
+
+	p, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check pagination max limit
+	if p.Limit > 1000 {
+		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+	}
+
+	result := ctx.Query("result")
+	if result != "" && result != commonAudit.ResultSuccess && result != commonAudit.ResultFailure {
+		return response.NewInvalidQueryParamError(ctx, "INVALID <result> QUERY PARAMETER", nil)
+	}
+
+	from, err := middleware.ExtractTime(ctx, time.RFC3339, "from")
+	if err != nil {
+		return err
+	}
+	to, err := middleware.ExtractTime(ctx, time.RFC3339, "to")
+	if err != nil {
+		return err
+	}
+
+	q := &audit.Query{
+		Service: ctx.Query("service"),
+		Action:  ctx.Query("action"),
+		Actor:   ctx.Query("actor"),
+		Result:  result,
+		From:    from,
+		To:      to,
+	}
+
+	records, err := c.srv.FindRecords(ctx.Context(), q, p)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, records)
+}
@@ -0,0 +1,99 @@
+package wormscan
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+)
+
+// RouteInfo describes a route of the api.
+type RouteInfo struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Params contains the names of the path params.
+	Params []string `json:"params,omitempty"`
+	// CacheTTL is the expiration in seconds of the responses in the http cache.
+	CacheTTL  int64 `json:"cacheTTL,omitempty"`
+	AdminOnly bool  `json:"adminOnly,omitempty"`
+}
+
+// routeMetadata contains the properties of the routes that can not be read from the fiber app.
+type routeMetadata struct {
+	method    string
+	path      string
+	cacheTTL  time.Duration
+	adminOnly bool
+}
+
+// routeCatalog lists the routes registered in the fiber app, so the ops tooling and the
+// sdk generators can stay in sync with the routes actually served.
+type routeCatalog struct {
+	app      *fiber.App
+	metadata []routeMetadata
+}
+
+func newRouteCatalog(app *fiber.App) *routeCatalog {
+	return &routeCatalog{app: app}
+}
+
+// describe sets the metadata of the routes of the method under the path, relative to the version group.
+// An empty method matches all the methods.
+func (c *routeCatalog) describe(method, path string, meta routeMetadata) {
+	meta.method = method
+	meta.path = strings.TrimSuffix(path, "/")
+	c.metadata = append(c.metadata, meta)
+}
+
+// routes returns the routes registered under the prefix, sorted by path and method.
+func (c *routeCatalog) routes(prefix string) []RouteInfo {
+	routes := []RouteInfo{}
+	seen := map[string]bool{}
+	for _, r := range c.app.GetRoutes(true) {
+		// the HEAD routes are registered by fiber for every GET route.
+		if r.Method == fiber.MethodHead || !strings.HasPrefix(r.Path, prefix+"/") {
+			continue
+		}
+		key := r.Method + " " + r.Path
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		route := RouteInfo{Method: r.Method, Path: r.Path, Params: r.Params}
+		relative := strings.TrimPrefix(r.Path, prefix)
+		for _, meta := range c.metadata {
+			if meta.method != "" && meta.method != r.Method {
+				continue
+			}
+			if relative != meta.path && !strings.HasPrefix(relative, meta.path+"/") {
+				continue
+			}
+			if meta.cacheTTL > 0 {
+				route.CacheTTL = int64(meta.cacheTTL.Seconds())
+			}
+			route.AdminOnly = route.AdminOnly || meta.adminOnly
+		}
+		routes = append(routes, route)
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+// List godoc
+// @Description Returns the routes of the API version, with their path params and the expiration of their http cache.
+// @Tags wormholescan
+// @ID get-routes
+// @Success 200 {object} []RouteInfo
+// @Router /api/v1/routes [get]
+func (c *routeCatalog) List(ctx *fiber.Ctx) error {
+	prefix := "/api/" + string(versioning.FromContext(ctx))
+	return versioning.JSON(ctx, c.routes(prefix))
+}
@@ -0,0 +1,43 @@
+package wormscan
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+)
+
+func TestRouteCatalog(t *testing.T) {
+	app := fiber.New()
+	catalog := newRouteCatalog(app)
+	catalog.describe(fiber.MethodGet, "/vaas", routeMetadata{cacheTTL: 5 * time.Second})
+	catalog.describe(fiber.MethodPut, "/address-labels", routeMetadata{adminOnly: true})
+
+	ok := func(c *fiber.Ctx) error { return nil }
+	for _, api := range versioning.Groups(app, "/api", versioning.Supported...) {
+		api.Get("/routes", catalog.List)
+		vaas := api.Group("/vaas")
+		vaas.Get("/:chain/:emitter", ok)
+		vaas.Post("/parse", ok)
+		api.Get("/address-labels", ok)
+		api.Put("/address-labels/:address", ok)
+	}
+
+	resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/api/v2/routes", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var routes []RouteInfo
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&routes))
+	assert.Equal(t, []RouteInfo{
+		{Method: fiber.MethodGet, Path: "/api/v2/address-labels"},
+		{Method: fiber.MethodPut, Path: "/api/v2/address-labels/:address", Params: []string{"address"}, AdminOnly: true},
+		{Method: fiber.MethodGet, Path: "/api/v2/routes"},
+		{Method: fiber.MethodGet, Path: "/api/v2/vaas/:chain/:emitter", Params: []string{"chain", "emitter"}, CacheTTL: 5},
+		{Method: fiber.MethodPost, Path: "/api/v2/vaas/parse"},
+	}, routes)
+}
@@ -0,0 +1,56 @@
+package chaindata
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/chaindata"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+// Controller definition.
+type Controller struct {
+	srv    *chaindata.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *chaindata.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "ChainDataController")),
+	}
+}
+
+// Wipe godoc
+// @Description Deletes all the data of a chain, e.g. after the chain was redeployed in the testnet.
+// @Description Returns the number of documents deleted by collection. Only allowed when the data wipe is enabled.
+// @Description Requires the admin api key in the X-Api-Key header.
+// @Tags wormholescan
+// @ID wipe-chain-data
+// @Param chain_id path integer true "id of the blockchain"
+// @Success 200 {object} map[string]int64
+// @Failure 400
+// @Failure 401
+// @Failure 403
+// @Failure 500
+// @Router /api/v1/chains/:chain_id/data [delete]
+func (c *Controller) Wipe(ctx *fiber.Ctx) error {
+	chainID, err := middleware.ExtractChainID(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := c.srv.Wipe(ctx.Context(), chainID)
+	if errors.Is(err, chaindata.ErrWipeDisabled) {
+		return response.NewApiError(ctx, fiber.StatusForbidden, response.PermissionDenied,
+			"wiping chain data is not allowed in this environment", err)
+	}
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, deleted)
+}
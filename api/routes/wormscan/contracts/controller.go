@@ -0,0 +1,41 @@
+package contracts
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/contracts"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+// Controller definition.
+type Controller struct {
+	srv    *contracts.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *contracts.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "ContractsController")),
+	}
+}
+
+// FindContracts godoc
+// @Description Returns the addresses of the core, token bridge and NFT bridge contracts deployed in each chain,
+// @Description encoded in the native format of the chain. The addresses depend on the network of the API (mainnet or testnet).
+// @Tags wormholescan
+// @ID find-contracts
+// @Param chain query integer false "chain id"
+// @Success 200 {object} []contracts.ChainContracts
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/contracts [get]
+func (c *Controller) FindContracts(ctx *fiber.Ctx) error {
+	chainID, err := middleware.ExtractChainQuery(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, c.srv.FindContracts(chainID))
+}
@@ -0,0 +1,118 @@
+package denylist
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/denylist"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+// maxReasonLength is the maximum length of the reason to deny an emitter.
+const maxReasonLength = 200
+
+// Controller definition.
+type Controller struct {
+	srv    *denylist.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *denylist.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "DenyListController")),
+	}
+}
+
+// UpsertDeniedEmitterRequest is the request body of `PUT /api/v1/emitter-deny-list/:chain/:emitter`.
+type UpsertDeniedEmitterRequest struct {
+	Reason string `json:"reason"`
+}
+
+// FindAll godoc
+// @Description Returns the denied emitters, whose messages are excluded from the vaa, operation and transaction listings.
+// @Tags wormholescan
+// @ID find-emitter-deny-list
+// @Success 200 {object} []repository.DeniedEmitter
+// @Failure 500
+// @Router /api/v1/emitter-deny-list [get]
+func (c *Controller) FindAll(ctx *fiber.Ctx) error {
+	result, err := c.srv.FindAll(ctx.Context())
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, result)
+}
+
+// Upsert godoc
+// @Description Adds an emitter to the deny-list or replaces the reason it is denied.
+// @Description Requires the admin api key in the X-Api-Key header.
+// @Tags wormholescan
+// @ID upsert-denied-emitter
+// @Param chain_id path integer true "id of the blockchain"
+// @Param emitter path string true "address of the emitter"
+// @Param request body UpsertDeniedEmitterRequest true "reason"
+// @Success 200 {object} repository.DeniedEmitter
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /api/v1/emitter-deny-list/:chain_id/:emitter [put]
+func (c *Controller) Upsert(ctx *fiber.Ctx) error {
+	chainID, err := middleware.ExtractChainID(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+	emitter, err := middleware.ExtractEmitterAddr(ctx, c.logger, &chainID)
+	if err != nil {
+		return err
+	}
+
+	var body UpsertDeniedEmitterRequest
+	if err := ctx.BodyParser(&body); err != nil {
+		return response.NewRequestBodyError(ctx, "invalid deny-list request, unable to parse", errors.WithStack(err))
+	}
+	body.Reason = strings.TrimSpace(body.Reason)
+	if body.Reason == "" || len(body.Reason) > maxReasonLength {
+		return response.NewRequestBodyError(ctx, "invalid deny-list request, reason must have between 1 and 200 characters", nil)
+	}
+
+	deniedEmitter, err := c.srv.Upsert(ctx.Context(), chainID, emitter.Hex(), body.Reason)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, deniedEmitter)
+}
+
+// Delete godoc
+// @Description Removes an emitter from the deny-list.
+// @Description Requires the admin api key in the X-Api-Key header.
+// @Tags wormholescan
+// @ID delete-denied-emitter
+// @Param chain_id path integer true "id of the blockchain"
+// @Param emitter path string true "address of the emitter"
+// @Success 204
+// @Failure 400
+// @Failure 401
+// @Failure 404
+// @Failure 500
+// @Router /api/v1/emitter-deny-list/:chain_id/:emitter [delete]
+func (c *Controller) Delete(ctx *fiber.Ctx) error {
+	chainID, err := middleware.ExtractChainID(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+	emitter, err := middleware.ExtractEmitterAddr(ctx, c.logger, &chainID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.srv.Delete(ctx.Context(), chainID, emitter.Hex()); err != nil {
+		return err
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
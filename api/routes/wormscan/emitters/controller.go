@@ -0,0 +1,48 @@
+package emitters
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/emitters"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+// Controller definition.
+type Controller struct {
+	srv    *emitters.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *emitters.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "EmittersController")),
+	}
+}
+
+// FindRegisteredEmitters godoc
+// @Description Returns the official emitters of the token bridge, NFT bridge and relayer for each chain,
+// @Description registered by governance VAAs or by configuration.
+// @Tags wormholescan
+// @ID find-registered-emitters
+// @Param module query string false "governance module (TokenBridge, NFTBridge, WormholeRelayer)"
+// @Param chain query integer false "emitter chain"
+// @Success 200 {object} []emitters.RegisteredEmitter
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/emitters [get]
+func (c *Controller) FindRegisteredEmitters(ctx *fiber.Ctx) error {
+
+	chain, err := middleware.ExtractChainQuery(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.srv.FindRegisteredEmitters(ctx.Context(), ctx.Query("module"), chain)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, result)
+}
@@ -0,0 +1,56 @@
+package governance
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governance"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+// Controller definition.
+type Controller struct {
+	srv    *governance.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *governance.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "GovernanceController")),
+	}
+}
+
+// FindGovernanceActions godoc
+// @Description Returns the governance actions decoded from the governance VAAs
+// @Description (contract upgrades, guardian set upgrades, chain registrations, etc).
+// @Tags wormholescan
+// @ID find-governance-actions
+// @Param module query string false "governance module (Core, TokenBridge, NFTBridge, WormholeRelayer)"
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Param sortOrder query string false "Sort results by timestamp in ascending or descending order." Enums(ASC, DESC)
+// @Success 200 {object} []governance.GovernanceVaaDoc
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/governance/actions [get]
+func (c *Controller) FindGovernanceActions(ctx *fiber.Ctx) error {
+
+	p, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check pagination max limit
+	if p.Limit > 1000 {
+		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+	}
+
+	actions, err := c.srv.FindGovernanceVaas(ctx.Context(), ctx.Query("module"), p)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, actions)
+}
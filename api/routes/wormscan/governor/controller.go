@@ -2,13 +2,18 @@
 package governor
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	_ "github.com/wormhole-foundation/wormhole-explorer/api/response" // needed by swaggo docs
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 	"go.uber.org/zap"
 )
 
@@ -24,9 +29,11 @@ func NewController(serv *governor.Service, logger *zap.Logger) *Controller {
 }
 
 // FindGovernorConfigurations godoc
-// @Description Returns governor configuration for all guardians.
+// @Description Returns governor configuration for all guardians, sorted by guardian address.
+// @Description The pagination.next field of the response is the next page number, empty on the last page.
 // @Tags wormholescan
 // @ID governor-config
+// @Param nodeName query string false "guardian node name"
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[governor.GovConfig]
@@ -45,12 +52,12 @@ func (c *Controller) FindGovernorConfigurations(ctx *fiber.Ctx) error {
 		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
 	}
 
-	governorConfigs, err := c.srv.FindGovernorConfig(ctx.Context(), p)
+	governorConfigs, err := c.srv.FindGovernorConfig(ctx.Context(), ctx.Query("nodeName"), p)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(governorConfigs)
+	return versioning.JSON(ctx, governorConfigs)
 }
 
 // FindGovernorConfigurationByGuardianAddress godoc
@@ -82,13 +89,15 @@ func (c *Controller) FindGovernorConfigurationByGuardianAddress(ctx *fiber.Ctx)
 
 	// populate the response struct and return
 	res := response.Response[*governor.GovConfig]{Data: govConfigs[0]}
-	return ctx.JSON(res)
+	return versioning.JSON(ctx, res)
 }
 
 // FindGovernorStatus godoc
-// @Description Returns the governor status for all guardians.
+// @Description Returns the governor status for all guardians, sorted by guardian address.
+// @Description The pagination.next field of the response is the next page number, empty on the last page.
 // @Tags wormholescan
 // @ID governor-status
+// @Param nodeName query string false "guardian node name"
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Success 200 {object} response.Response[[]governor.GovStatus]
@@ -107,12 +116,41 @@ func (c *Controller) FindGovernorStatus(ctx *fiber.Ctx) error {
 		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
 	}
 
-	governorStatus, err := c.srv.FindGovernorStatus(ctx.Context(), p)
+	governorStatus, err := c.srv.FindGovernorStatus(ctx.Context(), ctx.Query("nodeName"), p)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(governorStatus)
+	return versioning.JSON(ctx, governorStatus)
+}
+
+// FindGovernorStatusFreshness godoc
+// @Description Returns the time since the last update of the governor status of each guardian node, sorted by guardian address.
+// @Description Nodes whose governor status is older than the threshold are flagged as stale.
+// @Tags wormholescan
+// @ID governor-status-freshness
+// @Param threshold query integer false "Stale threshold in minutes, defaults to the configured threshold."
+// @Success 200 {object} response.Response[[]governor.GovStatusFreshness]
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/governor/status/freshness [get]
+func (c *Controller) FindGovernorStatusFreshness(ctx *fiber.Ctx) error {
+
+	var threshold time.Duration
+	if v := ctx.Query("threshold"); v != "" {
+		minutes, err := strconv.Atoi(v)
+		if err != nil || minutes <= 0 {
+			return response.NewInvalidParamError(ctx, "threshold must be a positive number of minutes", err)
+		}
+		threshold = time.Duration(minutes) * time.Minute
+	}
+
+	freshness, err := c.srv.FindGovernorStatusFreshness(ctx.Context(), threshold)
+	if err != nil {
+		return err
+	}
+
+	return versioning.JSON(ctx, freshness)
 }
 
 // FindGovernorStatusByGuardianAddress godoc
@@ -147,15 +185,17 @@ func (c *Controller) FindGovernorStatusByGuardianAddress(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(govStatus)
+	return versioning.JSON(ctx, govStatus)
 }
 
 // GetGovernorLimit godoc
 // @Description Returns the governor limit for all blockchains.
+// @Description When fewer guardians than the quorum report a blockchain, quorumReached is false and the lowest reported value is returned.
 // @Tags wormholescan
 // @ID governor-notional-limit
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
+// @Param format query string false "Include human-readable values alongside the raw values." Enums(raw, human)
 // @Success 200 {object} response.Response[[]governor.GovernorLimit]
 // @Failure 400
 // @Failure 500
@@ -166,6 +206,10 @@ func (c *Controller) GetGovernorLimit(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
+	human, err := middleware.ExtractHumanFormat(ctx)
+	if err != nil {
+		return err
+	}
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
@@ -176,12 +220,16 @@ func (c *Controller) GetGovernorLimit(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
+	if human {
+		c.srv.HumanizeGovernorLimits(governorLimit.Data)
+	}
 
-	return ctx.JSON(governorLimit)
+	return versioning.JSON(ctx, governorLimit)
 }
 
 // FindNotionalLimit godoc
 // @Description Returns the detailed notional limit for all blockchains.
+// @Description When fewer guardians than the quorum report a blockchain, quorumReached is false and the lowest reported value is returned.
 // @Tags wormholescan
 // @ID governor-notional-limit-detail
 // @Param page query integer false "Page number."
@@ -207,7 +255,44 @@ func (c *Controller) FindNotionalLimit(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(notionalLimit)
+	return versioning.JSON(ctx, notionalLimit)
+}
+
+// FindLimitChanges godoc
+// @Description Returns the changes of the notional limit and big transaction size configured by the guardian governors, the most recent first.
+// @Tags wormholescan
+// @ID governor-limit-changes
+// @Param chain query integer false "Filter the changes by chain id."
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
+// @Success 200 {object} response.Response[[]governor.LimitChange]
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/governor/limit/changes [get]
+func (c *Controller) FindLimitChanges(ctx *fiber.Ctx) error {
+
+	p, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check pagination max limit
+	if p.Limit > 1000 {
+		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+	}
+
+	chainID, err := middleware.ExtractChainQuery(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	changes, err := c.srv.FindLimitChanges(ctx.Context(), p, chainID)
+	if err != nil {
+		return err
+	}
+
+	return versioning.JSON(ctx, changes)
 }
 
 // GetNotionalLimitByChainID godoc
@@ -242,16 +327,18 @@ func (c *Controller) GetNotionalLimitByChainID(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(notionalLimit)
+	return versioning.JSON(ctx, notionalLimit)
 }
 
 // GetAvailableNotional godoc
 // @Description Returns the amount of notional value available for each blockchain.
+// @Description When fewer guardians than the quorum report a blockchain, quorumReached is false and the lowest reported value is returned.
 // @Tags wormholescan
 // @ID governor-notional-available
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
+// @Param format query string false "Include human-readable values alongside the raw values." Enums(raw, human)
 // @Success 200 {object} response.Response[[]governor.NotionalAvailable]
 // @Failure 400
 // @Failure 500
@@ -262,6 +349,10 @@ func (c *Controller) GetAvailableNotional(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
+	human, err := middleware.ExtractHumanFormat(ctx)
+	if err != nil {
+		return err
+	}
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
@@ -272,8 +363,11 @@ func (c *Controller) GetAvailableNotional(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
+	if human {
+		c.srv.HumanizeAvailableNotional(notionalAvaialabilies.Data)
+	}
 
-	return ctx.JSON(notionalAvaialabilies)
+	return versioning.JSON(ctx, notionalAvaialabilies)
 }
 
 // GetAvailableNotionalByChainID godoc
@@ -308,11 +402,12 @@ func (c *Controller) GetAvailableNotionalByChainID(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
 }
 
 // GetMaxNotionalAvailableByChainID godoc
 // @Description Returns the maximum amount of notional value available for a given blockchain.
+// @Description When fewer guardians than the quorum report a blockchain, quorumReached is false and the lowest reported value is returned.
 // @Tags wormholescan
 // @ID governor-max-notional-available-by-chain
 // @Success 200 {object} response.Response[governor.MaxNotionalAvailableRecord]
@@ -331,7 +426,7 @@ func (c *Controller) GetMaxNotionalAvailableByChainID(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
 }
 
 // GetEnqueuedVaas godoc
@@ -341,6 +436,7 @@ func (c *Controller) GetMaxNotionalAvailableByChainID(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
+// @Param format query string false "Export all the enqueued VAAs from the page as CSV or NDJSON, reading pages of pageSize elements." Enums(csv, ndjson)
 // @Success 200 {object} response.Response[[]governor.EnqueuedVaas]
 // @Failure 400
 // @Failure 500
@@ -357,12 +453,23 @@ func (c *Controller) GetEnqueuedVaas(ctx *fiber.Ctx) error {
 		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
 	}
 
+	if format, ok := versioning.ExportFormatFromQuery(ctx); ok {
+		// the enqueued vaas are not paged, they are exported in a single page.
+		return versioning.Export(ctx, format, p, func(ctx context.Context, p *pagination.Pagination) ([]*governor.EnqueuedVaas, error) {
+			enqueuedVaas, err := c.srv.GetEnqueueVass(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+			return enqueuedVaas.Data, nil
+		}, func(*governor.EnqueuedVaas) *pagination.Cursor { return nil }, c.logger)
+	}
+
 	enqueuedVaas, err := c.srv.GetEnqueueVass(ctx.Context(), p)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(enqueuedVaas)
+	return versioning.JSON(ctx, enqueuedVaas)
 }
 
 // GetEnqueuedVaasByChainID godoc
@@ -372,6 +479,7 @@ func (c *Controller) GetEnqueuedVaas(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
+// @Param format query string false "Include human-readable values alongside the raw values, or export all the enqueued VAAs from the page as CSV or NDJSON, reading pages of pageSize VAAs." Enums(raw, human, csv, ndjson)
 // @Success 200 {object} response.Response[[]governor.EnqueuedVaaDetail]
 // @Failure 400
 // @Failure 500
@@ -382,6 +490,14 @@ func (c *Controller) GetEnqueuedVaasByChainID(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
+	exportFormat, export := versioning.ExportFormatFromQuery(ctx)
+	var human bool
+	if !export {
+		human, err = middleware.ExtractHumanFormat(ctx)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Check pagination max limit
 	if p.Limit > 1000 {
@@ -392,12 +508,26 @@ func (c *Controller) GetEnqueuedVaasByChainID(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	if export {
+		// the enqueued vaas are not paged, they are exported in a single page.
+		return versioning.Export(ctx, exportFormat, p, func(ctx context.Context, p *pagination.Pagination) ([]*governor.EnqueuedVaaDetail, error) {
+			enqueuedVaas, err := c.srv.GetEnqueueVassByChainID(ctx, p, chainID)
+			if err != nil {
+				return nil, err
+			}
+			return enqueuedVaas.Data, nil
+		}, func(*governor.EnqueuedVaaDetail) *pagination.Cursor { return nil }, c.logger)
+	}
+
 	enqueuedVaas, err := c.srv.GetEnqueueVassByChainID(ctx.Context(), p, chainID)
 	if err != nil {
 		return err
 	}
+	if human {
+		c.srv.HumanizeEnqueuedVaas(enqueuedVaas.Data)
+	}
 
-	return ctx.JSON(enqueuedVaas)
+	return versioning.JSON(ctx, enqueuedVaas)
 }
 
 // GetGovernorVaas godoc
@@ -432,5 +562,52 @@ func (c *Controller) GetGovernorVaas(ctx *fiber.Ctx) error {
 		})
 	}
 
-	return ctx.JSON(result)
+	return versioning.JSON(ctx, result)
+}
+
+// FindGovernorVaaByID godoc
+// @Description Returns the governor status of a given VAA, including its release time and the guardians that have it enqueued.
+// @Tags wormholescan
+// @ID governor-vaa-by-id
+// @Param chain_id path integer true "id of the blockchain"
+// @Param emitter path string true "address of the emitter"
+// @Param seq path integer true "sequence of the VAA"
+// @Success 200 {object} GovernorVaaResponse
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /api/v1/governor/vaas/{chain_id}/{emitter}/{seq} [get]
+func (c *Controller) FindGovernorVaaByID(ctx *fiber.Ctx) error {
+
+	chainID, emitter, seq, err := middleware.ExtractVAAParams(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	governorVaa, err := c.srv.GetGovernorVaaByID(ctx.Context(), chainID, emitter, strconv.FormatUint(seq, 10))
+	if err != nil {
+		return err
+	}
+
+	status := "pending"
+	if len(governorVaa.Vaas) > 0 {
+		status = "issued"
+	}
+
+	guardians := make([]string, 0, len(governorVaa.Nodes))
+	for _, n := range governorVaa.Nodes {
+		guardians = append(guardians, n.NodeName)
+	}
+
+	return versioning.JSON(ctx, GovernorVaaResponse{
+		VaaID:          governorVaa.ID,
+		ChainID:        governorVaa.ChainID,
+		EmitterAddress: governorVaa.EmitterAddress,
+		Sequence:       governorVaa.Sequence,
+		TxHash:         governorVaa.TxHash,
+		ReleaseTime:    governorVaa.ReleaseTime,
+		Amount:         uint64(governorVaa.Amount),
+		Status:         status,
+		Guardians:      guardians,
+	})
 }
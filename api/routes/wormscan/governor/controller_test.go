@@ -0,0 +1,142 @@
+package governor_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	govHandlers "github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/governor"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+)
+
+const tokenBridgeEmitter = "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"
+
+func Test_FindGovernorVaaByID(t *testing.T) {
+
+	m := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer m.Close()
+
+	vaaID := "2/" + tokenBridgeEmitter + "/5"
+	releaseTime := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+	amount, err := primitive.ParseDecimal128("1500000")
+	require.NoError(t, err)
+
+	governorVaa := func(vaas bson.A, nodes ...string) bson.D {
+		guardians := bson.A{}
+		for _, n := range nodes {
+			guardians = append(guardians, bson.D{{Key: "nodeName", Value: n}})
+		}
+		return bson.D{
+			{Key: "_id", Value: vaaID},
+			{Key: "chainId", Value: 2},
+			{Key: "emitterAddress", Value: tokenBridgeEmitter},
+			{Key: "sequence", Value: "5"},
+			{Key: "txHash", Value: "0xabc"},
+			{Key: "releaseTime", Value: releaseTime},
+			{Key: "amount", Value: amount},
+			{Key: "vaas", Value: vaas},
+			{Key: "nodes", Value: guardians},
+		}
+	}
+
+	testCases := []struct {
+		name               string
+		requestURL         string
+		responses          []bson.D
+		expectedStatusCode int
+		expectedResponse   *governor.GovernorVaaResponse
+	}{
+		{
+			name:               "Test_FindGovernorVaaByID_Pending",
+			requestURL:         "/api/v1/governor/vaas/2/" + tokenBridgeEmitter + "/5",
+			responses:          []bson.D{mtest.CreateCursorResponse(0, "wormhole.governorVaas", mtest.FirstBatch, governorVaa(bson.A{}, "guardian-0", "guardian-1"))},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse: &governor.GovernorVaaResponse{
+				VaaID:          vaaID,
+				ChainID:        sdk.ChainIDEthereum,
+				EmitterAddress: tokenBridgeEmitter,
+				Sequence:       "5",
+				TxHash:         "0xabc",
+				ReleaseTime:    releaseTime,
+				Amount:         1500000,
+				Status:         "pending",
+				Guardians:      []string{"guardian-0", "guardian-1"},
+			},
+		},
+		{
+			name:               "Test_FindGovernorVaaByID_Issued",
+			requestURL:         "/api/v1/governor/vaas/2/" + tokenBridgeEmitter + "/5",
+			responses:          []bson.D{mtest.CreateCursorResponse(0, "wormhole.governorVaas", mtest.FirstBatch, governorVaa(bson.A{bson.D{{Key: "_id", Value: vaaID}}}))},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse: &governor.GovernorVaaResponse{
+				VaaID:          vaaID,
+				ChainID:        sdk.ChainIDEthereum,
+				EmitterAddress: tokenBridgeEmitter,
+				Sequence:       "5",
+				TxHash:         "0xabc",
+				ReleaseTime:    releaseTime,
+				Amount:         1500000,
+				Status:         "issued",
+				Guardians:      []string{},
+			},
+		},
+		{
+			name:               "Test_FindGovernorVaaByID_NotFound",
+			requestURL:         "/api/v1/governor/vaas/2/" + tokenBridgeEmitter + "/6",
+			responses:          []bson.D{mtest.CreateCursorResponse(0, "wormhole.governorVaas", mtest.FirstBatch)},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Test_FindGovernorVaaByID_InvalidChain",
+			requestURL:         "/api/v1/governor/vaas/abc/" + tokenBridgeEmitter + "/5",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Test_FindGovernorVaaByID_InvalidSequence",
+			requestURL:         "/api/v1/governor/vaas/2/" + tokenBridgeEmitter + "/abc",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		m.Run(testCase.name, func(mt *mtest.T) {
+			mt.AddMockResponses(testCase.responses...)
+
+			srv := govHandlers.NewService(govHandlers.NewRepository(mt.DB, zap.NewNop()), nil, nil, metrics.NewNoOpMetrics(), 13, time.Hour, zap.NewNop())
+			app := fiber.New(fiber.Config{
+				ErrorHandler:          middleware.ErrorHandler,
+				DisableStartupMessage: true,
+				Immutable:             true,
+			})
+			app.Get("/api/v1/governor/vaas/:chain/:emitter/:sequence", governor.NewController(srv, zap.NewNop()).FindGovernorVaaByID)
+
+			req, err := http.NewRequest(http.MethodGet, testCase.requestURL, nil)
+			require.NoError(mt, err)
+			resp, err := app.Test(req, 1000)
+			require.NoError(mt, err)
+			defer resp.Body.Close()
+
+			assert.Equal(mt, testCase.expectedStatusCode, resp.StatusCode)
+			if testCase.expectedResponse == nil {
+				return
+			}
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(mt, err)
+			var response governor.GovernorVaaResponse
+			require.NoError(mt, json.Unmarshal(body, &response))
+			assert.Equal(mt, *testCase.expectedResponse, response)
+		})
+	}
+}
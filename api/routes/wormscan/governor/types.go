@@ -16,3 +16,15 @@ type GovernorVaasResponse struct {
 	Amount         uint64      `json:"amount"`
 	Status         string      `json:"status"`
 }
+
+type GovernorVaaResponse struct {
+	VaaID          string      `json:"vaaId"`
+	ChainID        vaa.ChainID `json:"chainId"`
+	EmitterAddress string      `json:"emitterAddress"`
+	Sequence       string      `json:"sequence"`
+	TxHash         string      `json:"txHash"`
+	ReleaseTime    time.Time   `json:"releaseTime"`
+	Amount         uint64      `json:"amount"`
+	Status         string      `json:"status"`
+	Guardians      []string    `json:"guardians"`
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/infrastructure"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/build"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 )
 
 // Controller definition.
@@ -79,3 +80,73 @@ func (c *Controller) Version(ctx *fiber.Ctx) error {
 		User:      build.User,
 	})
 }
+
+// GetGossipStats is the HTTP route handler for the endpoint `GET /api/v1/infrastructure/gossip`.
+// GetGossipStats godoc
+// @Description Get the gossip network statistics of each guardian, as seen by the explorer.
+// @Description The observations and heartbeats are counted in the last time window of the statistics.
+// @Tags wormholescan
+// @ID get-gossip-stats
+// @Success 200 {object} []infrastructure.GossipStatsDoc
+// @Failure 500
+// @Router /api/v1/infrastructure/gossip [get]
+func (c *Controller) GetGossipStats(ctx *fiber.Ctx) error {
+	stats, err := c.srv.GetGossipStats(ctx.Context())
+	if err != nil {
+		return err
+	}
+	if stats == nil {
+		stats = []*infrastructure.GossipStatsDoc{}
+	}
+	return versioning.JSON(ctx, stats)
+}
+
+// GetCacheStats is the HTTP route handler for the endpoint `GET /api/v1/infrastructure/cache-stats`.
+// GetCacheStats godoc
+// @Description Get the statistics of the cache operations since the api instance started.
+// @Description The hits, misses, errors and average latency are grouped by key prefix.
+// @Tags wormholescan
+// @ID get-cache-stats
+// @Success 200 {object} infrastructure.CacheStats
+// @Failure 500
+// @Router /api/v1/infrastructure/cache-stats [get]
+func (c *Controller) GetCacheStats(ctx *fiber.Ctx) error {
+	return versioning.JSON(ctx, c.srv.GetCacheStats())
+}
+
+// GetChainLag is the HTTP route handler for the endpoint `GET /api/v1/infrastructure/lag`.
+// GetChainLag godoc
+// @Description Get the indexing lag of each chain: the age of the most recent vaa versus the wall clock,
+// @Description and the newest height of the chain reported by the guardians.
+// @Description Chains without vaas in the last 7 days are reported without last vaa.
+// @Tags wormholescan
+// @ID get-chain-lag
+// @Success 200 {object} []infrastructure.ChainLag
+// @Failure 500
+// @Router /api/v1/infrastructure/lag [get]
+func (c *Controller) GetChainLag(ctx *fiber.Ctx) error {
+	lags, err := c.srv.GetChainLag(ctx.Context())
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, lags)
+}
+
+// GetSLO is the HTTP route handler for the endpoint `GET /api/v1/infrastructure/slo`.
+// GetSLO godoc
+// @Description Get the availability and latency service level objectives of each endpoint in the rolling window,
+// @Description and the ratio of the error budget not consumed yet. The requests are the ones served by all the api instances,
+// @Description and the endpoint is disabled when the prometheus server is not configured.
+// @Tags wormholescan
+// @ID get-slo
+// @Success 200 {object} slo.Summary
+// @Failure 404
+// @Failure 500
+// @Router /api/v1/infrastructure/slo [get]
+func (c *Controller) GetSLO(ctx *fiber.Ctx) error {
+	summary, err := c.srv.GetSLO(ctx.Context())
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, summary)
+}
@@ -0,0 +1,108 @@
+package labels
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/labels"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+// maxNameLength is the maximum length of the name of a label.
+const maxNameLength = 100
+
+// Controller definition.
+type Controller struct {
+	srv    *labels.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *labels.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "LabelsController")),
+	}
+}
+
+// UpsertLabelRequest is the request body of `PUT /api/v1/address-labels/:address`.
+type UpsertLabelRequest struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+// FindAll godoc
+// @Description Returns the labels of the known addresses (contracts, exchanges, bridges).
+// @Tags wormholescan
+// @ID find-address-labels
+// @Success 200 {object} []labels.Label
+// @Failure 500
+// @Router /api/v1/address-labels [get]
+func (c *Controller) FindAll(ctx *fiber.Ctx) error {
+	result, err := c.srv.FindAll(ctx.Context())
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, result)
+}
+
+// Upsert godoc
+// @Description Creates or replaces the label of an address.
+// @Description Requires the admin api key in the X-Api-Key header.
+// @Tags wormholescan
+// @ID upsert-address-label
+// @Param address path string true "address, hex addresses are case insensitive"
+// @Param request body UpsertLabelRequest true "label"
+// @Success 200 {object} labels.Label
+// @Failure 400
+// @Failure 401
+// @Failure 500
+// @Router /api/v1/address-labels/:address [put]
+func (c *Controller) Upsert(ctx *fiber.Ctx) error {
+	address := strings.TrimSpace(ctx.Params("address"))
+	if address == "" {
+		return response.NewInvalidParamError(ctx, "address is required", nil)
+	}
+
+	var body UpsertLabelRequest
+	if err := ctx.BodyParser(&body); err != nil {
+		return response.NewRequestBodyError(ctx, "invalid label request, unable to parse", errors.WithStack(err))
+	}
+	body.Name = strings.TrimSpace(body.Name)
+	if body.Name == "" || len(body.Name) > maxNameLength {
+		return response.NewRequestBodyError(ctx, "invalid label request, name must have between 1 and 100 characters", nil)
+	}
+	if body.Category == "" {
+		body.Category = labels.CategoryOther
+	}
+	if !labels.IsValidCategory(body.Category) {
+		return response.NewRequestBodyError(ctx, "invalid label request, category must be one of contract, exchange, bridge or other", nil)
+	}
+
+	label, err := c.srv.Upsert(ctx.Context(), address, body.Name, body.Category)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, label)
+}
+
+// Delete godoc
+// @Description Removes the label of an address.
+// @Description Requires the admin api key in the X-Api-Key header.
+// @Tags wormholescan
+// @ID delete-address-label
+// @Param address path string true "address, hex addresses are case insensitive"
+// @Success 204
+// @Failure 401
+// @Failure 404
+// @Failure 500
+// @Router /api/v1/address-labels/:address [delete]
+func (c *Controller) Delete(ctx *fiber.Ctx) error {
+	if err := c.srv.Delete(ctx.Context(), ctx.Params("address")); err != nil {
+		return err
+	}
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
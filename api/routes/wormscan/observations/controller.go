@@ -2,12 +2,15 @@
 package observations
 
 import (
+	"context"
 	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/observations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
-	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 	"go.uber.org/zap"
 )
 
@@ -33,8 +36,12 @@ func NewController(srv *observations.Service, logger *zap.Logger) *Controller {
 // @Param pageSize query integer false "Number of elements per page."
 // @Param txHash query string false "Transaction hash of the Observations"
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
+// @Param from query string false "From date, supported format 2006-01-02T15:04:05Z07:00"
+// @Param to query string false "To date, supported format 2006-01-02T15:04:05Z07:00"
+// @Param format query string false "Export all the observations from the page as CSV or NDJSON, reading pages of pageSize observations." Enums(csv, ndjson)
 // @Success 200 {object} []observations.ObservationDoc
 // @Failure 400
+// @Failure 413
 // @Failure 500
 // @Router /api/v1/observations [get]
 func (c *Controller) FindAll(ctx *fiber.Ctx) error {
@@ -44,27 +51,23 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	// Check pagination max limit
-	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
-	}
-
 	txHash, err := middleware.GetTxHash(ctx, c.logger)
 	if err != nil {
 		return err
 	}
 
-	params := &observations.FindAllParams{
-		Pagination: p,
-		TxHash:     txHash,
-	}
-
-	obs, err := c.srv.FindAll(ctx.Context(), params)
+	timeRange, err := extractTimeRange(ctx)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(obs)
+	return c.respond(ctx, p, func(ctx context.Context, p *pagination.Pagination) ([]*observations.ObservationDoc, error) {
+		return c.srv.FindAll(ctx, &observations.FindAllParams{
+			Pagination: p,
+			TxHash:     txHash,
+			TimeRange:  timeRange,
+		})
+	})
 }
 
 // FindAllByChain godoc
@@ -74,8 +77,12 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
+// @Param from query string false "From date, supported format 2006-01-02T15:04:05Z07:00"
+// @Param to query string false "To date, supported format 2006-01-02T15:04:05Z07:00"
+// @Param format query string false "Export all the observations from the page as CSV or NDJSON, reading pages of pageSize observations." Enums(csv, ndjson)
 // @Success 200 {object} []observations.ObservationDoc
 // @Failure 400
+// @Failure 413
 // @Failure 500
 // @Router /api/v1/observations/:chain [get]
 func (c *Controller) FindAllByChain(ctx *fiber.Ctx) error {
@@ -85,22 +92,19 @@ func (c *Controller) FindAllByChain(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	// Check pagination max limit
-	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
-	}
-
 	chainID, err := middleware.ExtractChainID(ctx, c.logger)
 	if err != nil {
 		return err
 	}
 
-	obs, err := c.srv.FindByChain(ctx.Context(), chainID, p)
+	timeRange, err := extractTimeRange(ctx)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(obs)
+	return c.respond(ctx, p, func(ctx context.Context, p *pagination.Pagination) ([]*observations.ObservationDoc, error) {
+		return c.srv.FindByChain(ctx, chainID, p, timeRange)
+	})
 }
 
 // FindAllByEmitter godoc
@@ -110,8 +114,12 @@ func (c *Controller) FindAllByChain(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
+// @Param from query string false "From date, supported format 2006-01-02T15:04:05Z07:00"
+// @Param to query string false "To date, supported format 2006-01-02T15:04:05Z07:00"
+// @Param format query string false "Export all the observations from the page as CSV or NDJSON, reading pages of pageSize observations." Enums(csv, ndjson)
 // @Success 200 {object} []observations.ObservationDoc
 // @Failure 400
+// @Failure 413
 // @Failure 500
 // @Router /api/v1/observations/:chain/:emitter [get]
 func (c *Controller) FindAllByEmitter(ctx *fiber.Ctx) error {
@@ -121,22 +129,19 @@ func (c *Controller) FindAllByEmitter(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	// Check pagination max limit
-	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
-	}
-
 	chainID, addr, err := middleware.ExtractVAAChainIDEmitter(ctx, c.logger)
 	if err != nil {
 		return err
 	}
 
-	obs, err := c.srv.FindByEmitter(ctx.Context(), chainID, addr, p)
+	timeRange, err := extractTimeRange(ctx)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(obs)
+	return c.respond(ctx, p, func(ctx context.Context, p *pagination.Pagination) ([]*observations.ObservationDoc, error) {
+		return c.srv.FindByEmitter(ctx, chainID, addr, p, timeRange)
+	})
 }
 
 // FindAllByVAA godoc
@@ -146,8 +151,10 @@ func (c *Controller) FindAllByEmitter(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number."
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
+// @Param format query string false "Export all the observations from the page as CSV or NDJSON, reading pages of pageSize observations." Enums(csv, ndjson)
 // @Success 200 {object} []observations.ObservationDoc
 // @Failure 400
+// @Failure 413
 // @Failure 500
 // @Router /api/v1/observations/:chain/:emitter/:sequence [get]
 func (c *Controller) FindAllByVAA(ctx *fiber.Ctx) error {
@@ -157,22 +164,14 @@ func (c *Controller) FindAllByVAA(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	// Check pagination max limit
-	if p.Limit > 1000 {
-		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
-	}
-
 	chainID, addr, seq, err := middleware.ExtractVAAParams(ctx, c.logger)
 	if err != nil {
 		return err
 	}
 
-	obs, err := c.srv.FindByVAA(ctx.Context(), chainID, addr, strconv.FormatUint(seq, 10), p)
-	if err != nil {
-		return err
-	}
-
-	return ctx.JSON(obs)
+	return c.respond(ctx, p, func(ctx context.Context, p *pagination.Pagination) ([]*observations.ObservationDoc, error) {
+		return c.srv.FindByVAA(ctx, chainID, addr, strconv.FormatUint(seq, 10), p)
+	})
 }
 
 // FindOne godoc
@@ -203,5 +202,41 @@ func (c *Controller) FindOne(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
-	return ctx.JSON(obs)
+	return versioning.JSON(ctx, obs)
+}
+
+// respond sends the observations of the page [p], or exports the observations from the page [p]
+// when the format query parameter is csv or ndjson.
+func (c *Controller) respond(ctx *fiber.Ctx, p *pagination.Pagination, find versioning.PageFunc[*observations.ObservationDoc]) error {
+	if format, ok := versioning.ExportFormatFromQuery(ctx); ok {
+		return versioning.Export(ctx, format, p, find, observationCursor, c.logger)
+	}
+	obs, err := find(ctx.Context(), p)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, obs)
+}
+
+// extractTimeRange returns the from/to query params of the observations listings.
+func extractTimeRange(ctx *fiber.Ctx) (*observations.TimeRange, error) {
+	from, err := middleware.ExtractTime(ctx, time.RFC3339, "from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := middleware.ExtractTime(ctx, time.RFC3339, "to")
+	if err != nil {
+		return nil, err
+	}
+	return &observations.TimeRange{From: from, To: to}, nil
+}
+
+// observationCursor returns the position of an observation, the observations are sorted by
+// indexing time and id.
+func observationCursor(o *observations.ObservationDoc) *pagination.Cursor {
+	cursor := &pagination.Cursor{ID: o.ID}
+	if o.IndexedAt != nil {
+		cursor.Timestamp = *o.IndexedAt
+	}
+	return cursor
 }
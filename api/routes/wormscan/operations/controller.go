@@ -2,15 +2,28 @@ package operations
 
 import (
 	"context"
+	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/operations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"strconv"
 	"strings"
+	"time"
+)
+
+const (
+	transferStatusPendingMaxAge = 10 * time.Second
+	transferStatusFinalMaxAge   = 24 * time.Hour
+
+	// pendingRedeemsDefaultHours is the default min age, in hours, of the vaas pending to be redeemed.
+	pendingRedeemsDefaultHours = 24
+	pendingRedeemsMaxHours     = 24 * 30
 )
 
 // Controller is the controller for the operation resource.
@@ -23,6 +36,7 @@ type Controller struct {
 type operationService interface {
 	FindById(ctx context.Context, chainID vaa.ChainID, emitter *types.Address, seq string) (*operations.OperationDto, error)
 	FindAll(ctx context.Context, filter operations.OperationFilter) ([]*operations.OperationDto, error)
+	FindPendingRedeems(ctx context.Context, filter operations.PendingRedeemsFilter) ([]*operations.PendingRedeemsDto, error)
 }
 
 // NewController create a new controler.
@@ -45,6 +59,7 @@ func NewController(operationService operationService, logger *zap.Logger) *Contr
 // @Param targetChain query string false "target chains of the operation, separated by comma".
 // @Param appId query string false "appID of the operation".
 // @Param exclusiveAppId query boolean false "single appId of the operation".
+// @Param protocol query string false "protocols of the emitter of the operation, like fast transfers or swap layers, separated by comma".
 // @Success 200 {object} []OperationResponse
 // @Failure 400
 // @Failure 500
@@ -95,11 +110,17 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	var protocols []string
+	if protocolQueryParam := ctx.Query("protocol"); protocolQueryParam != "" {
+		protocols = strings.Split(protocolQueryParam, ",")
+	}
+
 	searchBySourceTargetChain := len(sourceChain) > 0 || len(targetChain) > 0
 	searchByAppId := len(appIDs) != 0
+	searchByProtocol := len(protocols) != 0
 
-	if (searchByAddress || searchByTxHash) && (searchBySourceTargetChain || searchByAppId) {
-		return response.NewInvalidParamError(ctx, "address/txHash cannot be combined with sourceChain/targetChain/appId query filter", nil)
+	if (searchByAddress || searchByTxHash) && (searchBySourceTargetChain || searchByAppId || searchByProtocol) {
+		return response.NewInvalidParamError(ctx, "address/txHash cannot be combined with sourceChain/targetChain/appId/protocol query filter", nil)
 	}
 
 	payloadTypeParam := ctx.Query("payloadType")
@@ -123,6 +144,7 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 		AppIDs:         appIDs,
 		ExclusiveAppId: exclusiveAppId,
 		PayloadType:    payloadType,
+		Protocols:      protocols,
 		Pagination:     *pagination,
 	}
 
@@ -134,7 +156,7 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 
 	// build response
 	resp := toListOperationResponse(ops, c.logger)
-	return ctx.JSON(resp)
+	return versioning.JSON(ctx, resp)
 }
 
 // FindById godoc
@@ -166,5 +188,116 @@ func (c *Controller) FindById(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
+}
+
+// GetTransferStatus godoc
+// @Description Returns a minimal status object for the transfers initiated in a given transaction.
+// @Description This endpoint is intended for wallet and Wormhole Connect integrations.
+// @Tags wormholescan
+// @ID get-transfer-status
+// @Param txHash query string true "hash of the source transaction"
+// @Param chain query integer true "id of the source blockchain"
+// @Success 200 {object} []TransferStatusResponse
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /api/v1/transfers/status [get]
+func (c *Controller) GetTransferStatus(ctx *fiber.Ctx) error {
+	// Extract query params
+	txHash, err := middleware.GetTxHash(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+	if txHash == nil {
+		return response.NewInvalidQueryParamError(ctx, "MISSING <txHash> QUERY PARAMETER", nil)
+	}
+
+	chainParam := ctx.Query("chain")
+	if chainParam == "" {
+		return response.NewInvalidQueryParamError(ctx, "MISSING <chain> QUERY PARAMETER", nil)
+	}
+	chain, err := strconv.ParseUint(chainParam, 10, 16)
+	if err != nil {
+		return response.NewInvalidQueryParamError(ctx, "INVALID <chain> QUERY PARAMETER", err)
+	}
+	chainID := vaa.ChainID(chain)
+
+	// Find operations by txHash.
+	filter := operations.OperationFilter{
+		TxHash:     txHash,
+		Pagination: *pagination.Default(),
+	}
+	ops, err := c.srv.FindAll(ctx.Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	// build response
+	final := true
+	statuses := make([]*TransferStatusResponse, 0, len(ops))
+	for _, op := range ops {
+		status, err := toTransferStatusResponse(op, c.logger)
+		if err != nil || status.FromChain != chainID {
+			continue
+		}
+		final = final && status.isFinal()
+		statuses = append(statuses, status)
+	}
+	if len(statuses) == 0 {
+		return response.NewNotFoundError(ctx)
+	}
+
+	// redeemed transfers never change, so they can be cached for a long time.
+	maxAge := transferStatusPendingMaxAge
+	if final {
+		maxAge = transferStatusFinalMaxAge
+	}
+	ctx.Set(fiber.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds())))
+
+	return versioning.JSON(ctx, statuses)
+}
+
+// FindPendingRedeems godoc
+// @Description Returns the transfers signed more than N hours ago without a detected redeem, grouped by target chain and app.
+// @Description Only the transfers of the last 30 days are checked, and at most 50 VAAs are listed for each group.
+// @Tags wormholescan
+// @ID get-pending-redeems
+// @Param hours query integer false "min age of the VAAs in hours, 24 by default". Maximum value is 720.
+// @Param targetChain query string false "target chains of the transfers, separated by comma".
+// @Param appId query string false "appIDs of the transfers, separated by comma".
+// @Success 200 {object} []PendingRedeemsResponse
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/operations/pending-redeems [get]
+func (c *Controller) FindPendingRedeems(ctx *fiber.Ctx) error {
+	hours := pendingRedeemsDefaultHours
+	if param := ctx.Query("hours"); param != "" {
+		var err error
+		hours, err = strconv.Atoi(param)
+		if err != nil || hours <= 0 || hours > pendingRedeemsMaxHours {
+			return response.NewInvalidParamError(ctx, "hours must be between 1 and 720", err)
+		}
+	}
+
+	targetChain, err := middleware.ExtractTargetChain(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	var appIDs []string
+	if appIDQueryParam := ctx.Query("appId"); appIDQueryParam != "" {
+		appIDs = strings.Split(appIDQueryParam, ",")
+	}
+
+	filter := operations.PendingRedeemsFilter{
+		OlderThan:      time.Duration(hours) * time.Hour,
+		TargetChainIDs: targetChain,
+		AppIDs:         appIDs,
+	}
+	pending, err := c.srv.FindPendingRedeems(ctx.Context(), filter)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, toPendingRedeemsResponse(pending))
 }
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"slices"
 	"testing"
+	"time"
 )
 
 func Test_FindAll(t *testing.T) {
@@ -109,3 +110,198 @@ func (m *mockOpsService) FindAll(ctx context.Context, filter ops.OperationFilter
 	args := m.Called(ctx, filter)
 	return args.Get(0).([]*ops.OperationDto), args.Error(1)
 }
+
+func (m *mockOpsService) FindPendingRedeems(ctx context.Context, filter ops.PendingRedeemsFilter) ([]*ops.PendingRedeemsDto, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*ops.PendingRedeemsDto), args.Error(1)
+}
+
+func Test_GetTransferStatus(t *testing.T) {
+
+	txHash := "0xad7e3f2b5e1f8dd2fbdb5a8e0c24e1d0b3bd2a6c0b8d4ab2f0c0d5f4e5a3c2b1"
+
+	testCases := []struct {
+		name                 string
+		requestURL           string
+		expectedStatusCode   int
+		expectedResponse     string
+		expectedCacheControl string
+		setupServiceMock     func(*mockOpsService)
+	}{
+		{
+			name:               "Test_GetTransferStatus_MissingChain",
+			requestURL:         "/api/v1/transfers/status?txHash=" + txHash,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"code":3,"message":"MISSING \u003cchain\u003e QUERY PARAMETER","details":[{"request_id":"\u003cnil\u003e"}]}`,
+			setupServiceMock:   func(mockService *mockOpsService) {},
+		},
+		{
+			name:               "Test_GetTransferStatus_NotFound",
+			requestURL:         "/api/v1/transfers/status?chain=2&txHash=" + txHash,
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"code":5,"message":"NOT FOUND","details":[{"request_id":"\u003cnil\u003e"}]}`,
+			setupServiceMock: func(mockService *mockOpsService) {
+				mockService.On("FindAll", mock.Anything, mock.Anything).Return([]*ops.OperationDto{}, nil)
+			},
+		},
+		{
+			name:                 "Test_GetTransferStatus_Attested",
+			requestURL:           "/api/v1/transfers/status?chain=2&txHash=" + txHash,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponse:     `[{"id":"2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1","status":"attested","fromChain":2,"vaa":"AQ=="}]`,
+			expectedCacheControl: "public, max-age=10",
+			setupServiceMock: func(mockService *mockOpsService) {
+				mockService.On("FindAll", mock.Anything, mock.Anything).Return([]*ops.OperationDto{
+					{
+						ID:  "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1",
+						Vaa: &ops.VaaDto{EmitterChain: vaa.ChainIDEthereum, Vaa: []byte{1}},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:                 "Test_GetTransferStatus_Redeemed",
+			requestURL:           "/api/v1/transfers/status?chain=2&txHash=" + txHash,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponse:     `[{"id":"2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1","status":"redeemed","fromChain":2,"vaa":"AQ==","destinationTx":{"chainId":1,"txHash":"abc","timestamp":null}}]`,
+			expectedCacheControl: "public, max-age=86400",
+			setupServiceMock: func(mockService *mockOpsService) {
+				mockService.On("FindAll", mock.Anything, mock.Anything).Return([]*ops.OperationDto{
+					{
+						ID:            "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1",
+						Vaa:           &ops.VaaDto{EmitterChain: vaa.ChainIDEthereum, Vaa: []byte{1}},
+						DestinationTx: &ops.DestinationTx{ChainID: vaa.ChainIDSolana, Status: "completed", TxHash: "abc"},
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			req, err := http.NewRequest(http.MethodGet, testCase.requestURL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mockService := &mockOpsService{}
+			testCase.setupServiceMock(mockService)
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler:          middleware.ErrorHandler,
+				DisableStartupMessage: true,
+				Immutable:             true,
+			})
+			app.Get("/api/v1/transfers/status", operations.NewController(mockService, zap.NewNop()).GetTransferStatus)
+
+			resp, _ := app.Test(req, 1000)
+			defer resp.Body.Close()
+
+			if resp.StatusCode != testCase.expectedStatusCode {
+				t.Fatalf("expected status code %d, got %d", testCase.expectedStatusCode, resp.StatusCode)
+			}
+
+			respBytes, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(respBytes) != testCase.expectedResponse {
+				t.Fatalf("expected response %s, got %s", testCase.expectedResponse, string(respBytes))
+			}
+
+			if testCase.expectedCacheControl != "" && resp.Header.Get(fiber.HeaderCacheControl) != testCase.expectedCacheControl {
+				t.Fatalf("expected cache-control %s, got %s", testCase.expectedCacheControl, resp.Header.Get(fiber.HeaderCacheControl))
+			}
+		})
+	}
+}
+
+func Test_FindPendingRedeems(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		requestURL         string
+		expectedStatusCode int
+		expectedResponse   string
+		setupServiceMock   func(*mockOpsService)
+	}{
+		{
+			name:               "Test_FindPendingRedeems_Default",
+			requestURL:         "/api/v1/operations/pending-redeems",
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `[]`,
+			setupServiceMock: func(mockService *mockOpsService) {
+				filterMatcher := mock.MatchedBy(func(filter ops.PendingRedeemsFilter) bool {
+					return filter.OlderThan == 24*time.Hour && len(filter.TargetChainIDs) == 0 && len(filter.AppIDs) == 0
+				})
+				mockService.On("FindPendingRedeems", mock.Anything, filterMatcher).Return([]*ops.PendingRedeemsDto{}, nil)
+			},
+		},
+		{
+			name:               "Test_FindPendingRedeems_Filtered",
+			requestURL:         "/api/v1/operations/pending-redeems?hours=48&targetChain=1&appId=PORTAL_TOKEN_BRIDGE",
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `[{"targetChain":1,"appId":"PORTAL_TOKEN_BRIDGE","count":1,"oldestTimestamp":null,"vaas":[{"id":"2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1","timestamp":null}]}]`,
+			setupServiceMock: func(mockService *mockOpsService) {
+				filterMatcher := mock.MatchedBy(func(filter ops.PendingRedeemsFilter) bool {
+					return filter.OlderThan == 48*time.Hour &&
+						slices.Equal(filter.TargetChainIDs, []vaa.ChainID{vaa.ChainIDSolana}) &&
+						slices.Equal(filter.AppIDs, []string{"PORTAL_TOKEN_BRIDGE"})
+				})
+				mockService.On("FindPendingRedeems", mock.Anything, filterMatcher).Return([]*ops.PendingRedeemsDto{
+					{
+						TargetChain: vaa.ChainIDSolana,
+						AppID:       "PORTAL_TOKEN_BRIDGE",
+						Count:       1,
+						Vaas:        []ops.PendingRedeemVaa{{ID: "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1"}},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:               "Test_FindPendingRedeems_InvalidHours",
+			requestURL:         "/api/v1/operations/pending-redeems?hours=0",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"code":3,"message":"hours must be between 1 and 720","details":[{"request_id":"\u003cnil\u003e"}]}`,
+			setupServiceMock:   func(mockService *mockOpsService) {},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			req, err := http.NewRequest(http.MethodGet, testCase.requestURL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mockService := &mockOpsService{}
+			testCase.setupServiceMock(mockService)
+
+			app := fiber.New(fiber.Config{
+				ErrorHandler:          middleware.ErrorHandler,
+				DisableStartupMessage: true,
+				Immutable:             true,
+			})
+			app.Get("/api/v1/operations/pending-redeems", operations.NewController(mockService, zap.NewNop()).FindPendingRedeems)
+
+			resp, _ := app.Test(req, 1000)
+			defer resp.Body.Close()
+
+			if resp.StatusCode != testCase.expectedStatusCode {
+				t.Fatalf("expected status code %d, got %d", testCase.expectedStatusCode, resp.StatusCode)
+			}
+
+			respBytes, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(respBytes) != testCase.expectedResponse {
+				t.Fatalf("expected response %s, got %s", testCase.expectedResponse, string(respBytes))
+			}
+		})
+	}
+}
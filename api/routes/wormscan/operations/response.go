@@ -23,6 +23,7 @@ type OperationResponse struct {
 	Content        *Content       `json:"content,omitempty"`
 	SourceChain    *SourceChain   `json:"sourceChain,omitempty"`
 	TargetChain    *TargetChain   `json:"targetChain,omitempty"`
+	Protocol       string         `json:"protocol,omitempty"`
 	Data           map[string]any `json:"data,omitempty"`
 }
 
@@ -51,6 +52,8 @@ type SourceChain struct {
 	Transaction      Transaction `json:"transaction"`
 	From             string      `json:"from"`
 	Status           string      `json:"status"`
+	BlockNumber      uint64      `json:"blockNumber,omitempty"`
+	Finality         string      `json:"finality,omitempty"`
 	Data             *Data       `json:"attribute,omitempty"`
 	Fee              *string     `json:"fee,omitempty"`
 	GasTokenNotional *string     `json:"gasTokenNotional,omitempty"`
@@ -141,6 +144,7 @@ func toOperationResponse(operation *operations.OperationDto, log *zap.Logger) (*
 		Data:        getAdditionalData(operation),
 		SourceChain: sourceChain,
 		TargetChain: targetChain,
+		Protocol:    operation.Protocol,
 	}
 
 	return &r, nil
@@ -222,6 +226,8 @@ func getChainEvents(chainID sdk.ChainID, operation *operations.OperationDto) (*S
 			Transaction:      transaction,
 			From:             operation.SourceTx.From,
 			Status:           operation.SourceTx.Status,
+			BlockNumber:      operation.SourceTx.BlockNumber,
+			Finality:         operation.SourceTx.Finality,
 			Data:             data,
 			Fee:              sourceFee,
 			FeeUSD:           sourceFeeUSD,
@@ -272,3 +278,102 @@ func toListOperationResponse(operations []*operations.OperationDto, log *zap.Log
 
 	return response
 }
+
+// Transfer status values.
+const (
+	TransferStatusPending  = "pending"
+	TransferStatusAttested = "attested"
+	TransferStatusRedeemed = "redeemed"
+)
+
+// TransferStatusResponse definition.
+type TransferStatusResponse struct {
+	ID            string                 `json:"id"`
+	Status        string                 `json:"status"`
+	FromChain     sdk.ChainID            `json:"fromChain"`
+	ToChain       *sdk.ChainID           `json:"toChain,omitempty"`
+	Vaa           []byte                 `json:"vaa,omitempty"`
+	DestinationTx *TransferDestinationTx `json:"destinationTx,omitempty"`
+}
+
+// TransferDestinationTx definition.
+type TransferDestinationTx struct {
+	ChainId   sdk.ChainID `json:"chainId"`
+	TxHash    string      `json:"txHash"`
+	Timestamp *time.Time  `json:"timestamp"`
+}
+
+// isFinal returns true when the status of a transfer can no longer change.
+func (r *TransferStatusResponse) isFinal() bool {
+	return r.Status == TransferStatusRedeemed
+}
+
+func toTransferStatusResponse(operation *operations.OperationDto, log *zap.Logger) (*TransferStatusResponse, error) {
+	chainID, _, _, err := getChainEmitterSequence(operation)
+	if err != nil {
+		log.Error("Error parsing chainId, address, sequence from operation ID",
+			zap.Error(err),
+			zap.String("operationID", operation.ID))
+		return nil, err
+	}
+
+	response := TransferStatusResponse{
+		ID:        operation.ID,
+		Status:    TransferStatusPending,
+		FromChain: chainID,
+	}
+
+	if operation.StandardizedProperties != nil && operation.StandardizedProperties.ToChain != sdk.ChainIDUnset {
+		toChain := operation.StandardizedProperties.ToChain
+		response.ToChain = &toChain
+	}
+
+	if operation.Vaa != nil {
+		response.Status = TransferStatusAttested
+		response.Vaa = operation.Vaa.Vaa
+	}
+
+	if operation.DestinationTx != nil && operation.DestinationTx.Status == domain.DstTxStatusConfirmed {
+		response.Status = TransferStatusRedeemed
+		response.DestinationTx = &TransferDestinationTx{
+			ChainId:   operation.DestinationTx.ChainID,
+			TxHash:    operation.DestinationTx.TxHash,
+			Timestamp: operation.DestinationTx.Timestamp,
+		}
+	}
+
+	return &response, nil
+}
+
+// PendingRedeemsResponse definition.
+type PendingRedeemsResponse struct {
+	TargetChain     sdk.ChainID        `json:"targetChain"`
+	AppID           string             `json:"appId"`
+	Count           int                `json:"count"`
+	OldestTimestamp *time.Time         `json:"oldestTimestamp"`
+	Vaas            []PendingRedeemVaa `json:"vaas"`
+}
+
+// PendingRedeemVaa definition.
+type PendingRedeemVaa struct {
+	ID        string     `json:"id"`
+	Timestamp *time.Time `json:"timestamp"`
+}
+
+func toPendingRedeemsResponse(pending []*operations.PendingRedeemsDto) []PendingRedeemsResponse {
+	response := make([]PendingRedeemsResponse, 0, len(pending))
+	for _, p := range pending {
+		vaas := make([]PendingRedeemVaa, 0, len(p.Vaas))
+		for _, v := range p.Vaas {
+			vaas = append(vaas, PendingRedeemVaa{ID: v.ID, Timestamp: v.Timestamp})
+		}
+		response = append(response, PendingRedeemsResponse{
+			TargetChain:     p.TargetChain,
+			AppID:           p.AppID,
+			Count:           p.Count,
+			OldestTimestamp: p.OldestTimestamp,
+			Vaas:            vaas,
+		})
+	}
+	return response
+}
@@ -0,0 +1,79 @@
+package participation
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/participation"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+// maxParticipationDays is the maximum number of days of a participation query.
+const maxParticipationDays = 90
+
+// Controller definition.
+type Controller struct {
+	srv    *participation.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *participation.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "ParticipationController")),
+	}
+}
+
+// FindGuardianParticipation godoc
+// @Description Returns the signing participation of each guardian by chain: the number of VAAs signed by the guardian,
+// @Description the number of VAAs emitted while it was part of the guardian set and the ratio between both.
+// @Description The participation is computed daily, the current day is not included.
+// @Tags wormholescan
+// @ID find-guardian-participation
+// @Param chain query integer false "emitter chain"
+// @Param from query string false "first day, format YYYY-MM-DD, default: 7 days before to."
+// @Param to query string false "last day (exclusive), format YYYY-MM-DD, default: today."
+// @Success 200 {object} []participation.GuardianParticipation
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/guardians/participation [get]
+func (c *Controller) FindGuardianParticipation(ctx *fiber.Ctx) error {
+
+	chainID, err := middleware.ExtractChainQuery(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	from, err := middleware.ExtractTime(ctx, time.DateOnly, "from")
+	if err != nil {
+		return err
+	}
+	to, err := middleware.ExtractTime(ctx, time.DateOnly, "to")
+	if err != nil {
+		return err
+	}
+	if to == nil {
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		to = &today
+	}
+	if from == nil {
+		start := to.AddDate(0, 0, -7)
+		from = &start
+	}
+	if !from.Before(*to) {
+		return response.NewInvalidParamError(ctx, "from must be before to", nil)
+	}
+	if to.Sub(*from) > maxParticipationDays*24*time.Hour {
+		return response.NewInvalidParamError(ctx, "the time range cannot be greater than 90 days", nil)
+	}
+
+	stats, err := c.srv.FindGuardianParticipation(ctx.Context(), *from, *to, chainID)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, stats)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/protocols"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 	"go.uber.org/zap"
 )
 
@@ -37,7 +38,7 @@ func (c *Controller) GetProtocolsTotalValues(ctx *fiber.Ctx) error {
 		allFailed = allFailed && len(values[i].Error) > 0
 	}
 
-	err := ctx.JSON(values)
+	err := versioning.JSON(ctx, values)
 	if allFailed && len(values) > 0 {
 		return ctx.SendStatus(fiber.StatusInternalServerError)
 	}
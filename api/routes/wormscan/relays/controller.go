@@ -7,6 +7,9 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/relays"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
@@ -42,7 +45,53 @@ func (c *Controller) FindOne(ctx *fiber.Ctx) error {
 		return err
 	}
 	response := c.makeResponse(relay)
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
+}
+
+// GetRelayerFees godoc
+// @Description Returns a summary of the relayer fees earned by each relayer address.
+// @Description Fee amounts are normalized to 8 decimals.
+// @Tags wormholescan
+// @ID get-relayer-fees
+// @Param address query string false "address of the relayer"
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Param sortOrder query string false "Sort results by number of redeems in ascending or descending order." Enums(ASC, DESC)
+// @Success 200 {object} []RelayerFeeResponse
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/relays/fees [get]
+func (c *Controller) GetRelayerFees(ctx *fiber.Ctx) error {
+
+	p, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check pagination max limit
+	if p.Limit > 1000 {
+		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+	}
+
+	relayer := middleware.ExtractAddressFromQueryParams(ctx, c.logger)
+
+	fees, err := c.srv.FindRelayerFees(ctx.Context(), relayer, p)
+	if err != nil {
+		return err
+	}
+
+	result := make([]RelayerFeeResponse, 0, len(fees))
+	for _, f := range fees {
+		result = append(result, RelayerFeeResponse{
+			Relayer:      f.ID.Relayer,
+			ChainID:      f.ID.ChainID,
+			TokenChain:   f.ID.TokenChain,
+			TokenAddress: f.ID.TokenAddress,
+			TotalFee:     f.TotalFee.String(),
+			Count:        f.Count,
+		})
+	}
+	return versioning.JSON(ctx, result)
 }
 
 func (c *Controller) makeResponse(doc *relays.RelayDoc) *RelayResponse {
@@ -114,6 +163,15 @@ type RelayResponse struct {
 	Data        *RelayDataResponse `json:"data"`
 }
 
+type RelayerFeeResponse struct {
+	Relayer      string      `json:"relayer"`
+	ChainID      sdk.ChainID `json:"chainId"`
+	TokenChain   sdk.ChainID `json:"tokenChain"`
+	TokenAddress string      `json:"tokenAddress"`
+	TotalFee     string      `json:"totalFee"`
+	Count        int64       `json:"count"`
+}
+
 type RelayDataResponse struct {
 	FromTxHash   string               `json:"fromTxHash"`
 	ToTxHash     *string              `json:"toTxHash"`
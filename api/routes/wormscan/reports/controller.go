@@ -0,0 +1,151 @@
+package reports
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/reports"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
+	"go.uber.org/zap"
+)
+
+// maxFeeRevenueDays is the maximum number of days of a fee revenue query.
+const maxFeeRevenueDays = 366
+
+// Controller definition.
+type Controller struct {
+	srv    *reports.Service
+	logger *zap.Logger
+}
+
+// NewController create a new controler.
+func NewController(srv *reports.Service, logger *zap.Logger) *Controller {
+	return &Controller{
+		srv:    srv,
+		logger: logger.With(zap.String("module", "ReportsController")),
+	}
+}
+
+// FindFeeRevenue godoc
+// @Description Returns the daily fee revenue of the bridge by chain and token: the fees set in the payload
+// @Description of the token bridge transfers and the budget paid to the standard relayer, in token units and in USD.
+// @Description The chain is the target chain of the transfers, where the fees are collected.
+// @Description The revenue is computed daily, the current day is not included.
+// @Tags wormholescan
+// @ID find-fee-revenue
+// @Param chain query integer false "target chain"
+// @Param source query string false "fee source, payload or relayer"
+// @Param from query string false "first day, format YYYY-MM-DD, default: 30 days before to."
+// @Param to query string false "last day (exclusive), format YYYY-MM-DD, default: today."
+// @Success 200 {object} []reports.FeeRevenue
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/reports/fee-revenue [get]
+func (c *Controller) FindFeeRevenue(ctx *fiber.Ctx) error {
+
+	chainID, err := middleware.ExtractChainQuery(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	source := ctx.Query("source")
+	if source != "" && source != reports.FeeSourcePayload && source != reports.FeeSourceRelayer {
+		return response.NewInvalidParamError(ctx, "source must be payload or relayer", nil)
+	}
+
+	from, err := middleware.ExtractTime(ctx, time.DateOnly, "from")
+	if err != nil {
+		return err
+	}
+	to, err := middleware.ExtractTime(ctx, time.DateOnly, "to")
+	if err != nil {
+		return err
+	}
+	if to == nil {
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		to = &today
+	}
+	if from == nil {
+		start := to.AddDate(0, 0, -30)
+		from = &start
+	}
+	if !from.Before(*to) {
+		return response.NewInvalidParamError(ctx, "from must be before to", nil)
+	}
+	if to.Sub(*from) > maxFeeRevenueDays*24*time.Hour {
+		return response.NewInvalidParamError(ctx, "the time range cannot be greater than 366 days", nil)
+	}
+
+	revenue, err := c.srv.FindFeeRevenue(ctx.Context(), reports.FeeRevenueQuery{
+		From:    *from,
+		To:      *to,
+		ChainID: chainID,
+		Source:  source,
+	})
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, revenue)
+}
+
+// FindReorgs godoc
+// @Description Returns the reorgs detected in the blocks of the origin transactions of the VAAs.
+// @Description An origin transaction is reincluded when it was included in another block after the reorg,
+// @Description or removed when it is no longer included in any block.
+// @Tags wormholescan
+// @ID find-reorgs
+// @Param chain query integer false "origin chain"
+// @Param status query string false "status of the origin transaction" Enums(reincluded, removed)
+// @Param from query string false "from date, ISO 8601 format"
+// @Param to query string false "to date, ISO 8601 format"
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Param sortOrder query string false "Sort results by detection time in ascending or descending order." Enums(ASC, DESC)
+// @Success 200 {object} []reports.Reorg
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/reports/reorgs [get]
+func (c *Controller) FindReorgs(ctx *fiber.Ctx) error {
+
+	p, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check pagination max limit
+	if p.Limit > 1000 {
+		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+	}
+
+	chainID, err := middleware.ExtractChainQuery(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	status := ctx.Query("status")
+	if status != "" && status != reports.ReorgStatusReincluded && status != reports.ReorgStatusRemoved {
+		return response.NewInvalidParamError(ctx, "status must be reincluded or removed", nil)
+	}
+
+	from, err := middleware.ExtractTime(ctx, time.RFC3339, "from")
+	if err != nil {
+		return err
+	}
+	to, err := middleware.ExtractTime(ctx, time.RFC3339, "to")
+	if err != nil {
+		return err
+	}
+
+	reorgs, err := c.srv.FindReorgs(ctx.Context(), reports.ReorgQuery{
+		ChainID: chainID,
+		Status:  status,
+		From:    from,
+		To:      to,
+	}, p)
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, reorgs)
+}
@@ -0,0 +1,68 @@
+package wormscan
+
+import (
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+)
+
+// statsMaxAge is the max-age of the analytics and statistics responses,
+// which are refreshed by the jobs and the influx tasks every few minutes.
+const statsMaxAge = 60 * time.Second
+
+// queryDefaults are the default values of the query parameters shared by all the endpoints of the API.
+// The parameters set to their default value are dropped from the canonical query string of the requests.
+var queryDefaults = map[string]string{
+	"page":                "0",
+	"pageSize":            "50",
+	"sortOrder":           "DESC",
+	"parsedPayload":       "false",
+	"includeObservations": "false",
+	"refresh":             "false",
+}
+
+// responsePolicies are the http caching policies of the endpoint families of the API,
+// relative to the version prefix. The CDN in front of the API honors them.
+var responsePolicies = []middleware.CachePolicy{
+	// monitoring and admin endpoints must never be cached.
+	{Path: "/health", NoStore: true},
+	{Path: "/ready", NoStore: true},
+	{Path: "/infrastructure", NoStore: true},
+	{Path: "/audit", NoStore: true},
+	{Path: "/address-labels", NoStore: true},
+	{Path: "/version", MaxAge: 5 * time.Minute},
+	{Path: "/routes", MaxAge: 5 * time.Minute},
+
+	// the vaas are not immutable: the parsed payload and the other joined data change
+	// on a parser backfill or when the txHash is fixed.
+	{Path: "/vaas", MaxAge: 5 * time.Second},
+	{Path: "/vaas/vaa-counts", MaxAge: statsMaxAge},
+	{Path: "/observations", MaxAge: 5 * time.Second},
+	{Path: "/operations", MaxAge: 5 * time.Second},
+	{Path: "/transfers", MaxAge: transferStatusCacheConfig.Expiration},
+	{Path: "/transactions", MaxAge: 5 * time.Second},
+	{Path: "/global-tx", MaxAge: 5 * time.Second},
+	{Path: "/relays", MaxAge: 5 * time.Second},
+	{Path: "/address", MaxAge: 5 * time.Second},
+	{Path: "/token", MaxAge: 5 * time.Minute},
+	{Path: "/governor", MaxAge: 30 * time.Second},
+	{Path: "/governance", MaxAge: 5 * time.Minute},
+	{Path: "/emitters", MaxAge: 5 * time.Minute},
+	{Path: "/contracts", MaxAge: time.Hour},
+
+	// analytics and statistics.
+	{Path: "/last-txs", MaxAge: statsMaxAge},
+	{Path: "/scorecards", MaxAge: statsMaxAge},
+	{Path: "/x-chain-activity", MaxAge: statsMaxAge},
+	{Path: "/top-assets-by-volume", MaxAge: statsMaxAge},
+	{Path: "/top-chain-pairs-by-num-transfers", MaxAge: statsMaxAge},
+	{Path: "/application-activity", MaxAge: statsMaxAge},
+	{Path: "/tokens-symbol-volume", MaxAge: statsMaxAge},
+	{Path: "/tokens-symbol-activity", MaxAge: statsMaxAge},
+	{Path: "/top-symbols-by-volume", MaxAge: statsMaxAge},
+	{Path: "/top-100-corridors", MaxAge: statsMaxAge},
+	{Path: "/protocols", MaxAge: statsMaxAge},
+	{Path: "/native-token-transfer", MaxAge: statsMaxAge},
+	{Path: "/guardians/participation", MaxAge: 5 * time.Minute},
+	{Path: "/reports", MaxAge: 5 * time.Minute},
+}
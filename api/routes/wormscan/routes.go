@@ -9,26 +9,49 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/compress"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	addrsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/address"
+	attestationssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/attestations"
+	auditsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/audit"
+	chaindatasvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/chaindata"
+	contractssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/contracts"
+	denylistsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/denylist"
+	emitterssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/emitters"
+	governancesvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/governance"
 	govsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/governor"
 	infrasvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/infrastructure"
+	labelssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/labels"
 	obssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/observations"
 	opsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/operations"
+	participationsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/participation"
 	protocolssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/protocols"
 	relayssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/relays"
+	reportssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/reports"
 	statssvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/stats"
 	trxsvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
 	vaasvc "github.com/wormhole-foundation/wormhole-explorer/api/handlers/vaa"
+	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/address"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/attestations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/chaindata"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/contracts"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/denylist"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/emitters"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/governance"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/governor"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/infrastructure"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/labels"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/observations"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/operations"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/participation"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/protocols"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/relays"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/reports"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/stats"
 
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/transactions"
 	"github.com/wormhole-foundation/wormhole-explorer/api/routes/wormscan/vaa"
+	commonAudit "github.com/wormhole-foundation/wormhole-explorer/common/audit"
 
 	"go.uber.org/zap"
 )
@@ -37,14 +60,31 @@ var cacheConfig = cache.Config{
 	Next: func(c *fiber.Ctx) bool {
 		return c.Query("refresh") == "true"
 	},
+	KeyGenerator:         middleware.CacheKey,
 	Expiration:           1 * time.Second,
-	CacheControl:         true,
+	StoreResponseHeaders: true,
+}
+
+var transferStatusCacheConfig = cache.Config{
+	Next: func(c *fiber.Ctx) bool {
+		return c.Query("refresh") == "true"
+	},
+	KeyGenerator:         middleware.CacheKey,
+	Expiration:           10 * time.Second,
 	StoreResponseHeaders: true,
 }
 
 // RegisterRoutes sets up the handlers for the Wormscan API.
+//
+// The [lowPriority] handler is registered on the searches, heavy aggregations and exports,
+// so they can be rejected while the databases are overloaded.
+// The [adminOnly] handler is registered on the routes restricted to the operators,
+// and the changes made by the operators are recorded by the [auditor].
 func RegisterRoutes(
 	notSupportedByEnv fiber.Handler,
+	lowPriority fiber.Handler,
+	adminOnly fiber.Handler,
+	auditor *commonAudit.Auditor,
 	app *fiber.App,
 	rootLogger *zap.Logger,
 	addressService *addrsvc.Service,
@@ -57,6 +97,16 @@ func RegisterRoutes(
 	operationsService *opsvc.Service,
 	statsService *statssvc.Service,
 	protocolsService *protocolssvc.Service,
+	governanceService *governancesvc.Service,
+	emittersService *emitterssvc.Service,
+	auditService *auditsvc.Service,
+	labelsService *labelssvc.Service,
+	denyListService *denylistsvc.Service,
+	participationService *participationsvc.Service,
+	attestationsService *attestationssvc.Service,
+	reportsService *reportssvc.Service,
+	contractsService *contractssvc.Service,
+	chainDataService *chaindatasvc.Service,
 ) {
 
 	// Set up controllers
@@ -65,111 +115,198 @@ func RegisterRoutes(
 	observationsCtrl := observations.NewController(obsService, rootLogger)
 	governorCtrl := governor.NewController(governorService, rootLogger)
 	infrastructureCtrl := infrastructure.NewController(infrastructureService)
-	transactionCtrl := transactions.NewController(transactionsService, rootLogger)
+	transactionCtrl := transactions.NewController(transactionsService, labelsService, rootLogger)
 	relaysCtrl := relays.NewController(relaysService, rootLogger)
 	opsCtrl := operations.NewController(operationsService, rootLogger)
 	statsCtrl := stats.NewController(statsService, rootLogger)
 	contributorsCtrl := protocols.NewController(rootLogger, protocolsService)
+	governanceCtrl := governance.NewController(governanceService, rootLogger)
+	emittersCtrl := emitters.NewController(emittersService, rootLogger)
+	auditCtrl := audit.NewController(auditService, rootLogger)
+	labelsCtrl := labels.NewController(labelsService, rootLogger)
+	denyListCtrl := denylist.NewController(denyListService, rootLogger)
+	participationCtrl := participation.NewController(participationService, rootLogger)
+	attestationsCtrl := attestations.NewController(attestationsService, rootLogger)
+	reportsCtrl := reports.NewController(reportsService, rootLogger)
+	contractsCtrl := contracts.NewController(contractsService, rootLogger)
+	chainDataCtrl := chaindata.NewController(chainDataService, rootLogger)
+
+	// The list endpoints that are only heavy when exported are low priority for the exports.
+	lowPriorityExport := versioning.ExportOnly(lowPriority)
+
+	// Set up the metadata of the routes listed by the routes endpoint.
+	catalog := newRouteCatalog(app)
+	catalog.describe(fiber.MethodGet, "/vaas", routeMetadata{cacheTTL: cacheConfig.Expiration})
+	catalog.describe(fiber.MethodGet, "/transfers/status", routeMetadata{cacheTTL: transferStatusCacheConfig.Expiration})
+	catalog.describe(fiber.MethodGet, "/audit", routeMetadata{adminOnly: true})
+	catalog.describe(fiber.MethodPut, "/address-labels", routeMetadata{adminOnly: true})
+	catalog.describe(fiber.MethodDelete, "/address-labels", routeMetadata{adminOnly: true})
+	catalog.describe(fiber.MethodPut, "/emitter-deny-list", routeMetadata{adminOnly: true})
+	catalog.describe(fiber.MethodDelete, "/emitter-deny-list", routeMetadata{adminOnly: true})
+	catalog.describe(fiber.MethodDelete, "/chains", routeMetadata{adminOnly: true})
 
-	// Set up route handlers
-	api := app.Group("/api/v1")
-	api.Use(cors.New()) // TODO CORS restrictions?
-	api.Use(compress.New(compress.Config{
-		Next: func(c *fiber.Ctx) bool {
-			endpointsToCompress := []string{"/tokens-symbol-activity", "/application-activity"}
-			path := c.Path()
-			for _, endpoint := range endpointsToCompress {
-				if strings.HasSuffix(path, endpoint) {
-					return false
+	// Set up route handlers. The same handlers are registered for every API version,
+	// the differences between versions are handled by the response mappers.
+	for _, api := range versioning.Groups(app, "/api", versioning.Supported...) {
+		api.Use(cors.New()) // TODO CORS restrictions?
+		api.Use(middleware.ResponsePolicy(app, responsePolicies))
+		api.Use(middleware.CanonicalQuery(queryDefaults))
+		api.Use(compress.New(compress.Config{
+			Next: func(c *fiber.Ctx) bool {
+				endpointsToCompress := []string{"/tokens-symbol-activity", "/application-activity"}
+				path := c.Path()
+				for _, endpoint := range endpointsToCompress {
+					if strings.HasSuffix(path, endpoint) {
+						return false
+					}
 				}
-			}
-			return true // Don't execute middleware if Next returns true
-		},
-		Level: compress.LevelBestSpeed,
-	}))
-
-	// monitoring
-	api.Get("/health", infrastructureCtrl.HealthCheck)
-	api.Get("/ready", infrastructureCtrl.ReadyCheck)
-	api.Get("/version", infrastructureCtrl.Version)
-
-	// accounts resource
-	api.Get("/address/:id", addressCtrl.FindById)
-
-	// analytics, transactions, custom endpoints
-	api.Get("/global-tx/:chain/:emitter/:sequence", transactionCtrl.FindGlobalTransactionByID)
-	api.Get("/last-txs", transactionCtrl.GetLastTransactions)
-	api.Get("/scorecards", transactionCtrl.GetScorecards)
-	api.Get("/x-chain-activity", transactionCtrl.GetChainActivity)
-	api.Get("/x-chain-activity/tops", transactionCtrl.GetChainActivityTops)
-	api.Get("/top-assets-by-volume", transactionCtrl.GetTopAssets)
-	api.Get("/top-chain-pairs-by-num-transfers", transactionCtrl.GetTopChainPairs)
-	api.Get("token/:chain/:token_address", transactionCtrl.GetTokenByChainAndAddress)
-	api.Get("/transactions", transactionCtrl.ListTransactions)
-	api.Get("/transactions/:chain/:emitter/:sequence", transactionCtrl.GetTransactionByID)
-	api.Get("/application-activity", transactionCtrl.GetApplicationActivity)
-	api.Get("/tokens-symbol-volume", transactionCtrl.GetTokensVolume)
-	api.Get("/tokens-symbol-activity", transactionCtrl.GetTokenSymbolActivity)
-
-	// stats custom endpoints
-	api.Get("/top-symbols-by-volume", statsCtrl.GetTopSymbolsByVolume)
-	api.Get("/top-100-corridors", statsCtrl.GetTopCorridors)
-	api.Get("/protocols/stats", contributorsCtrl.GetProtocolsTotalValues)
-	api.Get("/native-token-transfer/summary", notSupportedByEnv, statsCtrl.GetNativeTokenTransferSummary)
-	api.Get("/native-token-transfer/activity", notSupportedByEnv, statsCtrl.GetNativeTokenTransferActivity)
-	api.Get("/native-token-transfer/transfer-by-time", notSupportedByEnv, statsCtrl.GetNativeTokenTransferByTime)
-	api.Get("/native-token-transfer/top-address", notSupportedByEnv, statsCtrl.GetNativeTokenTransferAddressTop)
-	api.Get("/native-token-transfer/top-holder", notSupportedByEnv, statsCtrl.GetNativeTokenTransferTopHolder)
-
-	// operations resource
-	operations := api.Group("/operations")
-	operations.Get("/", opsCtrl.FindAll)
-	operations.Get("/:chain/:emitter/:sequence", opsCtrl.FindById)
-
-	// vaas resource
-	vaas := api.Group("/vaas")
-	vaas.Use(cache.New(cacheConfig))
-	vaas.Get("/vaa-counts", vaaCtrl.GetVaaCount)
-	vaas.Get("/", vaaCtrl.FindAll)
-	vaas.Get("/:chain", vaaCtrl.FindByChain)
-	vaas.Get("/:chain/:emitter", vaaCtrl.FindByEmitter)
-	vaas.Get("/:chain/:emitter/:sequence", vaaCtrl.FindById)
-	vaas.Get("/:chain/:emitter/:sequence/duplicated", vaaCtrl.FindDuplicatedById)
-	vaas.Post("/parse", vaaCtrl.ParseVaa)
-
-	// oservations resource
-	observations := api.Group("/observations")
-	observations.Get("/", observationsCtrl.FindAll)
-	observations.Get("/:chain", observationsCtrl.FindAllByChain)
-	observations.Get("/:chain/:emitter", observationsCtrl.FindAllByEmitter)
-	observations.Get("/:chain/:emitter/:sequence", observationsCtrl.FindAllByVAA)
-	observations.Get("/:chain/:emitter/:sequence/:signer/:hash", observationsCtrl.FindOne)
-
-	// governor resources
-	governor := api.Group("/governor")
-	governorLimit := governor.Group("/limit")
-	governorLimit.Get("/", governorCtrl.GetGovernorLimit)
-
-	governorConfigs := governor.Group("/config")
-	governorConfigs.Get("/", governorCtrl.FindGovernorConfigurations)
-	governorConfigs.Get("/:guardian_address", governorCtrl.FindGovernorConfigurationByGuardianAddress)
-
-	governorStatus := governor.Group("/status")
-	governorStatus.Get("/", governorCtrl.FindGovernorStatus)
-	governorStatus.Get("/:guardian_address", governorCtrl.FindGovernorStatusByGuardianAddress)
-
-	governorNotional := governor.Group("/notional")
-	governorNotional.Get("/limit/", governorCtrl.FindNotionalLimit)
-	governorNotional.Get("/limit/:chain", governorCtrl.GetNotionalLimitByChainID)
-	governorNotional.Get("/available/", governorCtrl.GetAvailableNotional)
-	governorNotional.Get("/available/:chain", governorCtrl.GetAvailableNotionalByChainID)
-	governorNotional.Get("/max_available/:chain", governorCtrl.GetMaxNotionalAvailableByChainID)
-
-	enqueueVaas := governor.Group("/enqueued_vaas")
-	enqueueVaas.Get("/", governorCtrl.GetEnqueuedVaas)
-	enqueueVaas.Get("/:chain", governorCtrl.GetEnqueuedVaasByChainID)
-	governor.Get("/vaas", governorCtrl.GetGovernorVaas)
-
-	relays := api.Group("/relays")
-	relays.Get("/:chain/:emitter/:sequence", relaysCtrl.FindOne)
+				return true // Don't execute middleware if Next returns true
+			},
+			Level: compress.LevelBestSpeed,
+		}))
+
+		// monitoring
+		api.Get("/health", infrastructureCtrl.HealthCheck)
+		api.Get("/ready", infrastructureCtrl.ReadyCheck)
+		api.Get("/version", infrastructureCtrl.Version)
+		api.Get("/routes", catalog.List)
+		api.Get("/infrastructure/gossip", infrastructureCtrl.GetGossipStats)
+		api.Get("/infrastructure/cache-stats", infrastructureCtrl.GetCacheStats)
+		api.Get("/infrastructure/lag", infrastructureCtrl.GetChainLag)
+		api.Get("/infrastructure/slo", infrastructureCtrl.GetSLO)
+
+		// accounts resource
+		api.Get("/address/:id", lowPriority, addressCtrl.FindById)
+
+		// analytics, transactions, custom endpoints
+		api.Get("/global-tx/:chain/:emitter/:sequence", transactionCtrl.FindGlobalTransactionByID)
+		api.Get("/last-txs", lowPriority, transactionCtrl.GetLastTransactions)
+		api.Get("/last-txs/forecast", lowPriority, transactionCtrl.GetTransactionForecast)
+		api.Get("/scorecards", lowPriority, transactionCtrl.GetScorecards)
+		api.Get("/x-chain-activity", lowPriority, transactionCtrl.GetChainActivity)
+		api.Get("/x-chain-activity/tops", lowPriority, transactionCtrl.GetChainActivityTops)
+		api.Get("/top-assets-by-volume", lowPriority, transactionCtrl.GetTopAssets)
+		api.Get("/top-chain-pairs-by-num-transfers", lowPriority, transactionCtrl.GetTopChainPairs)
+		api.Get("token/:chain/:token_address", transactionCtrl.GetTokenByChainAndAddress)
+		api.Get("/transactions", lowPriority, transactionCtrl.ListTransactions)
+		api.Get("/transactions/:chain/:emitter/:sequence", transactionCtrl.GetTransactionByID)
+		api.Get("/application-activity", lowPriority, transactionCtrl.GetApplicationActivity)
+		api.Get("/tokens-symbol-volume", lowPriority, transactionCtrl.GetTokensVolume)
+		api.Get("/tokens-symbol-activity", lowPriority, transactionCtrl.GetTokenSymbolActivity)
+
+		// stats custom endpoints
+		api.Get("/top-symbols-by-volume", lowPriority, statsCtrl.GetTopSymbolsByVolume)
+		api.Get("/top-100-corridors", lowPriority, statsCtrl.GetTopCorridors)
+		api.Get("/transfer-sizes", lowPriority, statsCtrl.GetTransferSizes)
+		api.Get("/protocols/stats", lowPriority, contributorsCtrl.GetProtocolsTotalValues)
+		api.Get("/native-token-transfer/summary", notSupportedByEnv, lowPriority, statsCtrl.GetNativeTokenTransferSummary)
+		api.Get("/native-token-transfer/activity", notSupportedByEnv, lowPriority, statsCtrl.GetNativeTokenTransferActivity)
+		api.Get("/native-token-transfer/transfer-by-time", notSupportedByEnv, lowPriority, statsCtrl.GetNativeTokenTransferByTime)
+		api.Get("/native-token-transfer/top-address", notSupportedByEnv, lowPriority, statsCtrl.GetNativeTokenTransferAddressTop)
+		api.Get("/native-token-transfer/top-holder", notSupportedByEnv, lowPriority, statsCtrl.GetNativeTokenTransferTopHolder)
+
+		// operations resource
+		operations := api.Group("/operations")
+		operations.Get("/", lowPriority, opsCtrl.FindAll)
+		operations.Get("/pending-redeems", lowPriority, opsCtrl.FindPendingRedeems)
+		operations.Get("/:chain/:emitter/:sequence", opsCtrl.FindById)
+
+		// transfers resource
+		transfers := api.Group("/transfers")
+		transfers.Get("/status", cache.New(transferStatusCacheConfig), opsCtrl.GetTransferStatus)
+
+		// vaas resource
+		vaas := api.Group("/vaas")
+		vaas.Use(cache.New(cacheConfig))
+		vaas.Get("/vaa-counts", lowPriority, vaaCtrl.GetVaaCount)
+		vaas.Get("/conflicts", vaaCtrl.FindConflicts)
+		vaas.Get("/recent", vaaCtrl.FindRecent)
+		vaas.Get("/", vaaCtrl.FindAll)
+		vaas.Get("/:chain", vaaCtrl.FindByChain)
+		vaas.Get("/:chain/:emitter", vaaCtrl.FindByEmitter)
+		vaas.Get("/:chain/:emitter/:sequence", vaaCtrl.FindById)
+		vaas.Get("/:chain/:emitter/:sequence/duplicated", vaaCtrl.FindDuplicatedById)
+		vaas.Post("/parse", vaaCtrl.ParseVaa)
+
+		// oservations resource
+		observations := api.Group("/observations")
+		observations.Get("/", lowPriorityExport, observationsCtrl.FindAll)
+		observations.Get("/:chain", lowPriorityExport, observationsCtrl.FindAllByChain)
+		observations.Get("/:chain/:emitter", lowPriorityExport, observationsCtrl.FindAllByEmitter)
+		observations.Get("/:chain/:emitter/:sequence", lowPriorityExport, observationsCtrl.FindAllByVAA)
+		observations.Get("/:chain/:emitter/:sequence/:signer/:hash", observationsCtrl.FindOne)
+
+		// governor resources
+		governor := api.Group("/governor")
+		governorLimit := governor.Group("/limit")
+		governorLimit.Get("/", governorCtrl.GetGovernorLimit)
+		governorLimit.Get("/changes", governorCtrl.FindLimitChanges)
+
+		governorConfigs := governor.Group("/config")
+		governorConfigs.Get("/", governorCtrl.FindGovernorConfigurations)
+		governorConfigs.Get("/:guardian_address", governorCtrl.FindGovernorConfigurationByGuardianAddress)
+
+		governorStatus := governor.Group("/status")
+		governorStatus.Get("/", governorCtrl.FindGovernorStatus)
+		governorStatus.Get("/freshness", governorCtrl.FindGovernorStatusFreshness)
+		governorStatus.Get("/:guardian_address", governorCtrl.FindGovernorStatusByGuardianAddress)
+
+		governorNotional := governor.Group("/notional")
+		governorNotional.Get("/limit/", governorCtrl.FindNotionalLimit)
+		governorNotional.Get("/limit/:chain", governorCtrl.GetNotionalLimitByChainID)
+		governorNotional.Get("/available/", governorCtrl.GetAvailableNotional)
+		governorNotional.Get("/available/:chain", governorCtrl.GetAvailableNotionalByChainID)
+		governorNotional.Get("/max_available/:chain", governorCtrl.GetMaxNotionalAvailableByChainID)
+
+		enqueueVaas := governor.Group("/enqueued_vaas")
+		enqueueVaas.Get("/", lowPriorityExport, governorCtrl.GetEnqueuedVaas)
+		enqueueVaas.Get("/:chain", lowPriorityExport, governorCtrl.GetEnqueuedVaasByChainID)
+		governor.Get("/vaas", governorCtrl.GetGovernorVaas)
+		governor.Get("/vaas/:chain/:emitter/:sequence", governorCtrl.FindGovernorVaaByID)
+
+		relays := api.Group("/relays")
+		relays.Get("/fees", lowPriority, relaysCtrl.GetRelayerFees)
+		relays.Get("/:chain/:emitter/:sequence", relaysCtrl.FindOne)
+
+		// governance resources
+		governance := api.Group("/governance")
+		governance.Get("/actions", governanceCtrl.FindGovernanceActions)
+
+		// token attestations resources
+		tokenAttestations := api.Group("/token-attestations")
+		tokenAttestations.Get("/", attestationsCtrl.FindTokenAttestations)
+		tokenAttestations.Get("/:chain/:token_address", attestationsCtrl.FindTokenAttestationsByToken)
+
+		// guardian signing participation
+		api.Get("/guardians/participation", lowPriority, participationCtrl.FindGuardianParticipation)
+
+		// ecosystem reports
+		api.Get("/reports/fee-revenue", reportsCtrl.FindFeeRevenue)
+		api.Get("/reports/reorgs", reportsCtrl.FindReorgs)
+
+		// address book of the wormhole contracts
+		api.Get("/contracts", contractsCtrl.FindContracts)
+
+		// registered emitters resource
+		api.Get("/emitters", emittersCtrl.FindRegisteredEmitters)
+		api.Get("/emitters/anomalies", lowPriority, statsCtrl.GetEmitterRateAnomalies)
+
+		// audit records of the admin actions
+		api.Get("/audit", adminOnly, auditCtrl.FindRecords)
+
+		// address labels resource
+		addressLabels := api.Group("/address-labels")
+		addressLabels.Get("/", labelsCtrl.FindAll)
+		addressLabels.Put("/:address", adminOnly, auditor.Middleware("address-label.upsert"), labelsCtrl.Upsert)
+		addressLabels.Delete("/:address", adminOnly, auditor.Middleware("address-label.delete"), labelsCtrl.Delete)
+
+		// emitter deny-list resource
+		emitterDenyList := api.Group("/emitter-deny-list")
+		emitterDenyList.Get("/", denyListCtrl.FindAll)
+		emitterDenyList.Put("/:chain/:emitter", adminOnly, auditor.Middleware("emitter-deny-list.upsert"), denyListCtrl.Upsert)
+		emitterDenyList.Delete("/:chain/:emitter", adminOnly, auditor.Middleware("emitter-deny-list.delete"), denyListCtrl.Delete)
+
+		// wipe the data of a chain redeployed in the testnet
+		api.Delete("/chains/:chain/data", adminOnly, auditor.Middleware("chain-data.wipe"), chainDataCtrl.Wipe)
+	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/stats"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 	"go.uber.org/zap"
 )
 
@@ -56,7 +57,7 @@ func (c *Controller) GetTopSymbolsByVolume(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(TopSymbolByVolumeResult{Symbols: symbols})
+	return versioning.JSON(ctx, TopSymbolByVolumeResult{Symbols: symbols})
 }
 
 func (c *Controller) createTopSymbolsByVolumeResult(assets []stats.SymbolWithAssetDTO) ([]*TopSymbolResult, error) {
@@ -121,7 +122,7 @@ func (c *Controller) GetTopCorridors(ctx *fiber.Ctx) error {
 
 	result := createTop100CorridorsResult(corridors)
 
-	return ctx.JSON(TopCorridorsResult{Corridors: result})
+	return versioning.JSON(ctx, TopCorridorsResult{Corridors: result})
 }
 
 func createTop100CorridorsResult(corridors []stats.TopCorridorsDTO) []*TopCorridor {
@@ -167,7 +168,7 @@ func (c *Controller) GetNativeTokenTransferSummary(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
 }
 
 // GetNativeTokenTransferActivity godoc
@@ -196,7 +197,7 @@ func (c *Controller) GetNativeTokenTransferActivity(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
 }
 
 // GetNativeTokenTransferByTime godoc
@@ -242,7 +243,7 @@ func (c *Controller) GetNativeTokenTransferByTime(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
 }
 
 // GetNativeTokenTransferAddressTop godoc
@@ -270,7 +271,7 @@ func (c *Controller) GetNativeTokenTransferAddressTop(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
 }
 
 // GetNativeTokenTransferTopHolder godoc
@@ -294,5 +295,46 @@ func (c *Controller) GetNativeTokenTransferTopHolder(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(holders)
+	return versioning.JSON(ctx, holders)
+}
+
+// GetEmitterRateAnomalies godoc
+// @Description Returns the emitters whose message rate in the last hour is at least 10 times their rate in the previous 7 days,
+// @Description and the new emitters with many messages in the last hour, to spot abuses or protocol incidents.
+// @Description The emitters with less than 100 messages in the last hour are not reported.
+// @Tags wormholescan
+// @ID get-emitter-rate-anomalies
+// @Success 200 {object} []stats.EmitterRateAnomaly
+// @Failure 500
+// @Router /api/v1/emitters/anomalies [get]
+func (c *Controller) GetEmitterRateAnomalies(ctx *fiber.Ctx) error {
+	anomalies, err := c.srv.GetEmitterRateAnomalies(ctx.Context())
+	if err != nil {
+		c.logger.Error("Error getting emitter rate anomalies", zap.Error(err))
+		return err
+	}
+	return versioning.JSON(ctx, anomalies)
+}
+
+// GetTransferSizes godoc
+// @Description Returns the number of token transfers and their average and median size in USD by emitter chain and by token.
+// @Description The transfers without a known token price are not included.
+// @Tags wormholescan
+// @ID get-transfer-sizes
+// @Param timeSpan query string false "Time span, supported values: 1d, 7d and 30d (default is 7d)."
+// @Success 200 {object} stats.TransferSizes
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/transfer-sizes [get]
+func (c *Controller) GetTransferSizes(ctx *fiber.Ctx) error {
+	timeSpan, err := middleware.ExtractTransferSizeTimeSpan(ctx)
+	if err != nil {
+		return err
+	}
+	sizes, err := c.srv.GetTransferSizes(ctx.Context(), *timeSpan)
+	if err != nil {
+		c.logger.Error("Error getting transfer sizes", zap.Error(err))
+		return err
+	}
+	return versioning.JSON(ctx, sizes)
 }
@@ -1,16 +1,20 @@
 package transactions
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/labels"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
 	"github.com/wormhole-foundation/wormhole-explorer/api/internal/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/api/internal/pagination"
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -19,13 +23,15 @@ import (
 // Controller is the controller for the transactions resource.
 type Controller struct {
 	srv    *transactions.Service
+	labels *labels.Service
 	logger *zap.Logger
 }
 
 // NewController create a new controler.
-func NewController(transactionsService *transactions.Service, logger *zap.Logger) *Controller {
+func NewController(transactionsService *transactions.Service, labelsService *labels.Service, logger *zap.Logger) *Controller {
 	return &Controller{
 		srv:    transactionsService,
+		labels: labelsService,
 		logger: logger.With(zap.String("module", "TransactionsController")),
 	}
 }
@@ -57,7 +63,48 @@ func (c *Controller) GetLastTransactions(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(lastTrx)
+	return versioning.JSON(ctx, lastTrx)
+}
+
+// GetTransactionForecast godoc
+// @Description Returns the projected number of messages by emitter chain for the next 24 hours, by hour.
+// @Description The projection is computed from the hourly message counts of the last `days` days.
+// @Tags wormholescan
+// @ID get-transaction-forecast
+// @Param chain query integer false "emitter chain"
+// @Param days query integer false "days of history used to compute the forecast, default: 7, maximum: 30."
+// @Param method query string false "forecast method, default: seasonal-naive." Enums(seasonal-naive, moving-average)
+// @Success 200 {object} []transactions.TransactionForecast
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/last-txs/forecast [get]
+func (c *Controller) GetTransactionForecast(ctx *fiber.Ctx) error {
+	chainID, err := middleware.ExtractChainQuery(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
+	days := ctx.QueryInt("days", 7)
+	if days < 1 || days > 30 {
+		return response.NewInvalidParamError(ctx, "days must be between 1 and 30", nil)
+	}
+
+	method := transactions.ForecastMethod(ctx.Query("method", string(transactions.SeasonalNaive)))
+	if !method.IsValid() {
+		return response.NewInvalidParamError(ctx, "invalid method", nil)
+	}
+
+	q := &transactions.TransactionForecastQuery{
+		Days:    days,
+		Method:  method,
+		ChainID: chainID,
+	}
+	forecast, err := c.srv.GetTransactionForecast(ctx.Context(), q)
+	if err != nil {
+		return err
+	}
+
+	return versioning.JSON(ctx, forecast)
 }
 
 // GetScorecards godoc
@@ -70,11 +117,18 @@ func (c *Controller) GetLastTransactions(ctx *fiber.Ctx) error {
 // @Description Total messages is the number of VAAs emitted since the creation of the network (includes Pyth messages).
 // @Tags wormholescan
 // @ID get-scorecards
+// @Param format query string false "Include human-readable values alongside the raw values." Enums(raw, human)
 // @Success 200 {object} ScorecardsResponse
 // @Failure 500
 // @Router /api/v1/scorecards [get]
 func (c *Controller) GetScorecards(ctx *fiber.Ctx) error {
 
+	// Extract query parameters
+	human, err := middleware.ExtractHumanFormat(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Query indicators from the database
 	scorecards, err := c.srv.GetScorecards(ctx.Context())
 	if err != nil {
@@ -93,7 +147,10 @@ func (c *Controller) GetScorecards(ctx *fiber.Ctx) error {
 		Volume7d:      scorecards.Volume7d,
 		Volume30d:     scorecards.Volume30d,
 	}
-	return ctx.JSON(resp)
+	if human {
+		resp.Human = c.srv.HumanizeScorecards(scorecards)
+	}
+	return versioning.JSON(ctx, resp)
 }
 
 // GetTopChainPairs godoc
@@ -132,7 +189,7 @@ func (c *Controller) GetTopChainPairs(ctx *fiber.Ctx) error {
 		response.ChainPairs = append(response.ChainPairs, chainPair)
 	}
 
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
 }
 
 // GetTopAssets godoc
@@ -141,6 +198,7 @@ func (c *Controller) GetTopChainPairs(ctx *fiber.Ctx) error {
 // @Tags wormholescan
 // @ID get-top-assets-by-volume
 // @Param timeSpan query string true "Time span, supported values: 7d, 15d, 30d."
+// @Param format query string false "Include human-readable values alongside the raw values." Enums(raw, human)
 // @Success 200 {object} TopAssetsResponse
 // @Failure 500
 // @Router /api/v1/top-assets-by-volume [get]
@@ -151,6 +209,10 @@ func (c *Controller) GetTopAssets(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
+	human, err := middleware.ExtractHumanFormat(ctx)
+	if err != nil {
+		return err
+	}
 
 	// Query assets from the database
 	assetDTOs, err := c.srv.GetTopAssets(ctx.Context(), timeSpan)
@@ -171,6 +233,9 @@ func (c *Controller) GetTopAssets(ctx *fiber.Ctx) error {
 			TokenAddress: assetDTOs[i].TokenAddress,
 			Volume:       assetDTOs[i].Volume,
 		}
+		if human {
+			asset.Human = c.srv.HumanizeAsset(&assetDTOs[i])
+		}
 
 		// Look up the token symbol
 		tokenMeta, ok := c.srv.GetTokenProvider().GetTokenByAddress(assetDTOs[i].TokenChain, assetDTOs[i].TokenAddress)
@@ -181,7 +246,7 @@ func (c *Controller) GetTopAssets(ctx *fiber.Ctx) error {
 		response.Assets = append(response.Assets, asset)
 	}
 
-	return ctx.JSON(response)
+	return versioning.JSON(ctx, response)
 }
 
 // GetApplicationActivity godoc
@@ -256,7 +321,7 @@ func (c *Controller) GetApplicationActivity(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(activity)
+	return versioning.JSON(ctx, activity)
 
 }
 
@@ -271,6 +336,7 @@ func (c *Controller) GetApplicationActivity(ctx *fiber.Ctx) error {
 // @Param appId query string false "Search by appId"
 // @Param sourceChain query string false "Search by sourceChain"
 // @Param targetChain query string false "Search by targetChain"
+// @Param tz query string false "IANA time zone that aligns the days, months and years, e.g. America/New_York. Default: UTC"
 // @Success 200 {object} transactions.ChainActivityTopResults
 // @Failure 400
 // @Failure 500
@@ -297,6 +363,11 @@ func (c *Controller) GetChainActivityTops(ctx *fiber.Ctx) error {
 		return response.NewInvalidParamError(ctx, "missing from/to query params ", nil)
 	}
 
+	loc, err := middleware.ExtractTimeZone(ctx)
+	if err != nil {
+		return err
+	}
+
 	payload := transactions.ChainActivityTopsQuery{
 		SourceChains: sourceChains,
 		TargetChains: targetChains,
@@ -304,6 +375,7 @@ func (c *Controller) GetChainActivityTops(ctx *fiber.Ctx) error {
 		To:           *to,
 		AppId:        middleware.ExtractAppId(ctx, c.logger),
 		Timespan:     transactions.Timespan(ctx.Query("timespan")),
+		Location:     loc,
 	}
 
 	if !payload.Timespan.IsValid() {
@@ -326,7 +398,7 @@ func (c *Controller) GetChainActivityTops(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(activity)
+	return versioning.JSON(ctx, activity)
 }
 
 // GetChainActivity godoc
@@ -376,7 +448,7 @@ func (c *Controller) GetChainActivity(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(ChainActivity{Txs: txs})
+	return versioning.JSON(ctx, ChainActivity{Txs: txs})
 }
 
 func (c *Controller) createChainActivityResponse(activity []transactions.ChainActivityResult, isNotional bool) ([]Tx, error) {
@@ -460,7 +532,7 @@ func (c *Controller) FindGlobalTransactionByID(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(globalTransaction)
+	return versioning.JSON(ctx, globalTransaction)
 }
 
 func convertToDecimal(amount decimal.Decimal) decimal.Decimal {
@@ -494,7 +566,7 @@ func (c *Controller) GetTokenByChainAndAddress(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(token)
+	return versioning.JSON(ctx, token)
 }
 
 // ListTransactions godoc
@@ -504,7 +576,9 @@ func (c *Controller) GetTokenByChainAndAddress(ctx *fiber.Ctx) error {
 // @Param page query integer false "Page number. Starts at 0."
 // @Param pageSize query integer false "Number of elements per page."
 // @Param sortOrder query string false "Sort results in ascending or descending order." Enums(ASC, DESC)
-// @Param address query string false "Filter transactions by Address."
+// @Param address query string false "Filter transactions by Address. The transactions include their direction and signed amount relative to the address."
+// @Param appId query string false "Filter transactions by application ID, e.g. PORTAL_TOKEN_BRIDGE, CCTP_WORMHOLE_INTEGRATION or STANDARD_RELAYER."
+// @Param format query string false "Include human-readable values alongside the raw values, or export all the transactions from the page as CSV or NDJSON, reading pages of pageSize transactions." Enums(raw, human, csv, ndjson)
 // @Success 200 {object} ListTransactionsResponse
 // @Failure 400
 // @Failure 500
@@ -512,48 +586,91 @@ func (c *Controller) GetTokenByChainAndAddress(ctx *fiber.Ctx) error {
 func (c *Controller) ListTransactions(ctx *fiber.Ctx) error {
 
 	// Extract query parameters
-	pagination, err := middleware.ExtractPagination(ctx)
+	p, err := middleware.ExtractPagination(ctx)
 	if err != nil {
 		return err
 	}
 	address := middleware.ExtractAddressFromQueryParams(ctx, c.logger)
+	appID := middleware.ExtractAppId(ctx, c.logger)
+	exportFormat, export := versioning.ExportFormatFromQuery(ctx)
+	var human bool
+	if !export {
+		human, err = middleware.ExtractHumanFormat(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if address != "" && appID != "" {
+		return response.NewInvalidParamError(ctx, "address and appId cannot be used together", nil)
+	}
 
 	// Check pagination max limit
-	if pagination.Limit > 1000 {
+	if p.Limit > 1000 {
 		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
 	}
 
 	// Query transactions from the database
-	var dtos []transactions.TransactionDto
-	if address != "" {
-		dtos, err = c.srv.ListTransactionsByAddress(ctx.Context(), address, pagination)
-	} else {
-		dtos, err = c.srv.ListTransactions(ctx.Context(), pagination)
+	listTransactions := func(ctx context.Context, page *pagination.Pagination) ([]*TransactionDetail, error) {
+		var dtos []transactions.TransactionDto
+		var err error
+		if address != "" {
+			dtos, err = c.srv.ListTransactionsByAddress(ctx, address, page)
+		} else {
+			dtos, err = c.srv.ListTransactions(ctx, appID, page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return c.makeTransactionsResponse(ctx, dtos, human).Transactions, nil
 	}
+	if export {
+		return versioning.Export(ctx, exportFormat, p, listTransactions, transactionCursor, c.logger)
+	}
+
+	// Populate the response struct and return
+	txs, err := listTransactions(ctx.Context(), p)
 	if err != nil {
 		return err
 	}
+	return versioning.JSON(ctx, ListTransactionsResponse{Transactions: txs})
+}
 
-	// Populate the response struct and return
-	response := c.makeTransactionsResponse(dtos)
-	return ctx.JSON(response)
+// transactionCursor returns the position of a transaction, the transactions are sorted by
+// timestamp and id.
+func transactionCursor(tx *TransactionDetail) *pagination.Cursor {
+	return &pagination.Cursor{Timestamp: tx.Timestamp, ID: tx.ID}
 }
 
-func (c *Controller) makeTransactionsResponse(dtos []transactions.TransactionDto) ListTransactionsResponse {
+func (c *Controller) makeTransactionsResponse(ctx context.Context, dtos []transactions.TransactionDto, human bool) ListTransactionsResponse {
 
 	response := ListTransactionsResponse{
 		Transactions: make([]*TransactionDetail, 0, len(dtos)),
 	}
 
+	addressLabels := c.getLabels(ctx)
 	for i := range dtos {
-		tx := c.makeTransactionDetail(&dtos[i])
+		tx := c.makeTransactionDetail(&dtos[i], human, addressLabels)
 		response.Transactions = append(response.Transactions, tx)
 	}
 
 	return response
 }
 
-func (c *Controller) makeTransactionDetail(input *transactions.TransactionDto) *TransactionDetail {
+// getLabels returns the address labels to label the transactions.
+// The labels are optional, the transactions are returned without labels if they cannot be loaded.
+func (c *Controller) getLabels(ctx context.Context) labels.Labels {
+	if c.labels == nil {
+		return nil
+	}
+	addressLabels, err := c.labels.GetLabels(ctx)
+	if err != nil {
+		c.logger.Warn("failed to get address labels", zap.Error(err))
+		return nil
+	}
+	return addressLabels
+}
+
+func (c *Controller) makeTransactionDetail(input *transactions.TransactionDto, human bool, addressLabels labels.Labels) *TransactionDetail {
 
 	tx := TransactionDetail{
 		ID:                     input.ID,
@@ -565,6 +682,11 @@ func (c *Controller) makeTransactionDetail(input *transactions.TransactionDto) *
 		UsdAmount:              input.UsdAmount,
 		Payload:                input.Payload,
 		StandardizedProperties: input.StandardizedProperties,
+		Direction:              input.Direction,
+		SignedAmount:           input.SignedAmount,
+	}
+	if human {
+		tx.Human = c.srv.HumanizeTransaction(input)
 	}
 
 	// Translate the emitter address into the emitter chain's native format
@@ -593,11 +715,36 @@ func (c *Controller) makeTransactionDetail(input *transactions.TransactionDto) *
 	// Set the global transaction, if available
 	if len(input.GlobalTransations) == 1 {
 		tx.GlobalTx = &input.GlobalTransations[0]
+		tx.Fees = tx.GlobalTx.Fees()
+	}
+
+	// Label the known addresses
+	if len(addressLabels) > 0 {
+		tx.Labels = addressLabels.Collect(transactionAddresses(&tx)...)
 	}
 
 	return &tx
 }
 
+// transactionAddresses returns the addresses of a transaction that can be labeled.
+func transactionAddresses(tx *TransactionDetail) []string {
+	addresses := []string{tx.EmitterNativeAddress}
+	for _, key := range []string{"fromAddress", "toAddress"} {
+		if address, ok := tx.StandardizedProperties[key].(string); ok {
+			addresses = append(addresses, address)
+		}
+	}
+	if tx.GlobalTx != nil {
+		if tx.GlobalTx.OriginTx != nil {
+			addresses = append(addresses, tx.GlobalTx.OriginTx.From)
+		}
+		if tx.GlobalTx.DestinationTx != nil {
+			addresses = append(addresses, tx.GlobalTx.DestinationTx.From, tx.GlobalTx.DestinationTx.To)
+		}
+	}
+	return addresses
+}
+
 // GetTransactionByID godoc
 // @Description Find VAA metadata by ID.
 // @Tags wormholescan
@@ -605,6 +752,7 @@ func (c *Controller) makeTransactionDetail(input *transactions.TransactionDto) *
 // @Param chain_id path integer true "id of the blockchain"
 // @Param emitter path string true "address of the emitter"
 // @Param seq path integer true "sequence of the VAA"
+// @Param format query string false "Include human-readable values alongside the raw values." Enums(raw, human)
 // @Success 200 {object} TransactionDetail
 // @Failure 400
 // @Failure 500
@@ -616,6 +764,10 @@ func (c *Controller) GetTransactionByID(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
+	human, err := middleware.ExtractHumanFormat(ctx)
+	if err != nil {
+		return err
+	}
 
 	// Look up the VAA by ID
 	dto, err := c.srv.GetTransactionByID(
@@ -631,8 +783,8 @@ func (c *Controller) GetTransactionByID(ctx *fiber.Ctx) error {
 		return errors.ErrNotFound
 	}
 
-	tx := c.makeTransactionDetail(dto)
-	return ctx.JSON(tx)
+	tx := c.makeTransactionDetail(dto, human, c.getLabels(ctx.Context()))
+	return versioning.JSON(ctx, tx)
 }
 
 func (c *Controller) GetTokensVolume(ctx *fiber.Ctx) error {
@@ -644,7 +796,7 @@ func (c *Controller) GetTokensVolume(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(tokens)
+	return versioning.JSON(ctx, tokens)
 }
 
 func (c *Controller) GetTokenSymbolActivity(ctx *fiber.Ctx) error {
@@ -676,6 +828,11 @@ func (c *Controller) GetTokenSymbolActivity(ctx *fiber.Ctx) error {
 		}
 	}
 
+	loc, err := middleware.ExtractTimeZone(ctx)
+	if err != nil {
+		return err
+	}
+
 	payload := transactions.TokenSymbolActivityQuery{
 		From:         *from,
 		To:           *to,
@@ -683,6 +840,7 @@ func (c *Controller) GetTokenSymbolActivity(ctx *fiber.Ctx) error {
 		Timespan:     transactions.Timespan(ctx.Query("timespan")),
 		SourceChains: sourceChains,
 		TargetChains: targetChains,
+		Location:     loc,
 	}
 
 	if payload.Timespan != transactions.Hour && payload.Timespan != transactions.Day && payload.Timespan != transactions.Month {
@@ -717,6 +875,6 @@ func (c *Controller) GetTokenSymbolActivity(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(activity)
+	return versioning.JSON(ctx, activity)
 
 }
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/labels"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
@@ -952,7 +954,7 @@ func Test_Controller_createChainActivityResponse(t *testing.T) {
 	err := json.Unmarshal([]byte(activityJSON), &activity)
 	assert.NoError(t, err)
 
-	controller := NewController(nil, zap.NewExample())
+	controller := NewController(nil, nil, zap.NewExample())
 	result, err := controller.createChainActivityResponse(activity, false)
 	assert.NoError(t, err)
 
@@ -967,3 +969,33 @@ func Test_Controller_createChainActivityResponse(t *testing.T) {
 	}
 	assert.Equal(t, 100, int(math.Round(totalPercentage)))
 }
+
+func Test_Controller_makeTransactionDetail_labels(t *testing.T) {
+
+	tokenBridge := &labels.Label{Address: "0x3ee18b2214aff97000d974cf647e7c347e8fa585", Name: "Token Bridge: Ethereum", Category: labels.CategoryContract}
+	exchange := &labels.Label{Address: "0x28c6c06298d514db089934071355e5743bf21d60", Name: "Binance hot wallet", Category: labels.CategoryExchange}
+	addressLabels := labels.Labels{tokenBridge.Address: tokenBridge, exchange.Address: exchange}
+
+	dto := &transactions.TransactionDto{
+		ID:           "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1",
+		EmitterChain: sdk.ChainIDEthereum,
+		EmitterAddr:  "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585",
+		StandardizedProperties: map[string]interface{}{
+			"fromAddress": "0x28C6c06298d514Db089934071355E5743bf21d60",
+			"toAddress":   "0x8d5e2ab1c2b7c3f1b3a4b6d2b4c5e6f7a8b9c0d1",
+		},
+		GlobalTransations: []transactions.GlobalTransactionDoc{{
+			OriginTx: &transactions.OriginTx{From: "0x28C6c06298d514Db089934071355E5743bf21d60"},
+		}},
+	}
+
+	controller := NewController(nil, nil, zap.NewExample())
+	tx := controller.makeTransactionDetail(dto, false, addressLabels)
+	assert.Equal(t, map[string]*labels.Label{
+		"0x3ee18b2214aff97000d974cf647e7c347e8fa585": tokenBridge,
+		"0x28C6c06298d514Db089934071355E5743bf21d60": exchange,
+	}, tx.Labels)
+
+	tx = controller.makeTransactionDetail(dto, false, nil)
+	assert.Nil(t, tx.Labels)
+}
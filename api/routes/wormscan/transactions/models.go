@@ -3,6 +3,7 @@ package transactions
 import (
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/labels"
 	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -23,6 +24,16 @@ type TransactionDetail struct {
 	Payload                map[string]interface{}             `json:"payload,omitempty"`
 	StandardizedProperties map[string]interface{}             `json:"standardizedProperties,omitempty"`
 	GlobalTx               *transactions.GlobalTransactionDoc `json:"globalTx,omitempty"`
+	// Fees contains the fees in USD paid by the origin and destination transactions, when known.
+	Fees *transactions.TransactionFees `json:"fees,omitempty"`
+	// Human contains the human-readable fields, only included when `format=human` is requested.
+	Human *transactions.TransactionHuman `json:"human,omitempty"`
+	// Labels contains the labels of the known addresses of the transaction, indexed by address.
+	Labels map[string]*labels.Label `json:"labels,omitempty"`
+	// Direction is the direction of the transaction relative to the address the transactions are listed by.
+	Direction transactions.TransferDirection `json:"direction,omitempty"`
+	// SignedAmount is the token amount, negative when the address sent the tokens.
+	SignedAmount string `json:"signedAmount,omitempty"`
 }
 
 // ListTransactionsResponse is the "200 OK" response model for `GET /api/v1/transactions`.
@@ -2,6 +2,7 @@ package transactions
 
 import (
 	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/api/handlers/transactions"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -48,6 +49,9 @@ type ScorecardsResponse struct {
 
 	// Volume transferred through the token bridge in the last 24 hours, in USD.
 	Volume30d string `json:"30d_volume"`
+
+	// Human-readable USD values, only included when `format=human` is requested.
+	Human *transactions.ScorecardsHuman `json:"human,omitempty"`
 }
 
 // TopAssetsResponse is the "200 OK" response model for `GET /api/v1/top-assets-by-volume`.
@@ -56,11 +60,12 @@ type TopAssetsResponse struct {
 }
 
 type AssetWithVolume struct {
-	EmitterChain sdk.ChainID `json:"emitterChain"`
-	Symbol       string      `json:"symbol,omitempty"`
-	TokenChain   sdk.ChainID `json:"tokenChain"`
-	TokenAddress string      `json:"tokenAddress"`
-	Volume       string      `json:"volume"`
+	EmitterChain sdk.ChainID              `json:"emitterChain"`
+	Symbol       string                   `json:"symbol,omitempty"`
+	TokenChain   sdk.ChainID              `json:"tokenChain"`
+	TokenAddress string                   `json:"tokenAddress"`
+	Volume       string                   `json:"volume"`
+	Human        *transactions.AssetHuman `json:"human,omitempty"`
 }
 
 // TopChainPairsResponse is the "200 OK" response model for `GET /api/v1/top-chain-pairs-by-num-transfers`.
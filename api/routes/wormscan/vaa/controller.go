@@ -3,7 +3,9 @@ package vaa
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"strconv"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/pkg/errors"
@@ -11,6 +13,7 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/api/middleware"
 	"github.com/wormhole-foundation/wormhole-explorer/api/response"
 	_ "github.com/wormhole-foundation/wormhole-explorer/api/response" // required by swaggo
+	"github.com/wormhole-foundation/wormhole-explorer/api/routes/versioning"
 	"go.uber.org/zap"
 )
 
@@ -76,7 +79,7 @@ func (c *Controller) FindAll(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
-	return ctx.JSON(vaas)
+	return versioning.JSON(ctx, vaas)
 }
 
 // FindByChain godoc
@@ -113,7 +116,7 @@ func (c *Controller) FindByChain(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(vaas)
+	return versioning.JSON(ctx, vaas)
 }
 
 // FindByEmitter godoc
@@ -169,7 +172,7 @@ func (c *Controller) FindByEmitter(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(vaas)
+	return versioning.JSON(ctx, vaas)
 }
 
 // FindById godoc
@@ -180,6 +183,7 @@ func (c *Controller) FindByEmitter(ctx *fiber.Ctx) error {
 // @Param emitter path string true "address of the emitter"
 // @Param seq path integer true "sequence of the VAA"
 // @Param parsedPayload query bool false "include the parsed contents of the VAA, if available"
+// @Param includeObservations query bool false "include a summary of the guardian observations of the VAA"
 // @Success 200 {object} response.Response[[]vaa.VaaDoc]
 // @Failure 400
 // @Failure 500
@@ -196,6 +200,11 @@ func (c *Controller) FindById(ctx *fiber.Ctx) error {
 		return err
 	}
 
+	includeObservations, err := middleware.ExtractIncludeObservations(ctx, c.logger)
+	if err != nil {
+		return err
+	}
+
 	vaa, err := c.srv.FindById(
 		ctx.Context(),
 		chainID,
@@ -206,7 +215,23 @@ func (c *Controller) FindById(ctx *fiber.Ctx) error {
 	if err != nil {
 		return err
 	}
-	return ctx.JSON(vaa)
+
+	err = c.srv.AddQuorumProgress(
+		ctx.Context(),
+		chainID,
+		emitter,
+		strconv.FormatUint(seq, 10),
+		vaa.Data,
+		includeObservations,
+	)
+	if err != nil {
+		return err
+	}
+	c.srv.AddSigners(ctx.Context(), vaa.Data)
+	if vaa.Data.UpdatedAt != nil {
+		middleware.SetLastModified(ctx, *vaa.Data.UpdatedAt)
+	}
+	return versioning.JSON(ctx, vaa)
 }
 
 // GetVaaCount godoc
@@ -224,16 +249,16 @@ func (c *Controller) GetVaaCount(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(vaas)
+	return versioning.JSON(ctx, vaas)
 }
 
 // ParseVaa godoc
-// @Description Parse a VAA.
+// @Description Parse a VAA encoded in hex or base64.
+// @Description Returns the header of the VAA and the payload of the governance, token bridge and nft bridge VAAs.
 // @Tags wormholescan
 // @ID parse-vaa
-// @Success 200 {object} parser.ParseVaaWithStandarizedPropertiesdResponse
+// @Success 200 {object} vaa.ParsedVaa
 // @Failure 400
-// @Failure 404
 // @Failure 500
 // @Router /api/v1/vaas/parse [post]
 func (c *Controller) ParseVaa(ctx *fiber.Ctx) error {
@@ -256,10 +281,10 @@ func (c *Controller) ParseVaa(ctx *fiber.Ctx) error {
 			nil)
 	}
 
-	vaa, err := base64.StdEncoding.DecodeString(parseVaaBody.Vaa)
+	vaa, err := decodeRawVaa(parseVaaBody.Vaa)
 	if err != nil {
 		return response.NewRequestBodyError(ctx,
-			"invalid vaa request, vaa is not base64 encoded",
+			"invalid vaa request, vaa is not hex or base64 encoded",
 			errors.WithStack(err))
 	}
 
@@ -268,7 +293,17 @@ func (c *Controller) ParseVaa(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	return ctx.JSON(parsedVaa)
+	return versioning.JSON(ctx, parsedVaa)
+}
+
+// decodeRawVaa decodes a vaa encoded in hex, with or without the 0x prefix, or in base64.
+//
+// The hex encoding is tried first because a hex string is also a valid base64 string when its length is a multiple of 4.
+func decodeRawVaa(encoded string) ([]byte, error) {
+	if vaa, err := hex.DecodeString(strings.TrimPrefix(encoded, "0x")); err == nil {
+		return vaa, nil
+	}
+	return base64.StdEncoding.DecodeString(encoded)
 }
 
 // FindDuplicatedById godoc
@@ -314,7 +349,83 @@ func (c *Controller) FindDuplicatedById(ctx *fiber.Ctx) error {
 			UpdatedAt:         v.UpdatedAt,
 			IndexedAt:         v.IndexedAt,
 			Digest:            v.Digest,
+			Conflict:          v.Conflict,
+		})
+	}
+	return versioning.JSON(ctx, response.Response[[]DuplicateVaaResponse]{Data: duplicateVaas})
+}
+
+// FindRecent godoc
+// @Description Returns the VAAs indexed in the last hour, sorted by indexing time, for the clients that poll for new VAAs.
+// @Description The `pagination.next` field of the response is the cursor to pass as `since` in the next request,
+// @Description polling with it returns every VAA once. The VAAs are returned some seconds after they are indexed.
+// @Tags wormholescan
+// @ID find-recent-vaas
+// @Param since query string false "RFC3339 timestamp or cursor returned by a previous request, defaults to one hour ago."
+// @Param limit query integer false "Maximum number of VAAs, defaults to 100 and cannot be greater than 1000."
+// @Success 200 {object} response.Response[[]vaa.VaaDoc]
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/vaas/recent [get]
+func (c *Controller) FindRecent(ctx *fiber.Ctx) error {
+
+	limit := ctx.QueryInt("limit", 100)
+	if limit <= 0 || limit > 1000 {
+		return response.NewInvalidParamError(ctx, "limit must be between 1 and 1000", nil)
+	}
+
+	vaas, err := c.srv.FindRecent(ctx.Context(), ctx.Query("since"), int64(limit))
+	if err != nil {
+		return err
+	}
+	return versioning.JSON(ctx, vaas)
+}
+
+// FindConflicts godoc
+// @Description Returns the VAAs received with the same chain, emitter and sequence and a different digest.
+// @Description Output is paginated and sorted by timestamp in descending order.
+// @Tags wormholescan
+// @ID find-conflict-vaas
+// @Param page query integer false "Page number."
+// @Param pageSize query integer false "Number of elements per page."
+// @Success 200 {object} response.Response[[]DuplicateVaaResponse]
+// @Failure 400
+// @Failure 500
+// @Router /api/v1/vaas/conflicts [get]
+func (c *Controller) FindConflicts(ctx *fiber.Ctx) error {
+
+	pagination, err := middleware.ExtractPagination(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check pagination max limit
+	if pagination.Limit > 1000 {
+		return response.NewInvalidParamError(ctx, "pageSize cannot be greater than 1000", nil)
+	}
+
+	vaas, err := c.srv.FindConflicts(ctx.Context(), pagination)
+	if err != nil {
+		return err
+	}
+
+	conflicts := make([]DuplicateVaaResponse, 0, len(vaas.Data))
+	for _, v := range vaas.Data {
+		conflicts = append(conflicts, DuplicateVaaResponse{
+			ID:                v.ID,
+			Version:           v.Version,
+			EmitterChain:      v.EmitterChain,
+			EmitterAddr:       v.EmitterAddr,
+			EmitterNativeAddr: v.EmitterNativeAddr,
+			Sequence:          v.Sequence,
+			GuardianSetIndex:  v.GuardianSetIndex,
+			Vaa:               v.Vaa,
+			Timestamp:         v.Timestamp,
+			UpdatedAt:         v.UpdatedAt,
+			IndexedAt:         v.IndexedAt,
+			Digest:            v.Digest,
+			Conflict:          v.Conflict,
 		})
 	}
-	return ctx.JSON(response.Response[[]DuplicateVaaResponse]{Data: duplicateVaas})
+	return versioning.JSON(ctx, response.Response[[]DuplicateVaaResponse]{Data: conflicts})
 }
@@ -19,4 +19,5 @@ type DuplicateVaaResponse struct {
 	UpdatedAt         *time.Time  `json:"updatedAt"`
 	IndexedAt         *time.Time  `json:"indexedAt"`
 	Digest            string      `json:"digest"`
+	Conflict          bool        `json:"conflict"`
 }
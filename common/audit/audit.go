@@ -0,0 +1,83 @@
+// Package audit records the actions executed with the admin endpoints of the services.
+package audit
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuditCollection contains the audit records of all the services.
+const AuditCollection = "auditRecords"
+
+// action results.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Record is an audit record of an admin action.
+type Record struct {
+	ID primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	// Service is the name of the service that executed the action.
+	Service string `bson:"service" json:"service"`
+	// Action is the name of the action (e.g. "payload-schema.register").
+	Action string `bson:"action" json:"action"`
+	// Actor identifies who executed the action.
+	Actor    string `bson:"actor" json:"actor"`
+	RemoteIP string `bson:"remoteIp" json:"remoteIp"`
+	Method   string `bson:"method" json:"method"`
+	Path     string `bson:"path" json:"path"`
+	// Params contains the route and query parameters of the request.
+	Params map[string]string `bson:"params,omitempty" json:"params,omitempty"`
+	// Body is the request body, truncated to 4KB.
+	Body       string        `bson:"body,omitempty" json:"body,omitempty"`
+	StatusCode int           `bson:"statusCode" json:"statusCode"`
+	Result     string        `bson:"result" json:"result"`
+	Error      string        `bson:"error,omitempty" json:"error,omitempty"`
+	Duration   time.Duration `bson:"duration" json:"duration"`
+	Timestamp  time.Time     `bson:"timestamp" json:"timestamp"`
+}
+
+// Writer saves the audit records.
+type Writer interface {
+	Write(ctx context.Context, r *Record) error
+}
+
+// Repository saves the audit records in the database.
+type Repository struct {
+	collection *mongo.Collection
+}
+
+// NewRepository creates a new audit repository.
+func NewRepository(db *mongo.Database) *Repository {
+	return &Repository{collection: db.Collection(AuditCollection)}
+}
+
+// Write saves an audit record.
+func (r *Repository) Write(ctx context.Context, record *Record) error {
+	_, err := r.collection.InsertOne(ctx, record)
+	return err
+}
+
+// CreateIndexes creates the indexes used to query the audit records.
+func CreateIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection(AuditCollection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "timestamp", Value: -1}}},
+		{Keys: bson.D{{Key: "service", Value: 1}, {Key: "action", Value: 1}, {Key: "timestamp", Value: -1}}},
+		{Keys: bson.D{{Key: "actor", Value: 1}, {Key: "timestamp", Value: -1}}},
+	}, options.CreateIndexes())
+	return err
+}
+
+// DummyWriter discards the audit records.
+type DummyWriter struct{}
+
+// Write discards the audit record.
+func (DummyWriter) Write(_ context.Context, _ *Record) error {
+	return nil
+}
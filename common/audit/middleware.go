@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/common/auth"
+	"go.uber.org/zap"
+)
+
+const (
+	// ActorHeader is the header used by the operators to identify themselves.
+	ActorHeader = "X-Audit-Actor"
+	// maxBodySize is the maximum size of the request body saved in the records.
+	maxBodySize = 4 * 1024
+	// writeTimeout is the timeout to save a record.
+	writeTimeout = 5 * time.Second
+)
+
+// Auditor creates the middlewares that record the admin actions of a service.
+type Auditor struct {
+	writer  Writer
+	service string
+	logger  *zap.Logger
+}
+
+// NewAuditor creates a new Auditor for the service.
+func NewAuditor(writer Writer, service string, logger *zap.Logger) *Auditor {
+	return &Auditor{
+		writer:  writer,
+		service: service,
+		logger:  logger.With(zap.String("module", "Auditor")),
+	}
+}
+
+// Middleware returns a handler that records the requests to an admin endpoint with the given action name.
+//
+// The record is saved after the request is handled, with the status code and the error of the handler.
+// A failure saving the record is logged and does not change the response.
+func (a *Auditor) Middleware(action string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+
+		record := Record{
+			Service:    a.service,
+			Action:     action,
+			Actor:      actor(c),
+			RemoteIP:   c.IP(),
+			Method:     c.Method(),
+			Path:       c.Path(),
+			Params:     params(c),
+			Body:       body(c),
+			StatusCode: c.Response().StatusCode(),
+			Result:     ResultSuccess,
+			Duration:   time.Since(start),
+			Timestamp:  start,
+		}
+		if err != nil {
+			record.StatusCode = fiber.StatusInternalServerError
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				record.StatusCode = fiberErr.Code
+			}
+			record.Error = err.Error()
+		}
+		if record.StatusCode >= fiber.StatusBadRequest {
+			record.Result = ResultFailure
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+		defer cancel()
+		if werr := a.writer.Write(ctx, &record); werr != nil {
+			a.logger.Error("Error saving audit record", zap.Error(werr),
+				zap.String("action", record.Action),
+				zap.String("actor", record.Actor),
+				zap.String("result", record.Result))
+		}
+		return err
+	}
+}
+
+// actor returns the operator set in the actor header, or the fingerprint of the api key when it is not set.
+func actor(c *fiber.Ctx) string {
+	if actor := c.Get(ActorHeader); actor != "" {
+		return actor
+	}
+	if key := c.Get(auth.ApiKeyHeader); key != "" {
+		sum := sha256.Sum256([]byte(key))
+		return "api-key:" + hex.EncodeToString(sum[:4])
+	}
+	return "anonymous"
+}
+
+func params(c *fiber.Ctx) map[string]string {
+	p := make(map[string]string)
+	for key, value := range c.AllParams() {
+		p[key] = value
+	}
+	c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+		p[string(key)] = string(value)
+	})
+	if len(p) == 0 {
+		return nil
+	}
+	return p
+}
+
+func body(c *fiber.Ctx) string {
+	b := c.Body()
+	if len(b) > maxBodySize {
+		b = b[:maxBodySize]
+	}
+	return string(b)
+}
@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type memoryWriter struct {
+	records []*Record
+	err     error
+}
+
+func (w *memoryWriter) Write(_ context.Context, r *Record) error {
+	w.records = append(w.records, r)
+	return w.err
+}
+
+func TestAuditor_Middleware(t *testing.T) {
+
+	w := &memoryWriter{}
+	auditor := NewAuditor(w, "test-service", zap.NewNop())
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Post("/schemas/:chain", auditor.Middleware("schema.register"), func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusCreated)
+	})
+	app.Delete("/schemas/:chain", auditor.Middleware("schema.delete"), func(c *fiber.Ctx) error {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid chain")
+	})
+
+	req := httptest.NewRequest("POST", "/schemas/2?force=true", strings.NewReader(`{"protocol":"example"}`))
+	req.Header.Set(ActorHeader, "alice")
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, res.StatusCode)
+
+	req = httptest.NewRequest("DELETE", "/schemas/x", nil)
+	req.Header.Set("X-Api-Key", "secret")
+	res, err = app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+
+	assert.Len(t, w.records, 2)
+
+	r := w.records[0]
+	assert.Equal(t, "test-service", r.Service)
+	assert.Equal(t, "schema.register", r.Action)
+	assert.Equal(t, "alice", r.Actor)
+	assert.Equal(t, map[string]string{"chain": "2", "force": "true"}, r.Params)
+	assert.Equal(t, `{"protocol":"example"}`, r.Body)
+	assert.Equal(t, fiber.StatusCreated, r.StatusCode)
+	assert.Equal(t, ResultSuccess, r.Result)
+
+	r = w.records[1]
+	assert.Equal(t, "schema.delete", r.Action)
+	assert.True(t, strings.HasPrefix(r.Actor, "api-key:"))
+	assert.NotContains(t, r.Actor, "secret")
+	assert.Equal(t, fiber.StatusBadRequest, r.StatusCode)
+	assert.Equal(t, ResultFailure, r.Result)
+	assert.Equal(t, "invalid chain", r.Error)
+}
+
+func TestAuditor_MiddlewareWriteError(t *testing.T) {
+
+	w := &memoryWriter{err: errors.New("write error")}
+	auditor := NewAuditor(w, "test-service", zap.NewNop())
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Post("/jobs", auditor.Middleware("job.trigger"), func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	res, err := app.Test(httptest.NewRequest("POST", "/jobs", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	assert.Len(t, w.records, 1)
+	assert.Equal(t, "anonymous", w.records[0].Actor)
+}
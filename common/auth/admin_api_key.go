@@ -0,0 +1,25 @@
+// Package auth authenticates the requests to the admin endpoints of the services.
+package auth
+
+import (
+	"crypto/subtle"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ApiKeyHeader is the header with the api key of the admin endpoints.
+const ApiKeyHeader = "X-Api-Key"
+
+// AdminApiKey rejects the requests without the admin api key in the X-Api-Key header.
+// If the admin api key is not configured, all the requests are rejected.
+func AdminApiKey(apiKey string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Get(ApiKeyHeader)
+		if apiKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) != 1 {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Unauthorized",
+			})
+		}
+		return c.Next()
+	}
+}
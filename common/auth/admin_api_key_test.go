@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminApiKey(t *testing.T) {
+
+	newApp := func(apiKey string) *fiber.App {
+		app := fiber.New(fiber.Config{DisableStartupMessage: true})
+		app.Post("/admin", AdminApiKey(apiKey), func(c *fiber.Ctx) error {
+			return c.SendStatus(fiber.StatusNoContent)
+		})
+		return app
+	}
+
+	tests := []struct {
+		name   string
+		apiKey string
+		header string
+		status int
+	}{
+		{name: "valid key", apiKey: "secret", header: "secret", status: fiber.StatusNoContent},
+		{name: "wrong key", apiKey: "secret", header: "other", status: fiber.StatusUnauthorized},
+		{name: "missing key", apiKey: "secret", status: fiber.StatusUnauthorized},
+		{name: "not configured", apiKey: "", header: "", status: fiber.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/admin", nil)
+			if tt.header != "" {
+				req.Header.Set(ApiKeyHeader, tt.header)
+			}
+			res, err := newApp(tt.apiKey).Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, res.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
+)
+
+// vaaKeyPrefix is the prefix of the keys of the archived vaas.
+const vaaKeyPrefix = "vaas/"
+
+// VaaArchive is the long-term storage of the raw vaas moved out of the database.
+//
+// The raw vaas are stored in a S3 bucket keyed by vaa id.
+type VaaArchive struct {
+	store  *payload.S3Store
+	bucket string
+}
+
+// NewVaaArchive creates a VaaArchive that stores the raw vaas in [bucket].
+func NewVaaArchive(store *payload.S3Store, bucket string) *VaaArchive {
+	return &VaaArchive{
+		store:  store,
+		bucket: bucket,
+	}
+}
+
+// VaaKey returns the key of the archived raw vaa with id [vaaID].
+func VaaKey(vaaID string) string {
+	return vaaKeyPrefix + vaaID
+}
+
+// Put stores the raw vaa with id [vaaID].
+func (a *VaaArchive) Put(ctx context.Context, vaaID string, vaa []byte) error {
+	if err := a.store.Put(ctx, a.bucket, VaaKey(vaaID), vaa); err != nil {
+		return fmt.Errorf("failed to archive vaa %s: %w", vaaID, err)
+	}
+	return nil
+}
+
+// Get returns the archived raw vaa with id [vaaID].
+func (a *VaaArchive) Get(ctx context.Context, vaaID string) ([]byte, error) {
+	vaa, err := a.store.Get(ctx, a.bucket, VaaKey(vaaID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived vaa %s: %w", vaaID, err)
+	}
+	return vaa, nil
+}
@@ -25,6 +25,7 @@ type CacheClient struct {
 	Enabled bool
 	logger  *zap.Logger
 	Prefix  string
+	metrics Metrics
 }
 
 // Cache is the interface for cache client.
@@ -36,6 +37,7 @@ type Cache interface {
 // CacheWriteable is the interface for write cache.
 type CacheWriteable interface {
 	Set(ctx context.Context, key string, value interface{}, expirations time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
 }
 
 // CacheReadable is the interface for read cache.
@@ -46,12 +48,26 @@ type CacheReadable interface {
 
 type CacheGetFunc func(ctx context.Context, key string) (string, error)
 
+// CacheClientOption is a functional option for the cache client.
+type CacheClientOption func(*CacheClient)
+
+// WithMetrics sets the metrics that record the operations of the cache client.
+func WithMetrics(metrics Metrics) CacheClientOption {
+	return func(c *CacheClient) {
+		c.metrics = metrics
+	}
+}
+
 // NewCacheClient init a new cache client.
-func NewCacheClient(redisClient *redis.Client, enabled bool, prefix string, log *zap.Logger) (*CacheClient, error) {
+func NewCacheClient(redisClient *redis.Client, enabled bool, prefix string, log *zap.Logger, opts ...CacheClientOption) (*CacheClient, error) {
 	if redisClient == nil {
 		return nil, errors.New("redis client is nil")
 	}
-	return &CacheClient{Client: redisClient, Enabled: enabled, logger: log, Prefix: prefix}, nil
+	c := &CacheClient{Client: redisClient, Enabled: enabled, logger: log, Prefix: prefix, metrics: NewDummyMetrics()}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
 }
 
 // Get get a cache value or error from a key.
@@ -62,19 +78,23 @@ func (c *CacheClient) Get(ctx context.Context, key string) (string, error) {
 	if !c.Enabled {
 		return "", ErrCacheNotEnabled
 	}
-	key = c.renderKey(key)
-	value, err := c.Client.Get(ctx, key).Result()
+	start := time.Now()
+	renderedKey := c.renderKey(key)
+	value, err := c.Client.Get(ctx, renderedKey).Result()
 	if err != nil {
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		if errors.Is(err, redis.Nil) {
+			c.metrics.Observe(OperationGet, key, ResultMiss, time.Since(start))
 			c.logger.Debug("key does not exist in cache",
-				zap.Error(err), zap.String("key", key), zap.String("requestID", requestID))
+				zap.Error(err), zap.String("key", renderedKey), zap.String("requestID", requestID))
 			return "", ErrNotFound
 		}
+		c.metrics.Observe(OperationGet, key, ResultError, time.Since(start))
 		c.logger.Error("error getting key from cache",
-			zap.Error(err), zap.String("key", key), zap.String("requestID", requestID))
+			zap.Error(err), zap.String("key", renderedKey), zap.String("requestID", requestID))
 		return "", ErrInternal
 	}
+	c.metrics.Observe(OperationGet, key, ResultHit, time.Since(start))
 	return value, nil
 }
 
@@ -88,17 +108,50 @@ func (c *CacheClient) Set(ctx context.Context, key string, value interface{}, ex
 	if !c.Enabled {
 		return ErrCacheNotEnabled
 	}
-	key = c.renderKey(key)
-	err := c.Client.Set(ctx, key, value, expiration).Err()
+	start := time.Now()
+	renderedKey := c.renderKey(key)
+	err := c.Client.Set(ctx, renderedKey, value, expiration).Err()
 	if err != nil {
+		c.metrics.Observe(OperationSet, key, ResultError, time.Since(start))
 		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
 		c.logger.Error("can not set key/value in cache",
 			zap.Error(err),
-			zap.String("key", key),
+			zap.String("key", renderedKey),
 			zap.Any("value", value),
 			zap.String("requestID", requestID))
 		return err
 	}
+	c.metrics.Observe(OperationSet, key, ResultOk, time.Since(start))
+	return nil
+}
+
+// Delete remove the keys from cache.
+// Keys that does not exist in cache are ignored.
+func (c *CacheClient) Delete(ctx context.Context, keys ...string) error {
+	if !c.Enabled {
+		return ErrCacheNotEnabled
+	}
+	start := time.Now()
+	renderedKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		renderedKeys = append(renderedKeys, c.renderKey(key))
+	}
+	err := c.Client.Del(ctx, renderedKeys...).Err()
+	result := ResultOk
+	if err != nil {
+		result = ResultError
+	}
+	for _, key := range keys {
+		c.metrics.Observe(OperationDelete, key, result, time.Since(start))
+	}
+	if err != nil {
+		requestID := fmt.Sprintf("%v", ctx.Value("requestid"))
+		c.logger.Error("can not delete keys from cache",
+			zap.Error(err),
+			zap.Strings("keys", renderedKeys),
+			zap.String("requestID", requestID))
+		return err
+	}
 	return nil
 }
 
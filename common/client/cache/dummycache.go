@@ -25,6 +25,11 @@ func (d *DummyCacheClient) Set(ctx context.Context, key string, value interface{
 	return nil
 }
 
+// Delete delete method is a dummy method that does nothing.
+func (d *DummyCacheClient) Delete(ctx context.Context, keys ...string) error {
+	return nil
+}
+
 // Close dummy cache client.
 func (d *DummyCacheClient) Close() error {
 	return nil
@@ -0,0 +1,192 @@
+package cache
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// cache operations.
+const (
+	OperationGet    = "get"
+	OperationSet    = "set"
+	OperationDelete = "delete"
+)
+
+// cache operation results.
+const (
+	ResultHit   = "hit"
+	ResultMiss  = "miss"
+	ResultOk    = "ok"
+	ResultError = "error"
+)
+
+// maxKeyPrefixes is the maximum number of key prefixes tracked by the metrics,
+// the operations on keys with other prefixes are grouped in the otherKeyPrefix.
+const (
+	maxKeyPrefixes = 100
+	otherKeyPrefix = "other"
+)
+
+// Metrics records the operations of the cache client.
+type Metrics interface {
+	Observe(operation, key, result string, elapsed time.Duration)
+	Stats() []PrefixStats
+}
+
+// PrefixStats are the counters of the cache operations on the keys with the same prefix.
+type PrefixStats struct {
+	Prefix           string  `json:"prefix"`
+	Hits             int64   `json:"hits"`
+	Misses           int64   `json:"misses"`
+	Errors           int64   `json:"errors"`
+	Sets             int64   `json:"sets"`
+	Deletes          int64   `json:"deletes"`
+	HitRatio         float64 `json:"hitRatio"`
+	AvgGetLatencyMs  float64 `json:"avgGetLatencyMs"`
+	gets             int64
+	totalGetDuration time.Duration
+}
+
+// DummyMetrics is a dummy implementation of Metrics.
+type DummyMetrics struct{}
+
+// NewDummyMetrics returns a new instance of DummyMetrics.
+func NewDummyMetrics() *DummyMetrics {
+	return &DummyMetrics{}
+}
+
+// Observe implements Metrics.
+func (d *DummyMetrics) Observe(operation, key, result string, elapsed time.Duration) {}
+
+// Stats implements Metrics.
+func (d *DummyMetrics) Stats() []PrefixStats {
+	return []PrefixStats{}
+}
+
+// PrometheusMetrics is a Prometheus implementation of Metrics.
+// It also keeps the counters by key prefix in memory to report them with Stats.
+type PrometheusMetrics struct {
+	operationsCount   *prometheus.CounterVec
+	operationDuration *prometheus.HistogramVec
+	keyPrefixes       prometheus.Gauge
+	mu                sync.Mutex
+	stats             map[string]*PrefixStats
+}
+
+// NewPrometheusMetrics returns a new instance of PrometheusMetrics.
+func NewPrometheusMetrics(environment, service string) *PrometheusMetrics {
+	constLabels := map[string]string{
+		"environment": environment,
+		"service":     service,
+	}
+
+	operationsCount := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:        "cache_operations_total",
+			Help:        "Total cache operations by operation, key prefix and result.",
+			ConstLabels: constLabels,
+		}, []string{"operation", "prefix", "result"})
+
+	operationDuration := promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:        "cache_operation_duration_seconds",
+			Help:        "Duration of cache operations by operation and key prefix.",
+			ConstLabels: constLabels,
+			Buckets:     []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"operation", "prefix"})
+
+	keyPrefixes := promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name:        "cache_key_prefixes",
+			Help:        "Number of distinct key prefixes used in cache operations.",
+			ConstLabels: constLabels,
+		})
+
+	return &PrometheusMetrics{
+		operationsCount:   operationsCount,
+		operationDuration: operationDuration,
+		keyPrefixes:       keyPrefixes,
+		stats:             make(map[string]*PrefixStats),
+	}
+}
+
+// Observe records a cache operation on a key.
+func (m *PrometheusMetrics) Observe(operation, key, result string, elapsed time.Duration) {
+	prefix := m.observeStats(operation, KeyPrefix(key), result, elapsed)
+	m.operationsCount.WithLabelValues(operation, prefix, result).Inc()
+	m.operationDuration.WithLabelValues(operation, prefix).Observe(elapsed.Seconds())
+}
+
+// observeStats updates the in memory counters and returns the prefix used as label.
+func (m *PrometheusMetrics) observeStats(operation, prefix, result string, elapsed time.Duration) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.stats[prefix]
+	if !ok {
+		if len(m.stats) >= maxKeyPrefixes {
+			prefix = otherKeyPrefix
+			s, ok = m.stats[prefix]
+		}
+		if !ok {
+			s = &PrefixStats{Prefix: prefix}
+			m.stats[prefix] = s
+			m.keyPrefixes.Set(float64(len(m.stats)))
+		}
+	}
+
+	switch {
+	case result == ResultError:
+		s.Errors++
+	case operation == OperationGet && result == ResultHit:
+		s.Hits++
+	case operation == OperationGet && result == ResultMiss:
+		s.Misses++
+	case operation == OperationSet:
+		s.Sets++
+	case operation == OperationDelete:
+		s.Deletes++
+	}
+	if operation == OperationGet {
+		s.gets++
+		s.totalGetDuration += elapsed
+	}
+	return prefix
+}
+
+// Stats returns the counters of the cache operations by key prefix, sorted by prefix.
+func (m *PrometheusMetrics) Stats() []PrefixStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := make([]PrefixStats, 0, len(m.stats))
+	for _, s := range m.stats {
+		stat := *s
+		if gets := stat.Hits + stat.Misses; gets > 0 {
+			stat.HitRatio = float64(stat.Hits) / float64(gets)
+		}
+		if stat.gets > 0 {
+			stat.AvgGetLatencyMs = float64(stat.totalGetDuration.Microseconds()) / 1000 / float64(stat.gets)
+		}
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Prefix < stats[j].Prefix
+	})
+	return stats
+}
+
+// KeyPrefix returns the prefix of a cache key, made of its first two segments separated by ':'.
+// For example, the prefix of the key "wormscan:vaa:2/000...0001/10" is "wormscan:vaa".
+func KeyPrefix(key string) string {
+	segments := strings.SplitN(key, ":", 3)
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	return strings.Join(segments, ":")
+}
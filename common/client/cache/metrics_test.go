@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyPrefix(t *testing.T) {
+	assert.Equal(t, "wormscan:vaa", KeyPrefix("wormscan:vaa:2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1"))
+	assert.Equal(t, "wormscan:vaa-max-sequence", KeyPrefix("wormscan:vaa-max-sequence:2:abc"))
+	assert.Equal(t, "wormscan:scorecards", KeyPrefix("wormscan:scorecards"))
+	assert.Equal(t, "current-guardian-set", KeyPrefix("current-guardian-set"))
+}
+
+func TestPrometheusMetrics_Stats(t *testing.T) {
+
+	m := NewPrometheusMetrics("test", "test")
+
+	m.Observe(OperationGet, "wormscan:vaa:1", ResultHit, 2*time.Millisecond)
+	m.Observe(OperationGet, "wormscan:vaa:2", ResultHit, 2*time.Millisecond)
+	m.Observe(OperationGet, "wormscan:vaa:3", ResultMiss, 2*time.Millisecond)
+	m.Observe(OperationGet, "wormscan:vaa:4", ResultError, 6*time.Millisecond)
+	m.Observe(OperationSet, "wormscan:vaa:3", ResultOk, time.Millisecond)
+	m.Observe(OperationDelete, "wormscan:scorecards", ResultOk, time.Millisecond)
+
+	stats := m.Stats()
+	assert.Len(t, stats, 2)
+
+	assert.Equal(t, "wormscan:scorecards", stats[0].Prefix)
+	assert.Equal(t, int64(1), stats[0].Deletes)
+
+	assert.Equal(t, "wormscan:vaa", stats[1].Prefix)
+	assert.Equal(t, int64(2), stats[1].Hits)
+	assert.Equal(t, int64(1), stats[1].Misses)
+	assert.Equal(t, int64(1), stats[1].Errors)
+	assert.Equal(t, int64(1), stats[1].Sets)
+	assert.InDelta(t, 2.0/3.0, stats[1].HitRatio, 0.0001)
+	assert.InDelta(t, 3.0, stats[1].AvgGetLatencyMs, 0.0001)
+}
@@ -24,3 +24,8 @@ func (c *CacheMock) Set(ctx context.Context, key string, value interface{}, expi
 	args := c.Called(ctx, key, value, expirations)
 	return args.Error(0)
 }
+
+func (c *CacheMock) Delete(ctx context.Context, keys ...string) error {
+	args := c.Called(ctx, keys)
+	return args.Error(0)
+}
@@ -0,0 +1,169 @@
+package cache
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// TieredCache is a two-tier cache: an in-process local cache in front of a regional cache (e.g. redis),
+// so the replicas in a region far from the regional cache do not reach it on every read.
+//
+// The local entries are not invalidated in other replicas, so a value can be stale for up to the local
+// expiration after it is changed or deleted in the regional cache.
+type TieredCache struct {
+	remote     Cache
+	localTTL   time.Duration
+	jitter     float64
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]localEntry
+	// inflight contains the reads to the regional cache in progress, by key.
+	inflight map[string]*inflightGet
+}
+
+type localEntry struct {
+	value     string
+	expiresAt time.Time
+}
+
+// inflightGet is a read to the regional cache shared by the concurrent reads of the same key.
+type inflightGet struct {
+	done  chan struct{}
+	value string
+	err   error
+}
+
+// TieredCacheOption is a functional option for the tiered cache.
+type TieredCacheOption func(*TieredCache)
+
+// WithExpirationJitter randomizes the expirations by up to the given fraction (e.g. 0.1 for ±10%),
+// so the keys set at the same time do not expire at the same time.
+func WithExpirationJitter(jitter float64) TieredCacheOption {
+	return func(c *TieredCache) {
+		c.jitter = jitter
+	}
+}
+
+// WithMaxLocalEntries sets the maximum number of entries of the local cache.
+func WithMaxLocalEntries(maxEntries int) TieredCacheOption {
+	return func(c *TieredCache) {
+		c.maxEntries = maxEntries
+	}
+}
+
+// NewTieredCache creates a TieredCache that keeps the values read from the remote cache locally during localTTL.
+func NewTieredCache(remote Cache, localTTL time.Duration, opts ...TieredCacheOption) *TieredCache {
+	c := &TieredCache{
+		remote:     remote,
+		localTTL:   localTTL,
+		maxEntries: 10000,
+		entries:    make(map[string]localEntry),
+		inflight:   make(map[string]*inflightGet),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Get gets the value from the local cache or, if it is not found or expired, from the remote cache.
+// The concurrent reads of a key missing in the local cache share a single read to the remote cache.
+func (c *TieredCache) Get(ctx context.Context, key string) (string, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && now.Before(e.expiresAt) {
+		c.mu.Unlock()
+		return e.value, nil
+	}
+	if call, ok := c.inflight[key]; ok {
+		c.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.value, call.err
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	call := &inflightGet{done: make(chan struct{})}
+	c.inflight[key] = call
+	c.mu.Unlock()
+
+	call.value, call.err = c.remote.Get(ctx, key)
+
+	c.mu.Lock()
+	delete(c.inflight, key)
+	if call.err == nil {
+		c.setLocal(key, call.value, now)
+	}
+	c.mu.Unlock()
+	close(call.done)
+
+	return call.value, call.err
+}
+
+// Set sets the value in the remote cache with a jittered expiration and removes the local entry,
+// so the next read gets the value as it was stored in the remote cache.
+func (c *TieredCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	c.deleteLocal(key)
+	return c.remote.Set(ctx, key, value, c.withJitter(expiration))
+}
+
+// Delete removes the keys from the local and the remote cache.
+func (c *TieredCache) Delete(ctx context.Context, keys ...string) error {
+	c.deleteLocal(keys...)
+	return c.remote.Delete(ctx, keys...)
+}
+
+// Close closes the remote cache.
+func (c *TieredCache) Close() error {
+	return c.remote.Close()
+}
+
+// setLocal stores a value in the local cache, the caller must hold the lock.
+func (c *TieredCache) setLocal(key, value string, now time.Time) {
+	if c.localTTL <= 0 {
+		return
+	}
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = localEntry{value: value, expiresAt: now.Add(c.withJitter(c.localTTL))}
+}
+
+// evict removes the expired entries or, if there are none, an arbitrary entry. The caller must hold the lock.
+func (c *TieredCache) evict(now time.Time) {
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	if len(c.entries) < c.maxEntries {
+		return
+	}
+	for k := range c.entries {
+		delete(c.entries, k)
+		if len(c.entries) < c.maxEntries {
+			return
+		}
+	}
+}
+
+func (c *TieredCache) deleteLocal(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+}
+
+func (c *TieredCache) withJitter(d time.Duration) time.Duration {
+	if d <= 0 || c.jitter <= 0 {
+		return d
+	}
+	delta := time.Duration(float64(d) * c.jitter * (2*rand.Float64() - 1))
+	return d + delta
+}
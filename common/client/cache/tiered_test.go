@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// remoteCacheFake is a Cache that counts the reads and blocks them until release is closed.
+type remoteCacheFake struct {
+	values  map[string]string
+	gets    atomic.Int32
+	release chan struct{}
+	sets    map[string]time.Duration
+}
+
+func newRemoteCacheFake() *remoteCacheFake {
+	release := make(chan struct{})
+	close(release)
+	return &remoteCacheFake{values: map[string]string{}, release: release, sets: map[string]time.Duration{}}
+}
+
+func (f *remoteCacheFake) Get(_ context.Context, key string) (string, error) {
+	f.gets.Add(1)
+	<-f.release
+	v, ok := f.values[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return v, nil
+}
+
+func (f *remoteCacheFake) Set(_ context.Context, key string, value interface{}, expiration time.Duration) error {
+	f.values[key] = value.(string)
+	f.sets[key] = expiration
+	return nil
+}
+
+func (f *remoteCacheFake) Delete(_ context.Context, keys ...string) error {
+	for _, k := range keys {
+		delete(f.values, k)
+	}
+	return nil
+}
+
+func (f *remoteCacheFake) Close() error {
+	return nil
+}
+
+func TestTieredCache_Get(t *testing.T) {
+	ctx := context.Background()
+	remote := newRemoteCacheFake()
+	remote.values["key"] = "value"
+	c := NewTieredCache(remote, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		v, err := c.Get(ctx, "key")
+		assert.NoError(t, err)
+		assert.Equal(t, "value", v)
+	}
+	assert.Equal(t, int32(1), remote.gets.Load())
+
+	// misses are not cached locally.
+	for i := 0; i < 2; i++ {
+		_, err := c.Get(ctx, "missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+	}
+	assert.Equal(t, int32(3), remote.gets.Load())
+}
+
+func TestTieredCache_GetExpired(t *testing.T) {
+	ctx := context.Background()
+	remote := newRemoteCacheFake()
+	remote.values["key"] = "value"
+	c := NewTieredCache(remote, time.Minute)
+
+	_, _ = c.Get(ctx, "key")
+	c.entries["key"] = localEntry{value: "value", expiresAt: time.Now().Add(-time.Second)}
+	_, _ = c.Get(ctx, "key")
+	assert.Equal(t, int32(2), remote.gets.Load())
+}
+
+func TestTieredCache_GetStampede(t *testing.T) {
+	ctx := context.Background()
+	remote := newRemoteCacheFake()
+	remote.values["key"] = "value"
+	remote.release = make(chan struct{})
+	c := NewTieredCache(remote, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := c.Get(ctx, "key")
+			assert.NoError(t, err)
+			assert.Equal(t, "value", v)
+		}()
+	}
+	// wait until the first read reaches the remote cache and the others are waiting for it.
+	assert.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.inflight) == 1
+	}, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(remote.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), remote.gets.Load())
+}
+
+func TestTieredCache_SetAndDelete(t *testing.T) {
+	ctx := context.Background()
+	remote := newRemoteCacheFake()
+	remote.values["key"] = "old"
+	c := NewTieredCache(remote, time.Minute, WithExpirationJitter(0.1))
+
+	_, _ = c.Get(ctx, "key")
+	assert.NoError(t, c.Set(ctx, "key", "new", 10*time.Second))
+	assert.InDelta(t, float64(10*time.Second), float64(remote.sets["key"]), float64(time.Second))
+
+	v, err := c.Get(ctx, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, "new", v)
+
+	assert.NoError(t, c.Delete(ctx, "key"))
+	_, err = c.Get(ctx, "key")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTieredCache_MaxLocalEntries(t *testing.T) {
+	ctx := context.Background()
+	remote := newRemoteCacheFake()
+	remote.values["a"] = "1"
+	remote.values["b"] = "2"
+	remote.values["c"] = "3"
+	c := NewTieredCache(remote, time.Minute, WithMaxLocalEntries(2))
+
+	for _, k := range []string{"a", "b", "c"} {
+		_, err := c.Get(ctx, k)
+		assert.NoError(t, err)
+	}
+	assert.Len(t, c.entries, 2)
+	assert.Contains(t, c.entries, "c")
+}
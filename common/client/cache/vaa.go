@@ -0,0 +1,13 @@
+package cache
+
+import "fmt"
+
+const vaaKeyFormatString = "wormscan:vaa:%s"
+
+// VaaKey returns the cache key of a vaa document by its id (chainID/emitterAddress/sequence).
+//
+// The api caches the vaa documents under this key and the pipeline deletes it
+// when a vaa document is updated.
+func VaaKey(vaaID string) string {
+	return fmt.Sprintf(vaaKeyFormatString, vaaID)
+}
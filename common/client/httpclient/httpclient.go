@@ -0,0 +1,116 @@
+// Package httpclient provides the http client shared by the outbound requests of the services.
+//
+// The client keeps a pool of connections, so the requests to the same host reuse the connections
+// instead of opening a new one per request.
+package httpclient
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultTimeout is the timeout of the requests when none is configured.
+	DefaultTimeout = 30 * time.Second
+	// DefaultMaxIdleConnsPerHost is the number of idle connections kept by host when none is configured.
+	DefaultMaxIdleConnsPerHost = 16
+	// DefaultUserAgent is the user agent of the requests when none is configured.
+	DefaultUserAgent = "wormhole-explorer"
+)
+
+// Config is the configuration of an http client.
+type Config struct {
+	// Timeout is the timeout of each request, including reading the response body.
+	Timeout time.Duration
+	// ProxyURL is the url of the http proxy of the requests.
+	// When empty, the proxy is taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+	// UserAgent is set on the requests without a User-Agent header.
+	UserAgent string
+	// MaxIdleConnsPerHost is the number of idle connections kept by host.
+	MaxIdleConnsPerHost int
+}
+
+// New creates an http client with a pool of connections.
+func New(cfg Config) (*http.Client, error) {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = DefaultUserAgent
+	}
+	if cfg.MaxIdleConnsPerHost <= 0 {
+		cfg.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url %s: scheme and host are required", cfg.ProxyURL)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	transport := &http.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+
+	return &http.Client{
+		Timeout:   cfg.Timeout,
+		Transport: &userAgentTransport{userAgent: cfg.UserAgent, next: transport},
+	}, nil
+}
+
+// userAgentTransport sets the user agent of the requests without one.
+type userAgentTransport struct {
+	userAgent string
+	next      http.RoundTripper
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("User-Agent") != "" {
+		return t.next.RoundTrip(req)
+	}
+	// a round tripper must not modify the request, so the header is set on a copy.
+	r := req.Clone(req.Context())
+	r.Header.Set("User-Agent", t.userAgent)
+	return t.next.RoundTrip(r)
+}
+
+var defaultClient atomic.Pointer[http.Client]
+
+func init() {
+	client, _ := New(Config{})
+	defaultClient.Store(client)
+}
+
+// Default returns the shared http client.
+// It is created with the default configuration until it is replaced with [SetDefault].
+func Default() *http.Client {
+	return defaultClient.Load()
+}
+
+// SetDefault replaces the shared http client, it is meant to be called on startup with the configuration of the service.
+func SetDefault(client *http.Client) {
+	if client != nil {
+		defaultClient.Store(client)
+	}
+}
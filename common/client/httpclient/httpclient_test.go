@@ -0,0 +1,83 @@
+package httpclient
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/test-go/testify/assert"
+)
+
+func TestNew_Defaults(t *testing.T) {
+	client, err := New(Config{})
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultTimeout, client.Timeout)
+
+	transport := client.Transport.(*userAgentTransport)
+	assert.Equal(t, DefaultUserAgent, transport.userAgent)
+	assert.Equal(t, DefaultMaxIdleConnsPerHost, transport.next.(*http.Transport).MaxIdleConnsPerHost)
+}
+
+func TestNew_Proxy(t *testing.T) {
+	client, err := New(Config{ProxyURL: "http://proxy.local:3128"})
+	assert.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com", nil)
+	proxy, err := client.Transport.(*userAgentTransport).next.(*http.Transport).Proxy(req)
+	assert.NoError(t, err)
+	assert.Equal(t, &url.URL{Scheme: "http", Host: "proxy.local:3128"}, proxy)
+
+	_, err = New(Config{ProxyURL: "proxy.local"})
+	assert.Error(t, err)
+}
+
+func TestClient_UserAgentAndKeepAlive(t *testing.T) {
+	var userAgents []string
+	var conns atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.UserAgent())
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	client, err := New(Config{Timeout: time.Second, UserAgent: "tx-tracker"})
+	assert.NoError(t, err)
+
+	for _, userAgent := range []string{"", "custom"} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		if userAgent != "" {
+			req.Header.Set("User-Agent", userAgent)
+		}
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		// the request is not modified by the client.
+		assert.Equal(t, userAgent, req.Header.Get("User-Agent"))
+	}
+
+	assert.Equal(t, []string{"tx-tracker", "custom"}, userAgents)
+	// the second request reuses the connection of the first one.
+	assert.Equal(t, int32(1), conns.Load())
+}
+
+func TestSetDefault(t *testing.T) {
+	initial := Default()
+	assert.NotNil(t, initial)
+	defer SetDefault(initial)
+
+	client := &http.Client{}
+	SetDefault(client)
+	assert.True(t, client == Default())
+
+	SetDefault(nil)
+	assert.True(t, client == Default())
+}
@@ -0,0 +1,171 @@
+package payload
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// EncodingAttribute is the message attribute with the encoding of the message body.
+const EncodingAttribute = "encoding"
+
+// message body encodings.
+const (
+	// EncodingGzip is a gzip compressed body encoded in base64.
+	EncodingGzip = "gzip+base64"
+	// EncodingS3 is a reference to the gzip compressed body stored in S3.
+	EncodingS3 = "s3"
+)
+
+// MaxBodySize is the maximum size of a message body sent to SNS/SQS.
+// The limit of a message is 256KB, part of it is left for the message attributes and the SNS envelope.
+const MaxBodySize = 248 * 1024
+
+// DefaultCompressThreshold is the default minimum size of the bodies that are compressed.
+const DefaultCompressThreshold = 64 * 1024
+
+// ErrBodyTooLarge is returned when the body exceeds the maximum size and there is no S3 store to offload it.
+var ErrBodyTooLarge = errors.New("message body too large")
+
+// Attribute is a message attribute of the SNS notification delivered to SQS.
+type Attribute struct {
+	Type  string `json:"Type"`
+	Value string `json:"Value"`
+}
+
+// s3Reference is the body of the messages offloaded to S3.
+type s3Reference struct {
+	Bucket string `json:"bucket"`
+	Key    string `json:"key"`
+}
+
+// CodecOption represents a codec option function.
+type CodecOption func(*Codec)
+
+// Codec compresses the bodies of the messages and offloads to S3 the bodies that exceed the maximum size.
+type Codec struct {
+	compressThreshold int
+	store             *S3Store
+	bucket            string
+}
+
+// NewCodec creates a Codec. By default the bodies are not compressed and the messages can not be offloaded to S3.
+func NewCodec(opts ...CodecOption) *Codec {
+	c := &Codec{compressThreshold: -1}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithCompressThreshold allows to specify the minimum size of the bodies that are compressed.
+func WithCompressThreshold(size int) CodecOption {
+	return func(c *Codec) {
+		c.compressThreshold = size
+	}
+}
+
+// WithS3Store allows to specify the S3 store used to read the offloaded messages.
+// If bucket is not empty the messages that exceed the maximum size are offloaded to it.
+//
+// The offloaded messages are not deleted when they are consumed, since every queue subscribed to the topic
+// reads them. They are deleted by the lifecycle of the bucket, see S3Store.PutExpiration, that must outlive
+// the retention of the queues.
+func WithS3Store(store *S3Store, bucket string) CodecOption {
+	return func(c *Codec) {
+		c.store = store
+		c.bucket = bucket
+	}
+}
+
+// Encode encodes the body of a message and returns the encoded body and the message attributes to send with it.
+// key identifies the message in S3 when the body is offloaded.
+func (c *Codec) Encode(ctx context.Context, key, body string) (string, map[string]string, error) {
+	if c.compressThreshold < 0 || len(body) < c.compressThreshold {
+		if len(body) > MaxBodySize {
+			return "", nil, ErrBodyTooLarge
+		}
+		return body, nil, nil
+	}
+
+	compressed, err := compress([]byte(body))
+	if err != nil {
+		return "", nil, err
+	}
+	encoded := base64.StdEncoding.EncodeToString(compressed)
+	if len(encoded) <= MaxBodySize {
+		return encoded, map[string]string{EncodingAttribute: EncodingGzip}, nil
+	}
+
+	if c.store == nil || c.bucket == "" {
+		return "", nil, ErrBodyTooLarge
+	}
+	if err := c.store.Put(ctx, c.bucket, key, compressed); err != nil {
+		return "", nil, fmt.Errorf("failed to offload message to s3: %w", err)
+	}
+	ref, err := json.Marshal(s3Reference{Bucket: c.bucket, Key: key})
+	if err != nil {
+		return "", nil, err
+	}
+	return string(ref), map[string]string{EncodingAttribute: EncodingS3}, nil
+}
+
+// Decode returns the original body of a message with the given attributes.
+// The messages without the encoding attribute are returned as is.
+func (c *Codec) Decode(ctx context.Context, body string, attributes map[string]Attribute) (string, error) {
+	switch attributes[EncodingAttribute].Value {
+	case "":
+		return body, nil
+	case EncodingGzip:
+		compressed, err := base64.StdEncoding.DecodeString(body)
+		if err != nil {
+			return "", err
+		}
+		return decompress(compressed)
+	case EncodingS3:
+		if c.store == nil {
+			return "", errors.New("s3 store is not configured")
+		}
+		var ref s3Reference
+		if err := json.Unmarshal([]byte(body), &ref); err != nil {
+			return "", err
+		}
+		compressed, err := c.store.Get(ctx, ref.Bucket, ref.Key)
+		if err != nil {
+			return "", fmt.Errorf("failed to get offloaded message from s3: %w", err)
+		}
+		return decompress(compressed)
+	default:
+		return "", fmt.Errorf("unknown message encoding %s", attributes[EncodingAttribute].Value)
+	}
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) (string, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
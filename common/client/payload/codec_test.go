@@ -0,0 +1,50 @@
+package payload
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodecCompress(t *testing.T) {
+	ctx := context.Background()
+	codec := NewCodec(WithCompressThreshold(10))
+
+	// small bodies are not compressed.
+	body, attrs, err := codec.Encode(ctx, "key", "small")
+	assert.NoError(t, err)
+	assert.Equal(t, "small", body)
+	assert.Empty(t, attrs)
+
+	original := strings.Repeat("vaa", 100)
+	body, attrs, err = codec.Encode(ctx, "key", original)
+	assert.NoError(t, err)
+	assert.Equal(t, EncodingGzip, attrs[EncodingAttribute])
+	assert.NotEqual(t, original, body)
+
+	decoded, err := codec.Decode(ctx, body, map[string]Attribute{EncodingAttribute: {Type: "String", Value: attrs[EncodingAttribute]}})
+	assert.NoError(t, err)
+	assert.Equal(t, original, decoded)
+
+	decoded, err = codec.Decode(ctx, "plain", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "plain", decoded)
+}
+
+func TestCodecBodyTooLarge(t *testing.T) {
+	ctx := context.Background()
+	large := strings.Repeat("a", MaxBodySize+1)
+
+	_, _, err := NewCodec().Encode(ctx, "key", large)
+	assert.ErrorIs(t, err, ErrBodyTooLarge)
+
+	// compressed bodies under the limit do not need to be offloaded.
+	_, attrs, err := NewCodec(WithCompressThreshold(0)).Encode(ctx, "key", large)
+	assert.NoError(t, err)
+	assert.Equal(t, EncodingGzip, attrs[EncodingAttribute])
+
+	_, err = NewCodec().Decode(ctx, "{}", map[string]Attribute{EncodingAttribute: {Value: EncodingS3}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+package payload
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// S3Store stores and retrieves objects from S3 with signed http requests.
+type S3Store struct {
+	awsConfig aws.Config
+	endpoint  string
+	client    *http.Client
+	signer    *v4.Signer
+}
+
+// NewS3Store creates a S3Store. If endpoint is not empty the objects are addressed in path style,
+// otherwise the regional virtual hosted endpoint of the bucket is used.
+func NewS3Store(awsConfig aws.Config, endpoint string) *S3Store {
+	return &S3Store{
+		awsConfig: awsConfig,
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		client:    &http.Client{Timeout: 30 * time.Second},
+		signer:    v4.NewSigner(),
+	}
+}
+
+// lifecycleConfiguration is the body of the bucket lifecycle request.
+type lifecycleConfiguration struct {
+	XMLName xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LifecycleConfiguration"`
+	Rules   []lifecycleRule `xml:"Rule"`
+}
+
+type lifecycleRule struct {
+	ID     string `xml:"ID"`
+	Prefix string `xml:"Filter>Prefix"`
+	Status string `xml:"Status"`
+	Days   int    `xml:"Expiration>Days"`
+}
+
+// Put stores an object.
+func (s *S3Store) Put(ctx context.Context, bucket, key string, data []byte) error {
+	res, err := s.do(ctx, http.MethodPut, bucket, key, data, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to put object %s/%s: status %d", bucket, key, res.StatusCode)
+	}
+	return nil
+}
+
+// Get retrieves an object.
+func (s *S3Store) Get(ctx context.Context, bucket, key string) ([]byte, error) {
+	res, err := s.do(ctx, http.MethodGet, bucket, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get object %s/%s: status %d", bucket, key, res.StatusCode)
+	}
+	return io.ReadAll(res.Body)
+}
+
+// PutExpiration sets the lifecycle of the bucket so the objects are deleted [days] after they are stored.
+// The lifecycle replaces the previous rules of the bucket, so the bucket must only be used by the store.
+func (s *S3Store) PutExpiration(ctx context.Context, bucket string, days int) error {
+	data, err := xml.Marshal(lifecycleConfiguration{Rules: []lifecycleRule{
+		{ID: "expire-objects", Status: "Enabled", Days: days},
+	}})
+	if err != nil {
+		return err
+	}
+	// the lifecycle requests require the md5 of the body.
+	sum := md5.Sum(data)
+	headers := map[string]string{"Content-MD5": base64.StdEncoding.EncodeToString(sum[:])}
+	res, err := s.do(ctx, http.MethodPut, bucket, "?lifecycle", data, headers)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to put the lifecycle of bucket %s: status %d", bucket, res.StatusCode)
+	}
+	return nil
+}
+
+func (s *S3Store) do(ctx context.Context, method, bucket, key string, data []byte, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.url(bucket, key), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	credentials, err := s.awsConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+	payloadHash := hex.EncodeToString(hash[:])
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	err = s.signer.SignHTTP(ctx, credentials, req, payloadHash, "s3", s.awsConfig.Region, time.Now(),
+		func(o *v4.SignerOptions) { o.DisableURIPathEscaping = true })
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(req)
+}
+
+func (s *S3Store) url(bucket, key string) string {
+	if s.endpoint != "" {
+		return fmt.Sprintf("%s/%s/%s", s.endpoint, bucket, key)
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", bucket, s.awsConfig.Region, key)
+}
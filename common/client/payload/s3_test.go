@@ -0,0 +1,44 @@
+package payload
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3StorePutExpiration(t *testing.T) {
+	var path, query, body, contentMD5 string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		path, query, body = r.URL.Path, r.URL.RawQuery, string(data)
+		contentMD5 = r.Header.Get("Content-MD5")
+		sum := md5.Sum(data)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), contentMD5)
+	}))
+	defer server.Close()
+
+	store := NewS3Store(aws.Config{
+		Region: "us-east-1",
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "key", SecretAccessKey: "secret"}, nil
+		}),
+	}, server.URL)
+	err := store.PutExpiration(context.Background(), "payloads", 14)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/payloads/", path)
+	assert.Equal(t, "lifecycle=", query)
+	assert.Equal(t, `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`+
+		`<Rule><ID>expire-objects</ID><Filter><Prefix></Prefix></Filter><Status>Enabled</Status>`+
+		`<Expiration><Days>14</Days></Expiration></Rule></LifecycleConfiguration>`, body)
+	assert.NotEmpty(t, contentMD5)
+}
@@ -5,29 +5,62 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	aws_sns "github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
 )
 
+// ProducerOption represents a producer option function.
+type ProducerOption func(*Producer)
+
 // Producer represents SNS producer.
 type Producer struct {
-	api *aws_sns.Client
-	url string
+	api   *aws_sns.Client
+	url   string
+	codec *payload.Codec
+}
+
+func NewProducer(awsConfig aws.Config, url string, opts ...ProducerOption) (*Producer, error) {
+	p := &Producer{
+		api:   aws_sns.NewFromConfig(awsConfig),
+		url:   url,
+		codec: payload.NewCodec(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p, nil
 }
 
-func NewProducer(awsConfig aws.Config, url string) (*Producer, error) {
-	return &Producer{
-		api: aws_sns.NewFromConfig(awsConfig),
-		url: url,
-	}, nil
+// WithPayloadCodec allows to specify the codec used to compress or offload to S3 the bodies of the messages.
+func WithPayloadCodec(codec *payload.Codec) ProducerOption {
+	return func(p *Producer) {
+		p.codec = codec
+	}
 }
 
 // SendMessage sends messages to SQS.
 func (p *Producer) SendMessage(ctx context.Context, groupID, deduplicationID, body string) error {
-	_, err := p.api.Publish(ctx,
+	body, encodingAttrs, err := p.codec.Encode(ctx, deduplicationID, body)
+	if err != nil {
+		return err
+	}
+	var attrs map[string]types.MessageAttributeValue
+	if len(encodingAttrs) > 0 {
+		attrs = make(map[string]types.MessageAttributeValue, len(encodingAttrs))
+		for name, value := range encodingAttrs {
+			attrs[name] = types.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(value),
+			}
+		}
+	}
+	_, err = p.api.Publish(ctx,
 		&aws_sns.PublishInput{
 			MessageGroupId:         aws.String(groupID),
 			MessageDeduplicationId: aws.String(deduplicationID),
 			Message:                aws.String(body),
 			TopicArn:               aws.String(p.url),
+			MessageAttributes:      attrs,
 		})
 	return err
 }
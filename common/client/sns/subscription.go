@@ -0,0 +1,29 @@
+package sns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aws_sns "github.com/aws/aws-sdk-go-v2/service/sns"
+)
+
+// filterPolicyAttribute is the subscription attribute that contains the filter policy.
+const filterPolicyAttribute = "FilterPolicy"
+
+// SetSubscriptionFilterPolicy sets the filter policy of a SNS subscription.
+// The filter policy is applied to the message attributes, so only the matching messages are delivered to the subscription.
+// An empty filter policy removes the filter and all the messages are delivered.
+func SetSubscriptionFilterPolicy(ctx context.Context, awsConfig aws.Config, subscriptionArn, filterPolicy string) error {
+	if filterPolicy != "" && !json.Valid([]byte(filterPolicy)) {
+		return fmt.Errorf("invalid filter policy %s", filterPolicy)
+	}
+	_, err := aws_sns.NewFromConfig(awsConfig).SetSubscriptionAttributes(ctx,
+		&aws_sns.SetSubscriptionAttributesInput{
+			SubscriptionArn: aws.String(subscriptionArn),
+			AttributeName:   aws.String(filterPolicyAttribute),
+			AttributeValue:  aws.String(filterPolicy),
+		})
+	return err
+}
@@ -0,0 +1,141 @@
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBackoffInitialInterval = 1 * time.Second
+	defaultBackoffMaxInterval     = 1 * time.Minute
+	defaultBackoffFatalThreshold  = 10
+)
+
+// BackoffOption represents a backoff option function.
+type BackoffOption func(*Backoff)
+
+// FailureObserver is called each time the number of consecutive failures changes.
+type FailureObserver func(consecutiveFailures int)
+
+// Backoff applies an exponential backoff between consecutive errors polling messages from SQS.
+//
+// A persistent error (e.g. bad credentials or a deleted queue) would otherwise make the consumer
+// spin in a hot loop. Once the number of consecutive failures reaches the fatal threshold,
+// the health check returned by HealthCheck starts failing.
+type Backoff struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	fatalThreshold  int
+	observer        FailureObserver
+
+	mu       sync.Mutex
+	failures int
+	lastErr  error
+}
+
+// NewBackoff creates a new Backoff.
+func NewBackoff(opts ...BackoffOption) *Backoff {
+	b := &Backoff{
+		initialInterval: defaultBackoffInitialInterval,
+		maxInterval:     defaultBackoffMaxInterval,
+		fatalThreshold:  defaultBackoffFatalThreshold,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// WithBackoffInterval allows to specify the initial and maximum wait between retries.
+func WithBackoffInterval(initial, max time.Duration) BackoffOption {
+	return func(b *Backoff) {
+		b.initialInterval = initial
+		b.maxInterval = max
+	}
+}
+
+// WithFatalThreshold allows to specify the number of consecutive failures that makes the health check fail.
+func WithFatalThreshold(threshold int) BackoffOption {
+	return func(b *Backoff) {
+		b.fatalThreshold = threshold
+	}
+}
+
+// WithFailureObserver allows to specify a function called when the number of consecutive failures changes.
+func WithFailureObserver(observer FailureObserver) BackoffOption {
+	return func(b *Backoff) {
+		b.observer = observer
+	}
+}
+
+// Failure records a failure and waits for the backoff interval or until the context is done.
+func (b *Backoff) Failure(ctx context.Context, err error) {
+	b.mu.Lock()
+	b.failures++
+	b.lastErr = err
+	failures := b.failures
+	b.mu.Unlock()
+
+	if b.observer != nil {
+		b.observer(failures)
+	}
+
+	timer := time.NewTimer(b.Interval(failures))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// Success resets the consecutive failures.
+func (b *Backoff) Success() {
+	b.mu.Lock()
+	failures := b.failures
+	b.failures = 0
+	b.lastErr = nil
+	b.mu.Unlock()
+
+	if failures > 0 && b.observer != nil {
+		b.observer(0)
+	}
+}
+
+// Interval returns the wait after the given number of consecutive failures.
+func (b *Backoff) Interval(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	interval := b.initialInterval
+	for i := 1; i < failures; i++ {
+		interval *= 2
+		if interval >= b.maxInterval {
+			return b.maxInterval
+		}
+	}
+	if interval > b.maxInterval {
+		return b.maxInterval
+	}
+	return interval
+}
+
+// ConsecutiveFailures returns the number of consecutive failures.
+func (b *Backoff) ConsecutiveFailures() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures
+}
+
+// HealthCheck returns a check that fails when the consecutive failures reach the fatal threshold.
+func (b *Backoff) HealthCheck() func(context.Context) error {
+	return func(_ context.Context) error {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if b.fatalThreshold > 0 && b.failures >= b.fatalThreshold {
+			return fmt.Errorf("sqs consumer failed %d consecutive times: %w", b.failures, b.lastErr)
+		}
+		return nil
+	}
+}
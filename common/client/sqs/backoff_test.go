@@ -0,0 +1,54 @@
+package sqs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/test-go/testify/assert"
+)
+
+func TestBackoffInterval(t *testing.T) {
+	b := NewBackoff(WithBackoffInterval(time.Second, 10*time.Second))
+
+	assert.Equal(t, time.Duration(0), b.Interval(0))
+	assert.Equal(t, time.Second, b.Interval(1))
+	assert.Equal(t, 2*time.Second, b.Interval(2))
+	assert.Equal(t, 8*time.Second, b.Interval(4))
+	assert.Equal(t, 10*time.Second, b.Interval(5))
+	assert.Equal(t, 10*time.Second, b.Interval(100))
+}
+
+func TestBackoffHealthCheck(t *testing.T) {
+	var observed []int
+	b := NewBackoff(
+		WithBackoffInterval(time.Millisecond, time.Millisecond),
+		WithFatalThreshold(2),
+		WithFailureObserver(func(failures int) { observed = append(observed, failures) }),
+	)
+	check := b.HealthCheck()
+	ctx := context.Background()
+
+	b.Failure(ctx, errors.New("queue does not exist"))
+	assert.NoError(t, check(ctx))
+
+	b.Failure(ctx, errors.New("queue does not exist"))
+	assert.Error(t, check(ctx))
+	assert.Equal(t, 2, b.ConsecutiveFailures())
+
+	b.Success()
+	assert.NoError(t, check(ctx))
+	assert.Equal(t, 0, b.ConsecutiveFailures())
+	assert.Equal(t, []int{1, 2, 0}, observed)
+}
+
+func TestBackoffFailureCancelled(t *testing.T) {
+	b := NewBackoff(WithBackoffInterval(time.Hour, time.Hour))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	b.Failure(ctx, errors.New("queue does not exist"))
+	assert.True(t, time.Since(start) < time.Second)
+}
@@ -0,0 +1,287 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
+	"go.uber.org/zap"
+)
+
+// snsNotification is the SNS notification delivered to a SQS queue subscribed to a topic.
+type snsNotification struct {
+	MessageID         string                       `json:"MessageId"`
+	Message           string                       `json:"Message"`
+	MessageAttributes map[string]payload.Attribute `json:"MessageAttributes"`
+}
+
+// ConsumerMessage is a message of an EventQueue, with the event converted from its body.
+type ConsumerMessage[E any] interface {
+	Retry() uint8
+	Data() *E
+	Done()
+	Failed()
+	IsExpired() bool
+	SentTimestamp() *time.Time
+}
+
+// ConverterFunc converts the body of a message to an event.
+// A nil event without error means the message cannot be handled and it is deleted.
+type ConverterFunc[E any] func(string) (*E, error)
+
+// EventQueueHooks are the functions called with the events of an EventQueue, mainly to record metrics.
+// All of them are optional.
+type EventQueueHooks[E any] struct {
+	// Attributes sets the fields of the event taken from the message attributes.
+	Attributes func(event *E, attributes map[string]payload.Attribute)
+	// Received is called with each event converted from a message, before it is filtered.
+	Received func(event *E)
+	// Filter returns true for the events deleted from the queue without being dispatched.
+	Filter func(event *E) bool
+	// Dispatched is called with each event dispatched to the consumers.
+	Dispatched func(event *E)
+	// Done is called when a dispatched message is done.
+	Done func(event *E, retry uint8)
+	// Failed is called when a dispatched message is failed.
+	Failed func(event *E, retry uint8)
+}
+
+// EventQueueConfig is the configuration of an EventQueue.
+type EventQueueConfig[E any] struct {
+	// ChannelSize is the size of the channel the messages are dispatched to, 10 when it is not positive.
+	ChannelSize int
+	// Backoff is applied when getting messages from SQS fails, a default backoff when nil.
+	Backoff *Backoff
+	// Codec decodes the compressed or offloaded message bodies, a codec without S3 store when nil.
+	Codec *payload.Codec
+	// Hooks are called with the events of the queue.
+	Hooks EventQueueHooks[E]
+}
+
+// EventQueue receives the messages of a SQS queue, converts them to events and dispatches them to a channel.
+type EventQueue[E any] struct {
+	consumer  *Consumer
+	converter ConverterFunc[E]
+	cfg       EventQueueConfig[E]
+	logger    *zap.Logger
+	mu        sync.Mutex
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewEventQueue creates an EventQueue.
+func NewEventQueue[E any](consumer *Consumer, converter ConverterFunc[E], cfg EventQueueConfig[E], logger *zap.Logger) *EventQueue[E] {
+	if cfg.ChannelSize <= 0 {
+		cfg.ChannelSize = 10
+	}
+	if cfg.Backoff == nil {
+		cfg.Backoff = NewBackoff()
+	}
+	if cfg.Codec == nil {
+		cfg.Codec = payload.NewCodec()
+	}
+	return &EventQueue[E]{
+		consumer:  consumer,
+		converter: converter,
+		cfg:       cfg,
+		logger:    logger.With(zap.String("queueUrl", consumer.GetQueueUrl())),
+	}
+}
+
+// Consume starts receiving the messages from the SQS queue and returns the channel they are dispatched to.
+//
+// The channel is owned by the producer goroutine, which is the only one sending to it and closing it.
+// It stops when the context is canceled or Close is called, and then closes the channel: the consumers
+// drain the messages already dispatched and stop once the channel is empty.
+// Calling Consume again stops the previous producer and starts a new one on a new channel.
+func (q *EventQueue[E]) Consume(ctx context.Context) <-chan ConsumerMessage[E] {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.stop()
+
+	ctx, cancel := context.WithCancel(ctx)
+	ch := make(chan ConsumerMessage[E], q.cfg.ChannelSize)
+	done := make(chan struct{})
+	q.cancel, q.done = cancel, done
+
+	go func() {
+		defer close(done)
+		defer close(ch)
+		q.produce(ctx, ch)
+		q.logger.Info("Stopped consuming messages from SQS")
+	}()
+	return ch
+}
+
+// Close stops the producer and waits until it closes the channel.
+// The messages already dispatched are still delivered to the consumers. It is safe to call it several times.
+func (q *EventQueue[E]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.stop()
+}
+
+// stop cancels the running producer, if any, and waits for it. It must be called with the lock held.
+func (q *EventQueue[E]) stop() {
+	if q.cancel == nil {
+		return
+	}
+	q.cancel()
+	<-q.done
+	q.cancel, q.done = nil, nil
+}
+
+// produce receives the messages from the SQS queue and dispatches them to the channel until the context is canceled.
+// The messages of a batch are dispatched before the next batch is received, once they are done or failed.
+func (q *EventQueue[E]) produce(ctx context.Context, ch chan<- ConsumerMessage[E]) {
+	hooks := q.cfg.Hooks
+	var wg sync.WaitGroup
+	for ctx.Err() == nil {
+		messages, err := q.consumer.GetMessages(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			q.logger.Error("Error getting messages from SQS", zap.Error(err))
+			q.cfg.Backoff.Failure(ctx, err)
+			continue
+		}
+		q.cfg.Backoff.Success()
+		q.logger.Debug("Received messages from SQS", zap.Int("count", len(messages)))
+		expiredAt := time.Now().Add(q.consumer.GetVisibilityTimeout())
+		for _, msg := range messages {
+			// unmarshal body to the sns notification
+			var notification snsNotification
+			err := json.Unmarshal([]byte(*msg.Body), &notification)
+			if err != nil {
+				q.logger.Error("Error decoding message from SQS", zap.Error(err), zap.String("body", *msg.Body))
+				q.delete(ctx, msg.ReceiptHandle)
+				continue
+			}
+
+			// decode the compressed or offloaded message body
+			message, err := q.cfg.Codec.Decode(ctx, notification.Message, notification.MessageAttributes)
+			if err != nil {
+				// the message is not deleted, it is retried once the visibility timeout expires.
+				q.logger.Error("Error decoding message body", zap.String("messageId", notification.MessageID), zap.Error(err))
+				continue
+			}
+
+			// convert message to event
+			event, err := q.converter(message)
+			if err != nil {
+				q.logger.Error("Error converting event message", zap.Error(err), zap.String("body", *msg.Body))
+				q.delete(ctx, msg.ReceiptHandle)
+				continue
+			}
+			if event == nil {
+				q.logger.Warn("Can not handle message", zap.String("body", *msg.Body))
+				q.delete(ctx, msg.ReceiptHandle)
+				continue
+			}
+			if hooks.Attributes != nil {
+				hooks.Attributes(event, notification.MessageAttributes)
+			}
+			if hooks.Received != nil {
+				hooks.Received(event)
+			}
+			if hooks.Filter != nil && hooks.Filter(event) {
+				q.delete(ctx, msg.ReceiptHandle)
+				continue
+			}
+			if hooks.Dispatched != nil {
+				hooks.Dispatched(event)
+			}
+
+			retry, _ := strconv.Atoi(msg.Attributes["ApproximateReceiveCount"])
+			wg.Add(1)
+			consumerMessage := &eventMessage[E]{
+				id:            msg.ReceiptHandle,
+				data:          event,
+				wg:            &wg,
+				logger:        q.logger,
+				consumer:      q.consumer,
+				hooks:         &q.cfg.Hooks,
+				retry:         uint8(retry),
+				expiredAt:     expiredAt,
+				sentTimestamp: GetSentTimestamp(msg),
+				ctx:           context.WithoutCancel(ctx),
+			}
+
+			// stop dispatching messages on shutdown, they will be visible again in the queue
+			// once the visibility timeout expires.
+			select {
+			case ch <- consumerMessage:
+			case <-ctx.Done():
+				wg.Done()
+			}
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		// on shutdown the channel is closed right away, the consumers drain the dispatched messages.
+		if ctx.Err() != nil {
+			return
+		}
+		wg.Wait()
+	}
+}
+
+func (q *EventQueue[E]) delete(ctx context.Context, id *string) {
+	if err := q.consumer.DeleteMessage(ctx, id); err != nil {
+		q.logger.Error("Error deleting message from SQS", zap.Error(err))
+	}
+}
+
+type eventMessage[E any] struct {
+	data          *E
+	consumer      *Consumer
+	wg            *sync.WaitGroup
+	id            *string
+	logger        *zap.Logger
+	hooks         *EventQueueHooks[E]
+	retry         uint8
+	expiredAt     time.Time
+	sentTimestamp *time.Time
+	ctx           context.Context
+}
+
+func (m *eventMessage[E]) Data() *E {
+	return m.data
+}
+
+func (m *eventMessage[E]) Done() {
+	if err := m.consumer.DeleteMessage(m.ctx, m.id); err != nil {
+		m.logger.Error("Error deleting message from SQS",
+			zap.Bool("isExpired", m.IsExpired()),
+			zap.Time("expiredAt", m.expiredAt),
+			zap.Error(err),
+		)
+	}
+	if m.hooks.Done != nil {
+		m.hooks.Done(m.data, m.retry)
+	}
+	m.wg.Done()
+}
+
+func (m *eventMessage[E]) Failed() {
+	if m.hooks.Failed != nil {
+		m.hooks.Failed(m.data, m.retry)
+	}
+	m.wg.Done()
+}
+
+func (m *eventMessage[E]) IsExpired() bool {
+	return m.expiredAt.Before(time.Now())
+}
+
+func (m *eventMessage[E]) Retry() uint8 {
+	return m.retry
+}
+
+func (m *eventMessage[E]) SentTimestamp() *time.Time {
+	return m.sentTimestamp
+}
@@ -0,0 +1,184 @@
+package txtracker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+)
+
+// ServiceName is the full name of the tx-tracker internal gRPC service.
+const ServiceName = "txtracker.v1.TxTrackerService"
+
+// CodecName is the name of the codec used by the tx-tracker gRPC service.
+//
+// The messages are encoded as json, so the service can be called without generated protobuf code.
+const CodecName = "json"
+
+const resolveOriginTxMethod = "/" + ServiceName + "/ResolveOriginTx"
+
+var ErrOriginTxNotFound = errors.New("ORIGIN TX NOT FOUND")
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+// ResolveOriginTxRequest represent a resolve origin tx request.
+type ResolveOriginTxRequest struct {
+	ChainID uint16 `json:"chainId"`
+	// TxHash is the transaction hash as found in the vaa.
+	TxHash string `json:"txHash"`
+	// Timestamp is the vaa timestamp, used to disambiguate solana transactions.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// ResolveOriginTxResponse represent a resolve origin tx response.
+type ResolveOriginTxResponse struct {
+	From         string             `json:"from"`
+	NativeTxHash string             `json:"nativeTxHash"`
+	Attribute    *OriginTxAttribute `json:"attribute,omitempty"`
+	Fee          *OriginTxFee       `json:"fee,omitempty"`
+}
+
+// OriginTxAttribute represent the chain specific information of the origin transaction.
+type OriginTxAttribute struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// OriginTxFee represent the fee paid by the origin transaction.
+type OriginTxFee struct {
+	Fee              string            `json:"fee"`
+	RawFee           map[string]string `json:"rawFee"`
+	GasTokenNotional string            `json:"gasTokenNotional"`
+	FeeUSD           string            `json:"feeUSD"`
+}
+
+// TxTrackerServiceServer is the server API of the tx-tracker gRPC service.
+type TxTrackerServiceServer interface {
+	ResolveOriginTx(context.Context, *ResolveOriginTxRequest) (*ResolveOriginTxResponse, error)
+}
+
+// RegisterTxTrackerServiceServer registers the tx-tracker gRPC service in a server.
+func RegisterTxTrackerServiceServer(s grpc.ServiceRegistrar, srv TxTrackerServiceServer) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*TxTrackerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ResolveOriginTx",
+			Handler:    resolveOriginTxHandler,
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+func resolveOriginTxHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(ResolveOriginTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxTrackerServiceServer).ResolveOriginTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: resolveOriginTxMethod,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(TxTrackerServiceServer).ResolveOriginTx(ctx, req.(*ResolveOriginTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResolveOriginTxFunc represent a resolve origin tx function.
+type ResolveOriginTxFunc func(ctx context.Context, chainID sdk.ChainID, txHash string, timestamp *time.Time) (*ResolveOriginTxResponse, error)
+
+// TxTrackerGRPCClient tx tracker internal gRPC client.
+type TxTrackerGRPCClient struct {
+	conn    *grpc.ClientConn
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+// NewTxTrackerGRPCClient create a new instance of TxTrackerGRPCClient.
+func NewTxTrackerGRPCClient(timeout int64, addr string, logger *zap.Logger) (*TxTrackerGRPCClient, error) {
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	if addr == "" {
+		return nil, errors.New("addr can not be empty")
+	}
+
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(jsonCodec{})))
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxTrackerGRPCClient{
+		conn:    conn,
+		timeout: time.Duration(timeout) * time.Second,
+		logger:  logger,
+	}, nil
+}
+
+// ResolveOriginTx resolves the origin transaction of a chain using the tx-tracker rpc providers.
+// The timestamp of the vaa is required to resolve the solana transactions.
+func (c *TxTrackerGRPCClient) ResolveOriginTx(ctx context.Context, chainID sdk.ChainID, txHash string, timestamp *time.Time) (*ResolveOriginTxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req := ResolveOriginTxRequest{ChainID: uint16(chainID), TxHash: txHash, Timestamp: timestamp}
+	var resp ResolveOriginTxResponse
+	err := c.conn.Invoke(ctx, resolveOriginTxMethod, &req, &resp)
+	if err == nil {
+		return &resp, nil
+	}
+
+	switch status.Code(err) {
+	case codes.NotFound:
+		return nil, ErrOriginTxNotFound
+	case codes.InvalidArgument, codes.Unimplemented:
+		return nil, ErrBadRequest
+	case codes.Unavailable, codes.DeadlineExceeded:
+		c.logger.Error("error call resolve origin tx",
+			zap.Error(err),
+			zap.String("chainId", chainID.String()),
+			zap.String("txHash", txHash))
+		return nil, ErrCallEndpoint
+	default:
+		return nil, ErrInternalError
+	}
+}
+
+// Close closes the connection to the tx-tracker gRPC service.
+func (c *TxTrackerGRPCClient) Close() error {
+	return c.conn.Close()
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/auth"
 	"go.uber.org/zap"
 )
 
@@ -23,11 +24,13 @@ var (
 type TxTrackerAPIClient struct {
 	Client  http.Client
 	BaseURL string
-	Logger  *zap.Logger
+	// ApiKey is the admin api key of the tx-tracker endpoints.
+	ApiKey string
+	Logger *zap.Logger
 }
 
 // NewTxTrackerAPIClient create new instances of TxTrackerAPIClient.
-func NewTxTrackerAPIClient(timeout int64, baseURL string, logger *zap.Logger) (TxTrackerAPIClient, error) {
+func NewTxTrackerAPIClient(timeout int64, baseURL, apiKey string, logger *zap.Logger) (TxTrackerAPIClient, error) {
 	if timeout == 0 {
 		timeout = DefaultTimeout
 	}
@@ -40,10 +43,22 @@ func NewTxTrackerAPIClient(timeout int64, baseURL string, logger *zap.Logger) (T
 			Timeout: time.Duration(timeout) * time.Second,
 		},
 		BaseURL: baseURL,
+		ApiKey:  apiKey,
 		Logger:  logger,
 	}, nil
 }
 
+// post sends a json body to an endpoint of the tx-tracker with the admin api key.
+func (c *TxTrackerAPIClient) post(endpoint string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(auth.ApiKeyHeader, c.ApiKey)
+	return c.Client.Do(req)
+}
+
 // ProcessVaaResponse represent a process vaa response.
 type ProcessVaaResponse struct {
 	From         string `json:"from"`
@@ -68,7 +83,7 @@ func (c *TxTrackerAPIClient) Process(vaaID string) (*ProcessVaaResponse, error)
 		return nil, err
 	}
 
-	response, err := c.Client.Post(endpointUrl, "application/json", bytes.NewBuffer(body))
+	response, err := c.post(endpointUrl, body)
 	if err != nil {
 		c.Logger.Error("error call parse vaa endpoint", zap.Error(err), zap.String("vaaID", vaaID))
 		return nil, ErrCallEndpoint
@@ -113,7 +128,7 @@ func (c *TxTrackerAPIClient) CreateTxHash(vaaID, txHash string) (*TxHashResponse
 		return nil, err
 	}
 
-	response, err := c.Client.Post(endpoint, "application/json", bytes.NewBuffer(body))
+	response, err := c.post(endpoint, body)
 	if err != nil {
 		c.Logger.Error("error call create tx hash endpoint",
 			zap.Error(err),
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/httpclient"
 )
 
 var ErrCoinNotFound = fmt.Errorf("coin not found")
@@ -30,7 +32,7 @@ func NewCoinGeckoAPI(url, headerKey string, apiKey string) *CoinGeckoAPI {
 		ApiURL:     url,
 		HeaderKey:  headerKey,
 		ApiKey:     apiKey,
-		client:     http.DefaultClient,
+		client:     httpclient.Default(),
 		tokenCache: make(map[string]TokenItem),
 	}
 }
@@ -40,7 +42,6 @@ func (cg *CoinGeckoAPI) GetSymbolDailyPrice(coinID, days string) (*CoinHistoryRe
 	url := fmt.Sprintf("%s/api/v3/coins/%s/market_chart?vs_currency=usd&days=%s&interval=daily", cg.ApiURL, coinID, days)
 	method := "GET"
 
-	client := &http.Client{}
 	req, err := http.NewRequest(method, url, nil)
 	if err != nil {
 		return nil, err
@@ -50,7 +51,7 @@ func (cg *CoinGeckoAPI) GetSymbolDailyPrice(coinID, days string) (*CoinHistoryRe
 		req.Header.Add(cg.HeaderKey, cg.ApiKey)
 	}
 
-	res, err := client.Do(req)
+	res, err := cg.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +130,6 @@ func (cg *CoinGeckoAPI) GetSymbolByContract(ChainId string, ContractId string) (
 	url := fmt.Sprintf("https://%s/api/v3/coins/%s/contract/%s", cg.ApiURL, chain, ContractId)
 	method := "GET"
 
-	client := &http.Client{}
 	req, err := http.NewRequest(method, url, nil)
 
 	if err != nil {
@@ -137,7 +137,7 @@ func (cg *CoinGeckoAPI) GetSymbolByContract(ChainId string, ContractId string) (
 	}
 	//req.Header.Add("Cookie", "__cf_bm=jUWxA1U8U3SdvDF2EXgCZUmnDopOozWnB5VpXIjWH.c-1682970763-0-AaLD4yVrSy53aAJQwVNe61P5IcXSnW4vIMeRrsRDIMGJ/+PbEcOv/lene34+FB4Q4kapT//4660lx/Rw507zw7Q=")
 
-	res, err := client.Do(req)
+	res, err := cg.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
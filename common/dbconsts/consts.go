@@ -7,4 +7,5 @@ const (
 	ProtocolsStatsMeasurementHourly    = "protocols_stats_1h"
 	TotalProtocolsStatsDaily           = "protocols_stats_totals_1d"
 	TotalProtocolsStatsHourly          = "protocols_stats_totals_1h"
+	FeesPerChainMeasurementHourly      = "fees_per_chain_1h"
 )
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"encoding/hex"
+	"strings"
+
+	wormholesdk "github.com/wormhole-foundation/wormhole/sdk"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// GetAppIDByEmitter returns the app id of a well-known emitter.
+// Only the token bridge emitters are known, for other emitters AppIdUnkonwn is returned.
+func GetAppIDByEmitter(p2pNetwork string, chainID sdk.ChainID, emitterAddress string) string {
+	var emitters map[sdk.ChainID][]byte
+	switch p2pNetwork {
+	case P2pMainNet:
+		emitters = wormholesdk.KnownTokenbridgeEmitters
+	case P2pTestNet:
+		emitters = wormholesdk.KnownTestnetTokenbridgeEmitters
+	case P2pDevNet:
+		emitters = wormholesdk.KnownDevnetTokenbridgeEmitters
+	}
+
+	emitter, ok := emitters[chainID]
+	if ok && hex.EncodeToString(emitter) == strings.ToLower(strings.TrimPrefix(emitterAddress, "0x")) {
+		return AppIdPortalTokenBridge
+	}
+	return AppIdUnkonwn
+}
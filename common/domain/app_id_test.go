@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestGetAppIDByEmitter(t *testing.T) {
+	tokenBridge := "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"
+	assert.Equal(t, AppIdPortalTokenBridge, GetAppIDByEmitter(P2pMainNet, sdk.ChainIDEthereum, tokenBridge))
+	assert.Equal(t, AppIdPortalTokenBridge, GetAppIDByEmitter(P2pMainNet, sdk.ChainIDEthereum, "0x"+tokenBridge))
+	assert.Equal(t, AppIdUnkonwn, GetAppIDByEmitter(P2pMainNet, sdk.ChainIDBSC, tokenBridge))
+	assert.Equal(t, AppIdUnkonwn, GetAppIDByEmitter(P2pTestNet, sdk.ChainIDEthereum, tokenBridge))
+	assert.Equal(t, AppIdUnkonwn, GetAppIDByEmitter(P2pMainNet, sdk.ChainID(65000), tokenBridge))
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"runtime/debug"
+	"sort"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const wormholeSdkModule = "github.com/wormhole-foundation/wormhole/sdk"
+
+// ChainReconciliation is the result of comparing the chains known by the compiled wormhole sdk
+// with the chains present in the recorded VAAs.
+type ChainReconciliation struct {
+	// SdkVersion is the version of the compiled wormhole sdk.
+	SdkVersion string
+	// Unknown contains the chains present in the recorded VAAs that are not known by the sdk
+	// nor registered with RegisterChainIDs.
+	Unknown []sdk.ChainID
+	// Overridden contains the chains present in the recorded VAAs that are only known by configuration.
+	Overridden []sdk.ChainID
+}
+
+// HasMismatches returns true if there are recorded chains not known by the sdk.
+func (r *ChainReconciliation) HasMismatches() bool {
+	return len(r.Unknown) > 0
+}
+
+// ReconcileChainIDs compares the chains known by the compiled wormhole sdk with the recorded chains.
+func ReconcileChainIDs(recorded []sdk.ChainID) *ChainReconciliation {
+	result := &ChainReconciliation{SdkVersion: WormholeSdkVersion()}
+	for _, chainID := range recorded {
+		if chainID == sdk.ChainIDUnset {
+			continue
+		}
+		if _, ok := chainIDOverrides[chainID]; ok {
+			result.Overridden = append(result.Overridden, chainID)
+			continue
+		}
+		if !ChainIdIsValid(chainID) {
+			result.Unknown = append(result.Unknown, chainID)
+		}
+	}
+	sort.Slice(result.Unknown, func(i, j int) bool { return result.Unknown[i] < result.Unknown[j] })
+	sort.Slice(result.Overridden, func(i, j int) bool { return result.Overridden[i] < result.Overridden[j] })
+	return result
+}
+
+// WormholeSdkVersion returns the version of the wormhole sdk the binary was built with.
+func WormholeSdkVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != wormholeSdkModule {
+			continue
+		}
+		if dep.Replace != nil {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return "unknown"
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/base32"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
 
 	algorand_types "github.com/algorand/go-algorand-sdk/types"
@@ -37,6 +38,9 @@ var (
 
 var allChainIDs = make(map[sdk.ChainID]bool)
 
+// chainIDOverrides contains the chain IDs registered by configuration that are not known by the wormhole sdk.
+var chainIDOverrides = make(map[sdk.ChainID]string)
+
 func init() {
 	for _, chainID := range sdk.GetAllNetworkIDs() {
 		allChainIDs[chainID] = true
@@ -52,13 +56,50 @@ func ChainIdIsValid(id sdk.ChainID) bool {
 // GetSupportedChainIDs returns a map of all supported chain IDs to their respective names.
 func GetSupportedChainIDs() map[sdk.ChainID]string {
 	chainIDs := sdk.GetAllNetworkIDs()
-	supportedChaindIDs := make(map[sdk.ChainID]string, len(chainIDs))
+	supportedChaindIDs := make(map[sdk.ChainID]string, len(chainIDs)+len(chainIDOverrides))
 	for _, chainID := range chainIDs {
 		supportedChaindIDs[chainID] = chainID.String()
 	}
+	for chainID, name := range chainIDOverrides {
+		supportedChaindIDs[chainID] = name
+	}
 	return supportedChaindIDs
 }
 
+// RegisterChainIDs adds chain IDs that are not known by the compiled wormhole sdk,
+// so a new chain added by governance is not dropped before the sdk is updated.
+//
+// It must be called at startup, before the chain IDs are used concurrently.
+func RegisterChainIDs(overrides map[sdk.ChainID]string) {
+	for chainID, name := range overrides {
+		if _, exists := allChainIDs[chainID]; exists {
+			continue
+		}
+		allChainIDs[chainID] = true
+		chainIDOverrides[chainID] = name
+	}
+}
+
+// ParseChainIDOverrides parses a list of chain IDs with the format "id:name,id:name".
+func ParseChainIDOverrides(s string) (map[sdk.ChainID]string, error) {
+	overrides := make(map[sdk.ChainID]string)
+	if strings.TrimSpace(s) == "" {
+		return overrides, nil
+	}
+	for _, item := range strings.Split(s, ",") {
+		id, name, found := strings.Cut(strings.TrimSpace(item), ":")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid chain id override %q, expected format id:name", item)
+		}
+		chainID, err := strconv.ParseUint(id, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain id override %q: %w", item, err)
+		}
+		overrides[sdk.ChainID(chainID)] = name
+	}
+	return overrides, nil
+}
+
 // TranslateEmitterAddress converts an emitter address into the corresponding native address for the given chain.
 func TranslateEmitterAddress(chainID sdk.ChainID, address string) (string, error) {
 
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"maps"
 	"testing"
 
 	"github.com/test-go/testify/assert"
@@ -229,3 +230,75 @@ func TestTranslateEmitterAddress(t *testing.T) {
 		}
 	}
 }
+
+func TestParseChainIDOverrides(t *testing.T) {
+	overrides, err := ParseChainIDOverrides("")
+	assert.NoError(t, err)
+	assert.Empty(t, overrides)
+
+	overrides, err = ParseChainIDOverrides("60000:newchain, 60001:otherchain")
+	assert.NoError(t, err)
+	assert.Equal(t, map[sdk.ChainID]string{60000: "newchain", 60001: "otherchain"}, overrides)
+
+	_, err = ParseChainIDOverrides("60000")
+	assert.Error(t, err)
+
+	_, err = ParseChainIDOverrides("abc:newchain")
+	assert.Error(t, err)
+
+	_, err = ParseChainIDOverrides("70000:newchain")
+	assert.Error(t, err)
+}
+
+// registerChainIDs registers the chain IDs for a test and restores the registered chains once it finishes.
+func registerChainIDs(t *testing.T, overrides map[sdk.ChainID]string) {
+	all, registered := maps.Clone(allChainIDs), maps.Clone(chainIDOverrides)
+	t.Cleanup(func() {
+		allChainIDs, chainIDOverrides = all, registered
+	})
+	RegisterChainIDs(overrides)
+}
+
+func TestReconcileChainIDs(t *testing.T) {
+	registerChainIDs(t, map[sdk.ChainID]string{60100: "newchain"})
+
+	result := ReconcileChainIDs([]sdk.ChainID{sdk.ChainIDEthereum, sdk.ChainIDSolana, 60101, 60100, sdk.ChainIDUnset})
+	assert.Equal(t, []sdk.ChainID{60101}, result.Unknown)
+	assert.Equal(t, []sdk.ChainID{60100}, result.Overridden)
+	assert.True(t, result.HasMismatches())
+	assert.True(t, ChainIdIsValid(60100))
+	assert.Equal(t, "newchain", GetSupportedChainIDs()[60100])
+
+	result = ReconcileChainIDs([]sdk.ChainID{sdk.ChainIDEthereum})
+	assert.False(t, result.HasMismatches())
+}
+
+func TestParseProtocolEmitters(t *testing.T) {
+	emitters, err := ParseProtocolEmitters("")
+	assert.NoError(t, err)
+	assert.Empty(t, emitters)
+
+	emitters, err = ParseProtocolEmitters("2:0x3e76EE8e55cd10C2aDD47F1A4a9B2d5BbC8Df0bA:SWAP_LAYER, 1:5c3a9a5f4e4f2b3c3b58e0e2b1a7d6c9f0e4d3b2a1c0f9e8d7c6b5a4f3e2d1c0:MAYAN_SWIFT")
+	assert.NoError(t, err)
+	assert.Len(t, emitters, 2)
+
+	protocol, ok := emitters.Protocol(sdk.ChainIDEthereum, "0000000000000000000000003e76ee8e55cd10c2add47f1a4a9b2d5bbc8df0ba")
+	assert.True(t, ok)
+	assert.Equal(t, "SWAP_LAYER", protocol)
+
+	protocol, ok = emitters.Protocol(sdk.ChainIDSolana, "5c3a9a5f4e4f2b3c3b58e0e2b1a7d6c9f0e4d3b2a1c0f9e8d7c6b5a4f3e2d1c0")
+	assert.True(t, ok)
+	assert.Equal(t, "MAYAN_SWIFT", protocol)
+
+	_, ok = emitters.Protocol(sdk.ChainIDBSC, "0000000000000000000000003e76ee8e55cd10c2add47f1a4a9b2d5bbc8df0ba")
+	assert.False(t, ok)
+
+	_, err = ParseProtocolEmitters("2:0x3e76ee8e55cd10c2add47f1a4a9b2d5bbc8df0ba")
+	assert.Error(t, err)
+
+	_, err = ParseProtocolEmitters("abc:0x3e76ee8e55cd10c2add47f1a4a9b2d5bbc8df0ba:SWAP_LAYER")
+	assert.Error(t, err)
+
+	_, err = ParseProtocolEmitters("2:0xzz:SWAP_LAYER")
+	assert.Error(t, err)
+}
@@ -33,3 +33,17 @@ const (
 	DstTxStatusConfirmed       = "completed"
 	DstTxStatusUnkonwn         = "unknown"
 )
+
+// TxFinality describes the finality of the block that included a transaction at the time it was observed.
+type TxFinality string
+
+const (
+	// TxFinalityFinalized indicates that the block was finalized and can no longer be reorganized.
+	TxFinalityFinalized TxFinality = "finalized"
+
+	// TxFinalitySafe indicates that the block was safe but not yet finalized.
+	TxFinalitySafe TxFinality = "safe"
+
+	// TxFinalityUnsafe indicates that the block was neither safe nor finalized.
+	TxFinalityUnsafe TxFinality = "unsafe"
+)
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"sort"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Contracts are the addresses of the wormhole contracts deployed in a chain, encoded in the chain's native format.
+// The address of a contract is empty when it is not deployed in the chain.
+type Contracts struct {
+	ChainID     sdk.ChainID
+	Core        string
+	TokenBridge string
+	NFTBridge   string
+}
+
+// mainnetContracts is the address book of the contracts deployed in mainnet.
+var mainnetContracts = []Contracts{
+	{ChainID: sdk.ChainIDSolana, Core: "worm2ZoG2kUd4vFXhvjh93UUH596ayRfgQ2MgjNMTth", TokenBridge: "wormDTUJ6AWPNvk59vGQbDvGJmqbDTdgWgAqcLBCgUb", NFTBridge: "WnFt12ZrnzZrFZkt2xsNsaNWoQribnuQ5B5FrDbwDhD"},
+	{ChainID: sdk.ChainIDEthereum, Core: "0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B", TokenBridge: "0x3ee18B2214AFF97000D974cf647E7C347E8fa585", NFTBridge: "0x6FFd7EdE62328b3Af38FCD61461Bbfc52F5651fE"},
+	{ChainID: sdk.ChainIDTerra, Core: "terra1dq03ugtd40zu9hcgdzrsq6z2z4hwhc9tqk2uy5", TokenBridge: "terra10nmmwe8r3g99a9newtqa7a75xfgs2e8z87r2sf"},
+	{ChainID: sdk.ChainIDBSC, Core: "0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B", TokenBridge: "0xB6F6D86a8f9879A9c87f643768d9efc38c1Da6E7", NFTBridge: "0x5a58505a96D1dbf8dF91cB21B54419FC36e93fdE"},
+	{ChainID: sdk.ChainIDPolygon, Core: "0x7A4B5a56256163F07b2C80A7cA55aBE66c4ec4d7", TokenBridge: "0x5a58505a96D1dbf8dF91cB21B54419FC36e93fdE", NFTBridge: "0x90BBd86a6Fe93D3bc3ed6335935447E75fAb7fCf"},
+	{ChainID: sdk.ChainIDAvalanche, Core: "0x54a8e5f9c4CbA08F9943965859F6c34eAF03E26c", TokenBridge: "0x0e082F06FF657D94310cB8cE8B0D9a04541d8052", NFTBridge: "0xf7B6737Ca9c4e08aE573F75A97B73D7a813f5De5"},
+	{ChainID: sdk.ChainIDOasis, Core: "0xfE8cD454b4A1CA468B57D79c0cc77Ef5B6f64585", TokenBridge: "0x5848C791e09901b40A9Ef749f2a6735b418d7564", NFTBridge: "0x04952D522Ff217f40B5Ef3cbF659EcA7b952a6c1"},
+	{ChainID: sdk.ChainIDAlgorand, Core: "842125965", TokenBridge: "842126029"},
+	{ChainID: sdk.ChainIDFantom, Core: "0x126783A6Cb203a3E35344528B26ca3a0489a1485", TokenBridge: "0x7C9Fc5741288cDFdD83CeB07f3ea7e22618D79D2", NFTBridge: "0xA9c7119aBDa80d4a4E0C06C8F4d8cF5893234535"},
+	{ChainID: sdk.ChainIDKarura, Core: "0xa321448d90d4e5b0A732867c18eA198e75CAC48E", TokenBridge: "0xae9d7fe007b3327AA64A32824Aaac52C42a6E624", NFTBridge: "0xb91e3638F82A1fACb28690b37e3aAE45d2c33808"},
+	{ChainID: sdk.ChainIDAcala, Core: "0xa321448d90d4e5b0A732867c18eA198e75CAC48E", TokenBridge: "0xae9d7fe007b3327AA64A32824Aaac52C42a6E624", NFTBridge: "0xb91e3638F82A1fACb28690b37e3aAE45d2c33808"},
+	{ChainID: sdk.ChainIDKlaytn, Core: "0x0C21603c4f3a6387e241c0091A7EA39E43E90bb7", TokenBridge: "0x5b08ac39EAED75c0439FC750d9FE7E1F9dD0193F", NFTBridge: "0x3c3c561757BAa0b78c5C025CdEAa4ee24C1dFfEf"},
+	{ChainID: sdk.ChainIDCelo, Core: "0xa321448d90d4e5b0A732867c18eA198e75CAC48E", TokenBridge: "0x796Dff6D74F3E27060B71255Fe517BFb23C93eed", NFTBridge: "0xA6A377d75ca5c9052c9a77ED1e865Cc25Bd97bf3"},
+	{ChainID: sdk.ChainIDNear, Core: "contract.wormhole_crypto.near", TokenBridge: "contract.portalbridge.near"},
+	{ChainID: sdk.ChainIDMoonbeam, Core: "0xC8e2b0cD52Cf01b0Ce87d389Daa3d414d4cE29f3", TokenBridge: "0xb1731c586ca89a23809861c6103f0b96b3f57d92", NFTBridge: "0x453cfbe096c0f8d763e8c5f24b441097d577bde2"},
+	{ChainID: sdk.ChainIDTerra2, Core: "terra12mrnzvhx3rpej6843uge2yyfppfyd3u9c3uq223q8sl48huz9juqffcnhp", TokenBridge: "terra153366q50k7t8nn7gec00hg66crnhkdggpgdtaxltaq6xrutkkz3s992fw9"},
+	{ChainID: sdk.ChainIDInjective, Core: "inj17p9rzwnnfxcjp32un9ug7yhhzgtkhvl9l2q74d", TokenBridge: "inj1ghd753shjuwexxywmgs4xz7x2q732vcnxxynfn"},
+	{ChainID: sdk.ChainIDSui, Core: "0xaeab97f96cf9877fee2883315d459552b2b921edc16d7ceac6eab944dd88919c", TokenBridge: "0xc57508ee0d4595e5a8728974a4a93a787d38f339757230d441e895422c07aba9"},
+	{ChainID: sdk.ChainIDAptos, Core: "0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625", TokenBridge: "0x576410486a2da45eee6c949c995670112ddf2fbeedab20350d506328eefc9d4f", NFTBridge: "0x1bdffae984043833ed7fe223f7af7a3f8902d04129b14f801823e64827da7130"},
+	{ChainID: sdk.ChainIDArbitrum, Core: "0xa5f208e072434bC67592E4C49C1B991BA79BCA46", TokenBridge: "0x0b2402144Bb366A632D14B83F244D2e0e21bD39c", NFTBridge: "0x3dD14D553cFD986EAC8e3bddF629d82073e188c8"},
+	{ChainID: sdk.ChainIDOptimism, Core: "0xEe91C335eab126dF5fDB3797EA9d6aD93aeC9722", TokenBridge: "0x1D68124e65faFC907325e3EDbF8c4d84499DAa8b", NFTBridge: "0xfE8cD454b4A1CA468B57D79c0cc77Ef5B6f64585"},
+	{ChainID: sdk.ChainIDBase, Core: "0xbebdb6C8ddC678FfA9f8748f85C815C556Dd8ac6", TokenBridge: "0x8d2de8d2f73F1F4cAB472AC9A881C9b123C79627", NFTBridge: "0xDA3adC6621B2677BEf9aD26598e6939CF0D92f88"},
+	{ChainID: sdk.ChainIDScroll, Core: "0xbebdb6C8ddC678FfA9f8748f85C815C556Dd8ac6", TokenBridge: "0x24850c6f61C438823F01B7A3BF2B89B72174Fa9d"},
+	{ChainID: sdk.ChainIDWormchain, Core: "wormhole1ufs3tlq4umljk0qfe8k5ya0x6hpavn897u2cnf9k0en9jr7qarqqaqfk2j"},
+}
+
+// testnetContracts is the address book of the contracts deployed in testnet.
+var testnetContracts = []Contracts{
+	{ChainID: sdk.ChainIDSolana, Core: "3u8hJUVTA4jH1wYAyUur7FFZVQ8H635K3tSHHF4ssjQ5", TokenBridge: "DZnkkTmCiFWfYTfT41X3Rd1kDgozqzxWaHqsw6W4x2oe", NFTBridge: "2rHhojZ7hpu1zA91nvZmT8TqWWvMcKmmNBCr2mKTtMq4"},
+	{ChainID: sdk.ChainIDBSC, Core: "0x68605AD7b15c732a30b1BbC62BE8F2A509D74b4D", TokenBridge: "0x9dcF9D205C9De35334D646BeE44b2D2859712A09", NFTBridge: "0xcD16E5613EF35599dc82B24Cb45B5A93D779f1EE"},
+	{ChainID: sdk.ChainIDAvalanche, Core: "0x7bbcE28e64B3F8b84d876Ab298393c38ad7aac4C", TokenBridge: "0x61E44E506Ca5659E6c0bba9b678586fA2d729756", NFTBridge: "0xD601BAf2EEE3C028344471684F6b27E789D9075D"},
+	{ChainID: sdk.ChainIDAlgorand, Core: "86525623", TokenBridge: "86525641"},
+	{ChainID: sdk.ChainIDFantom, Core: "0x1BB3B4119b7BA9dfad76B0545fb3F531383c3bB7", TokenBridge: "0x599CEa2204B4FaECd584Ab1F2b6aCA137a0afbE8", NFTBridge: "0x63eD9318628D26BdCB15df58B53BB27231D1B227"},
+	{ChainID: sdk.ChainIDCelo, Core: "0x88505117CA88e7dd2eC6EA1E13f0948db2D50D56", TokenBridge: "0x05ca6037eC51F8b712eD2E6Fa72219FEaE74E153", NFTBridge: "0xaCD8190F647a31E56A656748bC30F69259f245Db"},
+	{ChainID: sdk.ChainIDMoonbeam, Core: "0xa5B7D85a8f27dd7907dc8FdC21FA5657D5E2F901", TokenBridge: "0xbc976D4b9D57E57c3cA52e1Fd136C45FF7955A96", NFTBridge: "0x98A0F4B96972b32Fcb3BD03cAeB66A44a6aB9Edb"},
+	{ChainID: sdk.ChainIDSui, Core: "0x31358d198147da50db32eda2562951d53973a0c0ad5ed738e9b17d88b213d790", TokenBridge: "0x6fb10cdb7aa299e9a4308752dadecb049ff55a892de92992a1edbd7912b3d6da"},
+	{ChainID: sdk.ChainIDAptos, Core: "0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625", TokenBridge: "0x576410486a2da45eee6c949c995670112ddf2fbeedab20350d506328eefc9d4f", NFTBridge: "0x1bdffae984043833ed7fe223f7af7a3f8902d04129b14f801823e64827da7130"},
+	{ChainID: sdk.ChainIDSepolia, Core: "0x4a8bc80Ed5a4067f1CCf107057b8270E0cC11A78", TokenBridge: "0xDB5492265f6038831E89f495670FF909aDe94bd9", NFTBridge: "0x6a0B52ac198e4870e5F3797d5B403838a5bbFD99"},
+	{ChainID: sdk.ChainIDArbitrumSepolia, Core: "0x6b9C8671cdDC8dEab9c719bB87cBd3e782bA6a35", TokenBridge: "0xC7A204bDBFe983FCD8d8E61D02b475D4073fF97e"},
+	{ChainID: sdk.ChainIDBaseSepolia, Core: "0x79A1027a6A159502049F10906D333EC57E95F083", TokenBridge: "0x86F55A04690fd7815A3D802bD587e83eA888B239"},
+	{ChainID: sdk.ChainIDOptimismSepolia, Core: "0x31377888146f3253211EFEf5c676D41ECe7D58Fe", TokenBridge: "0x99737Ec4B815d816c49A385943baf0380e75c0Ac"},
+}
+
+// ContractsByNetwork returns the address book of a p2p network sorted by chain id.
+// It returns nil for an unknown network.
+func ContractsByNetwork(p2pNetwork string) []Contracts {
+	var contracts []Contracts
+	switch p2pNetwork {
+	case P2pMainNet:
+		contracts = mainnetContracts
+	case P2pTestNet:
+		contracts = testnetContracts
+	default:
+		return nil
+	}
+	sorted := make([]Contracts, len(contracts))
+	copy(sorted, contracts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ChainID < sorted[j].ChainID })
+	return sorted
+}
+
+// GetContracts returns the contracts deployed in a chain of a p2p network.
+func GetContracts(p2pNetwork string, chainID sdk.ChainID) (Contracts, bool) {
+	for _, c := range ContractsByNetwork(p2pNetwork) {
+		if c.ChainID == chainID {
+			return c, true
+		}
+	}
+	return Contracts{}, false
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestContractsByNetwork(t *testing.T) {
+	for _, network := range []string{P2pMainNet, P2pTestNet} {
+		contracts := ContractsByNetwork(network)
+		assert.NotEmpty(t, contracts)
+		for i := range contracts {
+			assert.NotEmpty(t, contracts[i].Core, "chain %d", contracts[i].ChainID)
+			if i > 0 {
+				assert.True(t, contracts[i-1].ChainID < contracts[i].ChainID)
+			}
+		}
+	}
+	assert.Nil(t, ContractsByNetwork(P2pDevNet))
+}
+
+func TestGetContracts(t *testing.T) {
+	c, ok := GetContracts(P2pMainNet, sdk.ChainIDEthereum)
+	assert.True(t, ok)
+	assert.Equal(t, "0x3ee18B2214AFF97000D974cf647E7C347E8fa585", c.TokenBridge)
+
+	_, ok = GetContracts(P2pTestNet, sdk.ChainIDEthereum)
+	assert.False(t, ok)
+}
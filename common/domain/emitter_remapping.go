@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// EmitterRemapping maps the emitters of the contracts redeployed by a chain (e.g. a new token bridge)
+// to the emitter that replaced them, so the historical stats of the old and new emitters can be aggregated.
+type EmitterRemapping map[string]string
+
+// ParseEmitterRemapping parses a list of remapped emitters with the format "chainId:oldEmitterAddress:newEmitterAddress,...".
+// The emitter addresses are the hex addresses of the emitters, with or without the 0x prefix and the left padding.
+//
+// A contract redeployed more than once is remapped to its last emitter, e.g. "2:a:b,2:b:c" remaps a and b to c.
+func ParseEmitterRemapping(s string) (EmitterRemapping, error) {
+	remapping := make(EmitterRemapping)
+	if strings.TrimSpace(s) == "" {
+		return remapping, nil
+	}
+	for _, item := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid emitter remapping %q, expected format chainId:oldEmitterAddress:newEmitterAddress", item)
+		}
+		chainID, err := strconv.ParseUint(parts[0], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid emitter remapping %q: %w", item, err)
+		}
+		oldAddress, err := sdk.StringToAddress(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid emitter remapping %q: %w", item, err)
+		}
+		newAddress, err := sdk.StringToAddress(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid emitter remapping %q: %w", item, err)
+		}
+		if oldAddress == newAddress {
+			return nil, fmt.Errorf("invalid emitter remapping %q: the emitter is remapped to itself", item)
+		}
+		remapping[protocolEmitterKey(sdk.ChainID(chainID), oldAddress.String())] = newAddress.String()
+	}
+
+	// resolve the contracts redeployed more than once to their last emitter.
+	for key, address := range remapping {
+		chainID := key[:strings.Index(key, "/")]
+		for hops := 0; ; hops++ {
+			if hops == len(remapping) {
+				return nil, fmt.Errorf("invalid emitter remapping: the emitter %s is remapped in a cycle", key)
+			}
+			next, ok := remapping[chainID+"/"+address]
+			if !ok {
+				break
+			}
+			address = next
+		}
+		remapping[key] = address
+	}
+	return remapping, nil
+}
+
+// Remap returns the emitter that replaced an emitter, or false if the emitter was not remapped.
+func (m EmitterRemapping) Remap(chainID sdk.ChainID, emitterAddress string) (string, bool) {
+	address, ok := m[protocolEmitterKey(chainID, normalizeEmitterAddress(emitterAddress))]
+	return address, ok
+}
+
+// Canonical returns the emitter that replaced an emitter, or the emitter itself if it was not remapped.
+func (m EmitterRemapping) Canonical(chainID sdk.ChainID, emitterAddress string) string {
+	if address, ok := m.Remap(chainID, emitterAddress); ok {
+		return address
+	}
+	return emitterAddress
+}
+
+func normalizeEmitterAddress(emitterAddress string) string {
+	return strings.ToLower(strings.TrimPrefix(emitterAddress, "0x"))
+}
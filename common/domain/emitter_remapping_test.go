@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParseEmitterRemapping(t *testing.T) {
+	const (
+		a = "000000000000000000000000000000000000000000000000000000000000000a"
+		b = "000000000000000000000000000000000000000000000000000000000000000b"
+		c = "000000000000000000000000000000000000000000000000000000000000000c"
+	)
+
+	remapping, err := ParseEmitterRemapping("")
+	assert.NoError(t, err)
+	assert.Empty(t, remapping)
+
+	remapping, err = ParseEmitterRemapping("2:0x0A:0b, 2:0x0b:0x0c, 4:0a:0c")
+	assert.NoError(t, err)
+
+	// the contract redeployed twice is remapped to its last emitter.
+	address, ok := remapping.Remap(sdk.ChainIDEthereum, a)
+	assert.True(t, ok)
+	assert.Equal(t, c, address)
+	address, ok = remapping.Remap(sdk.ChainIDEthereum, "0x"+b)
+	assert.True(t, ok)
+	assert.Equal(t, c, address)
+	_, ok = remapping.Remap(sdk.ChainIDEthereum, c)
+	assert.False(t, ok)
+
+	// the remappings are by chain.
+	assert.Equal(t, c, remapping.Canonical(sdk.ChainIDBSC, a))
+	assert.Equal(t, b, remapping.Canonical(sdk.ChainIDBSC, b))
+
+	for _, invalid := range []string{"2:0a", "2:0a:", "x:0a:0b", "2:zz:0b", "2:0a:0a", "2:0a:0b,2:0b:0a"} {
+		_, err = ParseEmitterRemapping(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
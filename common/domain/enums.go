@@ -0,0 +1,41 @@
+package domain
+
+import (
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Token bridge payload types.
+const (
+	TokenBridgePayloadTransfer            uint8 = 1
+	TokenBridgePayloadAttestMeta          uint8 = 2
+	TokenBridgePayloadTransferWithPayload uint8 = 3
+)
+
+// payloadTypeNames maps the payload types of the applications to their canonical names, by app id.
+// The payload types are only meaningful within an application, so they are not mapped without one.
+var payloadTypeNames = map[string]map[uint8]string{
+	AppIdPortalTokenBridge: {
+		TokenBridgePayloadTransfer:            "transfer",
+		TokenBridgePayloadAttestMeta:          "attestMeta",
+		TokenBridgePayloadTransferWithPayload: "transferWithPayload",
+	},
+}
+
+// ChainName returns the canonical name of a chain, including the chains registered with RegisterChainIDs.
+// It returns false when the chain is not known.
+func ChainName(chainID sdk.ChainID) (string, bool) {
+	if name, ok := chainIDOverrides[chainID]; ok {
+		return name, true
+	}
+	if !ChainIdIsValid(chainID) {
+		return "", false
+	}
+	return chainID.String(), true
+}
+
+// PayloadTypeName returns the canonical name of the payload type of an application.
+// It returns false when the application or the payload type are not known.
+func PayloadTypeName(appID string, payloadType uint8) (string, bool) {
+	name, ok := payloadTypeNames[appID][payloadType]
+	return name, ok
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestChainName(t *testing.T) {
+	registerChainIDs(t, map[sdk.ChainID]string{60200: "newchain"})
+
+	name, ok := ChainName(sdk.ChainIDSolana)
+	assert.True(t, ok)
+	assert.Equal(t, "solana", name)
+
+	name, ok = ChainName(60200)
+	assert.True(t, ok)
+	assert.Equal(t, "newchain", name)
+
+	_, ok = ChainName(60201)
+	assert.False(t, ok)
+}
+
+func TestPayloadTypeName(t *testing.T) {
+	name, ok := PayloadTypeName(AppIdPortalTokenBridge, TokenBridgePayloadTransferWithPayload)
+	assert.True(t, ok)
+	assert.Equal(t, "transferWithPayload", name)
+
+	_, ok = PayloadTypeName(AppIdPortalTokenBridge, 9)
+	assert.False(t, ok)
+
+	_, ok = PayloadTypeName("CCTP_WORMHOLE_INTEGRATION", TokenBridgePayloadTransfer)
+	assert.False(t, ok)
+}
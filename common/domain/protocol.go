@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// ProtocolEmitters maps the emitters of the protocols built on top of wormhole, like the fast transfers
+// or the swap layers, to the name of the protocol.
+type ProtocolEmitters map[string]string
+
+// ParseProtocolEmitters parses a list of emitters with the format "chainId:emitterAddress:protocol,...".
+// The emitter address is the hex address of the emitter, with or without the 0x prefix and the left padding.
+func ParseProtocolEmitters(s string) (ProtocolEmitters, error) {
+	emitters := make(ProtocolEmitters)
+	if strings.TrimSpace(s) == "" {
+		return emitters, nil
+	}
+	for _, item := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid protocol emitter %q, expected format chainId:emitterAddress:protocol", item)
+		}
+		chainID, err := strconv.ParseUint(parts[0], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid protocol emitter %q: %w", item, err)
+		}
+		address, err := sdk.StringToAddress(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid protocol emitter %q: %w", item, err)
+		}
+		emitters[protocolEmitterKey(sdk.ChainID(chainID), address.String())] = parts[2]
+	}
+	return emitters, nil
+}
+
+// Protocol returns the name of the protocol of an emitter.
+func (p ProtocolEmitters) Protocol(chainID sdk.ChainID, emitterAddress string) (string, bool) {
+	protocol, ok := p[protocolEmitterKey(chainID, strings.ToLower(strings.TrimPrefix(emitterAddress, "0x")))]
+	return protocol, ok
+}
+
+func protocolEmitterKey(chainID sdk.ChainID, emitterAddress string) string {
+	return fmt.Sprintf("%d/%s", chainID, emitterAddress)
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// UnknownChain contains the information of a chain ID that is not known by the wormhole sdk.
+type UnknownChain struct {
+	ChainID   sdk.ChainID `json:"chainId"`
+	Count     uint64      `json:"count"`
+	FirstSeen time.Time   `json:"firstSeen"`
+	LastSeen  time.Time   `json:"lastSeen"`
+	LastVaaID string      `json:"lastVaaId"`
+}
+
+// UnknownChainTracker keeps track of the chain IDs seen by a service that are not known by the wormhole sdk.
+//
+// New chains are added by governance before the sdk is updated, so this allows operators to discover them quickly.
+type UnknownChainTracker struct {
+	mu     sync.RWMutex
+	chains map[sdk.ChainID]*UnknownChain
+}
+
+// NewUnknownChainTracker creates a new UnknownChainTracker.
+func NewUnknownChainTracker() *UnknownChainTracker {
+	return &UnknownChainTracker{
+		chains: make(map[sdk.ChainID]*UnknownChain),
+	}
+}
+
+// Track records the chain ID if it is not known by the wormhole sdk.
+// It returns true if the chain ID is unknown.
+func (t *UnknownChainTracker) Track(chainID sdk.ChainID, vaaID string) bool {
+	if chainID == sdk.ChainIDUnset || ChainIdIsValid(chainID) {
+		return false
+	}
+
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	c, ok := t.chains[chainID]
+	if !ok {
+		c = &UnknownChain{ChainID: chainID, FirstSeen: now}
+		t.chains[chainID] = c
+	}
+	c.Count++
+	c.LastSeen = now
+	c.LastVaaID = vaaID
+	return true
+}
+
+// List returns the unknown chains seen so far sorted by chain ID.
+func (t *UnknownChainTracker) List() []UnknownChain {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	result := make([]UnknownChain, 0, len(t.chains))
+	for _, c := range t.chains {
+		result = append(result, *c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ChainID < result[j].ChainID
+	})
+	return result
+}
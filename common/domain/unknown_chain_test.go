@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestUnknownChainTracker(t *testing.T) {
+	tracker := NewUnknownChainTracker()
+
+	assert.False(t, tracker.Track(sdk.ChainIDEthereum, "2/000000000000000000000000000000000000000000000000000000000000cafe/1"))
+	assert.False(t, tracker.Track(sdk.ChainIDUnset, "0/000000000000000000000000000000000000000000000000000000000000cafe/1"))
+	assert.True(t, tracker.Track(sdk.ChainID(65000), "65000/000000000000000000000000000000000000000000000000000000000000cafe/1"))
+	assert.True(t, tracker.Track(sdk.ChainID(65000), "65000/000000000000000000000000000000000000000000000000000000000000cafe/2"))
+	assert.True(t, tracker.Track(sdk.ChainID(64000), "64000/000000000000000000000000000000000000000000000000000000000000cafe/1"))
+
+	chains := tracker.List()
+	assert.Len(t, chains, 2)
+	assert.Equal(t, sdk.ChainID(64000), chains[0].ChainID)
+	assert.Equal(t, uint64(1), chains[0].Count)
+	assert.Equal(t, sdk.ChainID(65000), chains[1].ChainID)
+	assert.Equal(t, uint64(2), chains[1].Count)
+	assert.Equal(t, "65000/000000000000000000000000000000000000000000000000000000000000cafe/2", chains[1].LastVaaID)
+	assert.False(t, chains[1].FirstSeen.After(chains[1].LastSeen))
+}
@@ -16,3 +16,26 @@ func ConsistencyLevelIsImmediately(v *sdk.VAA) bool {
 
 	return false
 }
+
+// SignersBitmap returns a bitmap of the guardians that signed the VAA,
+// the bit i is set when the guardian with index i in the guardian set signed it.
+func SignersBitmap(v *sdk.VAA) uint64 {
+	var bitmap uint64
+	for _, s := range v.Signatures {
+		if s.Index < 64 {
+			bitmap |= 1 << s.Index
+		}
+	}
+	return bitmap
+}
+
+// SignerIndexes returns the guardian set indexes of the signers set in the bitmap, in ascending order.
+func SignerIndexes(bitmap uint64) []uint8 {
+	var indexes []uint8
+	for i := uint8(0); i < 64; i++ {
+		if bitmap&(1<<i) != 0 {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
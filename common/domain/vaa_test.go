@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestSignersBitmap(t *testing.T) {
+	v := &sdk.VAA{Signatures: []*sdk.Signature{{Index: 0}, {Index: 3}, {Index: 18}}}
+	bitmap := SignersBitmap(v)
+	assert.Equal(t, uint64(1|1<<3|1<<18), bitmap)
+	assert.Equal(t, []uint8{0, 3, 18}, SignerIndexes(bitmap))
+
+	assert.Equal(t, uint64(0), SignersBitmap(&sdk.VAA{}))
+	assert.Nil(t, SignerIndexes(0))
+}
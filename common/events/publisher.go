@@ -0,0 +1,36 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisPublisher publishes notification events to a redis channel, e.g. the channel streamed by the spy.
+type RedisPublisher struct {
+	client  *redis.Client
+	channel string
+}
+
+// NewRedisPublisher creates a RedisPublisher for the channel [channel] of the redis server [redisURI].
+func NewRedisPublisher(redisURI, channel string) *RedisPublisher {
+	return &RedisPublisher{
+		client:  redis.NewClient(&redis.Options{Addr: redisURI}),
+		channel: channel,
+	}
+}
+
+// Publish publishes a notification event.
+func (p *RedisPublisher) Publish(ctx context.Context, e *NotificationEvent) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return p.client.Publish(ctx, p.channel, string(body)).Err()
+}
+
+// Close closes the connection to the redis server.
+func (p *RedisPublisher) Close() error {
+	return p.client.Close()
+}
@@ -11,6 +11,9 @@ const (
 	EvmTransactionFoundType = "evm-transaction-found"
 	TransferRedeemedType    = "transfer-redeemed"
 	EvmTransferRedeemedName = "transfer-redeemed"
+	GovernorVaaEnqueuedType = "governor-vaa-enqueued"
+	GovernorVaaReleasedType = "governor-vaa-released"
+	HeartbeatGapType        = "heartbeat-gap"
 )
 
 type NotificationEvent struct {
@@ -38,7 +41,7 @@ func NewNotificationEvent[T EventData](trackID, source, _type string, data T) (*
 }
 
 type EventData interface {
-	SignedVaa | LogMessagePublished | EvmTransactionFound | TransferRedeemed
+	SignedVaa | LogMessagePublished | EvmTransactionFound | TransferRedeemed | GovernorVaa | HeartbeatGap
 }
 
 func GetEventData[T EventData](e *NotificationEvent) (T, error) {
@@ -117,3 +120,24 @@ type TransferRedeemedAttributes struct {
 	EffectiveGasPrice *string `json:"effectiveGasPrice"`
 	Fee               *uint64 `json:"fee"`
 }
+
+// GovernorVaa is a vaa enqueued by the governor of the guardians, or released when no guardian holds it anymore.
+type GovernorVaa struct {
+	ID             string    `json:"id"`
+	EmitterChain   uint16    `json:"emitterChain"`
+	EmitterAddress string    `json:"emitterAddress"`
+	Sequence       string    `json:"sequence"`
+	TxHash         string    `json:"txHash,omitempty"`
+	ReleaseTime    time.Time `json:"releaseTime,omitempty"`
+	// Amount is the notional value of the vaa in usd.
+	Amount uint64 `json:"amount,omitempty"`
+}
+
+// HeartbeatGap is reported when a guardian stops sending heartbeats, and again when it recovers.
+type HeartbeatGap struct {
+	GuardianAddr  string    `json:"guardianAddr"`
+	NodeName      string    `json:"nodeName"`
+	LastHeartbeat time.Time `json:"lastHeartbeat"`
+	// Recovered is set when the guardian sends a heartbeat after the gap.
+	Recovered bool `json:"recovered"`
+}
@@ -0,0 +1,61 @@
+package events
+
+import "time"
+
+// Stages of the pipeline that stamp the events they ingest.
+const (
+	StageFly       = "fly"
+	StagePipeline  = "pipeline"
+	StageParser    = "parser"
+	StageTxTracker = "tx-tracker"
+	StageAnalytics = "analytics"
+)
+
+// previousStages contains the stage that sends the events to each stage.
+var previousStages = map[string]string{
+	StagePipeline:  StageFly,
+	StageParser:    StagePipeline,
+	StageTxTracker: StagePipeline,
+	StageAnalytics: StagePipeline,
+}
+
+// Watermarks contains the time at which an event was ingested by each stage of the pipeline.
+type Watermarks map[string]time.Time
+
+// Stamp returns a copy of the watermarks with the time at which the stage ingested the event.
+func (w Watermarks) Stamp(stage string, t time.Time) Watermarks {
+	stamped := make(Watermarks, len(w)+1)
+	for s, ts := range w {
+		stamped[s] = ts
+	}
+	stamped[stage] = t
+	return stamped
+}
+
+// StageLatency returns the time elapsed between the ingestion of the event by the previous stage
+// and its ingestion by the stage. It returns false if any of both stages did not stamp the event.
+func (w Watermarks) StageLatency(stage string) (time.Duration, bool) {
+	previous, ok := previousStages[stage]
+	if !ok {
+		return 0, false
+	}
+	from, ok := w[previous]
+	if !ok {
+		return 0, false
+	}
+	to, ok := w[stage]
+	if !ok {
+		return 0, false
+	}
+	return to.Sub(from), true
+}
+
+// EndToEndLatency returns the time elapsed between the ingestion of the event by fly and t.
+// It returns false if fly did not stamp the event.
+func (w Watermarks) EndToEndLatency(t time.Time) (time.Duration, bool) {
+	from, ok := w[StageFly]
+	if !ok {
+		return 0, false
+	}
+	return t.Sub(from), true
+}
@@ -0,0 +1,41 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatermarks(t *testing.T) {
+	fly := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	var w Watermarks
+	pipeline := w.Stamp(StageFly, fly).Stamp(StagePipeline, fly.Add(2*time.Second))
+	parser := pipeline.Stamp(StageParser, fly.Add(5*time.Second))
+
+	// stamping does not modify the original watermarks.
+	assert.Len(t, pipeline, 2)
+	assert.Len(t, parser, 3)
+
+	latency, ok := parser.StageLatency(StagePipeline)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, latency)
+
+	latency, ok = parser.StageLatency(StageParser)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, latency)
+
+	_, ok = parser.StageLatency(StageAnalytics)
+	assert.False(t, ok)
+
+	_, ok = parser.StageLatency(StageFly)
+	assert.False(t, ok)
+
+	latency, ok = parser.EndToEndLatency(fly.Add(7 * time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, latency)
+
+	_, ok = w.EndToEndLatency(fly)
+	assert.False(t, ok)
+}
@@ -0,0 +1,212 @@
+package logger
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Redacted replaces the values of the redacted fields.
+const Redacted = "[REDACTED]"
+
+// ipCandidatePattern matches the tokens that may be an ip address, they are redacted when they are parsed
+// as one, so the compressed IPv6 addresses, e.g. fe80::1, are found too.
+var ipCandidatePattern = regexp.MustCompile(`[0-9a-fA-F:.]*[:.][0-9a-fA-F:.]*`)
+
+// RedactConfig defines the data removed from the logs.
+type RedactConfig struct {
+	// Fields are the keys of the fields whose values are replaced by Redacted.
+	Fields []string
+	// TruncatedFields are the keys of the fields whose values only keep the first and the last
+	// TruncateLength characters, e.g. wallet addresses.
+	TruncatedFields []string
+	TruncateLength  int
+	// IPs replaces the ip addresses found in the message, the strings and the errors of the logs.
+	IPs bool
+}
+
+// Enabled returns whether the configuration redacts any data.
+func (c RedactConfig) Enabled() bool {
+	return len(c.Fields) > 0 || len(c.TruncatedFields) > 0 || c.IPs
+}
+
+// Redact returns a logger that removes from the logs the data defined in the configuration.
+func Redact(l *zap.Logger, cfg RedactConfig) *zap.Logger {
+	if !cfg.Enabled() {
+		return l
+	}
+	return l.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return newRedactCore(core, cfg)
+	}))
+}
+
+// redactCore is a zapcore.Core that redacts the fields before writing them to the wrapped core.
+type redactCore struct {
+	zapcore.Core
+	fields          map[string]bool
+	truncatedFields map[string]bool
+	truncateLength  int
+	ips             bool
+}
+
+func newRedactCore(core zapcore.Core, cfg RedactConfig) *redactCore {
+	c := &redactCore{
+		Core:            core,
+		fields:          make(map[string]bool, len(cfg.Fields)),
+		truncatedFields: make(map[string]bool, len(cfg.TruncatedFields)),
+		truncateLength:  cfg.TruncateLength,
+		ips:             cfg.IPs,
+	}
+	for _, f := range cfg.Fields {
+		c.fields[f] = true
+	}
+	for _, f := range cfg.TruncatedFields {
+		c.truncatedFields[f] = true
+	}
+	return c
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.Core = c.Core.With(c.redact(fields))
+	return &clone
+}
+
+func (c *redactCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *redactCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if c.ips {
+		ent.Message = redactIPs(ent.Message)
+	}
+	return c.Core.Write(ent, c.redact(fields))
+}
+
+func (c *redactCore) redact(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		redacted[i] = c.redactField(f)
+	}
+	return redacted
+}
+
+func (c *redactCore) redactField(f zapcore.Field) zapcore.Field {
+	if c.fields[f.Key] {
+		return zap.String(f.Key, Redacted)
+	}
+
+	var value string
+	switch f.Type {
+	case zapcore.StringType:
+		value = f.String
+	case zapcore.StringerType:
+		value = fmt.Sprint(f.Interface)
+	case zapcore.ErrorType:
+		if !c.ips {
+			return f
+		}
+		err, ok := f.Interface.(error)
+		if !ok || err == nil {
+			return f
+		}
+		return zap.String(f.Key, redactIPs(err.Error()))
+	default:
+		return f
+	}
+
+	if c.truncatedFields[f.Key] {
+		value = truncate(value, c.truncateLength)
+	}
+	if c.ips {
+		value = redactIPs(value)
+	}
+	return zap.String(f.Key, value)
+}
+
+// truncate keeps the first and the last n characters of s.
+func truncate(s string, n int) string {
+	if n <= 0 {
+		return Redacted
+	}
+	if len(s) <= 2*n {
+		return s
+	}
+	return s[:n] + "..." + s[len(s)-n:]
+}
+
+// RedactQuery returns the query string with the values of the redacted and truncated fields, e.g. ?address=,
+// replaced as in the log fields.
+func (c RedactConfig) RedactQuery(query string) string {
+	if !c.Enabled() || query == "" {
+		return query
+	}
+	params := strings.Split(query, "&")
+	for i, param := range params {
+		key, value, found := strings.Cut(param, "=")
+		if !found {
+			continue
+		}
+		if k, err := url.QueryUnescape(key); err == nil {
+			key = k
+		}
+		if v, err := url.QueryUnescape(value); err == nil {
+			value = v
+		}
+		switch {
+		case contains(c.Fields, key):
+			value = Redacted
+		case contains(c.TruncatedFields, key):
+			value = truncate(value, c.TruncateLength)
+		case !c.IPs:
+			continue
+		}
+		if c.IPs {
+			value = redactIPs(value)
+		}
+		params[i] = key + "=" + value
+	}
+	return strings.Join(params, "&")
+}
+
+func contains(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func redactIPs(s string) string {
+	return ipCandidatePattern.ReplaceAllStringFunc(s, func(token string) string {
+		// the trailing dots and colons are punctuation, e.g. the end of a sentence or a port separator.
+		candidate := strings.TrimRight(token, ".:")
+		suffix := token[len(candidate):]
+		// the leading separator of a key, e.g. ip:10.0.0.1.
+		prefix := ""
+		if !isIP(candidate) && len(candidate) > 1 && (candidate[0] == ':' || candidate[0] == '.') {
+			prefix, candidate = candidate[:1], candidate[1:]
+		}
+		if isIP(candidate) {
+			return prefix + Redacted + suffix
+		}
+		// an IPv4 address with a port, the IPv6 addresses with a port are enclosed in brackets.
+		if host, port, found := strings.Cut(candidate, ":"); found && strings.Count(host, ".") == 3 && isIP(host) {
+			return prefix + Redacted + ":" + port + suffix
+		}
+		return token
+	})
+}
+
+func isIP(s string) bool {
+	return net.ParseIP(s) != nil
+}
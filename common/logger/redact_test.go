@@ -0,0 +1,83 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRedact(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	l := Redact(zap.New(core), RedactConfig{
+		Fields:          []string{"apiKey"},
+		TruncatedFields: []string{"address"},
+		TruncateLength:  4,
+		IPs:             true,
+	})
+
+	l.With(zap.String("apiKey", "secret")).Info("request from 10.0.0.1",
+		zap.String("address", "0x3ee18b2214aff97000d974cf647e7c347e8fa585"),
+		zap.String("ip", "192.168.1.20"),
+		zap.Error(errors.New("dial tcp 172.16.0.3:27017: connection refused")),
+		zap.Int("status", 500),
+		zap.String("path", "/api/v1/vaas"),
+	)
+
+	entries := logs.All()
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "request from [REDACTED]", entries[0].Message)
+		assert.Equal(t, map[string]any{
+			"apiKey":  "[REDACTED]",
+			"address": "0x3e...a585",
+			"ip":      "[REDACTED]",
+			"error":   "dial tcp [REDACTED]:27017: connection refused",
+			"status":  int64(500),
+			"path":    "/api/v1/vaas",
+		}, entries[0].ContextMap())
+	}
+}
+
+func TestRedact_disabled(t *testing.T) {
+	l := zap.NewNop()
+	assert.Equal(t, l, Redact(l, RedactConfig{}))
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "[REDACTED]", truncate("0x3ee18b2214aff97000d974cf647e7c347e8fa585", 0))
+	assert.Equal(t, "0x3ee1...8fa585", truncate("0x3ee18b2214aff97000d974cf647e7c347e8fa585", 6))
+	assert.Equal(t, "short", truncate("short", 4))
+}
+
+func TestRedactIPs(t *testing.T) {
+	assert.Equal(t, "request from [REDACTED].", redactIPs("request from 10.0.0.1."))
+	assert.Equal(t, "dial tcp [REDACTED]:27017: connection refused", redactIPs("dial tcp 172.16.0.3:27017: connection refused"))
+	assert.Equal(t, "ip:[REDACTED]", redactIPs("ip:10.0.0.1"))
+	// the compressed IPv6 addresses.
+	assert.Equal(t, "from [REDACTED] and [[REDACTED]]:8080", redactIPs("from fe80::1 and [2001:db8::8a2e:370:7334]:8080"))
+	assert.Equal(t, "loopback [REDACTED]", redactIPs("loopback ::1"))
+	assert.Equal(t, "mapped [REDACTED]", redactIPs("mapped ::ffff:192.168.1.20"))
+	// the tokens that are not ip addresses.
+	assert.Equal(t, "at 2024-05-01T10:00:00Z version 1.2.3 took 1.5s", redactIPs("at 2024-05-01T10:00:00Z version 1.2.3 took 1.5s"))
+	assert.Equal(t, "hash 0x3ee18b2214aff97000d974cf647e7c347e8fa585", redactIPs("hash 0x3ee18b2214aff97000d974cf647e7c347e8fa585"))
+}
+
+func TestRedactQuery(t *testing.T) {
+	cfg := RedactConfig{
+		Fields:          []string{"apiKey"},
+		TruncatedFields: []string{"address"},
+		TruncateLength:  4,
+		IPs:             true,
+	}
+	assert.Equal(t,
+		"page=0&address=0x3e...a585&apiKey=[REDACTED]&host=[REDACTED]&sortOrder=DESC",
+		cfg.RedactQuery("page=0&address=0x3ee18b2214aff97000d974cf647e7c347e8fa585&apiKey=secret&host=fe80%3A%3A1&sortOrder=DESC"))
+	assert.Equal(t, "", cfg.RedactQuery(""))
+
+	// the query is not modified without redaction.
+	query := "address=0x3ee18b2214aff97000d974cf647e7c347e8fa585"
+	assert.Equal(t, query, RedactConfig{}.RedactQuery(query))
+}
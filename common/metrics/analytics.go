@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// AnalyticsMetrics are the metrics of the analytics.
+type AnalyticsMetrics interface {
+	Pipeline
+	Messages
+	UnknownChains
+
+	IncFailedMeasurement(measurement string)
+	IncSuccessfulMeasurement(measurement string)
+	IncMissingNotional(symbol string)
+	IncFoundNotional(symbol string)
+	IncMissingToken(chain, token string)
+	IncFoundToken(chain, token string)
+}
+
+// PrometheusAnalyticsMetrics is a Prometheus implementation of the AnalyticsMetrics interface.
+type PrometheusAnalyticsMetrics struct {
+	*PrometheusPipeline
+	*PrometheusMessages
+	*PrometheusUnknownChains
+	measurementCount   *prometheus.CounterVec
+	notionalCount      *prometheus.CounterVec
+	tokenRequestsCount *prometheus.CounterVec
+}
+
+// NewPrometheusAnalyticsMetrics returns a new instance of PrometheusAnalyticsMetrics.
+func NewPrometheusAnalyticsMetrics(environment string) *PrometheusAnalyticsMetrics {
+	labels := constLabels(environment, ServiceAnalytics)
+	return &PrometheusAnalyticsMetrics{
+		PrometheusPipeline:      NewPrometheusPipeline(environment, ServiceAnalytics),
+		PrometheusMessages:      NewPrometheusMessages(environment, ServiceAnalytics),
+		PrometheusUnknownChains: NewPrometheusUnknownChains(environment, ServiceAnalytics),
+		measurementCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "measurement_count",
+				Help:        "Total number of measurement",
+				ConstLabels: labels,
+			}, []string{"measurement", "status"}),
+		notionalCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "notional_requests_count_by_symbol",
+				Help:        "Total number requests of notional by symbol",
+				ConstLabels: labels,
+			}, []string{"symbol", "status"}),
+		tokenRequestsCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "token_requests_count",
+				Help:        "Total number of missing notional by symbol",
+				ConstLabels: labels,
+			}, []string{"chain", "token", "status"}),
+	}
+}
+
+// IncFailedMeasurement increments the number of measurements that cannot be written.
+func (m *PrometheusAnalyticsMetrics) IncFailedMeasurement(measurement string) {
+	m.measurementCount.WithLabelValues(measurement, "failed").Inc()
+}
+
+// IncSuccessfulMeasurement increments the number of measurements written.
+func (m *PrometheusAnalyticsMetrics) IncSuccessfulMeasurement(measurement string) {
+	m.measurementCount.WithLabelValues(measurement, "successful").Inc()
+}
+
+// IncMissingNotional increments the number of requests of a notional that is not found.
+func (m *PrometheusAnalyticsMetrics) IncMissingNotional(symbol string) {
+	m.notionalCount.WithLabelValues(symbol, "missing").Inc()
+}
+
+// IncFoundNotional increments the number of requests of a notional that is found.
+func (m *PrometheusAnalyticsMetrics) IncFoundNotional(symbol string) {
+	m.notionalCount.WithLabelValues(symbol, "found").Inc()
+}
+
+// IncMissingToken increments the number of requests of a token that is not found.
+func (m *PrometheusAnalyticsMetrics) IncMissingToken(chain, token string) {
+	m.tokenRequestsCount.WithLabelValues(chain, token, "missing").Inc()
+}
+
+// IncFoundToken increments the number of requests of a token that is found.
+func (m *PrometheusAnalyticsMetrics) IncFoundToken(chain, token string) {
+	m.tokenRequestsCount.WithLabelValues(chain, token, "found").Inc()
+}
+
+// NoopAnalyticsMetrics is a no-op implementation of the AnalyticsMetrics interface.
+type NoopAnalyticsMetrics struct {
+	NoopPipeline
+	NoopMessages
+	NoopUnknownChains
+}
+
+// NewNoopAnalyticsMetrics returns a new instance of NoopAnalyticsMetrics.
+func NewNoopAnalyticsMetrics() *NoopAnalyticsMetrics {
+	return &NoopAnalyticsMetrics{}
+}
+
+func (*NoopAnalyticsMetrics) IncFailedMeasurement(measurement string) {}
+
+func (*NoopAnalyticsMetrics) IncSuccessfulMeasurement(measurement string) {}
+
+func (*NoopAnalyticsMetrics) IncMissingNotional(symbol string) {}
+
+func (*NoopAnalyticsMetrics) IncFoundNotional(symbol string) {}
+
+func (*NoopAnalyticsMetrics) IncMissingToken(chain, token string) {}
+
+func (*NoopAnalyticsMetrics) IncFoundToken(chain, token string) {}
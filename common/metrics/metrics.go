@@ -0,0 +1,25 @@
+// Package metrics contains the prometheus metrics of the services of the vaa pipeline.
+//
+// The metrics are organized in groups, and the metrics of each service are composed of the
+// groups it shares with the other services and of its own metrics, so the shared metrics
+// have the same names and labels in every service.
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Service names, they are the value of the service label of the metrics.
+const (
+	ServiceParser    = "wormscan-parser"
+	ServiceTxTracker = "wormscan-tx-tracker"
+	ServiceAnalytics = "wormscan-analytics"
+)
+
+// durationBuckets are the buckets in seconds of the histograms of the vaa processing durations.
+var durationBuckets = []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 20, 30, 60, 120, 300, 600, 1200}
+
+func constLabels(environment, service string) prometheus.Labels {
+	return prometheus.Labels{
+		"environment": environment,
+		"service":     service,
+	}
+}
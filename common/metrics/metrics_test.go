@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/test-go/testify/assert"
+)
+
+// the metrics are registered in the default registry, so each service is built once.
+var (
+	parserMetrics    = NewPrometheusParserMetrics("test")
+	txTrackerMetrics = NewPrometheusTxTrackerMetrics("test")
+	analyticsMetrics = NewPrometheusAnalyticsMetrics("test")
+)
+
+var (
+	_ ParserMetrics    = NewNoopParserMetrics()
+	_ TxTrackerMetrics = NewNoopTxTrackerMetrics()
+	_ AnalyticsMetrics = NewNoopAnalyticsMetrics()
+)
+
+func TestMessages_SharedByServices(t *testing.T) {
+	parserMetrics.IncProcessedMessage("ethereum", "pipeline", 1)
+	txTrackerMetrics.IncProcessedMessage("ethereum", "pipeline", 1)
+	txTrackerMetrics.IncInvalidMessage("ethereum", "pipeline", 2)
+	analyticsMetrics.IncUnprocessedMessage("ethereum", "pipeline", 0)
+
+	processed := func(m *PrometheusMessages, status, retry string) float64 {
+		c, err := m.processedMessage.GetMetricWith(prometheus.Labels{
+			"chain": "ethereum", "source": "pipeline", "status": status, "retry": retry,
+		})
+		assert.NoError(t, err)
+		return testutil.ToFloat64(c)
+	}
+
+	assert.Equal(t, float64(1), processed(parserMetrics.PrometheusMessages, "processed", "1"))
+	assert.Equal(t, float64(1), processed(txTrackerMetrics.PrometheusMessages, "processed", "1"))
+	assert.Equal(t, float64(1), processed(txTrackerMetrics.PrometheusMessages, "invalid", "2"))
+	assert.Equal(t, float64(1), processed(analyticsMetrics.PrometheusMessages, "unprocessed", "0"))
+	assert.Equal(t, float64(0), processed(parserMetrics.PrometheusMessages, "invalid", "2"))
+}
+
+func TestUnknownChains(t *testing.T) {
+	analyticsMetrics.IncUnknownChain(65000)
+	assert.Equal(t, float64(1), testutil.ToFloat64(analyticsMetrics.unknownChain.WithLabelValues("65000")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(parserMetrics.unknownChain.WithLabelValues("65000")))
+}
+
+func TestParserMetrics_ParseCount(t *testing.T) {
+	parserMetrics.IncVaaParsed(2)
+	parserMetrics.IncVaaParsed(2)
+	parserMetrics.IncVaaPayloadParserNotFoundCount(2)
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(parserMetrics.vaaParseCount.WithLabelValues("ethereum", "parsed")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(parserMetrics.vaaPayloadParserResponseCount.WithLabelValues("ethereum", "not_found")))
+}
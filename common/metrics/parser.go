@@ -0,0 +1,166 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// ParserMetrics are the metrics of the parser.
+type ParserMetrics interface {
+	Pipeline
+	Messages
+	UnknownChains
+
+	IncVaaConsumedQueue(chainID uint16)
+	IncVaaUnfiltered(chainID uint16)
+	IncVaaParsed(chainID uint16)
+	IncVaaParsedInserted(chainID uint16)
+	IncVaaParsedInvalid(chainID uint16)
+	IncVaaParseFailure(chainID uint16)
+
+	IncVaaPayloadParserRequestCount(chainID uint16)
+	IncVaaPayloadParserErrorCount(chainID uint16)
+	IncVaaPayloadParserNotFoundCount(chainID uint16)
+	IncVaaPayloadParserSuccessCount(chainID uint16)
+	IncVaaPayloadSchemaDecodedCount(chainID uint16)
+	IncVaaPayloadSchemaErrorCount(chainID uint16)
+}
+
+// PrometheusParserMetrics is a Prometheus implementation of the ParserMetrics interface.
+type PrometheusParserMetrics struct {
+	*PrometheusPipeline
+	*PrometheusMessages
+	*PrometheusUnknownChains
+	vaaParseCount                 *prometheus.CounterVec
+	vaaPayloadParserRequest       *prometheus.CounterVec
+	vaaPayloadParserResponseCount *prometheus.CounterVec
+}
+
+// NewPrometheusParserMetrics returns a new instance of PrometheusParserMetrics.
+func NewPrometheusParserMetrics(environment string) *PrometheusParserMetrics {
+	labels := constLabels(environment, ServiceParser)
+	return &PrometheusParserMetrics{
+		PrometheusPipeline:      NewPrometheusPipeline(environment, ServiceParser),
+		PrometheusMessages:      NewPrometheusMessages(environment, ServiceParser),
+		PrometheusUnknownChains: NewPrometheusUnknownChains(environment, ServiceParser),
+		vaaParseCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "parse_vaa_count_by_chain",
+				Help:        "Total number of vaa parser by chain",
+				ConstLabels: labels,
+			}, []string{"chain", "type"}),
+		vaaPayloadParserRequest: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "parse_vaa_payload_request_count_by_chain",
+				Help:        "Total number of request to payload parser component by chain",
+				ConstLabels: labels,
+			}, []string{"chain"}),
+		vaaPayloadParserResponseCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "parse_vaa_payload_response_count_by_chain",
+				Help:        "Total number of response from payload parser component by chain",
+				ConstLabels: labels,
+			}, []string{"chain", "status"}),
+	}
+}
+
+// IncVaaConsumedQueue increments the number of consumed VAA.
+func (m *PrometheusParserMetrics) IncVaaConsumedQueue(chainID uint16) {
+	m.incParseCount(chainID, "consumed")
+}
+
+// IncVaaUnfiltered increments the number of unfiltered VAA.
+func (m *PrometheusParserMetrics) IncVaaUnfiltered(chainID uint16) {
+	m.incParseCount(chainID, "unfiltered")
+}
+
+// IncVaaParsed increments the number of parsed VAA.
+func (m *PrometheusParserMetrics) IncVaaParsed(chainID uint16) {
+	m.incParseCount(chainID, "parsed")
+}
+
+// IncVaaParsedInserted increments the number of parsed VAA inserted into database.
+func (m *PrometheusParserMetrics) IncVaaParsedInserted(chainID uint16) {
+	m.incParseCount(chainID, "inserted")
+}
+
+// IncVaaParsedInvalid increments the number of parsed VAA that do not pass the validation.
+func (m *PrometheusParserMetrics) IncVaaParsedInvalid(chainID uint16) {
+	m.incParseCount(chainID, "invalid")
+}
+
+// IncVaaParseFailure increments the number of VAA whose payload cannot be parsed.
+func (m *PrometheusParserMetrics) IncVaaParseFailure(chainID uint16) {
+	m.incParseCount(chainID, "parse_failure")
+}
+
+// IncVaaPayloadSchemaDecodedCount increments the number of VAA payloads decoded with a registered schema.
+func (m *PrometheusParserMetrics) IncVaaPayloadSchemaDecodedCount(chainID uint16) {
+	m.incParseCount(chainID, "schema_decoded")
+}
+
+// IncVaaPayloadSchemaErrorCount increments the number of VAA payloads that do not match the registered schema.
+func (m *PrometheusParserMetrics) IncVaaPayloadSchemaErrorCount(chainID uint16) {
+	m.incParseCount(chainID, "schema_error")
+}
+
+// IncVaaPayloadParserRequestCount increments the number of vaa payload parser request.
+func (m *PrometheusParserMetrics) IncVaaPayloadParserRequestCount(chainID uint16) {
+	m.vaaPayloadParserRequest.WithLabelValues(vaa.ChainID(chainID).String()).Inc()
+}
+
+// IncVaaPayloadParserErrorCount increments the number of vaa payload parser error.
+func (m *PrometheusParserMetrics) IncVaaPayloadParserErrorCount(chainID uint16) {
+	m.vaaPayloadParserResponseCount.WithLabelValues(vaa.ChainID(chainID).String(), "failed").Inc()
+}
+
+// IncVaaPayloadParserSuccessCount increments the number of vaa payload parser success.
+func (m *PrometheusParserMetrics) IncVaaPayloadParserSuccessCount(chainID uint16) {
+	m.vaaPayloadParserResponseCount.WithLabelValues(vaa.ChainID(chainID).String(), "success").Inc()
+}
+
+// IncVaaPayloadParserNotFoundCount increments the number of vaa payload parser not found.
+func (m *PrometheusParserMetrics) IncVaaPayloadParserNotFoundCount(chainID uint16) {
+	m.vaaPayloadParserResponseCount.WithLabelValues(vaa.ChainID(chainID).String(), "not_found").Inc()
+}
+
+func (m *PrometheusParserMetrics) incParseCount(chainID uint16, countType string) {
+	m.vaaParseCount.WithLabelValues(vaa.ChainID(chainID).String(), countType).Inc()
+}
+
+// NoopParserMetrics is a no-op implementation of the ParserMetrics interface.
+type NoopParserMetrics struct {
+	NoopPipeline
+	NoopMessages
+	NoopUnknownChains
+}
+
+// NewNoopParserMetrics returns a new instance of NoopParserMetrics.
+func NewNoopParserMetrics() *NoopParserMetrics {
+	return &NoopParserMetrics{}
+}
+
+func (*NoopParserMetrics) IncVaaConsumedQueue(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaUnfiltered(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaParsed(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaParsedInserted(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaParsedInvalid(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaParseFailure(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaPayloadSchemaDecodedCount(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaPayloadSchemaErrorCount(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaPayloadParserRequestCount(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaPayloadParserErrorCount(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaPayloadParserSuccessCount(chainID uint16) {}
+
+func (*NoopParserMetrics) IncVaaPayloadParserNotFoundCount(chainID uint16) {}
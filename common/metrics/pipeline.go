@@ -0,0 +1,185 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Pipeline are the metrics of the consumers of the vaa pipeline.
+type Pipeline interface {
+	VaaProcessingDuration(chain string, start *time.Time)
+	VaaStageLatency(stage string, latency time.Duration)
+	VaaEndToEndLatency(latency time.Duration)
+	SetSqsConsecutiveFailures(queue string, failures int)
+}
+
+// Messages are the metrics of the messages consumed from the queues of the vaa pipeline.
+type Messages interface {
+	IncExpiredMessage(chain, source string, retry uint8)
+	IncInvalidMessage(chain, source string, retry uint8)
+	IncUnprocessedMessage(chain, source string, retry uint8)
+	IncProcessedMessage(chain, source string, retry uint8)
+}
+
+// UnknownChains are the metrics of the vaas that reference a chain not known by the wormhole sdk.
+type UnknownChains interface {
+	IncUnknownChain(chainID uint16)
+}
+
+// PrometheusPipeline is a Prometheus implementation of the Pipeline interface.
+type PrometheusPipeline struct {
+	vaaProcessingDuration  *prometheus.HistogramVec
+	vaaStageLatency        *prometheus.HistogramVec
+	vaaEndToEndLatency     prometheus.Histogram
+	sqsConsecutiveFailures *prometheus.GaugeVec
+}
+
+// NewPrometheusPipeline returns a new instance of PrometheusPipeline.
+func NewPrometheusPipeline(environment, service string) *PrometheusPipeline {
+	labels := constLabels(environment, service)
+	return &PrometheusPipeline{
+		vaaProcessingDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:        "vaa_processing_duration_seconds",
+				Help:        "Duration of all vaa processing by chain.",
+				ConstLabels: labels,
+				Buckets:     durationBuckets,
+			}, []string{"chain"}),
+		vaaStageLatency: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:        "vaa_stage_latency_seconds",
+				Help:        "Time elapsed between the ingestion of a vaa by the previous stage of the pipeline and the stage",
+				ConstLabels: labels,
+				Buckets:     durationBuckets,
+			}, []string{"stage"}),
+		vaaEndToEndLatency: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "vaa_end_to_end_latency_seconds",
+				Help:        "Time elapsed between the ingestion of a vaa by fly and its processing",
+				ConstLabels: labels,
+				Buckets:     durationBuckets,
+			}),
+		sqsConsecutiveFailures: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "sqs_consecutive_failures",
+				Help:        "Number of consecutive errors getting messages from the sqs queue",
+				ConstLabels: labels,
+			}, []string{"queue"}),
+	}
+}
+
+// VaaProcessingDuration records the duration of the vaa processing since the vaa was sent to the queue.
+func (p *PrometheusPipeline) VaaProcessingDuration(chain string, start *time.Time) {
+	if start == nil {
+		return
+	}
+	p.vaaProcessingDuration.WithLabelValues(chain).Observe(time.Since(*start).Seconds())
+}
+
+// VaaStageLatency records the time elapsed between the ingestion of a vaa by the previous stage and the stage.
+func (p *PrometheusPipeline) VaaStageLatency(stage string, latency time.Duration) {
+	p.vaaStageLatency.WithLabelValues(stage).Observe(latency.Seconds())
+}
+
+// VaaEndToEndLatency records the time elapsed between the ingestion of a vaa by fly and its processing.
+func (p *PrometheusPipeline) VaaEndToEndLatency(latency time.Duration) {
+	p.vaaEndToEndLatency.Observe(latency.Seconds())
+}
+
+// SetSqsConsecutiveFailures sets the number of consecutive errors getting messages from a sqs queue.
+func (p *PrometheusPipeline) SetSqsConsecutiveFailures(queue string, failures int) {
+	p.sqsConsecutiveFailures.WithLabelValues(queue).Set(float64(failures))
+}
+
+// PrometheusMessages is a Prometheus implementation of the Messages interface.
+type PrometheusMessages struct {
+	processedMessage *prometheus.CounterVec
+}
+
+// NewPrometheusMessages returns a new instance of PrometheusMessages.
+func NewPrometheusMessages(environment, service string) *PrometheusMessages {
+	return &PrometheusMessages{
+		processedMessage: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "processed_message",
+				Help:        "Total number of processed message",
+				ConstLabels: constLabels(environment, service),
+			}, []string{"chain", "source", "status", "retry"}),
+	}
+}
+
+// IncExpiredMessage increments the number of messages expired before being processed.
+func (p *PrometheusMessages) IncExpiredMessage(chain, source string, retry uint8) {
+	p.inc(chain, source, "expired", retry)
+}
+
+// IncInvalidMessage increments the number of messages discarded because they cannot be processed.
+func (p *PrometheusMessages) IncInvalidMessage(chain, source string, retry uint8) {
+	p.inc(chain, source, "invalid", retry)
+}
+
+// IncUnprocessedMessage increments the number of messages whose processing failed.
+func (p *PrometheusMessages) IncUnprocessedMessage(chain, source string, retry uint8) {
+	p.inc(chain, source, "unprocessed", retry)
+}
+
+// IncProcessedMessage increments the number of messages processed.
+func (p *PrometheusMessages) IncProcessedMessage(chain, source string, retry uint8) {
+	p.inc(chain, source, "processed", retry)
+}
+
+func (p *PrometheusMessages) inc(chain, source, status string, retry uint8) {
+	p.processedMessage.WithLabelValues(chain, source, status, strconv.Itoa(int(retry))).Inc()
+}
+
+// PrometheusUnknownChains is a Prometheus implementation of the UnknownChains interface.
+type PrometheusUnknownChains struct {
+	unknownChain *prometheus.CounterVec
+}
+
+// NewPrometheusUnknownChains returns a new instance of PrometheusUnknownChains.
+func NewPrometheusUnknownChains(environment, service string) *PrometheusUnknownChains {
+	return &PrometheusUnknownChains{
+		unknownChain: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "unknown_chain",
+				Help:        "Total number of vaa with a chain id not known by the wormhole sdk",
+				ConstLabels: constLabels(environment, service),
+			}, []string{"chain_id"}),
+	}
+}
+
+// IncUnknownChain increments the number of vaa with a chain id not known by the wormhole sdk.
+func (p *PrometheusUnknownChains) IncUnknownChain(chainID uint16) {
+	p.unknownChain.WithLabelValues(strconv.Itoa(int(chainID))).Inc()
+}
+
+// NoopPipeline is a no-op implementation of the Pipeline interface.
+type NoopPipeline struct{}
+
+func (NoopPipeline) VaaProcessingDuration(chain string, start *time.Time) {}
+
+func (NoopPipeline) VaaStageLatency(stage string, latency time.Duration) {}
+
+func (NoopPipeline) VaaEndToEndLatency(latency time.Duration) {}
+
+func (NoopPipeline) SetSqsConsecutiveFailures(queue string, failures int) {}
+
+// NoopMessages is a no-op implementation of the Messages interface.
+type NoopMessages struct{}
+
+func (NoopMessages) IncExpiredMessage(chain, source string, retry uint8) {}
+
+func (NoopMessages) IncInvalidMessage(chain, source string, retry uint8) {}
+
+func (NoopMessages) IncUnprocessedMessage(chain, source string, retry uint8) {}
+
+func (NoopMessages) IncProcessedMessage(chain, source string, retry uint8) {}
+
+// NoopUnknownChains is a no-op implementation of the UnknownChains interface.
+type NoopUnknownChains struct{}
+
+func (NoopUnknownChains) IncUnknownChain(chainID uint16) {}
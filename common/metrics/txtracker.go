@@ -0,0 +1,298 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// TxTrackerMetrics are the metrics of the tx-tracker.
+type TxTrackerMetrics interface {
+	Pipeline
+	Messages
+	UnknownChains
+
+	IncVaaConsumedQueue(chainID string, source string)
+	IncVaaUnfiltered(chainID string, source string)
+	IncOriginTxInserted(chainID string, source string)
+	IncVaaWithoutTxHash(chainID uint16, source string)
+	IncVaaWithTxHashFixed(chainID uint16, source string)
+	IncDestinationTxInserted(chainID string, source string)
+	AddVaaProcessedDuration(chainID uint16, duration float64)
+	IncCallRpcSuccess(chainID uint16, rpc string)
+	IncCallRpcError(chainID uint16, rpc string)
+	IncRpcCall(chainID uint16, provider string, status string)
+	ObserveRpcLatency(chainID uint16, provider string, seconds float64)
+	IncRateLimited(chainID uint16, provider string)
+	IncStoreUnprocessedOriginTx(chainID uint16)
+	IncVaaProcessed(chainID uint16, retry uint8)
+	IncVaaFailed(chainID uint16, retry uint8)
+	IncWormchainUnknown(srcChannel string, dstChannel string)
+	SetContractWatcherLag(chainID uint16, blocks uint64)
+	IncContractWatcherRedeem(chainID uint16)
+	IncOriginTxReorg(chainID uint16, status string)
+}
+
+// PrometheusTxTrackerMetrics is a Prometheus implementation of the TxTrackerMetrics interface.
+type PrometheusTxTrackerMetrics struct {
+	*PrometheusPipeline
+	*PrometheusMessages
+	*PrometheusUnknownChains
+	vaaTxTrackerCount        *prometheus.CounterVec
+	vaaProcesedDuration      *prometheus.HistogramVec
+	rpcCallCount             *prometheus.CounterVec
+	rpcProviderCalls         *prometheus.CounterVec
+	rpcProviderLatency       *prometheus.HistogramVec
+	rpcProviderRateLimited   *prometheus.CounterVec
+	storeUnprocessedOriginTx *prometheus.CounterVec
+	vaaProcessed             *prometheus.CounterVec
+	wormchainUnknown         *prometheus.CounterVec
+	contractWatcherLag       *prometheus.GaugeVec
+	contractWatcherRedeems   *prometheus.CounterVec
+	originTxReorgs           *prometheus.CounterVec
+}
+
+// NewPrometheusTxTrackerMetrics returns a new instance of PrometheusTxTrackerMetrics.
+func NewPrometheusTxTrackerMetrics(environment string) *PrometheusTxTrackerMetrics {
+	labels := constLabels(environment, ServiceTxTracker)
+	return &PrometheusTxTrackerMetrics{
+		PrometheusPipeline:      NewPrometheusPipeline(environment, ServiceTxTracker),
+		PrometheusMessages:      NewPrometheusMessages(environment, ServiceTxTracker),
+		PrometheusUnknownChains: NewPrometheusUnknownChains(environment, ServiceTxTracker),
+		vaaTxTrackerCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "vaa_tx_tracker_count_by_chain",
+				Help:        "Total number of vaa processed by tx tracker by chain",
+				ConstLabels: labels,
+			}, []string{"chain", "source", "type"}),
+		vaaProcesedDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:        "vaa_processed_duration",
+				Help:        "Duration of vaa processing",
+				ConstLabels: labels,
+				Buckets:     durationBuckets,
+			}, []string{"chain"}),
+		rpcCallCount: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "rpc_call_count_by_chain",
+				Help:        "Total number of rpc calls by chain",
+				ConstLabels: labels,
+			}, []string{"chain", "rpc", "status"}),
+		rpcProviderCalls: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "rpc_provider_calls_total",
+				Help:        "Total number of calls to the rpc providers by chain, provider and status",
+				ConstLabels: labels,
+			}, []string{"chain", "provider", "status"}),
+		rpcProviderLatency: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:        "rpc_provider_latency_seconds",
+				Help:        "Latency of the calls to the rpc providers by chain and provider",
+				ConstLabels: labels,
+				Buckets:     []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 20, 30, 60},
+			}, []string{"chain", "provider"}),
+		rpcProviderRateLimited: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "rpc_provider_rate_limited_total",
+				Help:        "Total number of calls to the rpc providers delayed by the rate limiter by chain and provider",
+				ConstLabels: labels,
+			}, []string{"chain", "provider"}),
+		storeUnprocessedOriginTx: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "store_unprocessed_origin_tx",
+				Help:        "Total number of unprocessed origin tx",
+				ConstLabels: labels,
+			}, []string{"chain"}),
+		vaaProcessed: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "vaa_processed",
+				Help:        "Total number of processed vaa with retry context",
+				ConstLabels: labels,
+			}, []string{"chain", "retry", "status"}),
+		wormchainUnknown: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "wormchain_unknown",
+				Help:        "Total number of unknown wormchain",
+				ConstLabels: labels,
+			}, []string{"srcChannel", "dstChannel"}),
+		contractWatcherLag: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "contract_watcher_lag_blocks",
+				Help:        "Number of blocks behind the chain head of the contract watcher by chain",
+				ConstLabels: labels,
+			}, []string{"chain"}),
+		contractWatcherRedeems: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "contract_watcher_redeems_total",
+				Help:        "Total number of redeems found by the contract watcher by chain",
+				ConstLabels: labels,
+			}, []string{"chain"}),
+		originTxReorgs: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "origin_tx_reorgs_total",
+				Help:        "Total number of origin txs affected by a reorg by chain and status",
+				ConstLabels: labels,
+			}, []string{"chain", "status"}),
+	}
+}
+
+// IncVaaConsumedQueue increments the number of consumed VAA.
+func (m *PrometheusTxTrackerMetrics) IncVaaConsumedQueue(chainID string, source string) {
+	m.vaaTxTrackerCount.WithLabelValues(chainID, source, "consumed_queue").Inc()
+}
+
+// IncVaaUnfiltered increments the number of unfiltered VAA.
+func (m *PrometheusTxTrackerMetrics) IncVaaUnfiltered(chainID string, source string) {
+	m.vaaTxTrackerCount.WithLabelValues(chainID, source, "unfiltered").Inc()
+}
+
+// IncOriginTxInserted increments the number of inserted origin tx.
+func (m *PrometheusTxTrackerMetrics) IncOriginTxInserted(chainID string, source string) {
+	m.vaaTxTrackerCount.WithLabelValues(chainID, source, "origin_tx_inserted").Inc()
+}
+
+// IncDestinationTxInserted increments the number of inserted destination tx.
+func (m *PrometheusTxTrackerMetrics) IncDestinationTxInserted(chainID string, source string) {
+	m.vaaTxTrackerCount.WithLabelValues(chainID, source, "destination_tx_inserted").Inc()
+}
+
+// AddVaaProcessedDuration adds the duration of vaa processing.
+func (m *PrometheusTxTrackerMetrics) AddVaaProcessedDuration(chainID uint16, duration float64) {
+	chain := vaa.ChainID(chainID).String()
+	m.vaaProcesedDuration.WithLabelValues(chain).Observe(duration)
+}
+
+// IncVaaWithoutTxHash increments the number of vaa without tx hash.
+func (m *PrometheusTxTrackerMetrics) IncVaaWithoutTxHash(chainID uint16, source string) {
+	chain := vaa.ChainID(chainID).String()
+	m.vaaTxTrackerCount.WithLabelValues(chain, source, "vaa_without_txhash").Inc()
+}
+
+// IncVaaWithTxHashFixed increments the number of vaa with tx hash fixed.
+func (m *PrometheusTxTrackerMetrics) IncVaaWithTxHashFixed(chainID uint16, source string) {
+	chain := vaa.ChainID(chainID).String()
+	m.vaaTxTrackerCount.WithLabelValues(chain, source, "vaa_txhash_fixed").Inc()
+}
+
+// IncCallRpcSuccess increments the number of successful rpc calls.
+func (m *PrometheusTxTrackerMetrics) IncCallRpcSuccess(chainID uint16, rpc string) {
+	chain := vaa.ChainID(chainID).String()
+	m.rpcCallCount.WithLabelValues(chain, rpc, "success").Inc()
+}
+
+// IncCallRpcError increments the number of failed rpc calls.
+func (m *PrometheusTxTrackerMetrics) IncCallRpcError(chainID uint16, rpc string) {
+	chain := vaa.ChainID(chainID).String()
+	m.rpcCallCount.WithLabelValues(chain, rpc, "error").Inc()
+}
+
+// IncRpcCall increments the number of calls to a rpc provider.
+func (m *PrometheusTxTrackerMetrics) IncRpcCall(chainID uint16, provider string, status string) {
+	chain := vaa.ChainID(chainID).String()
+	m.rpcProviderCalls.WithLabelValues(chain, provider, status).Inc()
+}
+
+// ObserveRpcLatency records the latency in seconds of a call to a rpc provider.
+func (m *PrometheusTxTrackerMetrics) ObserveRpcLatency(chainID uint16, provider string, seconds float64) {
+	chain := vaa.ChainID(chainID).String()
+	m.rpcProviderLatency.WithLabelValues(chain, provider).Observe(seconds)
+}
+
+// IncRateLimited increments the number of calls to a rpc provider delayed by the rate limiter.
+func (m *PrometheusTxTrackerMetrics) IncRateLimited(chainID uint16, provider string) {
+	chain := vaa.ChainID(chainID).String()
+	m.rpcProviderRateLimited.WithLabelValues(chain, provider).Inc()
+}
+
+// IncStoreUnprocessedOriginTx increments the number of unprocessed origin tx.
+func (m *PrometheusTxTrackerMetrics) IncStoreUnprocessedOriginTx(chainID uint16) {
+	chain := vaa.ChainID(chainID).String()
+	m.storeUnprocessedOriginTx.WithLabelValues(chain).Inc()
+}
+
+// IncVaaProcessed increments the number of processed vaa.
+func (m *PrometheusTxTrackerMetrics) IncVaaProcessed(chainID uint16, retry uint8) {
+	chain := vaa.ChainID(chainID).String()
+	m.vaaProcessed.WithLabelValues(chain, strconv.Itoa(int(retry)), "success").Inc()
+}
+
+// IncVaaFailed increments the number of failed vaa.
+func (m *PrometheusTxTrackerMetrics) IncVaaFailed(chainID uint16, retry uint8) {
+	chain := vaa.ChainID(chainID).String()
+	m.vaaProcessed.WithLabelValues(chain, strconv.Itoa(int(retry)), "failed").Inc()
+}
+
+// IncWormchainUnknown increments the number of unknown wormchain.
+func (m *PrometheusTxTrackerMetrics) IncWormchainUnknown(srcChannel string, dstChannel string) {
+	m.wormchainUnknown.WithLabelValues(srcChannel, dstChannel).Inc()
+}
+
+// SetContractWatcherLag sets the number of blocks behind the chain head of the contract watcher.
+func (m *PrometheusTxTrackerMetrics) SetContractWatcherLag(chainID uint16, blocks uint64) {
+	chain := vaa.ChainID(chainID).String()
+	m.contractWatcherLag.WithLabelValues(chain).Set(float64(blocks))
+}
+
+// IncContractWatcherRedeem increments the number of redeems found by the contract watcher.
+func (m *PrometheusTxTrackerMetrics) IncContractWatcherRedeem(chainID uint16) {
+	chain := vaa.ChainID(chainID).String()
+	m.contractWatcherRedeems.WithLabelValues(chain).Inc()
+}
+
+// IncOriginTxReorg increments the number of origin txs affected by a reorg.
+func (m *PrometheusTxTrackerMetrics) IncOriginTxReorg(chainID uint16, status string) {
+	chain := vaa.ChainID(chainID).String()
+	m.originTxReorgs.WithLabelValues(chain, status).Inc()
+}
+
+// NoopTxTrackerMetrics is a no-op implementation of the TxTrackerMetrics interface.
+type NoopTxTrackerMetrics struct {
+	NoopPipeline
+	NoopMessages
+	NoopUnknownChains
+}
+
+// NewNoopTxTrackerMetrics returns a new instance of NoopTxTrackerMetrics.
+func NewNoopTxTrackerMetrics() *NoopTxTrackerMetrics {
+	return &NoopTxTrackerMetrics{}
+}
+
+func (*NoopTxTrackerMetrics) IncVaaConsumedQueue(chainID string, source string) {}
+
+func (*NoopTxTrackerMetrics) IncVaaUnfiltered(chainID string, source string) {}
+
+func (*NoopTxTrackerMetrics) IncOriginTxInserted(chainID string, source string) {}
+
+func (*NoopTxTrackerMetrics) IncDestinationTxInserted(chainID string, source string) {}
+
+func (*NoopTxTrackerMetrics) IncVaaWithoutTxHash(chainID uint16, source string) {}
+
+func (*NoopTxTrackerMetrics) IncVaaWithTxHashFixed(chainID uint16, source string) {}
+
+func (*NoopTxTrackerMetrics) AddVaaProcessedDuration(chainID uint16, duration float64) {}
+
+func (*NoopTxTrackerMetrics) IncCallRpcSuccess(chainID uint16, rpc string) {}
+
+func (*NoopTxTrackerMetrics) IncCallRpcError(chainID uint16, rpc string) {}
+
+func (*NoopTxTrackerMetrics) IncRpcCall(chainID uint16, provider string, status string) {}
+
+func (*NoopTxTrackerMetrics) ObserveRpcLatency(chainID uint16, provider string, seconds float64) {}
+
+func (*NoopTxTrackerMetrics) IncRateLimited(chainID uint16, provider string) {}
+
+func (*NoopTxTrackerMetrics) IncStoreUnprocessedOriginTx(chainID uint16) {}
+
+func (*NoopTxTrackerMetrics) IncVaaProcessed(chainID uint16, retry uint8) {}
+
+func (*NoopTxTrackerMetrics) IncVaaFailed(chainID uint16, retry uint8) {}
+
+func (*NoopTxTrackerMetrics) IncWormchainUnknown(srcChannel string, dstChannel string) {}
+
+func (*NoopTxTrackerMetrics) SetContractWatcherLag(chainID uint16, blocks uint64) {}
+
+func (*NoopTxTrackerMetrics) IncContractWatcherRedeem(chainID uint16) {}
+
+func (*NoopTxTrackerMetrics) IncOriginTxReorg(chainID uint16, status string) {}
@@ -0,0 +1,63 @@
+// Package partition implements a pool of workers where the items sharing a key
+// are always processed by the same worker, in the order they were dispatched.
+package partition
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+const defaultBufferSize = 100
+
+// KeyFunc returns the partition key of an item.
+type KeyFunc[T any] func(item T) string
+
+// Workers processes items concurrently across partitions and sequentially within a partition.
+type Workers[T any] struct {
+	queues []chan T
+	key    KeyFunc[T]
+	wg     sync.WaitGroup
+}
+
+// NewWorkers creates and starts size workers calling process for each dispatched item.
+func NewWorkers[T any](size int, key KeyFunc[T], process func(T)) *Workers[T] {
+	if size < 1 {
+		size = 1
+	}
+	w := &Workers[T]{
+		queues: make([]chan T, size),
+		key:    key,
+	}
+	for i := range w.queues {
+		w.queues[i] = make(chan T, defaultBufferSize)
+		w.wg.Add(1)
+		go func(queue <-chan T) {
+			defer w.wg.Done()
+			for item := range queue {
+				process(item)
+			}
+		}(w.queues[i])
+	}
+	return w
+}
+
+// Dispatch sends an item to the worker of its partition.
+//
+// It blocks while the queue of the worker is full.
+func (w *Workers[T]) Dispatch(item T) {
+	w.queues[w.partition(w.key(item))] <- item
+}
+
+// Close stops the workers once all the dispatched items have been processed.
+func (w *Workers[T]) Close() {
+	for _, queue := range w.queues {
+		close(queue)
+	}
+	w.wg.Wait()
+}
+
+func (w *Workers[T]) partition(key string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(len(w.queues)))
+}
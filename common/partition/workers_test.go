@@ -0,0 +1,39 @@
+package partition
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/test-go/testify/assert"
+)
+
+type item struct {
+	emitter  string
+	sequence int
+}
+
+func TestWorkersKeepOrderPerKey(t *testing.T) {
+	var mu sync.Mutex
+	processed := make(map[string][]int)
+
+	workers := NewWorkers(4, func(i item) string { return i.emitter }, func(i item) {
+		mu.Lock()
+		defer mu.Unlock()
+		processed[i.emitter] = append(processed[i.emitter], i.sequence)
+	})
+	for seq := 0; seq < 50; seq++ {
+		for e := 0; e < 10; e++ {
+			workers.Dispatch(item{emitter: fmt.Sprintf("emitter-%d", e), sequence: seq})
+		}
+	}
+	workers.Close()
+
+	assert.Len(t, processed, 10)
+	for emitter, sequences := range processed {
+		assert.Len(t, sequences, 50, emitter)
+		for i, seq := range sequences {
+			assert.Equal(t, i, seq, emitter)
+		}
+	}
+}
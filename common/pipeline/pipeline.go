@@ -0,0 +1,228 @@
+// Package pipeline implements the consume, process and acknowledge loop shared by the services
+// that process the messages of a queue.
+//
+// A [Source] dispatches the messages to a channel and closes it on shutdown, a [Processor] handles
+// each message, and the [AckPolicy] decides from the result whether the message is done or failed,
+// i.e. deleted from the queue or retried once its visibility timeout expires.
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/partition"
+)
+
+// Message is a message received from a source.
+type Message interface {
+	// Done acknowledges the message, it is not received again.
+	Done()
+	// Failed releases the message, it is received again once its visibility timeout expires.
+	Failed()
+	// IsExpired returns true when the visibility timeout of the message expired.
+	IsExpired() bool
+}
+
+// Source starts receiving messages and returns the channel they are dispatched to.
+// The source must close the channel once the context is canceled.
+type Source[M Message] func(ctx context.Context) <-chan M
+
+// Processor processes a message.
+type Processor[M Message] func(ctx context.Context, msg M) error
+
+// Ack is the acknowledgement of a processed message.
+type Ack int
+
+const (
+	// AckDone marks the message as done.
+	AckDone Ack = iota
+	// AckFailed marks the message as failed.
+	AckFailed
+)
+
+// AckPolicy returns the acknowledgement of a message from the error returned by the processor.
+type AckPolicy func(err error) Ack
+
+// DefaultAckPolicy marks as done the messages processed successfully and the discarded ones,
+// and as failed the others.
+func DefaultAckPolicy(err error) Ack {
+	if err == nil || IsDiscarded(err) {
+		return AckDone
+	}
+	return AckFailed
+}
+
+type discardError struct {
+	err error
+}
+
+func (e *discardError) Error() string { return e.err.Error() }
+
+func (e *discardError) Unwrap() error { return e.err }
+
+// Discard wraps the error of a message that cannot be processed however many times it is retried,
+// e.g. a malformed message. The [DefaultAckPolicy] marks it as done so it is not retried.
+func Discard(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &discardError{err: err}
+}
+
+// IsDiscarded returns true when the error was wrapped by [Discard].
+func IsDiscarded(err error) bool {
+	var d *discardError
+	return errors.As(err, &d)
+}
+
+// Hooks are the functions called with the outcome of each message, mainly to record metrics.
+// All of them are optional.
+type Hooks[M Message] struct {
+	// Expired is called when a message is failed without being processed because it expired.
+	Expired func(msg M)
+	// Processed is called when a message is processed without errors.
+	Processed func(msg M, elapsed time.Duration)
+	// Failed is called with the error returned by the processor, whatever the acknowledgement of the message.
+	Failed func(msg M, err error, elapsed time.Duration)
+}
+
+// DefaultDrainTimeout is how long the in-flight and drained messages are processed after the
+// context is cancelled when the configuration does not set it.
+const DefaultDrainTimeout = 20 * time.Second
+
+// Config is the configuration of a pipeline.
+type Config[M Message] struct {
+	// Workers is the number of messages processed concurrently, one when it is lower.
+	Workers int
+	// PartitionKey, when set, makes the messages sharing a key to be processed by the same worker,
+	// in the order they were received.
+	PartitionKey partition.KeyFunc[M]
+	// SkipExpired fails the expired messages without processing them.
+	SkipExpired bool
+	// AckPolicy is the acknowledgement policy of the messages, DefaultAckPolicy when nil.
+	AckPolicy AckPolicy
+	// Hooks are called with the outcome of each message.
+	Hooks Hooks[M]
+	// DrainTimeout bounds the processing of the in-flight and drained messages once the context is
+	// cancelled, DefaultDrainTimeout when it is not positive.
+	DrainTimeout time.Duration
+}
+
+// Pipeline consumes the messages of a source with a pool of workers.
+type Pipeline[M Message] struct {
+	source  Source[M]
+	process Processor[M]
+	cfg     Config[M]
+	wg      sync.WaitGroup
+}
+
+// New creates a pipeline processing the messages of the source.
+func New[M Message](source Source[M], process Processor[M], cfg Config[M]) *Pipeline[M] {
+	if cfg.Workers < 1 {
+		cfg.Workers = 1
+	}
+	if cfg.AckPolicy == nil {
+		cfg.AckPolicy = DefaultAckPolicy
+	}
+	if cfg.DrainTimeout <= 0 {
+		cfg.DrainTimeout = DefaultDrainTimeout
+	}
+	return &Pipeline[M]{source: source, process: process, cfg: cfg}
+}
+
+// Start starts consuming the messages of the source until it closes the channel on context cancellation.
+//
+// The messages are processed with a context that is not cancelled with ctx, so the in-flight and
+// drained messages are still processed on shutdown, until the drain timeout elapses.
+func (p *Pipeline[M]) Start(ctx context.Context) {
+	ch := p.source(ctx)
+	processCtx, stop := drainContext(ctx, p.cfg.DrainTimeout)
+
+	if p.cfg.PartitionKey != nil && p.cfg.Workers > 1 {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			workers := partition.NewWorkers(p.cfg.Workers, p.cfg.PartitionKey, func(msg M) {
+				p.handle(processCtx, msg)
+			})
+			defer workers.Close()
+			for msg := range ch {
+				workers.Dispatch(msg)
+			}
+		}()
+	} else {
+		for i := 0; i < p.cfg.Workers; i++ {
+			p.wg.Add(1)
+			go func() {
+				defer p.wg.Done()
+				for msg := range ch {
+					p.handle(processCtx, msg)
+				}
+			}()
+		}
+	}
+
+	go func() {
+		p.wg.Wait()
+		stop()
+	}()
+}
+
+// drainContext returns a context that keeps the values of ctx but is only cancelled once the timeout
+// elapses after ctx is cancelled, or when the returned function is called.
+func drainContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	drainCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-drainCtx.Done():
+			return
+		}
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			cancel()
+		case <-drainCtx.Done():
+		}
+	}()
+	return drainCtx, cancel
+}
+
+// Wait blocks until the pipeline stops after the context is cancelled and the in-flight messages are drained.
+func (p *Pipeline[M]) Wait() {
+	p.wg.Wait()
+}
+
+func (p *Pipeline[M]) handle(ctx context.Context, msg M) {
+	if p.cfg.SkipExpired && msg.IsExpired() {
+		msg.Failed()
+		if p.cfg.Hooks.Expired != nil {
+			p.cfg.Hooks.Expired(msg)
+		}
+		return
+	}
+
+	start := time.Now()
+	err := p.process(ctx, msg)
+	elapsed := time.Since(start)
+
+	switch p.cfg.AckPolicy(err) {
+	case AckDone:
+		msg.Done()
+	default:
+		msg.Failed()
+	}
+
+	if err != nil {
+		if p.cfg.Hooks.Failed != nil {
+			p.cfg.Hooks.Failed(msg, err, elapsed)
+		}
+		return
+	}
+	if p.cfg.Hooks.Processed != nil {
+		p.cfg.Hooks.Processed(msg, elapsed)
+	}
+}
@@ -0,0 +1,196 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/test-go/testify/assert"
+)
+
+type message struct {
+	key      string
+	sequence int
+	expired  bool
+
+	mu   *sync.Mutex
+	acks map[string]Ack
+}
+
+func (m *message) id() string { return fmt.Sprintf("%s/%d", m.key, m.sequence) }
+
+func (m *message) Done() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.acks[m.id()] = AckDone
+}
+
+func (m *message) Failed() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.acks[m.id()] = AckFailed
+}
+
+func (m *message) IsExpired() bool { return m.expired }
+
+type fixture struct {
+	mu     sync.Mutex
+	acks   map[string]Ack
+	orders map[string][]int
+}
+
+func newFixture() *fixture {
+	return &fixture{acks: make(map[string]Ack), orders: make(map[string][]int)}
+}
+
+func (f *fixture) message(key string, sequence int, expired bool) *message {
+	return &message{key: key, sequence: sequence, expired: expired, mu: &f.mu, acks: f.acks}
+}
+
+func source(messages ...*message) Source[*message] {
+	return func(ctx context.Context) <-chan *message {
+		ch := make(chan *message, len(messages))
+		for _, m := range messages {
+			ch <- m
+		}
+		close(ch)
+		return ch
+	}
+}
+
+func TestPipelineAcknowledgesByResult(t *testing.T) {
+	f := newFixture()
+	errInvalid := errors.New("invalid")
+	errUnavailable := errors.New("unavailable")
+
+	var mu sync.Mutex
+	var expired, processed, failed []string
+	p := New(source(f.message("ok", 1, false), f.message("invalid", 1, false), f.message("unavailable", 1, false), f.message("expired", 1, true)),
+		func(ctx context.Context, m *message) error {
+			switch m.key {
+			case "invalid":
+				return Discard(errInvalid)
+			case "unavailable":
+				return errUnavailable
+			}
+			return nil
+		},
+		Config[*message]{
+			Workers:     2,
+			SkipExpired: true,
+			Hooks: Hooks[*message]{
+				Expired: func(m *message) {
+					mu.Lock()
+					defer mu.Unlock()
+					expired = append(expired, m.key)
+				},
+				Processed: func(m *message, _ time.Duration) {
+					mu.Lock()
+					defer mu.Unlock()
+					processed = append(processed, m.key)
+				},
+				Failed: func(m *message, err error, _ time.Duration) {
+					mu.Lock()
+					defer mu.Unlock()
+					failed = append(failed, m.key)
+					if m.key == "invalid" {
+						assert.True(t, IsDiscarded(err))
+						assert.True(t, errors.Is(err, errInvalid))
+					}
+				},
+			},
+		})
+	p.Start(context.Background())
+	p.Wait()
+
+	assert.Equal(t, map[string]Ack{
+		"ok/1":          AckDone,
+		"invalid/1":     AckDone,
+		"unavailable/1": AckFailed,
+		"expired/1":     AckFailed,
+	}, f.acks)
+	assert.Equal(t, []string{"expired"}, expired)
+	assert.Equal(t, []string{"ok"}, processed)
+	sort.Strings(failed)
+	assert.Equal(t, []string{"invalid", "unavailable"}, failed)
+}
+
+func TestPipelineKeepsOrderPerPartition(t *testing.T) {
+	f := newFixture()
+	var messages []*message
+	for seq := 0; seq < 50; seq++ {
+		for e := 0; e < 10; e++ {
+			messages = append(messages, f.message(fmt.Sprintf("emitter-%d", e), seq, false))
+		}
+	}
+
+	p := New(source(messages...),
+		func(ctx context.Context, m *message) error {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.orders[m.key] = append(f.orders[m.key], m.sequence)
+			return nil
+		},
+		Config[*message]{
+			Workers:      4,
+			PartitionKey: func(m *message) string { return m.key },
+		})
+	p.Start(context.Background())
+	p.Wait()
+
+	assert.Len(t, f.acks, 500)
+	assert.Len(t, f.orders, 10)
+	for key, sequences := range f.orders {
+		assert.Len(t, sequences, 50, key)
+		for i, seq := range sequences {
+			assert.Equal(t, i, seq, key)
+		}
+	}
+}
+
+func TestPipelineDrainsWithoutCancelledContext(t *testing.T) {
+	f := newFixture()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var errs []error
+	p := New(source(f.message("a", 1, false), f.message("a", 2, false)),
+		func(ctx context.Context, m *message) error {
+			errs = append(errs, ctx.Err())
+			return ctx.Err()
+		},
+		Config[*message]{})
+	p.Start(ctx)
+	p.Wait()
+
+	assert.Equal(t, []error{nil, nil}, errs)
+	assert.Equal(t, map[string]Ack{"a/1": AckDone, "a/2": AckDone}, f.acks)
+}
+
+func TestPipelineDrainTimeout(t *testing.T) {
+	f := newFixture()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := New(source(f.message("a", 1, false)),
+		func(ctx context.Context, m *message) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		Config[*message]{DrainTimeout: 10 * time.Millisecond})
+	p.Start(ctx)
+	p.Wait()
+
+	assert.Equal(t, map[string]Ack{"a/1": AckFailed}, f.acks)
+}
+
+func TestDefaultAckPolicy(t *testing.T) {
+	assert.Equal(t, AckDone, DefaultAckPolicy(nil))
+	assert.Equal(t, AckDone, DefaultAckPolicy(fmt.Errorf("wrapped: %w", Discard(errors.New("invalid")))))
+	assert.Equal(t, AckFailed, DefaultAckPolicy(errors.New("unavailable")))
+	assert.Nil(t, Discard(nil))
+}
@@ -0,0 +1,45 @@
+// Package policy defines the operational policies that differ between the environments.
+//
+// The policies are keyed by run mode, e.g. the testnet is reset when the chains are redeployed
+// and the faucets and the integration tests of the protocols make many more requests than the mainnet users.
+package policy
+
+import "time"
+
+// Run modes.
+const (
+	RunModeProduction  = "PRODUCTION"
+	RunModeTestnet     = "TESTNET"
+	RunModeDevelopment = "DEVELOPMENT"
+)
+
+// Policy is the set of operational limits of an environment.
+type Policy struct {
+	// RateLimitMax is the default max number of requests per minute by ip to the api.
+	RateLimitMax int
+	// ObservationsRetention is how long the observations are kept by the clean-up job, zero keeps them forever.
+	ObservationsRetention time.Duration
+}
+
+var policies = map[string]Policy{
+	RunModeProduction: {
+		RateLimitMax: 60,
+	},
+	RunModeTestnet: {
+		RateLimitMax:          600,
+		ObservationsRetention: 90 * 24 * time.Hour,
+	},
+	RunModeDevelopment: {
+		RateLimitMax:          1000,
+		ObservationsRetention: 30 * 24 * time.Hour,
+	},
+}
+
+// ForRunMode returns the policy of a run mode. The production policy is returned for the unknown run modes,
+// so a misconfigured environment gets the strictest limits.
+func ForRunMode(runMode string) Policy {
+	if p, ok := policies[runMode]; ok {
+		return p
+	}
+	return policies[RunModeProduction]
+}
@@ -0,0 +1,17 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/test-go/testify/assert"
+)
+
+func TestForRunMode(t *testing.T) {
+	assert.Zero(t, ForRunMode(RunModeProduction).ObservationsRetention)
+	assert.True(t, ForRunMode(RunModeTestnet).ObservationsRetention > 0)
+	assert.True(t, ForRunMode(RunModeTestnet).RateLimitMax > ForRunMode(RunModeProduction).RateLimitMax)
+
+	// the unknown run modes get the production policy.
+	assert.Equal(t, ForRunMode(RunModeProduction), ForRunMode("STAGING"))
+	assert.Equal(t, ForRunMode(RunModeProduction), ForRunMode(""))
+}
@@ -2,6 +2,7 @@ package pool
 
 import (
 	"sort"
+	"sync"
 	"time"
 
 	"golang.org/x/net/context"
@@ -10,6 +11,7 @@ import (
 
 // Pool is a pool of items.
 type Pool struct {
+	mu    sync.RWMutex
 	items []Item
 }
 
@@ -24,6 +26,8 @@ type Item struct {
 	priority uint8
 	// rateLimit is the rate limiter for the item.
 	rateLimit *rate.Limiter
+	// requestsPerMinute is the limit of the rate limiter.
+	requestsPerMinute uint16
 }
 
 // NewPool creates a new pool.
@@ -37,18 +41,46 @@ func NewPool(cfg []Config) *Pool {
 
 // addItem adds a new item to the pool.
 func (p *Pool) addItem(cfg Config) {
-	i := Item{
-		Id:          cfg.Id,
-		Description: cfg.Description,
-		priority:    cfg.Priority,
+	p.items = append(p.items, newItem(cfg))
+}
+
+func newItem(cfg Config) Item {
+	return Item{
+		Id:                cfg.Id,
+		Description:       cfg.Description,
+		priority:          cfg.Priority,
+		requestsPerMinute: cfg.RequestsPerMinute,
 		rateLimit: rate.NewLimiter(
 			rate.Every(time.Minute/time.Duration(cfg.RequestsPerMinute)), 1),
 	}
-	p.items = append(p.items, i)
+}
+
+// Update replaces the items of the pool.
+// The items with the same id and requests per minute keep their rate limiter.
+func (p *Pool) Update(cfg []Config) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current := make(map[string]Item, len(p.items))
+	for _, i := range p.items {
+		current[i.Id] = i
+	}
+	items := make([]Item, 0, len(cfg))
+	for _, c := range cfg {
+		i := newItem(c)
+		if old, ok := current[c.Id]; ok && old.requestsPerMinute == c.RequestsPerMinute {
+			i.rateLimit = old.rateLimit
+		}
+		items = append(items, i)
+	}
+	p.items = items
 }
 
 // GetItem returns the next available item of the pool.
 func (p *Pool) GetItem() Item {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	// check if there is no item
 	if len(p.items) == 0 {
 		return Item{}
@@ -86,6 +118,9 @@ func (p *Pool) GetItem() Item {
 // GetItems returns the list of items sorted by score and priority.
 // Once there is an event on the item, it must be notified using the method NotifyEvent.
 func (p *Pool) GetItems() []Item {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	if len(p.items) == 0 {
 		return []Item{}
 	}
@@ -127,3 +162,8 @@ func (p *Pool) GetItems() []Item {
 func (i *Item) Wait(ctx context.Context) error {
 	return i.rateLimit.Wait(ctx)
 }
+
+// IsRateLimited returns whether the next item request has to wait for the rate limiter.
+func (i *Item) IsRateLimited() bool {
+	return i.rateLimit.Tokens() < 1
+}
@@ -0,0 +1,36 @@
+// Package postgres implements the connection to the PostgreSQL storage backend of the core collections.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"time"
+
+	_ "github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+//go:embed schema.sql
+var schema string
+
+// Connect opens a connection pool to the PostgreSQL database and creates the schema if it does not exist.
+func Connect(ctx context.Context, url string, logger *zap.Logger) (*sql.DB, error) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(20)
+	db.SetConnMaxIdleTime(5 * time.Minute)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	logger.Info("connected to PostgreSQL")
+	return db, nil
+}
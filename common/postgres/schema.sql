@@ -0,0 +1,87 @@
+-- Schema of the core collections stored in PostgreSQL.
+-- The tables mirror the fields of the mongodb documents used by the api, they are copied from mongodb
+-- by the postgres sync job (JOB_POSTGRES_SYNC).
+
+CREATE TABLE IF NOT EXISTS vaas (
+    id                 TEXT PRIMARY KEY,
+    version            SMALLINT NOT NULL,
+    emitter_chain      INTEGER NOT NULL,
+    emitter_addr       TEXT NOT NULL,
+    sequence           TEXT NOT NULL,
+    guardian_set_index BIGINT NOT NULL,
+    vaa                BYTEA NOT NULL,
+    digest             TEXT NOT NULL DEFAULT '',
+    tx_hash            TEXT,
+    is_duplicated      BOOLEAN NOT NULL DEFAULT FALSE,
+    conflict           BOOLEAN NOT NULL DEFAULT FALSE,
+    timestamp          TIMESTAMPTZ,
+    indexed_at         TIMESTAMPTZ,
+    updated_at         TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS vaas_timestamp_idx ON vaas (timestamp DESC);
+CREATE INDEX IF NOT EXISTS vaas_emitter_idx ON vaas (emitter_chain, emitter_addr, timestamp DESC);
+CREATE INDEX IF NOT EXISTS vaas_tx_hash_idx ON vaas (tx_hash);
+CREATE INDEX IF NOT EXISTS vaas_indexed_at_idx ON vaas (indexed_at, id);
+
+CREATE TABLE IF NOT EXISTS vaas_pythnet (LIKE vaas INCLUDING ALL);
+
+CREATE TABLE IF NOT EXISTS duplicate_vaas (
+    id                 TEXT PRIMARY KEY,
+    vaa_id             TEXT NOT NULL,
+    version            SMALLINT NOT NULL,
+    emitter_chain      INTEGER NOT NULL,
+    emitter_addr       TEXT NOT NULL,
+    sequence           TEXT NOT NULL,
+    guardian_set_index BIGINT NOT NULL,
+    vaa                BYTEA NOT NULL,
+    digest             TEXT NOT NULL DEFAULT '',
+    tx_hash            TEXT,
+    conflict           BOOLEAN NOT NULL DEFAULT FALSE,
+    timestamp          TIMESTAMPTZ,
+    indexed_at         TIMESTAMPTZ,
+    updated_at         TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS duplicate_vaas_vaa_id_idx ON duplicate_vaas (vaa_id);
+CREATE INDEX IF NOT EXISTS duplicate_vaas_conflict_idx ON duplicate_vaas (conflict, timestamp DESC);
+
+CREATE TABLE IF NOT EXISTS parsed_vaa (
+    id             TEXT PRIMARY KEY,
+    app_ids        TEXT[] NOT NULL DEFAULT '{}',
+    parsed_payload JSONB,
+    raw_to_chain   INTEGER,
+    indexed_at     TIMESTAMPTZ,
+    updated_at     TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS parsed_vaa_app_ids_idx ON parsed_vaa USING GIN (app_ids);
+
+CREATE TABLE IF NOT EXISTS global_transactions (
+    id             TEXT PRIMARY KEY,
+    native_tx_hash TEXT,
+    origin_tx_hash TEXT
+);
+CREATE INDEX IF NOT EXISTS global_transactions_native_tx_hash_idx ON global_transactions (native_tx_hash);
+CREATE INDEX IF NOT EXISTS global_transactions_origin_tx_hash_idx ON global_transactions (origin_tx_hash);
+
+CREATE TABLE IF NOT EXISTS observations (
+    id            TEXT PRIMARY KEY,
+    emitter_chain INTEGER NOT NULL,
+    emitter_addr  TEXT NOT NULL,
+    sequence      TEXT NOT NULL,
+    guardian_addr TEXT NOT NULL,
+    hash          BYTEA NOT NULL,
+    indexed_at    TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS observations_vaa_idx ON observations (emitter_chain, emitter_addr, sequence);
+
+CREATE TABLE IF NOT EXISTS vaa_counts (
+    chain_id INTEGER PRIMARY KEY,
+    count    BIGINT NOT NULL
+);
+
+-- sync_state is the position of the postgres sync job in each mongodb collection:
+-- the update time and id of the last document copied.
+CREATE TABLE IF NOT EXISTS sync_state (
+    collection TEXT PRIMARY KEY,
+    updated_at TIMESTAMPTZ NOT NULL,
+    last_id    TEXT NOT NULL
+);
@@ -0,0 +1,137 @@
+package reload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// LoadFunc parses and validates the content of a configuration file.
+// An error keeps the current configuration in use.
+type LoadFunc[T any] func(data []byte) (*T, error)
+
+// Watcher keeps the current value of a configuration file that can be changed without restarting the service,
+// e.g. a configmap mounted in the pod.
+//
+// The file is read again when the process receives a SIGHUP and, when [interval] is greater than zero,
+// when the modification time of the file changes. The new configuration is only swapped in when it is valid,
+// and then the reload callbacks are called with it.
+type Watcher[T any] struct {
+	path     string
+	interval time.Duration
+	load     LoadFunc[T]
+	current  atomic.Pointer[T]
+	mu       sync.Mutex
+	modTime  time.Time
+	onReload []func(*T)
+	logger   *zap.Logger
+}
+
+// NewWatcher creates a watcher of the configuration file [path] and loads its current content.
+func NewWatcher[T any](path string, interval time.Duration, load LoadFunc[T], logger *zap.Logger) (*Watcher[T], error) {
+	if path == "" {
+		return nil, errors.New("config file path is empty")
+	}
+	w := &Watcher[T]{
+		path:     path,
+		interval: interval,
+		load:     load,
+		logger:   logger.With(zap.String("path", path)),
+	}
+	if _, err := w.Reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Get returns the current configuration.
+func (w *Watcher[T]) Get() *T {
+	return w.current.Load()
+}
+
+// OnReload registers a callback called with the new configuration after each reload.
+// The callbacks must be registered before starting the watcher.
+func (w *Watcher[T]) OnReload(fn func(*T)) {
+	w.onReload = append(w.onReload, fn)
+}
+
+// Reload reads the configuration file and swaps the current configuration when it is valid.
+// It returns whether the configuration changed.
+func (w *Watcher[T]) Reload() (bool, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.reload(false)
+}
+
+func (w *Watcher[T]) reload(onlyIfModified bool) (bool, error) {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat config file %s: %w", w.path, err)
+	}
+	if onlyIfModified && info.ModTime().Equal(w.modTime) {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read config file %s: %w", w.path, err)
+	}
+	cfg, err := w.load(data)
+	if err != nil {
+		return false, fmt.Errorf("invalid config file %s: %w", w.path, err)
+	}
+
+	w.modTime = info.ModTime()
+	w.current.Store(cfg)
+	for _, fn := range w.onReload {
+		fn(cfg)
+	}
+	return true, nil
+}
+
+// Start reloads the configuration on SIGHUP and on the changes of the file until the context is cancelled.
+func (w *Watcher[T]) Start(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	var tick <-chan time.Time
+	if w.interval > 0 {
+		ticker := time.NewTicker(w.interval)
+		tick = ticker.C
+		defer ticker.Stop()
+	}
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			w.logger.Info("reloading config on SIGHUP")
+			w.check(false)
+		case <-tick:
+			w.check(true)
+		}
+	}
+}
+
+func (w *Watcher[T]) check(onlyIfModified bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	changed, err := w.reload(onlyIfModified)
+	if err != nil {
+		w.logger.Error("failed to reload config, keeping the current config", zap.Error(err))
+		return
+	}
+	if changed {
+		w.logger.Info("config reloaded")
+	}
+}
@@ -0,0 +1,115 @@
+package reload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"go.uber.org/zap"
+)
+
+type testConfig struct {
+	Max int `json:"max"`
+}
+
+func loadTestConfig(data []byte) (*testConfig, error) {
+	var cfg testConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Max <= 0 {
+		return nil, errors.New("max must be greater than zero")
+	}
+	return &cfg, nil
+}
+
+func writeFile(t *testing.T, path, content string, modTime time.Time) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestWatcherReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	now := time.Now()
+	writeFile(t, path, `{"max": 10}`, now)
+
+	w, err := NewWatcher(path, 0, loadTestConfig, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 10, w.Get().Max)
+
+	var reloaded []int
+	w.OnReload(func(cfg *testConfig) { reloaded = append(reloaded, cfg.Max) })
+
+	// the new config is swapped in.
+	writeFile(t, path, `{"max": 20}`, now.Add(time.Second))
+	changed, err := w.Reload()
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, 20, w.Get().Max)
+
+	// an invalid config keeps the current one.
+	writeFile(t, path, `{"max": 0}`, now.Add(2*time.Second))
+	_, err = w.Reload()
+	assert.Error(t, err)
+	assert.Equal(t, 20, w.Get().Max)
+
+	// the file is not reloaded when it was not modified.
+	writeFile(t, path, `{"max": 30}`, now.Add(3*time.Second))
+	w.check(true)
+	assert.Equal(t, 30, w.Get().Max)
+	writeFile(t, path, `{"max": 40}`, now.Add(3*time.Second))
+	w.check(true)
+	assert.Equal(t, 30, w.Get().Max)
+
+	assert.Equal(t, []int{20, 30}, reloaded)
+}
+
+func TestNewWatcherInvalidConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeFile(t, path, `{"max": -1}`, time.Now())
+
+	_, err := NewWatcher(path, 0, loadTestConfig, zap.NewNop())
+	assert.Error(t, err)
+
+	_, err = NewWatcher(filepath.Join(t.TempDir(), "missing.json"), 0, loadTestConfig, zap.NewNop())
+	assert.Error(t, err)
+}
+
+func TestWatcherStartOnSIGHUP(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	now := time.Now()
+	writeFile(t, path, `{"max": 10}`, now)
+
+	w, err := NewWatcher(path, 0, loadTestConfig, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloaded := make(chan int, 1)
+	w.OnReload(func(cfg *testConfig) { reloaded <- cfg.Max })
+	go w.Start(ctx)
+
+	// a SIGHUP sent before the watcher handles it must not stop the test.
+	signal.Notify(make(chan os.Signal, 1), syscall.SIGHUP)
+	// wait for the signal handler of the watcher to be registered.
+	time.Sleep(100 * time.Millisecond)
+	writeFile(t, path, `{"max": 50}`, now)
+	p, err := os.FindProcess(os.Getpid())
+	require.NoError(t, err)
+	require.NoError(t, p.Signal(syscall.SIGHUP))
+
+	select {
+	case max := <-reloaded:
+		assert.Equal(t, 50, max)
+	case <-time.After(5 * time.Second):
+		t.Fatal("config not reloaded on SIGHUP")
+	}
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// chainDataCollections are the collections whose ids start with a vaa id,
+// so the documents of a chain are the ones whose id starts with "<chain>/".
+var chainDataCollections = []string{
+	Vaas,
+	DuplicateVaas,
+	Observations,
+	VaaIdTxHash,
+	ParsedVaa,
+	GlobalTransactions,
+	TransferPrices,
+	Reorgs,
+}
+
+// MatchChainData returns the filter of the documents of a chain in the collections whose ids start with a vaa id.
+func MatchChainData(chainID sdk.ChainID) bson.D {
+	return bson.D{{Key: "_id", Value: primitive.Regex{Pattern: fmt.Sprintf("^%d/", chainID)}}}
+}
+
+// ChainDataWiper deletes all the data of a chain, e.g. after a testnet redeployment.
+type ChainDataWiper struct {
+	db     *mongo.Database
+	logger *zap.Logger
+}
+
+// NewChainDataWiper creates a new ChainDataWiper.
+func NewChainDataWiper(db *mongo.Database, logger *zap.Logger) *ChainDataWiper {
+	return &ChainDataWiper{
+		db:     db,
+		logger: logger.With(zap.String("module", "ChainDataWiper")),
+	}
+}
+
+// Wipe deletes the documents of a chain and returns the number of documents deleted by collection.
+//
+// When a collection cannot be wiped, the collections wiped so far are returned with the error,
+// and the wipe can be run again.
+func (w *ChainDataWiper) Wipe(ctx context.Context, chainID sdk.ChainID) (map[string]int64, error) {
+	deleted := make(map[string]int64, len(chainDataCollections))
+	filter := MatchChainData(chainID)
+	for _, name := range chainDataCollections {
+		result, err := w.db.Collection(name).DeleteMany(ctx, filter)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to wipe %s of chain %d: %w", name, chainID, err)
+		}
+		deleted[name] = result.DeletedCount
+		w.logger.Info("wiped chain data",
+			zap.String("collection", name),
+			zap.Uint16("chainId", uint16(chainID)),
+			zap.Int64("deleted", result.DeletedCount))
+	}
+	return deleted, nil
+}
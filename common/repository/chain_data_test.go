@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMatchChainData(t *testing.T) {
+	expected := bson.D{{Key: "_id", Value: primitive.Regex{Pattern: "^2/"}}}
+	assert.Equal(t, expected, MatchChainData(sdk.ChainIDEthereum))
+}
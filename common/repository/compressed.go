@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// BinaryZstd is the user defined binary subtype of the zstd compressed binaries,
+// so they can be told apart from the uncompressed binaries stored before.
+const BinaryZstd byte = 0x80
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// CompressedBytes are bytes stored compressed with zstd, e.g. the raw vaas.
+//
+// The bytes are only stored compressed when they are smaller compressed, and the
+// uncompressed binaries are still read, so the documents can be migrated progressively.
+type CompressedBytes []byte
+
+// MarshalBSONValue encodes the bytes as a zstd compressed binary.
+func (b CompressedBytes) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if b == nil {
+		return bsontype.Null, nil, nil
+	}
+	if compressed := zstdEncoder.EncodeAll(b, nil); len(compressed) < len(b) {
+		return bsontype.Binary, bsoncore.AppendBinary(nil, BinaryZstd, compressed), nil
+	}
+	return bsontype.Binary, bsoncore.AppendBinary(nil, bsontype.BinaryGeneric, b), nil
+}
+
+// UnmarshalBSONValue decodes a binary, decompressing it if it is compressed.
+func (b *CompressedBytes) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	switch t {
+	case bsontype.Null, bsontype.Undefined:
+		*b = nil
+		return nil
+	case bsontype.Binary:
+	default:
+		return fmt.Errorf("cannot decode %s into CompressedBytes", t)
+	}
+
+	subtype, bin, _, ok := bsoncore.ReadBinary(data)
+	if !ok {
+		return errors.New("CompressedBytes UnmarshalBSONValue error")
+	}
+	if subtype != BinaryZstd {
+		// the data is owned by the driver, it can be reused once the value is decoded.
+		*b = append(CompressedBytes(nil), bin...)
+		return nil
+	}
+
+	decompressed, err := zstdDecoder.DecodeAll(bin, nil)
+	if err != nil {
+		return fmt.Errorf("failed to decompress binary: %w", err)
+	}
+	*b = decompressed
+	return nil
+}
+
+// IsCompressed returns true when the raw bson value is a zstd compressed binary.
+func IsCompressed(t bsontype.Type, data []byte) bool {
+	if t != bsontype.Binary {
+		return false
+	}
+	subtype, _, _, ok := bsoncore.ReadBinary(data)
+	return ok && subtype == BinaryZstd
+}
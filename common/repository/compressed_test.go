@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func TestCompressedBytes(t *testing.T) {
+	type doc struct {
+		Vaa CompressedBytes `bson:"vaas"`
+	}
+
+	tcs := []struct {
+		name       string
+		vaa        []byte
+		compressed bool
+	}{
+		{name: "compressible", vaa: bytes.Repeat([]byte{0x01, 0x02, 0x03, 0x04}, 256), compressed: true},
+		{name: "incompressible", vaa: []byte{0x01, 0x02, 0x03}, compressed: false},
+		{name: "nil", vaa: nil, compressed: false},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := bson.Marshal(doc{Vaa: tc.vaa})
+			assert.NoError(t, err)
+
+			raw := bson.Raw(data).Lookup("vaas")
+			assert.Equal(t, tc.compressed, IsCompressed(raw.Type, raw.Value))
+
+			var decoded doc
+			assert.NoError(t, bson.Unmarshal(data, &decoded))
+			assert.Equal(t, CompressedBytes(tc.vaa), decoded.Vaa)
+		})
+	}
+}
+
+func TestCompressedBytesReadsUncompressed(t *testing.T) {
+	data, err := bson.Marshal(bson.M{"vaas": []byte{0x01, 0x02, 0x03}})
+	assert.NoError(t, err)
+
+	raw := bson.Raw(data).Lookup("vaas")
+	assert.Equal(t, bsontype.Binary, raw.Type)
+	assert.False(t, IsCompressed(raw.Type, raw.Value))
+
+	var decoded struct {
+		Vaa CompressedBytes `bson:"vaas"`
+	}
+	assert.NoError(t, bson.Unmarshal(data, &decoded))
+	assert.Equal(t, CompressedBytes{0x01, 0x02, 0x03}, decoded.Vaa)
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// DeniedEmitter is an emitter whose messages are excluded from the listings and the statistics, e.g. a spam emitter.
+type DeniedEmitter struct {
+	ID             string      `bson:"_id" json:"id"`
+	EmitterChain   sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddress string      `bson:"emitterAddr" json:"emitterAddress"`
+	Reason         string      `bson:"reason" json:"reason"`
+	UpdatedAt      time.Time   `bson:"updatedAt" json:"updatedAt"`
+}
+
+// DeniedEmitterID returns the id of a denied emitter, the prefix of the ids of its vaas.
+func DeniedEmitterID(chainID sdk.ChainID, address string) string {
+	return fmt.Sprintf("%d/%s", chainID, NormalizeEmitterAddress(address))
+}
+
+// NormalizeEmitterAddress returns the emitter address as it is stored in the vaa ids,
+// the hex address in lower case without the 0x prefix.
+func NormalizeEmitterAddress(address string) string {
+	address = strings.ToLower(strings.TrimSpace(address))
+	return strings.TrimPrefix(address, "0x")
+}
+
+// MatchNotDenied returns a $match stage that excludes the documents of the denied emitters.
+//
+// The documents are matched by their id, so the stage can be used in any collection
+// whose ids are vaa ids (e.g. vaas, parsedVaa and globalTransactions).
+// It returns nil when there are no denied emitters.
+func MatchNotDenied(emitters []*DeniedEmitter) bson.D {
+	filter := FilterNotDenied(emitters)
+	if filter == nil {
+		return nil
+	}
+	return bson.D{{Key: "$match", Value: filter}}
+}
+
+// FilterNotDenied returns the filter of the documents that are not of the denied emitters,
+// to add to the filter of a find. It returns nil when there are no denied emitters.
+func FilterNotDenied(emitters []*DeniedEmitter) bson.D {
+	if len(emitters) == 0 {
+		return nil
+	}
+	prefixes := make(bson.A, 0, len(emitters))
+	for _, e := range emitters {
+		prefixes = append(prefixes, primitive.Regex{Pattern: "^" + regexp.QuoteMeta(e.ID+"/")})
+	}
+	return bson.D{{Key: "_id", Value: bson.D{{Key: "$nin", Value: prefixes}}}}
+}
+
+// EmitterDenyList is a read-only view of the denied emitters for the services that
+// consume the vaas, e.g. to exclude the spam emitters from the statistics.
+//
+// The denied emitters are loaded from the database and reloaded once they are older than the refresh interval.
+// If they cannot be reloaded, the last loaded ones are kept.
+type EmitterDenyList struct {
+	collection *mongo.Collection
+	refresh    time.Duration
+	logger     *zap.Logger
+
+	mu       sync.Mutex
+	loadedAt time.Time
+	denied   map[string]struct{}
+}
+
+// NewEmitterDenyList creates a new EmitterDenyList.
+func NewEmitterDenyList(db *mongo.Database, refresh time.Duration, logger *zap.Logger) *EmitterDenyList {
+	return &EmitterDenyList{
+		collection: db.Collection(EmitterDenyLists),
+		refresh:    refresh,
+		logger:     logger.With(zap.String("module", "EmitterDenyList")),
+	}
+}
+
+// IsDenied returns true if the emitter is denied.
+func (l *EmitterDenyList) IsDenied(ctx context.Context, chainID sdk.ChainID, address string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if time.Since(l.loadedAt) > l.refresh {
+		l.load(ctx)
+	}
+	_, ok := l.denied[DeniedEmitterID(chainID, address)]
+	return ok
+}
+
+func (l *EmitterDenyList) load(ctx context.Context) {
+	// the load time is updated on failures too, so an unavailable database is not queried for every vaa.
+	l.loadedAt = time.Now()
+
+	cur, err := l.collection.Find(ctx, bson.D{})
+	if err != nil {
+		l.logger.Error("failed to load denied emitters", zap.Error(err))
+		return
+	}
+	var emitters []*DeniedEmitter
+	if err := cur.All(ctx, &emitters); err != nil {
+		l.logger.Error("failed to decode denied emitters", zap.Error(err))
+		return
+	}
+
+	denied := make(map[string]struct{}, len(emitters))
+	for _, e := range emitters {
+		denied[e.ID] = struct{}{}
+	}
+	l.denied = denied
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestDeniedEmitterID(t *testing.T) {
+	id := DeniedEmitterID(sdk.ChainIDEthereum, " 0x0000000000000000000000003EE18B2214AFF97000D974CF647E7C347E8FA585")
+	assert.Equal(t, "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585", id)
+}
+
+func TestMatchNotDenied(t *testing.T) {
+	assert.Nil(t, MatchNotDenied(nil))
+
+	emitters := []*DeniedEmitter{{ID: "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"}}
+	expected := bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: bson.D{{Key: "$nin", Value: bson.A{
+		primitive.Regex{Pattern: "^2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/"},
+	}}}}}}}
+	assert.Equal(t, expected, MatchNotDenied(emitters))
+}
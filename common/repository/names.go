@@ -1,12 +1,19 @@
 package repository
 
 const (
-	VaaIdTxHash      = "vaaIdTxHash"
-	TransferPrices   = "transferPrices"
-	Vaas             = "vaas"
-	DuplicateVaas    = "duplicateVaas"
-	GuardianSets     = "guardianSets"
-	NodeGovernorVaas = "nodeGovernorVaas"
-	GovernorVaas     = "governorVaas"
-	Observations     = "observations"
+	VaaIdTxHash           = "vaaIdTxHash"
+	TransferPrices        = "transferPrices"
+	Vaas                  = "vaas"
+	DuplicateVaas         = "duplicateVaas"
+	GuardianSets          = "guardianSets"
+	NodeGovernorVaas      = "nodeGovernorVaas"
+	GovernorVaas          = "governorVaas"
+	Observations          = "observations"
+	GovernorLimitChanges  = "governorLimitChanges"
+	GuardianParticipation = "guardianParticipation"
+	EmitterDenyLists      = "emitterDenyList"
+	FeeRevenue            = "feeRevenue"
+	Reorgs                = "reorgs"
+	ParsedVaa             = "parsedVaa"
+	GlobalTransactions    = "globalTransactions"
 )
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrNotFound is returned by FindOne when no document matches the filter.
+var ErrNotFound = errors.New("not found")
+
+// DefaultQueryTimeout is the timeout applied to the queries when the context has no deadline.
+const DefaultQueryTimeout = 30 * time.Second
+
+// QueryObserver is called after each query with the collection, the operation,
+// the elapsed time and the error, if any. It can be used to record metrics.
+type QueryObserver func(collection, operation string, elapsed time.Duration, err error)
+
+var (
+	queryTimeout  = DefaultQueryTimeout
+	queryObserver QueryObserver
+)
+
+// SetQueryTimeout sets the timeout applied to the queries when the context has no deadline.
+//
+// It must be called at startup, before any query is executed.
+func SetQueryTimeout(timeout time.Duration) {
+	queryTimeout = timeout
+}
+
+// SetQueryObserver sets the function called after each query.
+//
+// It must be called at startup, before any query is executed.
+func SetQueryObserver(observer QueryObserver) {
+	queryObserver = observer
+}
+
+// Find executes a find command and decodes all the documents into a slice of T.
+// If no documents were found, an empty slice is returned.
+func Find[T any](ctx context.Context, c *mongo.Collection, filter any, opts ...*options.FindOptions) ([]T, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	result, err := find[T](ctx, c, filter, opts...)
+	observe(c, "find", start, err)
+	return result, err
+}
+
+func find[T any](ctx context.Context, c *mongo.Collection, filter any, opts ...*options.FindOptions) ([]T, error) {
+	cur, err := c.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute find command on %s: %w", c.Name(), err)
+	}
+	return decodeAll[T](ctx, c, cur)
+}
+
+// FindOne executes a findOne command and decodes the document into T.
+// If no document was found, ErrNotFound is returned.
+func FindOne[T any](ctx context.Context, c *mongo.Collection, filter any, opts ...*options.FindOneOptions) (*T, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	var doc T
+	err := c.FindOne(ctx, filter, opts...).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		observe(c, "findOne", start, nil)
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to execute findOne command on %s: %w", c.Name(), err)
+	}
+	observe(c, "findOne", start, err)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// Aggregate executes an aggregation pipeline and decodes all the documents into a slice of T.
+// If no documents were found, an empty slice is returned.
+func Aggregate[T any](ctx context.Context, c *mongo.Collection, pipeline any, opts ...*options.AggregateOptions) ([]T, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	start := time.Now()
+	result, err := aggregate[T](ctx, c, pipeline, opts...)
+	observe(c, "aggregate", start, err)
+	return result, err
+}
+
+func aggregate[T any](ctx context.Context, c *mongo.Collection, pipeline any, opts ...*options.AggregateOptions) ([]T, error) {
+	cur, err := c.Aggregate(ctx, pipeline, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute aggregate command on %s: %w", c.Name(), err)
+	}
+	return decodeAll[T](ctx, c, cur)
+}
+
+func decodeAll[T any](ctx context.Context, c *mongo.Collection, cur *mongo.Cursor) ([]T, error) {
+	var result []T
+	if err := cur.All(ctx, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode cursor from %s: %w", c.Name(), err)
+	}
+	if result == nil {
+		result = make([]T, 0)
+	}
+	return result, nil
+}
+
+// withQueryTimeout applies the query timeout if the context has no deadline.
+func withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || queryTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, queryTimeout)
+}
+
+func observe(c *mongo.Collection, operation string, start time.Time, err error) {
+	if queryObserver != nil {
+		queryObserver(c.Name(), operation, time.Since(start), err)
+	}
+}
@@ -20,18 +20,18 @@ type VaaRepository struct {
 
 // VaaDoc is a document for VAA.
 type VaaDoc struct {
-	ID               string     `bson:"_id" json:"id"`
-	Vaa              []byte     `bson:"vaas" json:"vaa"`
-	ChainID          uint16     `bson:"emitterChain"`
-	EmitterAddress   string     `bson:"emitterAddr"`
-	Sequence         string     `bson:"sequence"`
-	GuardianSetIndex uint32     `bson:"guardianSetIndex"`
-	IndexedAt        time.Time  `bson:"indexedAt"`
-	Timestamp        *time.Time `bson:"timestamp"`
-	UpdatedAt        *time.Time `bson:"updatedAt"`
-	TxHash           string     `bson:"txHash"`
-	Version          int        `bson:"version"`
-	Revision         int        `bson:"revision"`
+	ID               string          `bson:"_id" json:"id"`
+	Vaa              CompressedBytes `bson:"vaas" json:"vaa"`
+	ChainID          uint16          `bson:"emitterChain"`
+	EmitterAddress   string          `bson:"emitterAddr"`
+	Sequence         string          `bson:"sequence"`
+	GuardianSetIndex uint32          `bson:"guardianSetIndex"`
+	IndexedAt        time.Time       `bson:"indexedAt"`
+	Timestamp        *time.Time      `bson:"timestamp"`
+	UpdatedAt        *time.Time      `bson:"updatedAt"`
+	TxHash           string          `bson:"txHash"`
+	Version          int             `bson:"version"`
+	Revision         int             `bson:"revision"`
 }
 
 // VaaQuery is a query for VAA.
@@ -130,3 +130,23 @@ func (r *VaaRepository) FindPage(ctx context.Context, query VaaQuery, pagination
 	err = cur.All(ctx, &vaas)
 	return vaas, err
 }
+
+// FindEmitterChains returns the distinct emitter chains of the recorded VAAs.
+func (r *VaaRepository) FindEmitterChains(ctx context.Context) ([]sdk.ChainID, error) {
+	values, err := r.vaas.Distinct(ctx, "emitterChain", bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	chainIDs := make([]sdk.ChainID, 0, len(values))
+	for _, v := range values {
+		switch id := v.(type) {
+		case int32:
+			chainIDs = append(chainIDs, sdk.ChainID(id))
+		case int64:
+			chainIDs = append(chainIDs, sdk.ChainID(id))
+		default:
+			r.logger.Warn("unexpected emitterChain type", zap.Any("value", v))
+		}
+	}
+	return chainIDs, nil
+}
@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// AwsSecretsManager fetches the secrets of AWS Secrets Manager with signed http requests.
+type AwsSecretsManager struct {
+	awsConfig aws.Config
+	endpoint  string
+	client    *http.Client
+	signer    *v4.Signer
+}
+
+// NewAwsSecretsManager creates an AwsSecretsManager. If endpoint is empty the regional endpoint is used.
+func NewAwsSecretsManager(awsConfig aws.Config, endpoint string) *AwsSecretsManager {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", awsConfig.Region)
+	}
+	return &AwsSecretsManager{
+		awsConfig: awsConfig,
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		client:    &http.Client{Timeout: 10 * time.Second},
+		signer:    v4.NewSigner(),
+	}
+}
+
+// GetSecret returns the current value of the secret with id [name].
+func (s *AwsSecretsManager) GetSecret(ctx context.Context, name string) (string, error) {
+	body, err := json.Marshal(map[string]string{"SecretId": name})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	credentials, err := s.awsConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(body)
+	err = s.signer.SignHTTP(ctx, credentials, req, hex.EncodeToString(hash[:]), "secretsmanager", s.awsConfig.Region, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("status %d: %s", res.StatusCode, string(data))
+	}
+
+	var secret struct {
+		SecretString *string `json:"SecretString"`
+	}
+	if err := json.Unmarshal(data, &secret); err != nil {
+		return "", err
+	}
+	if secret.SecretString == nil {
+		return "", fmt.Errorf("secret %s has no string value", name)
+	}
+	return *secret.SecretString, nil
+}
@@ -0,0 +1,192 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// reference schemes of the secrets.
+const (
+	// SchemeAws references a secret of AWS Secrets Manager, e.g. aws-secret://prod/api#mongo_uri.
+	SchemeAws = "aws-secret"
+	// SchemeVault references a secret of Vault, e.g. vault://secret/data/api#mongo_uri.
+	SchemeVault = "vault"
+)
+
+// ErrProviderNotFound is returned when the scheme of a secret reference has no registered provider.
+var ErrProviderNotFound = errors.New("secret provider not found")
+
+// Provider fetches the value of a secret from a secret store.
+type Provider interface {
+	GetSecret(ctx context.Context, name string) (string, error)
+}
+
+// Reference is a reference to a secret in a config value, with the format <scheme>://<name>[#<key>].
+//
+// When the key is present, the secret is a json object and the reference is the value of the key.
+type Reference struct {
+	Scheme string
+	Name   string
+	Key    string
+}
+
+// ParseReference parses a secret reference. It returns false when the value is a plain value.
+func ParseReference(value string) (Reference, bool) {
+	scheme, rest, ok := strings.Cut(value, "://")
+	if !ok || (scheme != SchemeAws && scheme != SchemeVault) || rest == "" {
+		return Reference{}, false
+	}
+	name, key, _ := strings.Cut(rest, "#")
+	return Reference{Scheme: scheme, Name: name, Key: key}, true
+}
+
+// String returns the reference in the format of the config values.
+func (r Reference) String() string {
+	if r.Key == "" {
+		return r.Scheme + "://" + r.Name
+	}
+	return r.Scheme + "://" + r.Name + "#" + r.Key
+}
+
+// Resolver replaces the secret references of the config values with the values fetched from the providers.
+//
+// The resolved references are fetched again periodically, and the rotation callbacks are called
+// when the value of a secret changed.
+type Resolver struct {
+	providers map[string]Provider
+	mu        sync.RWMutex
+	values    map[Reference]string
+	onRotate  []func(ref Reference)
+	logger    *zap.Logger
+}
+
+// NewResolver creates a Resolver without providers, so it only accepts plain values.
+func NewResolver(logger *zap.Logger) *Resolver {
+	return &Resolver{
+		providers: make(map[string]Provider),
+		values:    make(map[Reference]string),
+		logger:    logger,
+	}
+}
+
+// Register sets the provider of the references with [scheme].
+func (r *Resolver) Register(scheme string, provider Provider) {
+	r.providers[scheme] = provider
+}
+
+// OnRotate registers a callback called when the value of a resolved reference changed.
+// The callbacks must be registered before starting the rotation.
+func (r *Resolver) OnRotate(fn func(ref Reference)) {
+	r.onRotate = append(r.onRotate, fn)
+}
+
+// Resolve returns the value of a config value, the plain values are returned as they are.
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	ref, ok := ParseReference(value)
+	if !ok {
+		return value, nil
+	}
+	r.mu.RLock()
+	secret, ok := r.values[ref]
+	r.mu.RUnlock()
+	if ok {
+		return secret, nil
+	}
+
+	secret, err := r.fetch(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	r.values[ref] = secret
+	r.mu.Unlock()
+	return secret, nil
+}
+
+// ResolveAll replaces the config values with their resolved values.
+func (r *Resolver) ResolveAll(ctx context.Context, values ...*string) error {
+	for _, v := range values {
+		secret, err := r.Resolve(ctx, *v)
+		if err != nil {
+			return err
+		}
+		*v = secret
+	}
+	return nil
+}
+
+func (r *Resolver) fetch(ctx context.Context, ref Reference) (string, error) {
+	provider, ok := r.providers[ref.Scheme]
+	if !ok {
+		return "", fmt.Errorf("%w for %s", ErrProviderNotFound, ref)
+	}
+	secret, err := provider.GetSecret(ctx, ref.Name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get secret %s: %w", ref, err)
+	}
+	if ref.Key == "" {
+		return secret, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", fmt.Errorf("secret %s is not a json object: %w", ref, err)
+	}
+	value, ok := fields[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key of secret %s not found", ref)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(value), nil
+}
+
+// Start fetches the resolved references every [interval] until the context is cancelled.
+func (r *Resolver) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.rotate(ctx)
+		}
+	}
+}
+
+func (r *Resolver) rotate(ctx context.Context) {
+	r.mu.RLock()
+	refs := make([]Reference, 0, len(r.values))
+	for ref := range r.values {
+		refs = append(refs, ref)
+	}
+	r.mu.RUnlock()
+
+	for _, ref := range refs {
+		secret, err := r.fetch(ctx, ref)
+		if err != nil {
+			r.logger.Error("failed to fetch secret", zap.String("ref", ref.String()), zap.Error(err))
+			continue
+		}
+		r.mu.Lock()
+		changed := r.values[ref] != secret
+		r.values[ref] = secret
+		r.mu.Unlock()
+		if !changed {
+			continue
+		}
+		r.logger.Info("secret rotated", zap.String("ref", ref.String()))
+		for _, fn := range r.onRotate {
+			fn(ref)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Vault fetches the secrets of the kv secrets engine of Vault.
+type Vault struct {
+	addr   string
+	token  string
+	client *http.Client
+}
+
+// NewVault creates a Vault with the address of the server and the token used to read the secrets.
+func NewVault(addr, token string) *Vault {
+	return &Vault{
+		addr:   strings.TrimSuffix(addr, "/"),
+		token:  token,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// GetSecret returns the data of the secret at path [name], e.g. secret/data/api, as a json object.
+// Both the versions 1 and 2 of the kv secrets engine are supported.
+func (v *Vault) GetSecret(ctx context.Context, name string) (string, error) {
+	url := fmt.Sprintf("%s/v1/%s", v.addr, strings.TrimPrefix(name, "/"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("status %d: %s", res.StatusCode, string(body))
+	}
+
+	var secret struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", err
+	}
+	// the kv version 2 nests the data of the secret with its metadata.
+	data, hasMetadata := secret.Data["data"]
+	if _, ok := secret.Data["metadata"]; ok && hasMetadata {
+		return string(data), nil
+	}
+	b, err := json.Marshal(secret.Data)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -39,7 +39,7 @@ func NewHolderRepository(client *resty.Client, arkhamUrl, arkhamApiKey, solanaUr
 	notionalCache notional.NotionalLocalCacheReadable,
 	log *zap.Logger) *HolderRepository {
 	return &HolderRepository{
-		client:        resty.New(),
+		client:        client,
 		arkhamUrl:     arkhamUrl,
 		artkhamApiKey: arkhamApiKey,
 		solanaUrl:     solanaUrl,
@@ -3,7 +3,6 @@ package stats
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"time"
 
@@ -12,6 +11,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/shopspring/decimal"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.uber.org/zap"
 )
 
@@ -76,7 +76,7 @@ func (r *NTTRepository) getNativeTokenTransferMedian(ctx context.Context, symbol
 	if !result.Next() {
 		r.logger.Error("ntt median transfer size query result has no next",
 			zap.String("symbol", symbol))
-		return decimal.Decimal{}, errors.New("no result")
+		return decimal.Decimal{}, repository.ErrNotFound
 	}
 	row := struct {
 		Value float64 `mapstructure:"_value"`
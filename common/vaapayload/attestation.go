@@ -0,0 +1,75 @@
+package vaapayload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// PayloadAssetMeta is the id of the token bridge payload that attests a token.
+const PayloadAssetMeta uint8 = 2
+
+// ErrNotAttestation is returned when the payload is not a token attestation.
+var ErrNotAttestation = errors.New("not a token attestation")
+
+// AssetMeta is a decoded token attestation.
+type AssetMeta struct {
+	TokenAddress string      `json:"tokenAddress"`
+	TokenChain   sdk.ChainID `json:"tokenChain"`
+	Decimals     uint8       `json:"decimals"`
+	Symbol       string      `json:"symbol"`
+	Name         string      `json:"name"`
+}
+
+// IsAttestation returns true if the payload starts with the AssetMeta payload id.
+func IsAttestation(payload []byte) bool {
+	return len(payload) > 0 && payload[0] == PayloadAssetMeta
+}
+
+// DecodeAttestation decodes the payload of a token bridge attestation vaa.
+//
+// The payload is the payload id (1 byte), the token address (32 bytes), the token chain (2 bytes),
+// the decimals (1 byte), the symbol (32 bytes) and the name (32 bytes). The symbol and the name
+// are right padded with zeros.
+func DecodeAttestation(payload []byte) (*AssetMeta, error) {
+	if !IsAttestation(payload) {
+		return nil, ErrNotAttestation
+	}
+
+	r := bytes.NewReader(payload[1:])
+	var address [32]byte
+	if _, err := io.ReadFull(r, address[:]); err != nil {
+		return nil, fmt.Errorf("failed to read token address: %w", err)
+	}
+	var m AssetMeta
+	m.TokenAddress = hex.EncodeToString(address[:])
+	var tokenChain uint16
+	if err := binary.Read(r, binary.BigEndian, &tokenChain); err != nil {
+		return nil, fmt.Errorf("failed to read token chain: %w", err)
+	}
+	m.TokenChain = sdk.ChainID(tokenChain)
+	if err := binary.Read(r, binary.BigEndian, &m.Decimals); err != nil {
+		return nil, fmt.Errorf("failed to read decimals: %w", err)
+	}
+	var err error
+	if m.Symbol, err = readString(r); err != nil {
+		return nil, fmt.Errorf("failed to read symbol: %w", err)
+	}
+	if m.Name, err = readString(r); err != nil {
+		return nil, fmt.Errorf("failed to read name: %w", err)
+	}
+	return &m, nil
+}
+
+func readString(r io.Reader) (string, error) {
+	var s [32]byte
+	if _, err := io.ReadFull(r, s[:]); err != nil {
+		return "", err
+	}
+	return string(bytes.TrimRight(s[:], "\x00")), nil
+}
@@ -0,0 +1,50 @@
+package vaapayload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func newAssetMetaPayload(address []byte, chain uint16, decimals uint8, symbol, name string) []byte {
+	var payload bytes.Buffer
+	payload.WriteByte(PayloadAssetMeta)
+	payload.Write(address)
+	binary.Write(&payload, binary.BigEndian, chain)
+	payload.WriteByte(decimals)
+	payload.WriteString(symbol)
+	payload.Write(make([]byte, 32-len(symbol)))
+	payload.WriteString(name)
+	payload.Write(make([]byte, 32-len(name)))
+	return payload.Bytes()
+}
+
+func TestDecodeAttestation_AssetMeta(t *testing.T) {
+	address := append(make([]byte, 12), bytes.Repeat([]byte{0xab}, 20)...)
+
+	meta, err := DecodeAttestation(newAssetMetaPayload(address, uint16(sdk.ChainIDEthereum), 18, "WETH", "Wrapped Ether"))
+	assert.NoError(t, err)
+	assert.Equal(t, "000000000000000000000000abababababababababababababababababababab", meta.TokenAddress)
+	assert.Equal(t, sdk.ChainIDEthereum, meta.TokenChain)
+	assert.Equal(t, uint8(18), meta.Decimals)
+	assert.Equal(t, "WETH", meta.Symbol)
+	assert.Equal(t, "Wrapped Ether", meta.Name)
+}
+
+func TestDecodeAttestation_Truncated(t *testing.T) {
+	payload := newAssetMetaPayload(make([]byte, 32), uint16(sdk.ChainIDSolana), 9, "SOL", "Wrapped SOL")
+	_, err := DecodeAttestation(payload[:80])
+	assert.Error(t, err)
+}
+
+func TestDecodeAttestation_NotAttestation(t *testing.T) {
+	_, err := DecodeAttestation([]byte{0x01, 0x02})
+	assert.True(t, errors.Is(err, ErrNotAttestation))
+
+	_, err = DecodeAttestation(nil)
+	assert.True(t, errors.Is(err, ErrNotAttestation))
+}
@@ -0,0 +1,86 @@
+// Package vaapayload decodes the payloads of the vaas emitted by the core contracts, the token bridge and the nft bridge.
+package vaapayload
+
+import (
+	"bytes"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	wormholesdk "github.com/wormhole-foundation/wormhole/sdk"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// payload types.
+const (
+	TypeGovernance          = "governance"
+	TypeTransfer            = "transfer"
+	TypeTransferWithPayload = "transferWithPayload"
+	TypeAttestMeta          = "attestMeta"
+	TypeNFTTransfer         = "nftTransfer"
+	TypeUnknown             = "unknown"
+)
+
+// Payload is the decoded payload of a vaa, only the field of its type is set.
+type Payload struct {
+	Type        string            `json:"type"`
+	Governance  *GovernanceAction `json:"governance,omitempty"`
+	Transfer    *Transfer         `json:"transfer,omitempty"`
+	Attestation *AssetMeta        `json:"attestation,omitempty"`
+	NFTTransfer *NFTTransfer      `json:"nftTransfer,omitempty"`
+}
+
+// Decode decodes the payload of a vaa by the emitter of the vaa in the [p2pNetwork].
+//
+// The payloads of the governance emitter, the token bridges and the nft bridges are decoded,
+// the other payloads are returned with the TypeUnknown type.
+func Decode(p2pNetwork string, vaa *sdk.VAA) (*Payload, error) {
+	switch {
+	case IsGovernanceVaa(vaa):
+		action, err := DecodeGovernance(vaa)
+		if err != nil {
+			return nil, err
+		}
+		return &Payload{Type: TypeGovernance, Governance: action}, nil
+
+	case domain.GetAppIDByEmitter(p2pNetwork, vaa.EmitterChain, vaa.EmitterAddress.String()) == domain.AppIdPortalTokenBridge:
+		if IsAttestation(vaa.Payload) {
+			meta, err := DecodeAttestation(vaa.Payload)
+			if err != nil {
+				return nil, err
+			}
+			return &Payload{Type: TypeAttestMeta, Attestation: meta}, nil
+		}
+		if IsTransfer(vaa.Payload) {
+			transfer, err := DecodeTransfer(vaa.Payload)
+			if err != nil {
+				return nil, err
+			}
+			t := TypeTransfer
+			if transfer.PayloadType == domain.TokenBridgePayloadTransferWithPayload {
+				t = TypeTransferWithPayload
+			}
+			return &Payload{Type: t, Transfer: transfer}, nil
+		}
+
+	case isNFTBridgeEmitter(p2pNetwork, vaa.EmitterChain, vaa.EmitterAddress):
+		transfer, err := DecodeNFTTransfer(vaa.Payload)
+		if err != nil {
+			return nil, err
+		}
+		return &Payload{Type: TypeNFTTransfer, NFTTransfer: transfer}, nil
+	}
+	return &Payload{Type: TypeUnknown}, nil
+}
+
+func isNFTBridgeEmitter(p2pNetwork string, chainID sdk.ChainID, address sdk.Address) bool {
+	var emitters map[sdk.ChainID][]byte
+	switch p2pNetwork {
+	case domain.P2pMainNet:
+		emitters = wormholesdk.KnownNFTBridgeEmitters
+	case domain.P2pTestNet:
+		emitters = wormholesdk.KnownTestnetNFTBridgeEmitters
+	case domain.P2pDevNet:
+		emitters = wormholesdk.KnownDevnetNFTBridgeEmitters
+	}
+	emitter, ok := emitters[chainID]
+	return ok && bytes.Equal(emitter, address[:])
+}
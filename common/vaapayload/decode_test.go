@@ -0,0 +1,134 @@
+package vaapayload
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	wormholesdk "github.com/wormhole-foundation/wormhole/sdk"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func newTransferPayload(payloadType uint8, amount byte, tail ...[]byte) []byte {
+	var payload bytes.Buffer
+	payload.WriteByte(payloadType)
+	payload.Write(append(make([]byte, 31), amount))
+	payload.Write(bytes.Repeat([]byte{0xab}, 32))
+	payload.Write(uint16Bytes(uint16(sdk.ChainIDEthereum)))
+	payload.Write(bytes.Repeat([]byte{0xcd}, 32))
+	payload.Write(uint16Bytes(uint16(sdk.ChainIDSolana)))
+	for _, b := range tail {
+		payload.Write(b)
+	}
+	return payload.Bytes()
+}
+
+func newNFTTransferPayload(uri string) []byte {
+	var payload bytes.Buffer
+	payload.WriteByte(PayloadNFTTransfer)
+	payload.Write(bytes.Repeat([]byte{0xab}, 32))
+	payload.Write(uint16Bytes(uint16(sdk.ChainIDEthereum)))
+	payload.WriteString("APE")
+	payload.Write(make([]byte, 29))
+	payload.WriteString("Apes")
+	payload.Write(make([]byte, 28))
+	payload.Write(append(make([]byte, 31), 7))
+	payload.WriteByte(byte(len(uri)))
+	payload.WriteString(uri)
+	payload.Write(bytes.Repeat([]byte{0xcd}, 32))
+	payload.Write(uint16Bytes(uint16(sdk.ChainIDSolana)))
+	return payload.Bytes()
+}
+
+func newEmitterVaa(emitters map[sdk.ChainID][]byte, chainID sdk.ChainID, payload []byte) *sdk.VAA {
+	var address sdk.Address
+	copy(address[:], emitters[chainID])
+	return &sdk.VAA{EmitterChain: chainID, EmitterAddress: address, Payload: payload}
+}
+
+func TestDecodeTransfer(t *testing.T) {
+	fee := append(make([]byte, 31), 1)
+	transfer, err := DecodeTransfer(newTransferPayload(domain.TokenBridgePayloadTransfer, 100, fee))
+	require.NoError(t, err)
+	assert.Equal(t, "100", transfer.Amount)
+	assert.Equal(t, "abababababababababababababababababababababababababababababababab", transfer.TokenAddress)
+	assert.Equal(t, sdk.ChainIDEthereum, transfer.TokenChain)
+	assert.Equal(t, "cdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcd", transfer.To)
+	assert.Equal(t, sdk.ChainIDSolana, transfer.ToChain)
+	assert.Equal(t, "1", transfer.Fee)
+	assert.Empty(t, transfer.Payload)
+
+	from := bytes.Repeat([]byte{0xef}, 32)
+	transfer, err = DecodeTransfer(newTransferPayload(domain.TokenBridgePayloadTransferWithPayload, 5, from, []byte{0x01, 0x02}))
+	require.NoError(t, err)
+	assert.Equal(t, "5", transfer.Amount)
+	assert.Equal(t, "efefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefef", transfer.FromAddress)
+	assert.Equal(t, "0102", transfer.Payload)
+	assert.Empty(t, transfer.Fee)
+
+	_, err = DecodeTransfer(newTransferPayload(domain.TokenBridgePayloadTransfer, 100))
+	assert.Error(t, err)
+	_, err = DecodeTransfer([]byte{domain.TokenBridgePayloadAttestMeta})
+	assert.Equal(t, ErrNotTransfer, err)
+}
+
+func TestDecodeNFTTransfer(t *testing.T) {
+	transfer, err := DecodeNFTTransfer(newNFTTransferPayload("ipfs://ape/7"))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.ChainIDEthereum, transfer.TokenChain)
+	assert.Equal(t, "APE", transfer.Symbol)
+	assert.Equal(t, "Apes", transfer.Name)
+	assert.Equal(t, "7", transfer.TokenID)
+	assert.Equal(t, "ipfs://ape/7", transfer.URI)
+	assert.Equal(t, sdk.ChainIDSolana, transfer.ToChain)
+
+	payload := newNFTTransferPayload("ipfs://ape/7")
+	_, err = DecodeNFTTransfer(payload[:len(payload)-10])
+	assert.Error(t, err)
+	_, err = DecodeNFTTransfer(nil)
+	assert.Equal(t, ErrNotNFTTransfer, err)
+}
+
+func TestDecode(t *testing.T) {
+	fee := make([]byte, 32)
+
+	payload, err := Decode(domain.P2pMainNet, newEmitterVaa(wormholesdk.KnownTokenbridgeEmitters, sdk.ChainIDEthereum,
+		newTransferPayload(domain.TokenBridgePayloadTransfer, 100, fee)))
+	require.NoError(t, err)
+	assert.Equal(t, TypeTransfer, payload.Type)
+	assert.Equal(t, "100", payload.Transfer.Amount)
+
+	payload, err = Decode(domain.P2pMainNet, newEmitterVaa(wormholesdk.KnownTokenbridgeEmitters, sdk.ChainIDEthereum,
+		newTransferPayload(domain.TokenBridgePayloadTransferWithPayload, 100, make([]byte, 32))))
+	require.NoError(t, err)
+	assert.Equal(t, TypeTransferWithPayload, payload.Type)
+
+	payload, err = Decode(domain.P2pMainNet, newEmitterVaa(wormholesdk.KnownTokenbridgeEmitters, sdk.ChainIDEthereum,
+		newAssetMetaPayload(make([]byte, 32), uint16(sdk.ChainIDEthereum), 18, "WETH", "Wrapped Ether")))
+	require.NoError(t, err)
+	assert.Equal(t, TypeAttestMeta, payload.Type)
+	assert.Equal(t, "WETH", payload.Attestation.Symbol)
+
+	payload, err = Decode(domain.P2pMainNet, newEmitterVaa(wormholesdk.KnownNFTBridgeEmitters, sdk.ChainIDEthereum,
+		newNFTTransferPayload("ipfs://ape/7")))
+	require.NoError(t, err)
+	assert.Equal(t, TypeNFTTransfer, payload.Type)
+	assert.Equal(t, "7", payload.NFTTransfer.TokenID)
+
+	payload, err = Decode(domain.P2pMainNet, newGovernanceVaa(ModuleCore, 3, 0, make([]byte, 32)))
+	require.NoError(t, err)
+	assert.Equal(t, TypeGovernance, payload.Type)
+	assert.Equal(t, ActionSetMessageFee, payload.Governance.Action)
+
+	// the token bridge of mainnet is not a token bridge of testnet.
+	payload, err = Decode(domain.P2pTestNet, newEmitterVaa(wormholesdk.KnownTokenbridgeEmitters, sdk.ChainIDEthereum,
+		newTransferPayload(domain.TokenBridgePayloadTransfer, 100, fee)))
+	require.NoError(t, err)
+	assert.Equal(t, TypeUnknown, payload.Type)
+
+	_, err = Decode(domain.P2pMainNet, newEmitterVaa(wormholesdk.KnownTokenbridgeEmitters, sdk.ChainIDEthereum,
+		newTransferPayload(domain.TokenBridgePayloadTransfer, 100)))
+	assert.Error(t, err)
+}
@@ -0,0 +1,225 @@
+package vaapayload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// governance modules.
+const (
+	ModuleCore            = "Core"
+	ModuleTokenBridge     = "TokenBridge"
+	ModuleNFTBridge       = "NFTBridge"
+	ModuleWormholeRelayer = "WormholeRelayer"
+)
+
+// governance actions.
+const (
+	ActionContractUpgrade               = "ContractUpgrade"
+	ActionGuardianSetUpgrade            = "GuardianSetUpgrade"
+	ActionSetMessageFee                 = "SetMessageFee"
+	ActionTransferFees                  = "TransferFees"
+	ActionRecoverChainID                = "RecoverChainId"
+	ActionRegisterChain                 = "RegisterChain"
+	ActionUpdateDefaultDeliveryProvider = "UpdateDefaultDeliveryProvider"
+	ActionUnknown                       = "Unknown"
+)
+
+// actionsByModule maps the action ids of each module to the action names.
+var actionsByModule = map[string]map[uint8]string{
+	ModuleCore: {
+		1: ActionContractUpgrade,
+		2: ActionGuardianSetUpgrade,
+		3: ActionSetMessageFee,
+		4: ActionTransferFees,
+		5: ActionRecoverChainID,
+	},
+	ModuleTokenBridge: {
+		1: ActionRegisterChain,
+		2: ActionContractUpgrade,
+		3: ActionRecoverChainID,
+	},
+	ModuleNFTBridge: {
+		1: ActionRegisterChain,
+		2: ActionContractUpgrade,
+		3: ActionRecoverChainID,
+	},
+	ModuleWormholeRelayer: {
+		1: ActionRegisterChain,
+		2: ActionContractUpgrade,
+		3: ActionUpdateDefaultDeliveryProvider,
+	},
+}
+
+// ErrNotGovernanceVaa is returned when the vaa is not emitted by the governance emitter.
+var ErrNotGovernanceVaa = errors.New("not a governance vaa")
+
+// GovernanceAction is a decoded governance action.
+type GovernanceAction struct {
+	Module      string      `json:"module"`
+	ActionID    uint8       `json:"actionId"`
+	Action      string      `json:"action"`
+	TargetChain sdk.ChainID `json:"targetChain"`
+	// NewContract is set for contract upgrades.
+	NewContract string `json:"newContract,omitempty"`
+	// GuardianSet is set for guardian set upgrades.
+	GuardianSet *GuardianSetUpgrade `json:"guardianSet,omitempty"`
+	// Registration is set for chain registrations.
+	Registration *ChainRegistration `json:"registration,omitempty"`
+	// Fee is the message fee or the amount of transferred fees.
+	Fee string `json:"fee,omitempty"`
+	// Recipient is the recipient of the transferred fees.
+	Recipient string `json:"recipient,omitempty"`
+	// RecoverChainID is set for chain id recoveries.
+	RecoverChainID *RecoverChainID `json:"recoverChainId,omitempty"`
+	// DeliveryProvider is the new default delivery provider of the relayer.
+	DeliveryProvider string `json:"deliveryProvider,omitempty"`
+}
+
+// GuardianSetUpgrade is the new guardian set of a guardian set upgrade.
+type GuardianSetUpgrade struct {
+	Index uint32   `bson:"index" json:"index"`
+	Keys  []string `bson:"keys" json:"keys"`
+}
+
+// ChainRegistration is the emitter registered for a chain.
+type ChainRegistration struct {
+	EmitterChain   sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddress string      `bson:"emitterAddress" json:"emitterAddress"`
+}
+
+// RecoverChainID is the chain id set on a contract after a chain fork.
+type RecoverChainID struct {
+	EvmChainID string      `bson:"evmChainId" json:"evmChainId"`
+	NewChainID sdk.ChainID `bson:"newChainId" json:"newChainId"`
+}
+
+// IsGovernanceVaa returns true if the vaa is emitted by the governance emitter.
+func IsGovernanceVaa(vaa *sdk.VAA) bool {
+	return vaa.EmitterChain == sdk.GovernanceChain && vaa.EmitterAddress == sdk.GovernanceEmitter
+}
+
+// DecodeGovernance decodes the payload of a governance vaa.
+//
+// The payload starts with the module (32 bytes, left padded with zeros), the action id (1 byte)
+// and the target chain (2 bytes, zero means all chains), followed by the body of the action.
+// The actions of unknown modules are decoded with the ActionUnknown name and without body.
+func DecodeGovernance(vaa *sdk.VAA) (*GovernanceAction, error) {
+	if !IsGovernanceVaa(vaa) {
+		return nil, ErrNotGovernanceVaa
+	}
+
+	r := bytes.NewReader(vaa.Payload)
+	var module [32]byte
+	if _, err := io.ReadFull(r, module[:]); err != nil {
+		return nil, fmt.Errorf("failed to read module: %w", err)
+	}
+	var a GovernanceAction
+	a.Module = string(bytes.TrimLeft(module[:], "\x00"))
+	if err := binary.Read(r, binary.BigEndian, &a.ActionID); err != nil {
+		return nil, fmt.Errorf("failed to read action: %w", err)
+	}
+	var targetChain uint16
+	if err := binary.Read(r, binary.BigEndian, &targetChain); err != nil {
+		return nil, fmt.Errorf("failed to read target chain: %w", err)
+	}
+	a.TargetChain = sdk.ChainID(targetChain)
+
+	name, ok := actionsByModule[a.Module][a.ActionID]
+	if !ok {
+		a.Action = ActionUnknown
+		return &a, nil
+	}
+	a.Action = name
+
+	var err error
+	switch a.Action {
+	case ActionContractUpgrade:
+		a.NewContract, err = readAddress(r)
+	case ActionGuardianSetUpgrade:
+		a.GuardianSet, err = readGuardianSet(r)
+	case ActionSetMessageFee:
+		a.Fee, err = readUint256(r)
+	case ActionTransferFees:
+		if a.Fee, err = readUint256(r); err == nil {
+			a.Recipient, err = readAddress(r)
+		}
+	case ActionRecoverChainID:
+		a.RecoverChainID, err = readRecoverChainID(r)
+	case ActionRegisterChain:
+		a.Registration, err = readRegistration(r)
+	case ActionUpdateDefaultDeliveryProvider:
+		a.DeliveryProvider, err = readAddress(r)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s %s: %w", a.Module, a.Action, err)
+	}
+	return &a, nil
+}
+
+func readAddress(r io.Reader) (string, error) {
+	var address [32]byte
+	if _, err := io.ReadFull(r, address[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(address[:]), nil
+}
+
+func readUint256(r io.Reader) (string, error) {
+	var value [32]byte
+	if _, err := io.ReadFull(r, value[:]); err != nil {
+		return "", err
+	}
+	return new(big.Int).SetBytes(value[:]).String(), nil
+}
+
+func readGuardianSet(r io.Reader) (*GuardianSetUpgrade, error) {
+	var gs GuardianSetUpgrade
+	if err := binary.Read(r, binary.BigEndian, &gs.Index); err != nil {
+		return nil, err
+	}
+	var size uint8
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+	gs.Keys = make([]string, 0, size)
+	for i := 0; i < int(size); i++ {
+		var key [20]byte
+		if _, err := io.ReadFull(r, key[:]); err != nil {
+			return nil, err
+		}
+		gs.Keys = append(gs.Keys, "0x"+hex.EncodeToString(key[:]))
+	}
+	return &gs, nil
+}
+
+func readRegistration(r io.Reader) (*ChainRegistration, error) {
+	var chainID uint16
+	if err := binary.Read(r, binary.BigEndian, &chainID); err != nil {
+		return nil, err
+	}
+	address, err := readAddress(r)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainRegistration{EmitterChain: sdk.ChainID(chainID), EmitterAddress: address}, nil
+}
+
+func readRecoverChainID(r io.Reader) (*RecoverChainID, error) {
+	evmChainID, err := readUint256(r)
+	if err != nil {
+		return nil, err
+	}
+	var newChainID uint16
+	if err := binary.Read(r, binary.BigEndian, &newChainID); err != nil {
+		return nil, err
+	}
+	return &RecoverChainID{EvmChainID: evmChainID, NewChainID: sdk.ChainID(newChainID)}, nil
+}
@@ -0,0 +1,98 @@
+package vaapayload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/test-go/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func newGovernanceVaa(module string, action uint8, chain uint16, body ...[]byte) *sdk.VAA {
+	var payload bytes.Buffer
+	payload.Write(make([]byte, 32-len(module)))
+	payload.WriteString(module)
+	payload.WriteByte(action)
+	binary.Write(&payload, binary.BigEndian, chain)
+	for _, b := range body {
+		payload.Write(b)
+	}
+	return &sdk.VAA{
+		EmitterChain:   sdk.GovernanceChain,
+		EmitterAddress: sdk.GovernanceEmitter,
+		Payload:        payload.Bytes(),
+	}
+}
+
+func uint16Bytes(v uint16) []byte {
+	return binary.BigEndian.AppendUint16(nil, v)
+}
+
+func TestDecodeGovernance_GuardianSetUpgrade(t *testing.T) {
+	body := binary.BigEndian.AppendUint32(nil, 4)
+	body = append(body, 2)
+	body = append(body, bytes.Repeat([]byte{0x11}, 20)...)
+	body = append(body, bytes.Repeat([]byte{0x22}, 20)...)
+
+	action, err := DecodeGovernance(newGovernanceVaa(ModuleCore, 2, 0, body))
+	assert.NoError(t, err)
+	assert.Equal(t, ModuleCore, action.Module)
+	assert.Equal(t, ActionGuardianSetUpgrade, action.Action)
+	assert.Equal(t, sdk.ChainIDUnset, action.TargetChain)
+	assert.Equal(t, uint32(4), action.GuardianSet.Index)
+	assert.Equal(t, []string{
+		"0x1111111111111111111111111111111111111111",
+		"0x2222222222222222222222222222222222222222",
+	}, action.GuardianSet.Keys)
+}
+
+func TestDecodeGovernance_RegisterChain(t *testing.T) {
+	address := append(make([]byte, 12), bytes.Repeat([]byte{0xab}, 20)...)
+
+	action, err := DecodeGovernance(newGovernanceVaa(ModuleTokenBridge, 1, 0, uint16Bytes(uint16(sdk.ChainIDEthereum)), address))
+	assert.NoError(t, err)
+	assert.Equal(t, ActionRegisterChain, action.Action)
+	assert.Equal(t, sdk.ChainIDEthereum, action.Registration.EmitterChain)
+	assert.Equal(t, "000000000000000000000000abababababababababababababababababababab", action.Registration.EmitterAddress)
+}
+
+func TestDecodeGovernance_ContractUpgrade(t *testing.T) {
+	contract := bytes.Repeat([]byte{0x01}, 32)
+
+	action, err := DecodeGovernance(newGovernanceVaa(ModuleNFTBridge, 2, uint16(sdk.ChainIDSolana), contract))
+	assert.NoError(t, err)
+	assert.Equal(t, ActionContractUpgrade, action.Action)
+	assert.Equal(t, sdk.ChainIDSolana, action.TargetChain)
+	assert.Equal(t, "0101010101010101010101010101010101010101010101010101010101010101", action.NewContract)
+}
+
+func TestDecodeGovernance_SetMessageFee(t *testing.T) {
+	fee := make([]byte, 32)
+	fee[31] = 100
+
+	action, err := DecodeGovernance(newGovernanceVaa(ModuleCore, 3, uint16(sdk.ChainIDEthereum), fee))
+	assert.NoError(t, err)
+	assert.Equal(t, ActionSetMessageFee, action.Action)
+	assert.Equal(t, "100", action.Fee)
+}
+
+func TestDecodeGovernance_UnknownModule(t *testing.T) {
+	action, err := DecodeGovernance(newGovernanceVaa("GeneralPurposeGovernance", 1, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, "GeneralPurposeGovernance", action.Module)
+	assert.Equal(t, ActionUnknown, action.Action)
+}
+
+func TestDecodeGovernance_TruncatedBody(t *testing.T) {
+	_, err := DecodeGovernance(newGovernanceVaa(ModuleTokenBridge, 1, 0, uint16Bytes(2)))
+	assert.Error(t, err)
+}
+
+func TestDecodeGovernance_NotGovernance(t *testing.T) {
+	vaa := newGovernanceVaa(ModuleCore, 2, 0)
+	vaa.EmitterChain = sdk.ChainIDEthereum
+	_, err := DecodeGovernance(vaa)
+	assert.True(t, errors.Is(err, ErrNotGovernanceVaa))
+}
@@ -0,0 +1,75 @@
+package vaapayload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// PayloadNFTTransfer is the id of the nft bridge payload that transfers a token.
+const PayloadNFTTransfer uint8 = 1
+
+// ErrNotNFTTransfer is returned when the payload is not a nft bridge transfer.
+var ErrNotNFTTransfer = errors.New("not a nft transfer")
+
+// NFTTransfer is a decoded nft bridge transfer.
+type NFTTransfer struct {
+	TokenAddress string      `json:"tokenAddress"`
+	TokenChain   sdk.ChainID `json:"tokenChain"`
+	Symbol       string      `json:"symbol"`
+	Name         string      `json:"name"`
+	TokenID      string      `json:"tokenId"`
+	URI          string      `json:"uri"`
+	To           string      `json:"to"`
+	ToChain      sdk.ChainID `json:"toChain"`
+}
+
+// DecodeNFTTransfer decodes the payload of a nft bridge transfer vaa.
+//
+// The payload is the payload id (1 byte), the token address (32 bytes), the token chain (2 bytes), the symbol
+// (32 bytes), the name (32 bytes), the token id (32 bytes), the uri length (1 byte), the uri, the recipient
+// (32 bytes) and the recipient chain (2 bytes).
+func DecodeNFTTransfer(payload []byte) (*NFTTransfer, error) {
+	if len(payload) == 0 || payload[0] != PayloadNFTTransfer {
+		return nil, ErrNotNFTTransfer
+	}
+
+	var t NFTTransfer
+	r := bytes.NewReader(payload[1:])
+	var err error
+	if t.TokenAddress, err = readAddress(r); err != nil {
+		return nil, fmt.Errorf("failed to read token address: %w", err)
+	}
+	if t.TokenChain, err = readChainID(r); err != nil {
+		return nil, fmt.Errorf("failed to read token chain: %w", err)
+	}
+	if t.Symbol, err = readString(r); err != nil {
+		return nil, fmt.Errorf("failed to read symbol: %w", err)
+	}
+	if t.Name, err = readString(r); err != nil {
+		return nil, fmt.Errorf("failed to read name: %w", err)
+	}
+	if t.TokenID, err = readUint256(r); err != nil {
+		return nil, fmt.Errorf("failed to read token id: %w", err)
+	}
+	var uriLength uint8
+	if err := binary.Read(r, binary.BigEndian, &uriLength); err != nil {
+		return nil, fmt.Errorf("failed to read uri length: %w", err)
+	}
+	uri := make([]byte, uriLength)
+	if _, err := io.ReadFull(r, uri); err != nil {
+		return nil, fmt.Errorf("failed to read uri: %w", err)
+	}
+	t.URI = string(uri)
+	if t.To, err = readAddress(r); err != nil {
+		return nil, fmt.Errorf("failed to read recipient: %w", err)
+	}
+	if t.ToChain, err = readChainID(r); err != nil {
+		return nil, fmt.Errorf("failed to read recipient chain: %w", err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,92 @@
+package vaapayload
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// ErrNotTransfer is returned when the payload is not a token bridge transfer.
+var ErrNotTransfer = errors.New("not a token bridge transfer")
+
+// Transfer is a decoded token bridge transfer, with or without payload.
+type Transfer struct {
+	PayloadType  uint8       `json:"payloadType"`
+	Amount       string      `json:"amount"`
+	TokenAddress string      `json:"tokenAddress"`
+	TokenChain   sdk.ChainID `json:"tokenChain"`
+	To           string      `json:"to"`
+	ToChain      sdk.ChainID `json:"toChain"`
+	// Fee is the relayer fee of the transfers without payload.
+	Fee string `json:"fee,omitempty"`
+	// FromAddress is the sender of the transfers with payload.
+	FromAddress string `json:"fromAddress,omitempty"`
+	// Payload is the hex encoded payload of the transfers with payload.
+	Payload string `json:"payload,omitempty"`
+}
+
+// IsTransfer returns true if the payload starts with the id of a transfer, with or without payload.
+func IsTransfer(payload []byte) bool {
+	return len(payload) > 0 &&
+		(payload[0] == domain.TokenBridgePayloadTransfer || payload[0] == domain.TokenBridgePayloadTransferWithPayload)
+}
+
+// DecodeTransfer decodes the payload of a token bridge transfer vaa.
+//
+// The payload is the payload id (1 byte), the amount (32 bytes), the token address (32 bytes), the token chain
+// (2 bytes), the recipient (32 bytes) and the recipient chain (2 bytes), followed by the fee (32 bytes) for the
+// transfers, or by the sender (32 bytes) and the arbitrary payload for the transfers with payload.
+func DecodeTransfer(payload []byte) (*Transfer, error) {
+	if !IsTransfer(payload) {
+		return nil, ErrNotTransfer
+	}
+
+	t := Transfer{PayloadType: payload[0]}
+	r := bytes.NewReader(payload[1:])
+	var err error
+	if t.Amount, err = readUint256(r); err != nil {
+		return nil, fmt.Errorf("failed to read amount: %w", err)
+	}
+	if t.TokenAddress, err = readAddress(r); err != nil {
+		return nil, fmt.Errorf("failed to read token address: %w", err)
+	}
+	if t.TokenChain, err = readChainID(r); err != nil {
+		return nil, fmt.Errorf("failed to read token chain: %w", err)
+	}
+	if t.To, err = readAddress(r); err != nil {
+		return nil, fmt.Errorf("failed to read recipient: %w", err)
+	}
+	if t.ToChain, err = readChainID(r); err != nil {
+		return nil, fmt.Errorf("failed to read recipient chain: %w", err)
+	}
+
+	if t.PayloadType == domain.TokenBridgePayloadTransfer {
+		if t.Fee, err = readUint256(r); err != nil {
+			return nil, fmt.Errorf("failed to read fee: %w", err)
+		}
+		return &t, nil
+	}
+	if t.FromAddress, err = readAddress(r); err != nil {
+		return nil, fmt.Errorf("failed to read sender: %w", err)
+	}
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read payload: %w", err)
+	}
+	t.Payload = hex.EncodeToString(rest)
+	return &t, nil
+}
+
+func readChainID(r io.Reader) (sdk.ChainID, error) {
+	var chainID uint16
+	if err := binary.Read(r, binary.BigEndian, &chainID); err != nil {
+		return 0, err
+	}
+	return sdk.ChainID(chainID), nil
+}
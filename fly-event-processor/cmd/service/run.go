@@ -13,8 +13,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/wormhole-foundation/wormhole-explorer/common/audit"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
@@ -76,24 +78,31 @@ func Run() {
 
 	// create a new processor
 	dupVaaProcessor := vaaprocessor.NewProcessor(guardianApiProviderPool, repository, logger, metrics)
-	governorProcessor := governorProcessor.NewProcessor(repository, createTxHashFunc, logger, metrics)
+	notifier := newGovernorNotifier(cfg, logger)
+	governorProcessor := governorProcessor.NewProcessor(repository, createTxHashFunc, notifier.notifyFunc(), logger, metrics)
+
+	// create the backoffs applied when getting messages from the sqs queues fails
+	duplicateVaaBackoff := newSqsBackoff(cfg.DuplicateVaaSQSUrl, metrics)
+	governorStatusBackoff := newSqsBackoff(cfg.GovernorSQSUrl, metrics)
 
 	// start serving /health and /ready endpoints
 	healthChecks, err := makeHealthChecks(rootCtx, cfg, db.Database)
 	if err != nil {
 		logger.Fatal("Failed to create health checks", zap.Error(err))
 	}
+	healthChecks = append(healthChecks, duplicateVaaBackoff.HealthCheck(), governorStatusBackoff.HealthCheck())
 	vaaCtrl := vaa.NewController(dupVaaProcessor.Process, repository, logger)
-	server := infrastructure.NewServer(logger, cfg.Port, vaaCtrl, cfg.PprofEnabled, healthChecks...)
+	auditor := audit.NewAuditor(audit.NewRepository(db.Database), "wormscan-fly-event-processor", logger)
+	server := infrastructure.NewServer(logger, cfg.Port, vaaCtrl, cfg.PprofEnabled, auditor, cfg.AdminApiKey, healthChecks...)
 	server.Start()
 
 	// create and start a duplicate VAA consumer.
-	duplicateVaaConsumeFunc := newDuplicateVaaConsumeFunc(rootCtx, cfg, metrics, logger)
+	duplicateVaaConsumeFunc := newDuplicateVaaConsumeFunc(rootCtx, cfg, metrics, duplicateVaaBackoff, logger)
 	duplicateVaa := vaaConsumer.New(duplicateVaaConsumeFunc, dupVaaProcessor.Process, logger, metrics, cfg.P2pNetwork, cfg.ConsumerWorkerSize)
 	duplicateVaa.Start(rootCtx)
 
 	// create and start a governor status consumer.
-	governorStatusConsumerFunc := newGovernorStatusConsumeFunc(rootCtx, cfg, metrics, logger)
+	governorStatusConsumerFunc := newGovernorStatusConsumeFunc(rootCtx, cfg, metrics, governorStatusBackoff, logger)
 	governorStatus := governorConsumer.New(governorStatusConsumerFunc, governorProcessor.Process, logger, metrics, cfg.P2pNetwork, cfg.GovernorConsumerWorkerSize)
 	governorStatus.Start(rootCtx)
 
@@ -119,6 +128,8 @@ func Run() {
 	logger.Info("Closing MongoDB connection...")
 	db.DisconnectWithTimeout(10 * time.Second)
 
+	notifier.close()
+
 	logger.Info("Terminated wormholescan-fly-event-processor")
 
 }
@@ -154,6 +165,12 @@ func newAwsConfig(ctx context.Context, cfg *config.ServiceConfiguration) (aws.Co
 	return awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
 }
 
+func newSqsBackoff(sqsUrl string, metrics metrics.Metrics) *sqs.Backoff {
+	return sqs.NewBackoff(sqs.WithFailureObserver(func(failures int) {
+		metrics.SetSqsConsecutiveFailures(sqsUrl, failures)
+	}))
+}
+
 func newSqsConsumer(ctx context.Context, cfg *config.ServiceConfiguration, sqsUrl string) (*sqs.Consumer, error) {
 
 	awsconfig, err := newAwsConfig(ctx, cfg)
@@ -221,6 +238,7 @@ func newDuplicateVaaConsumeFunc(
 	ctx context.Context,
 	cfg *config.ServiceConfiguration,
 	metrics metrics.Metrics,
+	backoff *sqs.Backoff,
 	logger *zap.Logger,
 ) queue.ConsumeFunc[queue.EventDuplicateVaa] {
 
@@ -230,7 +248,7 @@ func newDuplicateVaaConsumeFunc(
 	}
 
 	vaaQueue := queue.NewEventSqs[queue.EventDuplicateVaa](sqsConsumer,
-		metrics.IncDuplicatedVaaConsumedQueue, logger)
+		metrics.IncDuplicatedVaaConsumedQueue, logger, queue.WithBackoff[queue.EventDuplicateVaa](backoff))
 	return vaaQueue.Consume
 }
 
@@ -238,6 +256,7 @@ func newGovernorStatusConsumeFunc(
 	ctx context.Context,
 	cfg *config.ServiceConfiguration,
 	metrics metrics.Metrics,
+	backoff *sqs.Backoff,
 	logger *zap.Logger,
 ) queue.ConsumeFunc[queue.EventGovernorStatus] {
 
@@ -247,7 +266,7 @@ func newGovernorStatusConsumeFunc(
 	}
 
 	governorStatusQueue := queue.NewEventSqs[queue.EventGovernorStatus](sqsConsumer,
-		metrics.IncGovernorStatusConsumedQueue, logger)
+		metrics.IncGovernorStatusConsumedQueue, logger, queue.WithBackoff[queue.EventGovernorStatus](backoff))
 	return governorStatusQueue.Consume
 }
 
@@ -262,9 +281,44 @@ func newCreateTxHashFunc(
 			}, nil
 		}, nil
 	}
-	createTxHashClient, err := txTracker.NewTxTrackerAPIClient(cfg.TxTrackerTimeout, cfg.TxTrackerUrl, logger)
+	createTxHashClient, err := txTracker.NewTxTrackerAPIClient(cfg.TxTrackerTimeout, cfg.TxTrackerUrl, cfg.TxTrackerApiKey, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize TxTracker client: %w", err)
 	}
 	return createTxHashClient.CreateTxHash, nil
 }
+
+// governorNotifier publishes the governor notification events in redis, if it is configured.
+type governorNotifier struct {
+	publisher *events.RedisPublisher
+	logger    *zap.Logger
+}
+
+func newGovernorNotifier(cfg *config.ServiceConfiguration, logger *zap.Logger) *governorNotifier {
+	if cfg.RedisURI == "" {
+		logger.Info("governor notifications are disabled")
+		return &governorNotifier{logger: logger}
+	}
+	channel := fmt.Sprintf("%s:%s", cfg.RedisPrefix, cfg.RedisVaaChannel)
+	return &governorNotifier{
+		publisher: events.NewRedisPublisher(cfg.RedisURI, channel),
+		logger:    logger,
+	}
+}
+
+func (n *governorNotifier) notifyFunc() governorProcessor.NotifyFunc {
+	if n.publisher == nil {
+		return nil
+	}
+	return n.publisher.Publish
+}
+
+func (n *governorNotifier) close() {
+	if n.publisher == nil {
+		return
+	}
+	n.logger.Info("Closing redis connection...")
+	if err := n.publisher.Close(); err != nil {
+		n.logger.Error("failed to close redis connection", zap.Error(err))
+	}
+}
@@ -24,10 +24,12 @@ const (
 // ServiceConfiguration represents the application configuration when running as service with default values.
 type ServiceConfiguration struct {
 	// Global configuration
-	Environment    string `env:"ENVIRONMENT,required"`
-	LogLevel       string `env:"LOG_LEVEL,default=INFO"`
-	Port           string `env:"PORT,default=8000"`
-	PprofEnabled   bool   `env:"PPROF_ENABLED,default=false"`
+	Environment  string `env:"ENVIRONMENT,required"`
+	LogLevel     string `env:"LOG_LEVEL,default=INFO"`
+	Port         string `env:"PORT,default=8000"`
+	PprofEnabled bool   `env:"PPROF_ENABLED,default=false"`
+	// AdminApiKey is required in the X-Api-Key header of the vaa endpoints, an empty key rejects all the requests.
+	AdminApiKey    string `env:"ADMIN_API_KEY"`
 	P2pNetwork     string `env:"P2P_NETWORK,required"`
 	AlertEnabled   bool   `env:"ALERT_ENABLED,default=false"`
 	AlertApiKey    string `env:"ALERT_API_KEY"`
@@ -49,6 +51,13 @@ type ServiceConfiguration struct {
 	// Tx-tracker client configuration
 	TxTrackerUrl     string `env:"TX_TRACKER_URL,required"`
 	TxTrackerTimeout int64  `env:"TX_TRACKER_TIMEOUT,default=10"`
+	// TxTrackerApiKey is the admin api key of the tx-tracker.
+	TxTrackerApiKey string `env:"TX_TRACKER_API_KEY"`
+	// Redis configuration of the governor notifications, published in the channel of the signed vaas streamed by the spy.
+	// The notifications are not published if REDIS_URI is empty.
+	RedisURI        string `env:"REDIS_URI"`
+	RedisPrefix     string `env:"REDIS_PREFIX"`
+	RedisVaaChannel string `env:"REDIS_VAA_CHANNEL"`
 
 	// Guardian api provider configuration
 	GuardianAPIProviderPath       string `env:"GUARDIAN_API_PROVIDER_PATH,required"`
@@ -4,6 +4,8 @@ import (
 	"github.com/ansrivas/fiberprometheus/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/pprof"
+	"github.com/wormhole-foundation/wormhole-explorer/common/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/common/auth"
 	health "github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/http/vaa"
 	"go.uber.org/zap"
@@ -15,7 +17,7 @@ type Server struct {
 	logger *zap.Logger
 }
 
-func NewServer(logger *zap.Logger, port string, vaaController *vaa.Controller, pprofEnabled bool, checks ...health.Check) *Server {
+func NewServer(logger *zap.Logger, port string, vaaController *vaa.Controller, pprofEnabled bool, auditor *audit.Auditor, adminApiKey string, checks ...health.Check) *Server {
 	app := fiber.New(fiber.Config{DisableStartupMessage: true})
 	prometheus := fiberprometheus.New("wormscan-fly-event-processor")
 	prometheus.RegisterAt(app, "/metrics")
@@ -30,7 +32,8 @@ func NewServer(logger *zap.Logger, port string, vaaController *vaa.Controller, p
 	api := app.Group("/api")
 	api.Get("/health", ctrl.HealthCheck)
 	api.Get("/ready", ctrl.ReadyCheck)
-	api.Post("/vaa/duplicated", vaaController.Process)
+	// the requests are authenticated before they are audited, so the rejected requests are not recorded.
+	api.Post("/vaa/duplicated", auth.AdminApiKey(adminApiKey), auditor.Middleware("vaa.duplicated"), vaaController.Process)
 	return &Server{
 		app:    app,
 		port:   port,
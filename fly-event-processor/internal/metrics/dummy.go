@@ -42,3 +42,6 @@ func (d *DummyMetrics) IncGovernorVaaAdded(chainID sdk.ChainID) {}
 
 // IndGovenorVaaDeleted dummy implementation.
 func (d *DummyMetrics) IndGovenorVaaDeleted(chainID sdk.ChainID) {}
+
+// SetSqsConsecutiveFailures dummy implementation.
+func (d *DummyMetrics) SetSqsConsecutiveFailures(queue string, failures int) {}
@@ -16,6 +16,7 @@ type Metrics interface {
 	IncGovernorStatusExpired(node string, address string)
 	IncGovernorVaaAdded(chainID sdk.ChainID)
 	IndGovenorVaaDeleted(chainID sdk.ChainID)
+	SetSqsConsecutiveFailures(queue string, failures int)
 }
 
 // IncDuplicatedVaaConsumedQueue increments the counter of consumed queue
@@ -8,9 +8,10 @@ import (
 
 // PrometheusMetrics is a Prometheus implementation of Metric interface.
 type PrometheusMetrics struct {
-	duplicatedVaaCount  *prometheus.CounterVec
-	governorStatusCount *prometheus.CounterVec
-	governorVaaCount    *prometheus.CounterVec
+	duplicatedVaaCount     *prometheus.CounterVec
+	governorStatusCount    *prometheus.CounterVec
+	governorVaaCount       *prometheus.CounterVec
+	sqsConsecutiveFailures *prometheus.GaugeVec
 }
 
 // NewPrometheusMetrics returns a new instance of PrometheusMetrics.
@@ -43,6 +44,15 @@ func NewPrometheusMetrics(environment string) *PrometheusMetrics {
 					"service":     serviceName,
 				},
 			}, []string{"chain", "type"}),
+		sqsConsecutiveFailures: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "wormscan_fly_event_processor_sqs_consecutive_failures",
+				Help: "The number of consecutive errors getting messages from the sqs queue",
+				ConstLabels: map[string]string{
+					"environment": environment,
+					"service":     serviceName,
+				},
+			}, []string{"queue"}),
 	}
 }
 
@@ -106,3 +116,8 @@ func (m *PrometheusMetrics) IndGovenorVaaDeleted(chainID sdk.ChainID) {
 	chain := chainID.String()
 	m.governorVaaCount.WithLabelValues(chain, "deleted").Inc()
 }
+
+// SetSqsConsecutiveFailures sets the number of consecutive errors getting messages from a sqs queue.
+func (m *PrometheusMetrics) SetSqsConsecutiveFailures(queue string, failures int) {
+	m.sqsConsecutiveFailures.WithLabelValues(queue).Set(float64(failures))
+}
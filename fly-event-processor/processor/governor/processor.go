@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	txTracker "github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/storage"
@@ -16,6 +19,7 @@ import (
 type Processor struct {
 	repository       *storage.Repository
 	createTxHashFunc txTracker.CreateTxHashFunc
+	notifyFunc       NotifyFunc
 	logger           *zap.Logger
 	metrics          metrics.Metrics
 }
@@ -24,6 +28,7 @@ type Processor struct {
 func NewProcessor(
 	repository *storage.Repository,
 	createTxHashFunc txTracker.CreateTxHashFunc,
+	notifyFunc NotifyFunc,
 	logger *zap.Logger,
 	metrics metrics.Metrics,
 ) *Processor {
@@ -31,6 +36,7 @@ func NewProcessor(
 	return &Processor{
 		repository:       repository,
 		createTxHashFunc: createTxHashFunc,
+		notifyFunc:       notifyFunc,
 		logger:           logger,
 		metrics:          metrics,
 	}
@@ -112,9 +118,83 @@ func (p *Processor) Process(
 		return err
 	}
 
+	// 7. Notify the governor vaas enqueued and released.
+	p.notify(ctx, governorVaasToAdd, governorVaaIdsToDelete, logger)
+
 	return nil
 }
 
+// notify publishes a notification event for each governor vaa enqueued and released.
+// The failures are logged, they don't fail the processing of the governor event.
+func (p *Processor) notify(
+	ctx context.Context,
+	governorVaasToAdd []domain.GovernorVaa,
+	governorVaaIdsToDelete Set[string],
+	logger *zap.Logger,
+) {
+	if p.notifyFunc == nil {
+		return
+	}
+
+	for _, governorVaa := range governorVaasToAdd {
+		data := events.GovernorVaa{
+			ID:             governorVaa.ID,
+			EmitterChain:   uint16(governorVaa.ChainID),
+			EmitterAddress: governorVaa.EmitterAddress,
+			Sequence:       governorVaa.Sequence,
+			TxHash:         governorVaa.TxHash,
+			ReleaseTime:    governorVaa.ReleaseTime,
+			Amount:         governorVaa.Amount,
+		}
+		p.publish(ctx, events.GovernorVaaEnqueuedType, data, logger)
+	}
+
+	for vaaID := range governorVaaIdsToDelete {
+		data, err := newReleasedGovernorVaa(vaaID)
+		if err != nil {
+			logger.Error("failed to parse released governorVaa id",
+				zap.Error(err),
+				zap.String("vaaId", vaaID))
+			continue
+		}
+		p.publish(ctx, events.GovernorVaaReleasedType, data, logger)
+	}
+}
+
+func (p *Processor) publish(ctx context.Context, eventType string, data events.GovernorVaa, logger *zap.Logger) {
+	event, err := events.NewNotificationEvent(data.ID, "fly-event-processor", eventType, data)
+	if err != nil {
+		logger.Error("failed to create notification event",
+			zap.Error(err),
+			zap.String("vaaId", data.ID))
+		return
+	}
+	if err := p.notifyFunc(ctx, event); err != nil {
+		logger.Error("failed to publish notification event",
+			zap.Error(err),
+			zap.String("event", eventType),
+			zap.String("vaaId", data.ID))
+	}
+}
+
+// newReleasedGovernorVaa builds the event data of a released governor vaa from its id (chain/emitter/sequence).
+func newReleasedGovernorVaa(vaaID string) (events.GovernorVaa, error) {
+	parts := strings.Split(vaaID, "/")
+	if len(parts) != 3 {
+		return events.GovernorVaa{}, fmt.Errorf("invalid vaa id %s", vaaID)
+	}
+	chainID, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil {
+		return events.GovernorVaa{}, fmt.Errorf("invalid chain in vaa id %s: %w", vaaID, err)
+	}
+	return events.GovernorVaa{
+		ID:             vaaID,
+		EmitterChain:   uint16(chainID),
+		EmitterAddress: parts[1],
+		Sequence:       parts[2],
+	}, nil
+}
+
 // getNodeGovernorVaaIds gets the current governor vaaIds stored in the database by node address.
 func (p *Processor) getNodeGovernorVaaIds(
 	ctx context.Context,
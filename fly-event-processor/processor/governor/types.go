@@ -3,6 +3,7 @@ package governor
 import (
 	"context"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
 	"github.com/wormhole-foundation/wormhole-explorer/fly-event-processor/domain"
 )
 
@@ -41,3 +42,6 @@ type Params struct {
 
 // ProcessorFunc is a function to process a governor message.
 type ProcessorFunc func(context.Context, *Params) error
+
+// NotifyFunc is a function to publish the governor notification events.
+type NotifyFunc func(context.Context, *events.NotificationEvent) error
@@ -22,6 +22,7 @@ type SQS[T Event] struct {
 	chSize               int
 	wg                   sync.WaitGroup
 	incConsumedQueueFunc metrics.IncConsumedQueue
+	backoff              *sqs_client.Backoff
 	logger               *zap.Logger
 }
 
@@ -35,6 +36,7 @@ func NewEventSqs[T Event](
 		consumer:             consumer,
 		chSize:               10,
 		incConsumedQueueFunc: incConsumedQueueFunc,
+		backoff:              sqs_client.NewBackoff(),
 		logger:               logger.With(zap.String("queueUrl", consumer.GetQueueUrl())),
 	}
 	for _, opt := range opts {
@@ -51,6 +53,13 @@ func WithChannelSize[T Event](size int) SQSOption[T] {
 	}
 }
 
+// WithBackoff allows to specify the backoff applied when getting messages from SQS fails.
+func WithBackoff[T Event](backoff *sqs_client.Backoff) SQSOption[T] {
+	return func(d *SQS[T]) {
+		d.backoff = backoff
+	}
+}
+
 // Consume returns the channel with the received messages from SQS queue.
 func (q *SQS[T]) Consume(ctx context.Context) <-chan ConsumerMessage[T] {
 	go func() {
@@ -58,8 +67,10 @@ func (q *SQS[T]) Consume(ctx context.Context) <-chan ConsumerMessage[T] {
 			messages, err := q.consumer.GetMessages(ctx)
 			if err != nil {
 				q.logger.Error("Error getting messages from SQS", zap.Error(err))
+				q.backoff.Failure(ctx, err)
 				continue
 			}
+			q.backoff.Success()
 			q.logger.Debug("Received messages from SQS", zap.Int("count", len(messages)))
 			expiredAt := time.Now().Add(q.consumer.GetVisibilityTimeout())
 			for _, msg := range messages {
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	"go.mongodb.org/mongo-driver/bson/bsontype"
@@ -16,37 +17,39 @@ import (
 
 // VaaDoc represents a VAA document.
 type VaaDoc struct {
-	ID               string      `bson:"_id"`
-	Version          uint8       `bson:"version"`
-	EmitterChain     sdk.ChainID `bson:"emitterChain"`
-	EmitterAddr      string      `bson:"emitterAddr"`
-	Sequence         string      `bson:"sequence"`
-	GuardianSetIndex uint32      `bson:"guardianSetIndex"`
-	Vaa              []byte      `bson:"vaas"`
-	TxHash           string      `bson:"txHash,omitempty"`
-	OriginTxHash     *string     `bson:"_originTxHash,omitempty"` //this is temporary field for fix enconding txHash
-	Timestamp        *time.Time  `bson:"timestamp"`
-	UpdatedAt        *time.Time  `bson:"updatedAt"`
-	Digest           string      `bson:"digest"`
-	IsDuplicated     bool        `bson:"isDuplicated"`
-	DuplicatedFixed  bool        `bson:"duplicatedFixed"`
+	ID               string                     `bson:"_id"`
+	Version          uint8                      `bson:"version"`
+	EmitterChain     sdk.ChainID                `bson:"emitterChain"`
+	EmitterAddr      string                     `bson:"emitterAddr"`
+	Sequence         string                     `bson:"sequence"`
+	GuardianSetIndex uint32                     `bson:"guardianSetIndex"`
+	SignersBitmap    uint64                     `bson:"signersBitmap,omitempty"`
+	Vaa              repository.CompressedBytes `bson:"vaas"`
+	TxHash           string                     `bson:"txHash,omitempty"`
+	OriginTxHash     *string                    `bson:"_originTxHash,omitempty"` //this is temporary field for fix enconding txHash
+	Timestamp        *time.Time                 `bson:"timestamp"`
+	UpdatedAt        *time.Time                 `bson:"updatedAt"`
+	Digest           string                     `bson:"digest"`
+	IsDuplicated     bool                       `bson:"isDuplicated"`
+	DuplicatedFixed  bool                       `bson:"duplicatedFixed"`
 }
 
 // DuplicateVaaDoc represents a duplicate VAA document.
 type DuplicateVaaDoc struct {
-	ID               string      `bson:"_id"`
-	VaaID            string      `bson:"vaaId"`
-	Version          uint8       `bson:"version"`
-	EmitterChain     sdk.ChainID `bson:"emitterChain"`
-	EmitterAddr      string      `bson:"emitterAddr"`
-	Sequence         string      `bson:"sequence"`
-	GuardianSetIndex uint32      `bson:"guardianSetIndex"`
-	Vaa              []byte      `bson:"vaas"`
-	Digest           string      `bson:"digest"`
-	ConsistencyLevel uint8       `bson:"consistencyLevel"`
-	TxHash           string      `bson:"txHash,omitempty"`
-	Timestamp        *time.Time  `bson:"timestamp"`
-	UpdatedAt        *time.Time  `bson:"updatedAt"`
+	ID               string                     `bson:"_id"`
+	VaaID            string                     `bson:"vaaId"`
+	Version          uint8                      `bson:"version"`
+	EmitterChain     sdk.ChainID                `bson:"emitterChain"`
+	EmitterAddr      string                     `bson:"emitterAddr"`
+	Sequence         string                     `bson:"sequence"`
+	GuardianSetIndex uint32                     `bson:"guardianSetIndex"`
+	SignersBitmap    uint64                     `bson:"signersBitmap,omitempty"`
+	Vaa              repository.CompressedBytes `bson:"vaas"`
+	Digest           string                     `bson:"digest"`
+	ConsistencyLevel uint8                      `bson:"consistencyLevel"`
+	TxHash           string                     `bson:"txHash,omitempty"`
+	Timestamp        *time.Time                 `bson:"timestamp"`
+	UpdatedAt        *time.Time                 `bson:"updatedAt"`
 }
 
 type NodeGovernorVaaDoc struct {
@@ -67,6 +70,10 @@ type GovernorVaaDoc struct {
 }
 
 func (d *DuplicateVaaDoc) ToVaaDoc(duplicatedFixed bool) *VaaDoc {
+	signersBitmap := d.SignersBitmap
+	if vaa, err := sdk.Unmarshal(d.Vaa); err == nil {
+		signersBitmap = domain.SignersBitmap(vaa)
+	}
 	return &VaaDoc{
 		ID:               d.VaaID,
 		Version:          d.Version,
@@ -74,6 +81,7 @@ func (d *DuplicateVaaDoc) ToVaaDoc(duplicatedFixed bool) *VaaDoc {
 		EmitterAddr:      d.EmitterAddr,
 		Sequence:         d.Sequence,
 		GuardianSetIndex: d.GuardianSetIndex,
+		SignersBitmap:    signersBitmap,
 		Vaa:              d.Vaa,
 		Digest:           d.Digest,
 		TxHash:           d.TxHash,
@@ -100,6 +108,7 @@ func (v *VaaDoc) ToDuplicateVaaDoc() (*DuplicateVaaDoc, error) {
 		EmitterAddr:      v.EmitterAddr,
 		Sequence:         v.Sequence,
 		GuardianSetIndex: v.GuardianSetIndex,
+		SignersBitmap:    domain.SignersBitmap(vaa),
 		Vaa:              v.Vaa,
 		Digest:           v.Digest,
 		TxHash:           v.TxHash,
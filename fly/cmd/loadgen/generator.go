@@ -0,0 +1,197 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sort"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	wormholesdk "github.com/wormhole-foundation/wormhole/sdk"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// transferPayloadID is the payload id of the token bridge transfers.
+const transferPayloadID = 1
+
+// Message is a synthetic signed VAA with the observations of the guardians that signed it.
+type Message struct {
+	Vaa           *sdk.VAA
+	SerializedVaa []byte
+	Observations  []*gossipv1.SignedObservation
+}
+
+// Generator creates synthetic token bridge transfers emitted by the mainnet token bridge emitters.
+//
+// The VAAs are signed by a guardian set derived from the seed, so two runs with the same seed
+// and initial sequence generate the same VAAs (except for the timestamps).
+type Generator struct {
+	rnd              *rand.Rand
+	guardians        []*ecdsa.PrivateKey
+	guardianSetIndex uint32
+	chains           []sdk.ChainID
+	emitters         map[sdk.ChainID]sdk.Address
+	tokens           []domain.TokenMetadata
+	sequence         uint64
+}
+
+// NewGenerator creates a new Generator.
+func NewGenerator(seed int64, guardianCount int, guardianSetIndex uint32, firstSequence uint64) (*Generator, error) {
+	if guardianCount <= 0 || guardianCount > 255 {
+		return nil, fmt.Errorf("invalid guardian count %d", guardianCount)
+	}
+
+	guardians := make([]*ecdsa.PrivateKey, 0, guardianCount)
+	for i := 0; i < guardianCount; i++ {
+		key, err := guardianKey(seed, i)
+		if err != nil {
+			return nil, err
+		}
+		guardians = append(guardians, key)
+	}
+
+	emitters := make(map[sdk.ChainID]sdk.Address, len(wormholesdk.KnownTokenbridgeEmitters))
+	chains := make([]sdk.ChainID, 0, len(wormholesdk.KnownTokenbridgeEmitters))
+	for chainID, emitter := range wormholesdk.KnownTokenbridgeEmitters {
+		var address sdk.Address
+		copy(address[len(address)-len(emitter):], emitter)
+		emitters[chainID] = address
+		chains = append(chains, chainID)
+	}
+	// the map iteration order is random, the chains are sorted to generate the same VAAs for the same seed.
+	sort.Slice(chains, func(i, j int) bool { return chains[i] < chains[j] })
+
+	// only the tokens of the chains with a token bridge can be transferred.
+	var tokens []domain.TokenMetadata
+	for _, token := range domain.NewTokenProvider(domain.P2pMainNet).GetAllTokens() {
+		if _, ok := emitters[token.TokenChain]; ok {
+			tokens = append(tokens, token)
+		}
+	}
+	if len(chains) < 2 || len(tokens) == 0 {
+		return nil, fmt.Errorf("not enough token bridge chains or tokens to generate transfers")
+	}
+
+	return &Generator{
+		rnd:              rand.New(rand.NewSource(seed)),
+		guardians:        guardians,
+		guardianSetIndex: guardianSetIndex,
+		chains:           chains,
+		emitters:         emitters,
+		tokens:           tokens,
+		sequence:         firstSequence,
+	}, nil
+}
+
+// guardianKey derives the private key of the i-th guardian from the seed.
+func guardianKey(seed int64, i int) (*ecdsa.PrivateKey, error) {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf, uint64(seed))
+	binary.BigEndian.PutUint64(buf[8:], uint64(i))
+	d := sha256.Sum256(buf)
+	return crypto.ToECDSA(d[:])
+}
+
+// GuardianAddresses returns the addresses of the guardian set that signs the VAAs.
+func (g *Generator) GuardianAddresses() []string {
+	addresses := make([]string, 0, len(g.guardians))
+	for _, key := range g.guardians {
+		addresses = append(addresses, crypto.PubkeyToAddress(key.PublicKey).Hex())
+	}
+	return addresses
+}
+
+// Next creates a new signed token bridge transfer with the observations of the guardians.
+// The VAA is signed by a quorum of the guardians.
+//
+// Next is not safe for concurrent use.
+func (g *Generator) Next(timestamp time.Time) (*Message, error) {
+	token := g.tokens[g.rnd.Intn(len(g.tokens))]
+	tokenAddress, err := sdk.StringToAddress(token.TokenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token address %s: %w", token.TokenAddress, err)
+	}
+
+	emitterChain := g.chains[g.rnd.Intn(len(g.chains))]
+	targetChain := g.chains[g.rnd.Intn(len(g.chains))]
+	for targetChain == emitterChain {
+		targetChain = g.chains[g.rnd.Intn(len(g.chains))]
+	}
+
+	v := &sdk.VAA{
+		Version:          sdk.SupportedVAAVersion,
+		GuardianSetIndex: g.guardianSetIndex,
+		Timestamp:        timestamp.Truncate(time.Second).UTC(),
+		Nonce:            g.rnd.Uint32(),
+		Sequence:         g.sequence,
+		ConsistencyLevel: 1,
+		EmitterChain:     emitterChain,
+		EmitterAddress:   g.emitters[emitterChain],
+		Payload:          g.transferPayload(tokenAddress, token.TokenChain, targetChain),
+	}
+	g.sequence++
+
+	// sign with a random quorum of the guardians, as the real VAAs are.
+	txHash := g.randomBytes(32)
+	signers := g.rnd.Perm(len(g.guardians))[:sdk.CalculateQuorum(len(g.guardians))]
+	sort.Ints(signers)
+	observations := make([]*gossipv1.SignedObservation, 0, len(signers))
+	digest := v.SigningDigest().Bytes()
+	for _, i := range signers {
+		v.AddSignature(g.guardians[i], uint8(i))
+		observations = append(observations, &gossipv1.SignedObservation{
+			Addr:      crypto.PubkeyToAddress(g.guardians[i].PublicKey).Bytes(),
+			Hash:      digest,
+			Signature: v.Signatures[len(v.Signatures)-1].Signature[:],
+			TxHash:    txHash,
+			MessageId: v.MessageID(),
+		})
+	}
+
+	serializedVaa, err := v.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal vaa %s: %w", v.MessageID(), err)
+	}
+	return &Message{Vaa: v, SerializedVaa: serializedVaa, Observations: observations}, nil
+}
+
+// transferPayload creates a token bridge transfer payload with a random amount and recipient.
+//
+// The amounts are normalized to 8 decimals by the token bridge, they are generated from 0.01 to 9M tokens
+// with a log-uniform distribution so most of the transfers are small.
+func (g *Generator) transferPayload(tokenAddress sdk.Address, tokenChain, targetChain sdk.ChainID) []byte {
+	exp := 6 + g.rnd.Intn(9)
+	amount := new(big.Int).Mul(big.NewInt(1+g.rnd.Int63n(9)), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+
+	var recipient sdk.Address
+	copy(recipient[12:], g.randomBytes(20))
+
+	payload := make([]byte, 0, 133)
+	payload = append(payload, transferPayloadID)
+	payload = append(payload, uint256(amount)...)
+	payload = append(payload, tokenAddress[:]...)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(tokenChain))
+	payload = append(payload, recipient[:]...)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(targetChain))
+	// fee
+	payload = append(payload, uint256(big.NewInt(0))...)
+	return payload
+}
+
+func (g *Generator) randomBytes(n int) []byte {
+	b := make([]byte, n)
+	g.rnd.Read(b)
+	return b
+}
+
+// uint256 encodes a big-endian 32 bytes unsigned integer.
+func uint256(n *big.Int) []byte {
+	b := make([]byte, 32)
+	return n.FillBytes(b)
+}
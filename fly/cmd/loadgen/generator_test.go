@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestGeneratorValidVaas(t *testing.T) {
+	g, err := NewGenerator(42, 19, 4, 100)
+	assert.NoError(t, err)
+
+	var guardians []common.Address
+	for _, address := range g.GuardianAddresses() {
+		guardians = append(guardians, common.HexToAddress(address))
+	}
+
+	for i := 0; i < 20; i++ {
+		m, err := g.Next(time.Now())
+		assert.NoError(t, err)
+
+		v, err := sdk.Unmarshal(m.SerializedVaa)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100+i), v.Sequence)
+		assert.Equal(t, uint32(4), v.GuardianSetIndex)
+		assert.NoError(t, v.Verify(guardians))
+
+		transfer, err := sdk.DecodeTransferPayloadHdr(v.Payload)
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(transferPayloadID), transfer.Type)
+		assert.NotEqual(t, v.EmitterChain, transfer.TargetChain)
+		assert.Positive(t, transfer.Amount.Sign())
+
+		assert.Len(t, m.Observations, sdk.CalculateQuorum(19))
+		for _, o := range m.Observations {
+			assert.Equal(t, v.MessageID(), o.MessageId)
+			assert.Equal(t, v.SigningDigest().Bytes(), o.Hash)
+		}
+	}
+}
+
+func TestGeneratorSameSeed(t *testing.T) {
+	timestamp := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	g1, err := NewGenerator(7, 19, 4, 1)
+	assert.NoError(t, err)
+	g2, err := NewGenerator(7, 19, 4, 1)
+	assert.NoError(t, err)
+	g3, err := NewGenerator(8, 19, 4, 1)
+	assert.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		m1, err := g1.Next(timestamp)
+		assert.NoError(t, err)
+		m2, err := g2.Next(timestamp)
+		assert.NoError(t, err)
+		m3, err := g3.Next(timestamp)
+		assert.NoError(t, err)
+
+		assert.Equal(t, m1.SerializedVaa, m2.SerializedVaa)
+		assert.NotEqual(t, m1.SerializedVaa, m3.SerializedVaa)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	targetMongo = "mongo"
+	targetSNS   = "sns"
+)
+
+func main() {
+	execute()
+}
+
+func execute() error {
+	root := &cobra.Command{
+		Use:   "loadgen",
+		Short: "Generate synthetic VAAs to load test the wormscan services",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.Help()
+				os.Exit(0)
+			}
+		},
+	}
+
+	addRunCommand(root)
+
+	return root.Execute()
+}
+
+func addRunCommand(root *cobra.Command) {
+	var cfg Configuration
+
+	runCommand := &cobra.Command{
+		Use:   "run",
+		Short: "Generate synthetic VAAs and observations and send them to mongo or to the vaa topic",
+		Run: func(_ *cobra.Command, _ []string) {
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			if err := Run(ctx, cfg); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		},
+	}
+	runCommand.Flags().StringVar(&cfg.Target, "target", targetMongo, "where the messages are sent: mongo (stored as fly does and notified to the vaa topic) or sns (only notified to the vaa topic)")
+	runCommand.Flags().IntVar(&cfg.Count, "count", 1000, "number of vaas to generate")
+	runCommand.Flags().Float64Var(&cfg.Rate, "rate", 10, "vaas generated per second, 0 means as fast as possible")
+	runCommand.Flags().IntVar(&cfg.WorkerCount, "worker-count", 10, "number of workers sending the messages")
+	runCommand.Flags().Int64Var(&cfg.Seed, "seed", 1, "seed of the random generator, the same seed generates the same vaas")
+	runCommand.Flags().Uint64Var(&cfg.FirstSequence, "first-sequence", uint64(time.Now().Unix()), "sequence of the first vaa, must not collide with the stored vaas")
+	runCommand.Flags().IntVar(&cfg.GuardianCount, "guardian-count", 19, "number of guardians of the synthetic guardian set")
+	runCommand.Flags().Uint32Var(&cfg.GuardianSetIndex, "guardian-set-index", 4, "guardian set index of the vaas")
+	runCommand.Flags().StringVar(&cfg.MongoURI, "mongo-uri", "", "Mongo connection")
+	runCommand.Flags().StringVar(&cfg.MongoDatabase, "mongo-database", "", "Mongo database")
+	runCommand.Flags().BoolVar(&cfg.NotifyEnabled, "notify-enabled", true, "notify the stored vaas to the vaa topic")
+	runCommand.Flags().StringVar(&cfg.AwsRegion, "aws-region", "", "AWS region")
+	runCommand.Flags().StringVar(&cfg.AwsAccessKeyId, "aws-access-key-id", "", "AWS access key id")
+	runCommand.Flags().StringVar(&cfg.AwsSecretKey, "aws-secret-access-key", "", "AWS secret access key")
+	runCommand.Flags().StringVar(&cfg.AwsEndpoint, "aws-endpoint", "", "AWS endpoint")
+	runCommand.Flags().StringVar(&cfg.AwsSnsURL, "aws-sns-url", "", "AWS SNS URL of the vaa topic")
+
+	root.AddCommand(runCommand)
+}
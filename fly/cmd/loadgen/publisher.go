@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/track"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/producer"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/storage"
+)
+
+// Publisher sends the generated messages to the services under test.
+type Publisher interface {
+	Publish(ctx context.Context, m *Message) error
+}
+
+// mongoPublisher stores the generated messages as fly stores the messages received from the gossip network.
+//
+// The observations are stored first, so the tx hash of the vaa is resolved when it is stored.
+// New vaas are notified with the push function of the repository, as fly does.
+type mongoPublisher struct {
+	repository   *storage.Repository
+	observations *storage.ObservationWriter
+}
+
+// Publish stores the observations and the vaa of the message.
+func (p *mongoPublisher) Publish(ctx context.Context, m *Message) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	for _, o := range m.Observations {
+		wg.Add(1)
+		p.observations.Write(ctx, o, true, func(err error) {
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+			wg.Done()
+		})
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return p.repository.UpsertVaa(ctx, m.Vaa, m.SerializedVaa)
+}
+
+// topicPublisher sends the signed vaa events of the generated messages to the vaa topic,
+// without storing them, to measure the consumers of the topic in isolation.
+type topicPublisher struct {
+	push producer.PushFunc
+}
+
+// Publish sends the signed vaa event of the message.
+func (p *topicPublisher) Publish(ctx context.Context, m *Message) error {
+	v := m.Vaa
+
+	// all the observations of a message have the same tx hash.
+	var txHash string
+	if len(m.Observations) > 0 {
+		txHash, _ = domain.EncodeTrxHashByChainID(v.EmitterChain, m.Observations[0].TxHash)
+	}
+
+	event, err := events.NewNotificationEvent[events.SignedVaa](
+		track.GetTrackID(v.MessageID()), "loadgen", events.SignedVaaType,
+		events.SignedVaa{
+			ID:               v.MessageID(),
+			EmitterChain:     uint16(v.EmitterChain),
+			EmitterAddress:   v.EmitterAddress.String(),
+			Sequence:         v.Sequence,
+			GuardianSetIndex: v.GuardianSetIndex,
+			Timestamp:        v.Timestamp,
+			Vaa:              m.SerializedVaa,
+			TxHash:           txHash,
+			Version:          int(v.Version),
+		})
+	if err != nil {
+		return err
+	}
+	return p.push(ctx, &producer.Notification{ID: v.MessageID(), Event: event, EmitterChain: v.EmitterChain})
+}
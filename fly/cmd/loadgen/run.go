@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/schollz/progressbar/v3"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/sns"
+	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/event"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/producer"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/storage"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/txhash"
+	"go.uber.org/zap"
+)
+
+const (
+	observationsBatchSize     = 100
+	observationsFlushInterval = 100 * time.Millisecond
+)
+
+// Configuration of the load generator.
+type Configuration struct {
+	Target           string
+	Count            int
+	Rate             float64
+	WorkerCount      int
+	Seed             int64
+	FirstSequence    uint64
+	GuardianCount    int
+	GuardianSetIndex uint32
+	MongoURI         string
+	MongoDatabase    string
+	NotifyEnabled    bool
+	AwsRegion        string
+	AwsAccessKeyId   string
+	AwsSecretKey     string
+	AwsEndpoint      string
+	AwsSnsURL        string
+}
+
+// Run generates the configured number of vaas at the configured rate and sends them to the target.
+func Run(ctx context.Context, cfg Configuration) error {
+	if cfg.Count <= 0 || cfg.WorkerCount <= 0 || cfg.Rate < 0 {
+		return fmt.Errorf("count and worker-count must be greater than zero and rate must not be negative")
+	}
+
+	logger := zap.NewExample()
+
+	generator, err := NewGenerator(cfg.Seed, cfg.GuardianCount, cfg.GuardianSetIndex, cfg.FirstSequence)
+	if err != nil {
+		return err
+	}
+
+	publisher, closeFunc, err := newPublisher(ctx, cfg, logger)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	fmt.Printf("generating %d vaas from sequence %d with seed %d, guardian set %d: %v\n",
+		cfg.Count, cfg.FirstSequence, cfg.Seed, cfg.GuardianSetIndex, generator.GuardianAddresses())
+
+	bar := progressbar.Default(int64(cfg.Count))
+	queue := make(chan *Message, cfg.WorkerCount)
+	var failed atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < cfg.WorkerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for m := range queue {
+				if err := publisher.Publish(ctx, m); err != nil {
+					failed.Add(1)
+					logger.Error("failed to publish vaa", zap.String("id", m.Vaa.MessageID()), zap.Error(err))
+				}
+				bar.Add(1)
+			}
+		}()
+	}
+
+	// the vaas are generated by a single goroutine, so the generated sequence
+	// does not depend on the number of workers.
+	var ticker *time.Ticker
+	if cfg.Rate > 0 {
+		ticker = time.NewTicker(time.Duration(float64(time.Second) / cfg.Rate))
+		defer ticker.Stop()
+	}
+	start := time.Now()
+	generated := 0
+	for ; generated < cfg.Count; generated++ {
+		if ticker != nil {
+			select {
+			case <-ctx.Done():
+			case <-ticker.C:
+			}
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		m, err := generator.Next(time.Now())
+		if err != nil {
+			close(queue)
+			return err
+		}
+		queue <- m
+	}
+	close(queue)
+	wg.Wait()
+
+	elapsed := time.Since(start)
+	fmt.Printf("\ngenerated %d vaas in %s (%.2f vaas/s), %d failed\n",
+		generated, elapsed.Round(time.Millisecond), float64(generated)/elapsed.Seconds(), failed.Load())
+	return nil
+}
+
+// newPublisher creates the publisher of the configured target and a function to release its resources.
+func newPublisher(ctx context.Context, cfg Configuration, logger *zap.Logger) (Publisher, func(), error) {
+	alertClient := alert.NewDummyClient()
+	metricsClient := metrics.NewDummyMetrics()
+
+	switch cfg.Target {
+	case targetSNS:
+		snsProducer, err := newSNSProducer(ctx, cfg, alertClient, metricsClient, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &topicPublisher{push: snsProducer.Push}, func() {}, nil
+
+	case targetMongo:
+		if cfg.MongoURI == "" || cfg.MongoDatabase == "" {
+			return nil, nil, fmt.Errorf("mongo-uri and mongo-database are required by the mongo target")
+		}
+		push, err := newVAATopicProducerFunc(ctx, cfg, alertClient, metricsClient, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		db, err := dbutil.Connect(ctx, logger, cfg.MongoURI, cfg.MongoDatabase, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		repository := storage.NewRepository(alertClient, metricsClient, db.Database, push,
+			txhash.NewMongoTxHash(db.Database, logger), event.NewNoopEventDispatcher(), logger)
+
+		// the writer is stopped once all the messages are published.
+		writerCtx, cancel := context.WithCancel(ctx)
+		observations := storage.NewObservationWriter(repository, observationsBatchSize, observationsFlushInterval, nil, metricsClient, logger)
+		observations.Start(writerCtx)
+
+		closeFunc := func() {
+			cancel()
+			db.DisconnectWithTimeout(10 * time.Second)
+		}
+		return &mongoPublisher{repository: repository, observations: observations}, closeFunc, nil
+
+	default:
+		return nil, nil, fmt.Errorf("invalid target %s", cfg.Target)
+	}
+}
+
+// newAwsConfig creates a new AWS config from the given configuration.
+func newAwsConfig(ctx context.Context, cfg Configuration) (aws.Config, error) {
+	region := cfg.AwsRegion
+	if region == "" {
+		return aws.Config{}, fmt.Errorf("AWS_REGION is required")
+	}
+	awsSecretId := cfg.AwsAccessKeyId
+	awsSecretKey := cfg.AwsSecretKey
+	if awsSecretId != "" && awsSecretKey != "" {
+		credentials := credentials.NewStaticCredentialsProvider(awsSecretId, awsSecretKey, "")
+		customResolver := aws.EndpointResolverFunc(func(service, region string) (aws.Endpoint, error) {
+			if cfg.AwsEndpoint != "" {
+				return aws.Endpoint{
+					PartitionID:   "aws",
+					URL:           cfg.AwsEndpoint,
+					SigningRegion: region,
+				}, nil
+			}
+
+			return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+		})
+
+		awsCfg, err := awsconfig.LoadDefaultConfig(ctx,
+			awsconfig.WithRegion(region),
+			awsconfig.WithEndpointResolver(customResolver),
+			awsconfig.WithCredentialsProvider(credentials),
+		)
+		return awsCfg, err
+	}
+
+	return awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+}
+
+// newSNSProducer creates a new SNS producer from the given configuration.
+func newSNSProducer(ctx context.Context, cfg Configuration, alertClient alert.AlertClient, metricsClient metrics.Metrics, logger *zap.Logger) (*producer.SNSProducer, error) {
+	if cfg.AwsSnsURL == "" {
+		return nil, fmt.Errorf("AWS_SNS_URL is required")
+	}
+
+	awsConfig, err := newAwsConfig(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	snsProducer, err := sns.NewProducer(awsConfig, cfg.AwsSnsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return producer.NewSNSProducer(snsProducer, alertClient, metricsClient, logger), nil
+}
+
+// newVAATopicProducerFunc creates a new VAA topic producer function from the given configuration.
+func newVAATopicProducerFunc(ctx context.Context, cfg Configuration, alertClient alert.AlertClient, metricsClient metrics.Metrics, logger *zap.Logger) (producer.PushFunc, error) {
+	if !cfg.NotifyEnabled {
+		return func(context.Context, *producer.Notification) error {
+			return nil
+		}, nil
+	}
+
+	snsProducer, err := newSNSProducer(ctx, cfg, alertClient, metricsClient, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return snsProducer.Push, nil
+}
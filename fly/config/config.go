@@ -47,6 +47,9 @@ type Configuration struct {
 	GovernorConfigChannelSize int    `env:"GOVERNOR_CONFIG_CHANNEL_SIZE,required"`
 	GovernorStatusChannelSize int    `env:"GOVERNOR_STATUS_CHANNEL_SIZE,required"`
 	VaasWorkersSize           int    `env:"VAAS_WORKERS_SIZE,default=5"`
+	VaasNonPythWorkersSize    int    `env:"VAAS_NON_PYTH_WORKERS_SIZE,default=1"`
+	VaasNonPythWeight         int    `env:"VAAS_NON_PYTH_WEIGHT,default=4"`
+	VaasPythSampling          int    `env:"VAAS_PYTH_SAMPLING,default=1"`
 	ObservationsWorkersSize   int    `env:"OBSERVATIONS_WORKERS_SIZE,default=10"`
 	AlertEnabled              bool   `env:"ALERT_ENABLED"`
 	AlertApiKey               string `env:"ALERT_API_KEY"`
@@ -55,6 +58,14 @@ type Configuration struct {
 	P2pPort                   uint   `env:"P2P_PORT,required"`
 	PprofEnabled              bool   `env:"PPROF_ENABLED"`
 	MaxHealthTimeSeconds      int64  `env:"MAX_HEALTH_TIME_SECONDS,default=60"`
+	GossipStatsInterval       int64  `env:"GOSSIP_STATS_INTERVAL_SECONDS,default=60"`
+	HeartbeatGapThreshold     int64  `env:"HEARTBEAT_GAP_THRESHOLD_SECONDS,default=60"`
+	ObservationsBatchSize     int    `env:"OBSERVATIONS_BATCH_SIZE,default=100"`
+	ObservationsFlushInterval int64  `env:"OBSERVATIONS_FLUSH_INTERVAL_MS,default=500"`
+	ObservationsPersistMode   string `env:"OBSERVATIONS_PERSIST_MODE,default=all"`
+	ObservationsPersistFirstN int    `env:"OBSERVATIONS_PERSIST_FIRST_N,default=13"`
+	ObservationsSamplePercent int    `env:"OBSERVATIONS_SAMPLE_PERCENT,default=10"`
+	VaasVerifySignatures      bool   `env:"VAAS_VERIFY_SIGNATURES"`
 	IsLocal                   bool
 	Redis                     *RedisConfiguration
 	Aws                       *AwsConfiguration
@@ -0,0 +1,122 @@
+package gossip
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/producer"
+	"go.uber.org/zap"
+)
+
+type guardianHeartbeat struct {
+	nodeName        string
+	lastHeartbeatAt time.Time
+	inGap           bool
+}
+
+// HeartbeatGaps detects the guardians that stop sending heartbeats for longer than a threshold.
+// A notification is published when the gap is detected and another one when the guardian recovers.
+type HeartbeatGaps struct {
+	mu        sync.Mutex
+	guardians map[string]*guardianHeartbeat
+	threshold time.Duration
+	pushFunc  producer.PushFunc
+	logger    *zap.Logger
+}
+
+// NewHeartbeatGaps creates a new HeartbeatGaps that reports the gaps longer than threshold.
+func NewHeartbeatGaps(threshold time.Duration, pushFunc producer.PushFunc, logger *zap.Logger) *HeartbeatGaps {
+	return &HeartbeatGaps{
+		guardians: make(map[string]*guardianHeartbeat),
+		threshold: threshold,
+		pushFunc:  pushFunc,
+		logger:    logger.With(zap.String("module", "HeartbeatGaps")),
+	}
+}
+
+// RecordHeartbeat records a heartbeat of a guardian and notifies the recovery of the guardian if it was in a gap.
+func (g *HeartbeatGaps) RecordHeartbeat(ctx context.Context, hb *gossipv1.Heartbeat) {
+	addr := strings.ToLower(hb.GuardianAddr)
+
+	g.mu.Lock()
+	h, ok := g.guardians[addr]
+	if !ok {
+		h = &guardianHeartbeat{}
+		g.guardians[addr] = h
+	}
+	recovered := h.inGap
+	gap := events.HeartbeatGap{
+		GuardianAddr:  addr,
+		NodeName:      hb.NodeName,
+		LastHeartbeat: h.lastHeartbeatAt,
+		Recovered:     true,
+	}
+	h.nodeName = hb.NodeName
+	h.lastHeartbeatAt = time.Now()
+	h.inGap = false
+	g.mu.Unlock()
+
+	if recovered {
+		g.notify(ctx, gap)
+	}
+}
+
+// Start checks the heartbeat gaps until the context is cancelled.
+func (g *HeartbeatGaps) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(g.threshold / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, gap := range g.check(time.Now()) {
+					g.notify(ctx, gap)
+				}
+			}
+		}
+	}()
+}
+
+// check returns the guardians whose last heartbeat is older than the threshold.
+// Each gap is returned once, until the guardian recovers.
+func (g *HeartbeatGaps) check(now time.Time) []events.HeartbeatGap {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var gaps []events.HeartbeatGap
+	for addr, h := range g.guardians {
+		if h.inGap || now.Sub(h.lastHeartbeatAt) <= g.threshold {
+			continue
+		}
+		h.inGap = true
+		gaps = append(gaps, events.HeartbeatGap{
+			GuardianAddr:  addr,
+			NodeName:      h.nodeName,
+			LastHeartbeat: h.lastHeartbeatAt,
+		})
+	}
+	return gaps
+}
+
+func (g *HeartbeatGaps) notify(ctx context.Context, gap events.HeartbeatGap) {
+	if gap.Recovered {
+		g.logger.Info("Guardian heartbeats recovered", zap.String("guardianAddr", gap.GuardianAddr), zap.String("nodeName", gap.NodeName))
+	} else {
+		g.logger.Warn("Guardian heartbeats gap detected", zap.String("guardianAddr", gap.GuardianAddr), zap.String("nodeName", gap.NodeName))
+	}
+
+	event, err := events.NewNotificationEvent(gap.GuardianAddr, "fly", events.HeartbeatGapType, gap)
+	if err != nil {
+		g.logger.Error("Error creating heartbeat gap event", zap.Error(err))
+		return
+	}
+	if err := g.pushFunc(ctx, &producer.Notification{ID: gap.GuardianAddr, Event: event}); err != nil {
+		g.logger.Error("Error publishing heartbeat gap event", zap.Error(err), zap.String("guardianAddr", gap.GuardianAddr))
+	}
+}
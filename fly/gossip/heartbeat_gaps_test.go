@@ -0,0 +1,48 @@
+package gossip
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/producer"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestHeartbeatGaps(t *testing.T) {
+	var notifications []*producer.Notification
+	pushFunc := func(_ context.Context, n *producer.Notification) error {
+		notifications = append(notifications, n)
+		return nil
+	}
+
+	g := NewHeartbeatGaps(time.Minute, pushFunc, zaptest.NewLogger(t))
+	hb := &gossipv1.Heartbeat{GuardianAddr: "0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5", NodeName: "Certus One"}
+	g.RecordHeartbeat(context.Background(), hb)
+	assert.Empty(t, notifications)
+
+	// no gap within the threshold.
+	assert.Empty(t, g.check(time.Now().Add(30*time.Second)))
+
+	// the gap is reported once.
+	gaps := g.check(time.Now().Add(2 * time.Minute))
+	assert.Len(t, gaps, 1)
+	assert.Equal(t, "0x58cc3ae5c097b213ce3c81979e1b9f9570746aa5", gaps[0].GuardianAddr)
+	assert.Equal(t, "Certus One", gaps[0].NodeName)
+	assert.False(t, gaps[0].Recovered)
+	assert.Empty(t, g.check(time.Now().Add(3*time.Minute)))
+
+	// the recovery is notified with the next heartbeat.
+	g.RecordHeartbeat(context.Background(), hb)
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, events.HeartbeatGapType, notifications[0].Event.Event)
+	gap, err := events.GetEventData[events.HeartbeatGap](notifications[0].Event)
+	assert.NoError(t, err)
+	assert.True(t, gap.Recovered)
+
+	g.RecordHeartbeat(context.Background(), hb)
+	assert.Len(t, notifications, 1)
+}
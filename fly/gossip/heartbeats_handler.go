@@ -15,6 +15,8 @@ type heartbeatsHandler struct {
 	heartbeatsC chan *gossipv1.Heartbeat
 	repository  *storage.Repository
 	guardian    *health.GuardianCheck
+	stats       *Stats
+	gaps        *HeartbeatGaps
 	metrics     metrics.Metrics
 	logger      *zap.Logger
 }
@@ -23,6 +25,8 @@ func NewHeartbeatsHandler(
 	heartbeatsC chan *gossipv1.Heartbeat,
 	repository *storage.Repository,
 	guardian *health.GuardianCheck,
+	stats *Stats,
+	gaps *HeartbeatGaps,
 	metrics metrics.Metrics,
 	logger *zap.Logger,
 ) *heartbeatsHandler {
@@ -30,6 +34,8 @@ func NewHeartbeatsHandler(
 		heartbeatsC: heartbeatsC,
 		repository:  repository,
 		guardian:    guardian,
+		stats:       stats,
+		gaps:        gaps,
 		metrics:     metrics,
 		logger:      logger,
 	}
@@ -45,6 +51,10 @@ func (h *heartbeatsHandler) Start(ctx context.Context) {
 			case hb := <-h.heartbeatsC:
 				h.guardian.Ping(ctx)
 				h.metrics.IncHeartbeatFromGossipNetwork(hb.NodeName)
+				h.stats.RecordHeartbeat(hb)
+				if h.gaps != nil {
+					h.gaps.RecordHeartbeat(ctx, hb)
+				}
 				err := h.repository.UpsertHeartbeat(hb)
 				if err != nil {
 					h.logger.Error("Error inserting heartbeat", zap.Error(err))
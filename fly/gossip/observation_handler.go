@@ -14,6 +14,7 @@ type observationHandler struct {
 	obsvC    chan *common.MsgWithTimeStamp[gossipv1.SignedObservation]
 	pushFunc processor.ObservationPushFunc
 	guardian *health.GuardianCheck
+	stats    *Stats
 	metrics  metrics.Metrics
 }
 
@@ -21,12 +22,14 @@ func NewObservationHandler(
 	obsvC chan *common.MsgWithTimeStamp[gossipv1.SignedObservation],
 	pushFunc processor.ObservationPushFunc,
 	guardian *health.GuardianCheck,
+	stats *Stats,
 	metrics metrics.Metrics,
 ) *observationHandler {
 	return &observationHandler{
 		obsvC:    obsvC,
 		pushFunc: pushFunc,
 		guardian: guardian,
+		stats:    stats,
 		metrics:  metrics,
 	}
 }
@@ -42,6 +45,7 @@ func (h *observationHandler) Start(ctx context.Context) {
 				o := m.Msg
 				h.guardian.Ping(ctx)
 				h.metrics.IncObservationTotal()
+				h.stats.RecordObservation(o)
 				h.pushFunc(ctx, o)
 			}
 		}
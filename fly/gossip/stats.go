@@ -0,0 +1,138 @@
+package gossip
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/storage"
+	"go.uber.org/zap"
+)
+
+type guardianStats struct {
+	nodeName          string
+	p2pNodeID         string
+	observations      uint64
+	heartbeats        uint64
+	lastObservationAt *time.Time
+	lastHeartbeatAt   *time.Time
+}
+
+// Stats aggregates the gossip messages received from each guardian
+// and stores the statistics of each time window.
+type Stats struct {
+	mu          sync.Mutex
+	guardians   map[string]*guardianStats
+	windowStart time.Time
+	interval    time.Duration
+	repository  *storage.Repository
+	logger      *zap.Logger
+}
+
+// NewStats creates a new Stats that stores the statistics every interval.
+func NewStats(interval time.Duration, repository *storage.Repository, logger *zap.Logger) *Stats {
+	return &Stats{
+		guardians:   make(map[string]*guardianStats),
+		windowStart: time.Now(),
+		interval:    interval,
+		repository:  repository,
+		logger:      logger.With(zap.String("module", "GossipStats")),
+	}
+}
+
+// RecordObservation records an observation signed by a guardian.
+func (s *Stats) RecordObservation(o *gossipv1.SignedObservation) {
+	now := time.Now()
+	addr := strings.ToLower(eth_common.BytesToAddress(o.Addr).Hex())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g := s.guardian(addr)
+	g.observations++
+	g.lastObservationAt = &now
+}
+
+// RecordHeartbeat records a heartbeat of a guardian.
+func (s *Stats) RecordHeartbeat(hb *gossipv1.Heartbeat) {
+	now := time.Now()
+	addr := strings.ToLower(hb.GuardianAddr)
+
+	var p2pNodeID string
+	if id, err := peer.IDFromBytes(hb.P2PNodeId); err == nil {
+		p2pNodeID = id.String()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g := s.guardian(addr)
+	g.heartbeats++
+	g.lastHeartbeatAt = &now
+	g.nodeName = hb.NodeName
+	if p2pNodeID != "" {
+		g.p2pNodeID = p2pNodeID
+	}
+}
+
+func (s *Stats) guardian(addr string) *guardianStats {
+	g, ok := s.guardians[addr]
+	if !ok {
+		g = &guardianStats{}
+		s.guardians[addr] = g
+	}
+	return g
+}
+
+// Start stores the statistics every interval until the context is cancelled.
+func (s *Stats) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.repository.UpsertGossipStats(ctx, s.flush()); err != nil {
+					s.logger.Error("Error storing gossip stats", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// flush returns the statistics of the current window and starts a new one.
+// The guardians keep their identity and last seen times across windows.
+func (s *Stats) flush() []*storage.GossipStatsUpdate {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	window := now.Sub(s.windowStart).Seconds()
+	s.windowStart = now
+
+	stats := make([]*storage.GossipStatsUpdate, 0, len(s.guardians))
+	for addr, g := range s.guardians {
+		var perSecond float64
+		if window > 0 {
+			perSecond = float64(g.observations) / window
+		}
+		stats = append(stats, &storage.GossipStatsUpdate{
+			GuardianAddr:          addr,
+			NodeName:              g.nodeName,
+			P2pNodeID:             g.p2pNodeID,
+			WindowSeconds:         window,
+			Observations:          g.observations,
+			ObservationsPerSecond: perSecond,
+			Heartbeats:            g.heartbeats,
+			LastObservationAt:     g.lastObservationAt,
+			LastHeartbeatAt:       g.lastHeartbeatAt,
+		})
+		g.observations = 0
+		g.heartbeats = 0
+	}
+	return stats
+}
@@ -0,0 +1,38 @@
+package gossip
+
+import (
+	"testing"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestStatsFlush(t *testing.T) {
+	addr := eth_common.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5")
+
+	s := NewStats(time.Minute, nil, zaptest.NewLogger(t))
+	s.windowStart = time.Now().Add(-10 * time.Second)
+	for i := 0; i < 20; i++ {
+		s.RecordObservation(&gossipv1.SignedObservation{Addr: addr.Bytes()})
+	}
+	s.RecordHeartbeat(&gossipv1.Heartbeat{GuardianAddr: addr.Hex(), NodeName: "Certus One"})
+
+	stats := s.flush()
+	assert.Len(t, stats, 1)
+	assert.Equal(t, "0x58cc3ae5c097b213ce3c81979e1b9f9570746aa5", stats[0].GuardianAddr)
+	assert.Equal(t, "Certus One", stats[0].NodeName)
+	assert.Equal(t, uint64(20), stats[0].Observations)
+	assert.Equal(t, uint64(1), stats[0].Heartbeats)
+	assert.InDelta(t, 2.0, stats[0].ObservationsPerSecond, 0.1)
+	assert.NotNil(t, stats[0].LastObservationAt)
+
+	// the counters are reset, the identity of the guardian is kept.
+	stats = s.flush()
+	assert.Len(t, stats, 1)
+	assert.Equal(t, "Certus One", stats[0].NodeName)
+	assert.Equal(t, uint64(0), stats[0].Observations)
+	assert.NotNil(t, stats[0].LastHeartbeatAt)
+}
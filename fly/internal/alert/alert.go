@@ -20,6 +20,7 @@ const (
 	// warning alerts
 	GuardianSetUnknown       = "GUARDIAN_SET_UNKNOWN"
 	ObservationWithoutTxHash = "OBSERVATION_WITHOUT_TX_HASH"
+	VaaDigestConflict        = "VAA_DIGEST_CONFLICT"
 )
 
 func LoadAlerts(cfg alert.AlertConfig) map[string]alert.Alert {
@@ -92,6 +93,15 @@ func LoadAlerts(cfg alert.AlertConfig) map[string]alert.Alert {
 		Entity:      "fly",
 		Priority:    alert.INFORMATIONAL,
 	}
+	alerts[VaaDigestConflict] = alert.Alert{
+		Alias:       VaaDigestConflict,
+		Message:     fmt.Sprintf("[%s] %s", cfg.Environment, "VAA digest conflict"),
+		Description: "Two vaas with the same chain, emitter and sequence and a different digest were received.",
+		Actions:     []string{"check the conflicting vaas in duplicateVaas collection"},
+		Tags:        []string{cfg.Environment, "fly", "duplicateVaas", "vaa"},
+		Entity:      "fly",
+		Priority:    alert.CRITICAL,
+	}
 	alerts[ErrorGuardianNoActivity] = alert.Alert{
 		Alias:       ErrorGuardianNoActivity,
 		Message:     fmt.Sprintf("[%s] %s", cfg.Environment, "Guardian no activity from gossip network"),
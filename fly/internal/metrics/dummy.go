@@ -86,4 +86,16 @@ func (m *DummyMetrics) IncConsistencyLevelByChainID(chainID sdk.ChainID, consist
 
 func (m *DummyMetrics) IncDuplicateVaaByChainID(chain sdk.ChainID) {}
 
+func (m *DummyMetrics) IncVaaDigestConflictByChainID(chain sdk.ChainID) {}
+
 func (m *DummyMetrics) VaaProcessingDuration(chain sdk.ChainID, start *time.Time) {}
+
+func (m *DummyMetrics) IncObservationBatchDeduplicated(chain sdk.ChainID) {}
+
+func (m *DummyMetrics) AddObservationBatchWritten(size int, success bool) {}
+
+func (m *DummyMetrics) ObservationBatchDuration(start *time.Time) {}
+
+func (m *DummyMetrics) IncObservationNotPersisted(chain sdk.ChainID) {}
+
+func (m *DummyMetrics) IncVaaInvalid(chain sdk.ChainID) {}
@@ -16,6 +16,7 @@ type Metrics interface {
 	IncVaaInserted(chain sdk.ChainID)
 	IncVaaSendNotification(chain sdk.ChainID)
 	IncVaaTotal()
+	IncVaaInvalid(chain sdk.ChainID)
 
 	// observation metrics
 	IncObservationFromGossipNetwork(chain sdk.ChainID)
@@ -27,6 +28,12 @@ type Metrics interface {
 	IncObservationBadSigner(address string)
 	IncObservationValid(address string)
 
+	// observation batch metrics
+	IncObservationBatchDeduplicated(chain sdk.ChainID)
+	AddObservationBatchWritten(size int, success bool)
+	ObservationBatchDuration(start *time.Time)
+	IncObservationNotPersisted(chain sdk.ChainID)
+
 	// heartbeat metrics
 	IncHeartbeatFromGossipNetwork(guardianName string)
 	IncHeartbeatInserted(guardianName string)
@@ -51,6 +58,7 @@ type Metrics interface {
 
 	// duplicate vaa metrics
 	IncDuplicateVaaByChainID(chain sdk.ChainID)
+	IncVaaDigestConflictByChainID(chain sdk.ChainID)
 
 	// vaas processing duration
 	VaaProcessingDuration(chain sdk.ChainID, start *time.Time)
@@ -23,7 +23,13 @@ type PrometheusMetrics struct {
 	txHashSearchCount             *prometheus.CounterVec
 	consistenceLevelChainCount    *prometheus.CounterVec
 	duplicateVaaByChainCount      *prometheus.CounterVec
+	vaaDigestConflictByChainCount *prometheus.CounterVec
 	vaaProcessingDuration         *prometheus.HistogramVec
+	observationBatchDedupCount    *prometheus.CounterVec
+	observationBatchWrittenCount  *prometheus.CounterVec
+	observationBatchDuration      prometheus.Histogram
+	observationNotPersistedCount  *prometheus.CounterVec
+	vaaInvalidCount               *prometheus.CounterVec
 }
 
 // NewPrometheusMetrics returns a new instance of PrometheusMetrics.
@@ -111,6 +117,12 @@ func NewPrometheusMetrics(environment string) *PrometheusMetrics {
 			Help:        "Total number of duplicate vaa by chain",
 			ConstLabels: constLabels,
 		}, []string{"chain"})
+	vaaDigestConflictByChainCount := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:        "vaa_digest_conflict_count_by_chain",
+			Help:        "Total number of vaa with the same id and a different digest by chain",
+			ConstLabels: constLabels,
+		}, []string{"chain"})
 	vaaProcessingDuration := promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:        "vaa_processing_duration_seconds",
@@ -120,6 +132,37 @@ func NewPrometheusMetrics(environment string) *PrometheusMetrics {
 		},
 		[]string{"chain"},
 	)
+	observationBatchDedupCount := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:        "observation_batch_deduplicated_count_by_chain",
+			Help:        "Total number of identical observations discarded in a batch by chain",
+			ConstLabels: constLabels,
+		}, []string{"chain"})
+	observationBatchWrittenCount := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:        "observation_batch_written_count",
+			Help:        "Total number of observations written in batches by status",
+			ConstLabels: constLabels,
+		}, []string{"status"})
+	observationBatchDuration := promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:        "observation_batch_duration_seconds",
+			Help:        "Duration of the bulk write of a batch of observations.",
+			ConstLabels: constLabels,
+			Buckets:     []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		})
+	observationNotPersistedCount := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:        "observation_not_persisted_count_by_chain",
+			Help:        "Total number of observations discarded by the persist mode by chain",
+			ConstLabels: constLabels,
+		}, []string{"chain"})
+	vaaInvalidCount := promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:        "vaa_invalid_count_by_chain",
+			Help:        "Total number of vaa with invalid signatures stored in invalidVaas by chain",
+			ConstLabels: constLabels,
+		}, []string{"chain"})
 	return &PrometheusMetrics{
 		vaaReceivedCount:              vaaReceivedCount,
 		vaaTotal:                      vaaTotal,
@@ -133,7 +176,13 @@ func NewPrometheusMetrics(environment string) *PrometheusMetrics {
 		observationReceivedByGuardian: observationReceivedByGuardian,
 		consistenceLevelChainCount:    consistenceLevelChainCount,
 		duplicateVaaByChainCount:      duplicateVaaByChainCount,
+		vaaDigestConflictByChainCount: vaaDigestConflictByChainCount,
 		vaaProcessingDuration:         vaaProcessingDuration,
+		observationBatchDedupCount:    observationBatchDedupCount,
+		observationBatchWrittenCount:  observationBatchWrittenCount,
+		observationBatchDuration:      observationBatchDuration,
+		observationNotPersistedCount:  observationNotPersistedCount,
+		vaaInvalidCount:               vaaInvalidCount,
 	}
 }
 
@@ -260,6 +309,11 @@ func (m *PrometheusMetrics) IncDuplicateVaaByChainID(chain sdk.ChainID) {
 	m.duplicateVaaByChainCount.WithLabelValues(chain.String()).Inc()
 }
 
+// IncVaaDigestConflictByChainID increases the number of vaa digest conflicts by chain.
+func (m *PrometheusMetrics) IncVaaDigestConflictByChainID(chain sdk.ChainID) {
+	m.vaaDigestConflictByChainCount.WithLabelValues(chain.String()).Inc()
+}
+
 // VaaProcessingDuration increases the duration of vaa processing.
 func (m *PrometheusMetrics) VaaProcessingDuration(chain sdk.ChainID, start *time.Time) {
 	if start == nil {
@@ -268,3 +322,36 @@ func (m *PrometheusMetrics) VaaProcessingDuration(chain sdk.ChainID, start *time
 	elapsed := float64(time.Since(*start).Nanoseconds()) / 1e9
 	m.vaaProcessingDuration.WithLabelValues(chain.String()).Observe(elapsed)
 }
+
+// IncObservationBatchDeduplicated increases the number of identical observations discarded in a batch.
+func (m *PrometheusMetrics) IncObservationBatchDeduplicated(chain sdk.ChainID) {
+	m.observationBatchDedupCount.WithLabelValues(chain.String()).Inc()
+}
+
+// AddObservationBatchWritten adds the number of observations written in a batch.
+func (m *PrometheusMetrics) AddObservationBatchWritten(size int, success bool) {
+	status := "succeeded"
+	if !success {
+		status = "failed"
+	}
+	m.observationBatchWrittenCount.WithLabelValues(status).Add(float64(size))
+}
+
+// ObservationBatchDuration observes the duration of the bulk write of a batch of observations.
+func (m *PrometheusMetrics) ObservationBatchDuration(start *time.Time) {
+	if start == nil {
+		return
+	}
+	elapsed := float64(time.Since(*start).Nanoseconds()) / 1e9
+	m.observationBatchDuration.Observe(elapsed)
+}
+
+// IncObservationNotPersisted increases the number of observations discarded by the persist mode.
+func (m *PrometheusMetrics) IncObservationNotPersisted(chain sdk.ChainID) {
+	m.observationNotPersistedCount.WithLabelValues(chain.String()).Inc()
+}
+
+// IncVaaInvalid increases the number of vaa with invalid signatures.
+func (m *PrometheusMetrics) IncVaaInvalid(chain sdk.ChainID) {
+	m.vaaInvalidCount.WithLabelValues(chain.String()).Inc()
+}
@@ -130,14 +130,25 @@ func main() {
 	guardianCheck := health.NewGuardianCheck(cfg.MaxHealthTimeSeconds)
 
 	healthObservations, observationQueueConsume, observationPublish := builder.NewObservationConsumePublish(rootCtx, cfg, logger)
+	observationFilter, err := storage.NewObservationFilter(cfg.ObservationsPersistMode, cfg.ObservationsPersistFirstN, cfg.ObservationsSamplePercent)
+	if err != nil {
+		logger.Fatal("could not create observation filter", zap.Error(err))
+	}
+	observationWriter := storage.NewObservationWriter(repository, cfg.ObservationsBatchSize,
+		time.Duration(cfg.ObservationsFlushInterval)*time.Millisecond, observationFilter, metrics, logger)
+	observationWriter.Start(rootCtx)
 	observationGossipConsumer := processor.NewObservationGossipConsumer(observationPublish, gst, p2pNetworkConfig.Enviroment,
-		cfg.ObservationsChannelSize, cfg.ObservationsWorkersSize, metrics, txHashStore, repository, logger)
-	observationQueueConsumer := processor.NewObservationQueueConsumer(observationQueueConsume, repository, metrics, logger)
+		cfg.ObservationsChannelSize, cfg.ObservationsWorkersSize, metrics, txHashStore, observationWriter, logger)
+	observationQueueConsumer := processor.NewObservationQueueConsumer(observationQueueConsume, observationWriter, metrics, logger)
 	observationGossipConsumer.Start(rootCtx)
 	observationQueueConsumer.Start(rootCtx)
 
+	// Gossip stats by guardian
+	gossipStats := gossip.NewStats(time.Duration(cfg.GossipStatsInterval)*time.Second, repository, logger)
+	gossipStats.Start(rootCtx)
+
 	// Log observations
-	observationHandler := gossip.NewObservationHandler(channels.ObsvChannel, observationGossipConsumer.Push, guardianCheck, metrics)
+	observationHandler := gossip.NewObservationHandler(channels.ObsvChannel, observationGossipConsumer.Push, guardianCheck, gossipStats, metrics)
 	observationHandler.Start(rootCtx)
 
 	// Log signed VAAs
@@ -149,12 +160,24 @@ func main() {
 	// When recive a message, the message filter by deduplicator
 	// if VAA is from pyhnet should be saved directly to repository
 	// if VAA is from non pyhnet should be publish with nonPythVaaPublish
-	vaaGossipConsumer := processor.NewVAAGossipConsumer(guardianSetHistory, vaaNonPythDedup, vaaPythDedup, nonPythVaaPublish, repository.UpsertVaa, metrics, repository, logger)
+	// If the signature verification is enabled, the VAAs are verified before storing them
+	// and the invalid VAAs are stored in the invalidVaas collection
+	var vaaVerifyFunc processor.VAAVerifyFunc
+	var vaaQuarantineFunc processor.VAAQuarantineFunc
+	if cfg.VaasVerifySignatures {
+		vaaVerifyFunc = guardianSetHistory.Verify
+		vaaQuarantineFunc = repository.UpsertInvalidVaa
+	}
+	vaaGossipConsumer := processor.NewVAAGossipConsumer(guardianSetHistory, vaaNonPythDedup, vaaPythDedup, nonPythVaaPublish, repository.UpsertVaa, metrics, repository, vaaQuarantineFunc, logger)
 	// Creates a instance to consume VAA messages (non pyth) from a queue and store in a storage
-	vaaQueueConsumer := processor.NewVAAQueueConsumer(vaaQueueConsume, repository, notifierFunc, metrics, logger)
+	vaaQueueConsumer := processor.NewVAAQueueConsumer(vaaQueueConsume, repository, notifierFunc, vaaVerifyFunc, vaaQuarantineFunc, metrics, logger)
 	// Creates a wrapper that splits the incoming VAAs into 2 channels (pyth to non pyth) in order
-	// to be able to process them in a differentiated way
-	vaaGossipConsumerSplitter := processor.NewVAAGossipSplitterConsumer(vaaGossipConsumer.Push, cfg.VaasWorkersSize, logger, processor.WithSize(cfg.VaasChannelSize))
+	// to be able to process them in a differentiated way, so the pyth price updates never delay the transfers
+	vaaGossipConsumerSplitter := processor.NewVAAGossipSplitterConsumer(vaaGossipConsumer.Push, cfg.VaasWorkersSize, logger,
+		processor.WithSize(cfg.VaasChannelSize),
+		processor.WithNonPythWorkers(cfg.VaasNonPythWorkersSize),
+		processor.WithNonPythWeight(cfg.VaasNonPythWeight),
+		processor.WithPythSampling(cfg.VaasPythSampling))
 	vaaQueueConsumer.Start(rootCtx)
 	vaaGossipConsumerSplitter.Start(rootCtx)
 
@@ -169,7 +192,9 @@ func main() {
 	vaaHandler.Start(rootCtx)
 
 	// Heartbeats handler
-	hearbeatsHandler := gossip.NewHeartbeatsHandler(channels.HeartbeatChannel, repository, guardianCheck, metrics, logger)
+	heartbeatGaps := gossip.NewHeartbeatGaps(time.Duration(cfg.HeartbeatGapThreshold)*time.Second, producerFunc, logger)
+	heartbeatGaps.Start(rootCtx)
+	hearbeatsHandler := gossip.NewHeartbeatsHandler(channels.HeartbeatChannel, repository, guardianCheck, gossipStats, heartbeatGaps, metrics, logger)
 	hearbeatsHandler.Start(rootCtx)
 
 	// Governor config handler
@@ -30,6 +30,18 @@ func Run(db *mongo.Database) error {
 		return err
 	}
 
+	// Created invalidVaas collection.
+	err = db.CreateCollection(context.TODO(), "invalidVaas")
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// Created gossipStats collection.
+	err = db.CreateCollection(context.TODO(), "gossipStats")
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
 	// Created observations collection.
 	err = db.CreateCollection(context.TODO(), "observations")
 	if err != nil && isNotAlreadyExistsError(err) {
@@ -105,6 +117,16 @@ func Run(db *mongo.Database) error {
 		return err
 	}
 
+	indexVaaByIndexedAtId := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "indexedAt", Value: 1},
+			{Key: "_id", Value: 1},
+		}}
+	_, err = db.Collection("vaas").Indexes().CreateOne(context.TODO(), indexVaaByIndexedAtId)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
 	indexVaaByTxHash := mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "txHash", Value: 1},
@@ -114,6 +136,13 @@ func Run(db *mongo.Database) error {
 		return err
 	}
 
+	// create index in vaas collection by digest, used to link the redeems found by digest.
+	indexVaaByDigest := mongo.IndexModel{Keys: bson.D{{Key: "digest", Value: 1}}}
+	_, err = db.Collection("vaas").Indexes().CreateOne(context.TODO(), indexVaaByDigest)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
 	// create index in observations collection by indexedAt.
 	indexObservationsByIndexedAt := mongo.IndexModel{Keys: bson.D{{Key: "indexedAt", Value: 1}}}
 	_, err = db.Collection(repository.Observations).Indexes().CreateOne(context.TODO(), indexObservationsByIndexedAt)
@@ -282,6 +311,50 @@ func Run(db *mongo.Database) error {
 		return err
 	}
 
+	// create index for duplicateVaas by conflict and timestamp
+	indexDuplicateVaasByConflict := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conflict", Value: 1},
+			{Key: "timestamp", Value: -1},
+		},
+	}
+	_, err = db.Collection(repository.DuplicateVaas).Indexes().CreateOne(context.TODO(), indexDuplicateVaasByConflict)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index for invalidVaas by vaaId
+	indexInvalidVaasByVaaID := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "vaaId", Value: -1},
+		},
+	}
+	_, err = db.Collection("invalidVaas").Indexes().CreateOne(context.TODO(), indexInvalidVaasByVaaID)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create indexes in governorConfig and governorStatus collections by guardian node name.
+	indexGovernorConfigByNodeName := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "parsedConfig.nodename", Value: 1},
+			{Key: "_id", Value: 1},
+		}}
+	_, err = db.Collection("governorConfig").Indexes().CreateOne(context.TODO(), indexGovernorConfigByNodeName)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	indexGovernorStatusByNodeName := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "parsedStatus.nodename", Value: 1},
+			{Key: "_id", Value: 1},
+		}}
+	_, err = db.Collection("governorStatus").Indexes().CreateOne(context.TODO(), indexGovernorStatusByNodeName)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
 	// create index in nodeGovernorVaas collection by vaaId.
 	indexNodeGovernorVaasByVaaId := mongo.IndexModel{
 		Keys: bson.D{{Key: "vaaId", Value: 1}}}
@@ -27,7 +27,7 @@ type observationGossipConsumer struct {
 	metrics            metrics.Metrics
 	wgBlock            sync.WaitGroup
 	txHashStore        txhash.TxHashStore
-	repository         *storage.Repository
+	observationWriter  *storage.ObservationWriter
 	logger             *zap.Logger
 }
 
@@ -40,7 +40,7 @@ func NewObservationGossipConsumer(
 	workerSize int,
 	metrics metrics.Metrics,
 	txHashStore txhash.TxHashStore,
-	repository *storage.Repository,
+	observationWriter *storage.ObservationWriter,
 	logger *zap.Logger,
 ) *observationGossipConsumer {
 	return &observationGossipConsumer{
@@ -50,7 +50,7 @@ func NewObservationGossipConsumer(
 		workerSize:         workerSize,
 		metrics:            metrics,
 		txHashStore:        txHashStore,
-		repository:         repository,
+		observationWriter:  observationWriter,
 		logger:             logger,
 		signedObsCh:        make(chan *gossipv1.SignedObservation, channelSize),
 	}
@@ -122,10 +122,11 @@ func (c *observationGossipConsumer) process(ctx context.Context, o *gossipv1.Sig
 		if err != nil {
 			c.logger.Error("Error processing observation", zap.String("id", o.MessageId), zap.Error(err))
 			// This is the fallback to store the observation in the repository.
-			err = consumer.repository.UpsertObservation(ctx, obs, false)
-			if err != nil {
-				consumer.logger.Error("Error inserting observation in repository", zap.String("id", o.MessageId), zap.Error(err))
-			}
+			consumer.observationWriter.Write(ctx, obs, false, func(err error) {
+				if err != nil {
+					consumer.logger.Error("Error inserting observation in repository", zap.String("id", obs.MessageId), zap.Error(err))
+				}
+			})
 		}
 	}(c, ctx, o)
 
@@ -11,23 +11,23 @@ import (
 
 // ObservationQueueConsumer represents a observation queue consumer.
 type ObservationQueueConsumer struct {
-	consume    ObservationQueueConsumeFunc
-	repository *storage.Repository
-	metrics    metrics.Metrics
-	logger     *zap.Logger
+	consume           ObservationQueueConsumeFunc
+	observationWriter *storage.ObservationWriter
+	metrics           metrics.Metrics
+	logger            *zap.Logger
 }
 
 // ObservationQueueConsumer creates a new observation queue consumer instances.
 func NewObservationQueueConsumer(
 	consume ObservationQueueConsumeFunc,
-	repository *storage.Repository,
+	observationWriter *storage.ObservationWriter,
 	metrics metrics.Metrics,
 	logger *zap.Logger) *ObservationQueueConsumer {
 	return &ObservationQueueConsumer{
-		consume:    consume,
-		repository: repository,
-		metrics:    metrics,
-		logger:     logger,
+		consume:           consume,
+		observationWriter: observationWriter,
+		metrics:           metrics,
+		logger:            logger,
 	}
 }
 
@@ -44,14 +44,17 @@ func (c *ObservationQueueConsumer) Start(ctx context.Context) {
 				msg.Failed()
 				continue
 			}
-			err := c.repository.UpsertObservation(ctx, obs, true)
-			if err != nil {
-				log.Error("Error inserting observation in repository", zap.Error(err))
-				msg.Failed()
-				continue
-			}
-			msg.Done(ctx)
-			c.logger.Info("Observation saved in repository")
+			// the message is acknowledged once the batch with the observation is stored.
+			msg := msg
+			c.observationWriter.Write(ctx, obs, true, func(err error) {
+				if err != nil {
+					log.Error("Error inserting observation in repository", zap.Error(err))
+					msg.Failed()
+					return
+				}
+				msg.Done(ctx)
+				log.Info("Observation saved in repository")
+			})
 		}
 	}()
 }
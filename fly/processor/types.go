@@ -14,6 +14,12 @@ type VAAPushFunc func(context.Context, *vaa.VAA, []byte) error
 // VAANotifyFunc is a function to notify saved VAA message.
 type VAANotifyFunc func(context.Context, *vaa.VAA, []byte) error
 
+// VAAVerifyFunc is a function to verify the guardian signatures of a VAA.
+type VAAVerifyFunc func(context.Context, *vaa.VAA) error
+
+// VAAQuarantineFunc is a function to store an invalid VAA with the reason it was rejected.
+type VAAQuarantineFunc func(ctx context.Context, v *vaa.VAA, serializedVaa []byte, reason string) error
+
 // VAAQueueConsumeFunc is a function to obtain messages from a queue
 type VAAQueueConsumeFunc func(context.Context) <-chan queue.Message[[]byte]
 
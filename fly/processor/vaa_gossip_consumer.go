@@ -23,6 +23,7 @@ type vaaGossipConsumer struct {
 	pythDedup          *deduplicator.Deduplicator
 	metrics            metrics.Metrics
 	repository         *storage.Repository
+	quarantineFunc     VAAQuarantineFunc
 }
 
 // NewVAAGossipConsumer creates a new processor instances.
@@ -34,6 +35,7 @@ func NewVAAGossipConsumer(
 	pythPublish VAAPushFunc,
 	metrics metrics.Metrics,
 	repository *storage.Repository,
+	quarantineFunc VAAQuarantineFunc,
 	logger *zap.Logger,
 ) *vaaGossipConsumer {
 
@@ -45,6 +47,7 @@ func NewVAAGossipConsumer(
 		pythProcess:        pythPublish,
 		metrics:            metrics,
 		repository:         repository,
+		quarantineFunc:     quarantineFunc,
 		logger:             logger,
 	}
 }
@@ -55,6 +58,11 @@ func (p *vaaGossipConsumer) Push(ctx context.Context, v *vaa.VAA, serializedVaa
 	uniqueVaaID := domain.CreateUniqueVaaID(v)
 	if err := p.guardianSetHistory.Verify(ctx, v); err != nil {
 		p.logger.Error("Received invalid vaa", zap.String("id", uniqueVaaID))
+		if p.quarantineFunc != nil && vaa.ChainIDPythNet != v.EmitterChain {
+			if qErr := p.quarantineFunc(ctx, v, serializedVaa, err.Error()); qErr != nil {
+				p.logger.Error("Error inserting invalid vaa in repository", zap.String("id", uniqueVaaID), zap.Error(qErr))
+			}
+		}
 		return err
 	}
 
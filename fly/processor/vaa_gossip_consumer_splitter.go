@@ -3,6 +3,7 @@ package processor
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -12,14 +13,21 @@ import (
 type VAAGossipConsumerSplitterOption func(*VAAGossipConsumerSplitter)
 
 // VAAGossipConsumerSplitter represents a vaa message splitter.
+//
+// The pyth price updates dominate the gossip traffic, so the non pyth messages have their own workers,
+// and the shared workers take up to nonPythWeight non pyth messages for every pyth message.
 type VAAGossipConsumerSplitter struct {
-	push       VAAPushFunc
-	pythCh     chan *sppliterMessage
-	nonPythCh  chan *sppliterMessage
-	logger     *zap.Logger
-	workerSize int
-	wgBlock    sync.WaitGroup
-	size       int
+	push           VAAPushFunc
+	pythCh         chan *sppliterMessage
+	nonPythCh      chan *sppliterMessage
+	logger         *zap.Logger
+	workerSize     int
+	nonPythWorkers int
+	nonPythWeight  int
+	pythSampling   uint64
+	pythCount      atomic.Uint64
+	wgBlock        sync.WaitGroup
+	size           int
 }
 
 type sppliterMessage struct {
@@ -34,10 +42,13 @@ func NewVAAGossipSplitterConsumer(
 	logger *zap.Logger,
 	opts ...VAAGossipConsumerSplitterOption) *VAAGossipConsumerSplitter {
 	v := &VAAGossipConsumerSplitter{
-		push:       publish,
-		logger:     logger,
-		workerSize: workerSize,
-		size:       50,
+		push:           publish,
+		logger:         logger,
+		workerSize:     workerSize,
+		nonPythWorkers: 1,
+		nonPythWeight:  1,
+		pythSampling:   1,
+		size:           50,
 	}
 	for _, opt := range opts {
 		opt(v)
@@ -54,6 +65,34 @@ func WithSize(v int) VAAGossipConsumerSplitterOption {
 	}
 }
 
+// WithNonPythWorkers allows to specify the number of workers dedicated to the non pyth messages.
+func WithNonPythWorkers(v int) VAAGossipConsumerSplitterOption {
+	return func(i *VAAGossipConsumerSplitter) {
+		if v > 0 {
+			i.nonPythWorkers = v
+		}
+	}
+}
+
+// WithNonPythWeight allows to specify how many non pyth messages the shared workers take
+// for every pyth message while both kinds of messages are pending.
+func WithNonPythWeight(v int) VAAGossipConsumerSplitterOption {
+	return func(i *VAAGossipConsumerSplitter) {
+		if v > 0 {
+			i.nonPythWeight = v
+		}
+	}
+}
+
+// WithPythSampling allows to specify a sampling of the pyth messages: only one out of v is processed.
+func WithPythSampling(v int) VAAGossipConsumerSplitterOption {
+	return func(i *VAAGossipConsumerSplitter) {
+		if v > 0 {
+			i.pythSampling = uint64(v)
+		}
+	}
+}
+
 // Push splits vaa message on different channels depending on whether it is a pyth or non pyth.
 func (p *VAAGossipConsumerSplitter) Push(ctx context.Context, v *vaa.VAA, serializedVaa []byte) error {
 	msg := &sppliterMessage{
@@ -61,6 +100,10 @@ func (p *VAAGossipConsumerSplitter) Push(ctx context.Context, v *vaa.VAA, serial
 		data:  serializedVaa,
 	}
 	if vaa.ChainIDPythNet == v.EmitterChain {
+		// drop the pyth messages out of the sampling.
+		if p.pythSampling > 1 && (p.pythCount.Add(1)-1)%p.pythSampling != 0 {
+			return nil
+		}
 		//if the pyth channel is full, deletes the oldest message and sends the new message
 		select {
 		case p.pythCh <- msg:
@@ -77,13 +120,16 @@ func (p *VAAGossipConsumerSplitter) Push(ctx context.Context, v *vaa.VAA, serial
 	return nil
 }
 
-// Start runs two go routine to process messages for both channels.
+// Start runs the workers dedicated to the non pyth messages and the workers shared by both channels.
 func (p *VAAGossipConsumerSplitter) Start(ctx context.Context) {
 	for i := 0; i < p.workerSize; i++ {
 		p.wgBlock.Add(1)
-		go p.executePyth(ctx)
+		go p.executeWeighted(ctx)
+	}
+	for i := 0; i < p.nonPythWorkers; i++ {
+		p.wgBlock.Add(1)
+		go p.executeNonPyth(ctx)
 	}
-	go p.executeNonPyth(ctx)
 }
 
 // Close closes all consumer resources.
@@ -93,22 +139,66 @@ func (p *VAAGossipConsumerSplitter) Close() {
 	p.wgBlock.Wait()
 }
 
-func (p *VAAGossipConsumerSplitter) executePyth(ctx context.Context) {
+// executeWeighted processes the messages of both channels, taking up to nonPythWeight
+// non pyth messages before a pyth message when both channels have pending messages.
+func (p *VAAGossipConsumerSplitter) executeWeighted(ctx context.Context) {
 	defer p.wgBlock.Done()
-	for {
+	pythCh, nonPythCh := p.pythCh, p.nonPythCh
+	nonPythServed := 0
+	for pythCh != nil || nonPythCh != nil {
+		// take the pending non pyth messages first, until the weight is reached.
+		if nonPythCh != nil && nonPythServed < p.nonPythWeight {
+			select {
+			case m, opened := <-nonPythCh:
+				if !opened {
+					nonPythCh = nil
+					continue
+				}
+				nonPythServed++
+				_ = p.push(ctx, m.value, m.data)
+				continue
+			default:
+			}
+		}
+
+		// then give its turn to a pending pyth message.
+		if pythCh != nil && nonPythServed >= p.nonPythWeight {
+			select {
+			case m, opened := <-pythCh:
+				if !opened {
+					pythCh = nil
+					continue
+				}
+				nonPythServed = 0
+				_ = p.push(ctx, m.value, m.data)
+				continue
+			default:
+			}
+		}
+
 		select {
 		case <-ctx.Done():
 			return
-		case m, opened := <-p.pythCh:
+		case m, opened := <-nonPythCh:
 			if !opened {
-				return
+				nonPythCh = nil
+				continue
 			}
+			nonPythServed++
+			_ = p.push(ctx, m.value, m.data)
+		case m, opened := <-pythCh:
+			if !opened {
+				pythCh = nil
+				continue
+			}
+			nonPythServed = 0
 			_ = p.push(ctx, m.value, m.data)
 		}
 	}
 }
 
 func (p *VAAGossipConsumerSplitter) executeNonPyth(ctx context.Context) {
+	defer p.wgBlock.Done()
 	for {
 		select {
 		case <-ctx.Done():
@@ -2,6 +2,7 @@ package processor
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -53,3 +54,53 @@ func TestVAAGossipConsumerSplitter_PushNonPyth(t *testing.T) {
 	splitter.Close()
 	assert.Equal(t, 3, messagesProcessed)
 }
+
+func TestVAAGossipConsumerSplitter_PythSampling(t *testing.T) {
+	ctx := context.TODO()
+	var messagesProcessed atomic.Int32
+	pushFunc := func(_ context.Context, v *vaa.VAA, d []byte) error {
+		messagesProcessed.Add(1)
+		return nil
+	}
+	logger := zaptest.NewLogger(t)
+	splitter := NewVAAGossipSplitterConsumer(pushFunc, 1, logger, WithSize(10), WithPythSampling(3))
+	splitter.Start(ctx)
+
+	for i := 1; i <= 9; i++ {
+		splitter.Push(ctx, &vaa.VAA{EmitterChain: vaa.ChainIDPythNet, Sequence: uint64(i)}, nil)
+	}
+
+	time.Sleep(500 * time.Millisecond)
+	splitter.Close()
+	assert.Equal(t, int32(3), messagesProcessed.Load())
+}
+
+func TestVAAGossipConsumerSplitter_NonPythNotDelayedByPyth(t *testing.T) {
+	ctx := context.TODO()
+	nonPythProcessed := make(chan time.Time, 1)
+	pushFunc := func(_ context.Context, v *vaa.VAA, d []byte) error {
+		if v.EmitterChain == vaa.ChainIDPythNet {
+			time.Sleep(1 * time.Second)
+			return nil
+		}
+		nonPythProcessed <- time.Now()
+		return nil
+	}
+	logger := zaptest.NewLogger(t)
+	splitter := NewVAAGossipSplitterConsumer(pushFunc, 1, logger, WithSize(10))
+	splitter.Start(ctx)
+
+	splitter.Push(ctx, &vaa.VAA{EmitterChain: vaa.ChainIDPythNet, Sequence: 1}, nil)
+	splitter.Push(ctx, &vaa.VAA{EmitterChain: vaa.ChainIDPythNet, Sequence: 2}, nil)
+	time.Sleep(100 * time.Millisecond)
+	pushedAt := time.Now()
+	splitter.Push(ctx, &vaa.VAA{EmitterChain: vaa.ChainIDEthereum, Sequence: 1}, nil)
+
+	select {
+	case processedAt := <-nonPythProcessed:
+		assert.Less(t, processedAt.Sub(pushedAt), 500*time.Millisecond)
+	case <-time.After(3 * time.Second):
+		t.Fatal("non pyth message not processed")
+	}
+	splitter.Close()
+}
@@ -13,26 +13,34 @@ import (
 
 // VAAQueueConsumer represents a VAA queue consumer.
 type VAAQueueConsumer struct {
-	consume    VAAQueueConsumeFunc
-	repository *storage.Repository
-	notifyFunc VAANotifyFunc
-	metrics    metrics.Metrics
-	logger     *zap.Logger
+	consume        VAAQueueConsumeFunc
+	repository     *storage.Repository
+	notifyFunc     VAANotifyFunc
+	verifyFunc     VAAVerifyFunc
+	quarantineFunc VAAQuarantineFunc
+	metrics        metrics.Metrics
+	logger         *zap.Logger
 }
 
 // NewVAAQueueConsumer creates a new VAA queue consumer instances.
+// If verifyFunc is not nil the signatures of the VAAs are verified before storing them
+// and the invalid VAAs are stored with quarantineFunc.
 func NewVAAQueueConsumer(
 	consume VAAQueueConsumeFunc,
 	repository *storage.Repository,
 	notifyFunc VAANotifyFunc,
+	verifyFunc VAAVerifyFunc,
+	quarantineFunc VAAQuarantineFunc,
 	metrics metrics.Metrics,
 	logger *zap.Logger) *VAAQueueConsumer {
 	return &VAAQueueConsumer{
-		consume:    consume,
-		repository: repository,
-		notifyFunc: notifyFunc,
-		metrics:    metrics,
-		logger:     logger,
+		consume:        consume,
+		repository:     repository,
+		notifyFunc:     notifyFunc,
+		verifyFunc:     verifyFunc,
+		quarantineFunc: quarantineFunc,
+		metrics:        metrics,
+		logger:         logger,
 	}
 }
 
@@ -55,6 +63,21 @@ func (c *VAAQueueConsumer) Start(ctx context.Context) {
 
 			c.metrics.IncVaaConsumedFromQueue(v.EmitterChain)
 
+			if c.verifyFunc != nil {
+				if err := c.verifyFunc(ctx, v); err != nil {
+					c.logger.Error("Received vaa with invalid signatures", zap.String("id", v.MessageID()), zap.Error(err))
+					if c.quarantineFunc != nil {
+						if err := c.quarantineFunc(ctx, v, msg.Data(), err.Error()); err != nil {
+							msg.Failed()
+							continue
+						}
+					}
+					// the invalid vaa is not retried.
+					msg.Done(ctx)
+					continue
+				}
+			}
+
 			c.metrics.IncConsistencyLevelByChainID(v.EmitterChain, v.ConsistencyLevel)
 
 			if v.EmitterChain != sdk.ChainIDPythNet && domain.ConsistencyLevelIsImmediately(v) {
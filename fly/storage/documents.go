@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -13,19 +14,20 @@ type IndexingTimestamps struct {
 }
 
 type VaaUpdate struct {
-	ID               string      `bson:"_id"`
-	Version          uint8       `bson:"version"`
-	EmitterChain     vaa.ChainID `bson:"emitterChain"`
-	EmitterAddr      string      `bson:"emitterAddr"`
-	Sequence         string      `bson:"sequence"`
-	GuardianSetIndex uint32      `bson:"guardianSetIndex"`
-	Vaa              []byte      `bson:"vaas"`
-	TxHash           string      `bson:"txHash,omitempty"`
-	OriginTxHash     *string     `bson:"_originTxHash,omitempty"` //this is temporary field for fix enconding txHash
-	Timestamp        *time.Time  `bson:"timestamp"`
-	UpdatedAt        *time.Time  `bson:"updatedAt"`
-	Digest           string      `bson:"digest"`
-	IsDuplicated     bool        `bson:"isDuplicated"`
+	ID               string                     `bson:"_id"`
+	Version          uint8                      `bson:"version"`
+	EmitterChain     vaa.ChainID                `bson:"emitterChain"`
+	EmitterAddr      string                     `bson:"emitterAddr"`
+	Sequence         string                     `bson:"sequence"`
+	GuardianSetIndex uint32                     `bson:"guardianSetIndex"`
+	SignersBitmap    uint64                     `bson:"signersBitmap"`
+	Vaa              repository.CompressedBytes `bson:"vaas"`
+	TxHash           string                     `bson:"txHash,omitempty"`
+	OriginTxHash     *string                    `bson:"_originTxHash,omitempty"` //this is temporary field for fix enconding txHash
+	Timestamp        *time.Time                 `bson:"timestamp"`
+	UpdatedAt        *time.Time                 `bson:"updatedAt"`
+	Digest           string                     `bson:"digest"`
+	IsDuplicated     bool                       `bson:"isDuplicated"`
 }
 
 // ToMap returns a map representation of the VaaUpdate.
@@ -43,19 +45,21 @@ func (v *VaaUpdate) ToMap() map[string]string {
 }
 
 type DuplicateVaaUpdate struct {
-	ID               string      `bson:"_id"`
-	VaaID            string      `bson:"vaaId"`
-	Version          uint8       `bson:"version"`
-	EmitterChain     vaa.ChainID `bson:"emitterChain"`
-	EmitterAddr      string      `bson:"emitterAddr"`
-	Sequence         string      `bson:"sequence"`
-	GuardianSetIndex uint32      `bson:"guardianSetIndex"`
-	Vaa              []byte      `bson:"vaas"`
-	Digest           string      `bson:"digest"`
-	ConsistencyLevel uint8       `bson:"consistencyLevel"`
-	TxHash           string      `bson:"txHash,omitempty"`
-	Timestamp        *time.Time  `bson:"timestamp"`
-	UpdatedAt        *time.Time  `bson:"updatedAt"`
+	ID               string                     `bson:"_id"`
+	VaaID            string                     `bson:"vaaId"`
+	Version          uint8                      `bson:"version"`
+	EmitterChain     vaa.ChainID                `bson:"emitterChain"`
+	EmitterAddr      string                     `bson:"emitterAddr"`
+	Sequence         string                     `bson:"sequence"`
+	GuardianSetIndex uint32                     `bson:"guardianSetIndex"`
+	SignersBitmap    uint64                     `bson:"signersBitmap"`
+	Vaa              repository.CompressedBytes `bson:"vaas"`
+	Digest           string                     `bson:"digest"`
+	ConsistencyLevel uint8                      `bson:"consistencyLevel"`
+	TxHash           string                     `bson:"txHash,omitempty"`
+	Timestamp        *time.Time                 `bson:"timestamp"`
+	UpdatedAt        *time.Time                 `bson:"updatedAt"`
+	Conflict         bool                       `bson:"conflict,omitempty"`
 }
 
 // ToMap returns a map representation of the VaaUpdate.
@@ -75,6 +79,22 @@ func (v *DuplicateVaaUpdate) ToMap() map[string]string {
 	}
 }
 
+// InvalidVaaUpdate represents a vaa rejected by the signature verification.
+type InvalidVaaUpdate struct {
+	ID               string                     `bson:"_id"`
+	VaaID            string                     `bson:"vaaId"`
+	Version          uint8                      `bson:"version"`
+	EmitterChain     vaa.ChainID                `bson:"emitterChain"`
+	EmitterAddr      string                     `bson:"emitterAddr"`
+	Sequence         string                     `bson:"sequence"`
+	GuardianSetIndex uint32                     `bson:"guardianSetIndex"`
+	Vaa              repository.CompressedBytes `bson:"vaas"`
+	Digest           string                     `bson:"digest"`
+	Reason           string                     `bson:"reason"`
+	Timestamp        *time.Time                 `bson:"timestamp"`
+	UpdatedAt        *time.Time                 `bson:"updatedAt"`
+}
+
 type ObservationUpdate struct {
 	MessageID    string      `bson:"messageId"`
 	ChainID      vaa.ChainID `bson:"emitterChain"`
@@ -169,3 +189,16 @@ type ChainGovernorConfigToken struct {
 	OriginAddress string
 	Price         float32
 }
+
+// GossipStatsUpdate represents the gossip statistics of a guardian in a time window.
+type GossipStatsUpdate struct {
+	GuardianAddr          string     `bson:"guardianAddr"`
+	NodeName              string     `bson:"nodeName"`
+	P2pNodeID             string     `bson:"p2pNodeId"`
+	WindowSeconds         float64    `bson:"windowSeconds"`
+	Observations          uint64     `bson:"observations"`
+	ObservationsPerSecond float64    `bson:"observationsPerSecond"`
+	Heartbeats            uint64     `bson:"heartbeats"`
+	LastObservationAt     *time.Time `bson:"lastObservationAt"`
+	LastHeartbeatAt       *time.Time `bson:"lastHeartbeatAt"`
+}
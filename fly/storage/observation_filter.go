@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+)
+
+// observation persist modes.
+const (
+	// ObservationPersistAll stores all the observations.
+	ObservationPersistAll = "all"
+	// ObservationPersistFirst stores only the first N observations of each vaa, enough to reach quorum.
+	ObservationPersistFirst = "first"
+	// ObservationPersistSampled stores a percentage of the observations.
+	ObservationPersistSampled = "sampled"
+)
+
+// observationFirstExpiration is the time the guardians of a vaa are kept in the first mode.
+const observationFirstExpiration = time.Hour
+
+// ObservationFilter decides which observations are stored.
+type ObservationFilter struct {
+	mode          string
+	firstN        int
+	samplePercent int
+	mu            sync.Mutex
+	guardians     *gocache.Cache
+}
+
+// NewObservationFilter creates a new ObservationFilter.
+// firstN is used by the first mode and samplePercent by the sampled mode.
+func NewObservationFilter(mode string, firstN int, samplePercent int) (*ObservationFilter, error) {
+	f := &ObservationFilter{mode: mode, firstN: firstN, samplePercent: samplePercent}
+	switch mode {
+	case ObservationPersistAll:
+	case ObservationPersistFirst:
+		if firstN <= 0 {
+			return nil, fmt.Errorf("invalid number of observations to persist by vaa: %d", firstN)
+		}
+		f.guardians = gocache.New(observationFirstExpiration, 10*time.Minute)
+	case ObservationPersistSampled:
+		if samplePercent < 0 || samplePercent > 100 {
+			return nil, fmt.Errorf("invalid observations sample percent: %d", samplePercent)
+		}
+	default:
+		return nil, fmt.Errorf("invalid observations persist mode: %s", mode)
+	}
+	return f, nil
+}
+
+// Persist returns true if the observation must be stored.
+func (f *ObservationFilter) Persist(o *gossipv1.SignedObservation, id string) bool {
+	if f == nil {
+		return true
+	}
+	switch f.mode {
+	case ObservationPersistFirst:
+		return f.persistFirst(o)
+	case ObservationPersistSampled:
+		// the sample is based on the observation id so retries of an observation get the same result.
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(id))
+		return int(h.Sum32()%100) < f.samplePercent
+	default:
+		return true
+	}
+}
+
+// persistFirst keeps the guardians of the first observations of each vaa.
+// The guardians are tracked in memory, so each instance of fly stores its own first observations.
+func (f *ObservationFilter) persistFirst(o *gossipv1.SignedObservation) bool {
+	key := domain.CreateUniqueVaaIDByObservation(o)
+	guardian := string(o.GetAddr())
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var guardians []string
+	if v, ok := f.guardians.Get(key); ok {
+		guardians = v.([]string)
+	}
+	for _, g := range guardians {
+		if g == guardian {
+			return true
+		}
+	}
+	if len(guardians) >= f.firstN {
+		return false
+	}
+	f.guardians.SetDefault(key, append(guardians, guardian))
+	return true
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"testing"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservationFilterFirst(t *testing.T) {
+	f, err := NewObservationFilter(ObservationPersistFirst, 2, 0)
+	assert.NoError(t, err)
+
+	obs := func(guardian byte) *gossipv1.SignedObservation {
+		return &gossipv1.SignedObservation{MessageId: "2/abc/1", Hash: []byte{1}, Addr: []byte{guardian}}
+	}
+	assert.True(t, f.Persist(obs(1), "1"))
+	assert.True(t, f.Persist(obs(2), "2"))
+	assert.False(t, f.Persist(obs(3), "3"))
+	// a retry of a stored observation is stored again.
+	assert.True(t, f.Persist(obs(1), "1"))
+}
+
+func TestObservationFilterSampled(t *testing.T) {
+	none, err := NewObservationFilter(ObservationPersistSampled, 0, 0)
+	assert.NoError(t, err)
+	all, err := NewObservationFilter(ObservationPersistSampled, 0, 100)
+	assert.NoError(t, err)
+
+	o := &gossipv1.SignedObservation{MessageId: "2/abc/1"}
+	assert.False(t, none.Persist(o, "2/abc/1/01/02"))
+	assert.True(t, all.Persist(o, "2/abc/1/01/02"))
+}
+
+func TestNewObservationFilterInvalid(t *testing.T) {
+	_, err := NewObservationFilter("none", 0, 0)
+	assert.Error(t, err)
+	_, err = NewObservationFilter(ObservationPersistFirst, 0, 0)
+	assert.Error(t, err)
+	_, err = NewObservationFilter(ObservationPersistSampled, 0, 101)
+	assert.Error(t, err)
+}
@@ -0,0 +1,233 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// defaultObservationsFlushInterval is the flush interval of the ObservationWriter when it is not positive.
+const defaultObservationsFlushInterval = 500 * time.Millisecond
+
+// ErrObservationWriterStopped is returned to the observations written once the ObservationWriter is stopped.
+var ErrObservationWriterStopped = errors.New("observation writer stopped")
+
+// ObservationDoneFunc is called once the observation is stored, err is not nil when the observation could not be stored.
+type ObservationDoneFunc func(err error)
+
+type observationWrite struct {
+	id         string
+	obs        *gossipv1.SignedObservation
+	update     *ObservationUpdate
+	saveTxHash bool
+	done       []ObservationDoneFunc
+}
+
+// observationStore stores the observations of an ObservationWriter, it is implemented by the Repository.
+type observationStore interface {
+	newObservationUpdate(o *gossipv1.SignedObservation) (string, *ObservationUpdate, error)
+	saveObservationTxHash(ctx context.Context, o *gossipv1.SignedObservation, obs *ObservationUpdate) error
+	upsertObservations(ctx context.Context, writes []*observationWrite) error
+	afterObservationSaved(ctx context.Context, o *gossipv1.SignedObservation, obs *ObservationUpdate, saveTxHash bool) error
+}
+
+// ObservationWriter groups the observations in batches and stores each batch with a single bulk write.
+// A batch is stored when it reaches the batch size or when the flush interval elapses.
+type ObservationWriter struct {
+	repository    observationStore
+	batchSize     int
+	flushInterval time.Duration
+	filter        *ObservationFilter
+	ch            chan *observationWrite
+	metrics       metrics.Metrics
+	logger        *zap.Logger
+
+	// stopped is closed when the writer stops, the observations written after that are failed.
+	stopped chan struct{}
+	mu      sync.RWMutex
+	closed  bool
+}
+
+// NewObservationWriter creates a new ObservationWriter.
+// The observations discarded by the filter are not stored, a nil filter stores all the observations.
+// The batch size is 1 and the flush interval is 500ms when they are not positive.
+func NewObservationWriter(
+	repository *Repository,
+	batchSize int,
+	flushInterval time.Duration,
+	filter *ObservationFilter,
+	metrics metrics.Metrics,
+	logger *zap.Logger,
+) *ObservationWriter {
+	return newObservationWriter(repository, batchSize, flushInterval, filter, metrics, logger)
+}
+
+func newObservationWriter(
+	repository observationStore,
+	batchSize int,
+	flushInterval time.Duration,
+	filter *ObservationFilter,
+	metrics metrics.Metrics,
+	logger *zap.Logger,
+) *ObservationWriter {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	if flushInterval <= 0 {
+		flushInterval = defaultObservationsFlushInterval
+	}
+	return &ObservationWriter{
+		repository:    repository,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		filter:        filter,
+		ch:            make(chan *observationWrite, batchSize),
+		metrics:       metrics,
+		logger:        logger.With(zap.String("module", "ObservationWriter")),
+		stopped:       make(chan struct{}),
+	}
+}
+
+// Write adds the observation to the current batch. done is called once the batch is stored.
+// If saveTxHash is true the tx hash of the observation is saved after storing it.
+func (w *ObservationWriter) Write(ctx context.Context, o *gossipv1.SignedObservation, saveTxHash bool, done ObservationDoneFunc) {
+	id, update, err := w.repository.newObservationUpdate(o)
+	if err != nil || update == nil {
+		done(err)
+		return
+	}
+	if !w.filter.Persist(o, id) {
+		w.metrics.IncObservationNotPersisted(update.ChainID)
+		// the tx hash is still needed to resolve the tx hash of the vaa.
+		if saveTxHash {
+			err = w.repository.saveObservationTxHash(ctx, o, update)
+		}
+		done(err)
+		return
+	}
+	write := &observationWrite{
+		id:         id,
+		obs:        o,
+		update:     update,
+		saveTxHash: saveTxHash,
+		done:       []ObservationDoneFunc{done},
+	}
+
+	// the writer is not closed while the observation is sent, so it is either stored or drained on shutdown.
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		done(ErrObservationWriterStopped)
+		return
+	}
+	select {
+	case w.ch <- write:
+	case <-ctx.Done():
+		done(ctx.Err())
+	case <-w.stopped:
+		done(ErrObservationWriterStopped)
+	}
+}
+
+// Start stores the batches until the context is cancelled.
+// On shutdown the pending observations are failed so they can be retried by the queue.
+func (w *ObservationWriter) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.flushInterval)
+		defer ticker.Stop()
+		batch := newObservationBatch()
+		for {
+			select {
+			case <-ctx.Done():
+				for _, write := range batch.writes {
+					write.complete(ctx.Err())
+				}
+				w.stop(ctx.Err())
+				return
+			case write := <-w.ch:
+				if !batch.add(write) {
+					w.metrics.IncObservationBatchDeduplicated(write.update.ChainID)
+					continue
+				}
+				if len(batch.writes) >= w.batchSize {
+					w.flush(ctx, batch)
+					batch = newObservationBatch()
+				}
+			case <-ticker.C:
+				if len(batch.writes) > 0 {
+					w.flush(ctx, batch)
+					batch = newObservationBatch()
+				}
+			}
+		}
+	}()
+}
+
+// stop closes the writer and fails the observations written and not yet added to a batch.
+func (w *ObservationWriter) stop(err error) {
+	// the writes blocked on a full channel return once stopped is closed, then no write is sent anymore.
+	close(w.stopped)
+	w.mu.Lock()
+	w.closed = true
+	w.mu.Unlock()
+	for {
+		select {
+		case write := <-w.ch:
+			write.complete(err)
+		default:
+			return
+		}
+	}
+}
+
+func (w *ObservationWriter) flush(ctx context.Context, batch *observationBatch) {
+	start := time.Now()
+	err := w.repository.upsertObservations(ctx, batch.writes)
+	w.metrics.ObservationBatchDuration(&start)
+	if err != nil {
+		w.metrics.AddObservationBatchWritten(len(batch.writes), false)
+	} else {
+		w.metrics.AddObservationBatchWritten(len(batch.writes), true)
+	}
+
+	for _, write := range batch.writes {
+		writeErr := err
+		if writeErr == nil {
+			writeErr = w.repository.afterObservationSaved(ctx, write.obs, write.update, write.saveTxHash)
+		}
+		write.complete(writeErr)
+	}
+}
+
+func (o *observationWrite) complete(err error) {
+	for _, done := range o.done {
+		done(err)
+	}
+}
+
+// observationBatch keeps the order of the observations and discards identical observations.
+type observationBatch struct {
+	writes []*observationWrite
+	byID   map[string]*observationWrite
+}
+
+func newObservationBatch() *observationBatch {
+	return &observationBatch{byID: make(map[string]*observationWrite)}
+}
+
+// add adds the observation to the batch and returns false when an identical observation is already in the batch.
+func (b *observationBatch) add(write *observationWrite) bool {
+	if prev, ok := b.byID[write.id]; ok {
+		prev.saveTxHash = prev.saveTxHash || write.saveTxHash
+		prev.done = append(prev.done, write.done...)
+		return false
+	}
+	b.byID[write.id] = write
+	b.writes = append(b.writes, write)
+	return true
+}
@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/fly/internal/metrics"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestObservationBatchAdd(t *testing.T) {
+	var calls int
+	done := func(err error) { calls++ }
+
+	batch := newObservationBatch()
+	assert.True(t, batch.add(&observationWrite{id: "1/abc/1/guardian1/hash", done: []ObservationDoneFunc{done}}))
+	assert.True(t, batch.add(&observationWrite{id: "1/abc/1/guardian2/hash", done: []ObservationDoneFunc{done}}))
+
+	// an identical observation is discarded and completed with the first one.
+	assert.False(t, batch.add(&observationWrite{id: "1/abc/1/guardian1/hash", saveTxHash: true, done: []ObservationDoneFunc{done}}))
+	assert.Len(t, batch.writes, 2)
+	assert.True(t, batch.writes[0].saveTxHash)
+
+	batch.writes[0].complete(nil)
+	assert.Equal(t, 2, calls)
+}
+
+// observationStoreMock records the batches of observations stored.
+type observationStoreMock struct {
+	mu      sync.Mutex
+	batches [][]string
+	err     error
+}
+
+func (m *observationStoreMock) newObservationUpdate(o *gossipv1.SignedObservation) (string, *ObservationUpdate, error) {
+	return o.MessageId + "/" + hex.EncodeToString(o.Addr), &ObservationUpdate{MessageID: o.MessageId, ChainID: sdk.ChainIDEthereum}, nil
+}
+
+func (m *observationStoreMock) saveObservationTxHash(ctx context.Context, o *gossipv1.SignedObservation, obs *ObservationUpdate) error {
+	return nil
+}
+
+func (m *observationStoreMock) upsertObservations(ctx context.Context, writes []*observationWrite) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var ids []string
+	for _, w := range writes {
+		ids = append(ids, w.id)
+	}
+	m.batches = append(m.batches, ids)
+	return m.err
+}
+
+func (m *observationStoreMock) afterObservationSaved(ctx context.Context, o *gossipv1.SignedObservation, obs *ObservationUpdate, saveTxHash bool) error {
+	return nil
+}
+
+func (m *observationStoreMock) getBatches() [][]string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.batches
+}
+
+func newTestObservationWriter(store observationStore, batchSize int, flushInterval time.Duration) *ObservationWriter {
+	return newObservationWriter(store, batchSize, flushInterval, nil, metrics.NewDummyMetrics(), zap.NewNop())
+}
+
+func testObservation(guardian byte) *gossipv1.SignedObservation {
+	return &gossipv1.SignedObservation{MessageId: "2/abc/1", Hash: []byte{1}, Addr: []byte{guardian}}
+}
+
+// write writes the observation and returns the channel its result is sent to.
+func writeObservation(w *ObservationWriter, ctx context.Context, o *gossipv1.SignedObservation) <-chan error {
+	result := make(chan error, 1)
+	w.Write(ctx, o, false, func(err error) { result <- err })
+	return result
+}
+
+func waitResult(t *testing.T, result <-chan error) error {
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("observation was not completed")
+		return nil
+	}
+}
+
+func TestObservationWriterFlushesFullBatch(t *testing.T) {
+	store := &observationStoreMock{}
+	w := newTestObservationWriter(store, 2, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.Start(ctx)
+
+	first := writeObservation(w, ctx, testObservation(1))
+	second := writeObservation(w, ctx, testObservation(2))
+
+	assert.NoError(t, waitResult(t, first))
+	assert.NoError(t, waitResult(t, second))
+	assert.Equal(t, [][]string{{"2/abc/1/01", "2/abc/1/02"}}, store.getBatches())
+}
+
+func TestObservationWriterFlushesOnInterval(t *testing.T) {
+	store := &observationStoreMock{}
+	w := newTestObservationWriter(store, 10, 10*time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.Start(ctx)
+
+	assert.NoError(t, waitResult(t, writeObservation(w, ctx, testObservation(1))))
+	assert.Equal(t, [][]string{{"2/abc/1/01"}}, store.getBatches())
+}
+
+func TestObservationWriterFailsBatch(t *testing.T) {
+	store := &observationStoreMock{err: errors.New("bulk write failed")}
+	w := newTestObservationWriter(store, 1, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.Start(ctx)
+
+	assert.EqualError(t, waitResult(t, writeObservation(w, ctx, testObservation(1))), "bulk write failed")
+}
+
+func TestObservationWriterDefaultFlushInterval(t *testing.T) {
+	// a zero flush interval would make the ticker panic.
+	w := newTestObservationWriter(&observationStoreMock{}, 10, 0)
+	assert.Equal(t, defaultObservationsFlushInterval, w.flushInterval)
+}
+
+func TestObservationWriterShutdown(t *testing.T) {
+	store := &observationStoreMock{}
+	w := newTestObservationWriter(store, 10, time.Hour)
+
+	// the observations are written before the writer starts, they are pending in the channel or the batch.
+	ctx, cancel := context.WithCancel(context.Background())
+	first := writeObservation(w, context.Background(), testObservation(1))
+	second := writeObservation(w, context.Background(), testObservation(2))
+	cancel()
+	w.Start(ctx)
+
+	assert.ErrorIs(t, waitResult(t, first), context.Canceled)
+	assert.ErrorIs(t, waitResult(t, second), context.Canceled)
+	assert.Empty(t, store.getBatches())
+
+	// the observations written while the writer stops or once it is stopped are failed.
+	assert.Error(t, waitResult(t, writeObservation(w, context.Background(), testObservation(3))))
+}
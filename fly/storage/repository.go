@@ -47,6 +47,8 @@ type Repository struct {
 		vaasPythnet    *mongo.Collection
 		vaaCounts      *mongo.Collection
 		duplicateVaas  *mongo.Collection
+		gossipStats    *mongo.Collection
+		invalidVaas    *mongo.Collection
 	}
 }
 
@@ -66,6 +68,8 @@ func NewRepository(alertService alert.AlertClient, metrics metrics.Metrics,
 		vaasPythnet    *mongo.Collection
 		vaaCounts      *mongo.Collection
 		duplicateVaas  *mongo.Collection
+		gossipStats    *mongo.Collection
+		invalidVaas    *mongo.Collection
 	}{
 		vaas:           db.Collection(repository.Vaas),
 		heartbeats:     db.Collection("heartbeats"),
@@ -74,7 +78,9 @@ func NewRepository(alertService alert.AlertClient, metrics metrics.Metrics,
 		governorStatus: db.Collection("governorStatus"),
 		vaasPythnet:    db.Collection("vaasPythnet"),
 		vaaCounts:      db.Collection("vaaCounts"),
-		duplicateVaas:  db.Collection(repository.DuplicateVaas)}}
+		duplicateVaas:  db.Collection(repository.DuplicateVaas),
+		gossipStats:    db.Collection("gossipStats"),
+		invalidVaas:    db.Collection("invalidVaas")}}
 }
 
 func (s *Repository) UpsertVaa(ctx context.Context, v *vaa.VAA, serializedVaa []byte) error {
@@ -88,6 +94,7 @@ func (s *Repository) UpsertVaa(ctx context.Context, v *vaa.VAA, serializedVaa []
 		EmitterAddr:      v.EmitterAddress.String(),
 		Sequence:         strconv.FormatUint(v.Sequence, 10),
 		GuardianSetIndex: v.GuardianSetIndex,
+		SignersBitmap:    domain.SignersBitmap(v),
 		Vaa:              serializedVaa,
 		Digest:           utils.NormalizeHex(v.HexDigest()),
 		UpdatedAt:        &now,
@@ -121,6 +128,16 @@ func (s *Repository) UpsertVaa(ctx context.Context, v *vaa.VAA, serializedVaa []
 		if txHash != nil {
 			vaaDoc.TxHash = *txHash
 		}
+
+		// a vaa with the same id and a different digest must not overwrite the stored vaa.
+		stored, err := s.findConflictVaa(ctx, id, vaaDoc.Digest)
+		if err != nil {
+			s.log.Warn("Finding vaa digest conflict", zap.String("id", id), zap.Error(err))
+		}
+		if stored != nil {
+			return s.upsertConflictVaa(ctx, v, serializedVaa, stored)
+		}
+
 		result, err = s.collections.vaas.UpdateByID(ctx, id, update, opts)
 		if err != nil {
 			// send alert when exists an error saving vaa.
@@ -157,26 +174,30 @@ func (s *Repository) UpsertVaa(ctx context.Context, v *vaa.VAA, serializedVaa []
 	return err
 }
 
-func (s *Repository) UpsertObservation(ctx context.Context, o *gossipv1.SignedObservation, saveTxHash bool) error {
+// newObservationUpdate creates the document of an observation and returns it with its id.
+// Pyth observations are not stored and return a nil document.
+func (s *Repository) newObservationUpdate(o *gossipv1.SignedObservation) (string, *ObservationUpdate, error) {
 	vaaID := strings.Split(o.MessageId, "/")
+	if len(vaaID) != 3 {
+		return "", nil, fmt.Errorf("invalid observation message id %s", o.MessageId)
+	}
 	chainIDStr, emitter, sequenceStr := vaaID[0], vaaID[1], vaaID[2]
 	id := fmt.Sprintf("%s/%s/%s", o.MessageId, hex.EncodeToString(o.Addr), hex.EncodeToString(o.Hash))
-	now := time.Now()
 
 	chainIDUint, err := strconv.ParseUint(chainIDStr, 10, 16)
 	if err != nil {
 		s.log.Error("Error parsing chainId", zap.Error(err))
-		return err
+		return "", nil, err
 	}
 
 	// TODO should we notify the caller that pyth observations are not stored?
 	if vaa.ChainID(chainIDUint) == vaa.ChainIDPythNet {
-		return nil
+		return id, nil, nil
 	}
 	sequence, err := strconv.ParseUint(sequenceStr, 10, 64)
 	if err != nil {
 		s.log.Error("Error parsing sequence", zap.Error(err))
-		return err
+		return "", nil, err
 	}
 
 	chainID := vaa.ChainID(chainIDUint)
@@ -200,57 +221,79 @@ func (s *Repository) UpsertObservation(ctx context.Context, o *gossipv1.SignedOb
 		NativeTxHash: nativeTxHash,
 		GuardianAddr: addr.String(),
 		Signature:    o.GetSignature(),
-		UpdatedAt:    &now,
 	}
+	return id, &obs, nil
+}
 
-	update := bson.M{
-		"$set":         obs,
-		"$setOnInsert": indexedAt(now),
-		"$inc":         bson.D{{Key: "revision", Value: 1}},
+// upsertObservations stores a batch of observations with a single bulk write.
+func (s *Repository) upsertObservations(ctx context.Context, writes []*observationWrite) error {
+	if len(writes) == 0 {
+		return nil
 	}
-	opts := options.Update().SetUpsert(true)
-	_, err = s.collections.observations.UpdateByID(ctx, id, update, opts)
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, len(writes))
+	for _, w := range writes {
+		w.update.UpdatedAt = &now
+		update := bson.M{
+			"$set":         w.update,
+			"$setOnInsert": indexedAt(now),
+			"$inc":         bson.D{{Key: "revision", Value: 1}},
+		}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(bson.D{{Key: "_id", Value: w.id}}).SetUpdate(update).SetUpsert(true))
+	}
+	_, err := s.collections.observations.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
 	if err != nil {
-		s.log.Error("Error inserting observation", zap.Error(err))
-		// send alert when exists an error saving observation.
+		s.log.Error("Error inserting observations", zap.Int("size", len(writes)), zap.Error(err))
+		// send alert when exists an error saving observations.
 		alertContext := alert.AlertContext{
-			Details: obs.ToMap(),
-			Error:   err,
+			Details: map[string]string{
+				"batchSize":        strconv.Itoa(len(writes)),
+				"firstObservation": writes[0].id,
+			},
+			Error: err,
 		}
 		s.alertClient.CreateAndSend(ctx, flyAlert.ErrorSaveObservation, alertContext)
 		return err
 	}
+	return nil
+}
 
-	s.metrics.IncObservationInserted(vaa.ChainID(chainID))
-
-	if saveTxHash {
+// afterObservationSaved updates the metrics of a stored observation and
+// saves its tx hash when requested.
+func (s *Repository) afterObservationSaved(ctx context.Context, o *gossipv1.SignedObservation, obs *ObservationUpdate, saveTxHash bool) error {
+	s.metrics.IncObservationInserted(obs.ChainID)
 
-		txHash, err := domain.EncodeTrxHashByChainID(vaa.ChainID(chainID), o.GetTxHash())
-		if err != nil {
-			s.log.Warn("Error encoding tx hash",
-				zap.Uint64("chainId", chainIDUint),
-				zap.ByteString("txHash", o.GetTxHash()),
-				zap.Error(err))
-			s.metrics.IncObservationWithoutTxHash(chainID)
-		}
-
-		vaaTxHash := txhash.TxHash{
-			ChainID:  chainID,
-			Emitter:  emitter,
-			Sequence: strconv.FormatUint(sequence, 10),
-			TxHash:   txHash,
-		}
+	if !saveTxHash {
+		return nil
+	}
+	return s.saveObservationTxHash(ctx, o, obs)
+}
 
-		uniqueVaaID := domain.CreateUniqueVaaIDByObservation(o)
-		err = s.txHashStore.Set(ctx, uniqueVaaID, vaaTxHash)
-		if err != nil {
-			s.log.Error("Error setting txHash", zap.Error(err))
-			return err
-		}
+// saveObservationTxHash saves the tx hash of the vaa of an observation.
+func (s *Repository) saveObservationTxHash(ctx context.Context, o *gossipv1.SignedObservation, obs *ObservationUpdate) error {
+	txHash, err := domain.EncodeTrxHashByChainID(obs.ChainID, o.GetTxHash())
+	if err != nil {
+		s.log.Warn("Error encoding tx hash",
+			zap.Uint16("chainId", uint16(obs.ChainID)),
+			zap.ByteString("txHash", o.GetTxHash()),
+			zap.Error(err))
+		s.metrics.IncObservationWithoutTxHash(obs.ChainID)
 	}
 
-	return err
+	vaaTxHash := txhash.TxHash{
+		ChainID:  obs.ChainID,
+		Emitter:  obs.Emitter,
+		Sequence: obs.Sequence,
+		TxHash:   txHash,
+	}
 
+	uniqueVaaID := domain.CreateUniqueVaaIDByObservation(o)
+	err = s.txHashStore.Set(ctx, uniqueVaaID, vaaTxHash)
+	if err != nil {
+		s.log.Error("Error setting txHash", zap.Error(err))
+		return err
+	}
+	return nil
 }
 
 func (s *Repository) ReplaceVaaTxHash(ctx context.Context, vaaID, oldTxHash, newTxHash string) error {
@@ -288,6 +331,28 @@ func (s *Repository) UpsertHeartbeat(hb *gossipv1.Heartbeat) error {
 	return err
 }
 
+// UpsertGossipStats stores the gossip statistics of each guardian.
+func (s *Repository) UpsertGossipStats(ctx context.Context, stats []*GossipStatsUpdate) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, len(stats))
+	for _, st := range stats {
+		update := bson.D{
+			{Key: "$set", Value: st},
+			{Key: "$set", Value: bson.D{{Key: "updatedAt", Value: now}}},
+			{Key: "$setOnInsert", Value: bson.D{{Key: "indexedAt", Value: now}}},
+		}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(bson.D{{Key: "_id", Value: st.GuardianAddr}}).SetUpdate(update).SetUpsert(true))
+	}
+	_, err := s.collections.gossipStats.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		s.log.Error("Error upserting gossip stats", zap.Error(err))
+	}
+	return err
+}
+
 func (s *Repository) UpsertGovernorConfig(govC *gossipv1.SignedChainGovernorConfig) error {
 	id := hex.EncodeToString(govC.GuardianAddr)
 	now := time.Now()
@@ -479,6 +544,26 @@ func (r *Repository) FindVaaByChainID(ctx context.Context, chainID vaa.ChainID,
 	return result, err
 }
 
+// findConflictVaa returns the stored vaa with the given id when its digest differs from the given digest.
+func (s *Repository) findConflictVaa(ctx context.Context, id string, digest string) (*VaaUpdate, error) {
+	var stored struct {
+		Digest string `bson:"digest"`
+	}
+	opts := options.FindOne().SetProjection(bson.M{"digest": 1})
+	err := s.collections.vaas.FindOne(ctx, bson.M{"_id": id}, opts).Decode(&stored)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// vaas stored before the digest was persisted can't be compared.
+	if stored.Digest == "" || stored.Digest == digest {
+		return nil, nil
+	}
+	return s.FindVaaByID(ctx, id)
+}
+
 func (r *Repository) FindVaaByID(ctx context.Context, vaaID string) (*VaaUpdate, error) {
 	var vaa VaaUpdate
 	if err := r.collections.vaas.FindOne(ctx, bson.M{"_id": vaaID}).Decode(&vaa); err != nil {
@@ -495,10 +580,69 @@ func (s *Repository) UpsertDuplicateVaa(ctx context.Context, v *vaa.VAA, seriali
 		return nil
 	}
 
+	duplicateVaaDoc, isNew, err := s.saveDuplicateVaa(ctx, v, serializedVaa, false)
+	if err != nil {
+		return err
+	}
+
+	// send signedvaa event to topic.
+	if isNew {
+		err := s.notifyNewVaa(ctx, v, serializedVaa, duplicateVaaDoc.TxHash)
+		if err != nil {
+			return err
+		}
+		return s.eventDispatcher.NewDuplicateVaa(ctx, event.DuplicateVaa{
+			VaaID:            v.MessageID(),
+			ChainID:          uint16(v.EmitterChain),
+			Version:          v.Version,
+			GuardianSetIndex: v.GuardianSetIndex,
+			Vaa:              serializedVaa,
+			Digest:           utils.NormalizeHex(v.HexDigest()),
+			ConsistencyLevel: v.ConsistencyLevel,
+			Timestamp:        &v.Timestamp,
+		})
+	}
+
+	return nil
+}
+
+// upsertConflictVaa stores a vaa whose digest differs from the digest of the vaa already stored with the same id.
+// The stored vaa is not overwritten: both vaas are saved in the duplicateVaas collection flagged as conflict
+// and the vaa in the vaas collection is flagged as conflict too.
+func (s *Repository) upsertConflictVaa(ctx context.Context, v *vaa.VAA, serializedVaa []byte, stored *VaaUpdate) error {
+	storedVaa, err := vaa.Unmarshal(stored.Vaa)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal stored vaa %s: %w", stored.ID, err)
+	}
+	if _, _, err := s.saveDuplicateVaa(ctx, storedVaa, stored.Vaa, true); err != nil {
+		return err
+	}
+	duplicateVaaDoc, isNew, err := s.saveDuplicateVaa(ctx, v, serializedVaa, true)
+	if err != nil {
+		return err
+	}
+
+	if isNew {
+		s.metrics.IncVaaDigestConflictByChainID(v.EmitterChain)
+		s.log.Warn("Vaa digest conflict",
+			zap.String("id", v.MessageID()),
+			zap.String("storedDigest", stored.Digest),
+			zap.String("digest", duplicateVaaDoc.Digest))
+		details := duplicateVaaDoc.ToMap()
+		details["storedDigest"] = stored.Digest
+		s.alertClient.CreateAndSend(ctx, flyAlert.VaaDigestConflict, alert.AlertContext{Details: details})
+	}
+	return nil
+}
+
+// saveDuplicateVaa stores the vaa in the duplicateVaas collection and flags the vaa stored in the vaas collection.
+// It returns the stored document and whether it was inserted.
+func (s *Repository) saveDuplicateVaa(ctx context.Context, v *vaa.VAA, serializedVaa []byte, conflict bool) (*DuplicateVaaUpdate, bool, error) {
 	uniqueVaaID := domain.CreateUniqueVaaID(v)
 	now := time.Now()
 
 	duplicateVaaDoc := createDuplicateVaaUpdateFromVaa(uniqueVaaID, v, serializedVaa, now)
+	duplicateVaaDoc.Conflict = conflict
 	update := bson.M{
 		"$set":         duplicateVaaDoc,
 		"$setOnInsert": indexedAt(now),
@@ -524,42 +668,60 @@ func (s *Repository) UpsertDuplicateVaa(ctx context.Context, v *vaa.VAA, seriali
 			Error:   err,
 		}
 		s.alertClient.CreateAndSend(ctx, flyAlert.ErrorSaveDuplicateVAA, alertContext)
-		return err
+		return nil, false, err
 	}
 
-	// Update isDuplicated field in vaas collection
-	updateIsDuplicated := bson.D{
-		{Key: "$set", Value: bson.D{{Key: "isDuplicated", Value: true}}},
-		{Key: "$set", Value: bson.D{{Key: "updatedAt", Value: now}}},
+	// Update isDuplicated and conflict fields in vaas collection
+	fields := bson.D{
+		{Key: "isDuplicated", Value: true},
+		{Key: "updatedAt", Value: now},
 	}
-	_, err = s.collections.vaas.UpdateByID(ctx, v.MessageID(), updateIsDuplicated)
+	if conflict {
+		fields = append(fields, bson.E{Key: "conflict", Value: true})
+	}
+	_, err = s.collections.vaas.UpdateByID(ctx, v.MessageID(), bson.D{{Key: "$set", Value: fields}})
 	if err != nil {
 		alertContext := alert.AlertContext{
 			Details: duplicateVaaDoc.ToMap(),
 			Error:   err,
 		}
 		s.alertClient.CreateAndSend(ctx, flyAlert.ErrorSaveDuplicateVAA, alertContext)
-		return err
+		return nil, false, err
 	}
 
-	// send signedvaa event to topic.
-	if s.isNewRecord(result) {
-		err := s.notifyNewVaa(ctx, v, serializedVaa, duplicateVaaDoc.TxHash)
-		if err != nil {
-			return err
-		}
-		return s.eventDispatcher.NewDuplicateVaa(ctx, event.DuplicateVaa{
-			VaaID:            v.MessageID(),
-			ChainID:          uint16(v.EmitterChain),
-			Version:          v.Version,
-			GuardianSetIndex: v.GuardianSetIndex,
-			Vaa:              serializedVaa,
-			Digest:           utils.NormalizeHex(v.HexDigest()),
-			ConsistencyLevel: v.ConsistencyLevel,
-			Timestamp:        &v.Timestamp,
-		})
-	}
+	return duplicateVaaDoc, s.isNewRecord(result), nil
+}
 
+// UpsertInvalidVaa stores a vaa rejected by the signature verification in the invalidVaas collection with the reason.
+func (s *Repository) UpsertInvalidVaa(ctx context.Context, v *vaa.VAA, serializedVaa []byte, reason string) error {
+	uniqueVaaID := domain.CreateUniqueVaaID(v)
+	now := time.Now()
+	invalidVaaDoc := &InvalidVaaUpdate{
+		ID:               uniqueVaaID,
+		VaaID:            v.MessageID(),
+		Version:          v.Version,
+		EmitterChain:     v.EmitterChain,
+		EmitterAddr:      v.EmitterAddress.String(),
+		Sequence:         strconv.FormatUint(v.Sequence, 10),
+		GuardianSetIndex: v.GuardianSetIndex,
+		Vaa:              serializedVaa,
+		Digest:           utils.NormalizeHex(v.HexDigest()),
+		Reason:           reason,
+		Timestamp:        &v.Timestamp,
+		UpdatedAt:        &now,
+	}
+	update := bson.M{
+		"$set":         invalidVaaDoc,
+		"$setOnInsert": indexedAt(now),
+		"$inc":         bson.D{{Key: "revision", Value: 1}},
+	}
+	opts := options.Update().SetUpsert(true)
+	_, err := s.collections.invalidVaas.UpdateByID(ctx, uniqueVaaID, update, opts)
+	if err != nil {
+		s.log.Error("Error inserting invalid vaa", zap.String("id", uniqueVaaID), zap.Error(err))
+		return err
+	}
+	s.metrics.IncVaaInvalid(v.EmitterChain)
 	return nil
 }
 
@@ -595,6 +757,7 @@ func createDuplicateVaaUpdateFromVaa(uniqueID string, v *vaa.VAA, serializedVaa
 		EmitterAddr:      v.EmitterAddress.String(),
 		Sequence:         strconv.FormatUint(v.Sequence, 10),
 		GuardianSetIndex: v.GuardianSetIndex,
+		SignersBitmap:    domain.SignersBitmap(v),
 		Vaa:              serializedVaa,
 		UpdatedAt:        &t,
 		ConsistencyLevel: v.ConsistencyLevel,
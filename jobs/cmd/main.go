@@ -8,6 +8,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/go-resty/resty/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbconsts"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/protocols"
@@ -15,7 +18,10 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/stats"
 
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	vaaArchive "github.com/wormhole-foundation/wormhole-explorer/common/client/archive"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/httpclient"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
 	"github.com/wormhole-foundation/wormhole-explorer/common/configuration"
 
 	"github.com/go-redis/redis/v8"
@@ -25,13 +31,21 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/policy"
+	"github.com/wormhole-foundation/wormhole-explorer/common/postgres"
 	filePrices "github.com/wormhole-foundation/wormhole-explorer/common/prices"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/config"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/internal/coingecko"
 	apiPrices "github.com/wormhole-foundation/wormhole-explorer/jobs/internal/prices"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/archive"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/cleanup"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/fees"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/governor"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/guardian"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/migration"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/pgsync"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/report"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -52,7 +66,17 @@ func main() {
 	logger := logger.New("wormhole-explorer-jobs", logger.WithLevel(cfg.LogLevel))
 	logger.Info("started job execution", zap.String("job_id", cfg.JobID))
 
-	var err error
+	// configure the http client shared by the requests to external apis.
+	httpClient, err := httpclient.New(httpclient.Config{
+		Timeout:   cfg.HttpTimeout,
+		ProxyURL:  cfg.HttpProxyURL,
+		UserAgent: cfg.HttpUserAgent,
+	})
+	if err != nil {
+		logger.Fatal("failed to create http client", zap.Error(err))
+	}
+	httpclient.SetDefault(httpClient)
+
 	switch cfg.JobID {
 	case jobs.JobIDNotional:
 		nCfg, errCfg := configuration.LoadFromEnv[config.NotionalConfiguration](ctx)
@@ -106,6 +130,36 @@ func main() {
 	case jobs.JobIDNTTMedianStats:
 		job := initNTTMedianStatsJob(ctx, logger)
 		err = job.Run(ctx)
+	case jobs.JobIDFeesStatsHourly:
+		job := initFeesStatsHourlyJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDGovernorLimitChanges:
+		job := initGovernorLimitChangesJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDGuardianParticipation:
+		job := initGuardianParticipationJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDMigrationVaaCompress:
+		job := initMigrateVaaCompressionJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDFeeRevenue:
+		job := initFeeRevenueJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDVaaArchive:
+		job := initVaaArchiveJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDRetention:
+		job := initRetentionJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDWipeChainData:
+		job := initWipeChainDataJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDPostgresSync:
+		job := initPostgresSyncJob(ctx, logger)
+		err = job.Run(ctx)
+	case jobs.JobIDMigrationAppIDs:
+		job := initMigrateAppIDsJob(ctx, logger)
+		err = job.Run(ctx)
 	default:
 		logger.Error("Invalid job id", zap.String("job_id", cfg.JobID))
 	}
@@ -179,7 +233,7 @@ func initMigrateSourceTxJob(ctx context.Context, cfg *config.MigrateSourceTxConf
 	}
 
 	// init tx tracker api client.
-	txTrackerAPIClient, err := txtrackerProcessVaa.NewTxTrackerAPIClient(cfg.TxTrackerTimeout, cfg.TxTrackerURL, logger)
+	txTrackerAPIClient, err := txtrackerProcessVaa.NewTxTrackerAPIClient(cfg.TxTrackerTimeout, cfg.TxTrackerURL, cfg.TxTrackerApiKey, logger)
 	if err != nil {
 		logger.Fatal("Failed to create txtracker api client", zap.Error(err))
 	}
@@ -266,6 +320,30 @@ func initMigrateNativeTxHashJob(ctx context.Context, logger *zap.Logger) *migrat
 	return migration.NewMigrationNativeTxHash(db.Database, cfgJob.PageSize, logger)
 }
 
+func initMigrateVaaCompressionJob(ctx context.Context, logger *zap.Logger) *migration.MigrateVaaCompression {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.MigrateVaaCompressionConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	return migration.NewMigrationVaaCompression(db.Database, cfgJob.PageSize, logger)
+}
+
+func initMigrateAppIDsJob(ctx context.Context, logger *zap.Logger) *migration.MigrateAppIDs {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.MigrateAppIDsConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	return migration.NewMigrationAppIDs(db.Database, cfgJob.PageSize, logger)
+}
+
 func initNTTTopAddressStatsJob(ctx context.Context, logger *zap.Logger) *stats.NTTTopAddressJob {
 	cfgJob, errCfg := configuration.LoadFromEnv[config.NTTTopAddressStatsConfiguration](ctx)
 	if errCfg != nil {
@@ -311,7 +389,7 @@ func initNTTTopHolderStatsJob(ctx context.Context, logger *zap.Logger) *stats.NT
 	}
 	notionalCache.Init(ctx)
 
-	return stats.NewNTTTopHolderJob(resty.New(), cfgJob.ArkhamUrl, cfgJob.ArkhamApiKey, cfgJob.SolanaUrl, cache, tokenProvider, notionalCache, logger)
+	return stats.NewNTTTopHolderJob(resty.NewWithClient(httpclient.Default()), cfgJob.ArkhamUrl, cfgJob.ArkhamApiKey, cfgJob.SolanaUrl, cache, tokenProvider, notionalCache, logger)
 }
 
 func initNTTMedianStatsJob(ctx context.Context, logger *zap.Logger) *stats.NTTMedian {
@@ -335,6 +413,157 @@ func initNTTMedianStatsJob(ctx context.Context, logger *zap.Logger) *stats.NTTMe
 	return stats.NewNTTMedian(influxClient, cfgJob.InfluxOrganization, cfgJob.InfluxBucketInfinite, cache, logger)
 }
 
+func initFeesStatsHourlyJob(ctx context.Context, logger *zap.Logger) *fees.StatsJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.FeesStatsConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	dbClient := influxdb2.NewClient(cfgJob.InfluxUrl, cfgJob.InfluxToken)
+	dbWriter := dbClient.WriteAPIBlocking(cfgJob.InfluxOrganization, cfgJob.InfluxBucket30Days)
+
+	to := time.Now().UTC().Truncate(1 * time.Hour)
+	from := to.Add(-1 * time.Hour)
+	return fees.NewStatsJob(db.Database, dbWriter, dbconsts.FeesPerChainMeasurementHourly, from, to, logger)
+}
+
+func initGovernorLimitChangesJob(ctx context.Context, logger *zap.Logger) *governor.LimitChangesJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.GovernorLimitChangesConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	return governor.NewLimitChangesJob(db.Database, logger)
+}
+
+func initGuardianParticipationJob(ctx context.Context, logger *zap.Logger) *guardian.ParticipationJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.GuardianParticipationConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	day := time.Now().UTC().AddDate(0, 0, -1)
+	if cfgJob.Date != "" {
+		var err error
+		day, err = time.Parse(time.DateOnly, cfgJob.Date)
+		if err != nil {
+			log.Fatal("invalid date", err)
+		}
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	return guardian.NewParticipationJob(db.Database, day, logger)
+}
+
+func initFeeRevenueJob(ctx context.Context, logger *zap.Logger) *report.FeeRevenueJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.FeeRevenueConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	day := time.Now().UTC().AddDate(0, 0, -1)
+	if cfgJob.Date != "" {
+		var err error
+		day, err = time.Parse(time.DateOnly, cfgJob.Date)
+		if err != nil {
+			log.Fatal("invalid date", err)
+		}
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	var getPriceByTime report.GetPriceByTimeFn
+	switch strings.ToLower(cfgJob.PricesType) {
+	case "file":
+		pricesCache := filePrices.NewCoinPricesCache(cfgJob.PricesUri)
+		pricesCache.InitCache()
+		getPriceByTime = pricesCache.GetPriceByTime
+	case "api":
+		api := apiPrices.NewPricesApi(cfgJob.PricesUri, logger)
+		getPriceByTime = api.GetPriceByTime
+	default:
+		logger.Fatal("Invalid prices type", zap.String("prices_type", cfgJob.PricesType))
+	}
+	tokenProvider := domain.NewTokenProvider(cfgJob.P2pNetwork)
+	return report.NewFeeRevenueJob(db.Database, day, getPriceByTime, tokenProvider, logger)
+}
+
+func initVaaArchiveJob(ctx context.Context, logger *zap.Logger) *archive.VaaArchiveJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.VaaArchiveConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	awsConfig, err := newAwsConfig(ctx, cfgJob)
+	if err != nil {
+		logger.Fatal("Failed to create aws config", zap.Error(err))
+	}
+	store := vaaArchive.NewVaaArchive(payload.NewS3Store(awsConfig, cfgJob.AwsEndpoint), cfgJob.AwsBucket)
+	return archive.NewVaaArchiveJob(db.Database, store, cfgJob.PageSize, cfgJob.Months, cfgJob.RemoveRaw, logger)
+}
+
+func initRetentionJob(ctx context.Context, logger *zap.Logger) *cleanup.RetentionJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.RetentionConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	return cleanup.NewRetentionJob(db.Database, policy.ForRunMode(cfgJob.RunMode), logger)
+}
+
+func initWipeChainDataJob(ctx context.Context, logger *zap.Logger) *cleanup.WipeChainDataJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.WipeChainDataConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	return cleanup.NewWipeChainDataJob(db.Database, sdk.ChainID(cfgJob.ChainID), cfgJob.DataWipeEnabled, logger)
+}
+
+func initPostgresSyncJob(ctx context.Context, logger *zap.Logger) *pgsync.PostgresSyncJob {
+	cfgJob, errCfg := configuration.LoadFromEnv[config.PostgresSyncConfiguration](ctx)
+	if errCfg != nil {
+		log.Fatal("error creating config", errCfg)
+	}
+	db, err := dbutil.Connect(ctx, logger, cfgJob.MongoURI, cfgJob.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+	pg, err := postgres.Connect(ctx, cfgJob.PostgresURL, logger)
+	if err != nil {
+		logger.Fatal("Failed to connect PostgreSQL", zap.Error(err))
+	}
+	return pgsync.NewPostgresSyncJob(db.Database, pg, cfgJob.PageSize, logger)
+}
+
+// newAwsConfig creates a new AWS config depending on whether the execution is local (localstack) or not (AWS).
+func newAwsConfig(ctx context.Context, cfg *config.VaaArchiveConfiguration) (aws.Config, error) {
+	if cfg.AwsAccessKeyID != "" && cfg.AwsSecretAccessKey != "" {
+		credentials := credentials.NewStaticCredentialsProvider(cfg.AwsAccessKeyID, cfg.AwsSecretAccessKey, "")
+		return awsconfig.LoadDefaultConfig(ctx,
+			awsconfig.WithRegion(cfg.AwsRegion),
+			awsconfig.WithCredentialsProvider(credentials),
+		)
+	}
+	return awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(cfg.AwsRegion))
+}
+
 func handleExit() {
 	if r := recover(); r != nil {
 		if e, ok := r.(exitCode); ok {
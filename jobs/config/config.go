@@ -2,10 +2,17 @@
 // It define a type [Configuration] that represent the aplication configuration
 package config
 
+import "time"
+
 // Configuration is the configuration for the job
 type Configuration struct {
 	JobID    string `env:"JOB_ID,required"`
 	LogLevel string `env:"LOG_LEVEL,default=INFO"`
+	// HttpTimeout is the timeout of the requests of the jobs to external apis.
+	HttpTimeout time.Duration `env:"HTTP_TIMEOUT,default=30s"`
+	// HttpProxyURL is the http proxy of the requests, the proxy of the HTTP_PROXY and HTTPS_PROXY variables is used when empty.
+	HttpProxyURL  string `env:"HTTP_PROXY_URL"`
+	HttpUserAgent string `env:"HTTP_USER_AGENT,default=wormhole-explorer-jobs"`
 }
 
 type NotionalConfiguration struct {
@@ -51,6 +58,8 @@ type MigrateSourceTxConfiguration struct {
 	ToDate           string `env:"TO_DATE,required"`
 	TxTrackerURL     string `env:"TX_TRACKER_URL,required"`
 	TxTrackerTimeout int64  `env:"TX_TRACKER_TIMEOUT,default=30"`
+	// TxTrackerApiKey is the admin api key of the tx-tracker.
+	TxTrackerApiKey  string `env:"TX_TRACKER_API_KEY"`
 	SleepTimeSeconds int64  `env:"SLEEP_TIME_SECONDS,default=5"`
 }
 
@@ -79,6 +88,18 @@ type MigrateNativeTxHashConfiguration struct {
 	PageSize      int    `env:"PAGE_SIZE,default=100"`
 }
 
+type MigrateVaaCompressionConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	PageSize      int    `env:"PAGE_SIZE,default=100"`
+}
+
+type MigrateAppIDsConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	PageSize      int64  `env:"PAGE_SIZE,default=1000"`
+}
+
 type NTTTopAddressStatsConfiguration struct {
 	InfluxUrl            string `env:"INFLUX_URL,required"`
 	InfluxToken          string `env:"INFLUX_TOKEN,required"`
@@ -106,3 +127,73 @@ type NTTMedianStatsConfiguration struct {
 	CacheUrl             string `env:"CACHE_URL,required"`
 	CachePrefix          string `env:"CACHE_PREFIX,required"`
 }
+
+type FeesStatsConfiguration struct {
+	MongoURI           string `env:"MONGODB_URI,required"`
+	MongoDatabase      string `env:"MONGODB_DATABASE,required"`
+	InfluxUrl          string `env:"INFLUX_URL,required"`
+	InfluxToken        string `env:"INFLUX_TOKEN,required"`
+	InfluxOrganization string `env:"INFLUX_ORGANIZATION,required"`
+	InfluxBucket30Days string `env:"INFLUX_BUCKET_30_DAYS,required"`
+}
+
+type GovernorLimitChangesConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+}
+
+type GuardianParticipationConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	// Date is the day to compute with the format YYYY-MM-DD, the previous day when empty.
+	Date string `env:"DATE"`
+}
+
+type FeeRevenueConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	P2pNetwork    string `env:"P2P_NETWORK,required"`
+	PricesType    string `env:"PRICES_TYPE,required"`
+	PricesUri     string `env:"PRICES_URI,required"`
+	// Date is the day to compute with the format YYYY-MM-DD, the previous day when empty.
+	Date string `env:"DATE"`
+}
+
+type VaaArchiveConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	PageSize      int    `env:"PAGE_SIZE,default=100"`
+	// Months is the age of the vaas moved to the archive.
+	Months int `env:"ARCHIVE_MONTHS,default=12"`
+	// RemoveRaw removes the archived raw vaas from the database. It must not be enabled until all the readers
+	// of the raw vaas (the api listings and operations, the parser, the tx-tracker and analytics) read the archive.
+	RemoveRaw          bool   `env:"ARCHIVE_REMOVE_RAW,default=false"`
+	AwsRegion          string `env:"AWS_REGION,required"`
+	AwsBucket          string `env:"AWS_BUCKET,required"`
+	AwsEndpoint        string `env:"AWS_ENDPOINT"`
+	AwsAccessKeyID     string `env:"AWS_ACCESS_KEY_ID"`
+	AwsSecretAccessKey string `env:"AWS_SECRET_ACCESS_KEY"`
+}
+
+type RetentionConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	// RunMode selects the retention policy of the environment.
+	RunMode string `env:"RUN_MODE,default=PRODUCTION"`
+}
+
+type WipeChainDataConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	// DataWipeEnabled must be set explicitly to wipe the data, it must only be set in the testnet environments.
+	DataWipeEnabled bool   `env:"DATA_WIPE_ENABLED,default=false"`
+	ChainID         uint16 `env:"CHAIN_ID,required"`
+}
+
+type PostgresSyncConfiguration struct {
+	MongoURI      string `env:"MONGODB_URI,required"`
+	MongoDatabase string `env:"MONGODB_DATABASE,required"`
+	// PostgresURL is the connection string of the PostgreSQL storage backend of the api.
+	PostgresURL string `env:"POSTGRES_URL,required"`
+	PageSize    int64  `env:"PAGE_SIZE,default=1000"`
+}
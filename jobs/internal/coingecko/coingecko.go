@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/httpclient"
 	"go.uber.org/zap"
 )
 
@@ -26,7 +27,7 @@ func NewCoingeckoAPI(url string, headerKey, apiKey string, logger *zap.Logger) *
 	return &CoingeckoAPI{
 		url:       url,
 		chunkSize: 200,
-		client:    http.DefaultClient,
+		client:    httpclient.Default(),
 		headerKey: headerKey,
 		apiKey:    apiKey,
 		logger:    logger,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/httpclient"
 	"go.uber.org/zap"
 )
 
@@ -24,7 +25,7 @@ type PricesApi struct {
 
 func NewPricesApi(url string, log *zap.Logger) *PricesApi {
 	return &PricesApi{
-		client: resty.New().SetBaseURL(url),
+		client: resty.NewWithClient(httpclient.Default()).SetBaseURL(url),
 		log:    log,
 	}
 }
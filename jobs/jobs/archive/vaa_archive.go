@@ -0,0 +1,168 @@
+package archive
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// Store is the long-term storage of the raw vaas.
+type Store interface {
+	Put(ctx context.Context, vaaID string, vaa []byte) error
+}
+
+// VaaArchiveJob is the job to copy the raw vaas older than a number of months to the archive.
+//
+// The raw vaa is stored in the archive keyed by vaa id and the document is flagged with the archive date.
+// The raw vaa is only removed from the vaa document when [removeRaw] is true. Only the api reads the vaas
+// found by id from the archive, the vaa listings, the operations and the reprocessing of the parser,
+// the tx-tracker and analytics read the raw vaas from the database, so the raw vaas must be kept until
+// all of them read the archive. The job can be stopped and run again, the archived vaas are skipped.
+type VaaArchiveJob struct {
+	pageSize   int
+	months     int
+	removeRaw  bool
+	collection *mongo.Collection
+	store      Store
+	logger     *zap.Logger
+}
+
+// rawVaaDoc is a vaa document with the raw vaa as it is stored.
+type rawVaaDoc struct {
+	ID  string        `bson:"_id"`
+	Vaa bson.RawValue `bson:"vaas"`
+}
+
+// NewVaaArchiveJob creates a new archive job for the vaas older than [months].
+// If [removeRaw] is true, the archived raw vaas are removed from the database.
+func NewVaaArchiveJob(
+	db *mongo.Database,
+	store Store,
+	pageSize int,
+	months int,
+	removeRaw bool,
+	logger *zap.Logger) *VaaArchiveJob {
+	return &VaaArchiveJob{
+		pageSize:   pageSize,
+		months:     months,
+		removeRaw:  removeRaw,
+		collection: db.Collection(repository.Vaas),
+		store:      store,
+		logger:     logger,
+	}
+}
+
+// Run runs the archive job.
+func (j *VaaArchiveJob) Run(ctx context.Context) error {
+	var archived atomic.Uint64
+	var total uint64
+	var wg sync.WaitGroup
+	workerLimit := j.pageSize
+	jobs := make(chan rawVaaDoc, workerLimit)
+
+	before := time.Now().AddDate(0, -j.months, 0)
+	logger := j.logger.With(zap.Time("before", before), zap.Bool("removeRaw", j.removeRaw))
+	for i := 1; i <= workerLimit; i++ {
+		wg.Add(1)
+		go j.archiveVaa(ctx, &wg, jobs, &archived, logger)
+	}
+
+	var lastID string
+	var err error
+	for {
+		var docs []rawVaaDoc
+		docs, err = j.getVaasToArchive(ctx, before, int64(j.pageSize), lastID)
+		if err != nil {
+			logger.Error("failed to get vaas", zap.Error(err))
+			break
+		}
+		if len(docs) == 0 {
+			break
+		}
+		total += uint64(len(docs))
+		for _, doc := range docs {
+			jobs <- doc
+			lastID = doc.ID
+		}
+		logger.Info("archiving vaas",
+			zap.String("lastId", lastID),
+			zap.Uint64("total", total),
+			zap.Uint64("archived", archived.Load()))
+	}
+	close(jobs)
+	wg.Wait()
+
+	logger.Info("archived vaas", zap.Uint64("total", total), zap.Uint64("archived", archived.Load()))
+	return err
+}
+
+func (j *VaaArchiveJob) archiveVaa(ctx context.Context, wg *sync.WaitGroup, jobs <-chan rawVaaDoc, archived *atomic.Uint64, logger *zap.Logger) {
+	defer wg.Done()
+	for doc := range jobs {
+		var raw repository.CompressedBytes
+		if err := doc.Vaa.Unmarshal(&raw); err != nil {
+			logger.Error("failed to decode vaa", zap.Error(err), zap.String("id", doc.ID))
+			continue
+		}
+		if len(raw) == 0 {
+			continue
+		}
+		if err := j.store.Put(ctx, doc.ID, raw); err != nil {
+			logger.Error("failed to store vaa in the archive", zap.Error(err), zap.String("id", doc.ID))
+			continue
+		}
+
+		// only flag or remove the vaa if it was not changed meanwhile.
+		filter := bson.D{{Key: "_id", Value: doc.ID}, {Key: "vaas", Value: doc.Vaa}}
+		update := bson.D{{Key: "$set", Value: bson.D{{Key: "archivedAt", Value: time.Now()}}}}
+		if j.removeRaw {
+			update = append(update, bson.E{Key: "$unset", Value: bson.D{{Key: "vaas", Value: ""}}})
+		}
+		result, err := j.collection.UpdateOne(ctx, filter, update)
+		if err != nil {
+			logger.Error("failed to update vaa", zap.Error(err), zap.String("id", doc.ID))
+			continue
+		}
+		if result.ModifiedCount == 1 {
+			archived.Add(1)
+			logger.Debug("archived vaa", zap.String("id", doc.ID))
+		}
+	}
+}
+
+func (j *VaaArchiveJob) getVaasToArchive(ctx context.Context, before time.Time, pageSize int64, greaterThan string) ([]rawVaaDoc, error) {
+
+	filter := bson.D{
+		{Key: "timestamp", Value: bson.M{"$lt": before}},
+		{Key: "vaas", Value: bson.M{"$exists": true}},
+	}
+	// the vaas already archived are skipped, unless their raw vaa is removed now.
+	if !j.removeRaw {
+		filter = append(filter, bson.E{Key: "archivedAt", Value: bson.M{"$exists": false}})
+	}
+	if greaterThan != "" {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.M{"$gt": greaterThan}})
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(pageSize).
+		SetProjection(bson.D{{Key: "vaas", Value: 1}})
+
+	cur, err := j.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return []rawVaaDoc{}, err
+	}
+
+	var docs []rawVaaDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return []rawVaaDoc{}, err
+	}
+	return docs, nil
+}
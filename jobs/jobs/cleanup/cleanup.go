@@ -0,0 +1,81 @@
+// Package cleanup contains the jobs that delete data according to the policy of the environment.
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/policy"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// RetentionJob deletes the observations older than the retention of the policy.
+//
+// The observations are only needed until the vaas are signed, so the environments with lots
+// of short-lived test messages (e.g. testnet) do not keep them forever.
+type RetentionJob struct {
+	observations *mongo.Collection
+	policy       policy.Policy
+	logger       *zap.Logger
+}
+
+// NewRetentionJob creates a new RetentionJob.
+func NewRetentionJob(db *mongo.Database, p policy.Policy, logger *zap.Logger) *RetentionJob {
+	return &RetentionJob{
+		observations: db.Collection(repository.Observations),
+		policy:       p,
+		logger:       logger,
+	}
+}
+
+// Run runs the retention job.
+func (j *RetentionJob) Run(ctx context.Context) error {
+	if j.policy.ObservationsRetention <= 0 {
+		j.logger.Info("observations retention is disabled by the policy")
+		return nil
+	}
+	before := time.Now().Add(-j.policy.ObservationsRetention)
+	result, err := j.observations.DeleteMany(ctx, bson.D{{Key: "indexedAt", Value: bson.D{{Key: "$lt", Value: before}}}})
+	if err != nil {
+		return fmt.Errorf("failed to delete observations: %w", err)
+	}
+	j.logger.Info("deleted old observations", zap.Time("before", before), zap.Int64("deleted", result.DeletedCount))
+	return nil
+}
+
+// WipeChainDataJob deletes all the data of a chain, e.g. after a testnet redeployment.
+// The job fails when the data wipe is not enabled in the environment.
+type WipeChainDataJob struct {
+	wiper   *repository.ChainDataWiper
+	chainID sdk.ChainID
+	enabled bool
+	logger  *zap.Logger
+}
+
+// NewWipeChainDataJob creates a new WipeChainDataJob.
+func NewWipeChainDataJob(db *mongo.Database, chainID sdk.ChainID, enabled bool, logger *zap.Logger) *WipeChainDataJob {
+	return &WipeChainDataJob{
+		wiper:   repository.NewChainDataWiper(db, logger),
+		chainID: chainID,
+		enabled: enabled,
+		logger:  logger,
+	}
+}
+
+// Run runs the wipe job.
+func (j *WipeChainDataJob) Run(ctx context.Context) error {
+	if !j.enabled {
+		return fmt.Errorf("wiping the data of chain %d is not enabled", j.chainID)
+	}
+	deleted, err := j.wiper.Wipe(ctx, j.chainID)
+	if err != nil {
+		return err
+	}
+	j.logger.Info("wiped chain data", zap.Uint16("chainId", uint16(j.chainID)), zap.Any("deleted", deleted))
+	return nil
+}
@@ -0,0 +1,107 @@
+package fees
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// sides of a transfer whose fees are aggregated, named by the field of the global transaction.
+var sides = []struct {
+	name  string
+	field string
+}{
+	{name: "origin", field: "originTx"},
+	{name: "destination", field: "destinationTx"},
+}
+
+// StatsJob aggregates the fees paid by the origin and destination transactions per chain.
+type StatsJob struct {
+	globalTransactions *mongo.Collection
+	writerDB           api.WriteAPIBlocking
+	measurement        string
+	from               time.Time
+	to                 time.Time
+	logger             *zap.Logger
+}
+
+// chainFees is the result of the fees aggregation of a chain.
+type chainFees struct {
+	ChainID uint16  `bson:"_id"`
+	Fee     float64 `bson:"fee"`
+	FeeUSD  float64 `bson:"feeUSD"`
+	Txs     uint64  `bson:"txs"`
+}
+
+// NewStatsJob creates an instance of the fees stats job.
+func NewStatsJob(db *mongo.Database, writerDB api.WriteAPIBlocking, measurement string, from, to time.Time, logger *zap.Logger) *StatsJob {
+	return &StatsJob{
+		globalTransactions: db.Collection("globalTransactions"),
+		writerDB:           writerDB,
+		measurement:        measurement,
+		from:               from,
+		to:                 to,
+		logger:             logger.With(zap.String("module", "FeesStatsJob")),
+	}
+}
+
+// Run aggregates the fees of the transactions between from and to and writes them to influxdb.
+func (j *StatsJob) Run(ctx context.Context) error {
+
+	j.logger.Info("running fees stats job", zap.Time("from", j.from), zap.Time("to", j.to))
+
+	for _, s := range sides {
+		side := s.name
+		fees, err := j.aggregate(ctx, s.field)
+		if err != nil {
+			return fmt.Errorf("failed to aggregate %s fees: %w", side, err)
+		}
+		for _, f := range fees {
+			point := influxdb2.NewPointWithMeasurement(j.measurement).
+				AddTag("chain_id", fmt.Sprintf("%d", f.ChainID)).
+				AddTag("side", side).
+				AddField("fee", f.Fee).
+				AddField("fee_usd", f.FeeUSD).
+				AddField("txs", f.Txs).
+				SetTime(j.from)
+			if err := j.writerDB.WritePoint(ctx, point); err != nil {
+				j.logger.Error("failed updating fees in influxdb", zap.Error(err), zap.Uint16("chainId", f.ChainID), zap.String("side", side))
+				return err
+			}
+		}
+		j.logger.Info("fees stats updated", zap.String("side", side), zap.Int("chains", len(fees)))
+	}
+	return nil
+}
+
+// aggregate sums the fees of the transactions of a side, in the gas token and in USD, grouped by chain.
+// Only the transactions with a fee in USD are aggregated.
+func (j *StatsJob) aggregate(ctx context.Context, field string) ([]chainFees, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: field + ".timestamp", Value: bson.D{{Key: "$gte", Value: j.from}, {Key: "$lt", Value: j.to}}},
+			{Key: field + ".feeDetail.feeUSD", Value: bson.D{{Key: "$nin", Value: bson.A{nil, ""}}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$" + field + ".chainId"},
+			{Key: "fee", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$toDouble", Value: "$" + field + ".feeDetail.fee"}}}}},
+			{Key: "feeUSD", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$toDouble", Value: "$" + field + ".feeDetail.feeUSD"}}}}},
+			{Key: "txs", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+	cur, err := j.globalTransactions.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var fees []chainFees
+	if err := cur.All(ctx, &fees); err != nil {
+		return nil, err
+	}
+	return fees, nil
+}
@@ -0,0 +1,211 @@
+// Package governor contains the jobs that track the configuration of the guardian governors.
+package governor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"go.uber.org/zap"
+)
+
+// LimitChangesJob records the changes of the notional limit and the big transaction size
+// configured by each guardian governor, diffing the current governor configurations
+// against the last values recorded in the change log.
+type LimitChangesJob struct {
+	governorConfig *mongo.Collection
+	limitChanges   *mongo.Collection
+	logger         *zap.Logger
+}
+
+// Uint64 is an uint64 stored as a decimal128, like the limits of the governor configurations.
+type Uint64 uint64
+
+func (u Uint64) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	d128, err := primitive.ParseDecimal128(strconv.FormatUint(uint64(u), 10))
+	return bsontype.Decimal128, bsoncore.AppendDecimal128(nil, d128), err
+}
+
+func (u *Uint64) UnmarshalBSONValue(t bsontype.Type, b []byte) error {
+	d128, _, ok := bsoncore.ReadDecimal128(b)
+	if !ok {
+		return errors.New("Uint64 UnmarshalBSONValue error")
+	}
+	ui64, err := strconv.ParseUint(d128.String(), 10, 64)
+	if err != nil {
+		return err
+	}
+	*u = Uint64(ui64)
+	return nil
+}
+
+// GuardianConfig is the governor configuration of a guardian.
+type GuardianConfig struct {
+	GuardianAddress string        `bson:"_id"`
+	NodeName        string        `bson:"nodeName"`
+	Chains          []ChainLimits `bson:"chains"`
+}
+
+// ChainLimits are the limits of a chain in a governor configuration.
+type ChainLimits struct {
+	ChainID            uint16 `bson:"chainid"`
+	NotionalLimit      Uint64 `bson:"notionallimit"`
+	BigTransactionSize Uint64 `bson:"bigtransactionsize"`
+}
+
+// LimitChange is a change of the limits of a chain in the governor configuration of a guardian.
+// The previous values are nil the first time the limits of a chain are recorded.
+type LimitChange struct {
+	ID                         string    `bson:"_id"`
+	GuardianAddress            string    `bson:"guardianAddress"`
+	NodeName                   string    `bson:"nodeName"`
+	ChainID                    uint16    `bson:"chainId"`
+	NotionalLimit              Uint64    `bson:"notionalLimit"`
+	BigTransactionSize         Uint64    `bson:"bigTransactionSize"`
+	PreviousNotionalLimit      *Uint64   `bson:"previousNotionalLimit,omitempty"`
+	PreviousBigTransactionSize *Uint64   `bson:"previousBigTransactionSize,omitempty"`
+	DetectedAt                 time.Time `bson:"detectedAt"`
+}
+
+// LimitKey identifies the limits of a chain in the governor configuration of a guardian.
+type LimitKey struct {
+	GuardianAddress string `bson:"guardianAddress"`
+	ChainID         uint16 `bson:"chainId"`
+}
+
+// lastLimits is the last recorded change of a guardian and chain.
+type lastLimits struct {
+	Key                LimitKey `bson:"_id"`
+	NotionalLimit      Uint64   `bson:"notionalLimit"`
+	BigTransactionSize Uint64   `bson:"bigTransactionSize"`
+}
+
+// NewLimitChangesJob creates an instance of the governor limit changes job.
+func NewLimitChangesJob(db *mongo.Database, logger *zap.Logger) *LimitChangesJob {
+	return &LimitChangesJob{
+		governorConfig: db.Collection("governorConfig"),
+		limitChanges:   db.Collection(repository.GovernorLimitChanges),
+		logger:         logger.With(zap.String("module", "GovernorLimitChangesJob")),
+	}
+}
+
+// Run records the limits that changed since the last execution.
+func (j *LimitChangesJob) Run(ctx context.Context) error {
+
+	j.logger.Info("running governor limit changes job")
+
+	configs, err := j.findConfigs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get governor configurations: %w", err)
+	}
+	last, err := j.findLastLimits(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get last governor limits: %w", err)
+	}
+
+	changes := DiffLimits(configs, last, time.Now().UTC())
+	if len(changes) == 0 {
+		j.logger.Info("no governor limit changes found")
+		return nil
+	}
+
+	docs := make([]interface{}, 0, len(changes))
+	for _, c := range changes {
+		docs = append(docs, c)
+	}
+	_, err = j.limitChanges.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		j.logger.Error("failed inserting governor limit changes", zap.Error(err))
+		return err
+	}
+
+	j.logger.Info("governor limit changes recorded", zap.Int("changes", len(changes)))
+	return nil
+}
+
+// DiffLimits returns the changes of the configurations against the last recorded limits.
+func DiffLimits(configs []GuardianConfig, last map[LimitKey]ChainLimits, now time.Time) []LimitChange {
+	var changes []LimitChange
+	for _, cfg := range configs {
+		for _, chain := range cfg.Chains {
+			key := LimitKey{GuardianAddress: cfg.GuardianAddress, ChainID: chain.ChainID}
+			change := LimitChange{
+				ID:                 fmt.Sprintf("%s/%d/%d", cfg.GuardianAddress, chain.ChainID, now.Unix()),
+				GuardianAddress:    cfg.GuardianAddress,
+				NodeName:           cfg.NodeName,
+				ChainID:            chain.ChainID,
+				NotionalLimit:      chain.NotionalLimit,
+				BigTransactionSize: chain.BigTransactionSize,
+				DetectedAt:         now,
+			}
+			prev, ok := last[key]
+			if ok {
+				if prev.NotionalLimit == chain.NotionalLimit && prev.BigTransactionSize == chain.BigTransactionSize {
+					continue
+				}
+				change.PreviousNotionalLimit = &prev.NotionalLimit
+				change.PreviousBigTransactionSize = &prev.BigTransactionSize
+			}
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// findConfigs gets the chain limits of the governor configuration of each guardian.
+func (j *LimitChangesJob) findConfigs(ctx context.Context) ([]GuardianConfig, error) {
+	projection := bson.D{
+		{Key: "nodeName", Value: "$parsedConfig.nodename"},
+		{Key: "chains", Value: "$parsedConfig.chains"},
+	}
+	cur, err := j.governorConfig.Find(ctx, bson.D{}, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+	var configs []GuardianConfig
+	if err := cur.All(ctx, &configs); err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
+// findLastLimits gets the last recorded limits of each guardian and chain.
+func (j *LimitChangesJob) findLastLimits(ctx context.Context) (map[LimitKey]ChainLimits, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$sort", Value: bson.D{{Key: "detectedAt", Value: -1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "guardianAddress", Value: "$guardianAddress"},
+				{Key: "chainId", Value: "$chainId"},
+			}},
+			{Key: "notionalLimit", Value: bson.D{{Key: "$first", Value: "$notionalLimit"}}},
+			{Key: "bigTransactionSize", Value: bson.D{{Key: "$first", Value: "$bigTransactionSize"}}},
+		}}},
+	}
+	cur, err := j.limitChanges.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var docs []lastLimits
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	last := make(map[LimitKey]ChainLimits, len(docs))
+	for _, d := range docs {
+		last[d.Key] = ChainLimits{
+			ChainID:            d.Key.ChainID,
+			NotionalLimit:      d.NotionalLimit,
+			BigTransactionSize: d.BigTransactionSize,
+		}
+	}
+	return last, nil
+}
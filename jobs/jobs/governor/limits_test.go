@@ -0,0 +1,47 @@
+package governor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/governor"
+)
+
+func Test_DiffLimits(t *testing.T) {
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	configs := []governor.GuardianConfig{
+		{
+			GuardianAddress: "58cc3ae5c097b213ce3c81979e1b9f9570746aa5",
+			NodeName:        "guardian-0",
+			Chains: []governor.ChainLimits{
+				{ChainID: 1, NotionalLimit: 5000000, BigTransactionSize: 500000},
+				{ChainID: 2, NotionalLimit: 8000000, BigTransactionSize: 800000},
+				{ChainID: 4, NotionalLimit: 3000000, BigTransactionSize: 300000},
+			},
+		},
+	}
+	last := map[governor.LimitKey]governor.ChainLimits{
+		{GuardianAddress: "58cc3ae5c097b213ce3c81979e1b9f9570746aa5", ChainID: 1}: {ChainID: 1, NotionalLimit: 5000000, BigTransactionSize: 500000},
+		{GuardianAddress: "58cc3ae5c097b213ce3c81979e1b9f9570746aa5", ChainID: 2}: {ChainID: 2, NotionalLimit: 5000000, BigTransactionSize: 500000},
+	}
+
+	changes := governor.DiffLimits(configs, last, now)
+
+	assert.Len(t, changes, 2)
+
+	// chain 2 changed its limits.
+	assert.Equal(t, uint16(2), changes[0].ChainID)
+	assert.Equal(t, governor.Uint64(8000000), changes[0].NotionalLimit)
+	assert.Equal(t, governor.Uint64(800000), changes[0].BigTransactionSize)
+	assert.Equal(t, governor.Uint64(5000000), *changes[0].PreviousNotionalLimit)
+	assert.Equal(t, governor.Uint64(500000), *changes[0].PreviousBigTransactionSize)
+	assert.Equal(t, "guardian-0", changes[0].NodeName)
+	assert.Equal(t, now, changes[0].DetectedAt)
+
+	// chain 4 is recorded for the first time.
+	assert.Equal(t, uint16(4), changes[1].ChainID)
+	assert.Nil(t, changes[1].PreviousNotionalLimit)
+	assert.Nil(t, changes[1].PreviousBigTransactionSize)
+}
@@ -0,0 +1,212 @@
+// Package guardian contains the jobs that track the activity of the guardians.
+package guardian
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// ParticipationJob materializes, for a day, the number of vaas of each chain signed by each guardian
+// against the number of vaas the guardian could have signed, that is the vaas of its guardian sets.
+type ParticipationJob struct {
+	vaas         *mongo.Collection
+	stats        *mongo.Collection
+	guardianSets *repository.GuardianSetRepository
+	day          time.Time
+	logger       *zap.Logger
+}
+
+// SignersCount is the number of vaas of a chain signed by the same guardians.
+type SignersCount struct {
+	ChainID          uint16
+	GuardianSetIndex uint32
+	SignersBitmap    uint64
+	Count            int64
+}
+
+// Participation is the signing participation of a guardian in the vaas of a chain during a day.
+type Participation struct {
+	ID              string    `bson:"_id"`
+	Date            time.Time `bson:"date"`
+	ChainID         uint16    `bson:"chainId"`
+	GuardianAddress string    `bson:"guardianAddress"`
+	Signed          int64     `bson:"signed"`
+	Total           int64     `bson:"total"`
+	UpdatedAt       time.Time `bson:"updatedAt"`
+}
+
+// signersCountDoc is the result of grouping the vaas by chain, guardian set and signers.
+type signersCountDoc struct {
+	Key struct {
+		ChainID          uint16 `bson:"chainId"`
+		GuardianSetIndex uint32 `bson:"guardianSetIndex"`
+		SignersBitmap    uint64 `bson:"signersBitmap"`
+	} `bson:"_id"`
+	Count int64 `bson:"count"`
+}
+
+// NewParticipationJob creates an instance of the guardian participation job for the UTC day of [day].
+func NewParticipationJob(db *mongo.Database, day time.Time, logger *zap.Logger) *ParticipationJob {
+	return &ParticipationJob{
+		vaas:         db.Collection(repository.Vaas),
+		stats:        db.Collection(repository.GuardianParticipation),
+		guardianSets: repository.NewGuardianSetRepository(db, logger),
+		day:          day.UTC().Truncate(24 * time.Hour),
+		logger:       logger.With(zap.String("module", "GuardianParticipationJob")),
+	}
+}
+
+// Run computes and stores the participation of the guardians during the day of the job.
+func (j *ParticipationJob) Run(ctx context.Context) error {
+
+	j.logger.Info("running guardian participation job", zap.Time("day", j.day))
+
+	counts, err := j.findSignersCounts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get vaa signers: %w", err)
+	}
+	sets, err := j.findGuardianSets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get guardian sets: %w", err)
+	}
+
+	stats := ComputeParticipation(counts, sets, j.day, time.Now().UTC())
+	if len(stats) == 0 {
+		j.logger.Info("no guardian participation found", zap.Time("day", j.day))
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(stats))
+	for _, s := range stats {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: s.ID}}).
+			SetReplacement(s).
+			SetUpsert(true))
+	}
+	_, err = j.stats.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		j.logger.Error("failed writing guardian participation", zap.Error(err))
+		return err
+	}
+
+	j.logger.Info("guardian participation recorded", zap.Time("day", j.day), zap.Int("stats", len(stats)))
+	return nil
+}
+
+// ComputeParticipation returns the participation of each guardian by chain, from the signers of the vaas
+// and the addresses of the guardian sets by index.
+// The vaas of guardian sets that are unknown are ignored.
+func ComputeParticipation(counts []SignersCount, sets map[uint32][]string, day, now time.Time) []Participation {
+
+	type key struct {
+		chainID uint16
+		address string
+	}
+	byKey := make(map[key]*Participation)
+	for _, c := range counts {
+		addresses, ok := sets[c.GuardianSetIndex]
+		if !ok {
+			continue
+		}
+		for i, address := range addresses {
+			k := key{chainID: c.ChainID, address: address}
+			p, ok := byKey[k]
+			if !ok {
+				p = &Participation{
+					ID:              fmt.Sprintf("%s/%d/%s", day.Format(time.DateOnly), c.ChainID, address),
+					Date:            day,
+					ChainID:         c.ChainID,
+					GuardianAddress: address,
+					UpdatedAt:       now,
+				}
+				byKey[k] = p
+			}
+			p.Total += c.Count
+			if i < 64 && c.SignersBitmap&(1<<i) != 0 {
+				p.Signed += c.Count
+			}
+		}
+	}
+
+	stats := make([]Participation, 0, len(byKey))
+	for _, p := range byKey {
+		stats = append(stats, *p)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].ChainID != stats[j].ChainID {
+			return stats[i].ChainID < stats[j].ChainID
+		}
+		return stats[i].GuardianAddress < stats[j].GuardianAddress
+	})
+	return stats
+}
+
+// findSignersCounts counts the vaas of the day by chain, guardian set and signers.
+// The vaas stored before the signers bitmap was persisted are not taken into account.
+func (j *ParticipationJob) findSignersCounts(ctx context.Context) ([]SignersCount, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "timestamp", Value: bson.D{
+				{Key: "$gte", Value: j.day},
+				{Key: "$lt", Value: j.day.Add(24 * time.Hour)},
+			}},
+			{Key: "signersBitmap", Value: bson.D{{Key: "$gt", Value: 0}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "chainId", Value: "$emitterChain"},
+				{Key: "guardianSetIndex", Value: "$guardianSetIndex"},
+				{Key: "signersBitmap", Value: "$signersBitmap"},
+			}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+	cur, err := j.vaas.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var docs []signersCountDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	counts := make([]SignersCount, 0, len(docs))
+	for _, d := range docs {
+		counts = append(counts, SignersCount{
+			ChainID:          d.Key.ChainID,
+			GuardianSetIndex: d.Key.GuardianSetIndex,
+			SignersBitmap:    d.Key.SignersBitmap,
+			Count:            d.Count,
+		})
+	}
+	return counts, nil
+}
+
+// findGuardianSets gets the addresses of the guardians of each guardian set, ordered by guardian index.
+func (j *ParticipationJob) findGuardianSets(ctx context.Context) (map[uint32][]string, error) {
+	docs, err := j.guardianSets.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sets := make(map[uint32][]string, len(docs))
+	for _, doc := range docs {
+		keys := doc.Keys
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].Index < keys[j].Index
+		})
+		addresses := make([]string, 0, len(keys))
+		for _, k := range keys {
+			addresses = append(addresses, "0x"+hex.EncodeToString(k.Address))
+		}
+		sets[doc.GuardianSetIndex] = addresses
+	}
+	return sets, nil
+}
@@ -0,0 +1,52 @@
+package guardian
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ComputeParticipation(t *testing.T) {
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	now := day.Add(25 * time.Hour)
+	sets := map[uint32][]string{
+		3: {"0xaa", "0xbb", "0xcc"},
+		4: {"0xaa", "0xdd", "0xcc"},
+	}
+	counts := []SignersCount{
+		{ChainID: 2, GuardianSetIndex: 3, SignersBitmap: 0b111, Count: 10},
+		{ChainID: 2, GuardianSetIndex: 3, SignersBitmap: 0b101, Count: 5},
+		{ChainID: 2, GuardianSetIndex: 4, SignersBitmap: 0b011, Count: 4},
+		{ChainID: 1, GuardianSetIndex: 4, SignersBitmap: 0b110, Count: 2},
+		// unknown guardian set
+		{ChainID: 1, GuardianSetIndex: 9, SignersBitmap: 0b1, Count: 100},
+	}
+
+	stats := ComputeParticipation(counts, sets, day, now)
+
+	expected := []struct {
+		chainID       uint16
+		address       string
+		signed, total int64
+	}{
+		{1, "0xaa", 0, 2},
+		{1, "0xcc", 2, 2},
+		{1, "0xdd", 2, 2},
+		{2, "0xaa", 19, 19},
+		{2, "0xbb", 10, 15},
+		{2, "0xcc", 15, 19},
+		{2, "0xdd", 4, 4},
+	}
+	if assert.Len(t, stats, len(expected)) {
+		for i, e := range expected {
+			assert.Equal(t, e.chainID, stats[i].ChainID)
+			assert.Equal(t, e.address, stats[i].GuardianAddress)
+			assert.Equal(t, e.signed, stats[i].Signed, e.address)
+			assert.Equal(t, e.total, stats[i].Total, e.address)
+			assert.Equal(t, day, stats[i].Date)
+		}
+	}
+	assert.Equal(t, "2024-05-01/1/0xaa", stats[0].ID)
+	assert.Empty(t, ComputeParticipation(nil, sets, day, now))
+}
@@ -15,6 +15,16 @@ const (
 	JobIDNTTTopHolderStats     = "JOB_NTT_TOP_HOLDER_STATS"
 	JobIDNTTMedianStats        = "JOB_NTT_MEDIAN_STATS"
 	JobIDMigrationNativeTxHash = "JOB_MIGRATE_NATIVE_TX_HASH"
+	JobIDFeesStatsHourly       = "JOB_FEES_STATS_HOURLY"
+	JobIDGovernorLimitChanges  = "JOB_GOVERNOR_LIMIT_CHANGES"
+	JobIDGuardianParticipation = "JOB_GUARDIAN_PARTICIPATION"
+	JobIDMigrationVaaCompress  = "JOB_MIGRATE_VAA_COMPRESSION"
+	JobIDFeeRevenue            = "JOB_FEE_REVENUE"
+	JobIDVaaArchive            = "JOB_ARCHIVE_VAAS"
+	JobIDRetention             = "JOB_RETENTION"
+	JobIDWipeChainData         = "JOB_WIPE_CHAIN_DATA"
+	JobIDPostgresSync          = "JOB_POSTGRES_SYNC"
+	JobIDMigrationAppIDs       = "JOB_MIGRATE_APP_IDS"
 )
 
 // Job is the interface for jobs.
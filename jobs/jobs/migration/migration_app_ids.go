@@ -0,0 +1,111 @@
+package migration
+
+import (
+	"context"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// MigrateAppIDs is the job to store the application ids of the parsed vaas in their global transactions.
+//
+// The parser stores the application ids of the vaas it parses in the global transactions, so the
+// transactions can be filtered by application. This job copies them for the vaas parsed before.
+// The parsed vaas are read by pages sorted by id, so the job can be stopped and run again.
+type MigrateAppIDs struct {
+	pageSize           int64
+	parsedVaa          *mongo.Collection
+	globalTransactions *mongo.Collection
+	logger             *zap.Logger
+}
+
+// appIDsDoc is a parsed vaa document with its application ids.
+type appIDsDoc struct {
+	ID        string    `bson:"_id"`
+	AppIDs    []string  `bson:"appIds"`
+	Timestamp time.Time `bson:"timestamp"`
+}
+
+// NewMigrationAppIDs creates a new migration job.
+func NewMigrationAppIDs(db *mongo.Database, pageSize int64, logger *zap.Logger) *MigrateAppIDs {
+	return &MigrateAppIDs{
+		pageSize:           pageSize,
+		parsedVaa:          db.Collection(repository.ParsedVaa),
+		globalTransactions: db.Collection(repository.GlobalTransactions),
+		logger:             logger,
+	}
+}
+
+// Run runs the migration job.
+func (m *MigrateAppIDs) Run(ctx context.Context) error {
+	var lastID string
+	var total, updated int64
+	for {
+		docs, err := m.getParsedVaas(ctx, lastID)
+		if err != nil {
+			m.logger.Error("failed to get parsed vaas", zap.Error(err), zap.String("lastId", lastID))
+			return err
+		}
+		if len(docs) == 0 {
+			break
+		}
+
+		result, err := m.globalTransactions.BulkWrite(ctx, appIDsWrites(docs), options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			m.logger.Error("failed to update global transactions", zap.Error(err), zap.String("lastId", lastID))
+			return err
+		}
+		lastID = docs[len(docs)-1].ID
+		total += int64(len(docs))
+		updated += result.ModifiedCount + result.UpsertedCount
+		m.logger.Info("storing app ids in global transactions",
+			zap.String("lastId", lastID),
+			zap.Int64("total", total),
+			zap.Int64("updated", updated))
+	}
+	m.logger.Info("stored app ids in global transactions", zap.Int64("total", total), zap.Int64("updated", updated))
+	return nil
+}
+
+func (m *MigrateAppIDs) getParsedVaas(ctx context.Context, greaterThan string) ([]appIDsDoc, error) {
+
+	filter := bson.D{{Key: "appIds.0", Value: bson.M{"$exists": true}}}
+	if greaterThan != "" {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.M{"$gt": greaterThan}})
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(m.pageSize).
+		SetProjection(bson.D{{Key: "appIds", Value: 1}, {Key: "timestamp", Value: 1}})
+
+	cur, err := m.parsedVaa.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []appIDsDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// appIDsWrites returns the updates of the global transactions with the application ids of the
+// parsed vaas, as the parser stores them.
+func appIDsWrites(docs []appIDsDoc) []mongo.WriteModel {
+	writes := make([]mongo.WriteModel, 0, len(docs))
+	for _, doc := range docs {
+		update := bson.D{{Key: "$set", Value: bson.D{
+			{Key: "appIds", Value: doc.AppIDs},
+			{Key: "timestamp", Value: doc.Timestamp},
+		}}}
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: doc.ID}}).
+			SetUpdate(update).
+			SetUpsert(true))
+	}
+	return writes
+}
@@ -0,0 +1,32 @@
+package migration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestAppIDsWrites(t *testing.T) {
+	timestamp := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	docs := []appIDsDoc{
+		{ID: "1/emitter/1", AppIDs: []string{"PORTAL_TOKEN_BRIDGE"}, Timestamp: timestamp},
+		{ID: "1/emitter/2", AppIDs: []string{"CONNECT", "PORTAL_TOKEN_BRIDGE"}, Timestamp: timestamp},
+	}
+
+	writes := appIDsWrites(docs)
+	if assert.Len(t, writes, 2) {
+		update, ok := writes[1].(*mongo.UpdateOneModel)
+		if assert.True(t, ok) {
+			assert.Equal(t, bson.D{{Key: "_id", Value: "1/emitter/2"}}, update.Filter)
+			assert.Equal(t, bson.D{{Key: "$set", Value: bson.D{
+				{Key: "appIds", Value: []string{"CONNECT", "PORTAL_TOKEN_BRIDGE"}},
+				{Key: "timestamp", Value: timestamp},
+			}}}, update.Update)
+			// the global transaction is created if the tx-tracker has not processed the vaa yet.
+			assert.True(t, *update.Upsert)
+		}
+	}
+}
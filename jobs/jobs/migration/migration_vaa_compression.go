@@ -0,0 +1,147 @@
+package migration
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// MigrateVaaCompression is the job to compress the raw vaas stored before the compression was enabled.
+//
+// The vaas are read by pages sorted by id, and the ones that are not compressed yet are rewritten
+// with their compressed form. The job can be stopped and run again, the compressed vaas are skipped.
+type MigrateVaaCompression struct {
+	pageSize    int
+	collections []*mongo.Collection
+	logger      *zap.Logger
+}
+
+// rawVaaDoc is a vaa document with the raw vaa as it is stored.
+type rawVaaDoc struct {
+	ID  string        `bson:"_id"`
+	Vaa bson.RawValue `bson:"vaas"`
+}
+
+// NewMigrationVaaCompression creates a new migration job.
+func NewMigrationVaaCompression(
+	db *mongo.Database,
+	pageSize int,
+	logger *zap.Logger) *MigrateVaaCompression {
+	return &MigrateVaaCompression{
+		pageSize: pageSize,
+		collections: []*mongo.Collection{
+			db.Collection(repository.Vaas),
+			db.Collection(repository.DuplicateVaas),
+		},
+		logger: logger}
+}
+
+// Run runs the migration job.
+func (m *MigrateVaaCompression) Run(ctx context.Context) error {
+	for _, collection := range m.collections {
+		if err := m.runMigration(ctx, collection); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MigrateVaaCompression) runMigration(ctx context.Context, collection *mongo.Collection) error {
+	var updated atomic.Uint64
+	var total uint64
+	var wg sync.WaitGroup
+	workerLimit := m.pageSize
+	jobs := make(chan rawVaaDoc, workerLimit)
+
+	logger := m.logger.With(zap.String("collection", collection.Name()))
+	for i := 1; i <= workerLimit; i++ {
+		wg.Add(1)
+		go compressVaa(ctx, &wg, jobs, collection, &updated, logger)
+	}
+
+	var lastID string
+	var err error
+	for {
+		var docs []rawVaaDoc
+		docs, err = m.getVaasToMigrate(ctx, collection, int64(m.pageSize), lastID)
+		if err != nil {
+			logger.Error("failed to get vaas", zap.Error(err))
+			break
+		}
+		if len(docs) == 0 {
+			break
+		}
+		total += uint64(len(docs))
+		for _, doc := range docs {
+			jobs <- doc
+			lastID = doc.ID
+		}
+		logger.Info("compressing vaas",
+			zap.String("lastId", lastID),
+			zap.Uint64("total", total),
+			zap.Uint64("updated", updated.Load()))
+	}
+	close(jobs)
+	wg.Wait()
+
+	return err
+}
+
+func compressVaa(ctx context.Context, wg *sync.WaitGroup, jobs <-chan rawVaaDoc, collection *mongo.Collection, updated *atomic.Uint64, logger *zap.Logger) {
+	defer wg.Done()
+	for doc := range jobs {
+		if repository.IsCompressed(doc.Vaa.Type, doc.Vaa.Value) {
+			continue
+		}
+		var raw repository.CompressedBytes
+		if err := doc.Vaa.Unmarshal(&raw); err != nil {
+			logger.Error("failed to decode vaa", zap.Error(err), zap.String("id", doc.ID))
+			continue
+		}
+		if raw == nil {
+			continue
+		}
+
+		// only rewrite the vaa if it was not changed meanwhile.
+		filter := bson.D{{Key: "_id", Value: doc.ID}, {Key: "vaas", Value: doc.Vaa}}
+		update := bson.D{{Key: "$set", Value: bson.D{{Key: "vaas", Value: raw}}}}
+		result, err := collection.UpdateOne(ctx, filter, update)
+		if err != nil {
+			logger.Error("failed to update vaa", zap.Error(err), zap.String("id", doc.ID))
+			continue
+		}
+		if result.ModifiedCount == 1 {
+			updated.Add(1)
+			logger.Debug("compressed vaa", zap.String("id", doc.ID))
+		}
+	}
+}
+
+func (m *MigrateVaaCompression) getVaasToMigrate(ctx context.Context, collection *mongo.Collection, pageSize int64, greaterThan string) ([]rawVaaDoc, error) {
+
+	filter := bson.D{{Key: "vaas", Value: bson.M{"$type": "binData"}}}
+	if greaterThan != "" {
+		filter = append(filter, bson.E{Key: "_id", Value: bson.M{"$gt": greaterThan}})
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(pageSize).
+		SetProjection(bson.D{{Key: "vaas", Value: 1}})
+
+	cur, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return []rawVaaDoc{}, err
+	}
+
+	var docs []rawVaaDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return []rawVaaDoc{}, err
+	}
+	return docs, nil
+}
@@ -0,0 +1,205 @@
+// Package pgsync implements the job to copy the core collections from mongodb to the PostgreSQL storage backend.
+package pgsync
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// PostgresSyncJob is the job to copy the core collections read by the api from mongodb to PostgreSQL.
+//
+// The documents of each collection are copied in order of update time and id, and upserted in the table
+// of the collection. The update time and id of the last document copied are saved in the sync_state table
+// in the same transaction as the rows, so each run only copies the documents updated since the previous one
+// and the job can be stopped and run again. The vaa counts are copied in full on each run.
+type PostgresSyncJob struct {
+	db       *mongo.Database
+	pg       *sql.DB
+	pageSize int64
+	logger   *zap.Logger
+}
+
+// syncer copies a mongodb collection to PostgreSQL.
+type syncer interface {
+	name() string
+	sync(ctx context.Context, db *mongo.Database, pg *sql.DB, pageSize int64, logger *zap.Logger) (int, error)
+}
+
+// NewPostgresSyncJob creates a new postgres sync job.
+func NewPostgresSyncJob(db *mongo.Database, pg *sql.DB, pageSize int64, logger *zap.Logger) *PostgresSyncJob {
+	return &PostgresSyncJob{
+		db:       db,
+		pg:       pg,
+		pageSize: pageSize,
+		logger:   logger,
+	}
+}
+
+// Run runs the postgres sync job.
+func (j *PostgresSyncJob) Run(ctx context.Context) error {
+	for _, t := range tables {
+		logger := j.logger.With(zap.String("collection", t.name()))
+		total, err := t.sync(ctx, j.db, j.pg, j.pageSize, logger)
+		if err != nil {
+			logger.Error("failed to copy documents", zap.Int("total", total), zap.Error(err))
+			return err
+		}
+		logger.Info("copied documents", zap.Int("total", total))
+	}
+
+	total, err := j.syncVaaCounts(ctx)
+	if err != nil {
+		j.logger.Error("failed to copy vaa counts", zap.Error(err))
+		return err
+	}
+	j.logger.Info("copied vaa counts", zap.Int("total", total))
+	return nil
+}
+
+// syncKey is the position of a document in the order the documents are copied.
+type syncKey struct {
+	updatedAt time.Time
+	id        string
+}
+
+// table copies the documents of a mongodb collection to a PostgreSQL table.
+type table[T any] struct {
+	collection string
+	// updatedAt is the field of the documents with their update time.
+	updatedAt string
+	// upsert is the statement that inserts or updates a row with the values returned by row.
+	upsert string
+	// row returns the position and the column values of a document.
+	row func(doc *T) (syncKey, []any, error)
+}
+
+func (t *table[T]) name() string {
+	return t.collection
+}
+
+func (t *table[T]) sync(ctx context.Context, db *mongo.Database, pg *sql.DB, pageSize int64, logger *zap.Logger) (int, error) {
+
+	last, err := loadSyncKey(ctx, pg, t.collection)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int
+	for {
+		docs, err := t.find(ctx, db.Collection(t.collection), last, pageSize)
+		if err != nil {
+			return total, err
+		}
+		if len(docs) == 0 {
+			return total, nil
+		}
+
+		tx, err := pg.BeginTx(ctx, nil)
+		if err != nil {
+			return total, err
+		}
+		for i := range docs {
+			key, values, err := t.row(&docs[i])
+			if err != nil {
+				tx.Rollback()
+				return total, err
+			}
+			if _, err := tx.ExecContext(ctx, t.upsert, values...); err != nil {
+				tx.Rollback()
+				return total, fmt.Errorf("failed to upsert %s: %w", key.id, err)
+			}
+			last = key
+		}
+		if err := saveSyncKey(ctx, tx, t.collection, last); err != nil {
+			tx.Rollback()
+			return total, err
+		}
+		if err := tx.Commit(); err != nil {
+			return total, err
+		}
+
+		total += len(docs)
+		logger.Debug("copied documents",
+			zap.Int("total", total),
+			zap.Time("updatedAt", last.updatedAt),
+			zap.String("lastId", last.id))
+	}
+}
+
+// find returns the documents after the position [after], in order of update time and id.
+func (t *table[T]) find(ctx context.Context, collection *mongo.Collection, after syncKey, pageSize int64) ([]T, error) {
+
+	filter := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: t.updatedAt, Value: bson.M{"$gt": after.updatedAt}}},
+		bson.D{{Key: t.updatedAt, Value: after.updatedAt}, {Key: "_id", Value: bson.M{"$gt": after.id}}},
+	}}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: t.updatedAt, Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(pageSize)
+
+	cur, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []T
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// loadSyncKey returns the position of the last document of [collection] copied,
+// the zero position when no document was copied yet.
+func loadSyncKey(ctx context.Context, pg *sql.DB, collection string) (syncKey, error) {
+	var key syncKey
+	err := pg.QueryRowContext(ctx,
+		`SELECT updated_at, last_id FROM sync_state WHERE collection = $1`, collection).
+		Scan(&key.updatedAt, &key.id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return syncKey{}, nil
+	}
+	return key, err
+}
+
+func saveSyncKey(ctx context.Context, tx *sql.Tx, collection string, key syncKey) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO sync_state (collection, updated_at, last_id) VALUES ($1, $2, $3)
+		ON CONFLICT (collection) DO UPDATE SET updated_at = EXCLUDED.updated_at, last_id = EXCLUDED.last_id`,
+		collection, key.updatedAt, key.id)
+	return err
+}
+
+// syncVaaCounts copies the vaa counts by chain.
+func (j *PostgresSyncJob) syncVaaCounts(ctx context.Context) (int, error) {
+
+	cur, err := j.db.Collection(vaaCounts).Find(ctx, bson.D{})
+	if err != nil {
+		return 0, err
+	}
+	var counts []vaaCountDoc
+	if err := cur.All(ctx, &counts); err != nil {
+		return 0, err
+	}
+
+	tx, err := j.pg.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	for _, c := range counts {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO vaa_counts (chain_id, count) VALUES ($1, $2)
+			ON CONFLICT (chain_id) DO UPDATE SET count = EXCLUDED.count`, c.ChainID, c.Count); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	return len(counts), tx.Commit()
+}
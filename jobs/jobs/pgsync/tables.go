@@ -0,0 +1,197 @@
+package pgsync
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// collections without a constant in the common repository package.
+const (
+	vaasPythnet = "vaasPythnet"
+	vaaCounts   = "vaaCounts"
+)
+
+// tables are the collections copied to PostgreSQL, in the order they are copied.
+var tables = []syncer{
+	&table[vaaDoc]{collection: repository.Vaas, updatedAt: "updatedAt", upsert: vaaUpsert("vaas"), row: vaaRow},
+	&table[vaaDoc]{collection: vaasPythnet, updatedAt: "updatedAt", upsert: vaaUpsert("vaas_pythnet"), row: vaaRow},
+	&table[vaaDoc]{collection: repository.DuplicateVaas, updatedAt: "updatedAt", upsert: duplicateVaaUpsert, row: duplicateVaaRow},
+	&table[parsedVaaDoc]{collection: repository.ParsedVaa, updatedAt: "updatedAt", upsert: parsedVaaUpsert, row: parsedVaaRow},
+	&table[globalTransactionDoc]{collection: repository.GlobalTransactions, updatedAt: "originTx.updatedAt", upsert: globalTransactionUpsert, row: globalTransactionRow},
+	&table[observationDoc]{collection: repository.Observations, updatedAt: "updatedAt", upsert: observationUpsert, row: observationRow},
+}
+
+// vaaDoc is a document of the vaas, vaasPythnet and duplicateVaas collections.
+type vaaDoc struct {
+	ID               string                     `bson:"_id"`
+	VaaID            string                     `bson:"vaaId"`
+	Version          int                        `bson:"version"`
+	EmitterChain     int                        `bson:"emitterChain"`
+	EmitterAddr      string                     `bson:"emitterAddr"`
+	Sequence         string                     `bson:"sequence"`
+	GuardianSetIndex int64                      `bson:"guardianSetIndex"`
+	Vaa              repository.CompressedBytes `bson:"vaas"`
+	Digest           string                     `bson:"digest"`
+	TxHash           *string                    `bson:"txHash"`
+	IsDuplicated     bool                       `bson:"isDuplicated"`
+	Conflict         bool                       `bson:"conflict"`
+	Timestamp        *time.Time                 `bson:"timestamp"`
+	IndexedAt        *time.Time                 `bson:"indexedAt"`
+	UpdatedAt        *time.Time                 `bson:"updatedAt"`
+}
+
+// vaaUpsert returns the upsert statement of the vaas of [table].
+// The raw vaa removed from mongodb by the archive job is kept in the table.
+func vaaUpsert(table string) string {
+	return fmt.Sprintf(`INSERT INTO %[1]s (id, version, emitter_chain, emitter_addr, sequence, guardian_set_index, vaa,
+		digest, tx_hash, is_duplicated, conflict, timestamp, indexed_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	ON CONFLICT (id) DO UPDATE SET version = EXCLUDED.version, emitter_chain = EXCLUDED.emitter_chain,
+		emitter_addr = EXCLUDED.emitter_addr, sequence = EXCLUDED.sequence,
+		guardian_set_index = EXCLUDED.guardian_set_index, vaa = COALESCE(NULLIF(EXCLUDED.vaa, ''::bytea), %[1]s.vaa),
+		digest = EXCLUDED.digest, tx_hash = EXCLUDED.tx_hash, is_duplicated = EXCLUDED.is_duplicated,
+		conflict = EXCLUDED.conflict, timestamp = EXCLUDED.timestamp, indexed_at = EXCLUDED.indexed_at,
+		updated_at = EXCLUDED.updated_at`, table)
+}
+
+func vaaRow(d *vaaDoc) (syncKey, []any, error) {
+	return docKey(d.ID, d.UpdatedAt), []any{d.ID, d.Version, d.EmitterChain, d.EmitterAddr, d.Sequence,
+		d.GuardianSetIndex, rawVaa(d.Vaa), d.Digest, d.TxHash, d.IsDuplicated, d.Conflict, d.Timestamp,
+		d.IndexedAt, d.UpdatedAt}, nil
+}
+
+const duplicateVaaUpsert = `INSERT INTO duplicate_vaas (id, vaa_id, version, emitter_chain, emitter_addr, sequence,
+		guardian_set_index, vaa, digest, tx_hash, conflict, timestamp, indexed_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	ON CONFLICT (id) DO UPDATE SET vaa_id = EXCLUDED.vaa_id, version = EXCLUDED.version,
+		emitter_chain = EXCLUDED.emitter_chain, emitter_addr = EXCLUDED.emitter_addr, sequence = EXCLUDED.sequence,
+		guardian_set_index = EXCLUDED.guardian_set_index, vaa = EXCLUDED.vaa, digest = EXCLUDED.digest,
+		tx_hash = EXCLUDED.tx_hash, conflict = EXCLUDED.conflict, timestamp = EXCLUDED.timestamp,
+		indexed_at = EXCLUDED.indexed_at, updated_at = EXCLUDED.updated_at`
+
+func duplicateVaaRow(d *vaaDoc) (syncKey, []any, error) {
+	return docKey(d.ID, d.UpdatedAt), []any{d.ID, d.VaaID, d.Version, d.EmitterChain, d.EmitterAddr, d.Sequence,
+		d.GuardianSetIndex, rawVaa(d.Vaa), d.Digest, d.TxHash, d.Conflict, d.Timestamp, d.IndexedAt, d.UpdatedAt}, nil
+}
+
+// parsedVaaDoc is a document of the parsedVaa collection.
+type parsedVaaDoc struct {
+	ID                        string        `bson:"_id"`
+	AppIDs                    []string      `bson:"appIds"`
+	ParsedPayload             bson.RawValue `bson:"parsedPayload"`
+	RawStandardizedProperties struct {
+		ToChain int `bson:"toChain"`
+	} `bson:"rawStandardizedProperties"`
+	IndexedAt *time.Time `bson:"indexedAt"`
+	UpdatedAt *time.Time `bson:"updatedAt"`
+}
+
+const parsedVaaUpsert = `INSERT INTO parsed_vaa (id, app_ids, parsed_payload, raw_to_chain, indexed_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (id) DO UPDATE SET app_ids = EXCLUDED.app_ids, parsed_payload = EXCLUDED.parsed_payload,
+		raw_to_chain = EXCLUDED.raw_to_chain, indexed_at = EXCLUDED.indexed_at, updated_at = EXCLUDED.updated_at`
+
+func parsedVaaRow(d *parsedVaaDoc) (syncKey, []any, error) {
+	appIDs := d.AppIDs
+	if appIDs == nil {
+		appIDs = []string{}
+	}
+	// the payload is stored as relaxed extended json, which is plain json for the values of the parsed payloads.
+	var payload []byte
+	if d.ParsedPayload.Type == bsontype.EmbeddedDocument {
+		var err error
+		payload, err = bson.MarshalExtJSON(d.ParsedPayload.Document(), false, false)
+		if err != nil {
+			return syncKey{}, nil, fmt.Errorf("failed to encode parsed payload of %s: %w", d.ID, err)
+		}
+	}
+	var toChain any
+	if d.RawStandardizedProperties.ToChain != 0 {
+		toChain = d.RawStandardizedProperties.ToChain
+	}
+	return docKey(d.ID, d.UpdatedAt), []any{d.ID, pq.Array(appIDs), payload, toChain, d.IndexedAt, d.UpdatedAt}, nil
+}
+
+// globalTransactionDoc is a document of the globalTransactions collection.
+type globalTransactionDoc struct {
+	ID       string `bson:"_id"`
+	OriginTx struct {
+		NativeTxHash string `bson:"nativeTxHash"`
+		Attribute    struct {
+			Value struct {
+				OriginTxHash string `bson:"originTxHash"`
+			} `bson:"value"`
+		} `bson:"attribute"`
+		UpdatedAt *time.Time `bson:"updatedAt"`
+	} `bson:"originTx"`
+}
+
+const globalTransactionUpsert = `INSERT INTO global_transactions (id, native_tx_hash, origin_tx_hash)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (id) DO UPDATE SET native_tx_hash = EXCLUDED.native_tx_hash, origin_tx_hash = EXCLUDED.origin_tx_hash`
+
+func globalTransactionRow(d *globalTransactionDoc) (syncKey, []any, error) {
+	return docKey(d.ID, d.OriginTx.UpdatedAt), []any{d.ID, nullString(d.OriginTx.NativeTxHash),
+		nullString(d.OriginTx.Attribute.Value.OriginTxHash)}, nil
+}
+
+// observationDoc is a document of the observations collection.
+type observationDoc struct {
+	ID           string     `bson:"_id"`
+	EmitterChain int        `bson:"emitterChain"`
+	EmitterAddr  string     `bson:"emitterAddr"`
+	Sequence     string     `bson:"sequence"`
+	GuardianAddr string     `bson:"guardianAddr"`
+	Hash         []byte     `bson:"hash"`
+	IndexedAt    *time.Time `bson:"indexedAt"`
+	UpdatedAt    *time.Time `bson:"updatedAt"`
+}
+
+const observationUpsert = `INSERT INTO observations (id, emitter_chain, emitter_addr, sequence, guardian_addr, hash, indexed_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (id) DO UPDATE SET emitter_chain = EXCLUDED.emitter_chain, emitter_addr = EXCLUDED.emitter_addr,
+		sequence = EXCLUDED.sequence, guardian_addr = EXCLUDED.guardian_addr, hash = EXCLUDED.hash,
+		indexed_at = EXCLUDED.indexed_at`
+
+func observationRow(d *observationDoc) (syncKey, []any, error) {
+	hash := d.Hash
+	if hash == nil {
+		hash = []byte{}
+	}
+	return docKey(d.ID, d.UpdatedAt), []any{d.ID, d.EmitterChain, d.EmitterAddr, d.Sequence, d.GuardianAddr,
+		hash, d.IndexedAt}, nil
+}
+
+// vaaCountDoc is a document of the vaaCounts collection.
+type vaaCountDoc struct {
+	ChainID int   `bson:"_id"`
+	Count   int64 `bson:"count"`
+}
+
+func docKey(id string, updatedAt *time.Time) syncKey {
+	key := syncKey{id: id}
+	if updatedAt != nil {
+		key.updatedAt = *updatedAt
+	}
+	return key
+}
+
+// rawVaa returns the raw vaa to store in the not null vaa column, empty when it was removed by the archive job.
+func rawVaa(vaa repository.CompressedBytes) []byte {
+	if vaa == nil {
+		return []byte{}
+	}
+	return vaa
+}
+
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,75 @@
+package pgsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestVaaRow(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	doc := vaaDoc{
+		ID:        "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1",
+		Version:   1,
+		Vaa:       repository.CompressedBytes{0x01, 0x02},
+		UpdatedAt: &updatedAt,
+	}
+
+	key, values, err := vaaRow(&doc)
+	assert.NoError(t, err)
+	assert.Equal(t, syncKey{updatedAt: updatedAt, id: doc.ID}, key)
+	assert.Len(t, values, 14)
+	assert.Equal(t, []byte{0x01, 0x02}, values[6])
+}
+
+func TestVaaRowWithoutRawVaa(t *testing.T) {
+	// the raw vaa removed by the archive job is stored empty, the upsert keeps the raw vaa already copied.
+	_, values, err := vaaRow(&vaaDoc{ID: "1/emitter/1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{}, values[6])
+	assert.Equal(t, "1/emitter/1", values[0])
+}
+
+func TestParsedVaaRow(t *testing.T) {
+	payload, err := bson.Marshal(bson.D{{Key: "amount", Value: "1000"}, {Key: "toChain", Value: int32(2)}})
+	assert.NoError(t, err)
+	doc := parsedVaaDoc{
+		ID:            "1/emitter/1",
+		AppIDs:        []string{"PORTAL_TOKEN_BRIDGE"},
+		ParsedPayload: bson.RawValue{Type: bson.TypeEmbeddedDocument, Value: payload},
+	}
+	doc.RawStandardizedProperties.ToChain = 2
+
+	_, values, err := parsedVaaRow(&doc)
+	assert.NoError(t, err)
+	assert.Equal(t, pq.Array([]string{"PORTAL_TOKEN_BRIDGE"}), values[1])
+	assert.JSONEq(t, `{"amount":"1000","toChain":2}`, string(values[2].([]byte)))
+	assert.Equal(t, 2, values[3])
+}
+
+func TestParsedVaaRowWithoutPayload(t *testing.T) {
+	_, values, err := parsedVaaRow(&parsedVaaDoc{ID: "1/emitter/1"})
+	assert.NoError(t, err)
+	// the app ids column is not null, the payload and the destination chain are.
+	assert.Equal(t, pq.Array([]string{}), values[1])
+	assert.Nil(t, values[2])
+	assert.Nil(t, values[3])
+}
+
+func TestGlobalTransactionRow(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	var doc globalTransactionDoc
+	doc.ID = "1/emitter/1"
+	doc.OriginTx.NativeTxHash = "0xabc"
+	doc.OriginTx.UpdatedAt = &updatedAt
+
+	key, values, err := globalTransactionRow(&doc)
+	assert.NoError(t, err)
+	assert.Equal(t, syncKey{updatedAt: updatedAt, id: doc.ID}, key)
+	assert.Equal(t, "0xabc", *values[1].(*string))
+	assert.Nil(t, values[2])
+}
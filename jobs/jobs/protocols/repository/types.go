@@ -2,10 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/httpclient"
 	"github.com/wormhole-foundation/wormhole-explorer/jobs/jobs/protocols/internal/commons"
 	"go.uber.org/zap"
-	"net/http"
-	"time"
 )
 
 type ProtocolRepository interface {
@@ -39,10 +40,10 @@ type Activity struct {
 var ProtocolsRepositoryFactory = map[string]func(url string, logger *zap.Logger) ProtocolRepository{
 
 	commons.MayanProtocol: func(baseURL string, logger *zap.Logger) ProtocolRepository {
-		return NewMayanRestClient(baseURL, logger, &http.Client{})
+		return NewMayanRestClient(baseURL, logger, httpclient.Default())
 	},
 
 	commons.AllBridgeProtocol: func(baseURL string, logger *zap.Logger) ProtocolRepository {
-		return NewAllBridgeRestClient(baseURL, logger, &http.Client{})
+		return NewAllBridgeRestClient(baseURL, logger, httpclient.Default())
 	},
 }
@@ -0,0 +1,283 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/prices"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// Sources of the fee revenue.
+const (
+	// FeeSourcePayload is the fee set in the payload of the token bridge transfers,
+	// paid in the transferred token to the relayer that redeems the transfer.
+	FeeSourcePayload = "payload"
+	// FeeSourceRelayer is the budget paid to the standard relayer for the deliveries,
+	// in the native token of the target chain.
+	FeeSourceRelayer = "relayer"
+)
+
+// nativeTokenAddress is the address of the native tokens of the chains.
+const nativeTokenAddress = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// FeeRevenueJob materializes, for a day, the fee revenue of the bridge by chain and token.
+// The chain of the revenue is the target chain of the transfers, where the fees are collected.
+type FeeRevenueJob struct {
+	parsedVaa      *mongo.Collection
+	relays         *mongo.Collection
+	revenue        *mongo.Collection
+	getPriceByTime GetPriceByTimeFn
+	tokenProvider  *domain.TokenProvider
+	day            time.Time
+	logger         *zap.Logger
+}
+
+// FeeRevenue is the fee revenue of a token in a chain during a day.
+type FeeRevenue struct {
+	ID           string    `bson:"_id"`
+	Date         time.Time `bson:"date"`
+	Source       string    `bson:"source"`
+	ChainID      uint16    `bson:"chainId"`
+	TokenChain   uint16    `bson:"tokenChain"`
+	TokenAddress string    `bson:"tokenAddress"`
+	Symbol       string    `bson:"symbol,omitempty"`
+	// Amount is the fee amount in token units, it is empty when the token is not known.
+	Amount string `bson:"amount,omitempty"`
+	// AmountUSD is the fee amount in USD at the price of the token of the day.
+	AmountUSD float64   `bson:"amountUsd"`
+	Count     int64     `bson:"count"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+// FeeTotal is the sum of the fees of a token in a chain.
+type FeeTotal struct {
+	Source       string
+	ChainID      sdk.ChainID
+	TokenChain   sdk.ChainID
+	TokenAddress string
+	// NormalizedAmount is the fee amount normalized to 8 decimals, nil when only the amount in USD is known.
+	NormalizedAmount *big.Int
+	// AmountUSD is the fee amount in USD when it is known by the source.
+	AmountUSD float64
+	Count     int64
+}
+
+// feeTotalDoc is the result of grouping the fees by chain and token.
+type feeTotalDoc struct {
+	Key struct {
+		ChainID      uint16 `bson:"chainId"`
+		TokenChain   uint16 `bson:"tokenChain"`
+		TokenAddress string `bson:"tokenAddress"`
+	} `bson:"_id"`
+	Amount    primitive.Decimal128 `bson:"amount"`
+	AmountUSD float64              `bson:"amountUsd"`
+	Count     int64                `bson:"count"`
+}
+
+// NewFeeRevenueJob creates an instance of the fee revenue job for the UTC day of [day].
+func NewFeeRevenueJob(db *mongo.Database, day time.Time, getPriceByTime GetPriceByTimeFn, tokenProvider *domain.TokenProvider, logger *zap.Logger) *FeeRevenueJob {
+	return &FeeRevenueJob{
+		parsedVaa:      db.Collection("parsedVaa"),
+		relays:         db.Collection("relays"),
+		revenue:        db.Collection(repository.FeeRevenue),
+		getPriceByTime: getPriceByTime,
+		tokenProvider:  tokenProvider,
+		day:            day.UTC().Truncate(24 * time.Hour),
+		logger:         logger.With(zap.String("module", "FeeRevenueJob")),
+	}
+}
+
+// Run computes and stores the fee revenue of the day of the job.
+func (j *FeeRevenueJob) Run(ctx context.Context) error {
+
+	j.logger.Info("running fee revenue job", zap.Time("day", j.day))
+
+	payloadFees, err := j.findPayloadFees(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get payload fees: %w", err)
+	}
+	relayerFees, err := j.findRelayerFees(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get relayer fees: %w", err)
+	}
+
+	revenue := ComputeFeeRevenue(ctx, append(payloadFees, relayerFees...), j.day, j.tokenProvider, j.getPriceByTime, time.Now().UTC(), j.logger)
+	if len(revenue) == 0 {
+		j.logger.Info("no fee revenue found", zap.Time("day", j.day))
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(revenue))
+	for _, r := range revenue {
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: r.ID}}).
+			SetReplacement(r).
+			SetUpsert(true))
+	}
+	_, err = j.revenue.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		j.logger.Error("failed writing fee revenue", zap.Error(err))
+		return err
+	}
+
+	j.logger.Info("fee revenue recorded", zap.Time("day", j.day), zap.Int("revenue", len(revenue)))
+	return nil
+}
+
+// ComputeFeeRevenue returns the fee revenue of the day from the fee totals, with the amounts
+// in token units and in USD at the price of the token of the day.
+// The amount in USD is zero when the price of the token is not known.
+func ComputeFeeRevenue(ctx context.Context, totals []FeeTotal, day time.Time, tokenProvider *domain.TokenProvider,
+	getPriceByTime GetPriceByTimeFn, now time.Time, logger *zap.Logger) []FeeRevenue {
+
+	revenue := make([]FeeRevenue, 0, len(totals))
+	for _, t := range totals {
+		r := FeeRevenue{
+			ID:           fmt.Sprintf("%s/%s/%d/%d/%s", day.Format(time.DateOnly), t.Source, t.ChainID, t.TokenChain, t.TokenAddress),
+			Date:         day,
+			Source:       t.Source,
+			ChainID:      uint16(t.ChainID),
+			TokenChain:   uint16(t.TokenChain),
+			TokenAddress: t.TokenAddress,
+			AmountUSD:    t.AmountUSD,
+			Count:        t.Count,
+			UpdatedAt:    now,
+		}
+
+		m, ok := tokenProvider.GetTokenByAddress(t.TokenChain, t.TokenAddress)
+		if ok {
+			r.Symbol = m.Symbol.String()
+		}
+		if ok && t.NormalizedAmount != nil {
+			exp := m.Decimals
+			if exp > 8 {
+				exp = 8
+			}
+			r.Amount = decimal.NewFromBigInt(t.NormalizedAmount, -int32(exp)).String()
+			price, err := getPriceByTime(ctx, m.CoingeckoID, day)
+			if err != nil {
+				logger.Warn("failed to get token price", zap.Error(err), zap.String("coingeckoId", m.CoingeckoID), zap.Time("day", day))
+			} else {
+				r.AmountUSD, _ = prices.CalculatePriceUSD(price, t.NormalizedAmount, m.Decimals).Float64()
+			}
+		}
+		revenue = append(revenue, r)
+	}
+
+	sort.Slice(revenue, func(i, j int) bool {
+		return revenue[i].ID < revenue[j].ID
+	})
+	return revenue
+}
+
+// findPayloadFees sums the relayer fees set in the payload of the vaas of the day by target chain and token.
+func (j *FeeRevenueJob) findPayloadFees(ctx context.Context) ([]FeeTotal, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "timestamp", Value: bson.D{
+				{Key: "$gte", Value: j.day},
+				{Key: "$lt", Value: j.day.Add(24 * time.Hour)},
+			}},
+			{Key: "relayerFee", Value: bson.D{{Key: "$exists", Value: true}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "chainId", Value: "$standardizedProperties.toChain"},
+				{Key: "tokenChain", Value: "$relayerFee.tokenChain"},
+				{Key: "tokenAddress", Value: "$relayerFee.tokenAddress"},
+			}},
+			{Key: "amount", Value: bson.D{{Key: "$sum", Value: bson.D{
+				{Key: "$convert", Value: bson.D{
+					{Key: "input", Value: "$relayerFee.normalizedAmount"},
+					{Key: "to", Value: "decimal"},
+					{Key: "onError", Value: 0},
+					{Key: "onNull", Value: 0},
+				}},
+			}}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+	docs, err := j.aggregate(ctx, j.parsedVaa, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make([]FeeTotal, 0, len(docs))
+	for _, d := range docs {
+		amount, err := decimal.NewFromString(d.Amount.String())
+		if err != nil {
+			j.logger.Warn("invalid payload fee amount", zap.Error(err), zap.String("amount", d.Amount.String()))
+			continue
+		}
+		totals = append(totals, FeeTotal{
+			Source:           FeeSourcePayload,
+			ChainID:          sdk.ChainID(d.Key.ChainID),
+			TokenChain:       sdk.ChainID(d.Key.TokenChain),
+			TokenAddress:     d.Key.TokenAddress,
+			NormalizedAmount: amount.BigInt(),
+			Count:            d.Count,
+		})
+	}
+	return totals, nil
+}
+
+// findRelayerFees sums the budget in USD of the standard relayer deliveries completed during the day by target chain.
+func (j *FeeRevenueJob) findRelayerFees(ctx context.Context) ([]FeeTotal, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "data.completedAt", Value: bson.D{
+				{Key: "$gte", Value: j.day},
+				{Key: "$lt", Value: j.day.Add(24 * time.Hour)},
+			}},
+			{Key: "data.metadata.deliveryRecord.budgetUsd", Value: bson.D{{Key: "$gt", Value: 0}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "chainId", Value: "$data.metadata.instructions.targetChainId"},
+			}},
+			{Key: "amountUsd", Value: bson.D{{Key: "$sum", Value: "$data.metadata.deliveryRecord.budgetUsd"}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+	docs, err := j.aggregate(ctx, j.relays, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make([]FeeTotal, 0, len(docs))
+	for _, d := range docs {
+		totals = append(totals, FeeTotal{
+			Source:       FeeSourceRelayer,
+			ChainID:      sdk.ChainID(d.Key.ChainID),
+			TokenChain:   sdk.ChainID(d.Key.ChainID),
+			TokenAddress: nativeTokenAddress,
+			AmountUSD:    d.AmountUSD,
+			Count:        d.Count,
+		})
+	}
+	return totals, nil
+}
+
+func (j *FeeRevenueJob) aggregate(ctx context.Context, collection *mongo.Collection, pipeline mongo.Pipeline) ([]feeTotalDoc, error) {
+	cur, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var docs []feeTotalDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
@@ -0,0 +1,54 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func Test_ComputeFeeRevenue(t *testing.T) {
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	now := day.Add(25 * time.Hour)
+	usdc := "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+	getPriceByTime := func(_ context.Context, coingeckoID string, _ time.Time) (decimal.Decimal, error) {
+		if coingeckoID == "usd-coin" {
+			return decimal.NewFromInt(1), nil
+		}
+		return decimal.Zero, errors.New("price not found")
+	}
+	totals := []FeeTotal{
+		{Source: FeeSourceRelayer, ChainID: sdk.ChainIDEthereum, TokenChain: sdk.ChainIDEthereum, TokenAddress: nativeTokenAddress, AmountUSD: 12.5, Count: 3},
+		// 2.5 USDC normalized to 6 decimals.
+		{Source: FeeSourcePayload, ChainID: sdk.ChainIDSolana, TokenChain: sdk.ChainIDEthereum, TokenAddress: usdc, NormalizedAmount: big.NewInt(2500000), Count: 2},
+		// unknown token
+		{Source: FeeSourcePayload, ChainID: sdk.ChainIDSolana, TokenChain: sdk.ChainIDEthereum, TokenAddress: "00ff", NormalizedAmount: big.NewInt(100), Count: 1},
+	}
+
+	revenue := ComputeFeeRevenue(context.Background(), totals, day, domain.NewTokenProvider(domain.P2pMainNet), getPriceByTime, now, zap.NewNop())
+
+	assert.Len(t, revenue, 3)
+	assert.Equal(t, "2024-05-01/payload/1/2/"+usdc, revenue[0].ID)
+	assert.Equal(t, "USDC", revenue[0].Symbol)
+	assert.Equal(t, "2.5", revenue[0].Amount)
+	assert.Equal(t, 2.5, revenue[0].AmountUSD)
+	assert.Equal(t, int64(2), revenue[0].Count)
+	assert.Equal(t, day, revenue[0].Date)
+	assert.Equal(t, now, revenue[0].UpdatedAt)
+
+	assert.Equal(t, "2024-05-01/payload/1/2/00ff", revenue[1].ID)
+	assert.Equal(t, "", revenue[1].Amount)
+	assert.Equal(t, 0.0, revenue[1].AmountUSD)
+
+	assert.Equal(t, "2024-05-01/relayer/2/2/"+nativeTokenAddress, revenue[2].ID)
+	assert.Equal(t, "ETH", revenue[2].Symbol)
+	assert.Equal(t, "", revenue[2].Amount)
+	assert.Equal(t, 12.5, revenue[2].AmountUSD)
+}
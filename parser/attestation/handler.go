@@ -0,0 +1,80 @@
+package attestation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaapayload"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Handler stores the token attestations emitted by the token bridges.
+type Handler struct {
+	repository *Repository
+	p2pNetwork string
+	logger     *zap.Logger
+}
+
+// NewHandler creates a new token attestation handler.
+func NewHandler(repository *Repository, p2pNetwork string, logger *zap.Logger) *Handler {
+	return &Handler{
+		repository: repository,
+		p2pNetwork: p2pNetwork,
+		logger:     logger.With(zap.String("module", "AttestationHandler")),
+	}
+}
+
+// IsAttestationVaa returns true if the vaa is a token attestation emitted by a known token bridge.
+func (h *Handler) IsAttestationVaa(vaa *sdk.VAA) bool {
+	return vaapayload.IsAttestation(vaa.Payload) &&
+		domain.GetAppIDByEmitter(h.p2pNetwork, vaa.EmitterChain, vaa.EmitterAddress.String()) == domain.AppIdPortalTokenBridge
+}
+
+// Handle decodes and stores a token attestation, the other vaas are ignored.
+//
+// An attestation that cannot be decoded is logged and discarded because decoding it again gives the same result.
+// The errors returned are storage errors, so the vaa can be retried.
+func (h *Handler) Handle(ctx context.Context, trackID string, vaa *sdk.VAA) error {
+	if !h.IsAttestationVaa(vaa) {
+		return nil
+	}
+
+	meta, err := vaapayload.DecodeAttestation(vaa.Payload)
+	if err != nil {
+		h.logger.Warn("Token attestation VAA cannot be decoded", zap.Error(err),
+			zap.String("trackId", trackID),
+			zap.String("id", vaa.MessageID()))
+		return nil
+	}
+
+	now := time.Now()
+	doc := TokenAttestationDoc{
+		ID:           vaa.MessageID(),
+		TokenChain:   meta.TokenChain,
+		TokenAddress: meta.TokenAddress,
+		Decimals:     meta.Decimals,
+		Symbol:       meta.Symbol,
+		Name:         meta.Name,
+		EmitterChain: vaa.EmitterChain,
+		EmitterAddr:  vaa.EmitterAddress.String(),
+		Sequence:     fmt.Sprintf("%d", vaa.Sequence),
+		Timestamp:    vaa.Timestamp,
+		UpdatedAt:    &now,
+	}
+	if err := h.repository.UpsertTokenAttestation(ctx, &doc); err != nil {
+		h.logger.Error("Error inserting token attestation in repository", zap.Error(err),
+			zap.String("trackId", trackID),
+			zap.String("id", doc.ID))
+		return err
+	}
+
+	h.logger.Info("Token attestation VAA was successfully processed",
+		zap.String("trackId", trackID),
+		zap.String("id", doc.ID),
+		zap.Uint16("tokenChain", uint16(meta.TokenChain)),
+		zap.String("tokenAddress", meta.TokenAddress))
+	return nil
+}
@@ -0,0 +1,60 @@
+package attestation
+
+import (
+	"context"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// TokenAttestationCollection contains the token attestations decoded from the token bridge vaas.
+const TokenAttestationCollection = "tokenAttestations"
+
+// TokenAttestationDoc represents a token attested by the token bridge of a chain.
+type TokenAttestationDoc struct {
+	ID           string      `bson:"_id"`
+	TokenChain   sdk.ChainID `bson:"tokenChain"`
+	TokenAddress string      `bson:"tokenAddress"`
+	Decimals     uint8       `bson:"decimals"`
+	Symbol       string      `bson:"symbol"`
+	Name         string      `bson:"name"`
+	EmitterChain sdk.ChainID `bson:"emitterChain"`
+	EmitterAddr  string      `bson:"emitterAddr"`
+	Sequence     string      `bson:"sequence"`
+	Timestamp    time.Time   `bson:"timestamp"`
+	UpdatedAt    *time.Time  `bson:"updatedAt"`
+}
+
+// Repository definitions.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		tokenAttestations *mongo.Collection
+	}
+}
+
+// NewRepository create a new respository instance.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db, logger, struct {
+		tokenAttestations *mongo.Collection
+	}{
+		tokenAttestations: db.Collection(TokenAttestationCollection),
+	}}
+}
+
+// UpsertTokenAttestation saves a decoded token attestation.
+func (r *Repository) UpsertTokenAttestation(ctx context.Context, doc *TokenAttestationDoc) error {
+	update := bson.M{
+		"$set":         doc,
+		"$setOnInsert": repository.IndexedAt(*doc.UpdatedAt),
+	}
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collections.tokenAttestations.UpdateByID(ctx, doc.ID, update, opts)
+	return err
+}
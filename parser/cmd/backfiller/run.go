@@ -10,11 +10,14 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/attestation"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/config"
-	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/governance"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/processor"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/schema"
 	"go.uber.org/zap"
 )
 
@@ -76,8 +79,20 @@ func Run(config *config.BackfillerConfiguration) {
 	// create a token provider
 	tokenProvider := domain.NewTokenProvider(config.P2pNetwork)
 
+	// create a handler for the governance vaas
+	governanceHandler := governance.NewHandler(governance.NewRepository(db.Database, logger), logger)
+
+	// create a handler for the token attestation vaas
+	attestationHandler := attestation.NewHandler(attestation.NewRepository(db.Database, logger), config.P2pNetwork, logger)
+
+	// create the registry of the payload schemas registered by third-party protocols
+	schemaRegistry := schema.NewRegistry(schema.NewRepository(db.Database, logger), nil, logger)
+	if err := schemaRegistry.Reload(rootCtx); err != nil {
+		logger.Fatal("Failed to load payload schemas", zap.Error(err))
+	}
+
 	//create a processor
-	eventProcessor := processor.New(parserVAAAPIClient, parserRepository, alert.NewDummyClient(), metrics.NewDummyMetrics(), tokenProvider, logger)
+	eventProcessor := processor.New(parserVAAAPIClient, parserRepository, alert.NewDummyClient(), metrics.NewNoopParserMetrics(), tokenProvider, domain.NewUnknownChainTracker(), governanceHandler, attestationHandler, schemaRegistry, nil, nil, logger)
 
 	logger.Info("Started wormhole-explorer-parser as backfiller")
 
@@ -0,0 +1,191 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/attestation"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/config"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/governance"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/processor"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/schema"
+	"go.uber.org/zap"
+)
+
+// report counts the results of the dry run.
+type report struct {
+	total     int
+	unchanged int
+	changed   int
+	added     int
+	removed   int
+	invalid   int
+	failed    int
+	skipped   int
+}
+
+// Run parses again the stored vaas selected by the configuration with the current parser, and reports
+// the differences with the stored parsed vaas without writing anything, to validate the changes of the parser
+// against the production data. It uses the same configuration as the backfiller.
+func Run(config *config.BackfillerConfiguration) {
+
+	rootCtx := context.Background()
+
+	logger := logger.New("wormhole-explorer-parser", logger.WithLevel(config.LogLevel))
+
+	logger.Info("Starting wormhole-explorer-parser as dry run ...")
+
+	startTime, err := time.Parse(time.RFC3339, config.StartTime)
+	if err != nil {
+		logger.Fatal("failed to parse start time", zap.Error(err))
+	}
+
+	endTime := time.Now()
+	if config.EndTime != "" {
+		endTime, err = time.Parse(time.RFC3339, config.EndTime)
+		if err != nil {
+			logger.Fatal("Failed to parse end time", zap.Error(err))
+		}
+	}
+
+	if startTime.After(endTime) {
+		logger.Fatal("Start time should be before end time",
+			zap.String("start_time", startTime.Format(time.RFC3339)),
+			zap.String("end_time", endTime.Format(time.RFC3339)))
+	}
+
+	//setup DB connection
+	db, err := dbutil.Connect(rootCtx, logger, config.MongoURI, config.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("Failed to connect MongoDB", zap.Error(err))
+	}
+
+	parserVAAAPIClient, err := vaaPayloadParser.NewParserVAAAPIClient(config.VaaPayloadParserTimeout, config.VaaPayloadParserURL, logger)
+	if err != nil {
+		logger.Fatal("Failed to create parse vaa api client")
+	}
+
+	query := repository.VaaQuery{
+		StartTime:      &startTime,
+		EndTime:        &endTime,
+		EmitterChainID: config.EmitterChainID,
+		EmitterAddress: config.EmitterAddress,
+		Sequence:       config.Sequence,
+	}
+
+	pagination := repository.Pagination{
+		Page:     0,
+		PageSize: config.PageSize,
+		SortAsc:  config.SortAsc,
+	}
+
+	parserRepository := parser.NewRepository(db.Database, logger)
+	vaaRepository := repository.NewVaaRepository(db.Database, logger)
+
+	// the payload schemas are only read, the governance and attestation handlers are not called in dry runs.
+	schemaRegistry := schema.NewRegistry(schema.NewRepository(db.Database, logger), nil, logger)
+	if err := schemaRegistry.Reload(rootCtx); err != nil {
+		logger.Fatal("Failed to load payload schemas", zap.Error(err))
+	}
+	governanceHandler := governance.NewHandler(governance.NewRepository(db.Database, logger), logger)
+	attestationHandler := attestation.NewHandler(attestation.NewRepository(db.Database, logger), config.P2pNetwork, logger)
+
+	eventProcessor := processor.New(parserVAAAPIClient, parserRepository, alert.NewDummyClient(), metrics.NewNoopParserMetrics(), domain.NewTokenProvider(config.P2pNetwork), domain.NewUnknownChainTracker(), governanceHandler, attestationHandler, schemaRegistry, nil, nil, logger)
+
+	var r report
+	for {
+		vaas, err := vaaRepository.FindPage(rootCtx, query, pagination)
+		if err != nil {
+			logger.Error("Failed to get vaas", zap.Error(err))
+			break
+		}
+		if len(vaas) == 0 {
+			break
+		}
+		for _, v := range vaas {
+			r.total++
+			compare(rootCtx, eventProcessor, parserRepository, v, &r, logger)
+		}
+		logger.Info("Processed page", zap.Int64("page", pagination.Page), zap.Int("total", r.total), zap.Int("changed", r.changed))
+		pagination.Page++
+	}
+
+	logger.Info("Dry run report",
+		zap.Int("total", r.total),
+		zap.Int("unchanged", r.unchanged),
+		zap.Int("changed", r.changed),
+		zap.Int("added", r.added),
+		zap.Int("removed", r.removed),
+		zap.Int("invalid", r.invalid),
+		zap.Int("failed", r.failed),
+		zap.Int("skipped", r.skipped))
+
+	logger.Info("closing MongoDB connection...")
+	db.DisconnectWithTimeout(10 * time.Second)
+
+	logger.Info("Finish wormhole-explorer-parser as dry run")
+}
+
+// compare parses a vaa again and logs the differences with the stored parsed vaa.
+func compare(ctx context.Context, p *processor.Processor, repo *parser.Repository, v *repository.VaaDoc, r *report, logger *zap.Logger) {
+	// the raw vaas moved to the archive are not in the database.
+	if len(v.Vaa) == 0 {
+		r.skipped++
+		return
+	}
+
+	stored, err := repo.FindParsedVaa(ctx, v.ID)
+	if err != nil && !errors.Is(err, parser.ErrDocNotFound) {
+		r.failed++
+		logger.Error("Failed to get stored parsed vaa", zap.String("id", v.ID), zap.Error(err))
+		return
+	}
+
+	params := &processor.Params{Vaa: v.Vaa, TrackID: fmt.Sprintf("dry-run-%s", v.ID), DryRun: true}
+	reparsed, err := p.Process(ctx, params)
+	var validationErr *parser.ValidationError
+	switch {
+	case errors.Is(err, vaaPayloadParser.ErrInternalError) || errors.Is(err, vaaPayloadParser.ErrCallEndpoint):
+		r.failed++
+		logger.Error("Failed to parse vaa", zap.String("id", v.ID), zap.Error(err))
+		return
+	case errors.As(err, &validationErr):
+		r.invalid++
+		logger.Info("Parsed vaa is not valid", zap.String("id", v.ID), zap.Strings("fields", validationErr.Fields))
+		return
+	case err != nil && stored != nil:
+		r.removed++
+		logger.Info("Stored parsed vaa cannot be parsed anymore", zap.String("id", v.ID), zap.Error(err))
+		return
+	case err != nil:
+		r.unchanged++
+		return
+	case stored == nil:
+		r.added++
+		logger.Info("Vaa can be parsed now", zap.String("id", v.ID))
+		return
+	}
+
+	fields, err := parser.Diff(stored, reparsed)
+	if err != nil {
+		r.failed++
+		logger.Error("Failed to compare parsed vaa", zap.String("id", v.ID), zap.Error(err))
+		return
+	}
+	if len(fields) == 0 {
+		r.unchanged++
+		return
+	}
+	r.changed++
+	logger.Info("Parsed vaa changed", zap.String("id", v.ID), zap.Strings("fields", fields))
+}
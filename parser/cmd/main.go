@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/cmd/backfiller"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/cmd/dryrun"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/cmd/service"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/config"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -26,6 +27,7 @@ func execute() error {
 
 	addServiceCommand(root)
 	addBackfiller(root)
+	addDryRun(root)
 
 	return root.Execute()
 }
@@ -102,3 +104,60 @@ func addBackfiller(root *cobra.Command) {
 
 	root.AddCommand(backfillerCommand)
 }
+
+func addDryRun(root *cobra.Command) {
+	var mongoUri, mongoDb, p2pNetwork, vaaPayloadParserURL, logLevel, startTime, endTime, sort, emitterAddress, sequence string
+	var vaaPayloadParserTimeout, pageSize int64
+	var emitterChainID uint16
+
+	dryRunCommand := &cobra.Command{
+		Use:   "dry-run",
+		Short: "Parse the stored vaas again and report the differences with the stored results without writing",
+		Run: func(_ *cobra.Command, _ []string) {
+			cfg := &config.BackfillerConfiguration{
+				LogLevel:                logLevel,
+				MongoURI:                mongoUri,
+				MongoDatabase:           mongoDb,
+				P2pNetwork:              p2pNetwork,
+				VaaPayloadParserURL:     vaaPayloadParserURL,
+				VaaPayloadParserTimeout: vaaPayloadParserTimeout,
+				StartTime:               startTime,
+				EndTime:                 endTime,
+				PageSize:                pageSize,
+				SortAsc:                 strings.ToLower(sort) == "asc",
+			}
+
+			if emitterChainID != 0 {
+				eci := sdk.ChainID(emitterChainID)
+				cfg.EmitterChainID = &eci
+			}
+			if emitterAddress != "" {
+				cfg.EmitterAddress = &emitterAddress
+			}
+			if sequence != "" {
+				cfg.Sequence = &sequence
+			}
+			dryrun.Run(cfg)
+		},
+	}
+	dryRunCommand.Flags().StringVar(&logLevel, "log-level", "INFO", "log level")
+	dryRunCommand.Flags().StringVar(&mongoUri, "mongo-uri", "", "Mongo connection")
+	dryRunCommand.Flags().StringVar(&mongoDb, "mongo-database", "", "Mongo database")
+	dryRunCommand.Flags().StringVar(&p2pNetwork, "p2p-network", "", "P2P network")
+	dryRunCommand.Flags().StringVar(&vaaPayloadParserURL, "vaa-payload-parser-url", "", "VAA payload parser service URL")
+	dryRunCommand.Flags().Int64Var(&vaaPayloadParserTimeout, "vaa-payload-parser-timeout", 10, "maximum waiting time in call to VAA payload service in seconds")
+	dryRunCommand.Flags().StringVar(&startTime, "start-time", "1970-01-01T00:00:00Z", "minimum VAA timestamp to process")
+	dryRunCommand.Flags().StringVar(&endTime, "end-time", "", "maximum VAA timestamp to process (default now)")
+	dryRunCommand.Flags().Int64Var(&pageSize, "page-size", 100, "number of documents retrieved at a time")
+	dryRunCommand.Flags().StringVar(&sort, "sort", "desc", "process VAA in asc/desc order of timestamp")
+	dryRunCommand.Flags().Uint16Var(&emitterChainID, "emitter-chain", 0, "emitter chain id")
+	dryRunCommand.Flags().StringVar(&emitterAddress, "emitter-address", "", "emitter address")
+	dryRunCommand.Flags().StringVar(&sequence, "sequence", "", "sequence")
+
+	dryRunCommand.MarkFlagRequired("mongo-uri")
+	dryRunCommand.MarkFlagRequired("mongo-database")
+	dryRunCommand.MarkFlagRequired("p2p-network")
+	dryRunCommand.MarkFlagRequired("vaa-payload-parser-url")
+
+	root.AddCommand(dryRunCommand)
+}
@@ -5,29 +5,39 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/wormhole-foundation/wormhole-explorer/common/audit"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
 	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
+	common_sqs "github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/attestation"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/config"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/consumer"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/governance"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/http/failures"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/http/infrastructure"
+	schemaHttp "github.com/wormhole-foundation/wormhole-explorer/parser/http/schema"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/http/vaa"
 	parserAlert "github.com/wormhole-foundation/wormhole-explorer/parser/internal/alert"
-	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/metrics"
-	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/sqs"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/migration"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/processor"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/queue"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/schema"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 )
@@ -57,6 +67,23 @@ func Run() {
 
 	logger.Info("Starting wormhole-explorer-parser ...")
 
+	// register the chains not known by the compiled wormhole sdk.
+	chainIDOverrides, err := domain.ParseChainIDOverrides(config.ChainIDOverrides)
+	if err != nil {
+		logger.Fatal("failed to parse chain id overrides", zap.Error(err))
+	}
+	domain.RegisterChainIDs(chainIDOverrides)
+
+	protocolEmitters, err := domain.ParseProtocolEmitters(config.ProtocolEmitters)
+	if err != nil {
+		logger.Fatal("failed to parse protocol emitters", zap.Error(err))
+	}
+
+	emitterRemapping, err := domain.ParseEmitterRemapping(config.EmitterRemapping)
+	if err != nil {
+		logger.Fatal("failed to parse emitter remapping", zap.Error(err))
+	}
+
 	// setup DB connection
 	db, err := dbutil.Connect(rootCtx, logger, config.MongoURI, config.MongoDatabase, false)
 	if err != nil {
@@ -75,6 +102,9 @@ func Run() {
 		logger.Fatal("failed to create alert client", zap.Error(err))
 	}
 
+	// check the chains of the recorded vaas against the chains known by the wormhole sdk.
+	go reconcileChainIDs(rootCtx, db.Database, alertClient, logger)
+
 	// create a metrics
 	metrics := newMetrics(config)
 
@@ -85,11 +115,15 @@ func Run() {
 		logger.Fatal("failed to create parse vaa api client")
 	}
 
+	// create the backoffs applied when getting messages from the sqs queues fails.
+	vaaBackoff := newSQSBackoff(config.PipelineSQSUrl, metrics)
+	notificationBackoff := newSQSBackoff(config.NotificationsSQSUrl, metrics)
+
 	// get vaa consumer function.
-	vaaConsumeFunc := newVAAConsume(rootCtx, config, metrics, logger)
+	vaaConsumeFunc := newVAAConsume(rootCtx, config, metrics, vaaBackoff, logger)
 
 	//get notification consumer function.
-	notificationConsumeFunc := newNotificationConsume(rootCtx, config, metrics, logger)
+	notificationConsumeFunc := newNotificationConsume(rootCtx, config, metrics, notificationBackoff, logger)
 
 	// create a repository
 	repository := parser.NewRepository(db.Database, logger)
@@ -100,23 +134,44 @@ func Run() {
 	if err != nil {
 		logger.Fatal("failed to create health checks", zap.Error(err))
 	}
+	healthChecks = append(healthChecks, vaaBackoff.HealthCheck(), notificationBackoff.HealthCheck())
 	// create a token provider
 	tokenProvider := domain.NewTokenProvider(config.P2pNetwork)
 
+	// create a tracker for chains not known by the wormhole sdk
+	unknownChains := domain.NewUnknownChainTracker()
+
+	// create a handler for the governance vaas
+	governanceHandler := governance.NewHandler(governance.NewRepository(db.Database, logger), logger)
+
+	// create a handler for the token attestation vaas
+	attestationHandler := attestation.NewHandler(attestation.NewRepository(db.Database, logger), config.P2pNetwork, logger)
+
+	// create the registry of the payload schemas of third-party protocols
+	payloadSchemas, err := schema.LoadFile(config.PayloadSchemasFile)
+	if err != nil {
+		logger.Fatal("failed to load payload schemas", zap.Error(err))
+	}
+	schemaRegistry := schema.NewRegistry(schema.NewRepository(db.Database, logger), payloadSchemas, logger)
+	schemaRegistry.Start(rootCtx, time.Minute)
+
 	//create a processor
-	processor := processor.New(parserVAAAPIClient, repository, alertClient, metrics, tokenProvider, logger)
+	processor := processor.New(parserVAAAPIClient, repository, alertClient, metrics, tokenProvider, unknownChains, governanceHandler, attestationHandler, schemaRegistry, protocolEmitters, emitterRemapping, logger)
 
 	// create and start a vaaConsumer
-	vaaConsumer := consumer.New(vaaConsumeFunc, processor.Process, metrics, logger)
+	vaaConsumer := consumer.New(vaaConsumeFunc, processor.Process, metrics, config.ConsumerWorkersSize, config.ConsumerDrainTimeout, logger)
 	vaaConsumer.Start(rootCtx)
 
 	// create and start a notificationConsumer
-	notificationConsumer := consumer.New(notificationConsumeFunc, processor.Process, metrics, logger)
+	notificationConsumer := consumer.New(notificationConsumeFunc, processor.Process, metrics, config.ConsumerWorkersSize, config.ConsumerDrainTimeout, logger)
 	notificationConsumer.Start(rootCtx)
 
 	vaaRepository := vaa.NewRepository(db.Database, logger)
 	vaaController := vaa.NewController(vaaRepository, processor.Process, logger)
-	server := infrastructure.NewServer(logger, config.Port, config.PprofEnabled, vaaController, healthChecks...)
+	schemaController := schemaHttp.NewController(schemaRegistry, logger)
+	failuresController := failures.NewController(repository, logger)
+	auditor := audit.NewAuditor(audit.NewRepository(db.Database), "wormscan-parser", logger)
+	server := infrastructure.NewServer(logger, config.Port, config.PprofEnabled, vaaController, schemaController, failuresController, unknownChains, auditor, config.AdminApiKey, healthChecks...)
 	server.Start()
 
 	logger.Info("Started wormhole-explorer-parser")
@@ -134,6 +189,10 @@ func Run() {
 	logger.Info("root context cancelled, exiting...")
 	rootCtxCancel()
 
+	logger.Info("Waiting for consumers to drain in-flight messages...")
+	vaaConsumer.Wait()
+	notificationConsumer.Wait()
+
 	logger.Info("closing MongoDB connection...")
 	db.DisconnectWithTimeout(10 * time.Second)
 
@@ -170,38 +229,65 @@ func newAwsConfig(appCtx context.Context, cfg *config.ServiceConfiguration) (aws
 	return awsconfig.LoadDefaultConfig(appCtx, awsconfig.WithRegion(region))
 }
 
-func newVAAConsume(appCtx context.Context, config *config.ServiceConfiguration, metrics metrics.Metrics, logger *zap.Logger) queue.ConsumeFunc {
+func newVAAConsume(appCtx context.Context, config *config.ServiceConfiguration, metrics metrics.ParserMetrics, backoff *common_sqs.Backoff, logger *zap.Logger) queue.ConsumeFunc {
 	sqsConsumer, err := newSQSConsumer(appCtx, config, config.PipelineSQSUrl)
 	if err != nil {
 		logger.Fatal("failed to create sqs consumer", zap.Error(err))
 	}
 
-	filterConsumeFunc := newFilterFunc(config)
-	vaaQueue := queue.NewEventSQS(sqsConsumer, queue.NewVaaConverter(logger), filterConsumeFunc, metrics, logger)
+	// the pipeline offloads to S3 the vaas that exceed the maximum message size.
+	awsConfig, err := newAwsConfig(appCtx, config)
+	if err != nil {
+		logger.Fatal("failed to create aws config", zap.Error(err))
+	}
+	codec := payload.NewCodec(payload.WithS3Store(payload.NewS3Store(awsConfig, config.AwsEndpoint), ""))
+	vaaQueue := common_sqs.NewEventQueue(sqsConsumer, queue.NewVaaConverter(logger), common_sqs.EventQueueConfig[queue.Event]{
+		Backoff: backoff,
+		Codec:   codec,
+		Hooks:   newEventQueueHooks(config, metrics),
+	}, logger)
 	return vaaQueue.Consume
 }
 
-func newNotificationConsume(appCtx context.Context, config *config.ServiceConfiguration, metrics metrics.Metrics, logger *zap.Logger) queue.ConsumeFunc {
+func newNotificationConsume(appCtx context.Context, config *config.ServiceConfiguration, metrics metrics.ParserMetrics, backoff *common_sqs.Backoff, logger *zap.Logger) queue.ConsumeFunc {
 	sqsConsumer, err := newSQSConsumer(appCtx, config, config.NotificationsSQSUrl)
 	if err != nil {
 		logger.Fatal("failed to create sqs consumer", zap.Error(err))
 	}
 
-	filterConsumeFunc := newFilterFunc(config)
-	vaaQueue := queue.NewEventSQS(sqsConsumer, queue.NewNotificationEvent(logger), filterConsumeFunc, metrics, logger)
+	vaaQueue := common_sqs.NewEventQueue(sqsConsumer, queue.NewNotificationEvent(logger), common_sqs.EventQueueConfig[queue.Event]{
+		Backoff: backoff,
+		Hooks:   newEventQueueHooks(config, metrics),
+	}, logger)
 	return vaaQueue.Consume
 }
 
+// Create the hooks of the sqs queues, which record the consumed vaas and delete the filtered ones.
+func newEventQueueHooks(config *config.ServiceConfiguration, metrics metrics.ParserMetrics) common_sqs.EventQueueHooks[queue.Event] {
+	return common_sqs.EventQueueHooks[queue.Event]{
+		Received:   func(event *queue.Event) { metrics.IncVaaConsumedQueue(event.ChainID) },
+		Filter:     newFilterFunc(config),
+		Dispatched: func(event *queue.Event) { metrics.IncVaaUnfiltered(event.ChainID) },
+	}
+}
+
+// Create a new backoff for the errors getting messages from a SQS queue.
+func newSQSBackoff(sqsUrl string, metrics metrics.ParserMetrics) *common_sqs.Backoff {
+	return common_sqs.NewBackoff(common_sqs.WithFailureObserver(func(failures int) {
+		metrics.SetSqsConsecutiveFailures(sqsUrl, failures)
+	}))
+}
+
 // Create a new SQS consumer.
-func newSQSConsumer(appCtx context.Context, config *config.ServiceConfiguration, sqsUrl string) (*sqs.Consumer, error) {
+func newSQSConsumer(appCtx context.Context, config *config.ServiceConfiguration, sqsUrl string) (*common_sqs.Consumer, error) {
 	awsconfig, err := newAwsConfig(appCtx, config)
 	if err != nil {
 		return nil, err
 	}
 
-	return sqs.NewConsumer(awsconfig, sqsUrl,
-		sqs.WithMaxMessages(10),
-		sqs.WithVisibilityTimeout(120))
+	return common_sqs.NewConsumer(awsconfig, sqsUrl,
+		common_sqs.WithMaxMessages(10),
+		common_sqs.WithVisibilityTimeout(120))
 }
 
 // Creates a filter depending on whether the execution is local (dummy filter) or not (Pyth filter)
@@ -213,11 +299,11 @@ func newFilterFunc(cfg *config.ServiceConfiguration) queue.FilterConsumeFunc {
 }
 
 // Creates a metrics depending on whether the execution is local (dummy metrics) or not (Prometheus metrics)
-func newMetrics(cfg *config.ServiceConfiguration) metrics.Metrics {
+func newMetrics(cfg *config.ServiceConfiguration) metrics.ParserMetrics {
 	if !cfg.MetricsEnabled {
-		return metrics.NewDummyMetrics()
+		return metrics.NewNoopParserMetrics()
 	}
-	return metrics.NewPrometheusMetrics(cfg.Environment)
+	return metrics.NewPrometheusParserMetrics(cfg.Environment)
 }
 
 func newAlertClient(cfg *config.ServiceConfiguration) (alert.AlertClient, error) {
@@ -252,3 +338,43 @@ func newHealthChecks(
 	}
 	return healthChecks, nil
 }
+
+// reconcileChainIDs logs and alerts when the recorded vaas contain chains not known by the wormhole sdk,
+// so an outdated sdk release does not silently drop the traffic of new chains.
+func reconcileChainIDs(ctx context.Context, db *mongo.Database, alertClient alert.AlertClient, logger *zap.Logger) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	chainIDs, err := repository.NewVaaRepository(db, logger).FindEmitterChains(ctx)
+	if err != nil {
+		logger.Error("failed to get recorded emitter chains", zap.Error(err))
+		return
+	}
+
+	result := domain.ReconcileChainIDs(chainIDs)
+	if len(result.Overridden) > 0 {
+		logger.Info("recorded chains registered by chain id overrides",
+			zap.String("sdkVersion", result.SdkVersion),
+			zap.Any("chainIds", result.Overridden))
+	}
+	if !result.HasMismatches() {
+		logger.Info("recorded chains match the wormhole sdk", zap.String("sdkVersion", result.SdkVersion))
+		return
+	}
+
+	logger.Warn("recorded chains not known by the wormhole sdk",
+		zap.String("sdkVersion", result.SdkVersion),
+		zap.Any("chainIds", result.Unknown))
+
+	unknown := make([]string, 0, len(result.Unknown))
+	for _, chainID := range result.Unknown {
+		unknown = append(unknown, strconv.Itoa(int(chainID)))
+	}
+	alertContext := alert.AlertContext{
+		Details: map[string]string{
+			"sdkVersion": result.SdkVersion,
+			"chainIDs":   strings.Join(unknown, ", "),
+		},
+	}
+	alertClient.CreateAndSend(ctx, parserAlert.AlertKeyUnknownChainIDs, alertContext)
+}
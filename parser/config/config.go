@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
@@ -36,6 +37,22 @@ type ServiceConfiguration struct {
 	AlertEnabled            bool   `env:"ALERT_ENABLED,default=false"`
 	AlertApiKey             string `env:"ALERT_API_KEY"`
 	MetricsEnabled          bool   `env:"METRICS_ENABLED,default=false"`
+	// ChainIDOverrides registers chains not known by the wormhole sdk with the format "id:name,id:name".
+	ChainIDOverrides string `env:"CHAIN_ID_OVERRIDES"`
+	// ConsumerWorkersSize defines the number of workers processing messages, partitioned by emitter to keep their order.
+	ConsumerWorkersSize int `env:"CONSUMER_WORKERS_SIZE,default=1"`
+	// ConsumerDrainTimeout bounds the processing of the in-flight messages on shutdown.
+	ConsumerDrainTimeout time.Duration `env:"CONSUMER_DRAIN_TIMEOUT,default=20s"`
+	// PayloadSchemasFile is a json file with the payload schemas of the third-party protocols.
+	PayloadSchemasFile string `env:"PAYLOAD_SCHEMAS_FILE"`
+	// AdminApiKey is required to register payload schemas with the admin API, an empty key disables it.
+	AdminApiKey string `env:"ADMIN_API_KEY"`
+	// ProtocolEmitters tags the vaas of the emitters of protocols like the fast transfers or the swap layers
+	// with the protocol name, with the format "chainId:emitterAddress:protocol,chainId:emitterAddress:protocol".
+	ProtocolEmitters string `env:"PROTOCOL_EMITTERS"`
+	// EmitterRemapping tags the vaas of the emitters replaced by a redeployed contract (e.g. a new token bridge)
+	// with the new emitter, with the format "chainId:oldEmitterAddress:newEmitterAddress,...".
+	EmitterRemapping string `env:"EMITTER_REMAPPING"`
 }
 
 // BackfillerConfiguration represents the application configuration when running as backfiller with default values.
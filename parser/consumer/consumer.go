@@ -2,8 +2,14 @@ package consumer
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
-	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pipeline"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/processor"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/queue"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -12,54 +18,103 @@ import (
 
 // Consumer consumer struct definition.
 type Consumer struct {
-	consume queue.ConsumeFunc
-	process processor.ProcessorFunc
-	metrics metrics.Metrics
-	logger  *zap.Logger
+	pipeline *pipeline.Pipeline[queue.ConsumerMessage]
+	process  processor.ProcessorFunc
+	metrics  metrics.ParserMetrics
+	logger   *zap.Logger
 }
 
 // New creates a new vaa consumer.
-func New(consume queue.ConsumeFunc, process processor.ProcessorFunc, metrics metrics.Metrics, logger *zap.Logger) *Consumer {
-	return &Consumer{consume: consume, process: process, metrics: metrics, logger: logger}
+//
+// With more than one worker, the messages are partitioned by emitter so the vaas of an emitter are still processed in order.
+// On shutdown, the in-flight messages are still processed for up to drainTimeout.
+func New(consume queue.ConsumeFunc, process processor.ProcessorFunc, metrics metrics.ParserMetrics, workersSize int, drainTimeout time.Duration, logger *zap.Logger) *Consumer {
+	c := &Consumer{process: process, metrics: metrics, logger: logger}
+	c.pipeline = pipeline.New(pipeline.Source[queue.ConsumerMessage](consume), c.processMessage, pipeline.Config[queue.ConsumerMessage]{
+		Workers:      workersSize,
+		PartitionKey: emitterKey,
+		SkipExpired:  true,
+		DrainTimeout: drainTimeout,
+		Hooks: pipeline.Hooks[queue.ConsumerMessage]{
+			Expired:   c.onExpired,
+			Processed: c.onProcessed,
+			Failed:    c.onFailed,
+		},
+	})
+	return c
 }
 
 // Start consumes messages from VAA queue, parse and store those messages in a repository.
 func (c *Consumer) Start(ctx context.Context) {
-	go func() {
-		for msg := range c.consume(ctx) {
-			event := msg.Data()
+	c.pipeline.Start(ctx)
+}
+
+func emitterKey(msg queue.ConsumerMessage) string {
+	return fmt.Sprintf("%d/%s", msg.Data().ChainID, msg.Data().EmitterAddress)
+}
 
-			emitterChainID := sdk.ChainID(event.ChainID).String()
+func (c *Consumer) processMessage(ctx context.Context, msg queue.ConsumerMessage) error {
+	event := msg.Data()
+	params := &processor.Params{
+		TrackID: event.TrackID,
+		Vaa:     event.Vaa,
+		Replay:  event.Replay,
+	}
+	_, err := c.process(ctx, params)
+	// the invalid parsed vaas are quarantined by the processor, they are not retried.
+	var validationErr *parser.ValidationError
+	if errors.As(err, &validationErr) {
+		return nil
+	}
+	return err
+}
+
+func (c *Consumer) onExpired(msg queue.ConsumerMessage) {
+	event := msg.Data()
+	c.metrics.IncExpiredMessage(sdk.ChainID(event.ChainID).String(), event.Source, msg.Retry())
+	c.logger.Warn("Event expired", zap.String("id", event.ID))
+}
 
-			// check id message is expired.
-			if msg.IsExpired() {
-				c.metrics.IncExpiredMessage(emitterChainID, event.Source)
-				c.logger.Warn("Event expired", zap.String("id", event.ID))
-				msg.Failed()
-				continue
-			}
+func (c *Consumer) onFailed(msg queue.ConsumerMessage, err error, _ time.Duration) {
+	event := msg.Data()
+	chainID := sdk.ChainID(event.ChainID).String()
+	if pipeline.IsDiscarded(err) {
+		c.metrics.IncInvalidMessage(chainID, event.Source, msg.Retry())
+		c.logger.Error("Invalid event",
+			zap.String("trackId", event.TrackID),
+			zap.String("id", event.ID),
+			zap.Error(err))
+		return
+	}
+	c.metrics.IncUnprocessedMessage(chainID, event.Source, msg.Retry())
+	c.logger.Error("Error processing event",
+		zap.String("trackId", event.TrackID),
+		zap.String("id", event.ID),
+		zap.Error(err))
+}
+
+func (c *Consumer) onProcessed(msg queue.ConsumerMessage, _ time.Duration) {
+	event := msg.Data()
+	emitterChainID := sdk.ChainID(event.ChainID).String()
+	c.metrics.IncProcessedMessage(emitterChainID, event.Source, msg.Retry())
+	c.logger.Debug("Event processed",
+		zap.String("trackId", event.TrackID),
+		zap.String("id", event.ID))
+	c.metrics.VaaProcessingDuration(emitterChainID, msg.SentTimestamp())
+	c.observeLatency(event.Watermarks)
+}
+
+// observeLatency records the latency of the parser stage and the end-to-end latency of a processed event.
+func (c *Consumer) observeLatency(w events.Watermarks) {
+	if latency, ok := w.StageLatency(events.StageParser); ok {
+		c.metrics.VaaStageLatency(events.StageParser, latency)
+	}
+	if latency, ok := w.EndToEndLatency(time.Now()); ok {
+		c.metrics.VaaEndToEndLatency(latency)
+	}
+}
 
-			params := &processor.Params{
-				TrackID: event.TrackID,
-				Vaa:     event.Vaa,
-			}
-			_, err := c.process(ctx, params)
-			if err != nil {
-				c.metrics.IncUnprocessedMessage(emitterChainID, event.Source)
-				c.logger.Error("Error processing event",
-					zap.String("trackId", event.TrackID),
-					zap.String("id", event.ID),
-					zap.Error(err))
-				msg.Failed()
-				continue
-			} else {
-				c.metrics.IncProcessedMessage(emitterChainID, event.Source)
-				c.logger.Debug("Event processed",
-					zap.String("trackId", event.TrackID),
-					zap.String("id", event.ID))
-			}
-			c.metrics.VaaProcessingDuration(emitterChainID, msg.SentTimestamp())
-			msg.Done()
-		}
-	}()
+// Wait blocks until the consumer stops after the context is cancelled and the in-flight messages are drained.
+func (c *Consumer) Wait() {
+	c.pipeline.Wait()
 }
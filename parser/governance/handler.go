@@ -0,0 +1,96 @@
+package governance
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaapayload"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Handler stores the governance vaas and applies them to the guardian sets and registered emitters.
+type Handler struct {
+	repository *Repository
+	logger     *zap.Logger
+}
+
+// NewHandler creates a new governance vaa handler.
+func NewHandler(repository *Repository, logger *zap.Logger) *Handler {
+	return &Handler{
+		repository: repository,
+		logger:     logger.With(zap.String("module", "GovernanceHandler")),
+	}
+}
+
+// Handle decodes a governance vaa, stores it and updates the registries affected by the action.
+//
+// A vaa that cannot be decoded is logged and discarded because decoding it again gives the same result.
+// The errors returned are storage errors, so the vaa can be retried.
+func (h *Handler) Handle(ctx context.Context, trackID string, vaa *sdk.VAA) error {
+	action, err := vaapayload.DecodeGovernance(vaa)
+	if err != nil {
+		h.logger.Warn("Governance VAA cannot be decoded", zap.Error(err),
+			zap.String("trackId", trackID),
+			zap.String("id", vaa.MessageID()))
+		return nil
+	}
+
+	now := time.Now()
+	doc := GovernanceVaaDoc{
+		ID:               vaa.MessageID(),
+		Sequence:         fmt.Sprintf("%d", vaa.Sequence),
+		GuardianSetIndex: vaa.GuardianSetIndex,
+		Module:           action.Module,
+		ActionID:         action.ActionID,
+		Action:           action.Action,
+		TargetChain:      action.TargetChain,
+		NewContract:      action.NewContract,
+		GuardianSet:      action.GuardianSet,
+		Registration:     action.Registration,
+		Fee:              action.Fee,
+		Recipient:        action.Recipient,
+		RecoverChainID:   action.RecoverChainID,
+		DeliveryProvider: action.DeliveryProvider,
+		Payload:          hex.EncodeToString(vaa.Payload),
+		Timestamp:        vaa.Timestamp,
+		UpdatedAt:        &now,
+	}
+	if err := h.repository.UpsertGovernanceVaa(ctx, &doc); err != nil {
+		h.logger.Error("Error inserting governance vaa in repository", zap.Error(err),
+			zap.String("trackId", trackID),
+			zap.String("id", doc.ID))
+		return err
+	}
+
+	switch {
+	case action.GuardianSet != nil && action.Module == vaapayload.ModuleCore:
+		err = h.repository.UpsertGuardianSet(ctx, action.GuardianSet, vaa.Timestamp)
+	case action.Registration != nil:
+		err = h.repository.UpsertRegisteredEmitter(ctx, &RegisteredEmitterDoc{
+			ID:             fmt.Sprintf("%s/%d", action.Module, action.Registration.EmitterChain),
+			Module:         action.Module,
+			EmitterChain:   action.Registration.EmitterChain,
+			EmitterAddress: action.Registration.EmitterAddress,
+			VaaID:          doc.ID,
+			UpdatedAt:      &now,
+		}, vaa.Sequence)
+	}
+	if err != nil {
+		h.logger.Error("Error applying governance vaa", zap.Error(err),
+			zap.String("trackId", trackID),
+			zap.String("id", doc.ID),
+			zap.String("module", action.Module),
+			zap.String("action", action.Action))
+		return err
+	}
+
+	h.logger.Info("Governance VAA was successfully processed",
+		zap.String("trackId", trackID),
+		zap.String("id", doc.ID),
+		zap.String("module", action.Module),
+		zap.String("action", action.Action))
+	return nil
+}
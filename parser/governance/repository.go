@@ -0,0 +1,147 @@
+package governance
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaapayload"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// GovernanceVaaCollection contains the decoded governance vaas.
+const GovernanceVaaCollection = "governanceVaas"
+
+// RegisteredEmitterCollection contains the emitters registered by governance for each module and chain.
+const RegisteredEmitterCollection = "registeredEmitters"
+
+// guardianSetExpiration is the time a guardian set remains valid after it is replaced by a new one.
+const guardianSetExpiration = 24 * time.Hour
+
+// GovernanceVaaDoc represents a decoded governance vaa.
+type GovernanceVaaDoc struct {
+	ID               string                         `bson:"_id"`
+	Sequence         string                         `bson:"sequence"`
+	GuardianSetIndex uint32                         `bson:"guardianSetIndex"`
+	Module           string                         `bson:"module"`
+	ActionID         uint8                          `bson:"actionId"`
+	Action           string                         `bson:"action"`
+	TargetChain      sdk.ChainID                    `bson:"targetChain"`
+	NewContract      string                         `bson:"newContract,omitempty"`
+	GuardianSet      *vaapayload.GuardianSetUpgrade `bson:"guardianSet,omitempty"`
+	Registration     *vaapayload.ChainRegistration  `bson:"registration,omitempty"`
+	Fee              string                         `bson:"fee,omitempty"`
+	Recipient        string                         `bson:"recipient,omitempty"`
+	RecoverChainID   *vaapayload.RecoverChainID     `bson:"recoverChainId,omitempty"`
+	DeliveryProvider string                         `bson:"deliveryProvider,omitempty"`
+	Payload          string                         `bson:"payload"`
+	Timestamp        time.Time                      `bson:"timestamp"`
+	UpdatedAt        *time.Time                     `bson:"updatedAt"`
+}
+
+// RegisteredEmitterDoc represents the emitter registered by governance for a module and chain.
+type RegisteredEmitterDoc struct {
+	ID             string      `bson:"_id"`
+	Module         string      `bson:"module"`
+	EmitterChain   sdk.ChainID `bson:"emitterChain"`
+	EmitterAddress string      `bson:"emitterAddress"`
+	VaaID          string      `bson:"vaaId"`
+	UpdatedAt      *time.Time  `bson:"updatedAt"`
+}
+
+// Repository definitions.
+type Repository struct {
+	db          *mongo.Database
+	guardianSet *repository.GuardianSetRepository
+	logger      *zap.Logger
+	collections struct {
+		governanceVaas     *mongo.Collection
+		registeredEmitters *mongo.Collection
+	}
+}
+
+// NewRepository create a new respository instance.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db, repository.NewGuardianSetRepository(db, logger), logger, struct {
+		governanceVaas     *mongo.Collection
+		registeredEmitters *mongo.Collection
+	}{
+		governanceVaas:     db.Collection(GovernanceVaaCollection),
+		registeredEmitters: db.Collection(RegisteredEmitterCollection),
+	}}
+}
+
+// UpsertGovernanceVaa saves a decoded governance vaa.
+func (r *Repository) UpsertGovernanceVaa(ctx context.Context, doc *GovernanceVaaDoc) error {
+	update := bson.M{
+		"$set":         doc,
+		"$setOnInsert": repository.IndexedAt(*doc.UpdatedAt),
+		"$inc":         bson.D{{Key: "revision", Value: 1}},
+	}
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collections.governanceVaas.UpdateByID(ctx, doc.ID, update, opts)
+	return err
+}
+
+// UpsertRegisteredEmitter saves the emitter registered for a module and chain.
+//
+// The registration is skipped if the stored registration comes from a newer vaa.
+func (r *Repository) UpsertRegisteredEmitter(ctx context.Context, doc *RegisteredEmitterDoc, sequence uint64) error {
+	var stored struct {
+		Sequence uint64 `bson:"sequence"`
+	}
+	err := r.collections.registeredEmitters.FindOne(ctx, bson.M{"_id": doc.ID}).Decode(&stored)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return err
+	}
+	if err == nil && stored.Sequence > sequence {
+		return nil
+	}
+
+	update := bson.M{
+		"$set":         bson.M{"module": doc.Module, "emitterChain": doc.EmitterChain, "emitterAddress": doc.EmitterAddress, "vaaId": doc.VaaID, "sequence": sequence, "updatedAt": doc.UpdatedAt},
+		"$setOnInsert": repository.IndexedAt(*doc.UpdatedAt),
+	}
+	opts := options.Update().SetUpsert(true)
+	_, err = r.collections.registeredEmitters.UpdateByID(ctx, doc.ID, update, opts)
+	return err
+}
+
+// UpsertGuardianSet saves a new guardian set and sets the expiration time of the previous one.
+func (r *Repository) UpsertGuardianSet(ctx context.Context, gs *vaapayload.GuardianSetUpgrade, timestamp time.Time) error {
+	keys := make([]repository.GuardianSetKeyDoc, 0, len(gs.Keys))
+	for i, key := range gs.Keys {
+		address, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil {
+			return fmt.Errorf("invalid guardian key %s: %w", key, err)
+		}
+		keys = append(keys, repository.GuardianSetKeyDoc{Index: uint32(i), Address: address})
+	}
+	err := r.guardianSet.Upsert(ctx, &repository.GuardianSetDoc{
+		GuardianSetIndex: gs.Index,
+		Keys:             keys,
+		UpdatedAt:        time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if gs.Index == 0 {
+		return nil
+	}
+	previous, err := r.guardianSet.FindByIndex(ctx, gs.Index-1)
+	if err != nil || previous == nil || previous.ExpirationTime != nil {
+		return err
+	}
+	expirationTime := timestamp.Add(guardianSetExpiration)
+	previous.ExpirationTime = &expirationTime
+	previous.UpdatedAt = time.Now()
+	return r.guardianSet.Upsert(ctx, previous)
+}
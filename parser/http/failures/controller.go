@@ -0,0 +1,54 @@
+package failures
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const maxPageSize = 1000
+
+// Controller definition.
+type Controller struct {
+	repository *parser.Repository
+	logger     *zap.Logger
+}
+
+// NewController creates a Controller instance.
+func NewController(repository *parser.Repository, logger *zap.Logger) *Controller {
+	return &Controller{repository: repository, logger: logger}
+}
+
+// List returns the vaas whose payload cannot be parsed, filtered by the `chain` and `emitter` query params
+// and paginated by the `page` and `pageSize` query params.
+func (c *Controller) List(ctx *fiber.Ctx) error {
+	var q parser.FindParseFailuresQuery
+
+	if chain := ctx.Query("chain"); chain != "" {
+		chainID, err := strconv.ParseUint(chain, 10, 16)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid chain")
+		}
+		id := sdk.ChainID(chainID)
+		q.EmitterChain = &id
+	}
+	q.EmitterAddr = ctx.Query("emitter")
+
+	page := ctx.QueryInt("page", 0)
+	pageSize := ctx.QueryInt("pageSize", 50)
+	if page < 0 || pageSize <= 0 || pageSize > maxPageSize {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid pagination")
+	}
+	q.Skip = int64(page * pageSize)
+	q.Limit = int64(pageSize)
+
+	failures, err := c.repository.FindParseFailures(ctx.Context(), q)
+	if err != nil {
+		c.logger.Error("Error finding parse failures", zap.Error(err))
+		return err
+	}
+	return ctx.JSON(failures)
+}
@@ -4,7 +4,12 @@ import (
 	"github.com/ansrivas/fiberprometheus/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/pprof"
+	"github.com/wormhole-foundation/wormhole-explorer/common/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/common/auth"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/http/failures"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/http/schema"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/http/vaa"
 	"go.uber.org/zap"
 )
@@ -15,7 +20,7 @@ type Server struct {
 	logger *zap.Logger
 }
 
-func NewServer(logger *zap.Logger, port string, pprofEnabled bool, vaaController *vaa.Controller, checks ...health.Check) *Server {
+func NewServer(logger *zap.Logger, port string, pprofEnabled bool, vaaController *vaa.Controller, schemaController *schema.Controller, failuresController *failures.Controller, unknownChains *domain.UnknownChainTracker, auditor *audit.Auditor, adminApiKey string, checks ...health.Check) *Server {
 	ctrl := health.NewController(checks, logger)
 	app := fiber.New(fiber.Config{DisableStartupMessage: true})
 
@@ -32,7 +37,23 @@ func NewServer(logger *zap.Logger, port string, pprofEnabled bool, vaaController
 	api.Get("/health", ctrl.HealthCheck)
 	api.Get("/ready", ctrl.ReadyCheck)
 
-	api.Post("/vaa/parse", vaaController.Parse)
+	api.Post("/vaa/parse", auditor.Middleware("vaa.parse"), vaaController.Parse)
+
+	// payload schemas registered by the third-party protocols.
+	api.Get("/payload-schemas", schemaController.List)
+	// the schemas can only be changed with the admin api key, an empty key disables the changes.
+	adminOnly := auth.AdminApiKey(adminApiKey)
+	// the requests are authenticated before they are audited, so the rejected requests are not recorded.
+	api.Put("/payload-schemas", adminOnly, auditor.Middleware("payload-schema.register"), schemaController.Register)
+	api.Delete("/payload-schemas/:chain/:emitter", adminOnly, auditor.Middleware("payload-schema.delete"), schemaController.Delete)
+
+	// vaas whose payload cannot be parsed.
+	api.Get("/parse-failures", failuresController.List)
+
+	// chains seen by the service that are not known by the wormhole sdk.
+	api.Get("/unknown-chains", func(c *fiber.Ctx) error {
+		return c.JSON(unknownChains.List())
+	})
 
 	return &Server{
 		app:    app,
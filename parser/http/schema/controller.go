@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/schema"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Controller definition.
+type Controller struct {
+	registry *schema.Registry
+	logger   *zap.Logger
+}
+
+// NewController creates a Controller instance.
+func NewController(registry *schema.Registry, logger *zap.Logger) *Controller {
+	return &Controller{registry: registry, logger: logger}
+}
+
+// List returns the registered payload schemas.
+func (c *Controller) List(ctx *fiber.Ctx) error {
+	return ctx.JSON(c.registry.List())
+}
+
+// Register saves the payload schema of an emitter.
+func (c *Controller) Register(ctx *fiber.Ctx) error {
+	var s schema.Schema
+	if err := ctx.BodyParser(&s); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := c.registry.Register(ctx.Context(), &s); err != nil {
+		if errors.Is(err, schema.ErrInvalidSchema) {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		c.logger.Error("Error registering payload schema", zap.Error(err), zap.String("id", s.ID))
+		return err
+	}
+
+	c.logger.Info("Payload schema registered", zap.String("id", s.ID), zap.String("protocol", s.Protocol))
+	return ctx.JSON(s)
+}
+
+// Delete removes the payload schema of an emitter.
+func (c *Controller) Delete(ctx *fiber.Ctx) error {
+	chain, err := strconv.ParseUint(ctx.Params("chain"), 10, 16)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid chain")
+	}
+	address := ctx.Params("emitter")
+
+	if err := c.registry.Delete(ctx.Context(), sdk.ChainID(chain), address); err != nil {
+		c.logger.Error("Error deleting payload schema", zap.Error(err), zap.String("id", schema.SchemaID(sdk.ChainID(chain), address)))
+		return err
+	}
+
+	c.logger.Info("Payload schema deleted", zap.String("id", schema.SchemaID(sdk.ChainID(chain), address)))
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
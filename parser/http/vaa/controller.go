@@ -1,9 +1,11 @@
 package vaa
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/processor"
 	"go.uber.org/zap"
 )
@@ -39,6 +41,14 @@ func (c *Controller) Parse(ctx *fiber.Ctx) error {
 	trackID := fmt.Sprintf("controller-%s", payload.ID)
 
 	vaaParsed, err := c.processor(ctx.Context(), &processor.Params{Vaa: vaa.Vaa, TrackID: trackID})
+	// the invalid parsed vaas are quarantined, the invalid fields are returned instead of the result.
+	var validationErr *parser.ValidationError
+	if errors.As(err, &validationErr) {
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(struct {
+			Error  string   `json:"error"`
+			Fields []string `json:"fields"`
+		}{Error: validationErr.Error(), Fields: validationErr.Fields})
+	}
 	if err != nil {
 		return err
 	}
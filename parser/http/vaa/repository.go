@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -17,8 +18,8 @@ type Repository struct {
 }
 
 type VaaDoc struct {
-	ID  string `bson:"_id" json:"id"`
-	Vaa []byte `bson:"vaas" json:"vaa"`
+	ID  string                     `bson:"_id" json:"id"`
+	Vaa repository.CompressedBytes `bson:"vaas" json:"vaa"`
 }
 
 // NewRepository create a new Repository.
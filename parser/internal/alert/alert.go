@@ -10,6 +10,7 @@ import (
 const (
 	AlertKeyVaaPayloadParserError = "ERROR-REQUEST-VAA-PAYLOAD-PARSER"
 	AlertKeyInsertParsedVaaError  = "ERROR-INSERT-PARSED-VAA"
+	AlertKeyUnknownChainIDs       = "UNKNOWN-CHAIN-IDS"
 )
 
 func LoadAlerts(cfg alert.AlertConfig) map[string]alert.Alert {
@@ -37,5 +38,16 @@ func LoadAlerts(cfg alert.AlertConfig) map[string]alert.Alert {
 		Priority:    alert.CRITICAL,
 	}
 
+	// Alert for recorded chain IDs not known by the wormhole sdk.
+	alerts[AlertKeyUnknownChainIDs] = alert.Alert{
+		Alias:       "Unknown chain IDs",
+		Message:     fmt.Sprintf("[%s] %s", cfg.Environment, "Recorded VAAs contain chain IDs not known by the wormhole sdk"),
+		Description: "The wormhole sdk version is outdated or the chain ID overrides are missing",
+		Actions:     []string{"Update the wormhole sdk version or set CHAIN_ID_OVERRIDES"},
+		Tags:        []string{cfg.Environment, "parser", "chain", "sdk"},
+		Entity:      "parser",
+		Priority:    alert.HIGH,
+	}
+
 	return alerts
 }
@@ -4,7 +4,11 @@ import (
 	"context"
 	"errors"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/attestation"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/governance"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/schema"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -24,6 +28,82 @@ func Run(db *mongo.Database) error {
 		return err
 	}
 
+	// Created parsedVaaInvalid collection.
+	err = db.CreateCollection(context.TODO(), parser.InvalidParsedVAACollection)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// Created parseFailures collection.
+	err = db.CreateCollection(context.TODO(), parser.ParseFailuresCollection)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in parseFailures collection by emitter and updatedAt.
+	indexEmitterUpdatedAt := mongo.IndexModel{Keys: bson.D{{Key: "emitterChain", Value: 1}, {Key: "emitterAddr", Value: 1}, {Key: "updatedAt", Value: -1}}}
+	_, err = db.Collection(parser.ParseFailuresCollection).Indexes().CreateOne(context.TODO(), indexEmitterUpdatedAt)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// Created governanceVaas collection.
+	err = db.CreateCollection(context.TODO(), governance.GovernanceVaaCollection)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in governanceVaas collection by module and timestamp.
+	indexModuleTimestamp := mongo.IndexModel{Keys: bson.D{{Key: "module", Value: 1}, {Key: "timestamp", Value: -1}}}
+	_, err = db.Collection(governance.GovernanceVaaCollection).Indexes().CreateOne(context.TODO(), indexModuleTimestamp)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// Created registeredEmitters collection.
+	err = db.CreateCollection(context.TODO(), governance.RegisteredEmitterCollection)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// Created tokenAttestations collection.
+	err = db.CreateCollection(context.TODO(), attestation.TokenAttestationCollection)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in tokenAttestations collection by token and timestamp.
+	indexTokenTimestamp := mongo.IndexModel{Keys: bson.D{{Key: "tokenChain", Value: 1}, {Key: "tokenAddress", Value: 1}, {Key: "timestamp", Value: -1}}}
+	_, err = db.Collection(attestation.TokenAttestationCollection).Indexes().CreateOne(context.TODO(), indexTokenTimestamp)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// Created payloadSchemas collection.
+	err = db.CreateCollection(context.TODO(), schema.PayloadSchemaCollection)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create index in globalTransactions collection by appIds and timestamp.
+	indexAppIdsTimestamp := mongo.IndexModel{Keys: bson.D{{Key: "appIds", Value: 1}, {Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}}
+	_, err = db.Collection(parser.GlobalTransactionsCollection).Indexes().CreateOne(context.TODO(), indexAppIdsTimestamp)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// Created auditRecords collection, shared by the admin endpoints of all the services.
+	err = db.CreateCollection(context.TODO(), audit.AuditCollection)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
+	// create indexes in auditRecords collection by timestamp, service/action and actor.
+	err = audit.CreateIndexes(context.TODO(), db)
+	if err != nil && isNotAlreadyExistsError(err) {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"reflect"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ignoredDiffFields are the fields of the parsed vaas that change every time a vaa is parsed.
+var ignoredDiffFields = map[string]bool{
+	"updatedAt": true,
+	"indexedAt": true,
+	"revision":  true,
+}
+
+// Diff returns the paths of the fields that differ between a stored parsed vaa and a new result of the parser
+// for the same vaa, sorted by path. The nested documents are compared field by field and the arrays as a whole.
+func Diff(stored bson.M, reparsed *ParsedVaaUpdate) ([]string, error) {
+	data, err := bson.Marshal(reparsed)
+	if err != nil {
+		return nil, err
+	}
+	var current bson.M
+	if err := bson.Unmarshal(data, &current); err != nil {
+		return nil, err
+	}
+
+	fields := []string{}
+	for _, key := range unionKeys(stored, current) {
+		if ignoredDiffFields[key] {
+			continue
+		}
+		diffValues(key, stored[key], current[key], &fields)
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func diffValues(path string, stored, current interface{}, fields *[]string) {
+	storedDoc, ok1 := stored.(bson.M)
+	currentDoc, ok2 := current.(bson.M)
+	if ok1 && ok2 {
+		for _, key := range unionKeys(storedDoc, currentDoc) {
+			diffValues(path+"."+key, storedDoc[key], currentDoc[key], fields)
+		}
+		return
+	}
+	if !reflect.DeepEqual(stored, current) {
+		*fields = append(*fields, path)
+	}
+}
+
+func unionKeys(a, b bson.M) []string {
+	keys := make([]string, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
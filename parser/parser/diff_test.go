@@ -0,0 +1,34 @@
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestDiff(t *testing.T) {
+	// the stored document has the fields set by the repository.
+	data, err := bson.Marshal(newValidParsedVaa())
+	assert.NoError(t, err)
+	var stored bson.M
+	assert.NoError(t, bson.Unmarshal(data, &stored))
+	stored["indexedAt"] = time.Now()
+	stored["revision"] = 3
+
+	// the same result only differs in the update timestamps.
+	reparsed := newValidParsedVaa()
+	updatedAt := time.Now().Add(time.Hour)
+	reparsed.UpdatedAt = &updatedAt
+	fields, err := Diff(stored, reparsed)
+	assert.NoError(t, err)
+	assert.Empty(t, fields)
+
+	reparsed.AppIDs = []string{"PORTAL_TOKEN_BRIDGE", "CONNECT"}
+	reparsed.StandardizedProperties.Amount = "2000"
+	reparsed.Protocol = "fast-transfers"
+	fields, err = Diff(stored, reparsed)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"appIds", "protocol", "standardizedProperties.amount"}, fields)
+}
@@ -14,9 +14,52 @@ type ParsedVaaUpdate struct {
 	EmitterAddr               string                                  `bson:"emitterAddr" json:"emitterAddr"`
 	Sequence                  string                                  `bson:"sequence" json:"sequence"`
 	AppIDs                    []string                                `bson:"appIds" json:"appIds"`
+	Protocol                  string                                  `bson:"protocol,omitempty" json:"protocol,omitempty"`
+	CanonicalEmitterAddr      string                                  `bson:"canonicalEmitterAddr,omitempty" json:"canonicalEmitterAddr,omitempty"`
 	ParsedPayload             interface{}                             `bson:"parsedPayload" json:"parsedPayload"`
+	DecodedPayload            *DecodedPayload                         `bson:"decodedPayload,omitempty" json:"decodedPayload,omitempty"`
 	RawStandardizedProperties vaaPayloadParser.StandardizedProperties `bson:"rawStandardizedProperties" json:"rawStandardizedProperties"`
 	StandardizedProperties    vaaPayloadParser.StandardizedProperties `bson:"standardizedProperties" json:"standardizedProperties"`
+	RelayerFee                *RelayerFee                             `bson:"relayerFee,omitempty" json:"relayerFee,omitempty"`
+	UnknownChainIDs           []sdk.ChainID                           `bson:"unknownChainIds,omitempty" json:"unknownChainIds,omitempty"`
 	UpdatedAt                 *time.Time                              `bson:"updatedAt" json:"updatedAt"`
 	Timestamp                 time.Time                               `bson:"timestamp" json:"timestamp"`
 }
+
+// InvalidParsedVaaUpdate represent a parsed vaa that does not pass the validation.
+type InvalidParsedVaaUpdate struct {
+	ParsedVaa ParsedVaaUpdate `bson:"parsedVaa" json:"parsedVaa"`
+	Errors    []string        `bson:"errors" json:"errors"`
+	UpdatedAt *time.Time      `bson:"updatedAt" json:"updatedAt"`
+}
+
+// ParseFailure represent a vaa whose payload cannot be parsed, with a snapshot of the payload
+// to discover the formats not supported yet.
+type ParseFailure struct {
+	ID           string      `bson:"_id" json:"id"`
+	EmitterChain sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddr  string      `bson:"emitterAddr" json:"emitterAddr"`
+	Sequence     string      `bson:"sequence" json:"sequence"`
+	Payload      []byte      `bson:"payload" json:"payload"`
+	Error        string      `bson:"error" json:"error"`
+	Timestamp    time.Time   `bson:"timestamp" json:"timestamp"`
+	UpdatedAt    *time.Time  `bson:"updatedAt" json:"updatedAt"`
+	// Attempts is the number of times the vaa failed to be parsed.
+	Attempts int `bson:"attempts,omitempty" json:"attempts"`
+}
+
+// RelayerFee represent the fee paid to the relayer that redeems a vaa.
+type RelayerFee struct {
+	// Amount is the fee amount as it is present in the payload.
+	Amount string `bson:"amount" json:"amount"`
+	// NormalizedAmount is the fee amount normalized to 8 decimals.
+	NormalizedAmount string      `bson:"normalizedAmount" json:"normalizedAmount"`
+	TokenChain       sdk.ChainID `bson:"tokenChain" json:"tokenChain"`
+	TokenAddress     string      `bson:"tokenAddress" json:"tokenAddress"`
+}
+
+// DecodedPayload represent a payload decoded with the schema registered by a third-party protocol.
+type DecodedPayload struct {
+	Protocol string         `bson:"protocol" json:"protocol"`
+	Fields   map[string]any `bson:"fields" json:"fields"`
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -16,21 +17,40 @@ var ErrDocNotFound = errors.New("NOT FOUND")
 
 const ParsedVAACollection = "parsedVaa"
 
+// InvalidParsedVAACollection contains the parsed vaas that do not pass the validation.
+const InvalidParsedVAACollection = "parsedVaaInvalid"
+
+// ParseFailuresCollection contains the vaas whose payload cannot be parsed.
+const ParseFailuresCollection = "parseFailures"
+
+// GlobalTransactionsCollection contains the origin and destination transactions of the vaas.
+// The parser stores the application ids of the vaas to filter the transactions by application.
+const GlobalTransactionsCollection = "globalTransactions"
+
 // Repository definitions.
 type Repository struct {
 	db          *mongo.Database
 	log         *zap.Logger
 	collections struct {
-		parsedVaa *mongo.Collection
+		parsedVaa          *mongo.Collection
+		invalidParsedVaa   *mongo.Collection
+		parseFailures      *mongo.Collection
+		globalTransactions *mongo.Collection
 	}
 }
 
 // NewRepository create a new respository instance.
 func NewRepository(db *mongo.Database, log *zap.Logger) *Repository {
 	return &Repository{db, log, struct {
-		parsedVaa *mongo.Collection
+		parsedVaa          *mongo.Collection
+		invalidParsedVaa   *mongo.Collection
+		parseFailures      *mongo.Collection
+		globalTransactions *mongo.Collection
 	}{
-		parsedVaa: db.Collection(ParsedVAACollection),
+		parsedVaa:          db.Collection(ParsedVAACollection),
+		invalidParsedVaa:   db.Collection(InvalidParsedVAACollection),
+		parseFailures:      db.Collection(ParseFailuresCollection),
+		globalTransactions: db.Collection(GlobalTransactionsCollection),
 	}}
 }
 
@@ -48,6 +68,100 @@ func (s *Repository) UpsertParsedVaa(ctx context.Context, parsedVAA ParsedVaaUpd
 	return err
 }
 
+// UpsertGlobalTransactionAppIDs saves the application ids and the timestamp of a vaa in its global transaction.
+//
+// The global transaction is created if the tx-tracker has not processed the vaa yet.
+// The application ids of the vaas parsed before are copied by the JOB_MIGRATE_APP_IDS job.
+func (s *Repository) UpsertGlobalTransactionAppIDs(ctx context.Context, id string, appIDs []string, timestamp time.Time) error {
+	update := bson.M{
+		"$set": bson.M{
+			"appIds":    appIDs,
+			"timestamp": timestamp,
+		},
+	}
+
+	opts := options.Update().SetUpsert(true)
+	_, err := s.collections.globalTransactions.UpdateByID(ctx, id, update, opts)
+	return err
+}
+
+// UpsertInvalidParsedVaa saves a parsed vaa that does not pass the validation with the invalid fields.
+func (s *Repository) UpsertInvalidParsedVaa(ctx context.Context, parsedVAA ParsedVaaUpdate, fields []string) error {
+	update := bson.M{
+		"$set": InvalidParsedVaaUpdate{
+			ParsedVaa: parsedVAA,
+			Errors:    fields,
+			UpdatedAt: parsedVAA.UpdatedAt,
+		},
+		"$setOnInsert": indexedAt(*parsedVAA.UpdatedAt),
+	}
+
+	opts := options.Update().SetUpsert(true)
+	_, err := s.collections.invalidParsedVaa.UpdateByID(ctx, parsedVAA.ID, update, opts)
+	return err
+}
+
+// UpsertParseFailure saves a vaa whose payload cannot be parsed and counts the failed attempts.
+func (s *Repository) UpsertParseFailure(ctx context.Context, failure ParseFailure) error {
+	// the attempts are omitted from the $set, they are only incremented.
+	failure.Attempts = 0
+	update := bson.M{
+		"$set":         failure,
+		"$setOnInsert": indexedAt(*failure.UpdatedAt),
+		"$inc":         bson.D{{Key: "attempts", Value: 1}},
+	}
+
+	opts := options.Update().SetUpsert(true)
+	_, err := s.collections.parseFailures.UpdateByID(ctx, failure.ID, update, opts)
+	return err
+}
+
+// FindParseFailuresQuery contains the filters of the vaas whose payload cannot be parsed.
+type FindParseFailuresQuery struct {
+	EmitterChain *sdk.ChainID
+	EmitterAddr  string
+	Skip         int64
+	Limit        int64
+}
+
+// FindParseFailures returns the vaas whose payload cannot be parsed, the most recent first.
+func (s *Repository) FindParseFailures(ctx context.Context, q FindParseFailuresQuery) ([]ParseFailure, error) {
+	filter := bson.D{}
+	if q.EmitterChain != nil {
+		filter = append(filter, bson.E{Key: "emitterChain", Value: *q.EmitterChain})
+	}
+	if q.EmitterAddr != "" {
+		filter = append(filter, bson.E{Key: "emitterAddr", Value: q.EmitterAddr})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "updatedAt", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(q.Skip).
+		SetLimit(q.Limit)
+	cur, err := s.collections.parseFailures.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	failures := []ParseFailure{}
+	if err := cur.All(ctx, &failures); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return failures, nil
+}
+
+// FindParsedVaa returns the stored parsed vaa as a raw document, to compare it with a new result of the parser.
+func (s *Repository) FindParsedVaa(ctx context.Context, id string) (bson.M, error) {
+	var doc bson.M
+	err := s.collections.parsedVaa.FindOne(ctx, bson.M{"_id": id}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrDocNotFound
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return doc, nil
+}
+
 func indexedAt(t time.Time) IndexingTimestamps {
 	return IndexingTimestamps{
 		IndexedAt: t,
@@ -0,0 +1,31 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+func TestNewRepository_InitializesCollections(t *testing.T) {
+	// mongo.Connect does not dial the server, so no database is needed here.
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Disconnect(context.Background()) })
+
+	repository := NewRepository(client.Database("wormscan"), zap.NewNop())
+
+	if assert.NotNil(t, repository.collections.parsedVaa) {
+		assert.Equal(t, ParsedVAACollection, repository.collections.parsedVaa.Name())
+	}
+	if assert.NotNil(t, repository.collections.invalidParsedVaa) {
+		assert.Equal(t, InvalidParsedVAACollection, repository.collections.invalidParsedVaa.Name())
+	}
+	if assert.NotNil(t, repository.collections.globalTransactions) {
+		assert.Equal(t, GlobalTransactionsCollection, repository.collections.globalTransactions.Name())
+	}
+}
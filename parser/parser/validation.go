@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// maxAddressLength is the maximum length of the addresses in the standardized properties.
+const maxAddressLength = 256
+
+// ValidationError is returned when a parsed vaa document is not valid.
+type ValidationError struct {
+	// Fields contains a description of each invalid field.
+	Fields []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid parsed vaa: %s", strings.Join(e.Fields, ", "))
+}
+
+// Validate checks the required fields, the value ranges and the address formats of the parsed vaa.
+// It returns a *ValidationError with all the invalid fields.
+func (p *ParsedVaaUpdate) Validate() error {
+	var fields []string
+	invalid := func(field, reason string) {
+		fields = append(fields, fmt.Sprintf("%s: %s", field, reason))
+	}
+
+	if p.ID == "" {
+		invalid("id", "required")
+	} else if p.ID != fmt.Sprintf("%d/%s/%s", p.EmitterChain, p.EmitterAddr, p.Sequence) {
+		invalid("id", "does not match emitterChain/emitterAddr/sequence")
+	}
+	if p.EmitterChain == sdk.ChainIDUnset {
+		invalid("emitterChain", "required")
+	}
+	if b, err := hex.DecodeString(p.EmitterAddr); err != nil || len(b) != 32 {
+		invalid("emitterAddr", "must be a 32 bytes hex address")
+	}
+	if _, err := strconv.ParseUint(p.Sequence, 10, 64); err != nil {
+		invalid("sequence", "must be an unsigned integer")
+	}
+	for _, appID := range p.AppIDs {
+		if appID == "" {
+			invalid("appIds", "must not contain empty values")
+			break
+		}
+	}
+	if p.Timestamp.IsZero() {
+		invalid("timestamp", "required")
+	}
+	if p.UpdatedAt == nil {
+		invalid("updatedAt", "required")
+	}
+	validateStandardizedProperties("rawStandardizedProperties", &p.RawStandardizedProperties, invalid)
+	validateStandardizedProperties("standardizedProperties", &p.StandardizedProperties, invalid)
+	if p.RelayerFee != nil {
+		if !isAmount(p.RelayerFee.Amount) {
+			invalid("relayerFee.amount", "must be a non-negative integer")
+		}
+		if p.RelayerFee.NormalizedAmount != "" && !isAmount(p.RelayerFee.NormalizedAmount) {
+			invalid("relayerFee.normalizedAmount", "must be a non-negative integer")
+		}
+		validateAddress("relayerFee.tokenAddress", p.RelayerFee.TokenChain, p.RelayerFee.TokenAddress, invalid)
+	}
+
+	if len(fields) > 0 {
+		return &ValidationError{Fields: fields}
+	}
+	return nil
+}
+
+func validateStandardizedProperties(prefix string, sp *vaaPayloadParser.StandardizedProperties, invalid func(field, reason string)) {
+	validateAddress(prefix+".fromAddress", sp.FromChain, sp.FromAddress, invalid)
+	validateAddress(prefix+".toAddress", sp.ToChain, sp.ToAddress, invalid)
+	validateAddress(prefix+".tokenAddress", sp.TokenChain, sp.TokenAddress, invalid)
+	validateAddress(prefix+".feeAddress", sp.FeeChain, sp.FeeAddress, invalid)
+	if sp.Amount != "" && !isAmount(sp.Amount) {
+		invalid(prefix+".amount", "must be a non-negative integer")
+	}
+	if sp.Fee != "" && !isAmount(sp.Fee) {
+		invalid(prefix+".fee", "must be a non-negative integer")
+	}
+}
+
+// validateAddress checks that a non-empty address has a chain and does not contain whitespaces or control characters.
+// The address format depends on the chain, so only the characters are checked.
+func validateAddress(field string, chainID sdk.ChainID, address string, invalid func(field, reason string)) {
+	if address == "" {
+		return
+	}
+	if chainID == sdk.ChainIDUnset {
+		invalid(field, "chain is required")
+	}
+	if len(address) > maxAddressLength {
+		invalid(field, "too long")
+		return
+	}
+	for _, r := range address {
+		if unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			invalid(field, "invalid characters")
+			return
+		}
+	}
+}
+
+func isAmount(s string) bool {
+	n, ok := new(big.Int).SetString(s, 10)
+	return ok && n.Sign() >= 0
+}
@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func newValidParsedVaa() *ParsedVaaUpdate {
+	now := time.Now()
+	return &ParsedVaaUpdate{
+		ID:           "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1",
+		EmitterChain: sdk.ChainIDEthereum,
+		EmitterAddr:  "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585",
+		Sequence:     "1",
+		AppIDs:       []string{"PORTAL_TOKEN_BRIDGE"},
+		StandardizedProperties: vaaPayloadParser.StandardizedProperties{
+			ToChain:      sdk.ChainIDSolana,
+			ToAddress:    "8JoVhBvy8h6hCbjLGBDdeNjMVuuU7mtk8fxXXJwRz1YA",
+			TokenChain:   sdk.ChainIDEthereum,
+			TokenAddress: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+			Amount:       "1000",
+		},
+		Timestamp: now,
+		UpdatedAt: &now,
+	}
+}
+
+func TestParsedVaaValidate(t *testing.T) {
+	assert.NoError(t, newValidParsedVaa().Validate())
+
+	p := newValidParsedVaa()
+	p.Sequence = "abc"
+	p.EmitterAddr = "0x3ee18b2214aff97000d974cf647e7c347e8fa585"
+	p.StandardizedProperties.Amount = "-1"
+	p.StandardizedProperties.FromAddress = "0xabc"
+	p.UpdatedAt = nil
+
+	err := p.Validate()
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.ElementsMatch(t, []string{
+		"id: does not match emitterChain/emitterAddr/sequence",
+		"emitterAddr: must be a 32 bytes hex address",
+		"sequence: must be an unsigned integer",
+		"updatedAt: required",
+		"standardizedProperties.fromAddress: chain is required",
+		"standardizedProperties.amount: must be a non-negative integer",
+	}, validationErr.Fields)
+}
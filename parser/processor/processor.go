@@ -5,15 +5,21 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
 	vaaPayloadParser "github.com/wormhole-foundation/wormhole-explorer/common/client/parser"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pipeline"
+	"github.com/wormhole-foundation/wormhole-explorer/common/vaapayload"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/attestation"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/governance"
 	parserAlert "github.com/wormhole-foundation/wormhole-explorer/parser/internal/alert"
-	"github.com/wormhole-foundation/wormhole-explorer/parser/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/parser/parser"
+	"github.com/wormhole-foundation/wormhole-explorer/parser/schema"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -22,18 +28,30 @@ type Processor struct {
 	parser        vaaPayloadParser.ParserVAAAPIClient
 	repository    *parser.Repository
 	alert         alert.AlertClient
-	metrics       metrics.Metrics
+	metrics       metrics.ParserMetrics
 	tokenProvider *domain.TokenProvider
+	unknownChains *domain.UnknownChainTracker
+	governance    *governance.Handler
+	attestations  *attestation.Handler
+	schemas       *schema.Registry
+	protocols     domain.ProtocolEmitters
+	remapping     domain.EmitterRemapping
 	logger        *zap.Logger
 }
 
-func New(parser vaaPayloadParser.ParserVAAAPIClient, repository *parser.Repository, alert alert.AlertClient, metrics metrics.Metrics, tokenProvider *domain.TokenProvider, logger *zap.Logger) *Processor {
+func New(parser vaaPayloadParser.ParserVAAAPIClient, repository *parser.Repository, alert alert.AlertClient, metrics metrics.ParserMetrics, tokenProvider *domain.TokenProvider, unknownChains *domain.UnknownChainTracker, governance *governance.Handler, attestations *attestation.Handler, schemas *schema.Registry, protocols domain.ProtocolEmitters, remapping domain.EmitterRemapping, logger *zap.Logger) *Processor {
 	return &Processor{
 		parser:        parser,
 		repository:    repository,
 		alert:         alert,
 		metrics:       metrics,
 		tokenProvider: tokenProvider,
+		unknownChains: unknownChains,
+		governance:    governance,
+		attestations:  attestations,
+		schemas:       schemas,
+		protocols:     protocols,
+		remapping:     remapping,
 		logger:        logger,
 	}
 }
@@ -42,7 +60,8 @@ func (p *Processor) Process(ctx context.Context, params *Params) (*parser.Parsed
 	// unmarshal vaa.
 	vaa, err := sdk.Unmarshal(params.Vaa)
 	if err != nil {
-		return nil, err
+		// a malformed vaa is not retried.
+		return nil, pipeline.Discard(err)
 	}
 
 	// call vaa-payload-parser api to parse a VAA.
@@ -50,6 +69,29 @@ func (p *Processor) Process(ctx context.Context, params *Params) (*parser.Parsed
 	emitterAddress := vaa.EmitterAddress.String()
 	sequence := fmt.Sprintf("%d", vaa.Sequence)
 
+	// chains added by governance may not be known by the wormhole sdk yet.
+	// In that case the vaa is processed anyway and the chain is reported.
+	unknownChainIDs := p.trackUnknownChains(params.TrackID, vaa.MessageID(), nil, vaa.EmitterChain)
+
+	// governance vaas update the guardian sets and the registered emitters,
+	// they are also parsed as any other vaa.
+	if !params.DryRun && vaapayload.IsGovernanceVaa(vaa) {
+		if err := p.governance.Handle(ctx, params.TrackID, vaa); err != nil {
+			return nil, err
+		}
+	}
+
+	// token attestations register the tokens on the token bridges,
+	// they are also parsed as any other vaa.
+	if !params.DryRun {
+		if err := p.attestations.Handle(ctx, params.TrackID, vaa); err != nil {
+			return nil, err
+		}
+	}
+
+	// third-party protocols can register a schema to decode the payloads of their emitters.
+	decodedPayload := p.decodePayload(params.TrackID, vaa)
+
 	p.metrics.IncVaaPayloadParserRequestCount(chainID)
 	vaaParseResponse, err := p.parser.ParseVaaWithStandarizedProperties(vaa)
 	if err != nil {
@@ -72,22 +114,46 @@ func (p *Processor) Process(ctx context.Context, params *Params) (*parser.Parsed
 				},
 				Error: err,
 			}
-			p.alert.CreateAndSend(ctx, parserAlert.AlertKeyVaaPayloadParserError, alertContext)
+			if !params.Replay && !params.DryRun {
+				p.alert.CreateAndSend(ctx, parserAlert.AlertKeyVaaPayloadParserError, alertContext)
+			}
 			return nil, err
 		}
 
-		p.logger.Info("VAA cannot be parsed", zap.Error(err),
-			zap.String("trackId", params.TrackID),
-			zap.Uint16("chainId", chainID),
-			zap.String("address", emitterAddress),
-			zap.String("sequence", sequence))
-		return nil, nil
+		if decodedPayload == nil {
+			if params.DryRun {
+				return nil, err
+			}
+			p.logger.Info("VAA cannot be parsed", zap.Error(err),
+				zap.String("trackId", params.TrackID),
+				zap.Uint16("chainId", chainID),
+				zap.String("address", emitterAddress),
+				zap.String("sequence", sequence))
+			p.storeParseFailure(ctx, params.TrackID, vaa, err)
+			return nil, nil
+		}
+
+		// the vaa-payload-parser does not know the payloads of the third-party protocols,
+		// the vaa is stored only with the payload decoded by the registered schema.
+		vaaParseResponse = &vaaPayloadParser.ParseVaaWithStandarizedPropertiesdResponse{}
+	} else {
+		p.metrics.IncVaaPayloadParserSuccessCount(chainID)
 	}
-	p.metrics.IncVaaPayloadParserSuccessCount(chainID)
 	p.metrics.IncVaaParsed(chainID)
 
+	rawProperties := vaaParseResponse.StandardizedProperties
+	unknownChainIDs = p.trackUnknownChains(params.TrackID, vaa.MessageID(), unknownChainIDs,
+		rawProperties.FromChain, rawProperties.ToChain, rawProperties.TokenChain, rawProperties.FeeChain)
+
 	standardizedProperties := p.transformStandarizedProperties(params.TrackID, vaa.MessageID(), vaaParseResponse.StandardizedProperties)
 
+	// the vaas of the protocols built on top of wormhole are tagged with the protocol of their emitter.
+	protocol, _ := p.protocols.Protocol(vaa.EmitterChain, emitterAddress)
+
+	// the vaas of the emitters replaced by a redeployed contract are tagged with the new emitter,
+	// so the vaas of the old and new emitters can be aggregated.
+	canonicalEmitterAddr, _ := p.remapping.Remap(vaa.EmitterChain, emitterAddress)
+
 	// create ParsedVaaUpdate to upsert.
 	now := time.Now()
 	vaaParsed := parser.ParsedVaaUpdate{
@@ -96,13 +162,42 @@ func (p *Processor) Process(ctx context.Context, params *Params) (*parser.Parsed
 		EmitterAddr:               emitterAddress,
 		Sequence:                  sequence,
 		AppIDs:                    standardizedProperties.AppIds,
+		Protocol:                  protocol,
+		CanonicalEmitterAddr:      canonicalEmitterAddr,
 		ParsedPayload:             vaaParseResponse.ParsedPayload,
+		DecodedPayload:            decodedPayload,
 		RawStandardizedProperties: vaaParseResponse.StandardizedProperties,
 		StandardizedProperties:    standardizedProperties,
+		RelayerFee:                newRelayerFee(vaaParseResponse.StandardizedProperties, standardizedProperties),
+		UnknownChainIDs:           unknownChainIDs,
 		Timestamp:                 vaa.Timestamp,
 		UpdatedAt:                 &now,
 	}
 
+	// the dry runs return the parsed vaa without storing it, with the validation error if it is not valid.
+	if params.DryRun {
+		return &vaaParsed, vaaParsed.Validate()
+	}
+
+	// invalid documents are quarantined instead of stored, they are not retried because the result of the parser is the same.
+	// The validation error is returned with the document, so the callers can report the invalid fields.
+	var validationErr *parser.ValidationError
+	if err := vaaParsed.Validate(); errors.As(err, &validationErr) {
+		p.metrics.IncVaaParsedInvalid(chainID)
+		p.logger.Warn("Parsed VAA is not valid",
+			zap.String("trackId", params.TrackID),
+			zap.String("id", vaaParsed.ID),
+			zap.Strings("fields", validationErr.Fields))
+		if err := p.repository.UpsertInvalidParsedVaa(ctx, vaaParsed, validationErr.Fields); err != nil {
+			p.logger.Error("Error inserting invalid parsed vaa in repository",
+				zap.String("trackId", params.TrackID),
+				zap.String("id", vaaParsed.ID),
+				zap.Error(err))
+			return nil, err
+		}
+		return &vaaParsed, validationErr
+	}
+
 	err = p.repository.UpsertParsedVaa(ctx, vaaParsed)
 	if err != nil {
 		p.logger.Error("Error inserting vaa in repository",
@@ -119,15 +214,96 @@ func (p *Processor) Process(ctx context.Context, params *Params) (*parser.Parsed
 				"appIDs":         strings.Join(standardizedProperties.AppIds, ", "),
 			},
 			Error: err}
-		p.alert.CreateAndSend(ctx, parserAlert.AlertKeyInsertParsedVaaError, alertContext)
+		if !params.Replay {
+			p.alert.CreateAndSend(ctx, parserAlert.AlertKeyInsertParsedVaaError, alertContext)
+		}
 		return nil, err
 	}
 	p.metrics.IncVaaParsedInserted(chainID)
 
+	// the application ids are stored in the global transaction to filter the transactions by application.
+	if len(vaaParsed.AppIDs) > 0 {
+		err = p.repository.UpsertGlobalTransactionAppIDs(ctx, vaaParsed.ID, vaaParsed.AppIDs, vaaParsed.Timestamp)
+		if err != nil {
+			p.logger.Error("Error updating app ids of global transaction",
+				zap.String("trackId", params.TrackID),
+				zap.String("id", vaaParsed.ID),
+				zap.Error(err))
+			return nil, err
+		}
+	}
+
 	p.logger.Info("parsed VAA was successfully persisted", zap.String("trackId", params.TrackID), zap.String("id", vaaParsed.ID))
 	return &vaaParsed, nil
 }
 
+// storeParseFailure saves the payload of a vaa that cannot be parsed, so the new payload formats
+// can be reviewed. The vaa is not retried if the failure cannot be saved.
+func (p *Processor) storeParseFailure(ctx context.Context, trackID string, vaa *sdk.VAA, parseErr error) {
+	p.metrics.IncVaaParseFailure(uint16(vaa.EmitterChain))
+	now := time.Now()
+	failure := parser.ParseFailure{
+		ID:           vaa.MessageID(),
+		EmitterChain: vaa.EmitterChain,
+		EmitterAddr:  vaa.EmitterAddress.String(),
+		Sequence:     fmt.Sprintf("%d", vaa.Sequence),
+		Payload:      vaa.Payload,
+		Error:        parseErr.Error(),
+		Timestamp:    vaa.Timestamp,
+		UpdatedAt:    &now,
+	}
+	if err := p.repository.UpsertParseFailure(ctx, failure); err != nil {
+		p.logger.Error("Error inserting parse failure in repository",
+			zap.String("trackId", trackID),
+			zap.String("id", failure.ID),
+			zap.Error(err))
+	}
+}
+
+// decodePayload decodes the payload of a vaa with the schema registered for its emitter.
+// It returns nil when there is no schema for the emitter or the payload does not match the schema.
+func (p *Processor) decodePayload(trackID string, vaa *sdk.VAA) *parser.DecodedPayload {
+	if p.schemas == nil {
+		return nil
+	}
+	s, ok := p.schemas.Get(vaa.EmitterChain, vaa.EmitterAddress.String())
+	if !ok {
+		return nil
+	}
+	fields, err := s.Decode(vaa.Payload)
+	if err != nil {
+		p.metrics.IncVaaPayloadSchemaErrorCount(uint16(vaa.EmitterChain))
+		p.logger.Warn("VAA payload does not match the registered schema", zap.Error(err),
+			zap.String("trackId", trackID),
+			zap.String("id", vaa.MessageID()),
+			zap.String("protocol", s.Protocol))
+		return nil
+	}
+	p.metrics.IncVaaPayloadSchemaDecodedCount(uint16(vaa.EmitterChain))
+	return &parser.DecodedPayload{Protocol: s.Protocol, Fields: fields}
+}
+
+// trackUnknownChains reports the chain IDs that are not known by the wormhole sdk and not already tracked.
+// It returns the tracked chain IDs followed by the new unknown chain IDs, without duplicates.
+func (p *Processor) trackUnknownChains(trackID, vaaID string, tracked []sdk.ChainID, chainIDs ...sdk.ChainID) []sdk.ChainID {
+	unknown := tracked
+	for _, chainID := range chainIDs {
+		if chainID == sdk.ChainIDUnset || domain.ChainIdIsValid(chainID) || slices.Contains(unknown, chainID) {
+			continue
+		}
+		unknown = append(unknown, chainID)
+		if p.unknownChains != nil {
+			p.unknownChains.Track(chainID, vaaID)
+		}
+		p.metrics.IncUnknownChain(uint16(chainID))
+		p.logger.Warn("VAA references an unknown chain",
+			zap.String("trackId", trackID),
+			zap.String("vaaId", vaaID),
+			zap.Uint16("chainId", uint16(chainID)))
+	}
+	return unknown
+}
+
 // transformStandarizedProperties transform amount and fee amount.
 func (p *Processor) transformStandarizedProperties(trackID, vaaID string, sp vaaPayloadParser.StandardizedProperties) vaaPayloadParser.StandardizedProperties {
 	// transform amount.
@@ -150,6 +326,24 @@ func (p *Processor) transformStandarizedProperties(trackID, vaaID string, sp vaa
 	}
 }
 
+// newRelayerFee creates a RelayerFee from the standardized properties of a vaa.
+// It returns nil when the payload does not carry a relayer fee.
+func newRelayerFee(raw, normalized vaaPayloadParser.StandardizedProperties) *parser.RelayerFee {
+	if raw.FeeChain == sdk.ChainIDUnset || raw.FeeAddress == "" {
+		return nil
+	}
+	fee, ok := new(big.Int).SetString(raw.Fee, 10)
+	if !ok || fee.Sign() <= 0 {
+		return nil
+	}
+	return &parser.RelayerFee{
+		Amount:           raw.Fee,
+		NormalizedAmount: normalized.Fee,
+		TokenChain:       raw.FeeChain,
+		TokenAddress:     raw.FeeAddress,
+	}
+}
+
 // transformAmount transform amount and fee amount.
 func (p *Processor) transformAmount(chainID sdk.ChainID, trackID, nativeAddress, amount, vaaID string) string {
 
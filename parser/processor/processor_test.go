@@ -0,0 +1,34 @@
+package processor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestTrackUnknownChainsCountsEachChainOnce(t *testing.T) {
+	unknownChains := domain.NewUnknownChainTracker()
+	p := &Processor{
+		metrics:       metrics.NewNoopParserMetrics(),
+		unknownChains: unknownChains,
+		logger:        zap.NewNop(),
+	}
+	emitterChain := sdk.ChainID(65000)
+	toChain := sdk.ChainID(65001)
+
+	// the emitter chain is tracked before parsing the payload, then the chains of the payload.
+	unknown := p.trackUnknownChains("trackId", "vaaId", nil, emitterChain)
+	unknown = p.trackUnknownChains("trackId", "vaaId", unknown,
+		sdk.ChainIDEthereum, toChain, emitterChain, sdk.ChainIDUnset)
+
+	assert.Equal(t, []sdk.ChainID{emitterChain, toChain}, unknown)
+	chains := unknownChains.List()
+	if assert.Len(t, chains, 2) {
+		assert.Equal(t, uint64(1), chains[0].Count)
+		assert.Equal(t, uint64(1), chains[1].Count)
+	}
+}
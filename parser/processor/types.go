@@ -9,6 +9,11 @@ import (
 type Params struct {
 	TrackID string
 	Vaa     []byte
+	// Replay indicates the vaa was republished from the stored vaas, the alerts are not sent for replayed vaas.
+	Replay bool
+	// DryRun parses the vaa without side effects, to compare the result with the stored parsed vaa.
+	// Nothing is stored, no alert is sent and the governance and attestation vaas are not handled.
+	DryRun bool
 }
 
 // ProcessorFunc is a function to process vaa message.
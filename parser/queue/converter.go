@@ -24,6 +24,9 @@ type VaaEvent struct {
 	TxHash           string     `json:"txHash"`
 	Version          uint16     `json:"version"`
 	Revision         uint16     `json:"revision"`
+	Replay           bool       `json:"replay"`
+	// Watermarks contains the time at which the vaa was ingested by each stage of the pipeline.
+	Watermarks events.Watermarks `json:"watermarks"`
 }
 
 // VaaConverter converts a message from a VAAEvent.
@@ -46,6 +49,8 @@ func NewVaaConverter(log *zap.Logger) ConverterFunc {
 			Vaa:            vaaEvent.Vaa,
 			Timestamp:      vaaEvent.Timestamp,
 			TxHash:         vaaEvent.TxHash,
+			Replay:         vaaEvent.Replay,
+			Watermarks:     vaaEvent.Watermarks.Stamp(events.StageParser, time.Now()),
 		}, nil
 	}
 }
@@ -6,10 +6,17 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
+type sqsEvent struct {
+	MessageID         string                       `json:"MessageId"`
+	Message           string                       `json:"Message"`
+	MessageAttributes map[string]payload.Attribute `json:"MessageAttributes"`
+}
+
 func TestNotificationEvent(t *testing.T) {
 	log := zap.NewExample()
 	converter := NewNotificationEvent(log)
@@ -2,6 +2,9 @@ package queue
 
 import "github.com/wormhole-foundation/wormhole/sdk/vaa"
 
+// FilterConsumeFunc filter vaaa func definition.
+type FilterConsumeFunc func(*Event) bool
+
 // PythFilter filter vaa event from pyth chain.
 func PythFilter(vaaEvent *Event) bool {
 	return vaaEvent.ChainID == uint16(vaa.ChainIDPythNet)
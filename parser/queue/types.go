@@ -3,12 +3,10 @@ package queue
 import (
 	"context"
 	"time"
-)
 
-type sqsEvent struct {
-	MessageID string `json:"MessageId"`
-	Message   string `json:"Message"`
-}
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+)
 
 // Event represents a event data to be handle.
 type Event struct {
@@ -21,16 +19,15 @@ type Event struct {
 	Vaa            []byte
 	Timestamp      *time.Time
 	TxHash         string
+	Replay         bool
+	Watermarks     events.Watermarks
 }
 
 // ConsumerMessage defition.
-type ConsumerMessage interface {
-	Data() *Event
-	Done()
-	Failed()
-	IsExpired() bool
-	SentTimestamp() *time.Time
-}
+type ConsumerMessage = sqs.ConsumerMessage[Event]
+
+// ConverterFunc converts a message from a sqs message.
+type ConverterFunc = sqs.ConverterFunc[Event]
 
 // ConsumeFunc is a function to consume Event.
 type ConsumeFunc func(context.Context) <-chan ConsumerMessage
@@ -0,0 +1,159 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Registry contains the payload schemas of the emitters.
+//
+// The schemas are loaded from a configuration file and from the database, the schemas of the database
+// replace the schemas of the file for the same emitter. The schemas registered with the admin API are saved
+// in the database, so the registry is reloaded periodically to get the schemas registered on other instances.
+type Registry struct {
+	repository *Repository
+	seed       map[string]*Schema
+	schemas    map[string]*Schema
+	mu         sync.RWMutex
+	logger     *zap.Logger
+}
+
+// NewRegistry creates a new payload schema registry with the schemas set by configuration.
+func NewRegistry(repository *Repository, seed []*Schema, logger *zap.Logger) *Registry {
+	r := &Registry{
+		repository: repository,
+		seed:       make(map[string]*Schema, len(seed)),
+		schemas:    make(map[string]*Schema, len(seed)),
+		logger:     logger.With(zap.String("module", "PayloadSchemaRegistry")),
+	}
+	for _, s := range seed {
+		r.seed[s.ID] = s
+		r.schemas[s.ID] = s
+	}
+	return r
+}
+
+// LoadFile reads a json file with a list of payload schemas.
+// An empty path returns no schemas.
+func LoadFile(path string) ([]*Schema, error) {
+	if path == "" {
+		return nil, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var schemas []*Schema
+	if err := json.Unmarshal(b, &schemas); err != nil {
+		return nil, err
+	}
+	for _, s := range schemas {
+		s.Normalize()
+		if err := s.Validate(); err != nil {
+			return nil, fmt.Errorf("schema %s: %w", s.ID, err)
+		}
+	}
+	return schemas, nil
+}
+
+// Start loads the schemas from the database and reloads them every [interval] until the context is cancelled.
+func (r *Registry) Start(ctx context.Context, interval time.Duration) {
+	if err := r.Reload(ctx); err != nil {
+		r.logger.Error("failed to load payload schemas", zap.Error(err))
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Reload(ctx); err != nil {
+					r.logger.Error("failed to reload payload schemas", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Reload loads the schemas from the database.
+func (r *Registry) Reload(ctx context.Context) error {
+	stored, err := r.repository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	schemas := make(map[string]*Schema, len(r.seed)+len(stored))
+	for id, s := range r.seed {
+		schemas[id] = s
+	}
+	for _, s := range stored {
+		schemas[s.ID] = s
+	}
+	r.mu.Lock()
+	r.schemas = schemas
+	r.mu.Unlock()
+	return nil
+}
+
+// Get returns the schema of an emitter.
+func (r *Registry) Get(chain sdk.ChainID, address string) (*Schema, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.schemas[SchemaID(chain, address)]
+	return s, ok
+}
+
+// List returns all the schemas sorted by id.
+func (r *Registry) List() []*Schema {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	schemas := make([]*Schema, 0, len(r.schemas))
+	for _, s := range r.schemas {
+		schemas = append(schemas, s)
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].ID < schemas[j].ID })
+	return schemas
+}
+
+// Register validates and saves the schema of an emitter.
+func (r *Registry) Register(ctx context.Context, s *Schema) error {
+	s.Normalize()
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	now := time.Now()
+	s.UpdatedAt = &now
+	if err := r.repository.Upsert(ctx, s); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.schemas[s.ID] = s
+	r.mu.Unlock()
+	return nil
+}
+
+// Delete removes the schema of an emitter registered with the admin API.
+// The schemas set by configuration are restored.
+func (r *Registry) Delete(ctx context.Context, chain sdk.ChainID, address string) error {
+	id := SchemaID(chain, address)
+	if err := r.repository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	if s, ok := r.seed[id]; ok {
+		r.schemas[id] = s
+	} else {
+		delete(r.schemas, id)
+	}
+	r.mu.Unlock()
+	return nil
+}
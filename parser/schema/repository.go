@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// PayloadSchemaCollection contains the payload schemas registered by the protocols.
+const PayloadSchemaCollection = "payloadSchemas"
+
+// Repository definitions.
+type Repository struct {
+	db          *mongo.Database
+	logger      *zap.Logger
+	collections struct {
+		payloadSchemas *mongo.Collection
+	}
+}
+
+// NewRepository create a new respository instance.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{db, logger, struct {
+		payloadSchemas *mongo.Collection
+	}{
+		payloadSchemas: db.Collection(PayloadSchemaCollection),
+	}}
+}
+
+// Upsert saves a payload schema.
+func (r *Repository) Upsert(ctx context.Context, s *Schema) error {
+	update := bson.M{"$set": s}
+	opts := options.Update().SetUpsert(true)
+	_, err := r.collections.payloadSchemas.UpdateByID(ctx, s.ID, update, opts)
+	return err
+}
+
+// Delete removes a payload schema.
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	_, err := r.collections.payloadSchemas.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+// FindAll returns all the payload schemas.
+func (r *Repository) FindAll(ctx context.Context) ([]*Schema, error) {
+	cur, err := r.collections.payloadSchemas.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	var schemas []*Schema
+	if err := cur.All(ctx, &schemas); err != nil {
+		return nil, err
+	}
+	return schemas, nil
+}
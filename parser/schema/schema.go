@@ -0,0 +1,181 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// field types.
+//
+// The integers are big endian, as in the payloads of the wormhole protocols.
+// The fixed size byte arrays are defined as "bytes<N>" (e.g. "bytes20") and decoded to hex.
+// The "bytes" and "string" types take the remaining bytes of the payload, so they must be the last field.
+const (
+	TypeUint8   = "uint8"
+	TypeUint16  = "uint16"
+	TypeUint32  = "uint32"
+	TypeUint64  = "uint64"
+	TypeUint128 = "uint128"
+	TypeUint256 = "uint256"
+	TypeBool    = "bool"
+	TypeChain   = "chain"
+	TypeAddress = "address"
+	TypeBytes   = "bytes"
+	TypeString  = "string"
+)
+
+// fixedSizes contains the size in bytes of the fixed size types.
+var fixedSizes = map[string]int{
+	TypeUint8:   1,
+	TypeUint16:  2,
+	TypeUint32:  4,
+	TypeUint64:  8,
+	TypeUint128: 16,
+	TypeUint256: 32,
+	TypeBool:    1,
+	TypeChain:   2,
+	TypeAddress: 32,
+}
+
+// ErrInvalidSchema is returned when a schema is not valid.
+var ErrInvalidSchema = errors.New("invalid payload schema")
+
+// Field is a field of a payload.
+type Field struct {
+	Name string `bson:"name" json:"name"`
+	Type string `bson:"type" json:"type"`
+}
+
+// Schema describes the payload of the vaas of an emitter.
+type Schema struct {
+	ID             string      `bson:"_id" json:"id"`
+	EmitterChain   sdk.ChainID `bson:"emitterChain" json:"emitterChain"`
+	EmitterAddress string      `bson:"emitterAddress" json:"emitterAddress"`
+	// Protocol is the name of the protocol that owns the emitter.
+	Protocol  string     `bson:"protocol" json:"protocol"`
+	Fields    []Field    `bson:"fields" json:"fields"`
+	UpdatedAt *time.Time `bson:"updatedAt" json:"updatedAt"`
+}
+
+// SchemaID returns the id of the schema of an emitter.
+func SchemaID(chain sdk.ChainID, address string) string {
+	return fmt.Sprintf("%d/%s", chain, address)
+}
+
+// Normalize sets the id of the schema and normalizes the emitter address to lowercase hex without prefix.
+func (s *Schema) Normalize() {
+	s.EmitterAddress = strings.ToLower(strings.TrimPrefix(s.EmitterAddress, "0x"))
+	s.ID = SchemaID(s.EmitterChain, s.EmitterAddress)
+}
+
+// Validate checks the emitter and the fields of the schema.
+func (s *Schema) Validate() error {
+	if b, err := hex.DecodeString(s.EmitterAddress); err != nil || len(b) != 32 {
+		return fmt.Errorf("%w: the emitter address must be 32 bytes in hex", ErrInvalidSchema)
+	}
+	if s.Protocol == "" {
+		return fmt.Errorf("%w: the protocol is required", ErrInvalidSchema)
+	}
+	if len(s.Fields) == 0 {
+		return fmt.Errorf("%w: the fields are required", ErrInvalidSchema)
+	}
+	names := make(map[string]bool, len(s.Fields))
+	for i, f := range s.Fields {
+		if f.Name == "" || names[f.Name] {
+			return fmt.Errorf("%w: field %d has an empty or duplicated name", ErrInvalidSchema, i)
+		}
+		names[f.Name] = true
+		if f.Type == TypeBytes || f.Type == TypeString {
+			if i != len(s.Fields)-1 {
+				return fmt.Errorf("%w: field %s of type %s must be the last field", ErrInvalidSchema, f.Name, f.Type)
+			}
+			continue
+		}
+		if _, err := fieldSize(f.Type); err != nil {
+			return fmt.Errorf("%w: field %s: %s", ErrInvalidSchema, f.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+// Decode decodes a payload with the fields of the schema.
+//
+// The payload must contain exactly the fields of the schema, unless the last field is of type "bytes" or "string".
+func (s *Schema) Decode(payload []byte) (map[string]any, error) {
+	r := bytes.NewReader(payload)
+	decoded := make(map[string]any, len(s.Fields))
+	for _, f := range s.Fields {
+		value, err := decodeField(r, f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode field %s: %w", f.Name, err)
+		}
+		decoded[f.Name] = value
+	}
+	if r.Len() > 0 {
+		return nil, fmt.Errorf("payload has %d unexpected trailing bytes", r.Len())
+	}
+	return decoded, nil
+}
+
+func fieldSize(fieldType string) (int, error) {
+	if size, ok := fixedSizes[fieldType]; ok {
+		return size, nil
+	}
+	if n, found := strings.CutPrefix(fieldType, TypeBytes); found {
+		size, err := strconv.Atoi(n)
+		if err == nil && size > 0 && size <= 32 {
+			return size, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown type %s", fieldType)
+}
+
+func decodeField(r *bytes.Reader, fieldType string) (any, error) {
+	switch fieldType {
+	case TypeBytes:
+		b, _ := io.ReadAll(r)
+		return hex.EncodeToString(b), nil
+	case TypeString:
+		b, _ := io.ReadAll(r)
+		return string(bytes.TrimRight(b, "\x00")), nil
+	}
+
+	size, err := fieldSize(fieldType)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+
+	switch fieldType {
+	case TypeUint8:
+		return b[0], nil
+	case TypeUint16:
+		return binary.BigEndian.Uint16(b), nil
+	case TypeUint32:
+		return binary.BigEndian.Uint32(b), nil
+	case TypeUint64:
+		// encoded as string because it may not fit in the json numbers.
+		return strconv.FormatUint(binary.BigEndian.Uint64(b), 10), nil
+	case TypeUint128, TypeUint256:
+		return new(big.Int).SetBytes(b).String(), nil
+	case TypeBool:
+		return b[0] != 0, nil
+	case TypeChain:
+		return sdk.ChainID(binary.BigEndian.Uint16(b)), nil
+	default:
+		return hex.EncodeToString(b), nil
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func newSchema(fields ...Field) *Schema {
+	s := &Schema{
+		EmitterChain:   sdk.ChainIDEthereum,
+		EmitterAddress: "0x0000000000000000000000003EE18B2214AFF97000D974CF647E7C347E8FA585",
+		Protocol:       "example",
+		Fields:         fields,
+	}
+	s.Normalize()
+	return s
+}
+
+func TestSchema_Decode(t *testing.T) {
+	s := newSchema(
+		Field{Name: "kind", Type: TypeUint8},
+		Field{Name: "targetChain", Type: TypeChain},
+		Field{Name: "nonce", Type: TypeUint64},
+		Field{Name: "amount", Type: TypeUint256},
+		Field{Name: "recipient", Type: "bytes20"},
+		Field{Name: "enabled", Type: TypeBool},
+		Field{Name: "memo", Type: TypeString},
+	)
+	assert.NoError(t, s.Validate())
+	assert.Equal(t, "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585", s.ID)
+
+	var payload []byte
+	payload = append(payload, 1)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(sdk.ChainIDSolana))
+	payload = binary.BigEndian.AppendUint64(payload, 42)
+	amount := make([]byte, 32)
+	amount[31] = 100
+	payload = append(payload, amount...)
+	payload = append(payload, bytes.Repeat([]byte{0xab}, 20)...)
+	payload = append(payload, 1)
+	payload = append(payload, []byte("hello")...)
+
+	decoded, err := s.Decode(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(1), decoded["kind"])
+	assert.Equal(t, sdk.ChainIDSolana, decoded["targetChain"])
+	assert.Equal(t, "42", decoded["nonce"])
+	assert.Equal(t, "100", decoded["amount"])
+	assert.Equal(t, "abababababababababababababababababababab", decoded["recipient"])
+	assert.Equal(t, true, decoded["enabled"])
+	assert.Equal(t, "hello", decoded["memo"])
+}
+
+func TestSchema_DecodeInvalidPayload(t *testing.T) {
+	s := newSchema(Field{Name: "kind", Type: TypeUint8}, Field{Name: "amount", Type: TypeUint32})
+
+	_, err := s.Decode([]byte{1, 0, 0})
+	assert.Error(t, err)
+
+	_, err = s.Decode([]byte{1, 0, 0, 0, 1, 0xff})
+	assert.Error(t, err)
+}
+
+func TestSchema_Validate(t *testing.T) {
+	assert.ErrorIs(t, newSchema().Validate(), ErrInvalidSchema)
+	assert.ErrorIs(t, newSchema(Field{Name: "a", Type: "int8"}).Validate(), ErrInvalidSchema)
+	assert.ErrorIs(t, newSchema(Field{Name: "a", Type: "bytes33"}).Validate(), ErrInvalidSchema)
+	assert.ErrorIs(t, newSchema(Field{Name: "a", Type: TypeUint8}, Field{Name: "a", Type: TypeUint8}).Validate(), ErrInvalidSchema)
+	assert.ErrorIs(t, newSchema(Field{Name: "a", Type: TypeBytes}, Field{Name: "b", Type: TypeUint8}).Validate(), ErrInvalidSchema)
+
+	s := newSchema(Field{Name: "a", Type: TypeUint8})
+	s.EmitterAddress = "1234"
+	assert.ErrorIs(t, s.Validate(), ErrInvalidSchema)
+}
@@ -7,6 +7,7 @@ import (
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/sns"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/topic"
@@ -40,17 +41,18 @@ func NewAwsConfig(appCtx context.Context, region string, accessKeyID, secretAcce
 	return awsconfig.LoadDefaultConfig(appCtx, awsconfig.WithRegion(region))
 }
 
-func NewTopicProducer(ctx context.Context, region, snsUrl string, accessKeyID, secretAccessKey, endpoint string,
+func NewTopicProducer(ctx context.Context, region, snsUrl string, accessKeyID, secretAccessKey, endpoint, p2pNetwork string,
 	alertClient alert.AlertClient, metrics metrics.Metrics, logger *zap.Logger) (topic.PushFunc, error) {
 	awsConfig, err := NewAwsConfig(ctx, region, accessKeyID, secretAccessKey, endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	snsProducer, err := sns.NewProducer(awsConfig, snsUrl)
+	codec := payload.NewCodec(payload.WithCompressThreshold(payload.DefaultCompressThreshold))
+	snsProducer, err := sns.NewProducer(awsConfig, snsUrl, codec)
 	if err != nil {
 		return nil, err
 	}
 
-	return topic.NewVAASNS(snsProducer, alertClient, metrics, logger).Publish, nil
+	return topic.NewVAASNS(snsProducer, p2pNetwork, alertClient, metrics, logger).Publish, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/config"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/topic"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/ratelimit"
 	"go.uber.org/zap"
 )
@@ -25,7 +26,7 @@ func Run(cfg *config.Backfiller) {
 
 	logger := logger.New("wormhole-explorer-pipeline", logger.WithLevel(cfg.LogLevel))
 
-	logger.Info("Starting wormhole-explorer-pipeline as backfiller ...")
+	logger.Info("Starting wormhole-explorer-pipeline as backfiller ...", zap.Bool("replay", cfg.Replay))
 
 	startTime, err := time.Parse(time.RFC3339, cfg.StartTime)
 	if err != nil {
@@ -60,7 +61,7 @@ func Run(cfg *config.Backfiller) {
 
 	// get publish function.
 	pushFunc, err := builder.NewTopicProducer(ctx, cfg.AwsRegion, cfg.SNSUrl, cfg.AwsAccessKeyID,
-		cfg.AwsSecretAccessKey, cfg.AwsEndpoint, alertClient, metrics, logger)
+		cfg.AwsSecretAccessKey, cfg.AwsEndpoint, cfg.P2pNetwork, alertClient, metrics, logger)
 	if err != nil {
 		logger.Fatal("failed to create publish function", zap.Error(err))
 	}
@@ -72,6 +73,13 @@ func Run(cfg *config.Backfiller) {
 		StartTime: &startTime,
 		EndTime:   &endTime,
 	}
+	if cfg.EmitterChainID != nil {
+		chainID := sdk.ChainID(*cfg.EmitterChainID)
+		query.EmitterChainID = &chainID
+	}
+	if cfg.EmitterAddress != "" {
+		query.EmitterAddress = &cfg.EmitterAddress
+	}
 
 	limiter := ratelimit.New(int(cfg.RequestsPerSecond), ratelimit.Per(time.Second))
 
@@ -93,7 +101,7 @@ func Run(cfg *config.Backfiller) {
 	for i := 0; i < cfg.NumWorkers; i++ {
 		name := fmt.Sprintf("worker-%d", i)
 		log := logger.With(zap.String("worker", name))
-		go publishVaa(ctx, pushFunc, queue, log, &wg, limiter, &quantityConsumed)
+		go publishVaa(ctx, pushFunc, queue, log, &wg, limiter, &quantityConsumed, cfg.Replay)
 	}
 
 	logger.Info("Waiting for all workers to finish...")
@@ -146,7 +154,7 @@ func getVaas(ctx context.Context, logger *zap.Logger, pagination repository.Pagi
 }
 
 func publishVaa(ctx context.Context, push topic.PushFunc, queue chan *repository.VaaDoc, logger *zap.Logger, wg *sync.WaitGroup,
-	limiter ratelimit.Limiter, quantityConsumed *atomic.Uint64) {
+	limiter ratelimit.Limiter, quantityConsumed *atomic.Uint64, replay bool) {
 	// Main loop: fetch global txs and process them
 	defer wg.Done()
 	for {
@@ -175,6 +183,7 @@ func publishVaa(ctx context.Context, push topic.PushFunc, queue chan *repository
 				TxHash:           vaa.TxHash,
 				Version:          uint16(vaa.Version),
 				Revision:         uint16(vaa.Revision),
+				Replay:           replay,
 			}); err != nil {
 				logger.Error("Failed to push vaa", zap.Error(err))
 			} else {
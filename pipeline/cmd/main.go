@@ -3,6 +3,7 @@ package main
 import (
 	"github.com/spf13/cobra"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/cmd/backfiller"
+	"github.com/wormhole-foundation/wormhole-explorer/pipeline/cmd/mirror"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/cmd/service"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/config"
 )
@@ -23,6 +24,7 @@ func execute() error {
 
 	addServiceCommand(root)
 	addBackfiller(root)
+	addMirrorCommand(root)
 
 	return root.Execute()
 }
@@ -38,16 +40,29 @@ func addServiceCommand(root *cobra.Command) {
 	root.AddCommand(serviceCommand)
 }
 
+func addMirrorCommand(root *cobra.Command) {
+	mirrorCommand := &cobra.Command{
+		Use:   "mirror",
+		Short: "Run the service that publishes the database changes to kafka",
+		Run: func(_ *cobra.Command, _ []string) {
+			mirror.Run()
+		},
+	}
+	root.AddCommand(mirrorCommand)
+}
+
 func addBackfiller(root *cobra.Command) {
-	var mongoUri, mongoDb, snsUrl, logLevel, awsRegion, startTime, endTime string
-	var awsEndpoint, awsAccessKeyID, awsSecretAccessKey string
+	var mongoUri, mongoDb, snsUrl, logLevel, awsRegion, startTime, endTime, p2pNetwork string
+	var awsEndpoint, awsAccessKeyID, awsSecretAccessKey, emitterAddress string
 	var pageSize, requestsPerSecond int64
 	var numWorkers int
+	var emitterChainID uint16
+	var replay bool
 
 	backfillerCommand := &cobra.Command{
 		Use:   "backfiller",
 		Short: "Run backfiller to send vaas to sns",
-		Run: func(_ *cobra.Command, _ []string) {
+		Run: func(cmd *cobra.Command, _ []string) {
 			cfg := &config.Backfiller{
 				LogLevel:           logLevel,
 				MongoURI:           mongoUri,
@@ -62,6 +77,12 @@ func addBackfiller(root *cobra.Command) {
 				EndTime:            endTime,
 				PageSize:           pageSize,
 				NumWorkers:         numWorkers,
+				P2pNetwork:         p2pNetwork,
+				EmitterAddress:     emitterAddress,
+				Replay:             replay,
+			}
+			if cmd.Flags().Changed("emitter-chain") {
+				cfg.EmitterChainID = &emitterChainID
 			}
 			backfiller.Run(cfg)
 		},
@@ -76,6 +97,10 @@ func addBackfiller(root *cobra.Command) {
 	backfillerCommand.Flags().StringVar(&awsSecretAccessKey, "aws-secret-access-key", "", "Aws secret access key")
 	backfillerCommand.Flags().StringVar(&startTime, "start-time", "1970-01-01T00:00:00Z", "minimum VAA timestamp to process")
 	backfillerCommand.Flags().StringVar(&endTime, "end-time", "", "maximum VAA timestamp to process (default now)")
+	backfillerCommand.Flags().Uint16Var(&emitterChainID, "emitter-chain", 0, "emitter chain of the VAAs to process (default all)")
+	backfillerCommand.Flags().StringVar(&emitterAddress, "emitter-address", "", "emitter address of the VAAs to process (default all)")
+	backfillerCommand.Flags().BoolVar(&replay, "replay", false, "send the vaas with the replay attribute, so the consumers process the stored vaas again")
+	backfillerCommand.Flags().StringVar(&p2pNetwork, "p2p-network", "mainnet", "p2p network used to resolve the app id attribute of the vaas")
 	backfillerCommand.Flags().Int64Var(&pageSize, "page-size", 100, "number of documents retrieved at a time")
 	backfillerCommand.Flags().Int64Var(&requestsPerSecond, "requests-per-second", 100, "maximum number of requests per second to publish to sns topic")
 	backfillerCommand.Flags().IntVar(&numWorkers, "num-workers", 5, "number of workers to publish vaas")
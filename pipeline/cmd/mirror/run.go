@@ -0,0 +1,92 @@
+package mirror
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/pipeline/config"
+	"github.com/wormhole-foundation/wormhole-explorer/pipeline/healthcheck"
+	"github.com/wormhole-foundation/wormhole-explorer/pipeline/http/infrastructure"
+	"github.com/wormhole-foundation/wormhole-explorer/pipeline/mirror"
+	"go.uber.org/zap"
+)
+
+// Run runs the service that tails the change streams of the database and publishes the changes to kafka.
+func Run() {
+
+	rootCtx, rootCtxCancel := context.WithCancel(context.Background())
+
+	config, err := config.NewMirror(rootCtx)
+	if err != nil {
+		log.Fatal("Error creating config", err)
+	}
+
+	logger := logger.New("wormhole-explorer-pipeline-mirror", logger.WithLevel(config.LogLevel))
+
+	logger.Info("Starting wormhole-explorer-pipeline-mirror ...")
+
+	//setup DB connection
+	db, err := dbutil.Connect(rootCtx, logger, config.MongoURI, config.MongoDatabase, false)
+	if err != nil {
+		logger.Fatal("failed to connect MongoDB", zap.Error(err))
+	}
+
+	// create the kafka producer, the schemas are registered when a schema registry is set.
+	producer := mirror.NewProducer(config.KafkaRestURL, config.SchemaRegistryURL, config.KafkaTimeout)
+
+	// create and start the mirror.
+	m := mirror.New(db.Database, mirror.Config{
+		Name:          config.Name,
+		Collections:   config.Collections,
+		TopicPrefix:   config.TopicPrefix,
+		BatchSize:     config.BatchSize,
+		FlushInterval: config.FlushInterval,
+	}, producer, mirror.NewRepository(db.Database, logger), logger)
+	done := make(chan error, 1)
+	go func() {
+		done <- m.Run(rootCtx)
+	}()
+
+	healthChecks := []healthcheck.Check{healthcheck.Mongo(db.Database), healthcheck.KafkaRest(config.KafkaRestURL)}
+	server := infrastructure.NewServer(logger, config.Port, config.PprofEnabled, healthChecks...)
+	server.Start()
+
+	logger.Info("Started wormhole-explorer-pipeline-mirror",
+		zap.Strings("collections", config.Collections),
+		zap.String("topicPrefix", config.TopicPrefix))
+
+	// Waiting for signal
+	sigterm := make(chan os.Signal, 1)
+	signal.Notify(sigterm, syscall.SIGINT, syscall.SIGTERM)
+	stopped := false
+	select {
+	case err := <-done:
+		logger.Error("Terminating with mirror stopped.", zap.Error(err))
+		stopped = true
+	case signal := <-sigterm:
+		logger.Info("Terminating with signal.", zap.String("signal", signal.String()))
+	}
+
+	logger.Info("root context cancelled, exiting...")
+	rootCtxCancel()
+
+	// the changes that are not published yet are published again when the mirror is restarted.
+	if !stopped {
+		logger.Info("Waiting for the mirror to stop...")
+		<-done
+	}
+
+	logger.Info("closing MongoDB connection...")
+	db.DisconnectWithTimeout(10 * time.Second)
+
+	logger.Info("Closing Http server ...")
+	server.Stop()
+
+	logger.Info("Finished wormhole-explorer-pipeline-mirror")
+}
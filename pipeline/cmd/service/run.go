@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -11,7 +12,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/go-redis/redis/v8"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/config"
@@ -79,16 +83,22 @@ func Run() {
 		logger.Fatal("failed to create health checks", zap.Error(err))
 	}
 
+	// get the vaa cache of the api to invalidate the updated vaas.
+	vaaCache, err := newVaaCache(config, logger)
+	if err != nil {
+		logger.Fatal("failed to create vaa cache", zap.Error(err))
+	}
+
 	// create a new pipeline repository.
 	repository := pipeline.NewRepository(db.Database, logger)
 
 	// create and start a new tx hash handler.
 	quit := make(chan bool)
-	txHashHandler := pipeline.NewTxHashHandler(repository, pushFunc, alertClient, metrics, logger, quit)
+	txHashHandler := pipeline.NewTxHashHandler(repository, pushFunc, alertClient, vaaCache, metrics, logger, quit)
 	go txHashHandler.Run(rootCtx)
 
 	// create a new publisher.
-	publisher := pipeline.NewPublisher(pushFunc, metrics, repository, config.P2pNetwork, txHashHandler, logger)
+	publisher := pipeline.NewPublisher(pushFunc, metrics, repository, config.P2pNetwork, txHashHandler, vaaCache, logger)
 	watcher := watcher.NewWatcher(rootCtx, db.Database, config.MongoDatabase, publisher.Publish, alertClient, metrics, logger)
 	err = watcher.Start(rootCtx)
 	if err != nil {
@@ -160,12 +170,22 @@ func newTopicProducer(appCtx context.Context, config *config.Configuration, aler
 		return nil, err
 	}
 
-	snsProducer, err := sns.NewProducer(awsConfig, config.SNSUrl)
+	// the bodies above the threshold are compressed and offloaded to S3 if they exceed the maximum size.
+	// The offloaded bodies are read by every consumer of the topic, so they expire instead of being deleted.
+	store := payload.NewS3Store(awsConfig, config.AwsEndpoint)
+	if config.PayloadBucket != "" {
+		if err := store.PutExpiration(appCtx, config.PayloadBucket, config.PayloadRetentionDays); err != nil {
+			return nil, fmt.Errorf("failed to set the expiration of the offloaded payloads: %w", err)
+		}
+	}
+	codec := payload.NewCodec(payload.WithCompressThreshold(config.CompressThreshold),
+		payload.WithS3Store(store, config.PayloadBucket))
+	snsProducer, err := sns.NewProducer(awsConfig, config.SNSUrl, codec)
 	if err != nil {
 		return nil, err
 	}
 
-	return topic.NewVAASNS(snsProducer, alertClient, metrics, logger).Publish, nil
+	return topic.NewVAASNS(snsProducer, config.P2pNetwork, alertClient, metrics, logger).Publish, nil
 }
 
 func newHealthChecks(ctx context.Context, config *config.Configuration, db *mongo.Database) ([]healthcheck.Check, error) {
@@ -184,6 +204,14 @@ func newMetrics(cfg *config.Configuration) metrics.Metrics {
 	return metrics.NewPrometheusMetrics(cfg.Environment)
 }
 
+func newVaaCache(cfg *config.Configuration, logger *zap.Logger) (cache.Cache, error) {
+	if cfg.CacheURL == "" {
+		return cache.NewDummyCacheClient(), nil
+	}
+	redisClient := redis.NewClient(&redis.Options{Addr: cfg.CacheURL})
+	return cache.NewCacheClient(redisClient, true, cfg.CachePrefix, logger)
+}
+
 func newAlertClient(cfg *config.Configuration) (alert.AlertClient, error) {
 	if !cfg.AlertEnabled {
 		return alert.NewDummyClient(), nil
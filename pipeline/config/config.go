@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/sethvargo/go-envconfig"
@@ -20,10 +21,16 @@ type Configuration struct {
 	AwsSecretAccessKey string `env:"AWS_SECRET_ACCESS_KEY"`
 	AwsRegion          string `env:"AWS_REGION"`
 	SNSUrl             string `env:"SNS_URL"`
-	PprofEnabled       bool   `env:"PPROF_ENABLED,default=false"`
-	AlertEnabled       bool   `env:"ALERT_ENABLED,default=false"`
-	AlertApiKey        string `env:"ALERT_API_KEY"`
-	MetricsEnabled     bool   `env:"METRICS_ENABLED,default=false"`
+	CompressThreshold  int    `env:"PAYLOAD_COMPRESS_THRESHOLD,default=65536"`
+	PayloadBucket      string `env:"PAYLOAD_S3_BUCKET"`
+	// PayloadRetentionDays of the messages offloaded to the bucket, the default is the max retention of the sqs queues.
+	PayloadRetentionDays int    `env:"PAYLOAD_S3_RETENTION_DAYS,default=14"`
+	PprofEnabled         bool   `env:"PPROF_ENABLED,default=false"`
+	AlertEnabled         bool   `env:"ALERT_ENABLED,default=false"`
+	AlertApiKey          string `env:"ALERT_API_KEY"`
+	MetricsEnabled       bool   `env:"METRICS_ENABLED,default=false"`
+	CacheURL             string `env:"CACHE_URL"`
+	CachePrefix          string `env:"CACHE_PREFIX"`
 }
 
 type Backfiller struct {
@@ -40,6 +47,28 @@ type Backfiller struct {
 	EndTime            string
 	PageSize           int64
 	NumWorkers         int
+	P2pNetwork         string
+	EmitterChainID     *uint16
+	EmitterAddress     string
+	Replay             bool
+}
+
+// Mirror represents the configuration of the service that mirrors the database changes to kafka.
+type Mirror struct {
+	Environment       string        `env:"ENVIRONMENT,required"`
+	LogLevel          string        `env:"LOG_LEVEL,default=INFO"`
+	Port              string        `env:"PORT,default=8000"`
+	PprofEnabled      bool          `env:"PPROF_ENABLED,default=false"`
+	MongoURI          string        `env:"MONGODB_URI,required"`
+	MongoDatabase     string        `env:"MONGODB_DATABASE,required"`
+	KafkaRestURL      string        `env:"KAFKA_REST_URL,required"`
+	KafkaTimeout      time.Duration `env:"KAFKA_TIMEOUT,default=10s"`
+	SchemaRegistryURL string        `env:"SCHEMA_REGISTRY_URL"`
+	Name              string        `env:"MIRROR_NAME,default=kafka"`
+	Collections       []string      `env:"MIRROR_COLLECTIONS,default=vaas,globalTransactions"`
+	TopicPrefix       string        `env:"MIRROR_TOPIC_PREFIX,default=wormscan."`
+	BatchSize         int           `env:"MIRROR_BATCH_SIZE,default=100"`
+	FlushInterval     time.Duration `env:"MIRROR_FLUSH_INTERVAL,default=1s"`
 }
 
 // New creates a configuration with the values from .env file and environment variables.
@@ -53,3 +82,15 @@ func New(ctx context.Context) (*Configuration, error) {
 
 	return &configuration, nil
 }
+
+// NewMirror creates a mirror configuration with the values from .env file and environment variables.
+func NewMirror(ctx context.Context) (*Mirror, error) {
+	_ = godotenv.Load(".env", "../.env")
+
+	var configuration Mirror
+	if err := envconfig.Process(ctx, &configuration); err != nil {
+		return nil, err
+	}
+
+	return &configuration, nil
+}
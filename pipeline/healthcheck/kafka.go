@@ -0,0 +1,28 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// KafkaRest checks that the kafka rest proxy is available.
+func KafkaRest(url string) Check {
+	url = strings.TrimSuffix(url, "/") + "/topics"
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("kafka rest proxy not ready (status = %d)", res.StatusCode)
+		}
+		return nil
+	}
+}
@@ -1,5 +1,7 @@
 package metrics
 
+import "time"
+
 // DummyMetrics is a dummy implementation of Metric interface.
 type DummyMetrics struct {
 }
@@ -20,3 +22,6 @@ func (m *DummyMetrics) IncVaaWithoutTxHash(chainID uint16) {}
 
 // IncVaaWithTxHashFixed increments the vaa received count with tx hash fixed.
 func (m *DummyMetrics) IncVaaWithTxHashFixed(chainID uint16) {}
+
+// VaaStageLatency records the time elapsed between the ingestion of a vaa by the previous stage and the stage.
+func (m *DummyMetrics) VaaStageLatency(stage string, latency time.Duration) {}
@@ -1,5 +1,7 @@
 package metrics
 
+import "time"
+
 const serviceName = "wormscan-pipeline"
 
 // Metrics is a metrics interface.
@@ -9,4 +11,6 @@ type Metrics interface {
 
 	IncVaaWithoutTxHash(chainID uint16)
 	IncVaaWithTxHashFixed(chainID uint16)
+
+	VaaStageLatency(stage string, latency time.Duration)
 }
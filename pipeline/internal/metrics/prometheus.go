@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -10,6 +12,7 @@ import (
 type PrometheusMetrics struct {
 	vaaReceivedCount *prometheus.CounterVec
 	vaaTxHashCount   *prometheus.CounterVec
+	vaaStageLatency  *prometheus.HistogramVec
 }
 
 // NewPrometheusMetrics creates a new PrometheusMetrics.
@@ -34,9 +37,21 @@ func NewPrometheusMetrics(environment string) *PrometheusMetrics {
 			},
 		}, []string{"chain", "type"})
 
+	vaaStageLatency := promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "vaa_stage_latency_seconds",
+			Help: "Time elapsed between the ingestion of a vaa by the previous stage of the pipeline and the stage",
+			ConstLabels: map[string]string{
+				"environment": environment,
+				"service":     serviceName,
+			},
+			Buckets: []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 20, 30, 60, 120, 300, 600, 1200},
+		}, []string{"stage"})
+
 	return &PrometheusMetrics{
 		vaaReceivedCount: vaaReceivedCount,
 		vaaTxHashCount:   vaaTxHashCount,
+		vaaStageLatency:  vaaStageLatency,
 	}
 }
 
@@ -63,3 +78,8 @@ func (m *PrometheusMetrics) IncVaaWithTxHashFixed(chainID uint16) {
 	chain := vaa.ChainID(chainID).String()
 	m.vaaTxHashCount.WithLabelValues(chain, "vaa-with-txhash-fixed").Inc()
 }
+
+// VaaStageLatency records the time elapsed between the ingestion of a vaa by the previous stage and the stage.
+func (m *PrometheusMetrics) VaaStageLatency(stage string, latency time.Duration) {
+	m.vaaStageLatency.WithLabelValues(stage).Observe(latency.Seconds())
+}
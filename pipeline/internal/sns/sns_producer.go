@@ -2,35 +2,51 @@ package sns
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	aws_sns "github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
 )
 
 // Producer represents SNS producer.
 type Producer struct {
-	api *aws_sns.Client
-	url string
+	api   *aws_sns.Client
+	url   string
+	codec *payload.Codec
 }
 
-func NewProducer(awsConfig aws.Config, url string) (*Producer, error) {
+// NewProducer creates a SNS producer. The bodies of the messages are encoded with codec.
+func NewProducer(awsConfig aws.Config, url string, codec *payload.Codec) (*Producer, error) {
 	return &Producer{
-		api: aws_sns.NewFromConfig(awsConfig),
-		url: url,
+		api:   aws_sns.NewFromConfig(awsConfig),
+		url:   url,
+		codec: codec,
 	}, nil
 }
 
 // SendMessage sends messages to SQS.
-func (p *Producer) SendMessage(ctx context.Context, chainId uint16, groupID, deduplicationID, body string) error {
-	attrs := map[string]types.MessageAttributeValue{
-		"chainId": {
+// The attributes are sent as string message attributes, so the subscriptions can filter the messages by them.
+// The body is compressed or offloaded to S3 by the codec, in that case the encoding attribute is added.
+func (p *Producer) SendMessage(ctx context.Context, groupID, deduplicationID, body string, attributes map[string]string) error {
+	body, encodingAttrs, err := p.codec.Encode(ctx, deduplicationID, body)
+	if err != nil {
+		return err
+	}
+	attrs := make(map[string]types.MessageAttributeValue, len(attributes)+len(encodingAttrs))
+	for name, value := range encodingAttrs {
+		attrs[name] = types.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(value),
+		}
+	}
+	for name, value := range attributes {
+		attrs[name] = types.MessageAttributeValue{
 			DataType:    aws.String("String"),
-			StringValue: aws.String(fmt.Sprintf("%d", chainId)),
-		},
+			StringValue: aws.String(value),
+		}
 	}
-	_, err := p.api.Publish(ctx,
+	_, err = p.api.Publish(ctx,
 		&aws_sns.PublishInput{
 			MessageGroupId:         aws.String(groupID),
 			MessageDeduplicationId: aws.String(deduplicationID),
@@ -0,0 +1,120 @@
+package mirror
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// operation types of the change stream events that are mirrored.
+const (
+	OperationInsert  = "insert"
+	OperationUpdate  = "update"
+	OperationReplace = "replace"
+	OperationDelete  = "delete"
+)
+
+// changeEvent is a change stream event.
+type changeEvent struct {
+	OperationType string              `bson:"operationType"`
+	ClusterTime   primitive.Timestamp `bson:"clusterTime"`
+	Ns            struct {
+		Coll string `bson:"coll"`
+	} `bson:"ns"`
+	DocumentKey struct {
+		ID bson.RawValue `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument bson.Raw `bson:"fullDocument"`
+}
+
+// Change is the message published for a change of a document.
+//
+// The document is the full document after the change encoded as relaxed extended json,
+// it is not set for the deleted documents.
+type Change struct {
+	Operation   string          `json:"operation"`
+	Collection  string          `json:"collection"`
+	ID          string          `json:"id"`
+	ClusterTime time.Time       `json:"clusterTime"`
+	Document    json.RawMessage `json:"document,omitempty"`
+}
+
+// changeSchema is the json schema of the Change messages, registered in the schema registry.
+const changeSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "Change",
+  "type": "object",
+  "properties": {
+    "operation": {"type": "string", "enum": ["insert", "update", "replace", "delete"]},
+    "collection": {"type": "string"},
+    "id": {"type": "string"},
+    "clusterTime": {"type": "string", "format": "date-time"},
+    "document": {"type": "object"}
+  },
+  "required": ["operation", "collection", "id", "clusterTime"]
+}`
+
+// keySchema is the json schema of the message keys, the ids of the documents.
+const keySchema = `{"$schema": "http://json-schema.org/draft-07/schema#", "type": "string"}`
+
+// newChange creates the message of a change stream event.
+func newChange(e *changeEvent) (*Change, error) {
+	id, err := documentID(e.DocumentKey.ID)
+	if err != nil {
+		return nil, err
+	}
+	c := Change{
+		Operation:   e.OperationType,
+		Collection:  e.Ns.Coll,
+		ID:          id,
+		ClusterTime: time.Unix(int64(e.ClusterTime.T), 0).UTC(),
+	}
+	// the document of an updated document is not set if it was deleted meanwhile.
+	if e.OperationType == OperationDelete || len(e.FullDocument) == 0 {
+		return &c, nil
+	}
+	if c.Document, err = encodeDocument(e.FullDocument); err != nil {
+		return nil, fmt.Errorf("failed to encode document %s: %w", id, err)
+	}
+	return &c, nil
+}
+
+// documentID returns the id of a document as a string, the ids that are not strings
+// are encoded as relaxed extended json.
+func documentID(v bson.RawValue) (string, error) {
+	if id, ok := v.StringValueOK(); ok {
+		return id, nil
+	}
+	id, err := bson.MarshalExtJSON(bson.D{{Key: "_id", Value: v}}, false, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode document id: %w", err)
+	}
+	return string(id), nil
+}
+
+// encodeDocument encodes a document as relaxed extended json.
+//
+// The raw vaas are stored compressed, they are decompressed so they can be read without
+// knowing how they are stored.
+func encodeDocument(doc bson.Raw) (json.RawMessage, error) {
+	var d bson.D
+	if err := bson.Unmarshal(doc, &d); err != nil {
+		return nil, err
+	}
+	for i, e := range d {
+		v, ok := e.Value.(primitive.Binary)
+		if !ok || v.Subtype != repository.BinaryZstd {
+			continue
+		}
+		var raw repository.CompressedBytes
+		if err := doc.Lookup(e.Key).Unmarshal(&raw); err != nil {
+			return nil, err
+		}
+		d[i].Value = []byte(raw)
+	}
+	return bson.MarshalExtJSON(d, false, false)
+}
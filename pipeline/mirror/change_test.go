@@ -0,0 +1,74 @@
+package mirror
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/test-go/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newChangeEvent(t *testing.T, operation, collection string, id any, doc any) *changeEvent {
+	var raw bson.Raw
+	if doc != nil {
+		data, err := bson.Marshal(doc)
+		assert.NoError(t, err)
+		raw = data
+	}
+	_, key, err := bson.MarshalValue(id)
+	assert.NoError(t, err)
+	keyType, _, _ := bson.MarshalValue(id)
+
+	e := changeEvent{
+		OperationType: operation,
+		ClusterTime:   primitive.Timestamp{T: 1714559400},
+		FullDocument:  raw,
+	}
+	e.Ns.Coll = collection
+	e.DocumentKey.ID = bson.RawValue{Type: keyType, Value: key}
+	return &e
+}
+
+func TestNewChange_DecompressesVaas(t *testing.T) {
+	vaa := bytes.Repeat([]byte{0x01, 0x02, 0x03, 0x04}, 64)
+	doc := bson.D{
+		{Key: "_id", Value: "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1"},
+		{Key: "emitterChain", Value: 2},
+		{Key: "vaas", Value: repository.CompressedBytes(vaa)},
+	}
+
+	change, err := newChange(newChangeEvent(t, OperationInsert, "vaas", doc[0].Value, doc))
+	assert.NoError(t, err)
+	assert.Equal(t, OperationInsert, change.Operation)
+	assert.Equal(t, "vaas", change.Collection)
+	assert.Equal(t, doc[0].Value, change.ID)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC), change.ClusterTime)
+
+	var decoded bson.D
+	assert.NoError(t, bson.UnmarshalExtJSON(change.Document, false, &decoded))
+	assert.Equal(t, primitive.Binary{Subtype: 0x00, Data: vaa}, decoded.Map()["vaas"])
+}
+
+func TestNewChange_Delete(t *testing.T) {
+	change, err := newChange(newChangeEvent(t, OperationDelete, "globalTransactions", "1/abc/2", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, OperationDelete, change.Operation)
+	assert.Equal(t, "1/abc/2", change.ID)
+	assert.Nil(t, change.Document)
+
+	data, err := json.Marshal(change)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "document")
+}
+
+func TestNewChange_ObjectID(t *testing.T) {
+	id, _ := primitive.ObjectIDFromHex("6630d8a8f1a2b3c4d5e6f708")
+	change, err := newChange(newChangeEvent(t, OperationUpdate, "vaas", id, bson.D{{Key: "_id", Value: id}}))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"_id":{"$oid":"6630d8a8f1a2b3c4d5e6f708"}}`, change.ID)
+	assert.JSONEq(t, `{"_id":{"$oid":"6630d8a8f1a2b3c4d5e6f708"}}`, string(change.Document))
+}
@@ -0,0 +1,169 @@
+package mirror
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// retryDelay is the time waited to publish the changes again when kafka is not available.
+const retryDelay = 5 * time.Second
+
+// Mirror tails the change streams of some collections and publishes the changes to kafka,
+// the changes of each collection are published to the topic prefix followed by the collection name.
+//
+// The resume token of the last published change is saved after each batch, so the mirror
+// continues where it stopped when it is restarted. The changes are published at least once.
+type Mirror struct {
+	name          string
+	db            *mongo.Database
+	collections   []string
+	topicPrefix   string
+	batchSize     int
+	flushInterval time.Duration
+	producer      *Producer
+	repository    *Repository
+	logger        *zap.Logger
+
+	batch     []*Change
+	token     bson.Raw
+	lastFlush time.Time
+}
+
+// Config is the configuration of a Mirror.
+type Config struct {
+	// Name identifies the resume token of the mirror.
+	Name          string
+	Collections   []string
+	TopicPrefix   string
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+// New creates a new Mirror.
+func New(db *mongo.Database, cfg Config, producer *Producer, repository *Repository, logger *zap.Logger) *Mirror {
+	return &Mirror{
+		name:          cfg.Name,
+		db:            db,
+		collections:   cfg.Collections,
+		topicPrefix:   cfg.TopicPrefix,
+		batchSize:     cfg.BatchSize,
+		flushInterval: cfg.FlushInterval,
+		producer:      producer,
+		repository:    repository,
+		logger:        logger.With(zap.String("module", "Mirror")),
+	}
+}
+
+// Run publishes the changes until the context is cancelled or the change stream fails.
+func (m *Mirror) Run(ctx context.Context) error {
+	token, err := m.repository.GetResumeToken(ctx, m.name)
+	if err != nil {
+		return err
+	}
+
+	opts := options.ChangeStream().
+		SetFullDocument(options.UpdateLookup).
+		SetMaxAwaitTime(m.flushInterval)
+	if token != nil {
+		opts.SetResumeAfter(token)
+		m.logger.Info("Resuming change stream", zap.String("name", m.name))
+	}
+	stream, err := m.db.Watch(ctx, m.pipeline(), opts)
+	if err != nil {
+		return err
+	}
+	defer stream.Close(context.Background())
+
+	m.lastFlush = time.Now()
+	for {
+		if stream.TryNext(ctx) {
+			var e changeEvent
+			if err := stream.Decode(&e); err != nil {
+				return err
+			}
+			change, err := newChange(&e)
+			if err != nil {
+				// the change cannot be encoded again, it is skipped.
+				m.logger.Error("Error encoding change", zap.Error(err),
+					zap.String("collection", e.Ns.Coll),
+					zap.String("operation", e.OperationType))
+			} else {
+				m.batch = append(m.batch, change)
+			}
+			m.token = stream.ResumeToken()
+		} else if err := stream.Err(); err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if len(m.batch) >= m.batchSize || (m.token != nil && time.Since(m.lastFlush) >= m.flushInterval) {
+			// the flush only fails when the context is cancelled.
+			if err := m.flush(ctx); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// pipeline returns the change stream pipeline of the mirrored collections.
+func (m *Mirror) pipeline() mongo.Pipeline {
+	return mongo.Pipeline{{{Key: "$match", Value: bson.D{
+		{Key: "ns.db", Value: m.db.Name()},
+		{Key: "ns.coll", Value: bson.D{{Key: "$in", Value: m.collections}}},
+		{Key: "operationType", Value: bson.D{{Key: "$in", Value: bson.A{OperationInsert, OperationUpdate, OperationReplace, OperationDelete}}}},
+	}}}}
+}
+
+// flush publishes the batch and saves the resume token, the batch is retried until it is
+// published. It only returns an error when the context is cancelled.
+func (m *Mirror) flush(ctx context.Context) error {
+	for {
+		err := m.publish(ctx)
+		if err == nil {
+			break
+		}
+		m.logger.Error("Error publishing changes", zap.Error(err), zap.Int("changes", len(m.batch)))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryDelay):
+		}
+	}
+
+	// the token is saved on the next flush if it cannot be saved now.
+	if err := m.repository.SaveResumeToken(ctx, m.name, m.token); err != nil {
+		m.logger.Error("Error saving resume token", zap.Error(err))
+	} else {
+		m.token = nil
+	}
+	m.logger.Debug("Published changes", zap.Int("changes", len(m.batch)))
+	m.batch = m.batch[:0]
+	m.lastFlush = time.Now()
+	return nil
+}
+
+// publish publishes the batch to the topics of the collections, keeping the order of the changes of each collection.
+func (m *Mirror) publish(ctx context.Context) error {
+	var topics []string
+	records := make(map[string][]Record)
+	for _, c := range m.batch {
+		topic := m.topicPrefix + c.Collection
+		if _, ok := records[topic]; !ok {
+			topics = append(topics, topic)
+		}
+		records[topic] = append(records[topic], Record{Key: c.ID, Value: c})
+	}
+	for _, topic := range topics {
+		if err := m.producer.Produce(ctx, topic, records[topic]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
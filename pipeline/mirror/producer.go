@@ -0,0 +1,142 @@
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// content types of the kafka rest proxy v2 api.
+const (
+	contentTypeJSON       = "application/vnd.kafka.json.v2+json"
+	contentTypeJSONSchema = "application/vnd.kafka.jsonschema.v2+json"
+	contentTypeRegistry   = "application/vnd.schemaregistry.v1+json"
+)
+
+// Record is a message published to a kafka topic.
+type Record struct {
+	Key   string `json:"key"`
+	Value any    `json:"value"`
+}
+
+// Producer publishes records to kafka through a kafka rest proxy.
+//
+// When a schema registry is set, the schemas of the keys and the values are registered
+// for the subjects of the topics and the records are serialized by the proxy in the
+// schema registry wire format, otherwise the records are published as plain json.
+type Producer struct {
+	restURL     string
+	registryURL string
+	client      *http.Client
+
+	mu        sync.Mutex
+	schemaIDs map[string]int
+}
+
+// NewProducer creates a new Producer, the registry url is optional.
+func NewProducer(restURL, registryURL string, timeout time.Duration) *Producer {
+	return &Producer{
+		restURL:     strings.TrimSuffix(restURL, "/"),
+		registryURL: strings.TrimSuffix(registryURL, "/"),
+		client:      &http.Client{Timeout: timeout},
+		schemaIDs:   make(map[string]int),
+	}
+}
+
+type produceRequest struct {
+	KeySchemaID   int      `json:"key_schema_id,omitempty"`
+	ValueSchemaID int      `json:"value_schema_id,omitempty"`
+	Records       []Record `json:"records"`
+}
+
+type produceResponse struct {
+	Offsets []struct {
+		Partition *int    `json:"partition"`
+		Offset    *int64  `json:"offset"`
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+}
+
+// Produce publishes the records to a topic, the records are published in order.
+func (p *Producer) Produce(ctx context.Context, topic string, records []Record) error {
+	req := produceRequest{Records: records}
+	contentType := contentTypeJSON
+	if p.registryURL != "" {
+		var err error
+		if req.KeySchemaID, err = p.schemaID(ctx, topic+"-key", keySchema); err != nil {
+			return err
+		}
+		if req.ValueSchemaID, err = p.schemaID(ctx, topic+"-value", changeSchema); err != nil {
+			return err
+		}
+		contentType = contentTypeJSONSchema
+	}
+
+	var res produceResponse
+	endpoint := fmt.Sprintf("%s/topics/%s", p.restURL, url.PathEscape(topic))
+	if err := p.post(ctx, endpoint, contentType, req, &res); err != nil {
+		return fmt.Errorf("failed to produce records to topic %s: %w", topic, err)
+	}
+	for _, o := range res.Offsets {
+		if o.Error != nil {
+			return fmt.Errorf("failed to produce record to topic %s: %s", topic, *o.Error)
+		}
+	}
+	return nil
+}
+
+// schemaID registers a json schema for a subject and returns its id.
+// Registering a schema that is already registered returns the id of the registered schema.
+func (p *Producer) schemaID(ctx context.Context, subject, schema string) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if id, ok := p.schemaIDs[subject]; ok {
+		return id, nil
+	}
+
+	req := struct {
+		SchemaType string `json:"schemaType"`
+		Schema     string `json:"schema"`
+	}{SchemaType: "JSON", Schema: schema}
+	var res struct {
+		ID int `json:"id"`
+	}
+	endpoint := fmt.Sprintf("%s/subjects/%s/versions", p.registryURL, url.PathEscape(subject))
+	if err := p.post(ctx, endpoint, contentTypeRegistry, req, &res); err != nil {
+		return 0, fmt.Errorf("failed to register schema of subject %s: %w", subject, err)
+	}
+	p.schemaIDs[subject] = res.ID
+	return res.ID, nil
+}
+
+func (p *Producer) post(ctx context.Context, endpoint, contentType string, body, result any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", res.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(res.Body).Decode(result)
+}
@@ -0,0 +1,87 @@
+package mirror
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/test-go/testify/assert"
+)
+
+type proxyFixture struct {
+	mu           sync.Mutex
+	contentTypes []string
+	requests     []produceRequest
+	subjects     []string
+}
+
+func (f *proxyFixture) handler(t *testing.T, produceError string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/subjects/", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.subjects = append(f.subjects, r.URL.Path)
+		w.Write([]byte(`{"id": 7}`))
+	})
+	mux.HandleFunc("/topics/", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var req produceRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		f.requests = append(f.requests, req)
+		f.contentTypes = append(f.contentTypes, r.Header.Get("Content-Type"))
+		if produceError != "" {
+			w.Write([]byte(`{"offsets": [{"partition": null, "offset": null, "error_code": 50002, "error": "` + produceError + `"}]}`))
+			return
+		}
+		w.Write([]byte(`{"offsets": [{"partition": 0, "offset": 10, "error_code": null, "error": null}]}`))
+	})
+	return mux
+}
+
+func TestProducer_JSON(t *testing.T) {
+	var f proxyFixture
+	server := httptest.NewServer(f.handler(t, ""))
+	defer server.Close()
+
+	p := NewProducer(server.URL, "", time.Second)
+	err := p.Produce(context.Background(), "wormscan.vaas", []Record{{Key: "1/abc/1", Value: &Change{ID: "1/abc/1"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{contentTypeJSON}, f.contentTypes)
+	assert.Empty(t, f.subjects)
+	assert.Zero(t, f.requests[0].ValueSchemaID)
+	assert.Len(t, f.requests[0].Records, 1)
+}
+
+func TestProducer_SchemaRegistry(t *testing.T) {
+	var f proxyFixture
+	server := httptest.NewServer(f.handler(t, ""))
+	defer server.Close()
+
+	p := NewProducer(server.URL, server.URL+"/", time.Second)
+	for i := 0; i < 2; i++ {
+		err := p.Produce(context.Background(), "wormscan.vaas", []Record{{Key: "1/abc/1", Value: &Change{ID: "1/abc/1"}}})
+		assert.NoError(t, err)
+	}
+	// the schemas are registered once.
+	assert.Equal(t, []string{"/subjects/wormscan.vaas-key/versions", "/subjects/wormscan.vaas-value/versions"}, f.subjects)
+	assert.Equal(t, []string{contentTypeJSONSchema, contentTypeJSONSchema}, f.contentTypes)
+	assert.Equal(t, 7, f.requests[1].KeySchemaID)
+	assert.Equal(t, 7, f.requests[1].ValueSchemaID)
+}
+
+func TestProducer_RecordError(t *testing.T) {
+	var f proxyFixture
+	server := httptest.NewServer(f.handler(t, "broker not available"))
+	defer server.Close()
+
+	p := NewProducer(server.URL, "", time.Second)
+	err := p.Produce(context.Background(), "wormscan.vaas", []Record{{Key: "1/abc/1", Value: &Change{ID: "1/abc/1"}}})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "broker not available")
+	}
+}
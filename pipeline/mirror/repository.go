@@ -0,0 +1,52 @@
+package mirror
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// ResumeTokenCollection contains the resume tokens of the change streams mirrored to kafka.
+const ResumeTokenCollection = "mirrorResumeTokens"
+
+// resumeTokenDoc is the resume token of the last change published by a mirror.
+type resumeTokenDoc struct {
+	ID        string    `bson:"_id"`
+	Token     bson.Raw  `bson:"token"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+// Repository stores the resume tokens of the change streams.
+type Repository struct {
+	collection *mongo.Collection
+	logger     *zap.Logger
+}
+
+// NewRepository creates a new repository.
+func NewRepository(db *mongo.Database, logger *zap.Logger) *Repository {
+	return &Repository{collection: db.Collection(ResumeTokenCollection), logger: logger}
+}
+
+// GetResumeToken returns the resume token of a mirror, or nil if it was never saved.
+func (r *Repository) GetResumeToken(ctx context.Context, name string) (bson.Raw, error) {
+	var doc resumeTokenDoc
+	err := r.collection.FindOne(ctx, bson.M{"_id": name}).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc.Token, nil
+}
+
+// SaveResumeToken saves the resume token of a mirror.
+func (r *Repository) SaveResumeToken(ctx context.Context, name string, token bson.Raw) error {
+	doc := resumeTokenDoc{ID: name, Token: token, UpdatedAt: time.Now()}
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": name}, doc, options.Replace().SetUpsert(true))
+	return err
+}
@@ -0,0 +1,16 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"go.uber.org/zap"
+)
+
+// invalidateVaaCache deletes the vaa document cached by the api,
+// so the next request reads the updated document from the database.
+func invalidateVaaCache(ctx context.Context, vaaCache cache.CacheWriteable, vaaID string, logger *zap.Logger) {
+	if err := vaaCache.Delete(ctx, cache.VaaKey(vaaID)); err != nil {
+		logger.Error("can not invalidate cached vaa", zap.Error(err), zap.String("vaaID", vaaID))
+	}
+}
@@ -2,7 +2,10 @@ package pipeline
 
 import (
 	"context"
+	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/topic"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/watcher"
@@ -17,17 +20,19 @@ type Publisher struct {
 	repository    *Repository
 	p2pNetwork    string
 	txHashHandler *TxHashHandler
+	vaaCache      cache.CacheWriteable
 	metrics       metrics.Metrics
 }
 
 // NewPublisher creates a new publisher for vaa with parse configuration.
-func NewPublisher(pushFunc topic.PushFunc, metrics metrics.Metrics, repository *Repository, p2pNetwork string, txHashHandler *TxHashHandler, logger *zap.Logger) *Publisher {
+func NewPublisher(pushFunc topic.PushFunc, metrics metrics.Metrics, repository *Repository, p2pNetwork string, txHashHandler *TxHashHandler, vaaCache cache.CacheWriteable, logger *zap.Logger) *Publisher {
 	return &Publisher{
 		logger:        logger,
 		repository:    repository,
 		pushFunc:      pushFunc,
 		p2pNetwork:    p2pNetwork,
 		txHashHandler: txHashHandler,
+		vaaCache:      vaaCache,
 		metrics:       metrics,
 	}
 }
@@ -51,6 +56,15 @@ func (p *Publisher) Publish(ctx context.Context, e *watcher.Event) {
 		Revision:         e.Revision,
 		Digest:           e.Digest,
 		Overwrite:        e.DuplicatedFixed,
+		Watermarks:       watermarks(e, time.Now()),
+	}
+	if latency, ok := event.Watermarks.StageLatency(events.StagePipeline); ok {
+		p.metrics.VaaStageLatency(events.StagePipeline, latency)
+	}
+
+	// A fixed duplicated vaa overwrites the document that the api may have cached.
+	if e.DuplicatedFixed {
+		invalidateVaaCache(ctx, p.vaaCache, e.ID, p.logger)
 	}
 
 	// In some scenarios the fly component that inserts the VAA documents does not have the txhash field available,
@@ -77,3 +91,13 @@ func (p *Publisher) Publish(ctx context.Context, e *watcher.Event) {
 		p.logger.Error("can not push event to topic", zap.Error(err), zap.String("event", event.ID))
 	}
 }
+
+// watermarks stamps the time at which fly stored the vaa and the time at which the pipeline received it.
+func watermarks(e *watcher.Event, now time.Time) events.Watermarks {
+	flyTimestamp := e.IndexedAt
+	if e.UpdatedAt != nil {
+		flyTimestamp = *e.UpdatedAt
+	}
+	var w events.Watermarks
+	return w.Stamp(events.StageFly, flyTimestamp).Stamp(events.StagePipeline, now)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/test-go/testify/assert"
 	"github.com/test-go/testify/require"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/pipeline"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/pipeline/mocks"
@@ -35,7 +36,7 @@ func TestNewTxHashHandler(t *testing.T) {
 		return nil
 	})
 
-	txHashHandler := pipeline.NewTxHashHandler(repo, f, alert.NewDummyClient(), metrics.NewDummyMetrics(), observedLogger, quit)
+	txHashHandler := pipeline.NewTxHashHandler(repo, f, alert.NewDummyClient(), cache.NewDummyCacheClient(), metrics.NewDummyMetrics(), observedLogger, quit)
 	txHashHandler.AddVaaFixItem(topic.Event{
 		ID: "vaa1",
 	},
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	pipelineAlert "github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
@@ -28,12 +29,15 @@ type TxHashHandler struct {
 	sleepTime      time.Duration
 	pushFunc       topic.PushFunc
 	alertClient    alert.AlertClient
+	vaaCache       cache.CacheWriteable
 	metrics        metrics.Metrics
 	defaultRetries int
 }
 
 // NewTxHashHandler creates a new TxHashHandler.
-func NewTxHashHandler(repository IRepository, pushFunc topic.PushFunc, alertClient alert.AlertClient, metrics metrics.Metrics, logger *zap.Logger, quit chan bool) *TxHashHandler {
+//
+// The vaas cached by the api are deleted from [vaaCache] when their txhash is fixed.
+func NewTxHashHandler(repository IRepository, pushFunc topic.PushFunc, alertClient alert.AlertClient, vaaCache cache.CacheWriteable, metrics metrics.Metrics, logger *zap.Logger, quit chan bool) *TxHashHandler {
 	return &TxHashHandler{
 		logger:         logger,
 		repository:     repository,
@@ -42,6 +46,7 @@ func NewTxHashHandler(repository IRepository, pushFunc topic.PushFunc, alertClie
 		sleepTime:      2 * time.Second,
 		pushFunc:       pushFunc,
 		alertClient:    alertClient,
+		vaaCache:       vaaCache,
 		metrics:        metrics,
 		defaultRetries: 3,
 	}
@@ -83,6 +88,7 @@ func (t *TxHashHandler) Run(ctx context.Context) {
 					} else {
 						t.logger.Info("Vaa txhash fixed", zap.String("vaaID", vaaID), zap.String("txHash", txHash))
 						item.Event.TxHash = txHash
+						invalidateVaaCache(ctx, t.vaaCache, vaaID, t.logger)
 						t.pushFunc(ctx, &item.Event)
 						delete(t.fixItems, vaaID)
 						// increment metrics vaa with txhash fixed
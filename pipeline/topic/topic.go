@@ -3,6 +3,8 @@ package topic
 import (
 	"context"
 	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
 )
 
 // Event represents a vaa data to be handle by the pipeline.
@@ -21,6 +23,9 @@ type Event struct {
 	Revision         uint16     `json:"revision"`
 	Digest           string     `json:"digest"`
 	Overwrite        bool       `json:"overwrite"`
+	Replay           bool       `json:"replay"`
+	// Watermarks contains the time at which the vaa was ingested by each stage of the pipeline.
+	Watermarks events.Watermarks `json:"watermarks,omitempty"`
 }
 
 // PushFunc is a function to push VAAEvent.
@@ -3,26 +3,32 @@ package topic
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	pipelineAlert "github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/sns"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
 // SQS represents a VAA queue in SNS.
 type SNS struct {
 	producer    *sns.Producer
+	p2pNetwork  string
 	alertClient alert.AlertClient
 	metrics     metrics.Metrics
 	logger      *zap.Logger
 }
 
 // NewVAASNS creates a VAA topic in SNS instances.
-func NewVAASNS(producer *sns.Producer, alertClient alert.AlertClient, metrics metrics.Metrics, logger *zap.Logger) *SNS {
+func NewVAASNS(producer *sns.Producer, p2pNetwork string, alertClient alert.AlertClient, metrics metrics.Metrics, logger *zap.Logger) *SNS {
 	s := &SNS{
 		producer:    producer,
+		p2pNetwork:  p2pNetwork,
 		alertClient: alertClient,
 		metrics:     metrics,
 		logger:      logger,
@@ -37,8 +43,15 @@ func (s *SNS) Publish(ctx context.Context, message *Event) error {
 		return err
 	}
 
-	s.logger.Debug("Publishing message", zap.String("groupID", message.ID))
-	err = s.producer.SendMessage(ctx, message.ChainID, message.ID, message.ID, string(body))
+	// replayed messages use a new deduplication id, otherwise they are discarded by the fifo topic
+	// when the vaa was published in the deduplication interval.
+	deduplicationID := message.ID
+	if message.Replay {
+		deduplicationID = fmt.Sprintf("%s/replay/%d", message.ID, time.Now().UnixNano())
+	}
+
+	s.logger.Debug("Publishing message", zap.String("groupID", message.ID), zap.Bool("replay", message.Replay))
+	err = s.producer.SendMessage(ctx, message.ID, deduplicationID, string(body), s.attributes(message))
 	if err == nil {
 		s.metrics.IncVaaSendNotification(message.ChainID)
 	} else {
@@ -54,3 +67,19 @@ func (s *SNS) Publish(ctx context.Context, message *Event) error {
 	}
 	return err
 }
+
+// attributes returns the message attributes used by the subscriptions to filter the events,
+// and by the consumers shared by several networks to route the events.
+// If the event is a replay the replay attribute is added so the consumers can skip side effects.
+func (s *SNS) attributes(message *Event) map[string]string {
+	attrs := map[string]string{
+		"chainId":        fmt.Sprintf("%d", message.ChainID),
+		"emitterAddress": message.EmitterAddress,
+		"appId":          domain.GetAppIDByEmitter(s.p2pNetwork, sdk.ChainID(message.ChainID), message.EmitterAddress),
+		"network":        s.p2pNetwork,
+	}
+	if message.Replay {
+		attrs["replay"] = "true"
+	}
+	return attrs
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	pipelineAlert "github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/pipeline/internal/metrics"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -38,21 +39,21 @@ type documentKey struct {
 
 // Event represents a database change.
 type Event struct {
-	ID               string     `bson:"_id"`
-	ChainID          uint16     `bson:"emitterChain"`
-	EmitterAddress   string     `bson:"emitterAddr"`
-	Sequence         string     `bson:"sequence"`
-	GuardianSetIndex uint32     `bson:"guardianSetIndex"`
-	Vaa              []byte     `bson:"vaas"`
-	IndexedAt        time.Time  `bson:"indexedAt"`
-	Timestamp        *time.Time `bson:"timestamp"`
-	UpdatedAt        *time.Time `bson:"updatedAt"`
-	TxHash           string     `bson:"txHash"`
-	Version          uint16     `bson:"version"`
-	Revision         uint16     `bson:"revision"`
-	Digest           string     `bson:"digest"`
-	IsDuplicated     bool       `bson:"isDuplicated"`
-	DuplicatedFixed  bool       `bson:"duplicatedFixed"`
+	ID               string                     `bson:"_id"`
+	ChainID          uint16                     `bson:"emitterChain"`
+	EmitterAddress   string                     `bson:"emitterAddr"`
+	Sequence         string                     `bson:"sequence"`
+	GuardianSetIndex uint32                     `bson:"guardianSetIndex"`
+	Vaa              repository.CompressedBytes `bson:"vaas"`
+	IndexedAt        time.Time                  `bson:"indexedAt"`
+	Timestamp        *time.Time                 `bson:"timestamp"`
+	UpdatedAt        *time.Time                 `bson:"updatedAt"`
+	TxHash           string                     `bson:"txHash"`
+	Version          uint16                     `bson:"version"`
+	Revision         uint16                     `bson:"revision"`
+	Digest           string                     `bson:"digest"`
+	IsDuplicated     bool                       `bson:"isDuplicated"`
+	DuplicatedFixed  bool                       `bson:"duplicatedFixed"`
 }
 
 const queryTemplate = `
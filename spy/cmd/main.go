@@ -14,6 +14,7 @@ import (
 	"github.com/wormhole-foundation/wormhole-explorer/spy/config"
 	"github.com/wormhole-foundation/wormhole-explorer/spy/grpc"
 	"github.com/wormhole-foundation/wormhole-explorer/spy/http/infraestructure"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/notification"
 	"github.com/wormhole-foundation/wormhole-explorer/spy/source"
 	"go.uber.org/zap"
 )
@@ -55,9 +56,12 @@ func main() {
 
 	logger.Info("Starting wormhole-explorer-spy ...")
 
-	svs := grpc.NewSignedVaaSubscribers(logger)
+	svs := grpc.NewSignedVaaSubscribers(logger, grpc.WithHistorySize(config.SubscriptionHistorySize))
 	go svs.Start(rootCtx)
 
+	notifications := notification.NewSubscribers(logger)
+	go notifications.Start(rootCtx)
+
 	handler := grpc.NewHandler(svs, logger)
 
 	grpcServer, err := grpc.NewServer(handler, logger, config.GrpcAddress)
@@ -80,7 +84,7 @@ func main() {
 
 	client := redis.NewClient(&redis.Options{Addr: config.RedisURI})
 
-	watcher, err := source.NewRedisSubscriber(rootCtx, client, config.RedisPrefix, config.RedisChannel, publisher.Publish, logger)
+	watcher, err := source.NewRedisSubscriber(rootCtx, client, config.RedisPrefix, config.RedisChannel, publisher.Publish, notifications.HandleNotification, logger)
 	if err != nil {
 		logger.Fatal("failed to create redis subscriber", zap.Error(err))
 	}
@@ -96,7 +100,7 @@ func main() {
 		logger.Fatal("failed to create health checks", zap.Error(err))
 	}
 
-	server := infraestructure.NewServer(logger, config.Port, config.PprofEnabled, healthChecks...)
+	server := infraestructure.NewServer(logger, config.Port, config.PprofEnabled, svs, notifications, healthChecks...)
 	server.Start()
 
 	logger.Info("Started wormhole-explorer-spy")
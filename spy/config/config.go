@@ -17,6 +17,8 @@ type Configuration struct {
 	RedisPrefix  string `env:"REDIS_PREFIX,required"`
 	RedisChannel string `env:"REDIS_VAA_CHANNEL,required"`
 	PprofEnabled bool   `env:"PPROF_ENABLED,default=false"`
+	// SubscriptionHistorySize is the number of VAAs kept to resume the subscriptions from a cursor.
+	SubscriptionHistorySize int `env:"SUBSCRIPTION_HISTORY_SIZE,default=10000"`
 }
 
 // New creates a configuration with the values from .env file and environment variables.
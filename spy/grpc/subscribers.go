@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -9,41 +10,133 @@ import (
 )
 
 type message struct {
+	offset   uint64
 	vaaBytes []byte
 }
 
+// Offset returns the position of the VAA in the stream of the spy, it is used as cursor to resume a subscription.
+func (m message) Offset() uint64 {
+	return m.offset
+}
+
+// VaaBytes returns the signed VAA.
+func (m message) VaaBytes() []byte {
+	return m.vaaBytes
+}
+
+// filterSignedVaa matches the VAAs of an emitter. An unset chain or a zero emitter address match any chain or emitter,
+// and the payload type, when it is set, must match the first byte of the payload.
 type filterSignedVaa struct {
 	chainId     vaa.ChainID
 	emitterAddr vaa.Address
+	payloadType *uint8
 }
+
+func (f *filterSignedVaa) match(v *vaa.VAA) bool {
+	if f.chainId != vaa.ChainIDUnset && f.chainId != v.EmitterChain {
+		return false
+	}
+	if f.emitterAddr != (vaa.Address{}) && f.emitterAddr != v.EmitterAddress {
+		return false
+	}
+	if f.payloadType != nil && (len(v.Payload) == 0 || v.Payload[0] != *f.payloadType) {
+		return false
+	}
+	return true
+}
+
+// SignedVaaFilter represents a filter of a subscription, the unset fields match any value.
+type SignedVaaFilter struct {
+	ChainID        vaa.ChainID
+	EmitterAddress *vaa.Address
+	PayloadType    *uint8
+}
+
 type subscriptionSignedVaa struct {
 	id      string
 	filters []filterSignedVaa
 	ch      chan message
+	// cursor is the offset of the last VAA received by the subscriber, the following VAAs are replayed on register.
+	cursor *uint64
+}
+
+// Messages returns the channel of the VAAs that match the filters of the subscription.
+func (s *subscriptionSignedVaa) Messages() <-chan message {
+	return s.ch
+}
+
+func (s *subscriptionSignedVaa) match(v *vaa.VAA) bool {
+	for _, fi := range s.filters {
+		if fi.match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// send sends a message without blocking, the message is discarded when the subscriber is slow.
+func (s *subscriptionSignedVaa) send(msg message) {
+	select {
+	case s.ch <- msg:
+	default:
+	}
+}
+
+// historyEntry is a VAA kept to replay it to the subscribers that resume from a cursor.
+type historyEntry struct {
+	msg message
+	vaa *vaa.VAA
 }
 
 func subscriptionId() string {
 	return uuid.New().String()
 }
 
+// defaultHistorySize is the default number of VAAs kept to resume subscriptions.
+const defaultHistorySize = 10000
+
+// resumeBufferSize is the size of the channel of a subscription that resumes from a cursor.
+const resumeBufferSize = 1024
+
+// SignedVaaSubscribersOption represents a signed VAA subscribers option function.
+type SignedVaaSubscribersOption func(*SignedVaaSubscribers)
+
+// WithHistorySize sets the number of VAAs kept to resume subscriptions.
+func WithHistorySize(size int) SignedVaaSubscribersOption {
+	return func(s *SignedVaaSubscribers) {
+		s.historySize = size
+	}
+}
+
 // SignedVaaSubscribers represents signed VAA subscribers.
 type SignedVaaSubscribers struct {
 	source           chan []byte
 	subscribers      map[string]*subscriptionSignedVaa
 	addSubscriber    chan *subscriptionSignedVaa
 	removeSubscriber chan *subscriptionSignedVaa
+	offset           uint64
+	history          []historyEntry
+	historyStart     int
+	historySize      int
 	logger           *zap.Logger
 }
 
 // NewSignedVaaSubscribers creates a signed VAA subscribers.
-func NewSignedVaaSubscribers(logger *zap.Logger) *SignedVaaSubscribers {
-	return &SignedVaaSubscribers{
+func NewSignedVaaSubscribers(logger *zap.Logger, opts ...SignedVaaSubscribersOption) *SignedVaaSubscribers {
+	s := &SignedVaaSubscribers{
 		subscribers:      make(map[string]*subscriptionSignedVaa),
 		addSubscriber:    make(chan *subscriptionSignedVaa, 1),
 		removeSubscriber: make(chan *subscriptionSignedVaa, 1),
 		source:           make(chan []byte, 1),
+		historySize:      defaultHistorySize,
 		logger:           logger,
 	}
+	// the offsets start from the current time so the cursors of a previous execution are older than the new VAAs.
+	s.offset = uint64(time.Now().UnixMicro())
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Register registers a new subscriber with a list of filters.
@@ -58,6 +151,28 @@ func (s *SignedVaaSubscribers) Register(fi []filterSignedVaa) *subscriptionSigne
 	return sub
 }
 
+// RegisterFrom registers a new subscriber with a list of filters. If the cursor is not nil,
+// the VAAs received by the spy after the cursor that are still kept in the history are replayed.
+func (s *SignedVaaSubscribers) RegisterFrom(filters []SignedVaaFilter, cursor *uint64) *subscriptionSignedVaa {
+	fi := make([]filterSignedVaa, 0, len(filters))
+	for _, f := range filters {
+		filter := filterSignedVaa{chainId: f.ChainID, payloadType: f.PayloadType}
+		if f.EmitterAddress != nil {
+			filter.emitterAddr = *f.EmitterAddress
+		}
+		fi = append(fi, filter)
+	}
+	sub := &subscriptionSignedVaa{
+		id:      subscriptionId(),
+		ch:      make(chan message, resumeBufferSize),
+		filters: fi,
+		cursor:  cursor,
+	}
+	s.logger.Info("Registering subscriber in signed VAAs ...", zap.String("id", sub.id))
+	s.addSubscriber <- sub
+	return sub
+}
+
 // Unregister removes a subscriber.
 func (s *SignedVaaSubscribers) Unregister(sub *subscriptionSignedVaa) {
 	s.logger.Info("Unregistering subscriber in signed VAAs ...", zap.String("id", sub.id))
@@ -86,6 +201,7 @@ func (s *SignedVaaSubscribers) Start(ctx context.Context) {
 		case newSubscriber := <-s.addSubscriber:
 			s.subscribers[newSubscriber.id] = newSubscriber
 			s.logger.Info("New subscriber registered in signed VAAs", zap.String("id", newSubscriber.id))
+			s.replay(newSubscriber)
 		case subscriberToRemove := <-s.removeSubscriber:
 			if subscriber, exists := s.subscribers[subscriberToRemove.id]; exists {
 				close(subscriber.ch)
@@ -96,36 +212,61 @@ func (s *SignedVaaSubscribers) Start(ctx context.Context) {
 			if !ok {
 				break
 			}
-			var v *vaa.VAA
+			s.offset++
+			msg := message{offset: s.offset, vaaBytes: vaas}
+
+			v, err := vaa.Unmarshal(vaas)
+			if err != nil {
+				s.logger.Error("Unmarshal vaa in signed VAAs", zap.Error(err))
+				v = nil
+			}
+			s.addHistory(historyEntry{msg: msg, vaa: v})
 
 			for _, sub := range s.subscribers {
 				if len(sub.filters) == 0 {
-					select {
-					case sub.ch <- message{vaaBytes: vaas}:
-					default:
-					}
+					sub.send(msg)
 					continue
 				}
-
-				if v == nil {
-					var err error
-					v, err = vaa.Unmarshal(vaas)
-					if err != nil {
-						s.logger.Error("Unmarshal vaa in signed VAAs", zap.Error(err))
-						break
-					}
+				if v != nil && sub.match(v) {
+					sub.send(msg)
 				}
+			}
+		}
+	}
+}
 
-				for _, fi := range sub.filters {
-					if fi.chainId == v.EmitterChain && fi.emitterAddr == v.EmitterAddress {
-						select {
-						case sub.ch <- message{vaaBytes: vaas}:
-						default:
-						}
-					}
-				}
+// addHistory adds a VAA to the history, when the history is full the oldest VAA is replaced.
+func (s *SignedVaaSubscribers) addHistory(e historyEntry) {
+	if s.historySize <= 0 {
+		return
+	}
+	if len(s.history) < s.historySize {
+		s.history = append(s.history, e)
+		return
+	}
+	s.history[s.historyStart] = e
+	s.historyStart = (s.historyStart + 1) % len(s.history)
+}
 
-			}
+// replay sends the VAAs of the history after the cursor of the subscriber.
+func (s *SignedVaaSubscribers) replay(sub *subscriptionSignedVaa) {
+	if sub.cursor == nil {
+		return
+	}
+	if len(s.history) == 0 {
+		return
+	}
+	if oldest := s.history[s.historyStart].msg.offset; oldest > *sub.cursor+1 {
+		s.logger.Warn("Subscriber cursor is older than the history, some VAAs can't be replayed",
+			zap.String("id", sub.id), zap.Uint64("cursor", *sub.cursor), zap.Uint64("oldest", oldest))
+	}
+	for i := range s.history {
+		e := s.history[(s.historyStart+i)%len(s.history)]
+		if e.msg.offset <= *sub.cursor {
+			continue
+		}
+		if len(sub.filters) == 0 || (e.vaa != nil && sub.match(e.vaa)) {
+			sub.send(e.msg)
 		}
 	}
 }
@@ -97,3 +97,42 @@ func TestSignedVaaSubscribers_HandleVAA(t *testing.T) {
 		assert.Equal(t, 0, len(sub.ch))
 	})
 }
+
+func TestFilterSignedVaa_Match(t *testing.T) {
+	v := createVAA(vaa.ChainIDEthereum, emitterAddr)
+	payloadType := uint8(97)
+	otherPayloadType := uint8(1)
+
+	assert.True(t, (&filterSignedVaa{}).match(v))
+	assert.True(t, (&filterSignedVaa{chainId: vaa.ChainIDEthereum}).match(v))
+	assert.True(t, (&filterSignedVaa{emitterAddr: emitterAddr, payloadType: &payloadType}).match(v))
+	assert.False(t, (&filterSignedVaa{chainId: vaa.ChainIDSolana}).match(v))
+	assert.False(t, (&filterSignedVaa{chainId: vaa.ChainIDEthereum, payloadType: &otherPayloadType}).match(v))
+}
+
+func TestSignedVaaSubscribers_Replay(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	svs := NewSignedVaaSubscribers(logger, WithHistorySize(2))
+
+	ethVaa := createVAA(vaa.ChainIDEthereum, emitterAddr)
+	solVaa := createVAA(vaa.ChainIDSolana, emitterAddr)
+	for i, v := range []*vaa.VAA{ethVaa, solVaa, ethVaa} {
+		vaaBytes, _ := v.MarshalBinary()
+		svs.addHistory(historyEntry{msg: message{offset: uint64(i + 1), vaaBytes: vaaBytes}, vaa: v})
+	}
+
+	// the first vaa was replaced in the history.
+	cursor := uint64(0)
+	sub := svs.RegisterFrom([]SignedVaaFilter{{ChainID: vaa.ChainIDEthereum}}, &cursor)
+	<-svs.addSubscriber
+	svs.replay(sub)
+	assert.Equal(t, 1, len(sub.ch))
+	msg := <-sub.Messages()
+	assert.Equal(t, uint64(3), msg.Offset())
+
+	cursor = 2
+	sub = svs.RegisterFrom(nil, &cursor)
+	<-svs.addSubscriber
+	svs.replay(sub)
+	assert.Equal(t, 1, len(sub.ch))
+}
@@ -4,6 +4,9 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/pprof"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/grpc"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/http/subscription"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/notification"
 	"go.uber.org/zap"
 )
 
@@ -13,8 +16,9 @@ type Server struct {
 	logger *zap.Logger
 }
 
-func NewServer(logger *zap.Logger, port string, pprofEnabled bool, checks ...health.Check) *Server {
+func NewServer(logger *zap.Logger, port string, pprofEnabled bool, svs *grpc.SignedVaaSubscribers, notifications *notification.Subscribers, checks ...health.Check) *Server {
 	ctrl := NewController(checks, logger)
+	subscriptionCtrl := subscription.NewController(svs, notifications, logger)
 	app := fiber.New(fiber.Config{DisableStartupMessage: true})
 	if pprofEnabled {
 		app.Use(pprof.New())
@@ -23,6 +27,8 @@ func NewServer(logger *zap.Logger, port string, pprofEnabled bool, checks ...hea
 	api := app.Group("/api")
 	api.Get("/health", ctrl.HealthCheck)
 	api.Get("/ready", ctrl.ReadyCheck)
+	api.Get("/v1/signed-vaas/subscribe", subscriptionCtrl.SubscribeSignedVaas)
+	api.Get("/v1/notifications/subscribe", subscriptionCtrl.SubscribeNotifications)
 	return &Server{
 		app:    app,
 		port:   port,
@@ -0,0 +1,172 @@
+package subscription
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/grpc"
+	"github.com/wormhole-foundation/wormhole-explorer/spy/notification"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// keepAliveInterval is the interval of the comments sent to keep the connection open and detect disconnects.
+const keepAliveInterval = 15 * time.Second
+
+// Controller definition.
+type Controller struct {
+	svs           *grpc.SignedVaaSubscribers
+	notifications *notification.Subscribers
+	logger        *zap.Logger
+}
+
+// NewController creates a Controller instance.
+func NewController(svs *grpc.SignedVaaSubscribers, notifications *notification.Subscribers, logger *zap.Logger) *Controller {
+	return &Controller{svs: svs, notifications: notifications, logger: logger}
+}
+
+// SubscribeSignedVaas handler for the endpoint /api/v1/signed-vaas/subscribe.
+// The signed VAAs that match any of the filters are streamed as server-sent events, the id of each
+// event is the cursor to resume the subscription with the Last-Event-ID header or the cursor query param.
+// Each filter has the format chainId/emitterAddress/payloadType, the empty parts match any value.
+func (c *Controller) SubscribeSignedVaas(ctx *fiber.Ctx) error {
+	var filters []grpc.SignedVaaFilter
+	for _, value := range ctx.Context().QueryArgs().PeekMulti("filter") {
+		filter, err := parseFilter(string(value))
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(struct {
+				Error string `json:"error"`
+			}{Error: err.Error()})
+		}
+		filters = append(filters, *filter)
+	}
+
+	cursor, err := parseCursor(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(struct {
+			Error string `json:"error"`
+		}{Error: err.Error()})
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+
+	subscriber := c.svs.RegisterFrom(filters, cursor)
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer c.svs.Unregister(subscriber)
+		ticker := time.NewTicker(keepAliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case msg, ok := <-subscriber.Messages():
+				if !ok {
+					return
+				}
+				fmt.Fprintf(w, "id: %d\nevent: vaa\ndata: %s\n\n", msg.Offset(), base64.StdEncoding.EncodeToString(msg.VaaBytes()))
+			case <-ticker.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+			}
+			if err := w.Flush(); err != nil {
+				c.logger.Info("Subscriber disconnected", zap.Error(err))
+				return
+			}
+		}
+	})
+	return nil
+}
+
+// SubscribeNotifications handler for the endpoint /api/v1/notifications/subscribe.
+// The governor enqueue/release events and the guardian heartbeat gaps are streamed as server-sent events,
+// the name of each event is its type and the data is the json of the event. The event query param
+// selects the types of events, all the types are streamed when it is missing.
+func (c *Controller) SubscribeNotifications(ctx *fiber.Ctx) error {
+	var eventTypes []string
+	for _, value := range ctx.Context().QueryArgs().PeekMulti("event") {
+		eventType := string(value)
+		if !notification.IsType(eventType) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(struct {
+				Error string `json:"error"`
+			}{Error: fmt.Sprintf("invalid event %s, valid events are %s", eventType, strings.Join(notification.Types, ", "))})
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+
+	subscriber := c.notifications.Register(eventTypes)
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer c.notifications.Unregister(subscriber)
+		ticker := time.NewTicker(keepAliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case e, ok := <-subscriber.Messages():
+				if !ok {
+					return
+				}
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Event, e.Data)
+			case <-ticker.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+			}
+			if err := w.Flush(); err != nil {
+				c.logger.Info("Subscriber disconnected", zap.Error(err))
+				return
+			}
+		}
+	})
+	return nil
+}
+
+func parseFilter(value string) (*grpc.SignedVaaFilter, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid filter %s", value)
+	}
+	var filter grpc.SignedVaaFilter
+	if parts[0] != "" {
+		chainID, err := strconv.ParseUint(parts[0], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain id in filter %s", value)
+		}
+		filter.ChainID = vaa.ChainID(chainID)
+	}
+	if len(parts) > 1 && parts[1] != "" {
+		addr, err := vaa.StringToAddress(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid emitter address in filter %s", value)
+		}
+		filter.EmitterAddress = &addr
+	}
+	if len(parts) > 2 && parts[2] != "" {
+		payloadType, err := strconv.ParseUint(parts[2], 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload type in filter %s", value)
+		}
+		pt := uint8(payloadType)
+		filter.PayloadType = &pt
+	}
+	return &filter, nil
+}
+
+func parseCursor(ctx *fiber.Ctx) (*uint64, error) {
+	value := ctx.Query("cursor")
+	if value == "" {
+		value = ctx.Get("Last-Event-ID")
+	}
+	if value == "" {
+		return nil, nil
+	}
+	cursor, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %s", value)
+	}
+	return &cursor, nil
+}
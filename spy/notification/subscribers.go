@@ -0,0 +1,134 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"go.uber.org/zap"
+)
+
+// Types are the notification events that can be subscribed.
+var Types = []string{
+	events.GovernorVaaEnqueuedType,
+	events.GovernorVaaReleasedType,
+	events.HeartbeatGapType,
+}
+
+// IsType returns true if the event type can be subscribed.
+func IsType(eventType string) bool {
+	for _, t := range Types {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferSize is the size of the channel of a subscription.
+const bufferSize = 64
+
+type subscription struct {
+	id string
+	// eventTypes are the event types of the subscription, an empty set matches all the types.
+	eventTypes map[string]struct{}
+	ch         chan *events.NotificationEvent
+}
+
+// Messages returns the channel of the notification events of the subscription.
+func (s *subscription) Messages() <-chan *events.NotificationEvent {
+	return s.ch
+}
+
+func (s *subscription) match(e *events.NotificationEvent) bool {
+	if len(s.eventTypes) == 0 {
+		return true
+	}
+	_, ok := s.eventTypes[e.Event]
+	return ok
+}
+
+// send sends an event without blocking, the event is discarded when the subscriber is slow.
+func (s *subscription) send(e *events.NotificationEvent) {
+	select {
+	case s.ch <- e:
+	default:
+	}
+}
+
+// Subscribers represents the subscribers of the governor and heartbeat notification events.
+type Subscribers struct {
+	source           chan *events.NotificationEvent
+	subscribers      map[string]*subscription
+	addSubscriber    chan *subscription
+	removeSubscriber chan *subscription
+	logger           *zap.Logger
+}
+
+// NewSubscribers creates a notification subscribers.
+func NewSubscribers(logger *zap.Logger) *Subscribers {
+	return &Subscribers{
+		source:           make(chan *events.NotificationEvent, bufferSize),
+		subscribers:      make(map[string]*subscription),
+		addSubscriber:    make(chan *subscription, 1),
+		removeSubscriber: make(chan *subscription, 1),
+		logger:           logger,
+	}
+}
+
+// Register registers a new subscriber of the event types, all the types are sent when it is empty.
+func (s *Subscribers) Register(eventTypes []string) *subscription {
+	sub := &subscription{
+		id:         uuid.New().String(),
+		eventTypes: make(map[string]struct{}, len(eventTypes)),
+		ch:         make(chan *events.NotificationEvent, bufferSize),
+	}
+	for _, t := range eventTypes {
+		sub.eventTypes[t] = struct{}{}
+	}
+	s.logger.Info("Registering subscriber in notifications ...", zap.String("id", sub.id))
+	s.addSubscriber <- sub
+	return sub
+}
+
+// Unregister removes a subscriber.
+func (s *Subscribers) Unregister(sub *subscription) {
+	s.logger.Info("Unregistering subscriber in notifications ...", zap.String("id", sub.id))
+	s.removeSubscriber <- sub
+}
+
+// HandleNotification sends a notification event to the subscribers of its type.
+func (s *Subscribers) HandleNotification(e *events.NotificationEvent) {
+	s.source <- e
+}
+
+// Start dispatches the notification events until the context is cancelled.
+func (s *Subscribers) Start(ctx context.Context) {
+	defer func() {
+		for _, sub := range s.subscribers {
+			close(sub.ch)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newSubscriber := <-s.addSubscriber:
+			s.subscribers[newSubscriber.id] = newSubscriber
+			s.logger.Info("New subscriber registered in notifications", zap.String("id", newSubscriber.id))
+		case subscriberToRemove := <-s.removeSubscriber:
+			if subscriber, exists := s.subscribers[subscriberToRemove.id]; exists {
+				close(subscriber.ch)
+				delete(s.subscribers, subscriberToRemove.id)
+				s.logger.Info("Subscriber unregistered in notifications", zap.String("id", subscriber.id))
+			}
+		case e := <-s.source:
+			for _, sub := range s.subscribers {
+				if sub.match(e) {
+					sub.send(e)
+				}
+			}
+		}
+	}
+}
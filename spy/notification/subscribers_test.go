@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSubscribers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewSubscribers(zaptest.NewLogger(t))
+	go s.Start(ctx)
+
+	governor := s.Register([]string{events.GovernorVaaEnqueuedType, events.GovernorVaaReleasedType})
+	all := s.Register(nil)
+
+	s.HandleNotification(&events.NotificationEvent{Event: events.HeartbeatGapType})
+	s.HandleNotification(&events.NotificationEvent{Event: events.GovernorVaaEnqueuedType})
+
+	e := receive(t, governor.Messages())
+	assert.Equal(t, events.GovernorVaaEnqueuedType, e.Event)
+
+	e = receive(t, all.Messages())
+	assert.Equal(t, events.HeartbeatGapType, e.Event)
+	e = receive(t, all.Messages())
+	assert.Equal(t, events.GovernorVaaEnqueuedType, e.Event)
+
+	s.Unregister(governor)
+	_, ok := <-governor.Messages()
+	assert.False(t, ok)
+}
+
+func TestIsType(t *testing.T) {
+	assert.True(t, IsType(events.HeartbeatGapType))
+	assert.False(t, IsType(events.SignedVaaType))
+}
+
+func receive(t *testing.T, ch <-chan *events.NotificationEvent) *events.NotificationEvent {
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("notification not received")
+		return nil
+	}
+}
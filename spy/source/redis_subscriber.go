@@ -11,16 +11,20 @@ import (
 	"go.uber.org/zap"
 )
 
+// NotificationFunc is a function to send the governor and heartbeat notification events.
+type NotificationFunc func(*events.NotificationEvent)
+
 // RedisSubscriber is a redis subscriber.
 type RedisSubscriber struct {
-	client  *redis.Client
-	pubSub  *redis.PubSub
-	logger  *zap.Logger
-	handler WatcherFunc
+	client              *redis.Client
+	pubSub              *redis.PubSub
+	logger              *zap.Logger
+	handler             WatcherFunc
+	notificationHandler NotificationFunc
 }
 
 // NewRedisSubscriber create a new redis subscriber.
-func NewRedisSubscriber(ctx context.Context, redisClient *redis.Client, prefix, channel string, handler WatcherFunc, log *zap.Logger) (*RedisSubscriber, error) {
+func NewRedisSubscriber(ctx context.Context, redisClient *redis.Client, prefix, channel string, handler WatcherFunc, notificationHandler NotificationFunc, log *zap.Logger) (*RedisSubscriber, error) {
 	if redisClient == nil {
 		return nil, errors.New("redis client is nil")
 	}
@@ -28,10 +32,11 @@ func NewRedisSubscriber(ctx context.Context, redisClient *redis.Client, prefix,
 	channel = fmt.Sprintf("%s:%s", prefix, channel)
 	pubsub := redisClient.Subscribe(ctx, channel)
 	return &RedisSubscriber{
-		client:  redisClient,
-		pubSub:  pubsub,
-		handler: handler,
-		logger:  log}, nil
+		client:              redisClient,
+		pubSub:              pubsub,
+		handler:             handler,
+		notificationHandler: notificationHandler,
+		logger:              log}, nil
 }
 
 // Start executes database event consumption.
@@ -67,6 +72,10 @@ func (r *RedisSubscriber) subscribe(ctx context.Context) {
 					ID:   signedVaa.ID,
 					Vaas: signedVaa.Vaa,
 				})
+			case events.GovernorVaaEnqueuedType, events.GovernorVaaReleasedType, events.HeartbeatGapType:
+				if r.notificationHandler != nil {
+					r.notificationHandler(&notification)
+				}
 			default:
 				continue
 			}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
@@ -30,9 +31,11 @@ type documentKey struct {
 }
 
 // Event represents a database change.
+//
+// The vaas are stored compressed in the database, they are decompressed when the event is decoded.
 type Event struct {
-	ID   string `bson:"_id"`
-	Vaas []byte
+	ID   string                     `bson:"_id"`
+	Vaas repository.CompressedBytes `bson:"vaas"`
 }
 
 const queryTemplate = `
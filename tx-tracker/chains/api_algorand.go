@@ -5,8 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -23,7 +23,7 @@ func FetchAlgorandTx(
 	ctx context.Context,
 	pool *pool.Pool,
 	txHash string,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
 	logger *zap.Logger,
 ) (*TxDetail, error) {
 
@@ -38,8 +38,9 @@ func FetchAlgorandTx(
 	var err error
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDAlgorand, metrics)
 		txDetail, err = fetchAlgorandTx(ctx, rpc.Id, txHash)
+		observeRpcCall(&rpc, sdk.ChainIDAlgorand, start, err, metrics)
 		if txDetail != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDAlgorand), rpc.Description)
 			break
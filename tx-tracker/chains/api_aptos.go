@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -21,16 +24,23 @@ type aptosEvent struct {
 }
 
 type aptosTx struct {
-	Timestamp uint64 `json:"timestamp,string"`
-	Sender    string `json:"sender"`
-	Hash      string `json:"hash"`
+	Timestamp    uint64 `json:"timestamp,string"`
+	Sender       string `json:"sender"`
+	Hash         string `json:"hash"`
+	GasUsed      uint64 `json:"gas_used,string"`
+	GasUnitPrice uint64 `json:"gas_unit_price,string"`
 }
 
-func FetchAptosTx(
+type apiAptos struct {
+	notionalCache *notional.NotionalCache
+	p2pNetwork    string
+}
+
+func (a *apiAptos) FetchAptosTx(
 	ctx context.Context,
 	pool *pool.Pool,
 	txHash string,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
 	logger *zap.Logger,
 ) (*TxDetail, error) {
 
@@ -50,8 +60,9 @@ func FetchAptosTx(
 	var events []aptosEvent
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDAptos, metrics)
 		events, err = fetchAptosAccountEvents(ctx, rpc.Id, aptosCoreContractAddress, creationNumber, 1)
+		observeRpcCall(&rpc, sdk.ChainIDAptos, start, err, metrics)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(sdk.ChainIDAptos), rpc.Description)
 			logger.Debug("Failed to fetch transaction from Aptos node", zap.String("url", rpc.Id), zap.Error(err))
@@ -81,8 +92,9 @@ func FetchAptosTx(
 	var tx *aptosTx
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDAptos, metrics)
 		tx, err = fetchAptosTx(ctx, rpc.Id, events[0].Version)
+		observeRpcCall(&rpc, sdk.ChainIDAptos, start, err, metrics)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(sdk.ChainIDAptos), rpc.Description)
 			logger.Debug("Failed to fetch transaction from Aptos node", zap.String("url", rpc.Id), zap.Error(err))
@@ -101,10 +113,33 @@ func FetchAptosTx(
 	TxDetail := TxDetail{
 		NativeTxHash: tx.Hash,
 		From:         tx.Sender,
+		FeeDetail:    aptosFeeDetail(tx.GasUsed, tx.GasUnitPrice),
+	}
+	if a.p2pNetwork == domain.P2pMainNet {
+		if err := SetGasTokenNotional(sdk.ChainIDAptos, TxDetail.FeeDetail, a.notionalCache); err != nil {
+			logger.Error("Failed to get gas price", zap.Error(err), zap.String("chainId", sdk.ChainIDAptos.String()), zap.String("txHash", txHash))
+		}
 	}
 	return &TxDetail, nil
 }
 
+// aptosFeeDetail returns the fee of an aptos transaction, the gas unit price is in octas (1e-8 APT).
+func aptosFeeDetail(gasUsed, gasUnitPrice uint64) *FeeDetail {
+	return &FeeDetail{
+		RawFee: map[string]string{
+			"gasUsed":      strconv.FormatUint(gasUsed, 10),
+			"gasUnitPrice": strconv.FormatUint(gasUnitPrice, 10),
+		},
+		Fee: AptosCalculateFee(gasUsed, gasUnitPrice).String(),
+	}
+}
+
+// AptosCalculateFee calculates the fee in APT of a transaction.
+func AptosCalculateFee(gasUsed, gasUnitPrice uint64) decimal.Decimal {
+	octas := decimal.NewFromUint64(gasUsed).Mul(decimal.NewFromUint64(gasUnitPrice))
+	return octas.DivRound(decimal.NewFromInt(1e8), 8)
+}
+
 // fetchAptosAccountEvents queries the Aptos node API for the events of a given account.
 func fetchAptosAccountEvents(ctx context.Context, baseUrl string, contractAddress string, start uint64, limit uint64) ([]aptosEvent, error) {
 	// Build the URI for the events endpoint
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -28,6 +30,7 @@ type cosmosTxsResponse struct {
 				} `json:"messages"`
 			} `json:"body"`
 		} `json:"tx"`
+		Height    string `json:"height"`
 		Timestamp string `json:"timestamp"`
 		TxHash    string `json:"txhash"`
 	} `json:"tx_response"`
@@ -41,7 +44,7 @@ func (c *apiCosmos) FetchCosmosTx(
 	ctx context.Context,
 	pool *pool.Pool,
 	txHash string,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
 	logger *zap.Logger,
 ) (*TxDetail, error) {
 
@@ -55,8 +58,9 @@ func (c *apiCosmos) FetchCosmosTx(
 	var err error
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, c.chainId, metrics)
 		txDetail, err = c.fetchCosmosTx(ctx, rpc.Id, txHash)
+		observeRpcCall(&rpc, c.chainId, start, err, metrics)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(c.chainId), rpc.Description)
 			logger.Debug("Failed to fetch transaction from cosmos node", zap.String("url", rpc.Id), zap.Error(err))
@@ -109,9 +113,14 @@ func (c *apiCosmos) fetchCosmosTx(
 	}
 
 	// Build the result object and return
+	// tendermint blocks are final as soon as they are committed.
 	TxDetail := &TxDetail{
 		From:         sender,
 		NativeTxHash: response.TxResponse.TxHash,
+		Finality:     domain.TxFinalityFinalized,
+	}
+	if height, err := strconv.ParseUint(response.TxResponse.Height, 10, 64); err == nil {
+		TxDetail.BlockNumber = height
 	}
 	return TxDetail, nil
 }
@@ -3,11 +3,12 @@ package chains
 import (
 	"context"
 	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/shopspring/decimal"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"math/big"
@@ -24,6 +25,7 @@ type ethGetTransactionByHashResponse struct {
 	BlockNumber string `json:"blockNumber"`
 	From        string `json:"from"`
 	To          string `json:"to"`
+	Input       string `json:"input"`
 }
 
 type ethGetTransactionReceiptResponse struct {
@@ -45,7 +47,7 @@ func (e *apiEvm) FetchEvmTx(
 	ctx context.Context,
 	pool *pool.Pool,
 	txHash string,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
 	logger *zap.Logger,
 ) (*TxDetail, error) {
 	// get rpc sorted by score and priority.
@@ -58,8 +60,9 @@ func (e *apiEvm) FetchEvmTx(
 	var err error
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, e.chainId, metrics)
 		txDetail, err = e.fetchEvmTx(ctx, rpc.Id, txHash, methodEthTxReceipt)
+		observeRpcCall(&rpc, e.chainId, start, err, metrics)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(e.chainId), rpc.Description)
 			logger.Debug("Failed to fetch transaction from evm node", zap.String("url", rpc.Id), zap.Error(err))
@@ -183,11 +186,36 @@ func (e *apiEvm) fetchEvmTxReceiptByTxHash(
 		}
 	}
 
-	return &TxDetail{
+	txDetail := &TxDetail{
 		From:         strings.ToLower(txReceiptResponse.From),
 		NativeTxHash: nativeTxHash,
 		FeeDetail:    feeDetail,
-	}, nil
+	}
+	if blockNumber, err := hexutil.DecodeUint64(txReceiptResponse.BlockNumber); err == nil {
+		txDetail.BlockNumber = blockNumber
+		txDetail.BlockHash = strings.ToLower(txReceiptResponse.BlockHash)
+		txDetail.Finality = fetchEvmBlockFinality(ctx, client, blockNumber)
+	}
+	return txDetail, nil
+}
+
+// fetchEvmBlockFinality compares a block number against the "finalized" and "safe" block tags.
+// It returns an empty finality when the node does not support the tags.
+func fetchEvmBlockFinality(ctx context.Context, client *rateLimitedRpcClient, blockNumber uint64) domain.TxFinality {
+	for _, tag := range []domain.TxFinality{domain.TxFinalityFinalized, domain.TxFinalitySafe} {
+		var block ethBlock
+		if err := client.CallContext(ctx, &block, methodEthGetBlockByNumber, string(tag), false); err != nil {
+			return ""
+		}
+		tagNumber, err := hexutil.DecodeUint64(block.Number)
+		if err != nil {
+			return ""
+		}
+		if blockNumber <= tagNumber {
+			return tag
+		}
+	}
+	return domain.TxFinalityUnsafe
 }
 
 func EvmCalculateFee(chainID sdk.ChainID, gasUsed string, effectiveGasPrice string) (*decimal.Decimal, error) {
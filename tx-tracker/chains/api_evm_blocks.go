@@ -0,0 +1,80 @@
+package chains
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// EvmTxBlock is the block that included a transaction of an evm chain.
+type EvmTxBlock struct {
+	Number uint64
+	Hash   string
+}
+
+// FetchEvmBlockHash returns the hash of the block of an evm chain at a height.
+// The hash is empty when the node does not have the block yet.
+func FetchEvmBlockHash(ctx context.Context, pool *pool.Pool, chainID sdk.ChainID, blockNumber uint64, metrics metrics.TxTrackerMetrics, logger *zap.Logger) (string, error) {
+	var hash string
+	err := callEvmRpc(ctx, pool, chainID, metrics, logger, func(client *rateLimitedRpcClient) error {
+		var block ethBlock
+		if err := client.CallContext(ctx, &block, methodEthGetBlockByNumber, hexutil.EncodeUint64(blockNumber), false); err != nil {
+			return fmt.Errorf("failed to get block: %w", err)
+		}
+		hash = strings.ToLower(block.Hash)
+		return nil
+	})
+	return hash, err
+}
+
+// FetchEvmFinalizedBlock returns the number of the last finalized block of an evm chain.
+func FetchEvmFinalizedBlock(ctx context.Context, pool *pool.Pool, chainID sdk.ChainID, metrics metrics.TxTrackerMetrics, logger *zap.Logger) (uint64, error) {
+	var finalized uint64
+	err := callEvmRpc(ctx, pool, chainID, metrics, logger, func(client *rateLimitedRpcClient) error {
+		var block ethBlock
+		if err := client.CallContext(ctx, &block, methodEthGetBlockByNumber, "finalized", false); err != nil {
+			return fmt.Errorf("failed to get finalized block: %w", err)
+		}
+		n, err := hexutil.DecodeUint64(block.Number)
+		if err != nil {
+			return fmt.Errorf("failed to decode finalized block number: %w", err)
+		}
+		finalized = n
+		return nil
+	})
+	return finalized, err
+}
+
+// FetchEvmTxBlock returns the block that currently includes a transaction of an evm chain.
+// It returns ErrTransactionNotFound when the transaction is not included in any block.
+func FetchEvmTxBlock(ctx context.Context, pool *pool.Pool, chainID sdk.ChainID, txHash string, metrics metrics.TxTrackerMetrics, logger *zap.Logger) (*EvmTxBlock, error) {
+	var txBlock *EvmTxBlock
+	err := callEvmRpc(ctx, pool, chainID, metrics, logger, func(client *rateLimitedRpcClient) error {
+		var receipt ethGetTransactionReceiptResponse
+		if err := client.CallContext(ctx, &receipt, methodEthTxReceipt, txHashLowerCaseWith0x(txHash)); err != nil {
+			return fmt.Errorf("failed to get tx receipt: %w", err)
+		}
+		if receipt.BlockHash == "" {
+			return nil
+		}
+		n, err := hexutil.DecodeUint64(receipt.BlockNumber)
+		if err != nil {
+			return fmt.Errorf("failed to decode block number: %w", err)
+		}
+		txBlock = &EvmTxBlock{Number: n, Hash: strings.ToLower(receipt.BlockHash)}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if txBlock == nil {
+		return nil, ErrTransactionNotFound
+	}
+	return txBlock, nil
+}
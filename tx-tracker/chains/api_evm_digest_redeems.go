@@ -0,0 +1,170 @@
+package chains
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// evmMinVaaLength is the length of a vaa without signatures and payload.
+const evmMinVaaLength = 57
+
+// EvmDigestRedeem is a transaction of an evm chain that may redeem vaas without emitting the
+// token bridge TransferRedeemed log, e.g. a manual redeem through an integrator contract.
+// The redeemed vaas are identified by their digests, found in the calldata and the logs of the transaction.
+type EvmDigestRedeem struct {
+	TxHash            string
+	BlockNumber       uint64
+	BlockTimestamp    *time.Time
+	From              string
+	To                string
+	GasUsed           string
+	EffectiveGasPrice string
+	// Digests are the candidate vaa digests of the transaction, most of them don't match any vaa.
+	Digests []string
+}
+
+// FetchEvmDigestRedeems returns the transactions that emitted logs of the contracts in the range of blocks [fromBlock, toBlock],
+// with the candidate digests of the vaas they redeem.
+func FetchEvmDigestRedeems(
+	ctx context.Context,
+	pool *pool.Pool,
+	chainID sdk.ChainID,
+	contracts []string,
+	fromBlock uint64,
+	toBlock uint64,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) ([]EvmDigestRedeem, error) {
+	var redeems []EvmDigestRedeem
+	err := callEvmRpc(ctx, pool, chainID, metrics, logger, func(client *rateLimitedRpcClient) error {
+		var logs []ethLog
+		filter := map[string]any{
+			"fromBlock": hexutil.EncodeUint64(fromBlock),
+			"toBlock":   hexutil.EncodeUint64(toBlock),
+			"address":   contracts,
+		}
+		if err := client.CallContext(ctx, &logs, methodEthGetLogs, filter); err != nil {
+			return fmt.Errorf("failed to get logs: %w", err)
+		}
+
+		// the logs are grouped by transaction, keeping the order of the chain.
+		redeems = nil
+		indexByTx := make(map[string]int)
+		for _, l := range logs {
+			if l.Removed {
+				continue
+			}
+			txHash := txHashLowerCaseWith0x(l.TxHash)
+			i, ok := indexByTx[txHash]
+			if !ok {
+				blockNumber, err := hexutil.DecodeUint64(l.BlockNumber)
+				if err != nil {
+					logger.Warn("Skipping log with invalid block number", zap.String("txHash", txHash), zap.Error(err))
+					continue
+				}
+				i = len(redeems)
+				indexByTx[txHash] = i
+				redeems = append(redeems, EvmDigestRedeem{TxHash: txHash, BlockNumber: blockNumber})
+			}
+			redeems[i].Digests = append(redeems[i].Digests, evmLogWords(l)...)
+		}
+
+		blockTimestamps := make(map[uint64]*time.Time)
+		for i := range redeems {
+			redeem := &redeems[i]
+
+			var tx ethGetTransactionByHashResponse
+			if err := client.CallContext(ctx, &tx, methodEthTxByHash, redeem.TxHash); err != nil {
+				return fmt.Errorf("failed to get tx: %w", err)
+			}
+			if input, err := hexutil.Decode(tx.Input); err == nil {
+				redeem.Digests = append(redeem.Digests, evmCalldataDigests(input)...)
+			}
+
+			var receipt ethGetTransactionReceiptResponse
+			if err := client.CallContext(ctx, &receipt, methodEthTxReceipt, redeem.TxHash); err != nil {
+				return fmt.Errorf("failed to get tx receipt: %w", err)
+			}
+			redeem.From = strings.ToLower(receipt.From)
+			redeem.To = strings.ToLower(receipt.To)
+			redeem.GasUsed = receipt.GasUsed
+			redeem.EffectiveGasPrice = receipt.EfectiveGasPrice
+
+			timestamp, ok := blockTimestamps[redeem.BlockNumber]
+			if !ok {
+				var block ethBlock
+				if err := client.CallContext(ctx, &block, methodEthGetBlockByNumber, hexutil.EncodeUint64(redeem.BlockNumber), false); err != nil {
+					return fmt.Errorf("failed to get block: %w", err)
+				}
+				seconds, err := hexutil.DecodeUint64(block.Timestamp)
+				if err != nil {
+					return fmt.Errorf("failed to decode block timestamp: %w", err)
+				}
+				t := time.Unix(int64(seconds), 0).UTC()
+				timestamp = &t
+				blockTimestamps[redeem.BlockNumber] = timestamp
+			}
+			redeem.BlockTimestamp = timestamp
+		}
+		return nil
+	})
+	return redeems, err
+}
+
+// evmLogWords returns the indexed topics and the 32 bytes words of the data of a log, normalized as digests.
+// The first topic is skipped, it is the signature of the event.
+func evmLogWords(l ethLog) []string {
+	var words []string
+	if len(l.Topics) > 1 {
+		for _, topic := range l.Topics[1:] {
+			words = append(words, utils.NormalizeHex(topic))
+		}
+	}
+	data, err := hexutil.Decode(l.Data)
+	if err != nil {
+		return words
+	}
+	for offset := 0; offset+32 <= len(data); offset += 32 {
+		words = append(words, hex.EncodeToString(data[offset:offset+32]))
+	}
+	return words
+}
+
+// evmCalldataDigests returns the digests of the vaas found in the calldata of an evm transaction.
+// The vaas are expected as abi encoded bytes arguments, a 32 bytes length followed by the vaa.
+func evmCalldataDigests(input []byte) []string {
+	if len(input) < 4 {
+		return nil
+	}
+	// the first 4 bytes are the selector of the method.
+	args := input[4:]
+
+	var digests []string
+	for offset := 0; offset+32 <= len(args); offset += 32 {
+		length := new(big.Int).SetBytes(args[offset : offset+32])
+		if !length.IsUint64() || length.Uint64() < evmMinVaaLength || length.Uint64() > uint64(len(args)-offset-32) {
+			continue
+		}
+		data := args[offset+32 : offset+32+int(length.Uint64())]
+		if data[0] != sdk.SupportedVAAVersion {
+			continue
+		}
+		v, err := sdk.Unmarshal(data)
+		if err != nil {
+			continue
+		}
+		digests = append(digests, utils.NormalizeHex(v.HexDigest()))
+	}
+	return digests
+}
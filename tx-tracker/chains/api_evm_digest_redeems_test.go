@@ -0,0 +1,62 @@
+package chains
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestEvmCalldataDigests(t *testing.T) {
+	v := &sdk.VAA{
+		Version:          sdk.SupportedVAAVersion,
+		GuardianSetIndex: 4,
+		Signatures:       []*sdk.Signature{{Index: 0}},
+		Timestamp:        time.Unix(1700000000, 0),
+		Nonce:            1,
+		Sequence:         790526,
+		EmitterChain:     sdk.ChainIDSolana,
+		EmitterAddress:   sdk.Address{0xec, 0x73},
+		Payload:          []byte{0x01, 0x02, 0x03},
+	}
+	data, err := v.Marshal()
+	assert.NoError(t, err)
+
+	// completeTransfer(bytes): selector, offset of the argument, length and padded vaa.
+	input := []byte{0xc6, 0x87, 0x85, 0x19}
+	input = append(input, word(32)...)
+	input = append(input, word(uint64(len(data)))...)
+	input = append(input, data...)
+	input = append(input, make([]byte, 32-len(data)%32)...)
+
+	digests := evmCalldataDigests(input)
+	assert.Equal(t, []string{utils.NormalizeHex(v.HexDigest())}, digests)
+
+	assert.Empty(t, evmCalldataDigests(input[:4]))
+	assert.Empty(t, evmCalldataDigests(input[:len(input)-40]))
+}
+
+func TestEvmLogWords(t *testing.T) {
+	l := ethLog{
+		Topics: []string{
+			"0x504e6efe18ab9eed10dc6501a417f5b12a2f7f2b1593aed9b89f9bce3cf29a91",
+			"0xEC7372995D5CC8732397FB0AD35C0121E0EAA90D26F828A534CAB54391B3A4F5",
+		},
+		Data: "0x00000000000000000000000000000000000000000000000000000000000c0ffe",
+	}
+
+	words := evmLogWords(l)
+	assert.Equal(t, []string{
+		"ec7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5",
+		"00000000000000000000000000000000000000000000000000000000000c0ffe",
+	}, words)
+}
+
+func word(n uint64) []byte {
+	w := make([]byte, 32)
+	binary.BigEndian.PutUint64(w[24:], n)
+	return w
+}
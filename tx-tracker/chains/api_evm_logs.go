@@ -0,0 +1,202 @@
+package chains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	methodEthBlockNumber      = "eth_blockNumber"
+	methodEthGetLogs          = "eth_getLogs"
+	methodEthGetBlockByNumber = "eth_getBlockByNumber"
+)
+
+// evmTransferRedeemedTopic is the topic of the token bridge event TransferRedeemed(uint16 indexed emitterChainId, bytes32 indexed emitterAddress, uint64 indexed sequence).
+var evmTransferRedeemedTopic = crypto.Keccak256Hash([]byte("TransferRedeemed(uint16,bytes32,uint64)")).Hex()
+
+type ethLog struct {
+	Address     string   `json:"address"`
+	Topics      []string `json:"topics"`
+	Data        string   `json:"data"`
+	BlockNumber string   `json:"blockNumber"`
+	TxHash      string   `json:"transactionHash"`
+	Removed     bool     `json:"removed"`
+}
+
+type ethBlock struct {
+	Number    string `json:"number"`
+	Hash      string `json:"hash"`
+	Timestamp string `json:"timestamp"`
+}
+
+// EvmRedeem is a token bridge redeem found in the logs of an evm chain.
+type EvmRedeem struct {
+	EmitterChain      sdk.ChainID
+	EmitterAddress    string
+	Sequence          uint64
+	TxHash            string
+	BlockNumber       uint64
+	BlockTimestamp    *time.Time
+	From              string
+	To                string
+	GasUsed           string
+	EffectiveGasPrice string
+}
+
+// VaaID returns the id of the vaa redeemed.
+func (r *EvmRedeem) VaaID() string {
+	return fmt.Sprintf("%d/%s/%d", r.EmitterChain, r.EmitterAddress, r.Sequence)
+}
+
+// FetchEvmLatestBlock returns the latest block number of an evm chain.
+func FetchEvmLatestBlock(ctx context.Context, pool *pool.Pool, chainID sdk.ChainID, metrics metrics.TxTrackerMetrics, logger *zap.Logger) (uint64, error) {
+	var latest uint64
+	err := callEvmRpc(ctx, pool, chainID, metrics, logger, func(client *rateLimitedRpcClient) error {
+		var reply string
+		if err := client.CallContext(ctx, &reply, methodEthBlockNumber); err != nil {
+			return fmt.Errorf("failed to get block number: %w", err)
+		}
+		n, err := hexutil.DecodeUint64(reply)
+		if err != nil {
+			return fmt.Errorf("failed to decode block number: %w", err)
+		}
+		latest = n
+		return nil
+	})
+	return latest, err
+}
+
+// FetchEvmRedeems returns the token bridge redeems emitted by the contracts in the range of blocks [fromBlock, toBlock].
+func FetchEvmRedeems(
+	ctx context.Context,
+	pool *pool.Pool,
+	chainID sdk.ChainID,
+	contracts []string,
+	fromBlock uint64,
+	toBlock uint64,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) ([]EvmRedeem, error) {
+	var redeems []EvmRedeem
+	err := callEvmRpc(ctx, pool, chainID, metrics, logger, func(client *rateLimitedRpcClient) error {
+		var logs []ethLog
+		filter := map[string]any{
+			"fromBlock": hexutil.EncodeUint64(fromBlock),
+			"toBlock":   hexutil.EncodeUint64(toBlock),
+			"address":   contracts,
+			"topics":    [][]string{{evmTransferRedeemedTopic}},
+		}
+		if err := client.CallContext(ctx, &logs, methodEthGetLogs, filter); err != nil {
+			return fmt.Errorf("failed to get logs: %w", err)
+		}
+
+		redeems = make([]EvmRedeem, 0, len(logs))
+		blockTimestamps := make(map[string]*time.Time)
+		for _, l := range logs {
+			if l.Removed {
+				continue
+			}
+			redeem, err := parseEvmRedeemLog(l)
+			if err != nil {
+				logger.Warn("Skipping invalid redeem log", zap.String("txHash", l.TxHash), zap.Error(err))
+				continue
+			}
+
+			var receipt ethGetTransactionReceiptResponse
+			if err := client.CallContext(ctx, &receipt, methodEthTxReceipt, redeem.TxHash); err != nil {
+				return fmt.Errorf("failed to get tx receipt: %w", err)
+			}
+			redeem.From = strings.ToLower(receipt.From)
+			redeem.To = strings.ToLower(receipt.To)
+			redeem.GasUsed = receipt.GasUsed
+			redeem.EffectiveGasPrice = receipt.EfectiveGasPrice
+
+			timestamp, ok := blockTimestamps[l.BlockNumber]
+			if !ok {
+				var block ethBlock
+				if err := client.CallContext(ctx, &block, methodEthGetBlockByNumber, l.BlockNumber, false); err != nil {
+					return fmt.Errorf("failed to get block: %w", err)
+				}
+				seconds, err := hexutil.DecodeUint64(block.Timestamp)
+				if err != nil {
+					return fmt.Errorf("failed to decode block timestamp: %w", err)
+				}
+				t := time.Unix(int64(seconds), 0).UTC()
+				timestamp = &t
+				blockTimestamps[l.BlockNumber] = timestamp
+			}
+			redeem.BlockTimestamp = timestamp
+
+			redeems = append(redeems, *redeem)
+		}
+		return nil
+	})
+	return redeems, err
+}
+
+func parseEvmRedeemLog(l ethLog) (*EvmRedeem, error) {
+	if len(l.Topics) != 4 {
+		return nil, fmt.Errorf("unexpected number of topics: %d", len(l.Topics))
+	}
+	emitterChain, ok := new(big.Int).SetString(strings.TrimPrefix(l.Topics[1], "0x"), 16)
+	if !ok || !emitterChain.IsUint64() || emitterChain.Uint64() > 0xffff {
+		return nil, errors.New("invalid emitter chain")
+	}
+	sequence, ok := new(big.Int).SetString(strings.TrimPrefix(l.Topics[3], "0x"), 16)
+	if !ok || !sequence.IsUint64() {
+		return nil, errors.New("invalid sequence")
+	}
+	blockNumber, err := hexutil.DecodeUint64(l.BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block number: %w", err)
+	}
+	return &EvmRedeem{
+		EmitterChain:   sdk.ChainID(emitterChain.Uint64()),
+		EmitterAddress: strings.ToLower(strings.TrimPrefix(l.Topics[2], "0x")),
+		Sequence:       sequence.Uint64(),
+		TxHash:         txHashLowerCaseWith0x(l.TxHash),
+		BlockNumber:    blockNumber,
+	}, nil
+}
+
+// callEvmRpc calls the rpcs of the pool, sorted by score and priority, until one of them succeeds.
+func callEvmRpc(ctx context.Context, pool *pool.Pool, chainID sdk.ChainID, metrics metrics.TxTrackerMetrics, logger *zap.Logger, call func(client *rateLimitedRpcClient) error) error {
+	rpcs := pool.GetItems()
+	if len(rpcs) == 0 {
+		return ErrChainNotSupported
+	}
+
+	var err error
+	for _, rpc := range rpcs {
+		// Wait for the RPC rate limiter
+		start := waitRpc(ctx, &rpc, chainID, metrics)
+		var client *rateLimitedRpcClient
+		client, err = rpcDialContext(ctx, rpc.Id)
+		if err != nil {
+			err = fmt.Errorf("failed to initialize RPC client: %w", err)
+		} else {
+			err = call(client)
+			client.Close()
+		}
+		observeRpcCall(&rpc, chainID, start, err, metrics)
+		if err != nil {
+			metrics.IncCallRpcError(uint16(chainID), rpc.Description)
+			logger.Debug("Failed to call evm node", zap.String("url", rpc.Id), zap.Error(err))
+			continue
+		}
+		metrics.IncCallRpcSuccess(uint16(chainID), rpc.Description)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,32 @@
+package chains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParseEvmRedeemLog(t *testing.T) {
+	l := ethLog{
+		Topics: []string{
+			evmTransferRedeemedTopic,
+			"0x0000000000000000000000000000000000000000000000000000000000000001",
+			"0xEC7372995D5CC8732397FB0AD35C0121E0EAA90D26F828A534CAB54391B3A4F5",
+			"0x00000000000000000000000000000000000000000000000000000000000c0ffe",
+		},
+		BlockNumber: "0x12d687",
+		TxHash:      "0xABCDEF",
+	}
+
+	redeem, err := parseEvmRedeemLog(l)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.ChainIDSolana, redeem.EmitterChain)
+	assert.Equal(t, uint64(0xc0ffe), redeem.Sequence)
+	assert.Equal(t, uint64(1234567), redeem.BlockNumber)
+	assert.Equal(t, "0xabcdef", redeem.TxHash)
+	assert.Equal(t, "1/ec7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5/790526", redeem.VaaID())
+
+	_, err = parseEvmRedeemLog(ethLog{Topics: []string{evmTransferRedeemedTopic}})
+	assert.Error(t, err)
+}
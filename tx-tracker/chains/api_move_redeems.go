@@ -0,0 +1,323 @@
+package chains
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// MoveRedeem is a token bridge redeem found in the events of a move chain (aptos or sui).
+type MoveRedeem struct {
+	EmitterChain   sdk.ChainID
+	EmitterAddress string
+	Sequence       uint64
+	TxHash         string
+	BlockHeight    string
+	BlockTimestamp *time.Time
+	From           string
+	FeeDetail      *FeeDetail
+}
+
+// VaaID returns the id of the vaa redeemed.
+func (r *MoveRedeem) VaaID() string {
+	return fmt.Sprintf("%d/%s/%d", r.EmitterChain, r.EmitterAddress, r.Sequence)
+}
+
+type aptosRedeemEvent struct {
+	Version        uint64 `json:"version,string"`
+	SequenceNumber uint64 `json:"sequence_number,string"`
+	Data           struct {
+		EmitterChain   json.RawMessage `json:"emitter_chain"`
+		EmitterAddress struct {
+			ExternalAddress string `json:"external_address"`
+		} `json:"emitter_address"`
+		Sequence json.RawMessage `json:"sequence"`
+	} `json:"data"`
+}
+
+type aptosRedeemTx struct {
+	Hash         string `json:"hash"`
+	Sender       string `json:"sender"`
+	Timestamp    uint64 `json:"timestamp,string"`
+	Success      bool   `json:"success"`
+	GasUsed      uint64 `json:"gas_used,string"`
+	GasUnitPrice uint64 `json:"gas_unit_price,string"`
+}
+
+// FetchAptosRedeems returns the redeem events of an event handle after the cursor, which is the sequence number of an event,
+// and the cursor of the last event returned. When the cursor is empty, only the latest event is returned.
+// The handle has the format "<address>::<module>::<struct>/<field>".
+func FetchAptosRedeems(
+	ctx context.Context,
+	pool *pool.Pool,
+	handle string,
+	cursor string,
+	limit int,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) ([]MoveRedeem, string, error) {
+	address, _, ok := strings.Cut(handle, "::")
+	if !ok {
+		return nil, "", fmt.Errorf("invalid aptos event handle: %s", handle)
+	}
+	// without start, the node returns the latest events of the handle.
+	query := "limit=1"
+	if cursor != "" {
+		last, err := strconv.ParseUint(cursor, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid aptos event cursor: %s", cursor)
+		}
+		query = fmt.Sprintf("start=%d&limit=%d", last+1, limit)
+	}
+
+	var redeems []MoveRedeem
+	next := cursor
+	err := callMoveRpc(ctx, pool, sdk.ChainIDAptos, metrics, logger, func(baseUrl string) error {
+		uri := fmt.Sprintf("%s/v1/accounts/%s/events/%s?%s", baseUrl, address, handle, query)
+		body, err := httpGet(ctx, uri)
+		if err != nil {
+			return fmt.Errorf("failed to query events endpoint: %w", err)
+		}
+		var events []aptosRedeemEvent
+		if err := json.Unmarshal(body, &events); err != nil {
+			return fmt.Errorf("failed to parse response body from events endpoint: %w", err)
+		}
+
+		redeems = make([]MoveRedeem, 0, len(events))
+		next = cursor
+		for _, e := range events {
+			next = strconv.FormatUint(e.SequenceNumber, 10)
+			redeem, err := parseAptosRedeemEvent(&e)
+			if err != nil {
+				logger.Warn("Skipping invalid redeem event", zap.Uint64("sequenceNumber", e.SequenceNumber), zap.Error(err))
+				continue
+			}
+
+			body, err := httpGet(ctx, fmt.Sprintf("%s/v1/transactions/by_version/%d", baseUrl, e.Version))
+			if err != nil {
+				return fmt.Errorf("failed to query transactions endpoint: %w", err)
+			}
+			var tx aptosRedeemTx
+			if err := json.Unmarshal(body, &tx); err != nil {
+				return fmt.Errorf("failed to parse response body from transactions endpoint: %w", err)
+			}
+			if !tx.Success {
+				continue
+			}
+			// the aptos timestamps are in microseconds.
+			t := time.UnixMicro(int64(tx.Timestamp)).UTC()
+			redeem.TxHash = tx.Hash
+			redeem.From = tx.Sender
+			redeem.BlockTimestamp = &t
+			redeem.FeeDetail = aptosFeeDetail(tx.GasUsed, tx.GasUnitPrice)
+			redeems = append(redeems, *redeem)
+		}
+		return nil
+	})
+	return redeems, next, err
+}
+
+func parseAptosRedeemEvent(e *aptosRedeemEvent) (*MoveRedeem, error) {
+	emitterChain, err := parseMoveUint(e.Data.EmitterChain, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid emitter chain: %w", err)
+	}
+	sequence, err := parseMoveUint(e.Data.Sequence, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sequence: %w", err)
+	}
+	emitterAddress, err := hex.DecodeString(strings.TrimPrefix(e.Data.EmitterAddress.ExternalAddress, "0x"))
+	if err != nil || len(emitterAddress) != 32 {
+		return nil, errors.New("invalid emitter address")
+	}
+	return &MoveRedeem{
+		EmitterChain:   sdk.ChainID(emitterChain),
+		EmitterAddress: hex.EncodeToString(emitterAddress),
+		Sequence:       sequence,
+		BlockHeight:    strconv.FormatUint(e.Version, 10),
+	}, nil
+}
+
+type suiEventID struct {
+	TxDigest string `json:"txDigest"`
+	EventSeq string `json:"eventSeq"`
+}
+
+type suiRedeemEvent struct {
+	ID          suiEventID `json:"id"`
+	Sender      string     `json:"sender"`
+	TimestampMs int64      `json:"timestampMs,string"`
+	ParsedJson  struct {
+		EmitterChain   json.RawMessage `json:"emitter_chain"`
+		EmitterAddress struct {
+			Value struct {
+				Data []uint8Number `json:"data"`
+			} `json:"value"`
+		} `json:"emitter_address"`
+		Sequence json.RawMessage `json:"sequence"`
+	} `json:"parsedJson"`
+}
+
+type suiQueryEventsResponse struct {
+	Data []suiRedeemEvent `json:"data"`
+}
+
+// FetchSuiRedeems returns the redeem events of the event type after the cursor, with the format "<txDigest>/<eventSeq>",
+// and the cursor of the last event returned. When the cursor is empty, only the latest event is returned.
+func FetchSuiRedeems(
+	ctx context.Context,
+	pool *pool.Pool,
+	eventType string,
+	cursor string,
+	limit int,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) ([]MoveRedeem, string, error) {
+	var eventCursor *suiEventID
+	descending := false
+	if cursor == "" {
+		limit = 1
+		descending = true
+	} else {
+		txDigest, eventSeq, ok := strings.Cut(cursor, "/")
+		if !ok {
+			return nil, "", fmt.Errorf("invalid sui event cursor: %s", cursor)
+		}
+		eventCursor = &suiEventID{TxDigest: txDigest, EventSeq: eventSeq}
+	}
+
+	var redeems []MoveRedeem
+	next := cursor
+	err := callMoveRpc(ctx, pool, sdk.ChainIDSui, metrics, logger, func(baseUrl string) error {
+		client, err := rpcDialContext(ctx, baseUrl)
+		if err != nil {
+			return fmt.Errorf("failed to initialize RPC client: %w", err)
+		}
+		defer client.Close()
+
+		var reply suiQueryEventsResponse
+		query := map[string]string{"MoveEventType": eventType}
+		err = client.CallContext(ctx, &reply, "suix_queryEvents", query, eventCursor, limit, descending)
+		if err != nil {
+			return fmt.Errorf("failed to query events: %w", err)
+		}
+
+		redeems = make([]MoveRedeem, 0, len(reply.Data))
+		next = cursor
+		for _, e := range reply.Data {
+			next = e.ID.TxDigest + "/" + e.ID.EventSeq
+			redeem, err := parseSuiRedeemEvent(&e)
+			if err != nil {
+				logger.Warn("Skipping invalid redeem event", zap.String("txDigest", e.ID.TxDigest), zap.Error(err))
+				continue
+			}
+			redeems = append(redeems, *redeem)
+		}
+		setSuiRedeemsFee(ctx, client, redeems, logger)
+		return nil
+	})
+	return redeems, next, err
+}
+
+// setSuiRedeemsFee sets the fee of the redeems from the effects of their transactions.
+// The redeems are returned without fee when the transactions can not be fetched.
+func setSuiRedeemsFee(ctx context.Context, client *rateLimitedRpcClient, redeems []MoveRedeem, logger *zap.Logger) {
+	if len(redeems) == 0 {
+		return
+	}
+	digests := make([]string, 0, len(redeems))
+	for _, r := range redeems {
+		digests = append(digests, r.TxHash)
+	}
+	var txs []suiGetTransactionBlockResponse
+	opts := suiGetTransactionBlockOpts{ShowEffects: true}
+	if err := client.CallContext(ctx, &txs, "sui_multiGetTransactionBlocks", digests, opts); err != nil {
+		logger.Warn("Failed to fetch fee of sui redeems", zap.Error(err))
+		return
+	}
+	fees := make(map[string]*FeeDetail, len(txs))
+	for _, tx := range txs {
+		if tx.Effects == nil {
+			continue
+		}
+		feeDetail, err := suiFeeDetail(&tx.Effects.GasUsed)
+		if err != nil {
+			logger.Warn("Failed to calculate fee of sui redeem", zap.String("txDigest", tx.Digest), zap.Error(err))
+			continue
+		}
+		fees[tx.Digest] = feeDetail
+	}
+	for i := range redeems {
+		redeems[i].FeeDetail = fees[redeems[i].TxHash]
+	}
+}
+
+func parseSuiRedeemEvent(e *suiRedeemEvent) (*MoveRedeem, error) {
+	emitterChain, err := parseMoveUint(e.ParsedJson.EmitterChain, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid emitter chain: %w", err)
+	}
+	sequence, err := parseMoveUint(e.ParsedJson.Sequence, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sequence: %w", err)
+	}
+	if len(e.ParsedJson.EmitterAddress.Value.Data) != 32 {
+		return nil, errors.New("invalid emitter address")
+	}
+	emitterAddress := make([]byte, len(e.ParsedJson.EmitterAddress.Value.Data))
+	for i, b := range e.ParsedJson.EmitterAddress.Value.Data {
+		emitterAddress[i] = byte(b)
+	}
+	t := time.UnixMilli(e.TimestampMs).UTC()
+	return &MoveRedeem{
+		EmitterChain:   sdk.ChainID(emitterChain),
+		EmitterAddress: hex.EncodeToString(emitterAddress),
+		Sequence:       sequence,
+		TxHash:         e.ID.TxDigest,
+		BlockTimestamp: &t,
+		From:           e.Sender,
+	}, nil
+}
+
+// uint8Number is a byte encoded as a json number, as the sui nodes encode the move vector<u8>.
+type uint8Number uint8
+
+// parseMoveUint parses an unsigned integer of a move event, the integers wider than 32 bits are encoded as strings.
+func parseMoveUint(raw json.RawMessage, bitSize int) (uint64, error) {
+	return strconv.ParseUint(string(bytes.Trim(raw, `"`)), 10, bitSize)
+}
+
+// callMoveRpc calls the rpcs of the pool, sorted by score and priority, until one of them succeeds.
+func callMoveRpc(ctx context.Context, pool *pool.Pool, chainID sdk.ChainID, metrics metrics.TxTrackerMetrics, logger *zap.Logger, call func(baseUrl string) error) error {
+	rpcs := pool.GetItems()
+	if len(rpcs) == 0 {
+		return ErrChainNotSupported
+	}
+
+	var err error
+	for _, rpc := range rpcs {
+		// Wait for the RPC rate limiter
+		start := waitRpc(ctx, &rpc, chainID, metrics)
+		err = call(rpc.Id)
+		observeRpcCall(&rpc, chainID, start, err, metrics)
+		if err != nil {
+			metrics.IncCallRpcError(uint16(chainID), rpc.Description)
+			logger.Debug("Failed to call node", zap.String("chain", chainID.String()), zap.String("url", rpc.Id), zap.Error(err))
+			continue
+		}
+		metrics.IncCallRpcSuccess(uint16(chainID), rpc.Description)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,72 @@
+package chains
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParseAptosRedeemEvent(t *testing.T) {
+	body := `{
+		"version": "412345",
+		"sequence_number": "7",
+		"data": {
+			"emitter_chain": 2,
+			"emitter_address": {"external_address": "0x0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"},
+			"sequence": "123456"
+		}
+	}`
+	var e aptosRedeemEvent
+	assert.NoError(t, json.Unmarshal([]byte(body), &e))
+
+	redeem, err := parseAptosRedeemEvent(&e)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.ChainIDEthereum, redeem.EmitterChain)
+	assert.Equal(t, "412345", redeem.BlockHeight)
+	assert.Equal(t, "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/123456", redeem.VaaID())
+
+	e.Data.EmitterAddress.ExternalAddress = "0x01"
+	_, err = parseAptosRedeemEvent(&e)
+	assert.Error(t, err)
+}
+
+func TestParseSuiRedeemEvent(t *testing.T) {
+	address := "[" + strings.Repeat("0,", 31) + "1]"
+	body := `{
+		"id": {"txDigest": "5HQVrnKNXxPD3vCGTp1Lq3K7NNsZeUvQsjmAGnStNTBG", "eventSeq": "0"},
+		"sender": "0xabc",
+		"timestampMs": "1700000000000",
+		"parsedJson": {
+			"emitter_chain": 1,
+			"emitter_address": {"value": {"data": ` + address + `}},
+			"sequence": "99"
+		}
+	}`
+	var e suiRedeemEvent
+	assert.NoError(t, json.Unmarshal([]byte(body), &e))
+
+	redeem, err := parseSuiRedeemEvent(&e)
+	assert.NoError(t, err)
+	assert.Equal(t, "1/0000000000000000000000000000000000000000000000000000000000000001/99", redeem.VaaID())
+	assert.Equal(t, "5HQVrnKNXxPD3vCGTp1Lq3K7NNsZeUvQsjmAGnStNTBG", redeem.TxHash)
+	assert.Equal(t, "0xabc", redeem.From)
+	assert.Equal(t, int64(1700000000), redeem.BlockTimestamp.Unix())
+
+	e.ParsedJson.Sequence = json.RawMessage(`"-1"`)
+	_, err = parseSuiRedeemEvent(&e)
+	assert.Error(t, err)
+}
+
+func TestMoveCalculateFee(t *testing.T) {
+	assert.Equal(t, "0.0015", AptosCalculateFee(1500, 100).String())
+
+	fee, err := SuiCalculateFee("750000", "1976000", "978120")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.00174788", fee.String())
+
+	_, err = SuiCalculateFee("750000", "", "0")
+	assert.Error(t, err)
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -46,7 +46,7 @@ func (a *apiSei) FetchSeiTx(
 	ctx context.Context,
 	pool *pool.Pool,
 	txHash string,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
 	logger *zap.Logger,
 ) (*TxDetail, error) {
 	txHash = txHashLowerCaseWith0x(txHash)
@@ -62,8 +62,9 @@ func (a *apiSei) FetchSeiTx(
 	var err error
 	for _, rpc := range wormchainRpcs {
 		// wait for the rpc to be available
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, vaa.ChainIDWormchain, metrics)
 		wormchainTx, err = fetchWormchainDetail(ctx, rpc.Id, txHash)
+		observeRpcCall(&rpc, vaa.ChainIDWormchain, start, err, metrics)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(vaa.ChainIDWormchain), rpc.Description)
 			logger.Debug("Failed to fetch transaction from wormchain", zap.String("url", rpc.Id), zap.Error(err))
@@ -91,8 +92,9 @@ func (a *apiSei) FetchSeiTx(
 	var seiTx *seiTx
 	for _, rpc := range seiRpcs {
 		// wait for the rpc to be available
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, vaa.ChainIDSei, metrics)
 		seiTx, err = fetchSeiDetail(ctx, rpc.Id, wormchainTx.sequence, wormchainTx.timestamp, wormchainTx.srcChannel, wormchainTx.dstChannel)
+		observeRpcCall(&rpc, vaa.ChainIDSei, start, err, metrics)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(vaa.ChainIDSei), rpc.Description)
 			logger.Debug("Failed to fetch transaction from sei", zap.String("url", rpc.Id), zap.Error(err))
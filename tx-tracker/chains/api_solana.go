@@ -11,9 +11,9 @@ import (
 
 	"github.com/mr-tron/base58"
 	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/common/types"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -25,7 +25,8 @@ type solanaTransactionSignature struct {
 }
 
 type solanaGetTransactionResponse struct {
-	BlockTime int64 `json:"blockTime"`
+	BlockTime int64  `json:"blockTime"`
+	Slot      uint64 `json:"slot"`
 	Meta      struct {
 		InnerInstructions []struct {
 			Instructions []struct {
@@ -70,7 +71,7 @@ func (a *apiSolana) FetchSolanaTx(
 	ctx context.Context,
 	pool *pool.Pool,
 	txHash string,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
 	logger *zap.Logger,
 ) (*TxDetail, error) {
 
@@ -85,8 +86,9 @@ func (a *apiSolana) FetchSolanaTx(
 	var err error
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDSolana, metrics)
 		txDetail, err = a.fetchSolanaTx(ctx, rpc.Id, txHash)
+		observeRpcCall(&rpc, sdk.ChainIDSolana, start, err, metrics)
 		if txDetail != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDSolana), rpc.Description)
 			break
@@ -187,8 +189,11 @@ func (a *apiSolana) fetchSolanaTx(
 	}
 
 	// populate the response object
+	// getTransaction uses the finalized commitment by default.
 	txDetail := TxDetail{
 		NativeTxHash: nativeTxHash,
+		BlockNumber:  response.Slot,
+		Finality:     domain.TxFinalityFinalized,
 	}
 
 	// set sender/receiver
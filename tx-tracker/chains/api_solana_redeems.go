@@ -0,0 +1,349 @@
+package chains
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const solanaCommitmentFinalized = "finalized"
+
+// solanaCompleteTransferInstructions are the token bridge instructions that redeem a vaa:
+// CompleteNative, CompleteWrapped, CompleteNativeWithPayload and CompleteWrappedWithPayload.
+var solanaCompleteTransferInstructions = map[byte]bool{2: true, 3: true, 9: true, 10: true}
+
+// solanaCompleteTransferVaaAccount is the index of the posted vaa account in the complete transfer instructions.
+const solanaCompleteTransferVaaAccount = 2
+
+// solanaPostedVaaMagic is the prefix of the posted vaa accounts of the core bridge.
+const solanaPostedVaaMagic = "vaa"
+
+type solanaSignatureInfo struct {
+	Signature string      `json:"signature"`
+	Slot      uint64      `json:"slot"`
+	BlockTime int64       `json:"blockTime"`
+	Err       interface{} `json:"err"`
+}
+
+type solanaSignaturesConfig struct {
+	Limit      int    `json:"limit,omitempty"`
+	Before     string `json:"before,omitempty"`
+	Until      string `json:"until,omitempty"`
+	Commitment string `json:"commitment,omitempty"`
+}
+
+type solanaInstruction struct {
+	ProgramIDIndex int    `json:"programIdIndex"`
+	Accounts       []int  `json:"accounts"`
+	Data           string `json:"data"`
+}
+
+// solanaGetRawTransactionResponse is the response of getTransaction with the json encoding,
+// which keeps the raw data of the instructions.
+type solanaGetRawTransactionResponse struct {
+	Slot      uint64 `json:"slot"`
+	BlockTime int64  `json:"blockTime"`
+	Meta      struct {
+		Err               interface{} `json:"err"`
+		Fee               uint64      `json:"fee"`
+		InnerInstructions []struct {
+			Index        int                 `json:"index"`
+			Instructions []solanaInstruction `json:"instructions"`
+		} `json:"innerInstructions"`
+		LoadedAddresses struct {
+			Writable []string `json:"writable"`
+			Readonly []string `json:"readonly"`
+		} `json:"loadedAddresses"`
+	} `json:"meta"`
+	Transaction struct {
+		Message struct {
+			AccountKeys  []string            `json:"accountKeys"`
+			Instructions []solanaInstruction `json:"instructions"`
+		} `json:"message"`
+		Signatures []string `json:"signatures"`
+	} `json:"transaction"`
+}
+
+type solanaGetTransactionRawConfig struct {
+	Encoding                       string `json:"encoding"`
+	MaxSupportedTransactionVersion int    `json:"maxSupportedTransactionVersion"`
+	Commitment                     string `json:"commitment"`
+}
+
+type solanaGetMultipleAccountsResponse struct {
+	Value []*struct {
+		Data []string `json:"data"`
+	} `json:"value"`
+}
+
+// SolanaSignature is a transaction signature of the token bridge program.
+type SolanaSignature struct {
+	Signature string
+	Slot      uint64
+	Failed    bool
+}
+
+// SolanaRedeem is a token bridge redeem found in a solana transaction.
+type SolanaRedeem struct {
+	EmitterChain   sdk.ChainID
+	EmitterAddress string
+	Sequence       uint64
+	// Digest is the hash of the vaa body, it matches the digest of the stored vaa.
+	Digest         string
+	TxHash         string
+	Slot           uint64
+	BlockTimestamp *time.Time
+	From           string
+	Fee            uint64
+}
+
+// VaaID returns the id of the vaa redeemed.
+func (r *SolanaRedeem) VaaID() string {
+	return fmt.Sprintf("%d/%s/%d", r.EmitterChain, r.EmitterAddress, r.Sequence)
+}
+
+// FetchSolanaLatestSlot returns the latest finalized slot of solana.
+func FetchSolanaLatestSlot(ctx context.Context, pool *pool.Pool, metrics metrics.TxTrackerMetrics, logger *zap.Logger) (uint64, error) {
+	var slot uint64
+	err := callSolanaRpc(ctx, pool, metrics, logger, func(client *rateLimitedRpcClient) error {
+		err := client.CallContext(ctx, &slot, "getSlot", map[string]string{"commitment": solanaCommitmentFinalized})
+		if err != nil {
+			return fmt.Errorf("failed to get slot: %w", err)
+		}
+		return nil
+	})
+	return slot, err
+}
+
+// FetchSolanaSignatures returns the finalized signatures of the program after the signature until,
+// sorted from the oldest to the newest. When until is empty, only the latest signature is returned.
+func FetchSolanaSignatures(
+	ctx context.Context,
+	pool *pool.Pool,
+	program string,
+	until string,
+	limit int,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) ([]SolanaSignature, error) {
+	var signatures []SolanaSignature
+	err := callSolanaRpc(ctx, pool, metrics, logger, func(client *rateLimitedRpcClient) error {
+		signatures = nil
+		cfg := solanaSignaturesConfig{Limit: limit, Until: until, Commitment: solanaCommitmentFinalized}
+		if until == "" {
+			cfg.Limit = 1
+		}
+		// the signatures are returned from the newest to the oldest, so pages are requested backwards.
+		for {
+			var page []solanaSignatureInfo
+			if err := client.CallContext(ctx, &page, "getSignaturesForAddress", program, cfg); err != nil {
+				return fmt.Errorf("failed to get signatures for program: %w", err)
+			}
+			for _, s := range page {
+				signatures = append(signatures, SolanaSignature{Signature: s.Signature, Slot: s.Slot, Failed: s.Err != nil})
+			}
+			if until == "" || len(page) < cfg.Limit {
+				break
+			}
+			cfg.Before = page[len(page)-1].Signature
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(signatures)-1; i < j; i, j = i+1, j-1 {
+		signatures[i], signatures[j] = signatures[j], signatures[i]
+	}
+	return signatures, nil
+}
+
+// FetchSolanaRedeems returns the token bridge redeems of a transaction of the program.
+func FetchSolanaRedeems(
+	ctx context.Context,
+	pool *pool.Pool,
+	program string,
+	signature string,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) ([]SolanaRedeem, error) {
+	var redeems []SolanaRedeem
+	err := callSolanaRpc(ctx, pool, metrics, logger, func(client *rateLimitedRpcClient) error {
+		var tx solanaGetRawTransactionResponse
+		cfg := solanaGetTransactionRawConfig{
+			Encoding:                       "json",
+			MaxSupportedTransactionVersion: 0,
+			Commitment:                     solanaCommitmentFinalized,
+		}
+		if err := client.CallContext(ctx, &tx, "getTransaction", signature, cfg); err != nil {
+			return fmt.Errorf("failed to get tx by signature: %w", err)
+		}
+		if tx.Meta.Err != nil {
+			redeems = nil
+			return nil
+		}
+
+		vaaAccounts := findSolanaPostedVaaAccounts(&tx, program)
+		if len(vaaAccounts) == 0 {
+			redeems = nil
+			return nil
+		}
+
+		var accounts solanaGetMultipleAccountsResponse
+		err := client.CallContext(ctx, &accounts, "getMultipleAccounts", vaaAccounts,
+			map[string]string{"encoding": "base64", "commitment": solanaCommitmentFinalized})
+		if err != nil {
+			return fmt.Errorf("failed to get posted vaa accounts: %w", err)
+		}
+
+		var blockTimestamp *time.Time
+		if tx.BlockTime != 0 {
+			t := time.Unix(tx.BlockTime, 0).UTC()
+			blockTimestamp = &t
+		}
+		var from string
+		if len(tx.Transaction.Message.AccountKeys) > 0 {
+			// the first account is the fee payer, which is the first signer.
+			from = tx.Transaction.Message.AccountKeys[0]
+		}
+
+		redeems = make([]SolanaRedeem, 0, len(vaaAccounts))
+		for i, account := range accounts.Value {
+			if account == nil || len(account.Data) == 0 {
+				logger.Warn("Posted vaa account not found", zap.String("account", vaaAccounts[i]), zap.String("txHash", signature))
+				continue
+			}
+			data, err := base64.StdEncoding.DecodeString(account.Data[0])
+			if err != nil {
+				return fmt.Errorf("failed to decode posted vaa account: %w", err)
+			}
+			redeem, err := parseSolanaPostedVaa(data)
+			if err != nil {
+				logger.Warn("Skipping invalid posted vaa account", zap.String("account", vaaAccounts[i]), zap.String("txHash", signature), zap.Error(err))
+				continue
+			}
+			redeem.TxHash = signature
+			redeem.Slot = tx.Slot
+			redeem.BlockTimestamp = blockTimestamp
+			redeem.From = from
+			redeem.Fee = tx.Meta.Fee
+			redeems = append(redeems, *redeem)
+		}
+		return nil
+	})
+	return redeems, err
+}
+
+// findSolanaPostedVaaAccounts returns the posted vaa accounts of the complete transfer instructions of the program,
+// including the instructions invoked by other programs.
+func findSolanaPostedVaaAccounts(tx *solanaGetRawTransactionResponse, program string) []string {
+	// the accounts loaded from lookup tables follow the static accounts of the message.
+	keys := make([]string, 0, len(tx.Transaction.Message.AccountKeys))
+	keys = append(keys, tx.Transaction.Message.AccountKeys...)
+	keys = append(keys, tx.Meta.LoadedAddresses.Writable...)
+	keys = append(keys, tx.Meta.LoadedAddresses.Readonly...)
+
+	instructions := tx.Transaction.Message.Instructions
+	for _, inner := range tx.Meta.InnerInstructions {
+		instructions = append(instructions, inner.Instructions...)
+	}
+
+	var accounts []string
+	seen := make(map[string]bool)
+	for _, ix := range instructions {
+		if ix.ProgramIDIndex >= len(keys) || keys[ix.ProgramIDIndex] != program {
+			continue
+		}
+		data, err := base58.Decode(ix.Data)
+		if err != nil || len(data) == 0 || !solanaCompleteTransferInstructions[data[0]] {
+			continue
+		}
+		if len(ix.Accounts) <= solanaCompleteTransferVaaAccount || ix.Accounts[solanaCompleteTransferVaaAccount] >= len(keys) {
+			continue
+		}
+		account := keys[ix.Accounts[solanaCompleteTransferVaaAccount]]
+		if !seen[account] {
+			seen[account] = true
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts
+}
+
+// parseSolanaPostedVaa parses the data of a posted vaa account of the core bridge, which is borsh encoded:
+// magic [3]byte, version u8, consistency_level u8, vaa_time u32, vaa_signature_account [32]byte,
+// submission_time u32, nonce u32, sequence u64, emitter_chain u16, emitter_address [32]byte, payload Vec<u8>.
+func parseSolanaPostedVaa(data []byte) (*SolanaRedeem, error) {
+	const headerLen = 3 + 1 + 1 + 4 + 32 + 4 + 4 + 8 + 2 + 32 + 4
+	if len(data) < headerLen {
+		return nil, fmt.Errorf("posted vaa account too short: %d bytes", len(data))
+	}
+	if string(data[:3]) != solanaPostedVaaMagic {
+		return nil, errors.New("invalid posted vaa magic")
+	}
+
+	v := sdk.VAA{
+		Version:          data[3],
+		ConsistencyLevel: data[4],
+		Timestamp:        time.Unix(int64(binary.LittleEndian.Uint32(data[5:9])), 0),
+		Nonce:            binary.LittleEndian.Uint32(data[45:49]),
+		Sequence:         binary.LittleEndian.Uint64(data[49:57]),
+		EmitterChain:     sdk.ChainID(binary.LittleEndian.Uint16(data[57:59])),
+	}
+	copy(v.EmitterAddress[:], data[59:91])
+
+	payloadLen := int(binary.LittleEndian.Uint32(data[91:95]))
+	if len(data) < headerLen+payloadLen {
+		return nil, fmt.Errorf("invalid posted vaa payload length: %d", payloadLen)
+	}
+	v.Payload = data[headerLen : headerLen+payloadLen]
+
+	return &SolanaRedeem{
+		EmitterChain:   v.EmitterChain,
+		EmitterAddress: hex.EncodeToString(v.EmitterAddress[:]),
+		Sequence:       v.Sequence,
+		Digest:         utils.NormalizeHex(v.HexDigest()),
+	}, nil
+}
+
+// callSolanaRpc calls the rpcs of the pool, sorted by score and priority, until one of them succeeds.
+func callSolanaRpc(ctx context.Context, pool *pool.Pool, metrics metrics.TxTrackerMetrics, logger *zap.Logger, call func(client *rateLimitedRpcClient) error) error {
+	rpcs := pool.GetItems()
+	if len(rpcs) == 0 {
+		return ErrChainNotSupported
+	}
+
+	var err error
+	for _, rpc := range rpcs {
+		// Wait for the RPC rate limiter
+		start := waitRpc(ctx, &rpc, sdk.ChainIDSolana, metrics)
+		var client *rateLimitedRpcClient
+		client, err = rpcDialContext(ctx, rpc.Id)
+		if err != nil {
+			err = fmt.Errorf("failed to initialize RPC client: %w", err)
+		} else {
+			err = call(client)
+			client.Close()
+		}
+		observeRpcCall(&rpc, sdk.ChainIDSolana, start, err, metrics)
+		if err != nil {
+			metrics.IncCallRpcError(uint16(sdk.ChainIDSolana), rpc.Description)
+			logger.Debug("Failed to call solana node", zap.String("url", rpc.Id), zap.Error(err))
+			continue
+		}
+		metrics.IncCallRpcSuccess(uint16(sdk.ChainIDSolana), rpc.Description)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,77 @@
+package chains
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParseSolanaPostedVaa(t *testing.T) {
+	emitter, _ := hex.DecodeString("0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585")
+	payload := []byte{0x01, 0x02, 0x03}
+
+	data := []byte(solanaPostedVaaMagic)
+	data = append(data, 1, 15)
+	data = binary.LittleEndian.AppendUint32(data, 1700000000)
+	data = append(data, make([]byte, 32)...)
+	data = binary.LittleEndian.AppendUint32(data, 1700000010)
+	data = binary.LittleEndian.AppendUint32(data, 42)
+	data = binary.LittleEndian.AppendUint64(data, 123456)
+	data = binary.LittleEndian.AppendUint16(data, uint16(sdk.ChainIDEthereum))
+	data = append(data, emitter...)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(payload)))
+	data = append(data, payload...)
+
+	redeem, err := parseSolanaPostedVaa(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/123456", redeem.VaaID())
+
+	v := sdk.VAA{
+		Version:          1,
+		ConsistencyLevel: 15,
+		Timestamp:        time.Unix(1700000000, 0),
+		Nonce:            42,
+		Sequence:         123456,
+		EmitterChain:     sdk.ChainIDEthereum,
+		Payload:          payload,
+	}
+	copy(v.EmitterAddress[:], emitter)
+	assert.Equal(t, utils.NormalizeHex(v.HexDigest()), redeem.Digest)
+
+	_, err = parseSolanaPostedVaa(data[:len(data)-1])
+	assert.Error(t, err)
+
+	_, err = parseSolanaPostedVaa(append([]byte("msg"), data[3:]...))
+	assert.Error(t, err)
+}
+
+func TestFindSolanaPostedVaaAccounts(t *testing.T) {
+	var tx solanaGetRawTransactionResponse
+	tx.Transaction.Message.AccountKeys = []string{"payer", "config", "program", "other"}
+	tx.Meta.LoadedAddresses.Writable = []string{"vaa1", "vaa2"}
+	tx.Transaction.Message.Instructions = []solanaInstruction{
+		// complete native
+		{ProgramIDIndex: 2, Accounts: []int{0, 1, 4}, Data: base58.Encode([]byte{2, 0})},
+		// transfer native
+		{ProgramIDIndex: 2, Accounts: []int{0, 1, 5}, Data: base58.Encode([]byte{5, 0})},
+		// another program
+		{ProgramIDIndex: 3, Accounts: []int{0, 1, 5}, Data: base58.Encode([]byte{3, 0})},
+	}
+	tx.Meta.InnerInstructions = append(tx.Meta.InnerInstructions, struct {
+		Index        int                 `json:"index"`
+		Instructions []solanaInstruction `json:"instructions"`
+	}{
+		Index: 2,
+		// complete wrapped with payload invoked by another program
+		Instructions: []solanaInstruction{{ProgramIDIndex: 2, Accounts: []int{0, 1, 5}, Data: base58.Encode([]byte{10})}},
+	})
+
+	accounts := findSolanaPostedVaaAccounts(&tx, "program")
+	assert.Equal(t, []string{"vaa1", "vaa2"}, accounts)
+}
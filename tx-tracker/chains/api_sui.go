@@ -6,8 +6,12 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/shopspring/decimal"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
@@ -19,6 +23,16 @@ type suiGetTransactionBlockResponse struct {
 			Sender string `json:"sender"`
 		} `json:"data"`
 	} `json:"transaction"`
+	Effects *struct {
+		GasUsed suiGasCostSummary `json:"gasUsed"`
+	} `json:"effects"`
+}
+
+// suiGasCostSummary is the gas paid by a sui transaction, the costs are in MIST (1e-9 SUI).
+type suiGasCostSummary struct {
+	ComputationCost string `json:"computationCost"`
+	StorageCost     string `json:"storageCost"`
+	StorageRebate   string `json:"storageRebate"`
 }
 
 type suiGetTransactionBlockOpts struct {
@@ -30,11 +44,16 @@ type suiGetTransactionBlockOpts struct {
 	ShowBalanceChanges bool `json:"showBalanceChanges"`
 }
 
-func FetchSuiTx(
+type apiSui struct {
+	notionalCache *notional.NotionalCache
+	p2pNetwork    string
+}
+
+func (a *apiSui) FetchSuiTx(
 	ctx context.Context,
 	pool *pool.Pool,
 	txHash string,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
 	logger *zap.Logger,
 ) (*TxDetail, error) {
 
@@ -48,12 +67,18 @@ func FetchSuiTx(
 	var err error
 	for _, rpc := range rpcs {
 		// Wait for the RPC rate limiter
-		rpc.Wait(ctx)
-		txDetail, err = fetchSuiTx(ctx, rpc.Id, txHash)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDSui, metrics)
+		txDetail, err = fetchSuiTx(ctx, rpc.Id, txHash, logger)
+		observeRpcCall(&rpc, sdk.ChainIDSui, start, err, metrics)
 		if err != nil {
 			logger.Debug("Failed to fetch transaction from SUI node", zap.String("url", rpc.Id), zap.Error(err))
 			continue
 		}
+		if txDetail.FeeDetail != nil && a.p2pNetwork == domain.P2pMainNet {
+			if err := SetGasTokenNotional(sdk.ChainIDSui, txDetail.FeeDetail, a.notionalCache); err != nil {
+				logger.Error("Failed to get gas price", zap.Error(err), zap.String("chainId", sdk.ChainIDSui.String()), zap.String("txHash", txHash))
+			}
+		}
 		return txDetail, nil
 	}
 	return txDetail, err
@@ -63,6 +88,7 @@ func fetchSuiTx(
 	ctx context.Context,
 	baseUrl string,
 	txHash string,
+	logger *zap.Logger,
 ) (*TxDetail, error) {
 
 	// Initialize RPC client
@@ -76,7 +102,7 @@ func fetchSuiTx(
 	var reply suiGetTransactionBlockResponse
 	{
 		// Execute the remote procedure call
-		opts := suiGetTransactionBlockOpts{ShowInput: true}
+		opts := suiGetTransactionBlockOpts{ShowInput: true, ShowEffects: true}
 		err = client.CallContext(ctx, &reply, "sui_getTransactionBlock", txHash, opts)
 		if err != nil {
 			if strings.Contains(err.Error(), "Could not find the referenced transaction") {
@@ -91,5 +117,46 @@ func fetchSuiTx(
 		NativeTxHash: reply.Digest,
 		From:         reply.Transaction.Data.Sender,
 	}
+	if reply.Effects != nil {
+		feeDetail, err := suiFeeDetail(&reply.Effects.GasUsed)
+		if err != nil {
+			logger.Warn("Failed to calculate fee of SUI transaction", zap.String("txHash", txHash), zap.Error(err))
+		}
+		txDetail.FeeDetail = feeDetail
+	}
 	return &txDetail, nil
 }
+
+// suiFeeDetail returns the fee of a sui transaction.
+func suiFeeDetail(gas *suiGasCostSummary) (*FeeDetail, error) {
+	fee, err := SuiCalculateFee(gas.ComputationCost, gas.StorageCost, gas.StorageRebate)
+	if err != nil {
+		return nil, err
+	}
+	return &FeeDetail{
+		RawFee: map[string]string{
+			"computationCost": gas.ComputationCost,
+			"storageCost":     gas.StorageCost,
+			"storageRebate":   gas.StorageRebate,
+		},
+		Fee: fee.String(),
+	}, nil
+}
+
+// SuiCalculateFee calculates the fee in SUI of a transaction: computation cost + storage cost - storage rebate.
+//
+// The fee is negative when the transaction frees more storage than it uses.
+func SuiCalculateFee(computationCost, storageCost, storageRebate string) (decimal.Decimal, error) {
+	var mist decimal.Decimal
+	for i, cost := range []string{computationCost, storageCost, storageRebate} {
+		d, err := decimal.NewFromString(cost)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("invalid gas cost %q: %w", cost, err)
+		}
+		if i == 2 {
+			d = d.Neg()
+		}
+		mist = mist.Add(d)
+	}
+	return mist.DivRound(decimal.NewFromInt(1e9), 9), nil
+}
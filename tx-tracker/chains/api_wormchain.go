@@ -8,8 +8,8 @@ import (
 	"strings"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -61,6 +61,7 @@ type event struct {
 type packetData struct {
 	Sender   string `json:"sender"`
 	Receiver string `json:"receiver"`
+	Memo     string `json:"memo"`
 }
 
 type logWrapper struct {
@@ -152,21 +153,14 @@ type osmosisResponse struct {
 			Height   string `json:"height"`
 			Index    int    `json:"index"`
 			TxResult struct {
-				Code      int    `json:"code"`
-				Data      string `json:"data"`
-				Log       string `json:"log"`
-				Info      string `json:"info"`
-				GasWanted string `json:"gas_wanted"`
-				GasUsed   string `json:"gas_used"`
-				Events    []struct {
-					Type       string `json:"type"`
-					Attributes []struct {
-						Key   string `json:"key"`
-						Value string `json:"value"`
-						Index bool   `json:"index"`
-					} `json:"attributes"`
-				} `json:"events"`
-				Codespace string `json:"codespace"`
+				Code      int     `json:"code"`
+				Data      string  `json:"data"`
+				Log       string  `json:"log"`
+				Info      string  `json:"info"`
+				GasWanted string  `json:"gas_wanted"`
+				GasUsed   string  `json:"gas_used"`
+				Events    []event `json:"events"`
+				Codespace string  `json:"codespace"`
 			} `json:"tx_result"`
 			Tx string `json:"tx"`
 		} `json:"txs"`
@@ -175,10 +169,11 @@ type osmosisResponse struct {
 }
 
 type osmosisTx struct {
-	txHash string
+	txHash        string
+	forwardedFrom *ibcHop
 }
 
-func (a *apiWormchain) fetchOsmosisDetail(ctx context.Context, pool *pool.Pool, sequence, timestamp, srcChannel, dstChannel string, metrics metrics.Metrics) (*osmosisTx, error) {
+func (a *apiWormchain) fetchOsmosisDetail(ctx context.Context, pool *pool.Pool, sequence, timestamp, srcChannel, dstChannel string, metrics metrics.TxTrackerMetrics) (*osmosisTx, error) {
 	if pool == nil {
 		return nil, fmt.Errorf("osmosis rpc pool not found")
 	}
@@ -189,8 +184,9 @@ func (a *apiWormchain) fetchOsmosisDetail(ctx context.Context, pool *pool.Pool,
 	}
 
 	for _, rpc := range osmosisRpcs {
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDOsmosis, metrics)
 		osmosisTx, err := fetchOsmosisDetail(ctx, rpc.Id, sequence, timestamp, srcChannel, dstChannel)
+		observeRpcCall(&rpc, sdk.ChainIDOsmosis, start, err, metrics)
 		if osmosisTx != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDOsmosis), rpc.Description)
 			return osmosisTx, nil
@@ -233,7 +229,12 @@ func fetchOsmosisDetail(ctx context.Context, baseUrl string, sequence, timestamp
 	if len(oReponse.Result.Txs) == 0 {
 		return nil, fmt.Errorf("can not found hash for sequence %s, timestamp %s, srcChannel %s, dstChannel %s", sequence, timestamp, srcChannel, dstChannel)
 	}
-	return &osmosisTx{txHash: strings.ToLower(oReponse.Result.Txs[0].Hash)}, nil
+	tx := oReponse.Result.Txs[0]
+	forwardedFrom, err := findForwardedPacket(tx.TxResult.Log, tx.TxResult.Events, srcChannel)
+	if err != nil {
+		return nil, err
+	}
+	return &osmosisTx{txHash: strings.ToLower(tx.Hash), forwardedFrom: forwardedFrom}, nil
 }
 
 type evmosRequest struct {
@@ -281,7 +282,7 @@ type evmosTx struct {
 	txHash string
 }
 
-func (a *apiWormchain) fetchEvmosDetail(ctx context.Context, pool *pool.Pool, sequence, timestamp, srcChannel, dstChannel string, metrics metrics.Metrics) (*evmosTx, error) {
+func (a *apiWormchain) fetchEvmosDetail(ctx context.Context, pool *pool.Pool, sequence, timestamp, srcChannel, dstChannel string, metrics metrics.TxTrackerMetrics) (*evmosTx, error) {
 	if pool == nil {
 		return nil, fmt.Errorf("evmos rpc pool not found")
 	}
@@ -291,8 +292,9 @@ func (a *apiWormchain) fetchEvmosDetail(ctx context.Context, pool *pool.Pool, se
 	}
 
 	for _, rpc := range evmosRpcs {
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDEvmos, metrics)
 		evmosTx, err := fetchEvmosDetail(ctx, rpc.Id, sequence, timestamp, srcChannel, dstChannel)
+		observeRpcCall(&rpc, sdk.ChainIDEvmos, start, err, metrics)
 		if evmosTx != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDEvmos), rpc.Description)
 			return evmosTx, nil
@@ -358,21 +360,14 @@ type kujiraResponse struct {
 			Height   string `json:"height"`
 			Index    int    `json:"index"`
 			TxResult struct {
-				Code      int    `json:"code"`
-				Data      string `json:"data"`
-				Log       string `json:"log"`
-				Info      string `json:"info"`
-				GasWanted string `json:"gas_wanted"`
-				GasUsed   string `json:"gas_used"`
-				Events    []struct {
-					Type       string `json:"type"`
-					Attributes []struct {
-						Key   string `json:"key"`
-						Value string `json:"value"`
-						Index bool   `json:"index"`
-					} `json:"attributes"`
-				} `json:"events"`
-				Codespace string `json:"codespace"`
+				Code      int     `json:"code"`
+				Data      string  `json:"data"`
+				Log       string  `json:"log"`
+				Info      string  `json:"info"`
+				GasWanted string  `json:"gas_wanted"`
+				GasUsed   string  `json:"gas_used"`
+				Events    []event `json:"events"`
+				Codespace string  `json:"codespace"`
 			} `json:"tx_result"`
 			Tx string `json:"tx"`
 		} `json:"txs"`
@@ -381,10 +376,11 @@ type kujiraResponse struct {
 }
 
 type kujiraTx struct {
-	txHash string
+	txHash        string
+	forwardedFrom *ibcHop
 }
 
-func (a *apiWormchain) fetchKujiraDetail(ctx context.Context, pool *pool.Pool, sequence, timestamp, srcChannel, dstChannel string, metrics metrics.Metrics) (*kujiraTx, error) {
+func (a *apiWormchain) fetchKujiraDetail(ctx context.Context, pool *pool.Pool, sequence, timestamp, srcChannel, dstChannel string, metrics metrics.TxTrackerMetrics) (*kujiraTx, error) {
 	if pool == nil {
 		return nil, fmt.Errorf("kujira rpc pool not found")
 	}
@@ -393,8 +389,9 @@ func (a *apiWormchain) fetchKujiraDetail(ctx context.Context, pool *pool.Pool, s
 		return nil, fmt.Errorf("kujira rpcs not found")
 	}
 	for _, rpc := range kujiraRpcs {
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDKujira, metrics)
 		kujiraTx, err := fetchKujiraDetail(ctx, rpc.Id, sequence, timestamp, srcChannel, dstChannel)
+		observeRpcCall(&rpc, sdk.ChainIDKujira, start, err, metrics)
 		if kujiraTx != nil {
 			metrics.IncCallRpcSuccess(uint16(sdk.ChainIDKujira), rpc.Description)
 			return kujiraTx, nil
@@ -436,7 +433,12 @@ func fetchKujiraDetail(ctx context.Context, baseUrl string, sequence, timestamp,
 	if len(kReponse.Result.Txs) == 0 {
 		return nil, fmt.Errorf("can not found hash for sequence %s, timestamp %s, srcChannel %s, dstChannel %s", sequence, timestamp, srcChannel, dstChannel)
 	}
-	return &kujiraTx{txHash: strings.ToLower(kReponse.Result.Txs[0].Hash)}, nil
+	tx := kReponse.Result.Txs[0]
+	forwardedFrom, err := findForwardedPacket(tx.TxResult.Log, tx.TxResult.Events, srcChannel)
+	if err != nil {
+		return nil, err
+	}
+	return &kujiraTx{txHash: strings.ToLower(tx.Hash), forwardedFrom: forwardedFrom}, nil
 }
 
 type injectiveRequest struct {
@@ -484,7 +486,7 @@ type injectiveTx struct {
 	txHash string
 }
 
-func (a *apiWormchain) fetchInjectiveDetail(ctx context.Context, pool *pool.Pool, sequence, timestamp, srcChannel, dstChannel string, metrics metrics.Metrics) (*injectiveTx, error) {
+func (a *apiWormchain) fetchInjectiveDetail(ctx context.Context, pool *pool.Pool, sequence, timestamp, srcChannel, dstChannel string, metrics metrics.TxTrackerMetrics) (*injectiveTx, error) {
 	if pool == nil {
 		return nil, fmt.Errorf("injective rpc pool not found")
 	}
@@ -493,8 +495,9 @@ func (a *apiWormchain) fetchInjectiveDetail(ctx context.Context, pool *pool.Pool
 		return nil, fmt.Errorf("injective rpcs not found")
 	}
 	for _, rpc := range injectiveRpcs {
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDInjective, metrics)
 		injectiveTx, err := fetchInjectiveDetail(ctx, rpc.Id, sequence, timestamp, srcChannel, dstChannel)
+		observeRpcCall(&rpc, sdk.ChainIDInjective, start, err, metrics)
 		if injectiveTx != nil {
 			success := fmt.Sprintf("Successfully fetched transaction from injective: %s", rpc.Id)
 			fmt.Sprintln(success)
@@ -547,13 +550,18 @@ type WorchainAttributeTxDetail struct {
 	OriginChainID sdk.ChainID `bson:"originChainId"`
 	OriginTxHash  string      `bson:"originTxHash"`
 	OriginAddress string      `bson:"originAddress"`
+	// The forward fields are set when the transfer hopped over an intermediate chain
+	// (e.g. osmosis or kujira) before reaching wormchain.
+	ForwardChainID sdk.ChainID `bson:"forwardChainId,omitempty"`
+	ForwardTxHash  string      `bson:"forwardTxHash,omitempty"`
+	ForwardAddress string      `bson:"forwardAddress,omitempty"`
 }
 
 func (a *apiWormchain) FetchWormchainTx(
 	ctx context.Context,
 	wormchainPool *pool.Pool,
 	txHash string,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
 	logger *zap.Logger,
 ) (*TxDetail, error) {
 
@@ -569,8 +577,9 @@ func (a *apiWormchain) FetchWormchainTx(
 	var err error
 	for _, rpc := range wormchainRpcs {
 		// wait for the rpc to be available
-		rpc.Wait(ctx)
+		start := waitRpc(ctx, &rpc, sdk.ChainIDWormchain, metrics)
 		wormchainTx, err = fetchWormchainDetail(ctx, rpc.Id, txHash)
+		observeRpcCall(&rpc, sdk.ChainIDWormchain, start, err, metrics)
 		if err != nil {
 			metrics.IncCallRpcError(uint16(sdk.ChainIDWormchain), rpc.Description)
 			logger.Debug("Failed to fetch transaction from wormchain", zap.String("url", rpc.Id), zap.Error(err))
@@ -594,16 +603,21 @@ func (a *apiWormchain) FetchWormchainTx(
 			return nil, err
 		}
 
+		attribute := &WorchainAttributeTxDetail{
+			OriginChainID: sdk.ChainIDOsmosis,
+			OriginTxHash:  osmosisTx.txHash,
+			OriginAddress: wormchainTx.sender,
+		}
+		if osmosisTx.forwardedFrom != nil {
+			attribute = a.resolveForwardedOrigin(ctx, attribute, osmosisTx.forwardedFrom, metrics, logger)
+		}
+
 		return &TxDetail{
 			NativeTxHash: txHash,
 			From:         wormchainTx.receiver,
 			Attribute: &AttributeTxDetail{
-				Type: "wormchain-gateway",
-				Value: &WorchainAttributeTxDetail{
-					OriginChainID: sdk.ChainIDOsmosis,
-					OriginTxHash:  osmosisTx.txHash,
-					OriginAddress: wormchainTx.sender,
-				},
+				Type:  "wormchain-gateway",
+				Value: attribute,
 			},
 		}, nil
 	}
@@ -615,16 +629,21 @@ func (a *apiWormchain) FetchWormchainTx(
 			return nil, err
 		}
 
+		attribute := &WorchainAttributeTxDetail{
+			OriginChainID: sdk.ChainIDKujira,
+			OriginTxHash:  kujiraTx.txHash,
+			OriginAddress: wormchainTx.sender,
+		}
+		if kujiraTx.forwardedFrom != nil {
+			attribute = a.resolveForwardedOrigin(ctx, attribute, kujiraTx.forwardedFrom, metrics, logger)
+		}
+
 		return &TxDetail{
 			NativeTxHash: txHash,
 			From:         wormchainTx.receiver,
 			Attribute: &AttributeTxDetail{
-				Type: "wormchain-gateway",
-				Value: &WorchainAttributeTxDetail{
-					OriginChainID: sdk.ChainIDKujira,
-					OriginTxHash:  kujiraTx.txHash,
-					OriginAddress: wormchainTx.sender,
-				},
+				Type:  "wormchain-gateway",
+				Value: attribute,
 			},
 		}, nil
 	}
@@ -684,6 +703,152 @@ func (a *apiWormchain) FetchWormchainTx(
 	}, nil
 }
 
+// ibcHop is an inbound IBC transfer received in the same transaction that sent the packet to wormchain,
+// which happens when the transfer is forwarded by the packet-forward-middleware or ibc-hooks of the
+// intermediate chain.
+type ibcHop struct {
+	srcChannel, dstChannel, sender, timestamp, sequence string
+}
+
+// findForwardedPacket looks for an inbound IBC transfer forwarded to srcChannel in the events of a transaction.
+// The events are read from the transaction log and, if the log is empty, from the transaction result events.
+func findForwardedPacket(txLog string, txEvents []event, srcChannel string) (*ibcHop, error) {
+	events := txEvents
+	if txLog != "" {
+		var log []logWrapper
+		if err := json.Unmarshal([]byte(txLog), &log); err == nil {
+			events = nil
+			for _, l := range log {
+				events = append(events, l.Events...)
+			}
+		}
+	}
+
+	for _, e := range events {
+		if e.Type != "recv_packet" {
+			continue
+		}
+		var hop ibcHop
+		var pd packetData
+		for _, attr := range e.Attributes {
+			switch attr.Key {
+			case "packet_src_channel":
+				hop.srcChannel = attr.Value
+			case "packet_dst_channel":
+				hop.dstChannel = attr.Value
+			case "packet_timeout_timestamp":
+				hop.timestamp = attr.Value
+			case "packet_sequence":
+				hop.sequence = attr.Value
+			case "packet_data":
+				if err := json.Unmarshal([]byte(attr.Value), &pd); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if isForwardMemo(pd.Memo, srcChannel) {
+			hop.sender = pd.Sender
+			return &hop, nil
+		}
+	}
+	return nil, nil
+}
+
+// isForwardMemo checks if the memo of an inbound transfer forwards it to channel, either through
+// the packet-forward-middleware or through an ibc-hooks contract call.
+func isForwardMemo(memo, channel string) bool {
+	if memo == "" {
+		return false
+	}
+	var m struct {
+		Forward *struct {
+			Channel string `json:"channel"`
+		} `json:"forward"`
+		Wasm json.RawMessage `json:"wasm"`
+	}
+	if err := json.Unmarshal([]byte(memo), &m); err != nil {
+		return false
+	}
+	if m.Forward != nil {
+		return m.Forward.Channel == channel
+	}
+	return m.Wasm != nil
+}
+
+// bech32Chains maps the bech32 prefix of the addresses of the cosmos chains to their chainID.
+var bech32Chains = map[string]sdk.ChainID{
+	"osmo":     sdk.ChainIDOsmosis,
+	"kujira":   sdk.ChainIDKujira,
+	"evmos":    sdk.ChainIDEvmos,
+	"inj":      sdk.ChainIDInjective,
+	"sei":      sdk.ChainIDSei,
+	"cosmos":   sdk.ChainIDCosmoshub,
+	"celestia": sdk.ChainIDCelestia,
+	"neutron":  sdk.ChainIDNeutron,
+	"stars":    sdk.ChainIDStargaze,
+	"dym":      sdk.ChainIDDymension,
+	"pb":       sdk.ChainIDProvenance,
+	"seda":     sdk.ChainIDSeda,
+}
+
+// chainIDFromBech32 gets the chainID of a cosmos address from its bech32 prefix.
+func chainIDFromBech32(address string) (sdk.ChainID, bool) {
+	i := strings.LastIndex(address, "1")
+	if i <= 0 {
+		return sdk.ChainIDUnset, false
+	}
+	chainID, ok := bech32Chains[address[:i]]
+	return chainID, ok
+}
+
+// resolveForwardedOrigin attributes a transfer forwarded by an intermediate chain to the chain and address
+// of the original sender. When the original chain is osmosis or kujira, the original transaction is also fetched.
+func (a *apiWormchain) resolveForwardedOrigin(
+	ctx context.Context,
+	forward *WorchainAttributeTxDetail,
+	hop *ibcHop,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) *WorchainAttributeTxDetail {
+
+	originChainID, ok := chainIDFromBech32(hop.sender)
+	if !ok {
+		metrics.IncWormchainUnknown(hop.srcChannel, hop.dstChannel)
+		logger.Debug("Unknown origin chain of forwarded wormchain transaction",
+			zap.String("sender", hop.sender),
+			zap.String("srcChannel", hop.srcChannel),
+			zap.String("dstChannel", hop.dstChannel))
+		return forward
+	}
+
+	attribute := &WorchainAttributeTxDetail{
+		OriginChainID:  originChainID,
+		OriginAddress:  hop.sender,
+		ForwardChainID: forward.OriginChainID,
+		ForwardTxHash:  forward.OriginTxHash,
+		ForwardAddress: forward.OriginAddress,
+	}
+
+	switch originChainID {
+	case sdk.ChainIDOsmosis:
+		osmosisTx, err := a.fetchOsmosisDetail(ctx, a.osmosisPool, hop.sequence, hop.timestamp, hop.srcChannel, hop.dstChannel, metrics)
+		if err != nil {
+			logger.Debug("Failed to fetch forwarded transaction from osmosis", zap.Error(err))
+			break
+		}
+		attribute.OriginTxHash = osmosisTx.txHash
+	case sdk.ChainIDKujira:
+		kujiraTx, err := a.fetchKujiraDetail(ctx, a.kujiraPool, hop.sequence, hop.timestamp, hop.srcChannel, hop.dstChannel, metrics)
+		if err != nil {
+			logger.Debug("Failed to fetch forwarded transaction from kujira", zap.Error(err))
+			break
+		}
+		attribute.OriginTxHash = kujiraTx.txHash
+	}
+
+	return attribute
+}
+
 func (a *apiWormchain) isOsmosisTx(tx *wormchainTx) bool {
 	if a.p2pNetwork == domain.P2pMainNet {
 		return tx.srcChannel == "channel-2186" && tx.dstChannel == "channel-3"
@@ -0,0 +1,50 @@
+package chains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const osmosisForwardLog = `[{"events":[{"type":"recv_packet","attributes":[{"key":"packet_data","value":"{\"amount\":\"1000000\",\"denom\":\"ukuji\",\"memo\":\"{\\\"forward\\\":{\\\"receiver\\\":\\\"wormhole14ejqjyq8um4p3xfqj74yld5waqljf88fz25yxnma0cngspxe3les00fpjx\\\",\\\"port\\\":\\\"transfer\\\",\\\"channel\\\":\\\"channel-2186\\\"}}\",\"receiver\":\"pfm\",\"sender\":\"kujira1xq0ym9l2lsu3hx8k0g2hkyv5hlk5ja6d6amfsw\"}"},{"key":"packet_timeout_timestamp","value":"1718207100000000000"},{"key":"packet_sequence","value":"21012"},{"key":"packet_src_port","value":"transfer"},{"key":"packet_src_channel","value":"channel-3"},{"key":"packet_dst_port","value":"transfer"},{"key":"packet_dst_channel","value":"channel-259"}]},{"type":"send_packet","attributes":[{"key":"packet_sequence","value":"5321"},{"key":"packet_src_channel","value":"channel-2186"},{"key":"packet_dst_channel","value":"channel-3"}]}]}]`
+
+func Test_findForwardedPacket(t *testing.T) {
+
+	hop, err := findForwardedPacket(osmosisForwardLog, nil, "channel-2186")
+	assert.NoError(t, err)
+	assert.NotNil(t, hop)
+	assert.Equal(t, "kujira1xq0ym9l2lsu3hx8k0g2hkyv5hlk5ja6d6amfsw", hop.sender)
+	assert.Equal(t, "channel-3", hop.srcChannel)
+	assert.Equal(t, "channel-259", hop.dstChannel)
+	assert.Equal(t, "21012", hop.sequence)
+	assert.Equal(t, "1718207100000000000", hop.timestamp)
+
+	// the inbound transfer is forwarded to another channel.
+	hop, err = findForwardedPacket(osmosisForwardLog, nil, "channel-1")
+	assert.NoError(t, err)
+	assert.Nil(t, hop)
+}
+
+func Test_findForwardedPacket_NotForwarded(t *testing.T) {
+	log := `[{"events":[{"type":"send_packet","attributes":[{"key":"packet_sequence","value":"5321"},{"key":"packet_src_channel","value":"channel-2186"}]}]}]`
+	hop, err := findForwardedPacket(log, nil, "channel-2186")
+	assert.NoError(t, err)
+	assert.Nil(t, hop)
+}
+
+func Test_chainIDFromBech32(t *testing.T) {
+	chainID, ok := chainIDFromBech32("kujira1xq0ym9l2lsu3hx8k0g2hkyv5hlk5ja6d6amfsw")
+	assert.True(t, ok)
+	assert.Equal(t, sdk.ChainIDKujira, chainID)
+
+	chainID, ok = chainIDFromBech32("osmo1xq0ym9l2lsu3hx8k0g2hkyv5hlk5ja6dc8pk3y")
+	assert.True(t, ok)
+	assert.Equal(t, sdk.ChainIDOsmosis, chainID)
+
+	_, ok = chainIDFromBech32("unknown1xq0ym9l2lsu3hx8k0g2hkyv5hlk5ja6d6amfsw")
+	assert.False(t, ok)
+
+	_, ok = chainIDFromBech32("0x8d32a4c0e1b2d2a1")
+	assert.False(t, ok)
+}
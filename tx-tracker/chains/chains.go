@@ -7,15 +7,17 @@ import (
 	"time"
 
 	notional "github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
 
 var (
 	ErrChainNotSupported   = errors.New("chain id not supported")
+	ErrUnknownChain        = errors.New("chain id not known by the wormhole sdk")
 	ErrTransactionNotFound = errors.New("transaction not found")
 )
 
@@ -28,6 +30,12 @@ type TxDetail struct {
 	Attribute *AttributeTxDetail
 	// FeeDetail contains the fee of the transactions.
 	FeeDetail *FeeDetail
+	// BlockNumber is the block (or slot/height) that included the transaction, zero when unknown.
+	BlockNumber uint64
+	// BlockHash is the hash of the block that included the transaction, only set for evm chains.
+	BlockHash string
+	// Finality is the finality of the block when the transaction was fetched, empty when unknown.
+	Finality domain.TxFinality
 }
 
 type FeeDetail struct {
@@ -50,12 +58,12 @@ func FetchTx(
 	txHash string,
 	timestamp *time.Time,
 	p2pNetwork string,
-	m metrics.Metrics,
+	m metrics.TxTrackerMetrics,
 	logger *zap.Logger,
 	notionalCache *notional.NotionalCache,
 ) (*TxDetail, error) {
 	// Decide which RPC/API service to use based on chain ID
-	var fetchFunc func(ctx context.Context, pool *pool.Pool, txHash string, metrics metrics.Metrics, logger *zap.Logger) (*TxDetail, error)
+	var fetchFunc func(ctx context.Context, pool *pool.Pool, txHash string, metrics metrics.TxTrackerMetrics, logger *zap.Logger) (*TxDetail, error)
 	switch chainId {
 	case sdk.ChainIDSolana:
 		apiSolana := &apiSolana{
@@ -67,9 +75,17 @@ func FetchTx(
 	case sdk.ChainIDAlgorand:
 		fetchFunc = FetchAlgorandTx
 	case sdk.ChainIDAptos:
-		fetchFunc = FetchAptosTx
+		apiAptos := &apiAptos{
+			notionalCache: notionalCache,
+			p2pNetwork:    p2pNetwork,
+		}
+		fetchFunc = apiAptos.FetchAptosTx
 	case sdk.ChainIDSui:
-		fetchFunc = FetchSuiTx
+		apiSui := &apiSui{
+			notionalCache: notionalCache,
+			p2pNetwork:    p2pNetwork,
+		}
+		fetchFunc = apiSui.FetchSuiTx
 	case sdk.ChainIDInjective,
 		sdk.ChainIDTerra,
 		sdk.ChainIDTerra2,
@@ -124,6 +140,9 @@ func FetchTx(
 		}
 		fetchFunc = apiSei.FetchSeiTx
 	default:
+		if !domain.ChainIdIsValid(chainId) {
+			return nil, ErrUnknownChain
+		}
 		return nil, ErrChainNotSupported
 	}
 
@@ -0,0 +1,36 @@
+package chains
+
+import (
+	"context"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// statuses of the calls to the rpc providers.
+const (
+	rpcCallStatusSuccess = "success"
+	rpcCallStatusError   = "error"
+)
+
+// waitRpc waits for the rate limiter of a rpc provider and returns the time the call starts.
+// The calls delayed by the rate limiter are counted as rate limited.
+func waitRpc(ctx context.Context, rpc *pool.Item, chainID sdk.ChainID, metrics metrics.TxTrackerMetrics) time.Time {
+	if rpc.IsRateLimited() {
+		metrics.IncRateLimited(uint16(chainID), rpc.Description)
+	}
+	rpc.Wait(ctx)
+	return time.Now()
+}
+
+// observeRpcCall records the status and the latency of a call to a rpc provider.
+func observeRpcCall(rpc *pool.Item, chainID sdk.ChainID, start time.Time, err error, metrics metrics.TxTrackerMetrics) {
+	status := rpcCallStatusSuccess
+	if err != nil {
+		status = rpcCallStatusError
+	}
+	metrics.IncRpcCall(uint16(chainID), rpc.Description, status)
+	metrics.ObserveRpcLatency(uint16(chainID), rpc.Description, time.Since(start).Seconds())
+}
@@ -10,9 +10,11 @@ import (
 	"strings"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/httpclient"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/shopspring/decimal"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -25,9 +27,8 @@ func httpGet(ctx context.Context, url string) ([]byte, error) {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Send it
-	var client http.Client
-	response, err := client.Do(request)
+	// Send it with the shared client, so the connections to the apis are reused
+	response, err := httpclient.Default().Do(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query url: %w", err)
 	}
@@ -60,9 +61,8 @@ func httpPost(ctx context.Context, url string, body any) ([]byte, error) {
 	}
 	request.Header.Set("Content-Type", "application/json")
 
-	// Send it
-	var client http.Client
-	response, err := client.Do(request)
+	// Send it with the shared client, so the connections to the apis are reused
+	response, err := httpclient.Default().Do(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query url: %w", err)
 	}
@@ -85,7 +85,8 @@ type rateLimitedRpcClient struct {
 
 func rpcDialContext(ctx context.Context, url string) (*rateLimitedRpcClient, error) {
 
-	client, err := rpc.DialContext(ctx, url)
+	// Dial with the shared client, so the connections to the nodes are reused
+	client, err := rpc.DialOptions(ctx, url, rpc.WithHTTPClient(httpclient.Default()))
 	if err != nil {
 		return nil, err
 	}
@@ -157,3 +158,21 @@ func GetGasTokenNotional(chainID sdk.ChainID, notionalCache *notional.NotionalCa
 	}
 	return notionalCache.Get(nativeToken.GetTokenID())
 }
+
+// SetGasTokenNotional sets the price of the gas token and the fee in USD of a transaction.
+func SetGasTokenNotional(chainID sdk.ChainID, feeDetail *FeeDetail, notionalCache *notional.NotionalCache) error {
+	if feeDetail == nil || feeDetail.Fee == "" {
+		return nil
+	}
+	gasPrice, err := GetGasTokenNotional(chainID, notionalCache)
+	if err != nil {
+		return err
+	}
+	fee, err := decimal.NewFromString(feeDetail.Fee)
+	if err != nil {
+		return err
+	}
+	feeDetail.GasTokenNotional = gasPrice.NotionalUsd.String()
+	feeDetail.FeeUSD = gasPrice.NotionalUsd.Mul(fee).String()
+	return nil
+}
@@ -12,12 +12,12 @@ import (
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/config"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/ratelimit"
 	"go.uber.org/zap"
@@ -209,7 +209,7 @@ func getVaas(ctx context.Context, logger *zap.Logger, pagination repository.Pagi
 
 func processVaa(ctx context.Context, params *vaasBackfillerParams, cache *notional.NotionalCache) {
 	// Main loop: fetch global txs and process them
-	metrics := metrics.NewDummyMetrics()
+	metrics := metrics.NewNoopTxTrackerMetrics()
 	defer params.wg.Done()
 	for {
 		select {
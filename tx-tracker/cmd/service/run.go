@@ -3,8 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/go-redis/redis/v8"
+	"github.com/wormhole-foundation/wormhole-explorer/common/audit"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/payload"
 	"log"
 	"os"
 	"os/signal"
@@ -16,19 +19,27 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"go.mongodb.org/mongo-driver/mongo"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/httpclient"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
 	"github.com/wormhole-foundation/wormhole-explorer/common/configuration"
 	"github.com/wormhole-foundation/wormhole-explorer/common/dbutil"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	"github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/common/logger"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/common/reload"
+	"github.com/wormhole-foundation/wormhole-explorer/common/secrets"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/config"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/http/infrastructure"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/http/vaa"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
+	txtrackerAlert "github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/alert"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/queue"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/rpc"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/watcher"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -50,11 +61,42 @@ func Run() {
 
 	logger.Info("Starting wormhole-explorer-tx-tracker ...")
 
+	// resolve the settings set with a secret reference
+	secretsResolver, err := newSecretsResolver(rootCtx, cfg, logger)
+	if err != nil {
+		logger.Fatal("Failed to initialize secrets", zap.Error(err))
+	}
+	if err := secretsResolver.ResolveAll(rootCtx, &cfg.MongodbUri, &cfg.AlertApiKey); err != nil {
+		logger.Fatal("Failed to resolve secrets", zap.Error(err))
+	}
+	if cfg.SecretsRotationInterval > 0 {
+		// the clients are created with the secrets at startup, so the service is stopped to start again with the new secrets.
+		secretsResolver.OnRotate(func(ref secrets.Reference) {
+			logger.Warn("Secret rotated, stopping the service to use the new secret", zap.String("ref", ref.String()))
+			rootCtxCancel()
+		})
+		go secretsResolver.Start(rootCtx, cfg.SecretsRotationInterval)
+	}
+
+	// configure the http client shared by the requests to the chain apis
+	httpClient, err := httpclient.New(httpclient.Config{
+		Timeout:   cfg.HttpTimeout,
+		ProxyURL:  cfg.HttpProxyUrl,
+		UserAgent: cfg.HttpUserAgent,
+	})
+	if err != nil {
+		logger.Fatal("Failed to create http client", zap.Error(err))
+	}
+	httpclient.SetDefault(httpClient)
+
 	// create rpc pool
-	rpcPool, wormchainRpcPool, err := newRpcPool(cfg)
+	rpcPool, wormchainRpcPool, err := newRpcPool(rootCtx, cfg, secretsResolver)
 	if err != nil {
 		logger.Fatal("Failed to initialize rpc pool: ", zap.Error(err))
 	}
+	if err := startRpcProviderWatcher(rootCtx, cfg, secretsResolver, rpcPool, wormchainRpcPool, logger); err != nil {
+		logger.Fatal("Failed to start rpc provider watcher", zap.Error(err))
+	}
 
 	// initialize the database client
 	db, err := dbutil.Connect(rootCtx, logger, cfg.MongodbUri, cfg.MongodbDatabase, false)
@@ -79,24 +121,66 @@ func Run() {
 	// create controller
 	vaaController := vaa.NewController(rpcPool, wormchainRpcPool, vaaRepository, repository, cfg.P2pNetwork, logger, notionalCache)
 
+	// create the backoffs applied when getting messages from the sqs queues fails
+	vaaBackoff := newSqsBackoff(cfg.PipelineSqsUrl, metrics)
+	notificationBackoff := newSqsBackoff(cfg.NotificationsSqsUrl, metrics)
+
 	// start serving /health and /ready endpoints
 	healthChecks, err := makeHealthChecks(rootCtx, cfg, db.Database)
 	if err != nil {
 		logger.Fatal("Failed to create health checks", zap.Error(err))
 	}
-	server := infrastructure.NewServer(logger, cfg.MonitoringPort, cfg.PprofEnabled, vaaController, healthChecks...)
+	healthChecks = append(healthChecks, vaaBackoff.HealthCheck(), notificationBackoff.HealthCheck())
+	// create a tracker for chains not known by the wormhole sdk
+	unknownChains := domain.NewUnknownChainTracker()
+
+	auditor := audit.NewAuditor(audit.NewRepository(db.Database), "wormscan-tx-tracker", logger)
+	server := infrastructure.NewServer(logger, cfg.MonitoringPort, cfg.PprofEnabled, vaaController, unknownChains, auditor, cfg.AdminApiKey, healthChecks...)
 	server.Start()
 
+	// start serving the internal gRPC service used to resolve origin txs on demand.
+	var grpcServer *rpc.Server
+	if cfg.GrpcPort != "" {
+		rpcHandler := rpc.NewHandler(rpcPool, wormchainRpcPool, cfg.P2pNetwork, notionalCache, metrics, cfg.ResolveTxCacheExpiration, logger)
+		grpcServer, err = rpc.NewServer(rpcHandler, logger, cfg.GrpcPort)
+		if err != nil {
+			logger.Fatal("Failed to create gRPC server", zap.Error(err))
+		}
+		grpcServer.Start()
+	}
+
 	// create and start a pipeline consumer.
-	vaaConsumeFunc := newVAAConsumeFunc(rootCtx, cfg, metrics, logger)
-	vaaConsumer := consumer.New(vaaConsumeFunc, rpcPool, wormchainRpcPool, logger, repository, metrics, cfg.P2pNetwork, cfg.ConsumerWorkersSize, notionalCache)
+	vaaConsumeFunc := newVAAConsumeFunc(rootCtx, cfg, metrics, vaaBackoff, logger)
+	vaaConsumer := consumer.New(vaaConsumeFunc, rpcPool, wormchainRpcPool, logger, repository, metrics, cfg.P2pNetwork, cfg.ConsumerWorkersSize, cfg.ConsumerOrderedByEmitter, cfg.ConsumerDrainTimeout, notionalCache, unknownChains)
 	vaaConsumer.Start(rootCtx)
 
 	// create and start a notification consumer.
-	notificationConsumeFunc := newNotificationConsumeFunc(rootCtx, cfg, metrics, logger)
-	notificationConsumer := consumer.New(notificationConsumeFunc, rpcPool, wormchainRpcPool, logger, repository, metrics, cfg.P2pNetwork, cfg.ConsumerWorkersSize, notionalCache)
+	notificationConsumeFunc := newNotificationConsumeFunc(rootCtx, cfg, metrics, notificationBackoff, logger)
+	notificationConsumer := consumer.New(notificationConsumeFunc, rpcPool, wormchainRpcPool, logger, repository, metrics, cfg.P2pNetwork, cfg.ConsumerWorkersSize, cfg.ConsumerOrderedByEmitter, cfg.ConsumerDrainTimeout, notionalCache, unknownChains)
 	notificationConsumer.Start(rootCtx)
 
+	// start the contract watchers detecting redeems on evm chains.
+	err = startContractWatchers(rootCtx, cfg, rpcPool, db.Database, repository, notionalCache, metrics, logger)
+	if err != nil {
+		logger.Fatal("Failed to start contract watchers", zap.Error(err))
+	}
+
+	// start the reorg watcher re-checking the blocks of the evm origin txs.
+	if cfg.ReorgWatcherEnabled {
+		alertClient, err := newAlertClient(cfg)
+		if err != nil {
+			logger.Fatal("Failed to create alert client", zap.Error(err))
+		}
+		params := watcher.ReorgWatcherParams{
+			PollInterval: cfg.ReorgWatcherPollInterval,
+			Window:       cfg.ReorgWatcherWindow,
+			BatchSize:    cfg.ReorgWatcherBatchSize,
+		}
+		reorgRepository := watcher.NewReorgRepository(logger, db.Database)
+		watcher.NewReorgWatcher(params, rpcPool, reorgRepository, alertClient, metrics, logger).Start(rootCtx)
+		logger.Info("Started reorg watcher", zap.Duration("window", cfg.ReorgWatcherWindow))
+	}
+
 	logger.Info("Started wormhole-explorer-tx-tracker")
 
 	// Waiting for signal
@@ -113,19 +197,123 @@ func Run() {
 	logger.Info("Cancelling root context...")
 	rootCtxCancel()
 
+	logger.Info("Waiting for consumers to drain in-flight messages...")
+	vaaConsumer.Wait()
+	notificationConsumer.Wait()
+
 	logger.Info("Closing Http server...")
 	server.Stop()
 
+	if grpcServer != nil {
+		logger.Info("Closing gRPC server...")
+		grpcServer.Stop()
+	}
+
 	logger.Info("Closing MongoDB connection...")
 	db.DisconnectWithTimeout(10 * time.Second)
 
 	logger.Info("Terminated wormhole-explorer-tx-tracker")
 }
 
+func startContractWatchers(
+	ctx context.Context,
+	cfg *config.ServiceSettings,
+	rpcPool map[sdk.ChainID]*pool.Pool,
+	db *mongo.Database,
+	repository *consumer.Repository,
+	notionalCache *notional.NotionalCache,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) error {
+	contracts, err := cfg.ContractWatcherContractsByChain()
+	if err != nil {
+		return err
+	}
+	digestContracts, err := cfg.ContractWatcherDigestContractsByChain()
+	if err != nil {
+		return err
+	}
+
+	// a watcher is started for each chain with token bridge or digest contracts.
+	chainIDs := make(map[sdk.ChainID]struct{})
+	for chainID := range contracts {
+		chainIDs[chainID] = struct{}{}
+	}
+	for chainID := range digestContracts {
+		chainIDs[chainID] = struct{}{}
+	}
+
+	blocks := watcher.NewRepository(logger, db)
+	for chainID := range chainIDs {
+		chainPool, ok := rpcPool[chainID]
+		if !ok {
+			return fmt.Errorf("not found rpc pool for chain %s", chainID.String())
+		}
+		params := watcher.EvmWatcherParams{
+			ChainID:         chainID,
+			Contracts:       contracts[chainID],
+			DigestContracts: digestContracts[chainID],
+			BlockBatchSize:  cfg.ContractWatcherBlockBatchSize,
+			Confirmations:   cfg.ContractWatcherConfirmations,
+			PollInterval:    cfg.ContractWatcherPollInterval,
+			P2pNetwork:      cfg.P2pNetwork,
+		}
+		watcher.NewEvmWatcher(params, chainPool, blocks, repository, notionalCache, metrics, logger).Start(ctx)
+		logger.Info("Started contract watcher",
+			zap.String("chain", chainID.String()),
+			zap.Strings("contracts", params.Contracts),
+			zap.Strings("digestContracts", params.DigestContracts))
+	}
+
+	if cfg.ContractWatcherSolanaProgram != "" {
+		solanaPool, ok := rpcPool[sdk.ChainIDSolana]
+		if !ok {
+			return fmt.Errorf("not found rpc pool for chain %s", sdk.ChainIDSolana.String())
+		}
+		params := watcher.SolanaWatcherParams{
+			Program:            cfg.ContractWatcherSolanaProgram,
+			SignatureBatchSize: int(cfg.ContractWatcherBlockBatchSize),
+			PollInterval:       cfg.ContractWatcherPollInterval,
+			P2pNetwork:         cfg.P2pNetwork,
+		}
+		watcher.NewSolanaWatcher(params, solanaPool, blocks, repository, notionalCache, metrics, logger).Start(ctx)
+		logger.Info("Started contract watcher", zap.String("chain", sdk.ChainIDSolana.String()), zap.String("program", cfg.ContractWatcherSolanaProgram))
+	}
+
+	moveEvents := map[sdk.ChainID]string{
+		sdk.ChainIDAptos: cfg.ContractWatcherAptosEventHandle,
+		sdk.ChainIDSui:   cfg.ContractWatcherSuiEventType,
+	}
+	for chainID, event := range moveEvents {
+		if event == "" {
+			continue
+		}
+		chainPool, ok := rpcPool[chainID]
+		if !ok {
+			return fmt.Errorf("not found rpc pool for chain %s", chainID.String())
+		}
+		params := watcher.MoveWatcherParams{
+			ChainID:        chainID,
+			Event:          event,
+			EventBatchSize: int(cfg.ContractWatcherBlockBatchSize),
+			PollInterval:   cfg.ContractWatcherPollInterval,
+			P2pNetwork:     cfg.P2pNetwork,
+		}
+		w, err := watcher.NewMoveWatcher(params, chainPool, blocks, repository, notionalCache, metrics, logger)
+		if err != nil {
+			return err
+		}
+		w.Start(ctx)
+		logger.Info("Started contract watcher", zap.String("chain", chainID.String()), zap.String("event", event))
+	}
+	return nil
+}
+
 func newVAAConsumeFunc(
 	ctx context.Context,
 	cfg *config.ServiceSettings,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
+	backoff *sqs.Backoff,
 	logger *zap.Logger,
 ) queue.ConsumeFunc {
 
@@ -134,14 +322,25 @@ func newVAAConsumeFunc(
 		logger.Fatal("failed to create sqs consumer", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewEventSqs(sqsConsumer, queue.NewVaaConverter(logger), metrics, logger)
+	// the pipeline offloads to S3 the vaas that exceed the maximum message size.
+	awsConfig, err := newAwsConfig(ctx, cfg)
+	if err != nil {
+		logger.Fatal("failed to create aws config", zap.Error(err))
+	}
+	codec := payload.NewCodec(payload.WithS3Store(payload.NewS3Store(awsConfig, cfg.AwsEndpoint), ""))
+	vaaQueue := sqs.NewEventQueue(sqsConsumer, queue.NewVaaConverter(logger), sqs.EventQueueConfig[queue.Event]{
+		Backoff: backoff,
+		Codec:   codec,
+		Hooks:   newEventQueueHooks(metrics),
+	}, logger)
 	return vaaQueue.Consume
 }
 
 func newNotificationConsumeFunc(
 	ctx context.Context,
 	cfg *config.ServiceSettings,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
+	backoff *sqs.Backoff,
 	logger *zap.Logger,
 ) queue.ConsumeFunc {
 
@@ -150,10 +349,22 @@ func newNotificationConsumeFunc(
 		logger.Fatal("failed to create sqs consumer", zap.Error(err))
 	}
 
-	vaaQueue := queue.NewEventSqs(sqsConsumer, queue.NewNotificationEvent(logger), metrics, logger)
+	vaaQueue := sqs.NewEventQueue(sqsConsumer, queue.NewNotificationEvent(logger), sqs.EventQueueConfig[queue.Event]{
+		Backoff: backoff,
+		Hooks:   newEventQueueHooks(metrics),
+	}, logger)
 	return vaaQueue.Consume
 }
 
+// newEventQueueHooks creates the hooks recording the metrics of the vaas consumed from the sqs queues.
+func newEventQueueHooks(metrics metrics.TxTrackerMetrics) sqs.EventQueueHooks[queue.Event] {
+	return sqs.EventQueueHooks[queue.Event]{
+		Received: func(event *queue.Event) { metrics.IncVaaConsumedQueue(event.ChainID.String(), event.Source) },
+		Done:     func(event *queue.Event, retry uint8) { metrics.IncVaaProcessed(uint16(event.ChainID), retry) },
+		Failed:   func(event *queue.Event, retry uint8) { metrics.IncVaaFailed(uint16(event.ChainID), retry) },
+	}
+}
+
 func newSqsConsumer(ctx context.Context, cfg *config.ServiceSettings, sqsUrl string) (*sqs.Consumer, error) {
 
 	awsconfig, err := newAwsConfig(ctx, cfg)
@@ -170,6 +381,12 @@ func newSqsConsumer(ctx context.Context, cfg *config.ServiceSettings, sqsUrl str
 	return consumer, err
 }
 
+func newSqsBackoff(sqsUrl string, metrics metrics.TxTrackerMetrics) *sqs.Backoff {
+	return sqs.NewBackoff(sqs.WithFailureObserver(func(failures int) {
+		metrics.SetSqsConsecutiveFailures(sqsUrl, failures)
+	}))
+}
+
 func newAwsConfig(ctx context.Context, cfg *config.ServiceSettings) (aws.Config, error) {
 
 	region := cfg.AwsRegion
@@ -221,14 +438,28 @@ func makeHealthChecks(
 	return plugins, nil
 }
 
-func newMetrics(cfg *config.ServiceSettings) metrics.Metrics {
+func newAlertClient(cfg *config.ServiceSettings) (alert.AlertClient, error) {
+	if !cfg.AlertEnabled {
+		return alert.NewDummyClient(), nil
+	}
+
+	alertConfig := alert.AlertConfig{
+		Environment: cfg.Environment,
+		ApiKey:      cfg.AlertApiKey,
+		Enabled:     cfg.AlertEnabled,
+	}
+
+	return alert.NewAlertService(alertConfig, txtrackerAlert.LoadAlerts)
+}
+
+func newMetrics(cfg *config.ServiceSettings) metrics.TxTrackerMetrics {
 	if !cfg.MetricsEnabled {
-		return metrics.NewDummyMetrics()
+		return metrics.NewNoopTxTrackerMetrics()
 	}
-	return metrics.NewPrometheusMetrics(cfg.Environment)
+	return metrics.NewPrometheusTxTrackerMetrics(cfg.Environment)
 }
 
-func newRpcPool(cfg *config.ServiceSettings) (map[sdk.ChainID]*pool.Pool, map[sdk.ChainID]*pool.Pool, error) {
+func newRpcPool(ctx context.Context, cfg *config.ServiceSettings, resolver *secrets.Resolver) (map[sdk.ChainID]*pool.Pool, map[sdk.ChainID]*pool.Pool, error) {
 	var rpcConfigMap map[sdk.ChainID][]config.RpcConfig
 	var wormchainRpcConfigMap map[sdk.ChainID][]config.RpcConfig
 	var err error
@@ -271,32 +502,115 @@ func newRpcPool(cfg *config.ServiceSettings) (map[sdk.ChainID]*pool.Pool, map[sd
 		return nil, nil, errors.New("rpc provider settings not found")
 	}
 
-	domains := []string{".network", ".cloud", ".com", ".io", ".build", ".team", ".dev", ".zone", ".org", ".net", ".in"}
-	// convert rpc settings map to rpc pool
-	convertFn := func(rpcConfig []config.RpcConfig) []pool.Config {
-		poolConfigs := make([]pool.Config, 0, len(rpcConfig))
-		for _, rpc := range rpcConfig {
-			poolConfigs = append(poolConfigs, pool.Config{
-				Id:                rpc.Url,
-				Priority:          rpc.Priority,
-				Description:       utils.FindSubstringBeforeDomains(rpc.Url, domains),
-				RequestsPerMinute: rpc.RequestsPerMinute,
-			})
-		}
-		return poolConfigs
+	// resolve the rpc urls set with a secret reference, e.g. the urls with api keys
+	if err := resolveRpcUrls(ctx, resolver, rpcConfigMap, wormchainRpcConfigMap); err != nil {
+		return nil, nil, err
 	}
 
 	// create rpc pool
 	rpcPool := make(map[sdk.ChainID]*pool.Pool)
 	for chainID, rpcConfig := range rpcConfigMap {
-		rpcPool[chainID] = pool.NewPool(convertFn(rpcConfig))
+		rpcPool[chainID] = pool.NewPool(toPoolConfigs(rpcConfig))
 	}
 
 	// create wormchain rpc pool
 	wormchainRpcPool := make(map[sdk.ChainID]*pool.Pool)
 	for chainID, rpcConfig := range wormchainRpcConfigMap {
-		wormchainRpcPool[chainID] = pool.NewPool(convertFn(rpcConfig))
+		wormchainRpcPool[chainID] = pool.NewPool(toPoolConfigs(rpcConfig))
 	}
 
 	return rpcPool, wormchainRpcPool, nil
 }
+
+// resolveRpcUrls replaces the rpc urls set with a secret reference with the value of the secret.
+func resolveRpcUrls(ctx context.Context, resolver *secrets.Resolver, rpcConfigMaps ...map[sdk.ChainID][]config.RpcConfig) error {
+	for _, rpcConfigMap := range rpcConfigMaps {
+		for _, rpcConfig := range rpcConfigMap {
+			for i := range rpcConfig {
+				if err := resolver.ResolveAll(ctx, &rpcConfig[i].Url); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// newSecretsResolver creates the resolver of the secret references with the secret stores of the configuration.
+func newSecretsResolver(ctx context.Context, cfg *config.ServiceSettings, logger *zap.Logger) (*secrets.Resolver, error) {
+	resolver := secrets.NewResolver(logger)
+	if cfg.SecretsAwsEnabled {
+		awsConfig, err := newAwsConfig(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		resolver.Register(secrets.SchemeAws, secrets.NewAwsSecretsManager(awsConfig, cfg.AwsEndpoint))
+	}
+	if cfg.SecretsVaultAddr != "" {
+		resolver.Register(secrets.SchemeVault, secrets.NewVault(cfg.SecretsVaultAddr, cfg.SecretsVaultToken))
+	}
+	return resolver, nil
+}
+
+// toPoolConfigs converts the rpc settings of a chain to the configs of the rpc pool.
+func toPoolConfigs(rpcConfig []config.RpcConfig) []pool.Config {
+	domains := []string{".network", ".cloud", ".com", ".io", ".build", ".team", ".dev", ".zone", ".org", ".net", ".in"}
+	poolConfigs := make([]pool.Config, 0, len(rpcConfig))
+	for _, rpc := range rpcConfig {
+		poolConfigs = append(poolConfigs, pool.Config{
+			Id:                rpc.Url,
+			Priority:          rpc.Priority,
+			Description:       utils.FindSubstringBeforeDomains(rpc.Url, domains),
+			RequestsPerMinute: rpc.RequestsPerMinute,
+		})
+	}
+	return poolConfigs
+}
+
+// startRpcProviderWatcher reloads the rpc providers when the file of the rpc provider settings changes
+// or on SIGHUP, so the provider urls can be changed without restarting the service.
+// The pools of the chains are updated in place, the chains missing at startup are not added.
+func startRpcProviderWatcher(ctx context.Context, cfg *config.ServiceSettings, resolver *secrets.Resolver,
+	rpcPool map[sdk.ChainID]*pool.Pool, wormchainRpcPool map[sdk.ChainID]*pool.Pool, logger *zap.Logger) error {
+	if cfg.RpcProviderPath == "" {
+		return nil
+	}
+
+	w, err := reload.NewWatcher(cfg.RpcProviderPath, cfg.RpcProviderReloadInterval, config.ParseRpcProviderSettingsJson, logger)
+	if err != nil {
+		return err
+	}
+
+	updatePools := func(pools map[sdk.ChainID]*pool.Pool, rpcConfigMap map[sdk.ChainID][]config.RpcConfig) {
+		for chainID, rpcConfig := range rpcConfigMap {
+			p, ok := pools[chainID]
+			if !ok {
+				logger.Warn("rpc providers of a new chain require a restart", zap.Stringer("chainId", chainID))
+				continue
+			}
+			p.Update(toPoolConfigs(rpcConfig))
+		}
+	}
+	w.OnReload(func(settings *config.RpcProviderSettingsJson) {
+		rpcConfigMap, err := settings.ToMap()
+		if err != nil {
+			logger.Error("failed to map rpc providers", zap.Error(err))
+			return
+		}
+		wormchainRpcConfigMap, err := settings.WormchainToMap()
+		if err != nil {
+			logger.Error("failed to map wormchain rpc providers", zap.Error(err))
+			return
+		}
+		if err := resolveRpcUrls(ctx, resolver, rpcConfigMap, wormchainRpcConfigMap); err != nil {
+			logger.Error("failed to resolve rpc provider secrets", zap.Error(err))
+			return
+		}
+		updatePools(rpcPool, rpcConfigMap)
+		updatePools(wormchainRpcPool, wormchainRpcConfigMap)
+		logger.Info("rpc providers reloaded")
+	})
+
+	go w.Start(ctx)
+	return nil
+}
@@ -7,19 +7,23 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
 type ServiceSettings struct {
 	// MonitoringPort defines the TCP port for the /health and /ready endpoints.
-	MonitoringPort       string `split_words:"true" default:"8000"`
-	Environment          string `split_words:"true" required:"true"`
-	LogLevel             string `split_words:"true" default:"INFO"`
-	PprofEnabled         bool   `split_words:"true" default:"false"`
+	MonitoringPort string `split_words:"true" default:"8000"`
+	Environment    string `split_words:"true" required:"true"`
+	LogLevel       string `split_words:"true" default:"INFO"`
+	PprofEnabled   bool   `split_words:"true" default:"false"`
+	// AdminApiKey is required in the X-Api-Key header of the vaa endpoints, an empty key rejects all the requests.
+	AdminApiKey          string `split_words:"true" required:"false"`
 	MetricsEnabled       bool   `split_words:"true" default:"false"`
 	P2pNetwork           string `split_words:"true" required:"true"`
 	RpcProviderPath      string `split_words:"true" required:"false"`
@@ -27,8 +31,54 @@ type ServiceSettings struct {
 	NotionalCacheURL     string `split_words:"true" required:"true"`
 	NotionalCachePrefix  string `split_words:"true" required:"true"`
 	NotionalCacheChannel string `split_words:"true" required:"true"`
+	// RpcProviderReloadInterval defines how often the file of RpcProviderPath is checked for changes,
+	// the providers are also reloaded on SIGHUP. Zero only reloads them on SIGHUP.
+	RpcProviderReloadInterval time.Duration `split_words:"true" default:"1m"`
+	// ConsumerOrderedByEmitter partitions the consumer workers by emitter to process the vaas of an emitter in order.
+	ConsumerOrderedByEmitter bool `split_words:"true" default:"false"`
+	// ConsumerDrainTimeout bounds the processing of the in-flight messages on shutdown.
+	ConsumerDrainTimeout time.Duration `split_words:"true" default:"20s"`
+	// ContractWatcherContracts defines the token bridge contracts watched for redeems with the format "chainId:address,chainId:address".
+	// When the address of a chain is omitted, the token bridge of the address book of the network is used.
+	// The contract watcher is disabled when empty.
+	ContractWatcherContracts string `split_words:"true" required:"false"`
+	// ContractWatcherDigestContracts defines the contracts whose transactions are linked to the vaas by the digests
+	// found in their calldata and logs, with the format "chainId:address,chainId:address".
+	// It covers the manual redeems through contracts that don't emit the token bridge TransferRedeemed log.
+	ContractWatcherDigestContracts string        `split_words:"true" required:"false"`
+	ContractWatcherPollInterval    time.Duration `split_words:"true" default:"15s"`
+	ContractWatcherBlockBatchSize  uint64        `split_words:"true" default:"100"`
+	ContractWatcherConfirmations   uint64        `split_words:"true" default:"10"`
+	// ContractWatcherSolanaProgram defines the solana token bridge program watched for redeems.
+	// The solana watcher is disabled when empty.
+	ContractWatcherSolanaProgram string `split_words:"true" required:"false"`
+	// ContractWatcherAptosEventHandle defines the aptos token bridge event handle watched for redeems
+	// with the format "<address>::<module>::<struct>/<field>". The aptos watcher is disabled when empty.
+	ContractWatcherAptosEventHandle string `split_words:"true" required:"false"`
+	// ContractWatcherSuiEventType defines the sui token bridge event type watched for redeems.
+	// The sui watcher is disabled when empty.
+	ContractWatcherSuiEventType string `split_words:"true" required:"false"`
+	// GrpcPort defines the TCP port of the internal gRPC service, the service is disabled when empty.
+	GrpcPort string `split_words:"true" required:"false"`
+	// ResolveTxCacheExpiration defines how long the origin txs resolved by the gRPC service are cached.
+	ResolveTxCacheExpiration time.Duration `split_words:"true" default:"10m"`
+	// ReorgWatcherEnabled enables the watcher that re-checks the blocks of the recent evm origin txs.
+	ReorgWatcherEnabled      bool          `split_words:"true" default:"false"`
+	ReorgWatcherPollInterval time.Duration `split_words:"true" default:"1m"`
+	// ReorgWatcherWindow defines how long after the vaa the block of an origin tx is re-checked.
+	ReorgWatcherWindow    time.Duration `split_words:"true" default:"2h"`
+	ReorgWatcherBatchSize int64         `split_words:"true" default:"100"`
+	AlertEnabled          bool          `split_words:"true" default:"false"`
+	AlertApiKey           string        `split_words:"true" required:"false"`
+	// HttpTimeout defines the timeout of the requests to the chain apis.
+	HttpTimeout time.Duration `split_words:"true" default:"30s"`
+	// HttpProxyUrl defines the http proxy of the requests to the chain apis,
+	// the proxy of the HTTP_PROXY and HTTPS_PROXY environment variables is used when empty.
+	HttpProxyUrl  string `split_words:"true" required:"false"`
+	HttpUserAgent string `split_words:"true" default:"wormhole-explorer-tx-tracker"`
 	AwsSettings
 	MongodbSettings
+	SecretsSettings
 	*RpcProviderSettings        `required:"false"`
 	*WormchainProviderSettings  `required:"false"`
 	*TestnetRpcProviderSettings `required:"false"`
@@ -55,6 +105,18 @@ type RpcSettings struct {
 	Priority         uint8  `json:"priority"`
 }
 
+// SecretsSettings defines the secret stores of the settings set with a secret reference instead of the plain value,
+// e.g. MONGODB_URI=aws-secret://prod/tx-tracker#mongodb_uri, and of the rpc provider urls with api keys.
+type SecretsSettings struct {
+	// SecretsAwsEnabled enables the aws-secret references, AWS Secrets Manager is reached with the aws settings of the service.
+	SecretsAwsEnabled bool   `split_words:"true" default:"false"`
+	SecretsVaultAddr  string `split_words:"true" required:"false"`
+	SecretsVaultToken string `split_words:"true" required:"false"`
+	// SecretsRotationInterval defines how often the secrets are fetched again, the service is stopped to start again
+	// with the new secrets when a secret changed. Zero disables the rotation.
+	SecretsRotationInterval time.Duration `split_words:"true" default:"1h"`
+}
+
 type AwsSettings struct {
 	AwsEndpoint         string `split_words:"true" required:"false"`
 	AwsAccessKeyID      string `split_words:"true" required:"false"`
@@ -246,6 +308,29 @@ func NewRpcProviderSettingJson(path string) (*RpcProviderSettingsJson, error) {
 	return &rpcProviderSettingsJson, nil
 }
 
+// ParseRpcProviderSettingsJson parses and validates the content of the rpc provider settings file.
+func ParseRpcProviderSettingsJson(data []byte) (*RpcProviderSettingsJson, error) {
+	var rpcProviderSettingsJson RpcProviderSettingsJson
+	if err := json.Unmarshal(data, &rpcProviderSettingsJson); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rpc provider settings: %w", err)
+	}
+	providers := append(rpcProviderSettingsJson.RpcProviders, rpcProviderSettingsJson.WormchainRpcProviders...)
+	for _, provider := range providers {
+		if len(provider.RpcSettings) == 0 {
+			return nil, fmt.Errorf("chain %d has no rpc providers", provider.ChainId)
+		}
+		for _, rpc := range provider.RpcSettings {
+			if rpc.Url == "" {
+				return nil, fmt.Errorf("chain %d has a rpc provider without url", provider.ChainId)
+			}
+			if rpc.RequestPerMinute == 0 {
+				return nil, fmt.Errorf("rpc provider %s of chain %d has no requests per minute", rpc.Url, provider.ChainId)
+			}
+		}
+	}
+	return &rpcProviderSettingsJson, nil
+}
+
 func New() (*ServiceSettings, error) {
 	_ = godotenv.Load()
 	var settings ServiceSettings
@@ -328,6 +413,53 @@ func (s *ServiceSettings) MapRpcProviderToRpcConfig() (map[sdk.ChainID][]RpcConf
 	return nil, nil, errors.New("rpc provider settings not found")
 }
 
+// ContractWatcherContractsByChain parses the contracts watched for redeems grouped by chain.
+func (s *ServiceSettings) ContractWatcherContractsByChain() (map[sdk.ChainID][]string, error) {
+	contracts := make(map[sdk.ChainID][]string)
+	if s.ContractWatcherContracts == "" {
+		return contracts, nil
+	}
+	for _, entry := range strings.Split(s.ContractWatcherContracts, ",") {
+		chain, address, hasAddress := strings.Cut(strings.TrimSpace(entry), ":")
+		if hasAddress && address == "" {
+			return nil, fmt.Errorf("invalid contract watcher contract: %s", entry)
+		}
+		chainID, err := strconv.ParseUint(chain, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contract watcher chain id: %s", chain)
+		}
+		if !hasAddress {
+			c, ok := domain.GetContracts(s.P2pNetwork, sdk.ChainID(chainID))
+			if !ok || c.TokenBridge == "" {
+				return nil, fmt.Errorf("token bridge of chain %s not found in the %s address book", chain, s.P2pNetwork)
+			}
+			address = c.TokenBridge
+		}
+		contracts[sdk.ChainID(chainID)] = append(contracts[sdk.ChainID(chainID)], strings.ToLower(address))
+	}
+	return contracts, nil
+}
+
+// ContractWatcherDigestContractsByChain parses the contracts whose transactions are linked to the vaas by digest grouped by chain.
+func (s *ServiceSettings) ContractWatcherDigestContractsByChain() (map[sdk.ChainID][]string, error) {
+	contracts := make(map[sdk.ChainID][]string)
+	if s.ContractWatcherDigestContracts == "" {
+		return contracts, nil
+	}
+	for _, entry := range strings.Split(s.ContractWatcherDigestContracts, ",") {
+		chain, address, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || address == "" {
+			return nil, fmt.Errorf("invalid contract watcher digest contract: %s", entry)
+		}
+		chainID, err := strconv.ParseUint(chain, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contract watcher chain id: %s", chain)
+		}
+		contracts[sdk.ChainID(chainID)] = append(contracts[sdk.ChainID(chainID)], strings.ToLower(address))
+	}
+	return contracts, nil
+}
+
 // ToMap converts the RpcProviderSettingsJson to a map of RpcConfig
 func (r RpcProviderSettingsJson) ToMap() (map[sdk.ChainID][]RpcConfig, error) {
 	rpcs := make(map[sdk.ChainID][]RpcConfig)
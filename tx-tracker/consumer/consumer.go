@@ -3,12 +3,16 @@ package consumer
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
 	"time"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pipeline"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/queue"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -17,15 +21,15 @@ import (
 
 // Consumer consumer struct definition.
 type Consumer struct {
-	consumeFunc      queue.ConsumeFunc
 	rpcpool          map[vaa.ChainID]*pool.Pool
 	wormchainRpcPool map[vaa.ChainID]*pool.Pool
 	logger           *zap.Logger
 	repository       *Repository
-	metrics          metrics.Metrics
+	metrics          metrics.TxTrackerMetrics
 	p2pNetwork       string
-	workersSize      int
 	notionalCache    *notional.NotionalCache
+	unknownChains    *domain.UnknownChainTracker
+	pipeline         *pipeline.Pipeline[queue.ConsumerMessage]
 }
 
 // New creates a new vaa consumer.
@@ -34,74 +38,104 @@ func New(consumeFunc queue.ConsumeFunc,
 	wormchainRpcPool map[vaa.ChainID]*pool.Pool,
 	logger *zap.Logger,
 	repository *Repository,
-	metrics metrics.Metrics,
+	metrics metrics.TxTrackerMetrics,
 	p2pNetwork string,
 	workersSize int,
+	orderedByEmitter bool,
+	drainTimeout time.Duration,
 	notionalCache *notional.NotionalCache,
+	unknownChains *domain.UnknownChainTracker,
 ) *Consumer {
 
-	c := Consumer{
-		consumeFunc:      consumeFunc,
+	c := &Consumer{
 		rpcpool:          rpcPool,
 		wormchainRpcPool: wormchainRpcPool,
 		logger:           logger,
 		repository:       repository,
 		metrics:          metrics,
 		p2pNetwork:       p2pNetwork,
-		workersSize:      workersSize,
 		notionalCache:    notionalCache,
+		unknownChains:    unknownChains,
 	}
 
-	return &c
+	// with orderedByEmitter the messages are partitioned by emitter,
+	// so the messages of the same emitter are processed in the order they were received.
+	cfg := pipeline.Config[queue.ConsumerMessage]{
+		Workers:      workersSize,
+		DrainTimeout: drainTimeout,
+		Hooks: pipeline.Hooks[queue.ConsumerMessage]{
+			Processed: c.onProcessed,
+			Failed:    c.onFailed,
+		},
+	}
+	if orderedByEmitter {
+		cfg.PartitionKey = emitterKey
+	}
+	c.pipeline = pipeline.New(pipeline.Source[queue.ConsumerMessage](consumeFunc), c.processMessage, cfg)
+
+	return c
 }
 
 // Start consumes messages from VAA queue, parse and store those messages in a repository.
 func (c *Consumer) Start(ctx context.Context) {
-	ch := c.consumeFunc(ctx)
-	for i := 0; i < c.workersSize; i++ {
-		go c.producerLoop(ctx, ch)
+	c.pipeline.Start(ctx)
+}
+
+// Wait blocks until the workers stop after the context is cancelled and the in-flight messages are drained.
+func (c *Consumer) Wait() {
+	c.pipeline.Wait()
+}
+
+func (c *Consumer) onProcessed(msg queue.ConsumerMessage, _ time.Duration) {
+	event := msg.Data()
+	c.metrics.IncProcessedMessage(event.ChainID.String(), event.Source, msg.Retry())
+}
+
+func (c *Consumer) onFailed(msg queue.ConsumerMessage, err error, _ time.Duration) {
+	event := msg.Data()
+	if pipeline.IsDiscarded(err) {
+		c.metrics.IncInvalidMessage(event.ChainID.String(), event.Source, msg.Retry())
+		return
 	}
+	c.metrics.IncUnprocessedMessage(event.ChainID.String(), event.Source, msg.Retry())
 }
 
-func (c *Consumer) producerLoop(ctx context.Context, ch <-chan queue.ConsumerMessage) {
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case msg := <-ch:
-			c.logger.Debug("Received message", zap.String("vaaId", msg.Data().ID), zap.String("trackId", msg.Data().TrackID))
-			switch msg.Data().Type {
-			case queue.SourceChainEvent:
-				c.processSourceTx(ctx, msg)
-			case queue.TargetChainEvent:
-				c.processTargetTx(ctx, msg)
-			default:
-				c.logger.Error("Unknown message type", zap.String("trackId", msg.Data().TrackID), zap.Any("type", msg.Data().Type))
-			}
-		}
+func emitterKey(msg queue.ConsumerMessage) string {
+	return fmt.Sprintf("%d/%s", msg.Data().ChainID, msg.Data().EmitterAddress)
+}
+
+// processMessage processes a message, the message is failed when an error is returned.
+func (c *Consumer) processMessage(ctx context.Context, msg queue.ConsumerMessage) error {
+	c.logger.Debug("Received message", zap.String("vaaId", msg.Data().ID), zap.String("trackId", msg.Data().TrackID))
+	switch msg.Data().Type {
+	case queue.SourceChainEvent:
+		return c.processSourceTx(ctx, msg)
+	case queue.TargetChainEvent:
+		return c.processTargetTx(ctx, msg)
+	default:
+		c.logger.Error("Unknown message type", zap.String("trackId", msg.Data().TrackID), zap.Any("type", msg.Data().Type))
+		return fmt.Errorf("unknown message type %v", msg.Data().Type)
 	}
 }
 
-func (c *Consumer) processSourceTx(ctx context.Context, msg queue.ConsumerMessage) {
+func (c *Consumer) processSourceTx(ctx context.Context, msg queue.ConsumerMessage) error {
 
 	event := msg.Data()
 
 	// Do not process messages from PythNet
 	if event.ChainID == sdk.ChainIDPythNet {
-		msg.Done()
 		c.logger.Debug("Skipping pythNet message", zap.String("trackId", event.TrackID), zap.String("vaaId", event.ID))
-		return
+		return nil
 	}
 
 	if event.ChainID == sdk.ChainIDNear {
-		msg.Done()
 		c.logger.Warn("Skipping vaa from near", zap.String("trackId", event.TrackID), zap.String("vaaId", event.ID))
-		return
+		return nil
 	}
 
 	start := time.Now()
 
+	c.trackUnknownChain(event.ChainID, event.TrackID, event.ID)
 	c.metrics.IncVaaUnfiltered(event.ChainID.String(), event.Source)
 
 	// Process the VAA
@@ -128,50 +162,73 @@ func (c *Consumer) processSourceTx(ctx context.Context, msg queue.ConsumerMessag
 	elapsedLog := zap.Uint64("elapsedTime", uint64(time.Since(start).Milliseconds()))
 	// Log a message informing the processing status
 	if errors.Is(err, chains.ErrChainNotSupported) {
-		msg.Done()
 		c.logger.Info("Skipping VAA - chain not supported",
 			zap.String("trackId", event.TrackID),
 			zap.String("vaaId", event.ID),
 			elapsedLog,
 		)
-	} else if errors.Is(err, ErrAlreadyProcessed) {
-		msg.Done()
-		c.logger.Warn("Origin message already processed - skipping",
+		return nil
+	}
+	if errors.Is(err, chains.ErrUnknownChain) {
+		c.logger.Warn("Skipping VAA - chain not known by the wormhole sdk, originTx stored unprocessed",
 			zap.String("trackId", event.TrackID),
 			zap.String("vaaId", event.ID),
+			zap.Uint16("chainId", uint16(event.ChainID)),
 			elapsedLog,
 		)
-	} else if err != nil {
-		msg.Failed()
-		c.logger.Error("Failed to process originTx",
+		return nil
+	}
+	if errors.Is(err, ErrAlreadyProcessed) {
+		c.logger.Warn("Origin message already processed - skipping",
 			zap.String("trackId", event.TrackID),
 			zap.String("vaaId", event.ID),
-			zap.Error(err),
 			elapsedLog,
 		)
-	} else {
-		msg.Done()
-		c.logger.Info("Origin transaction processed successfully",
+		return nil
+	}
+	if err != nil {
+		c.logger.Error("Failed to process originTx",
 			zap.String("trackId", event.TrackID),
-			zap.String("id", event.ID),
+			zap.String("vaaId", event.ID),
+			zap.Error(err),
 			elapsedLog,
 		)
-		c.metrics.IncOriginTxInserted(event.ChainID.String(), event.Source)
+		return err
+	}
+
+	c.logger.Info("Origin transaction processed successfully",
+		zap.String("trackId", event.TrackID),
+		zap.String("id", event.ID),
+		elapsedLog,
+	)
+	c.metrics.IncOriginTxInserted(event.ChainID.String(), event.Source)
+	c.observeLatency(event.Watermarks)
+	return nil
+}
+
+// observeLatency records the latency of the tx-tracker stage and the end-to-end latency of a processed event.
+func (c *Consumer) observeLatency(w events.Watermarks) {
+	if latency, ok := w.StageLatency(events.StageTxTracker); ok {
+		c.metrics.VaaStageLatency(events.StageTxTracker, latency)
+	}
+	if latency, ok := w.EndToEndLatency(time.Now()); ok {
+		c.metrics.VaaEndToEndLatency(latency)
 	}
 }
 
-func (c *Consumer) processTargetTx(ctx context.Context, msg queue.ConsumerMessage) {
+func (c *Consumer) processTargetTx(ctx context.Context, msg queue.ConsumerMessage) error {
 
 	event := msg.Data()
 
 	attr, ok := queue.GetAttributes[*queue.TargetChainAttributes](event)
 	if !ok || attr == nil {
-		msg.Failed()
 		c.logger.Error("Failed to get attributes from message", zap.String("trackId", event.TrackID), zap.String("vaaId", event.ID))
-		return
+		return errors.New("failed to get attributes from message")
 	}
 	start := time.Now()
 
+	c.trackUnknownChain(event.ChainID, event.TrackID, event.ID)
+
 	// evm fee
 	var evmFee *EvmFee
 	if attr.GasUsed != nil && attr.EffectiveGasPrice != nil {
@@ -212,19 +269,31 @@ func (c *Consumer) processTargetTx(ctx context.Context, msg queue.ConsumerMessag
 
 	elapsedLog := zap.Uint64("elapsedTime", uint64(time.Since(start).Milliseconds()))
 	if err != nil {
-		msg.Failed()
 		c.logger.Error("Failed to process destinationTx",
 			zap.String("trackId", event.TrackID),
 			zap.String("vaaId", event.ID),
 			zap.Error(err),
 			elapsedLog,
 		)
-	} else {
-		msg.Done()
-		c.logger.Info("Destination transaction processed successfully",
-			zap.String("trackId", event.TrackID),
-			zap.String("id", event.ID),
-			elapsedLog,
-		)
+		return err
+	}
+
+	c.logger.Info("Destination transaction processed successfully",
+		zap.String("trackId", event.TrackID),
+		zap.String("id", event.ID),
+		elapsedLog,
+	)
+	return nil
+}
+
+// trackUnknownChain reports the chain ID if it is not known by the wormhole sdk.
+func (c *Consumer) trackUnknownChain(chainID sdk.ChainID, trackID, vaaID string) {
+	if c.unknownChains == nil || !c.unknownChains.Track(chainID, vaaID) {
+		return
 	}
+	c.metrics.IncUnknownChain(uint16(chainID))
+	c.logger.Warn("VAA references an unknown chain",
+		zap.String("trackId", trackID),
+		zap.String("vaaId", vaaID),
+		zap.Uint16("chainId", uint16(chainID)))
 }
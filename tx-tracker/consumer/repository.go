@@ -111,6 +111,15 @@ func (r *Repository) UpsertOriginTx(ctx context.Context, params *UpsertOriginTxP
 		if params.TxDetail.FeeDetail != nil {
 			fields = append(fields, primitive.E{Key: "feeDetail", Value: params.TxDetail.FeeDetail})
 		}
+		if params.TxDetail.BlockNumber != 0 {
+			fields = append(fields, primitive.E{Key: "blockNumber", Value: params.TxDetail.BlockNumber})
+		}
+		if params.TxDetail.BlockHash != "" {
+			fields = append(fields, primitive.E{Key: "blockHash", Value: params.TxDetail.BlockHash})
+		}
+		if params.TxDetail.Finality != "" {
+			fields = append(fields, primitive.E{Key: "finality", Value: params.TxDetail.Finality})
+		}
 	}
 
 	if params.Timestamp != nil {
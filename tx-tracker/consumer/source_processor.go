@@ -7,9 +7,9 @@ import (
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
@@ -36,7 +36,7 @@ type ProcessSourceTxParams struct {
 	// In the context of the service, you usually don't want to overwrite existing data
 	// to avoid processing the same VAA twice, which would result in performance degradation.
 	Overwrite       bool
-	Metrics         metrics.Metrics
+	Metrics         metrics.TxTrackerMetrics
 	SentTimestamp   *time.Time
 	DisableDBUpsert bool
 	P2pNetwork      string
@@ -160,7 +160,7 @@ func handleFetchTxError(
 	err error,
 ) error {
 	// If the chain is not supported, we don't want to store the unprocessed originTx in the database.
-	if errors.Is(chains.ErrChainNotSupported, err) {
+	if errors.Is(err, chains.ErrChainNotSupported) {
 		return nil
 	}
 
@@ -168,7 +168,13 @@ func handleFetchTxError(
 	// unprocessed originTx in the database.
 	var vaaTxDetail *chains.TxDetail
 	isSolanaOrAptos := params.ChainId == vaa.ChainIDAptos || params.ChainId == vaa.ChainIDSolana
-	if !isSolanaOrAptos {
+	if errors.Is(err, chains.ErrUnknownChain) {
+		// the chain is not known by the wormhole sdk, so the txHash is stored as it was received
+		// in order to be processed once the sdk is updated.
+		vaaTxDetail = &chains.TxDetail{
+			NativeTxHash: params.TxHash,
+		}
+	} else if !isSolanaOrAptos {
 		txHash := chains.FormatTxHashByChain(params.ChainId, params.TxHash)
 		vaaTxDetail = &chains.TxDetail{
 			NativeTxHash: txHash,
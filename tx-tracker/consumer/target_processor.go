@@ -9,8 +9,8 @@ import (
 	"time"
 
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -37,7 +37,8 @@ type ProcessTargetTxParams struct {
 	Status         string
 	EvmFee         *EvmFee
 	SolanaFee      *SolanaFee
-	Metrics        metrics.Metrics
+	MoveFee        *MoveFee
+	Metrics        metrics.TxTrackerMetrics
 	P2pNetwork     string
 }
 
@@ -50,6 +51,12 @@ type SolanaFee struct {
 	Fee uint64
 }
 
+// MoveFee is the fee of a move (aptos or sui) redeemed tx, already calculated in the gas token.
+type MoveFee struct {
+	RawFee map[string]string
+	Fee    string
+}
+
 func ProcessTargetTx(
 	ctx context.Context,
 	logger *zap.Logger,
@@ -159,6 +166,13 @@ func calculateFeeDetail(params *ProcessTargetTxParams, logger *zap.Logger, notio
 			Fee: fee.String(),
 		}
 	}
+	// move redeemed txs carry the fee calculated from the chain api.
+	if params.MoveFee != nil {
+		feeDetail = &FeeDetail{
+			RawFee: params.MoveFee.RawFee,
+			Fee:    params.MoveFee.Fee,
+		}
+	}
 
 	if feeDetail != nil && params.P2pNetwork == domain.P2pMainNet {
 		gasTokenPrice, errGasPrice := chains.GetGasTokenNotional(params.ChainID, notionalCache)
@@ -4,6 +4,9 @@ import (
 	"github.com/ansrivas/fiberprometheus/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/pprof"
+	"github.com/wormhole-foundation/wormhole-explorer/common/audit"
+	"github.com/wormhole-foundation/wormhole-explorer/common/auth"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
 	health "github.com/wormhole-foundation/wormhole-explorer/common/health"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/http/vaa"
 	"go.uber.org/zap"
@@ -15,7 +18,7 @@ type Server struct {
 	logger *zap.Logger
 }
 
-func NewServer(logger *zap.Logger, port string, pprofEnabled bool, vaaController *vaa.Controller, checks ...health.Check) *Server {
+func NewServer(logger *zap.Logger, port string, pprofEnabled bool, vaaController *vaa.Controller, unknownChains *domain.UnknownChainTracker, auditor *audit.Auditor, adminApiKey string, checks ...health.Check) *Server {
 	app := fiber.New(fiber.Config{DisableStartupMessage: true})
 	prometheus := fiberprometheus.New("wormscan-tx-tracker")
 	prometheus.RegisterAt(app, "/metrics")
@@ -31,8 +34,15 @@ func NewServer(logger *zap.Logger, port string, pprofEnabled bool, vaaController
 	api.Get("/health", ctrl.HealthCheck)
 	api.Get("/ready", ctrl.ReadyCheck)
 
-	api.Post("/vaa/process", vaaController.Process)
-	api.Post("/vaa/tx-hash", vaaController.CreateTxHash)
+	// the requests are authenticated before they are audited, so the rejected requests are not recorded.
+	adminOnly := auth.AdminApiKey(adminApiKey)
+	api.Post("/vaa/process", adminOnly, auditor.Middleware("vaa.process"), vaaController.Process)
+	api.Post("/vaa/tx-hash", adminOnly, auditor.Middleware("vaa.tx-hash"), vaaController.CreateTxHash)
+
+	// chains seen by the service that are not known by the wormhole sdk.
+	api.Get("/unknown-chains", func(c *fiber.Ctx) error {
+		return c.JSON(unknownChains.List())
+	})
 
 	return &Server{
 		app:    app,
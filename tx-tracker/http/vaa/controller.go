@@ -5,10 +5,10 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
 	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
 	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
 	"github.com/wormhole-foundation/wormhole-explorer/common/utils"
 	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
-	"github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/metrics"
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"strconv"
@@ -22,7 +22,7 @@ type Controller struct {
 	wormchainRpcPool map[sdk.ChainID]*pool.Pool
 	vaaRepository    *Repository
 	repository       *consumer.Repository
-	metrics          metrics.Metrics
+	metrics          metrics.TxTrackerMetrics
 	p2pNetwork       string
 	notionalCache    *notional.NotionalCache
 }
@@ -30,7 +30,7 @@ type Controller struct {
 // NewController creates a Controller instance.
 func NewController(rpcPool map[sdk.ChainID]*pool.Pool, wormchainRpcPool map[sdk.ChainID]*pool.Pool, vaaRepository *Repository, repository *consumer.Repository, p2pNetwork string, logger *zap.Logger, notionalCache *notional.NotionalCache) *Controller {
 	return &Controller{
-		metrics:          metrics.NewDummyMetrics(),
+		metrics:          metrics.NewNoopTxTrackerMetrics(),
 		rpcPool:          rpcPool,
 		wormchainRpcPool: wormchainRpcPool,
 		vaaRepository:    vaaRepository,
@@ -3,6 +3,7 @@ package vaa
 import (
 	"context"
 
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
@@ -16,9 +17,9 @@ type Repository struct {
 }
 
 type VaaDoc struct {
-	ID     string `bson:"_id" json:"id"`
-	Vaa    []byte `bson:"vaas" json:"vaa"`
-	TxHash string `bson:"txHash" json:"txHash"`
+	ID     string                     `bson:"_id" json:"id"`
+	Vaa    repository.CompressedBytes `bson:"vaas" json:"vaa"`
+	TxHash string                     `bson:"txHash" json:"txHash"`
 }
 
 // NewRepository create a new Repository.
@@ -0,0 +1,29 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+)
+
+// alert key constants definition.
+const (
+	AlertKeyOriginTxReorg = "ORIGIN-TX-REORG"
+)
+
+func LoadAlerts(cfg alert.AlertConfig) map[string]alert.Alert {
+	alerts := make(map[string]alert.Alert)
+
+	// Alert for origin transactions removed from their block by a reorg.
+	alerts[AlertKeyOriginTxReorg] = alert.Alert{
+		Alias:       "Origin transaction reorg",
+		Message:     fmt.Sprintf("[%s] %s", cfg.Environment, "The block of an origin transaction was reorganized"),
+		Description: "The block recorded for the origin transaction of a VAA is no longer part of the chain",
+		Actions:     []string{"Check the reorg report of the VAA and the finality of the chain"},
+		Tags:        []string{cfg.Environment, "tx-tracker", "reorg", "chain"},
+		Entity:      "tx-tracker",
+		Priority:    alert.HIGH,
+	}
+
+	return alerts
+}
@@ -26,6 +26,8 @@ type VaaEvent struct {
 	Version          uint16      `json:"version"`
 	Revision         uint16      `json:"revision"`
 	Overwrite        bool        `json:"overwrite"`
+	// Watermarks contains the time at which the vaa was ingested by each stage of the pipeline.
+	Watermarks events.Watermarks `json:"watermarks"`
 }
 
 // VaaConverter converts a message from a VAAEvent.
@@ -51,6 +53,7 @@ func NewVaaConverter(log *zap.Logger) ConverterFunc {
 			IsVaaSigned:    true,
 			TxHash:         vaaEvent.TxHash,
 			Overwrite:      vaaEvent.Overwrite,
+			Watermarks:     vaaEvent.Watermarks.Stamp(events.StageTxTracker, time.Now()),
 		}, nil
 	}
 }
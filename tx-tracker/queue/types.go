@@ -5,12 +5,10 @@ import (
 	"time"
 
 	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
-)
 
-type sqsEvent struct {
-	MessageID string `json:"MessageId"`
-	Message   string `json:"Message"`
-}
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/sqs"
+	"github.com/wormhole-foundation/wormhole-explorer/common/events"
+)
 
 type EventType string
 
@@ -57,6 +55,7 @@ type Event struct {
 	IsVaaSigned    bool
 	Attributes     any
 	Overwrite      bool
+	Watermarks     events.Watermarks
 }
 
 func GetAttributes[T EventAttributes](e *Event) (T, bool) {
@@ -68,14 +67,10 @@ func GetAttributes[T EventAttributes](e *Event) (T, bool) {
 }
 
 // ConsumerMessage defition.
-type ConsumerMessage interface {
-	Retry() uint8
-	Data() *Event
-	Done()
-	Failed()
-	IsExpired() bool
-	SentTimestamp() *time.Time
-}
+type ConsumerMessage = sqs.ConsumerMessage[Event]
+
+// ConverterFunc converts a message from a sqs message.
+type ConverterFunc = sqs.ConverterFunc[Event]
 
 // ConsumeFunc is a function to consume Event.
 type ConsumeFunc func(context.Context) <-chan ConsumerMessage
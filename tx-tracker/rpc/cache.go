@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
+)
+
+// txCache is an in-memory cache of the resolved origin transactions.
+//
+// Origin transactions are immutable once finalized, so the expiration only bounds the memory usage.
+type txCache struct {
+	mu         sync.Mutex
+	entries    map[string]txCacheEntry
+	expiration time.Duration
+	maxSize    int
+	now        func() time.Time
+}
+
+type txCacheEntry struct {
+	value     *txtracker.ResolveOriginTxResponse
+	expiresAt time.Time
+}
+
+func newTxCache(expiration time.Duration, maxSize int) *txCache {
+	return &txCache{
+		entries:    make(map[string]txCacheEntry),
+		expiration: expiration,
+		maxSize:    maxSize,
+		now:        time.Now,
+	}
+}
+
+func (c *txCache) get(key string) (*txtracker.ResolveOriginTxResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *txCache) set(key string, value *txtracker.ResolveOriginTxResponse) {
+	if c.expiration <= 0 || c.maxSize <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if len(c.entries) >= c.maxSize {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	// skip caching when the cache is still full, the transaction will be fetched again.
+	if len(c.entries) >= c.maxSize {
+		return
+	}
+	c.entries[key] = txCacheEntry{value: value, expiresAt: now.Add(c.expiration)}
+}
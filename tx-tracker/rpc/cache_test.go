@@ -0,0 +1,35 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
+)
+
+func TestTxCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := newTxCache(time.Minute, 2)
+	c.now = func() time.Time { return now }
+
+	c.set("2/0x1", &txtracker.ResolveOriginTxResponse{NativeTxHash: "0x1"})
+	c.set("2/0x2", &txtracker.ResolveOriginTxResponse{NativeTxHash: "0x2"})
+	// the cache is full, so the entry is not stored.
+	c.set("2/0x3", &txtracker.ResolveOriginTxResponse{NativeTxHash: "0x3"})
+
+	resp, ok := c.get("2/0x1")
+	assert.True(t, ok)
+	assert.Equal(t, "0x1", resp.NativeTxHash)
+	_, ok = c.get("2/0x3")
+	assert.False(t, ok)
+
+	// expired entries are evicted to make room for new ones.
+	now = now.Add(2 * time.Minute)
+	c.set("2/0x3", &txtracker.ResolveOriginTxResponse{NativeTxHash: "0x3"})
+	_, ok = c.get("2/0x1")
+	assert.False(t, ok)
+	resp, ok = c.get("2/0x3")
+	assert.True(t, ok)
+	assert.Equal(t, "0x3", resp.NativeTxHash)
+}
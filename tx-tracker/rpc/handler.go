@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultCacheMaxSize = 10_000
+
+// Handler resolves origin transactions on demand using the rpc provider pools of the service.
+type Handler struct {
+	rpcPool          map[sdk.ChainID]*pool.Pool
+	wormchainRpcPool map[sdk.ChainID]*pool.Pool
+	p2pNetwork       string
+	notionalCache    *notional.NotionalCache
+	metrics          metrics.TxTrackerMetrics
+	cache            *txCache
+	logger           *zap.Logger
+}
+
+// NewHandler creates a new gRPC handler.
+func NewHandler(
+	rpcPool map[sdk.ChainID]*pool.Pool,
+	wormchainRpcPool map[sdk.ChainID]*pool.Pool,
+	p2pNetwork string,
+	notionalCache *notional.NotionalCache,
+	metrics metrics.TxTrackerMetrics,
+	cacheExpiration time.Duration,
+	logger *zap.Logger,
+) *Handler {
+	return &Handler{
+		rpcPool:          rpcPool,
+		wormchainRpcPool: wormchainRpcPool,
+		p2pNetwork:       p2pNetwork,
+		notionalCache:    notionalCache,
+		metrics:          metrics,
+		cache:            newTxCache(cacheExpiration, defaultCacheMaxSize),
+		logger:           logger,
+	}
+}
+
+// ResolveOriginTx fetches the origin transaction of a chain from the rpc providers.
+func (h *Handler) ResolveOriginTx(ctx context.Context, req *txtracker.ResolveOriginTxRequest) (*txtracker.ResolveOriginTxResponse, error) {
+	chainID := sdk.ChainID(req.ChainID)
+	if !domain.ChainIdIsValid(chainID) {
+		return nil, status.Error(codes.InvalidArgument, "invalid chain id")
+	}
+	if req.TxHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "tx hash is required")
+	}
+
+	key := fmt.Sprintf("%d/%s", chainID, req.TxHash)
+	if resp, ok := h.cache.get(key); ok {
+		return resp, nil
+	}
+
+	txDetail, err := chains.FetchTx(ctx, h.rpcPool, h.wormchainRpcPool, chainID, req.TxHash, req.Timestamp, h.p2pNetwork, h.metrics, h.logger, h.notionalCache)
+	switch {
+	case errors.Is(err, chains.ErrChainNotSupported):
+		return nil, status.Error(codes.Unimplemented, "chain not supported")
+	case errors.Is(err, chains.ErrTransactionNotFound):
+		return nil, status.Error(codes.NotFound, "transaction not found")
+	case err != nil:
+		h.logger.Error("Failed to resolve origin tx",
+			zap.String("chainId", chainID.String()),
+			zap.String("txHash", req.TxHash),
+			zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to resolve origin tx")
+	}
+
+	resp := newResolveOriginTxResponse(txDetail)
+	h.cache.set(key, resp)
+	return resp, nil
+}
+
+func newResolveOriginTxResponse(txDetail *chains.TxDetail) *txtracker.ResolveOriginTxResponse {
+	resp := txtracker.ResolveOriginTxResponse{
+		From:         txDetail.From,
+		NativeTxHash: txDetail.NativeTxHash,
+	}
+	if txDetail.Attribute != nil {
+		resp.Attribute = &txtracker.OriginTxAttribute{
+			Type:  txDetail.Attribute.Type,
+			Value: txDetail.Attribute.Value,
+		}
+	}
+	if txDetail.FeeDetail != nil {
+		resp.Fee = &txtracker.OriginTxFee{
+			Fee:              txDetail.FeeDetail.Fee,
+			RawFee:           txDetail.FeeDetail.RawFee,
+			GasTokenNotional: txDetail.FeeDetail.GasTokenNotional,
+			FeeUSD:           txDetail.FeeDetail.FeeUSD,
+		}
+	}
+	return &resp
+}
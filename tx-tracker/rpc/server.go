@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/txtracker"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Server is the internal gRPC server of the tx-tracker.
+type Server struct {
+	srv      *grpc.Server
+	listener net.Listener
+	logger   *zap.Logger
+}
+
+// NewServer creates a gRPC server listening on the given port.
+func NewServer(h *Handler, logger *zap.Logger, port string) (*Server, error) {
+	l, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	txtracker.RegisterTxTrackerServiceServer(grpcServer, h)
+
+	return &Server{srv: grpcServer, listener: l, logger: logger}, nil
+}
+
+// Start serves gRPC requests.
+func (s *Server) Start() {
+	s.logger.Info("gRPC server listening on " + s.listener.Addr().String())
+	go func() {
+		if err := s.srv.Serve(s.listener); err != nil {
+			s.logger.Error("gRPC server stopped", zap.Error(err))
+		}
+	}()
+}
+
+// Stop stops the gRPC server gracefully.
+func (s *Server) Stop() {
+	s.srv.GracefulStop()
+}
@@ -0,0 +1,236 @@
+// Package watcher implements the contract watchers that detect redeems by polling the chains,
+// instead of resolving the destination tx of each vaa, and the reorg watcher that re-checks
+// the blocks of the recent origin txs.
+package watcher
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const source = "contract-watcher"
+
+// EvmWatcherParams contains the parameters of an EvmWatcher.
+type EvmWatcherParams struct {
+	ChainID sdk.ChainID
+	// Contracts are the token bridge contracts emitting the TransferRedeemed logs.
+	Contracts []string
+	// DigestContracts are the contracts whose transactions are linked to the vaas by the digests
+	// found in their calldata and logs, e.g. the integrator contracts used to redeem manually.
+	DigestContracts []string
+	// BlockBatchSize is the max number of blocks requested in each eth_getLogs call.
+	BlockBatchSize uint64
+	// Confirmations is the number of blocks behind the head to consider a block final.
+	Confirmations uint64
+	PollInterval  time.Duration
+	P2pNetwork    string
+}
+
+// EvmWatcher polls the token bridge contracts of an evm chain for TransferRedeemed logs, and the digest
+// contracts for transactions including the digest of a vaa, and stores the redeem transactions as the
+// destination tx of the global transactions.
+type EvmWatcher struct {
+	params        EvmWatcherParams
+	rpcPool       *pool.Pool
+	blocks        *Repository
+	repository    *consumer.Repository
+	notionalCache *notional.NotionalCache
+	metrics       metrics.TxTrackerMetrics
+	logger        *zap.Logger
+}
+
+// NewEvmWatcher creates a new EvmWatcher.
+func NewEvmWatcher(
+	params EvmWatcherParams,
+	rpcPool *pool.Pool,
+	blocks *Repository,
+	repository *consumer.Repository,
+	notionalCache *notional.NotionalCache,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) *EvmWatcher {
+	return &EvmWatcher{
+		params:        params,
+		rpcPool:       rpcPool,
+		blocks:        blocks,
+		repository:    repository,
+		notionalCache: notionalCache,
+		metrics:       metrics,
+		logger:        logger.With(zap.String("module", "EvmWatcher"), zap.String("chain", params.ChainID.String())),
+	}
+}
+
+// Start polls the chain until the context is cancelled.
+func (w *EvmWatcher) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.params.PollInterval)
+		defer ticker.Stop()
+		for {
+			if err := w.poll(ctx); err != nil && ctx.Err() == nil {
+				w.logger.Error("Failed to poll redeems", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// poll processes the blocks from the last processed block up to the last final block.
+func (w *EvmWatcher) poll(ctx context.Context) error {
+	latest, err := chains.FetchEvmLatestBlock(ctx, w.rpcPool, w.params.ChainID, w.metrics, w.logger)
+	if err != nil {
+		return err
+	}
+	if latest < w.params.Confirmations {
+		return nil
+	}
+	latest -= w.params.Confirmations
+
+	lastBlock, err := w.blocks.GetLastBlock(ctx, w.params.ChainID)
+	if errors.Is(err, ErrBlockNotFound) {
+		// the first run starts from the head of the chain, older redeems are resolved by the pipeline.
+		w.logger.Info("Starting watcher from the latest block", zap.Uint64("block", latest))
+		return w.blocks.UpdateLastBlock(ctx, w.params.ChainID, latest)
+	}
+	if err != nil {
+		return err
+	}
+
+	for from := lastBlock + 1; from <= latest && ctx.Err() == nil; {
+		to := min(from+w.params.BlockBatchSize-1, latest)
+		if len(w.params.Contracts) > 0 {
+			redeems, err := chains.FetchEvmRedeems(ctx, w.rpcPool, w.params.ChainID, w.params.Contracts, from, to, w.metrics, w.logger)
+			if err != nil {
+				return err
+			}
+			for i := range redeems {
+				if err := w.processRedeem(ctx, &redeems[i]); err != nil {
+					return err
+				}
+			}
+		}
+		if len(w.params.DigestContracts) > 0 {
+			redeems, err := chains.FetchEvmDigestRedeems(ctx, w.rpcPool, w.params.ChainID, w.params.DigestContracts, from, to, w.metrics, w.logger)
+			if err != nil {
+				return err
+			}
+			for i := range redeems {
+				if err := w.processDigestRedeem(ctx, &redeems[i]); err != nil {
+					return err
+				}
+			}
+		}
+
+		// the block is only checkpointed once all its redeems are stored.
+		if err := w.blocks.UpdateLastBlock(ctx, w.params.ChainID, to); err != nil {
+			return err
+		}
+		w.metrics.SetContractWatcherLag(uint16(w.params.ChainID), latest-to)
+		from = to + 1
+	}
+	return nil
+}
+
+func (w *EvmWatcher) processRedeem(ctx context.Context, redeem *chains.EvmRedeem) error {
+	vaaID := redeem.VaaID()
+	w.logger.Debug("Redeem found", zap.String("vaaId", vaaID), zap.String("txHash", redeem.TxHash))
+
+	var evmFee *consumer.EvmFee
+	if redeem.GasUsed != "" && redeem.EffectiveGasPrice != "" {
+		evmFee = &consumer.EvmFee{
+			GasUsed:           redeem.GasUsed,
+			EffectiveGasPrice: redeem.EffectiveGasPrice,
+		}
+	}
+
+	p := consumer.ProcessTargetTxParams{
+		Source:         source,
+		TrackID:        source + "-" + redeem.TxHash,
+		VaaId:          vaaID,
+		ChainID:        w.params.ChainID,
+		Emitter:        redeem.EmitterAddress,
+		TxHash:         redeem.TxHash,
+		BlockTimestamp: redeem.BlockTimestamp,
+		BlockHeight:    strconv.FormatUint(redeem.BlockNumber, 10),
+		From:           redeem.From,
+		To:             redeem.To,
+		Status:         domain.DstTxStatusConfirmed,
+		EvmFee:         evmFee,
+		Metrics:        w.metrics,
+		P2pNetwork:     w.params.P2pNetwork,
+	}
+	if err := consumer.ProcessTargetTx(ctx, w.logger, w.repository, &p, w.notionalCache); err != nil {
+		return err
+	}
+	w.metrics.IncContractWatcherRedeem(uint16(w.params.ChainID))
+	return nil
+}
+
+// processDigestRedeem stores the transaction as the destination tx of the vaas whose digest was found in it.
+func (w *EvmWatcher) processDigestRedeem(ctx context.Context, redeem *chains.EvmDigestRedeem) error {
+	vaas, err := w.blocks.FindVaasByDigest(ctx, uniqueDigests(redeem.Digests))
+	if err != nil {
+		return err
+	}
+
+	var evmFee *consumer.EvmFee
+	if redeem.GasUsed != "" && redeem.EffectiveGasPrice != "" {
+		evmFee = &consumer.EvmFee{
+			GasUsed:           redeem.GasUsed,
+			EffectiveGasPrice: redeem.EffectiveGasPrice,
+		}
+	}
+
+	for _, v := range vaas {
+		w.logger.Debug("Redeem found by digest", zap.String("vaaId", v.ID), zap.String("digest", v.Digest), zap.String("txHash", redeem.TxHash))
+		p := consumer.ProcessTargetTxParams{
+			Source:         source,
+			TrackID:        source + "-" + redeem.TxHash,
+			VaaId:          v.ID,
+			ChainID:        w.params.ChainID,
+			Emitter:        v.EmitterAddr,
+			TxHash:         redeem.TxHash,
+			BlockTimestamp: redeem.BlockTimestamp,
+			BlockHeight:    strconv.FormatUint(redeem.BlockNumber, 10),
+			From:           redeem.From,
+			To:             redeem.To,
+			Status:         domain.DstTxStatusConfirmed,
+			EvmFee:         evmFee,
+			Metrics:        w.metrics,
+			P2pNetwork:     w.params.P2pNetwork,
+		}
+		if err := consumer.ProcessTargetTx(ctx, w.logger, w.repository, &p, w.notionalCache); err != nil {
+			return err
+		}
+		w.metrics.IncContractWatcherRedeem(uint16(w.params.ChainID))
+	}
+	return nil
+}
+
+// uniqueDigests returns the digests without duplicates, keeping their order.
+func uniqueDigests(digests []string) []string {
+	seen := make(map[string]struct{}, len(digests))
+	unique := make([]string, 0, len(digests))
+	for _, d := range digests {
+		if _, ok := seen[d]; ok {
+			continue
+		}
+		seen[d] = struct{}{}
+		unique = append(unique, d)
+	}
+	return unique
+}
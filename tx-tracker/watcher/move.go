@@ -0,0 +1,166 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// fetchMoveRedeemsFunc returns the redeem events after the cursor and the cursor of the last event returned.
+type fetchMoveRedeemsFunc func(ctx context.Context, pool *pool.Pool, event string, cursor string, limit int,
+	metrics metrics.TxTrackerMetrics, logger *zap.Logger) ([]chains.MoveRedeem, string, error)
+
+// MoveWatcherParams contains the parameters of a MoveWatcher.
+type MoveWatcherParams struct {
+	ChainID sdk.ChainID
+	// Event is the redeem event of the token bridge: the event handle in aptos and the event type in sui.
+	Event string
+	// EventBatchSize is the max number of events requested in each call.
+	EventBatchSize int
+	PollInterval   time.Duration
+	P2pNetwork     string
+}
+
+// MoveWatcher polls the redeem events of the token bridge of a move chain (aptos or sui)
+// and stores the redeem transactions as the destination tx of the global transactions.
+type MoveWatcher struct {
+	params        MoveWatcherParams
+	checkpointID  string
+	fetchRedeems  fetchMoveRedeemsFunc
+	rpcPool       *pool.Pool
+	blocks        *Repository
+	repository    *consumer.Repository
+	notionalCache *notional.NotionalCache
+	metrics       metrics.TxTrackerMetrics
+	logger        *zap.Logger
+}
+
+// NewMoveWatcher creates a new MoveWatcher.
+func NewMoveWatcher(
+	params MoveWatcherParams,
+	rpcPool *pool.Pool,
+	blocks *Repository,
+	repository *consumer.Repository,
+	notionalCache *notional.NotionalCache,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) (*MoveWatcher, error) {
+	w := &MoveWatcher{
+		params:        params,
+		rpcPool:       rpcPool,
+		blocks:        blocks,
+		repository:    repository,
+		notionalCache: notionalCache,
+		metrics:       metrics,
+		logger:        logger.With(zap.String("module", "MoveWatcher"), zap.String("chain", params.ChainID.String())),
+	}
+	switch params.ChainID {
+	case sdk.ChainIDAptos:
+		w.checkpointID = aptosCheckpointID
+		w.fetchRedeems = chains.FetchAptosRedeems
+	case sdk.ChainIDSui:
+		w.checkpointID = suiCheckpointID
+		w.fetchRedeems = chains.FetchSuiRedeems
+	default:
+		return nil, fmt.Errorf("move watcher not supported for chain %s", params.ChainID.String())
+	}
+	return w, nil
+}
+
+// Start polls the events until the context is cancelled.
+func (w *MoveWatcher) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.params.PollInterval)
+		defer ticker.Stop()
+		for {
+			if err := w.poll(ctx); err != nil && ctx.Err() == nil {
+				w.logger.Error("Failed to poll redeems", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// poll processes the redeem events after the last processed event.
+func (w *MoveWatcher) poll(ctx context.Context) error {
+	checkpoint, err := w.blocks.GetCheckpoint(ctx, w.checkpointID)
+	if errors.Is(err, ErrBlockNotFound) {
+		// the first run starts from the latest event, older redeems are resolved by the pipeline.
+		_, cursor, err := w.fetchRedeems(ctx, w.rpcPool, w.params.Event, "", 1, w.metrics, w.logger)
+		if err != nil || cursor == "" {
+			return err
+		}
+		w.logger.Info("Starting watcher from the latest event", zap.String("cursor", cursor))
+		return w.blocks.UpdateCheckpoint(ctx, w.checkpointID, Checkpoint{Cursor: cursor})
+	}
+	if err != nil {
+		return err
+	}
+
+	for cursor := checkpoint.Cursor; ctx.Err() == nil; {
+		redeems, next, err := w.fetchRedeems(ctx, w.rpcPool, w.params.Event, cursor, w.params.EventBatchSize, w.metrics, w.logger)
+		if err != nil {
+			return err
+		}
+		if next == cursor {
+			return nil
+		}
+		for i := range redeems {
+			if err := w.processRedeem(ctx, &redeems[i]); err != nil {
+				return err
+			}
+		}
+
+		// the events are only checkpointed once all their redeems are stored.
+		if err := w.blocks.UpdateCheckpoint(ctx, w.checkpointID, Checkpoint{Cursor: next}); err != nil {
+			return err
+		}
+		cursor = next
+	}
+	return nil
+}
+
+func (w *MoveWatcher) processRedeem(ctx context.Context, redeem *chains.MoveRedeem) error {
+	vaaID := redeem.VaaID()
+	w.logger.Debug("Redeem found", zap.String("vaaId", vaaID), zap.String("txHash", redeem.TxHash))
+
+	p := consumer.ProcessTargetTxParams{
+		Source:         source,
+		TrackID:        source + "-" + redeem.TxHash,
+		VaaId:          vaaID,
+		ChainID:        w.params.ChainID,
+		Emitter:        redeem.EmitterAddress,
+		TxHash:         redeem.TxHash,
+		BlockTimestamp: redeem.BlockTimestamp,
+		BlockHeight:    redeem.BlockHeight,
+		From:           redeem.From,
+		Status:         domain.DstTxStatusConfirmed,
+		Metrics:        w.metrics,
+		P2pNetwork:     w.params.P2pNetwork,
+	}
+	if redeem.FeeDetail != nil {
+		p.MoveFee = &consumer.MoveFee{
+			RawFee: redeem.FeeDetail.RawFee,
+			Fee:    redeem.FeeDetail.Fee,
+		}
+	}
+	if err := consumer.ProcessTargetTx(ctx, w.logger, w.repository, &p, w.notionalCache); err != nil {
+		return err
+	}
+	w.metrics.IncContractWatcherRedeem(uint16(w.params.ChainID))
+	return nil
+}
@@ -0,0 +1,204 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/alert"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
+	txtrackerAlert "github.com/wormhole-foundation/wormhole-explorer/txtracker/internal/alert"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Reorg statuses.
+const (
+	// ReorgStatusReincluded indicates that the origin tx was included again in another block.
+	ReorgStatusReincluded = "reincluded"
+	// ReorgStatusRemoved indicates that the origin tx is no longer included in any block.
+	ReorgStatusRemoved = "removed"
+)
+
+// Reorg is a reorg that removed the recorded block of the origin tx of a vaa.
+type Reorg struct {
+	ID      string      `bson:"_id"`
+	VaaID   string      `bson:"vaaId"`
+	ChainID sdk.ChainID `bson:"chainId"`
+	TxHash  string      `bson:"txHash"`
+	Status  string      `bson:"status"`
+	// BlockNumber and BlockHash are the block recorded for the origin tx before the reorg.
+	BlockNumber uint64 `bson:"blockNumber"`
+	BlockHash   string `bson:"blockHash"`
+	// NewBlockNumber and NewBlockHash are the block that includes the origin tx after the reorg, if any.
+	NewBlockNumber uint64    `bson:"newBlockNumber,omitempty"`
+	NewBlockHash   string    `bson:"newBlockHash,omitempty"`
+	DetectedAt     time.Time `bson:"detectedAt"`
+}
+
+// newReorg creates the reorg of an origin tx, txBlock is the block that currently includes the tx or nil.
+func newReorg(tx *OriginTxBlock, txBlock *chains.EvmTxBlock, now time.Time) *Reorg {
+	reorg := &Reorg{
+		ID:          tx.VaaID + "/" + tx.BlockHash,
+		VaaID:       tx.VaaID,
+		ChainID:     tx.ChainID,
+		TxHash:      tx.TxHash,
+		Status:      ReorgStatusRemoved,
+		BlockNumber: tx.BlockNumber,
+		BlockHash:   tx.BlockHash,
+		DetectedAt:  now,
+	}
+	if txBlock != nil {
+		reorg.Status = ReorgStatusReincluded
+		reorg.NewBlockNumber = txBlock.Number
+		reorg.NewBlockHash = txBlock.Hash
+	}
+	return reorg
+}
+
+// ReorgWatcherParams contains the parameters of a ReorgWatcher.
+type ReorgWatcherParams struct {
+	PollInterval time.Duration
+	// Window is how long after the vaa the block of an origin tx is re-checked.
+	Window time.Duration
+	// BatchSize is the max number of origin txs checked in each poll.
+	BatchSize int64
+}
+
+// ReorgWatcher re-checks the blocks of the recent origin txs of evm chains that were not finalized
+// when they were recorded. When the hash of the block at the recorded height changed, the reorg is
+// stored, the origin tx is flagged (and corrected if the tx was included in another block) and an alert is sent.
+type ReorgWatcher struct {
+	params      ReorgWatcherParams
+	rpcPool     map[sdk.ChainID]*pool.Pool
+	repository  *ReorgRepository
+	alertClient alert.AlertClient
+	metrics     metrics.TxTrackerMetrics
+	logger      *zap.Logger
+}
+
+// NewReorgWatcher creates a new ReorgWatcher.
+func NewReorgWatcher(
+	params ReorgWatcherParams,
+	rpcPool map[sdk.ChainID]*pool.Pool,
+	repository *ReorgRepository,
+	alertClient alert.AlertClient,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) *ReorgWatcher {
+	return &ReorgWatcher{
+		params:      params,
+		rpcPool:     rpcPool,
+		repository:  repository,
+		alertClient: alertClient,
+		metrics:     metrics,
+		logger:      logger.With(zap.String("module", "ReorgWatcher")),
+	}
+}
+
+// Start polls the origin txs until the context is cancelled.
+func (w *ReorgWatcher) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.params.PollInterval)
+		defer ticker.Stop()
+		for {
+			if err := w.poll(ctx); err != nil && ctx.Err() == nil {
+				w.logger.Error("Failed to check origin txs for reorgs", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (w *ReorgWatcher) poll(ctx context.Context) error {
+	txs, err := w.repository.FindUnfinalizedOriginTxs(ctx, time.Now().Add(-w.params.Window), w.params.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	// the finalized block of each chain is fetched once per poll.
+	finalized := make(map[sdk.ChainID]uint64)
+	for i := range txs {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := w.check(ctx, &txs[i], finalized); err != nil {
+			w.logger.Warn("Failed to check origin tx for reorgs",
+				zap.String("vaaId", txs[i].VaaID),
+				zap.String("txHash", txs[i].TxHash),
+				zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (w *ReorgWatcher) check(ctx context.Context, tx *OriginTxBlock, finalized map[sdk.ChainID]uint64) error {
+	rpcPool, ok := w.rpcPool[tx.ChainID]
+	if !ok {
+		return chains.ErrChainNotSupported
+	}
+
+	hash, err := chains.FetchEvmBlockHash(ctx, rpcPool, tx.ChainID, tx.BlockNumber, w.metrics, w.logger)
+	if err != nil {
+		return err
+	}
+	if hash == "" {
+		// the node is behind the block, it is checked in the next poll.
+		return nil
+	}
+
+	if hash == tx.BlockHash {
+		finalizedBlock, ok := finalized[tx.ChainID]
+		if !ok {
+			// chains without the finalized tag are checked until the window expires.
+			finalizedBlock, err = chains.FetchEvmFinalizedBlock(ctx, rpcPool, tx.ChainID, w.metrics, w.logger)
+			if err != nil {
+				w.logger.Debug("Failed to get finalized block", zap.String("chain", tx.ChainID.String()), zap.Error(err))
+			}
+			finalized[tx.ChainID] = finalizedBlock
+		}
+		if tx.BlockNumber <= finalizedBlock {
+			return w.repository.MarkOriginTxFinalized(ctx, tx.VaaID)
+		}
+		return nil
+	}
+
+	txBlock, err := chains.FetchEvmTxBlock(ctx, rpcPool, tx.ChainID, tx.TxHash, w.metrics, w.logger)
+	if err != nil && !errors.Is(err, chains.ErrTransactionNotFound) {
+		return err
+	}
+
+	reorg := newReorg(tx, txBlock, time.Now())
+	if err := w.repository.SaveReorg(ctx, reorg); err != nil {
+		return err
+	}
+	w.metrics.IncOriginTxReorg(uint16(tx.ChainID), reorg.Status)
+	w.logger.Warn("Origin tx block was reorganized",
+		zap.String("vaaId", reorg.VaaID),
+		zap.String("chain", reorg.ChainID.String()),
+		zap.String("txHash", reorg.TxHash),
+		zap.Uint64("blockNumber", reorg.BlockNumber),
+		zap.String("status", reorg.Status))
+
+	alertContext := alert.AlertContext{
+		Details: map[string]string{
+			"vaaId":       reorg.VaaID,
+			"chainId":     strconv.Itoa(int(reorg.ChainID)),
+			"txHash":      reorg.TxHash,
+			"blockNumber": strconv.FormatUint(reorg.BlockNumber, 10),
+			"blockHash":   reorg.BlockHash,
+			"status":      reorg.Status,
+		},
+	}
+	if err := w.alertClient.CreateAndSend(ctx, txtrackerAlert.AlertKeyOriginTxReorg, alertContext); err != nil {
+		w.logger.Error("Failed to send reorg alert", zap.String("vaaId", reorg.VaaID), zap.Error(err))
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/repository"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// OriginTxBlock is the block recorded for the origin tx of a vaa.
+type OriginTxBlock struct {
+	VaaID       string      `bson:"_id"`
+	ChainID     sdk.ChainID `bson:"chainId"`
+	TxHash      string      `bson:"nativeTxHash"`
+	BlockNumber uint64      `bson:"blockNumber"`
+	BlockHash   string      `bson:"blockHash"`
+}
+
+// ReorgRepository exposes the operations of the reorg watcher over the `globalTransactions` and `reorgs` collections.
+type ReorgRepository struct {
+	logger             *zap.Logger
+	globalTransactions *mongo.Collection
+	reorgs             *mongo.Collection
+}
+
+// NewReorgRepository creates a new ReorgRepository.
+func NewReorgRepository(logger *zap.Logger, db *mongo.Database) *ReorgRepository {
+	return &ReorgRepository{
+		logger:             logger,
+		globalTransactions: db.Collection("globalTransactions"),
+		reorgs:             db.Collection(repository.Reorgs),
+	}
+}
+
+// FindUnfinalizedOriginTxs returns the origin txs emitted after [since] whose block was not finalized
+// when they were recorded, oldest first.
+func (r *ReorgRepository) FindUnfinalizedOriginTxs(ctx context.Context, since time.Time, limit int64) ([]OriginTxBlock, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "originTx.timestamp", Value: bson.D{{Key: "$gte", Value: since}}},
+			{Key: "originTx.finality", Value: bson.D{{Key: "$in", Value: bson.A{domain.TxFinalitySafe, domain.TxFinalityUnsafe}}}},
+			{Key: "originTx.blockHash", Value: bson.D{{Key: "$exists", Value: true}}},
+			{Key: "originTx.reorg.status", Value: bson.D{{Key: "$ne", Value: ReorgStatusRemoved}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "originTx.timestamp", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "chainId", Value: "$originTx.chainId"},
+			{Key: "nativeTxHash", Value: "$originTx.nativeTxHash"},
+			{Key: "blockNumber", Value: "$originTx.blockNumber"},
+			{Key: "blockHash", Value: "$originTx.blockHash"},
+		}}},
+	}
+
+	cur, err := r.globalTransactions.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find unfinalized origin txs: %w", err)
+	}
+	var txs []OriginTxBlock
+	if err := cur.All(ctx, &txs); err != nil {
+		return nil, fmt.Errorf("failed to decode unfinalized origin txs: %w", err)
+	}
+	return txs, nil
+}
+
+// MarkOriginTxFinalized sets the finality of the origin tx of a vaa to finalized.
+func (r *ReorgRepository) MarkOriginTxFinalized(ctx context.Context, vaaID string) error {
+	update := bson.M{
+		"$set": bson.M{
+			"originTx.finality":  domain.TxFinalityFinalized,
+			"originTx.updatedAt": time.Now(),
+		},
+	}
+	_, err := r.globalTransactions.UpdateByID(ctx, vaaID, update)
+	if err != nil {
+		return fmt.Errorf("failed to mark origin tx as finalized: %w", err)
+	}
+	return nil
+}
+
+// SaveReorg stores a reorg and flags the origin tx of the affected vaa.
+// When the tx was included in another block, the block of the origin tx is corrected.
+func (r *ReorgRepository) SaveReorg(ctx context.Context, reorg *Reorg) error {
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.reorgs.ReplaceOne(ctx, bson.M{"_id": reorg.ID}, reorg, opts); err != nil {
+		return fmt.Errorf("failed to save reorg: %w", err)
+	}
+
+	set := bson.D{
+		{Key: "originTx.reorg", Value: bson.D{
+			{Key: "status", Value: reorg.Status},
+			{Key: "blockNumber", Value: reorg.BlockNumber},
+			{Key: "blockHash", Value: reorg.BlockHash},
+			{Key: "detectedAt", Value: reorg.DetectedAt},
+		}},
+		{Key: "originTx.updatedAt", Value: reorg.DetectedAt},
+	}
+	if reorg.Status == ReorgStatusReincluded {
+		set = append(set,
+			bson.E{Key: "originTx.blockNumber", Value: reorg.NewBlockNumber},
+			bson.E{Key: "originTx.blockHash", Value: reorg.NewBlockHash})
+	}
+	if _, err := r.globalTransactions.UpdateByID(ctx, reorg.VaaID, bson.D{{Key: "$set", Value: set}}); err != nil {
+		return fmt.Errorf("failed to flag reorged origin tx: %w", err)
+	}
+	return nil
+}
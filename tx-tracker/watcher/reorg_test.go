@@ -0,0 +1,40 @@
+package watcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestNewReorg(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tx := &OriginTxBlock{
+		VaaID:       "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1",
+		ChainID:     sdk.ChainIDEthereum,
+		TxHash:      "0xabc",
+		BlockNumber: 100,
+		BlockHash:   "0x01",
+	}
+
+	t.Run("removed", func(t *testing.T) {
+		reorg := newReorg(tx, nil, now)
+		assert.Equal(t, tx.VaaID+"/0x01", reorg.ID)
+		assert.Equal(t, ReorgStatusRemoved, reorg.Status)
+		assert.Equal(t, uint64(100), reorg.BlockNumber)
+		assert.Equal(t, "0x01", reorg.BlockHash)
+		assert.Zero(t, reorg.NewBlockNumber)
+		assert.Empty(t, reorg.NewBlockHash)
+		assert.Equal(t, now, reorg.DetectedAt)
+	})
+
+	t.Run("reincluded", func(t *testing.T) {
+		reorg := newReorg(tx, &chains.EvmTxBlock{Number: 101, Hash: "0x02"}, now)
+		assert.Equal(t, ReorgStatusReincluded, reorg.Status)
+		assert.Equal(t, uint64(100), reorg.BlockNumber)
+		assert.Equal(t, uint64(101), reorg.NewBlockNumber)
+		assert.Equal(t, "0x02", reorg.NewBlockHash)
+	})
+}
@@ -0,0 +1,143 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+// ErrBlockNotFound is returned when a watcher has not processed any block yet.
+var ErrBlockNotFound = errors.New("watcher block not found")
+
+// ids of the checkpoints of the watchers that don't resume from a block number.
+const (
+	solanaCheckpointID = "solana-redeems"
+	aptosCheckpointID  = "aptos-redeems"
+	suiCheckpointID    = "sui-redeems"
+)
+
+type watcherBlock struct {
+	ID          string    `bson:"_id"`
+	BlockNumber uint64    `bson:"blockNumber"`
+	Cursor      string    `bson:"cursor,omitempty"`
+	UpdatedAt   time.Time `bson:"updatedAt"`
+}
+
+// Checkpoint is the position of the last item processed by a watcher,
+// e.g. the signature of a solana transaction or the cursor of a sui event.
+type Checkpoint struct {
+	Cursor      string
+	BlockNumber uint64
+}
+
+// DigestVaa is the vaa of a digest.
+type DigestVaa struct {
+	ID          string `bson:"_id"`
+	EmitterAddr string `bson:"emitterAddr"`
+	Digest      string `bson:"digest"`
+}
+
+// Repository exposes operations over the `watcherBlocks` and `vaas` collections.
+type Repository struct {
+	logger        *zap.Logger
+	watcherBlocks *mongo.Collection
+	vaas          *mongo.Collection
+}
+
+// NewRepository creates a new repository.
+func NewRepository(logger *zap.Logger, db *mongo.Database) *Repository {
+	return &Repository{
+		logger:        logger,
+		watcherBlocks: db.Collection("watcherBlocks"),
+		vaas:          db.Collection("vaas"),
+	}
+}
+
+// GetLastBlock returns the last block processed by the watcher of a chain.
+func (r *Repository) GetLastBlock(ctx context.Context, chainID sdk.ChainID) (uint64, error) {
+	var block watcherBlock
+	err := r.watcherBlocks.FindOne(ctx, bson.M{"_id": blockID(chainID)}).Decode(&block)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, ErrBlockNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last watcher block: %w", err)
+	}
+	return block.BlockNumber, nil
+}
+
+// UpdateLastBlock stores the last block processed by the watcher of a chain.
+func (r *Repository) UpdateLastBlock(ctx context.Context, chainID sdk.ChainID, blockNumber uint64) error {
+	update := bson.M{
+		"$set": bson.M{
+			"blockNumber": blockNumber,
+			"updatedAt":   time.Now(),
+		},
+	}
+	opts := options.Update().SetUpsert(true)
+	_, err := r.watcherBlocks.UpdateByID(ctx, blockID(chainID), update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to update last watcher block: %w", err)
+	}
+	return nil
+}
+
+// GetCheckpoint returns the checkpoint of a watcher.
+func (r *Repository) GetCheckpoint(ctx context.Context, id string) (*Checkpoint, error) {
+	var block watcherBlock
+	err := r.watcherBlocks.FindOne(ctx, bson.M{"_id": id}).Decode(&block)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrBlockNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watcher checkpoint: %w", err)
+	}
+	return &Checkpoint{Cursor: block.Cursor, BlockNumber: block.BlockNumber}, nil
+}
+
+// UpdateCheckpoint stores the checkpoint of a watcher.
+func (r *Repository) UpdateCheckpoint(ctx context.Context, id string, checkpoint Checkpoint) error {
+	update := bson.M{
+		"$set": bson.M{
+			"blockNumber": checkpoint.BlockNumber,
+			"cursor":      checkpoint.Cursor,
+			"updatedAt":   time.Now(),
+		},
+	}
+	opts := options.Update().SetUpsert(true)
+	_, err := r.watcherBlocks.UpdateByID(ctx, id, update, opts)
+	if err != nil {
+		return fmt.Errorf("failed to update watcher checkpoint: %w", err)
+	}
+	return nil
+}
+
+// FindVaasByDigest returns the vaas whose digest is one of the digests.
+func (r *Repository) FindVaasByDigest(ctx context.Context, digests []string) ([]DigestVaa, error) {
+	if len(digests) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{"digest": bson.M{"$in": digests}}
+	opts := options.Find().SetProjection(bson.M{"emitterAddr": 1, "digest": 1})
+	cur, err := r.vaas.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vaas by digest: %w", err)
+	}
+	var vaas []DigestVaa
+	if err := cur.All(ctx, &vaas); err != nil {
+		return nil, fmt.Errorf("failed to decode vaas by digest: %w", err)
+	}
+	return vaas, nil
+}
+
+func blockID(chainID sdk.ChainID) string {
+	return "evm-redeems-" + strconv.Itoa(int(chainID))
+}
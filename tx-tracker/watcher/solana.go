@@ -0,0 +1,162 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole-explorer/common/client/cache/notional"
+	"github.com/wormhole-foundation/wormhole-explorer/common/domain"
+	"github.com/wormhole-foundation/wormhole-explorer/common/metrics"
+	"github.com/wormhole-foundation/wormhole-explorer/common/pool"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/chains"
+	"github.com/wormhole-foundation/wormhole-explorer/txtracker/consumer"
+	sdk "github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// SolanaWatcherParams contains the parameters of a SolanaWatcher.
+type SolanaWatcherParams struct {
+	// Program is the token bridge program executing the complete transfer instructions.
+	Program string
+	// SignatureBatchSize is the max number of signatures requested in each getSignaturesForAddress call.
+	SignatureBatchSize int
+	PollInterval       time.Duration
+	P2pNetwork         string
+}
+
+// SolanaWatcher polls the transactions of the solana token bridge program for complete transfer instructions
+// and stores the redeem transactions as the destination tx of the global transactions.
+type SolanaWatcher struct {
+	params        SolanaWatcherParams
+	rpcPool       *pool.Pool
+	blocks        *Repository
+	repository    *consumer.Repository
+	notionalCache *notional.NotionalCache
+	metrics       metrics.TxTrackerMetrics
+	logger        *zap.Logger
+}
+
+// NewSolanaWatcher creates a new SolanaWatcher.
+func NewSolanaWatcher(
+	params SolanaWatcherParams,
+	rpcPool *pool.Pool,
+	blocks *Repository,
+	repository *consumer.Repository,
+	notionalCache *notional.NotionalCache,
+	metrics metrics.TxTrackerMetrics,
+	logger *zap.Logger,
+) *SolanaWatcher {
+	return &SolanaWatcher{
+		params:        params,
+		rpcPool:       rpcPool,
+		blocks:        blocks,
+		repository:    repository,
+		notionalCache: notionalCache,
+		metrics:       metrics,
+		logger:        logger.With(zap.String("module", "SolanaWatcher"), zap.String("chain", sdk.ChainIDSolana.String())),
+	}
+}
+
+// Start polls the program until the context is cancelled.
+func (w *SolanaWatcher) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.params.PollInterval)
+		defer ticker.Stop()
+		for {
+			if err := w.poll(ctx); err != nil && ctx.Err() == nil {
+				w.logger.Error("Failed to poll redeems", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// poll processes the finalized transactions of the program after the last processed transaction.
+func (w *SolanaWatcher) poll(ctx context.Context) error {
+	checkpoint, err := w.blocks.GetCheckpoint(ctx, solanaCheckpointID)
+	if errors.Is(err, ErrBlockNotFound) {
+		// the first run starts from the latest transaction, older redeems are resolved by the pipeline.
+		signatures, err := chains.FetchSolanaSignatures(ctx, w.rpcPool, w.params.Program, "", 1, w.metrics, w.logger)
+		if err != nil || len(signatures) == 0 {
+			return err
+		}
+		latest := signatures[len(signatures)-1]
+		w.logger.Info("Starting watcher from the latest transaction", zap.String("signature", latest.Signature))
+		return w.blocks.UpdateCheckpoint(ctx, solanaCheckpointID, Checkpoint{Cursor: latest.Signature, BlockNumber: latest.Slot})
+	}
+	if err != nil {
+		return err
+	}
+
+	signatures, err := chains.FetchSolanaSignatures(ctx, w.rpcPool, w.params.Program, checkpoint.Cursor,
+		w.params.SignatureBatchSize, w.metrics, w.logger)
+	if err != nil {
+		return err
+	}
+	for _, s := range signatures {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !s.Failed {
+			redeems, err := chains.FetchSolanaRedeems(ctx, w.rpcPool, w.params.Program, s.Signature, w.metrics, w.logger)
+			if err != nil {
+				return err
+			}
+			for i := range redeems {
+				if err := w.processRedeem(ctx, &redeems[i]); err != nil {
+					return err
+				}
+			}
+		}
+
+		// the transaction is only checkpointed once all its redeems are stored.
+		checkpoint = &Checkpoint{Cursor: s.Signature, BlockNumber: s.Slot}
+		if err := w.blocks.UpdateCheckpoint(ctx, solanaCheckpointID, *checkpoint); err != nil {
+			return err
+		}
+	}
+
+	latest, err := chains.FetchSolanaLatestSlot(ctx, w.rpcPool, w.metrics, w.logger)
+	if err != nil {
+		return err
+	}
+	if latest > checkpoint.BlockNumber {
+		w.metrics.SetContractWatcherLag(uint16(sdk.ChainIDSolana), latest-checkpoint.BlockNumber)
+	} else {
+		w.metrics.SetContractWatcherLag(uint16(sdk.ChainIDSolana), 0)
+	}
+	return nil
+}
+
+func (w *SolanaWatcher) processRedeem(ctx context.Context, redeem *chains.SolanaRedeem) error {
+	vaaID := redeem.VaaID()
+	w.logger.Debug("Redeem found", zap.String("vaaId", vaaID), zap.String("digest", redeem.Digest), zap.String("txHash", redeem.TxHash))
+
+	p := consumer.ProcessTargetTxParams{
+		Source:         source,
+		TrackID:        source + "-" + redeem.TxHash,
+		VaaId:          vaaID,
+		ChainID:        sdk.ChainIDSolana,
+		Emitter:        redeem.EmitterAddress,
+		TxHash:         redeem.TxHash,
+		BlockTimestamp: redeem.BlockTimestamp,
+		BlockHeight:    strconv.FormatUint(redeem.Slot, 10),
+		From:           redeem.From,
+		To:             w.params.Program,
+		Status:         domain.DstTxStatusConfirmed,
+		SolanaFee:      &consumer.SolanaFee{Fee: redeem.Fee},
+		Metrics:        w.metrics,
+		P2pNetwork:     w.params.P2pNetwork,
+	}
+	if err := consumer.ProcessTargetTx(ctx, w.logger, w.repository, &p, w.notionalCache); err != nil {
+		return err
+	}
+	w.metrics.IncContractWatcherRedeem(uint16(sdk.ChainIDSolana))
+	return nil
+}